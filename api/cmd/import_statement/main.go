@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"regulation/internal/categorizer"
+	"regulation/internal/config"
+	"regulation/internal/ent"
+	_ "regulation/internal/ent/runtime"
+	"regulation/internal/envelope"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/budget"
+	"regulation/server/services/cashflow"
+	"regulation/server/services/importer"
+	"regulation/server/services/networth"
+)
+
+// Imports a CSV, OFX or QFX bank statement into a manual account.
+//
+// Transactions are categorized and run through rules exactly as synced ones are,
+// and rows already imported are skipped, so the same statement can be imported again.
+func main() {
+	accountFlag := flag.String("account", "", "ID of the manual account to import into")
+	file := flag.String("file", "", "statement file to import")
+	formatFlag := flag.String("format", "", "statement format: csv, ofx or qfx (default: inferred from the file extension)")
+	profilePath := flag.String("profile", "", "JSON file mapping the columns of a CSV statement (default: Date, Description and Amount)")
+	flag.Parse()
+
+	if *accountFlag == "" || *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	accountID, err := uuid.Parse(*accountFlag)
+	if err != nil {
+		log.Fatalf("Invalid account ID: %v", err)
+	}
+
+	format, err := importer.FormatFromPath(*file)
+	if *formatFlag != "" {
+		format, err = importer.ParseFormat(*formatFlag)
+	}
+	if err != nil {
+		log.Fatalf("Failed to determine statement format: %v", err)
+	}
+
+	var profile *importer.CSVProfile
+	if *profilePath != "" {
+		if profile, err = loadProfile(*profilePath); err != nil {
+			log.Fatalf("Failed to load CSV profile: %v", err)
+		}
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		log.Fatalf("Failed to read statement: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Reads config.json from CONFIG_DIR, like the server
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	kms, err := cfg.Encryption.KMS()
+	if err != nil {
+		log.Fatalf("Failed to load encryption keys: %v", err)
+	}
+	envelope.SetDefault(envelope.New(kms))
+
+	db, err := openDB(ctx, cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	importService, err := newImportService(db, cfg)
+	if err != nil {
+		log.Fatalf("Failed to set up import: %v", err)
+	}
+
+	account, err := db.Account.Get(ctx, accountID)
+	if err != nil {
+		log.Fatalf("Failed to load account: %v", err)
+	}
+
+	fmt.Printf("📥 Importing %s into %s (%s)\n", *file, account.Name, account.ID)
+
+	summary, err := importService.Import(ctx, account, format, data, profile)
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	fmt.Printf("✅ Imported %d of %d transactions\n", summary.Imported, summary.Parsed)
+	if summary.From != nil && summary.To != nil {
+		fmt.Printf("   📅 %s to %s\n", summary.From.Format("2006-01-02"), summary.To.Format("2006-01-02"))
+	}
+	fmt.Printf("   🔁 %d duplicates skipped\n", summary.Duplicates)
+	fmt.Printf("   ⚡ %d rule executions triggered\n", summary.RulesTriggered)
+	if summary.BalanceUpdated {
+		fmt.Println("   💰 Account balance updated from the statement")
+	}
+	if len(summary.Skipped) > 0 {
+		fmt.Printf("⚠️  %d rows could not be read:\n", len(summary.Skipped))
+		for _, row := range summary.Skipped {
+			fmt.Printf("   line %d: %s\n", row.Line, row.Reason)
+		}
+	}
+}
+
+// newImportService builds the same categorization and rule pipeline the server syncs transactions with
+func newImportService(db *ent.Client, cfg *config.Config) (*importer.Service, error) {
+	rates, err := cfg.Currency.RateProvider()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load exchange rates: %w", err)
+	}
+
+	apiKey := ""
+	if cfg.OpenAI != nil {
+		apiKey = cfg.OpenAI.APIKey
+	}
+
+	cashflowService := cashflow.NewService(db, rates)
+	netWorthService := networth.NewService(db, rates)
+	budgetTracker := budget.NewTracker(db, cfg, cashflowService)
+
+	// Imports never sync items, so neither a provider nor a lock is needed
+	syncService := aggregator.NewSyncService(nil, db, cfg, categorizer.NewService(apiKey), cashflowService, budgetTracker, netWorthService, nil)
+
+	return importer.NewService(db, syncService, netWorthService), nil
+}
+
+// loadProfile reads a CSV column mapping, see importer.CSVProfile
+func loadProfile(path string) (*importer.CSVProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read profile: %w", err)
+	}
+
+	var profile importer.CSVProfile
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse profile: %w", err)
+	}
+
+	return &profile, nil
+}
+
+// openDB connects to the database without the query cache the server uses
+func openDB(ctx context.Context, cfg *config.Config) (*ent.Client, error) {
+	pool, err := pgxpool.New(ctx, cfg.DB.URI())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pgx pool: %w", err)
+	}
+
+	client := stdlib.OpenDBFromPool(pool)
+	if err = client.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return ent.NewClient(ent.Driver(entsql.OpenDB("postgres", client))), nil
+}
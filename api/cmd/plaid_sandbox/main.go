@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	"regulation/server/services/plaid/sandbox"
+)
+
+// Runs a local stand-in for Plaid's API that replays a scenario of transactions, for developing offline.
+//
+// Point the server at it with PLAID_BASE_URL; any client_id and secret are accepted.
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8090", "address to listen on")
+	scenarioPath := flag.String("scenario", "", "scenario file to replay (default: the built-in scenario)")
+	flag.Parse()
+
+	scenario := sandbox.DefaultScenario()
+	if *scenarioPath != "" {
+		var err error
+		if scenario, err = sandbox.LoadScenario(*scenarioPath); err != nil {
+			log.Fatalf("Failed to load scenario: %v", err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	server := sandbox.New(scenario)
+	baseURL, err := server.Start(*addr)
+	if err != nil {
+		log.Fatalf("Failed to start sandbox: %v", err)
+	}
+	defer server.Close()
+
+	fmt.Printf("🏦 Plaid sandbox for %s listening on %s\n", scenario.Institution.Name, baseURL)
+	fmt.Printf("   %d accounts, %d scripted steps\n", len(scenario.Accounts), len(scenario.Steps))
+	fmt.Printf("   Run the server with PLAID_BASE_URL=%s PLAID_USE_MOCK=false\n", baseURL)
+
+	<-ctx.Done()
+	fmt.Println("👋 Stopping sandbox")
+}
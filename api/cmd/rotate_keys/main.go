@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"regulation/internal/config"
+	"regulation/internal/ent"
+	entitem "regulation/internal/ent/item"
+	_ "regulation/internal/ent/runtime"
+	"regulation/internal/envelope"
+)
+
+// batchSize is the number of items re-encrypted per query
+const batchSize = 100
+
+// Re-encrypts Plaid access tokens with the active key-encryption key.
+//
+// To rotate keys, add a new key to encryption.keys, make it encryption.active_key_id,
+// run this command, then remove the old key from the config.
+// Legacy plaintext tokens are encrypted as well.
+func main() {
+	all := flag.Bool("all", false, "re-encrypt every token with a fresh data key, not only those sealed with retired keys")
+	dryRun := flag.Bool("dry-run", false, "count the tokens that would be re-encrypted without changing them")
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Reads config.json from CONFIG_DIR, like the server
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	kms, err := cfg.Encryption.KMS()
+	if err != nil {
+		log.Fatalf("Failed to load encryption keys: %v", err)
+	}
+	cipher := envelope.New(kms)
+	envelope.SetDefault(cipher)
+
+	db, err := openDB(ctx, cfg)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	fmt.Printf("🔑 Active key: %s\n", cipher.ActiveKeyID())
+
+	rotated, err := rotate(ctx, db, cipher.ActiveKeyID(), *all, *dryRun)
+	if err != nil {
+		log.Fatalf("Rotation stopped after %d tokens: %v", rotated, err)
+	}
+
+	if *dryRun {
+		fmt.Printf("📋 %d tokens would be re-encrypted\n", rotated)
+		return
+	}
+	fmt.Printf("✅ Re-encrypted %d tokens\n", rotated)
+}
+
+// rotate re-encrypts the access tokens of items not sealed with activeKeyID, or of all items
+// Returns the number of tokens re-encrypted
+func rotate(ctx context.Context, db *ent.Client, activeKeyID string, all, dryRun bool) (int, error) {
+	rotated := 0
+	lastID := uuid.Nil
+
+	for {
+		query := db.Item.
+			Query().
+			Where(entitem.IDGT(lastID)).
+			Order(ent.Asc(entitem.FieldID)).
+			Limit(batchSize)
+		if !all {
+			query.Where(entitem.AccessTokenKeyIDNEQ(activeKeyID))
+		}
+
+		// Loading decrypts each token with the key it was sealed with
+		items, err := query.All(ctx)
+		if err != nil {
+			return rotated, fmt.Errorf("failed to query items: %w", err)
+		}
+		if len(items) == 0 {
+			return rotated, nil
+		}
+
+		for _, item := range items {
+			lastID = item.ID
+
+			if !dryRun {
+				// Saving seals the token under a fresh data key wrapped with the active key
+				err := db.Item.
+					UpdateOne(item).
+					SetAccessToken(item.AccessToken).
+					Exec(ctx)
+				if err != nil {
+					return rotated, fmt.Errorf("failed to re-encrypt item %s: %w", item.ID, err)
+				}
+			}
+
+			rotated++
+		}
+
+		fmt.Printf("   ... %d tokens\n", rotated)
+	}
+}
+
+// openDB connects to the database without the query cache the server uses
+func openDB(ctx context.Context, cfg *config.Config) (*ent.Client, error) {
+	pool, err := pgxpool.New(ctx, cfg.DB.URI())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pgx pool: %w", err)
+	}
+
+	client := stdlib.OpenDBFromPool(pool)
+	if err = client.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return ent.NewClient(ent.Driver(entsql.OpenDB("postgres", client))), nil
+}
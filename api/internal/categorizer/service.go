@@ -105,10 +105,10 @@ func NewService(apiKey string, opts ...Option) *Service {
 
 // CategorizationRequest contains transaction data for categorization
 type CategorizationRequest struct {
-	MerchantName    string   `json:"merchant_name"`
-	TransactionName string   `json:"transaction_name"`
-	Amount          float64  `json:"amount"`
-	PlaidCategories []string `json:"plaid_categories,omitempty"`
+	MerchantName       string   `json:"merchant_name"`
+	TransactionName    string   `json:"transaction_name"`
+	Amount             float64  `json:"amount"`
+	ProviderCategories []string `json:"provider_categories,omitempty"`
 }
 
 // CategorizationResponse contains the categorization result
@@ -210,8 +210,8 @@ func buildUserPrompt(req *CategorizationRequest) string {
 
 	sb.WriteString(fmt.Sprintf("Amount: $%.2f\n", req.Amount))
 
-	if len(req.PlaidCategories) > 0 {
-		sb.WriteString(fmt.Sprintf("Plaid Categories (hints): %s\n", strings.Join(req.PlaidCategories, " > ")))
+	if len(req.ProviderCategories) > 0 {
+		sb.WriteString(fmt.Sprintf("Bank Categories (hints): %s\n", strings.Join(req.ProviderCategories, " > ")))
 	}
 
 	sb.WriteString("\nReturn the category, confidence level, and your reasoning.")
@@ -28,6 +28,10 @@ type Config struct {
 	OpenAI  *OpenAI      `json:"openai"`
 	WebPush *WebPush     `json:"webpush,omitempty"`
 	CORS    *CORS        `json:"cors"`
+	// Encryption holds the keys sealing secrets stored in the database
+	Encryption *Encryption `json:"encryption"`
+	// Currency configures exchange rates for reporting totals in a user's home currency
+	Currency *Currency `json:"currency"`
 
 	Debug bool `json:"debug"`
 
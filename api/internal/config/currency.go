@@ -0,0 +1,36 @@
+package config
+
+import (
+	"errors"
+
+	"regulation/internal/currency"
+)
+
+// Currency configures how amounts in different currencies are reported together
+type Currency struct {
+	// RatesFile is a JSON file of exchange rates, see currency.LoadStaticRates
+	// Without it only amounts already in the reporting currency can be combined
+	RatesFile string `json:"rates_file,omitempty"`
+}
+
+// Validate ensures Currency has valid values
+func (c *Currency) Validate() error {
+	if c == nil {
+		return errors.New("currency config is nil")
+	}
+
+	if c.RatesFile == "" {
+		return nil
+	}
+
+	_, err := currency.LoadStaticRates(c.RatesFile)
+	return err
+}
+
+// RateProvider returns the exchange rates to report totals with
+func (c *Currency) RateProvider() (currency.RateProvider, error) {
+	if c.RatesFile == "" {
+		return currency.NewStaticRates(currency.USD, nil), nil
+	}
+	return currency.LoadStaticRates(c.RatesFile)
+}
@@ -0,0 +1,62 @@
+package config
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"regulation/internal/envelope"
+)
+
+// Encryption holds the key-encryption keys protecting secrets such as Plaid access tokens at rest
+type Encryption struct {
+	// Keys maps key IDs to base64-encoded 32-byte key-encryption keys
+	// Retired keys must stay until rotate_keys has re-encrypted everything sealed with them
+	Keys map[string]string `json:"keys"`
+	// ActiveKeyID selects the key new values are encrypted with
+	ActiveKeyID string `json:"active_key_id"`
+}
+
+// Validate ensures Encryption has valid values
+func (e *Encryption) Validate() error {
+	if e == nil {
+		return errors.New("encryption config is nil")
+	}
+
+	_, err := e.decodeKeys()
+	return err
+}
+
+// KMS returns a local KMS holding the configured keys
+func (e *Encryption) KMS() (*envelope.LocalKMS, error) {
+	keys, err := e.decodeKeys()
+	if err != nil {
+		return nil, err
+	}
+	return envelope.NewLocalKMS(keys, e.ActiveKeyID)
+}
+
+// decodeKeys decodes and checks the configured keys
+func (e *Encryption) decodeKeys() (map[string][]byte, error) {
+	if e.ActiveKeyID == "" {
+		return nil, errors.New("encryption active_key_id is required")
+	}
+
+	if _, ok := e.Keys[e.ActiveKeyID]; !ok {
+		return nil, fmt.Errorf("encryption active key %q is not in keys", e.ActiveKeyID)
+	}
+
+	keys := make(map[string][]byte, len(e.Keys))
+	for id, encoded := range e.Keys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %q is not valid base64: %w", id, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("encryption key %q must be 32 bytes, got %d", id, len(key))
+		}
+		keys[id] = key
+	}
+
+	return keys, nil
+}
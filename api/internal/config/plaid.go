@@ -13,6 +13,10 @@ type PlaidConfig struct {
 	Secret      string `json:"secret"`
 	Environment string `json:"environment"` // sandbox, development, production
 	UseMock     bool   `json:"use_mock"`
+	// WebhookURL is where Plaid delivers item webhooks; polling slows down when set
+	WebhookURL string `json:"webhook_url,omitempty"`
+	// BaseURL replaces the environment's API host, e.g. to point at the local sandbox server
+	BaseURL string `json:"base_url,omitempty"`
 }
 
 // Validate ensures PlaidConfig has valid values
@@ -88,6 +92,28 @@ func (p *PlaidConfig) GetEnvironment() string {
 	return p.Environment
 }
 
+// GetWebhookURL returns the webhook URL with environment variable override
+func (p *PlaidConfig) GetWebhookURL() string {
+	if envURL := os.Getenv("PLAID_WEBHOOK_URL"); envURL != "" {
+		return envURL
+	}
+	if p == nil {
+		return ""
+	}
+	return p.WebhookURL
+}
+
+// GetBaseURL returns the API base URL override with environment variable override
+func (p *PlaidConfig) GetBaseURL() string {
+	if envURL := os.Getenv("PLAID_BASE_URL"); envURL != "" {
+		return envURL
+	}
+	if p == nil {
+		return ""
+	}
+	return p.BaseURL
+}
+
 // UseMockClient reports whether the Plaid mock client should be used.
 func (p *PlaidConfig) UseMockClient() bool {
 	if mock, ok := parseBoolEnv("PLAID_USE_MOCK"); ok {
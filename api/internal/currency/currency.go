@@ -0,0 +1,62 @@
+// Package currency formats amounts per ISO 4217 currency and converts them between currencies.
+// Amounts are stored in hundredths of the currency unit ("cents") regardless of the currency.
+package currency
+
+import (
+	"fmt"
+	"strings"
+)
+
+// USD is the currency amounts are assumed to be in when none is known
+const USD = "USD"
+
+// symbols holds the display symbol of common currencies
+var symbols = map[string]string{
+	"USD": "$",
+	"CAD": "CA$",
+	"AUD": "A$",
+	"EUR": "€",
+	"GBP": "£",
+	"JPY": "¥",
+	"KRW": "₩",
+	"INR": "₹",
+}
+
+// zeroDecimal holds currencies that have no minor unit
+var zeroDecimal = map[string]bool{
+	"JPY": true,
+	"KRW": true,
+}
+
+// Normalize upper-cases a currency code, defaulting to USD when empty
+func Normalize(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return USD
+	}
+	return code
+}
+
+// Format renders an amount in cents of the given currency, such as $12.34, €5.00 or ¥1200
+// Currencies without a known symbol are suffixed with their code, such as 12.34 CHF
+func Format(cents int64, code string) string {
+	code = Normalize(code)
+
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	var number string
+	if zeroDecimal[code] {
+		number = fmt.Sprintf("%d", (cents+50)/100)
+	} else {
+		number = fmt.Sprintf("%d.%02d", cents/100, cents%100)
+	}
+
+	if symbol, ok := symbols[code]; ok {
+		return sign + symbol + number
+	}
+	return sign + number + " " + code
+}
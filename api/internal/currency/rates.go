@@ -0,0 +1,101 @@
+package currency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+)
+
+// ErrNoRate is returned when no exchange rate is known between two currencies
+var ErrNoRate = errors.New("no exchange rate")
+
+// RateProvider supplies exchange rates used to report totals in a single currency
+type RateProvider interface {
+	// Rate returns how many units of to one unit of from is worth
+	Rate(ctx context.Context, from, to string) (float64, error)
+}
+
+// StaticRates is a RateProvider backed by a fixed table of rates against a base currency
+type StaticRates struct {
+	base  string
+	rates map[string]float64
+}
+
+// staticRatesFile is the on-disk format read by LoadStaticRates
+type staticRatesFile struct {
+	// Base is the currency every rate is quoted against
+	Base string `json:"base"`
+	// Rates maps currency codes to units per one unit of Base
+	Rates map[string]float64 `json:"rates"`
+}
+
+// NewStaticRates creates a provider from rates quoted as units per one unit of base
+func NewStaticRates(base string, rates map[string]float64) *StaticRates {
+	s := &StaticRates{
+		base:  Normalize(base),
+		rates: make(map[string]float64, len(rates)+1),
+	}
+	for code, rate := range rates {
+		s.rates[Normalize(code)] = rate
+	}
+	s.rates[s.base] = 1
+
+	return s
+}
+
+// LoadStaticRates reads a JSON rates file of the form {"base": "USD", "rates": {"EUR": 0.92}}
+func LoadStaticRates(path string) (*StaticRates, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rates file: %w", err)
+	}
+
+	var file staticRatesFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse rates file: %w", err)
+	}
+
+	for code, rate := range file.Rates {
+		if rate <= 0 {
+			return nil, fmt.Errorf("rate for %s must be positive", code)
+		}
+	}
+
+	return NewStaticRates(file.Base, file.Rates), nil
+}
+
+// Rate returns how many units of to one unit of from is worth
+func (s *StaticRates) Rate(_ context.Context, from, to string) (float64, error) {
+	from, to = Normalize(from), Normalize(to)
+	if from == to {
+		return 1, nil
+	}
+
+	fromRate, ok := s.rates[from]
+	if !ok {
+		return 0, fmt.Errorf("%w from %s", ErrNoRate, from)
+	}
+	toRate, ok := s.rates[to]
+	if !ok {
+		return 0, fmt.Errorf("%w to %s", ErrNoRate, to)
+	}
+
+	return toRate / fromRate, nil
+}
+
+// Convert converts an amount in cents between currencies, rounding to the nearest cent
+func Convert(ctx context.Context, provider RateProvider, cents int64, from, to string) (int64, error) {
+	if Normalize(from) == Normalize(to) {
+		return cents, nil
+	}
+
+	rate, err := provider.Rate(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(math.Round(float64(cents) * rate)), nil
+}
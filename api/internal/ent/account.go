@@ -20,17 +20,17 @@ type Account struct {
 	config `json:"-"`
 	// ID of the ent.
 	ID uuid.UUID `json:"id,omitempty"`
-	// FK to Item
-	ItemID uuid.UUID `json:"item_id,omitempty"`
+	// FK to Item, nil for manual accounts filled by statement imports
+	ItemID *uuid.UUID `json:"item_id,omitempty"`
 	// FK to User for quick queries
 	UserID uuid.UUID `json:"user_id,omitempty"`
-	// Plaid's unique identifier for this account
-	PlaidID string `json:"plaid_id,omitempty"`
+	// Aggregator's identifier for this account, unique per item; manual-<uuid> for manual accounts
+	ExternalID string `json:"external_id,omitempty"`
 	// User-friendly account name from bank
 	Name string `json:"name,omitempty"`
 	// Type of account
 	Type account.Type `json:"type,omitempty"`
-	// Detailed account subtype from Plaid
+	// Detailed account subtype from the aggregator
 	Subtype string `json:"subtype,omitempty"`
 	// Last 4 digits of account number
 	Mask string `json:"mask,omitempty"`
@@ -38,8 +38,18 @@ type Account struct {
 	CurrentBalance int64 `json:"current_balance,omitempty"`
 	// Available balance in cents (may be nil)
 	AvailableBalance *int64 `json:"available_balance,omitempty"`
+	// ISO 4217 currency of the balances
+	Currency string `json:"currency,omitempty"`
+	// Credit limit in cents for credit accounts, if the institution reports one
+	CreditLimit *int64 `json:"credit_limit,omitempty"`
+	// Checking account savings are taken from when rules fire on this credit account's purchases
+	FundingAccountID *uuid.UUID `json:"funding_account_id,omitempty"`
 	// Whether this account is still active
 	IsActive bool `json:"is_active,omitempty"`
+	// When the account stopped appearing at the institution, reactivated if it reappears
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// Day of the statement balance last applied to a manual account, so older statements don't overwrite it
+	BalanceDate *time.Time `json:"balance_date,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -64,9 +74,17 @@ type AccountEdges struct {
 	OutgoingTransfers []*SavingsTransfer `json:"outgoing_transfers,omitempty"`
 	// IncomingTransfers holds the value of the incoming_transfers edge.
 	IncomingTransfers []*SavingsTransfer `json:"incoming_transfers,omitempty"`
+	// SavingsFamilies holds the value of the savings_families edge.
+	SavingsFamilies []*Family `json:"savings_families,omitempty"`
+	// BalanceSnapshots holds the value of the balance_snapshots edge.
+	BalanceSnapshots []*BalanceSnapshot `json:"balance_snapshots,omitempty"`
+	// FundingAccount holds the value of the funding_account edge.
+	FundingAccount *Account `json:"funding_account,omitempty"`
+	// FundedAccounts holds the value of the funded_accounts edge.
+	FundedAccounts []*Account `json:"funded_accounts,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [6]bool
+	loadedTypes [10]bool
 }
 
 // ItemOrErr returns the Item value or an error if the edge
@@ -127,20 +145,60 @@ func (e AccountEdges) IncomingTransfersOrErr() ([]*SavingsTransfer, error) {
 	return nil, &NotLoadedError{edge: "incoming_transfers"}
 }
 
+// SavingsFamiliesOrErr returns the SavingsFamilies value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) SavingsFamiliesOrErr() ([]*Family, error) {
+	if e.loadedTypes[6] {
+		return e.SavingsFamilies, nil
+	}
+	return nil, &NotLoadedError{edge: "savings_families"}
+}
+
+// BalanceSnapshotsOrErr returns the BalanceSnapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) BalanceSnapshotsOrErr() ([]*BalanceSnapshot, error) {
+	if e.loadedTypes[7] {
+		return e.BalanceSnapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "balance_snapshots"}
+}
+
+// FundingAccountOrErr returns the FundingAccount value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e AccountEdges) FundingAccountOrErr() (*Account, error) {
+	if e.FundingAccount != nil {
+		return e.FundingAccount, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: account.Label}
+	}
+	return nil, &NotLoadedError{edge: "funding_account"}
+}
+
+// FundedAccountsOrErr returns the FundedAccounts value or an error if the edge
+// was not loaded in eager-loading.
+func (e AccountEdges) FundedAccountsOrErr() ([]*Account, error) {
+	if e.loadedTypes[9] {
+		return e.FundedAccounts, nil
+	}
+	return nil, &NotLoadedError{edge: "funded_accounts"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Account) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case account.FieldItemID, account.FieldFundingAccountID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case account.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case account.FieldCurrentBalance, account.FieldAvailableBalance:
+		case account.FieldCurrentBalance, account.FieldAvailableBalance, account.FieldCreditLimit:
 			values[i] = new(sql.NullInt64)
-		case account.FieldPlaidID, account.FieldName, account.FieldType, account.FieldSubtype, account.FieldMask:
+		case account.FieldExternalID, account.FieldName, account.FieldType, account.FieldSubtype, account.FieldMask, account.FieldCurrency:
 			values[i] = new(sql.NullString)
-		case account.FieldCreatedAt, account.FieldUpdatedAt:
+		case account.FieldClosedAt, account.FieldBalanceDate, account.FieldCreatedAt, account.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case account.FieldID, account.FieldItemID, account.FieldUserID:
+		case account.FieldID, account.FieldUserID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -164,10 +222,11 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 				_m.ID = *value
 			}
 		case account.FieldItemID:
-			if value, ok := values[i].(*uuid.UUID); !ok {
+			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field item_id", values[i])
-			} else if value != nil {
-				_m.ItemID = *value
+			} else if value.Valid {
+				_m.ItemID = new(uuid.UUID)
+				*_m.ItemID = *value.S.(*uuid.UUID)
 			}
 		case account.FieldUserID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
@@ -175,11 +234,11 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.UserID = *value
 			}
-		case account.FieldPlaidID:
+		case account.FieldExternalID:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field plaid_id", values[i])
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
 			} else if value.Valid {
-				_m.PlaidID = value.String
+				_m.ExternalID = value.String
 			}
 		case account.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -218,12 +277,46 @@ func (_m *Account) assignValues(columns []string, values []any) error {
 				_m.AvailableBalance = new(int64)
 				*_m.AvailableBalance = value.Int64
 			}
+		case account.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case account.FieldCreditLimit:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit_limit", values[i])
+			} else if value.Valid {
+				_m.CreditLimit = new(int64)
+				*_m.CreditLimit = value.Int64
+			}
+		case account.FieldFundingAccountID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field funding_account_id", values[i])
+			} else if value.Valid {
+				_m.FundingAccountID = new(uuid.UUID)
+				*_m.FundingAccountID = *value.S.(*uuid.UUID)
+			}
 		case account.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_active", values[i])
 			} else if value.Valid {
 				_m.IsActive = value.Bool
 			}
+		case account.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case account.FieldBalanceDate:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field balance_date", values[i])
+			} else if value.Valid {
+				_m.BalanceDate = new(time.Time)
+				*_m.BalanceDate = value.Time
+			}
 		case account.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -279,6 +372,26 @@ func (_m *Account) QueryIncomingTransfers() *SavingsTransferQuery {
 	return NewAccountClient(_m.config).QueryIncomingTransfers(_m)
 }
 
+// QuerySavingsFamilies queries the "savings_families" edge of the Account entity.
+func (_m *Account) QuerySavingsFamilies() *FamilyQuery {
+	return NewAccountClient(_m.config).QuerySavingsFamilies(_m)
+}
+
+// QueryBalanceSnapshots queries the "balance_snapshots" edge of the Account entity.
+func (_m *Account) QueryBalanceSnapshots() *BalanceSnapshotQuery {
+	return NewAccountClient(_m.config).QueryBalanceSnapshots(_m)
+}
+
+// QueryFundingAccount queries the "funding_account" edge of the Account entity.
+func (_m *Account) QueryFundingAccount() *AccountQuery {
+	return NewAccountClient(_m.config).QueryFundingAccount(_m)
+}
+
+// QueryFundedAccounts queries the "funded_accounts" edge of the Account entity.
+func (_m *Account) QueryFundedAccounts() *AccountQuery {
+	return NewAccountClient(_m.config).QueryFundedAccounts(_m)
+}
+
 // Update returns a builder for updating this Account.
 // Note that you need to call Account.Unwrap() before calling this method if this Account
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -302,14 +415,16 @@ func (_m *Account) String() string {
 	var builder strings.Builder
 	builder.WriteString("Account(")
 	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
-	builder.WriteString("item_id=")
-	builder.WriteString(fmt.Sprintf("%v", _m.ItemID))
+	if v := _m.ItemID; v != nil {
+		builder.WriteString("item_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
 	builder.WriteString(", ")
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
-	builder.WriteString("plaid_id=")
-	builder.WriteString(_m.PlaidID)
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
 	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
@@ -331,9 +446,32 @@ func (_m *Account) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	if v := _m.CreditLimit; v != nil {
+		builder.WriteString("credit_limit=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FundingAccountID; v != nil {
+		builder.WriteString("funding_account_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.BalanceDate; v != nil {
+		builder.WriteString("balance_date=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -20,8 +20,8 @@ const (
 	FieldItemID = "item_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
-	// FieldPlaidID holds the string denoting the plaid_id field in the database.
-	FieldPlaidID = "plaid_id"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldType holds the string denoting the type field in the database.
@@ -34,8 +34,18 @@ const (
 	FieldCurrentBalance = "current_balance"
 	// FieldAvailableBalance holds the string denoting the available_balance field in the database.
 	FieldAvailableBalance = "available_balance"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldCreditLimit holds the string denoting the credit_limit field in the database.
+	FieldCreditLimit = "credit_limit"
+	// FieldFundingAccountID holds the string denoting the funding_account_id field in the database.
+	FieldFundingAccountID = "funding_account_id"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// FieldBalanceDate holds the string denoting the balance_date field in the database.
+	FieldBalanceDate = "balance_date"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -52,6 +62,14 @@ const (
 	EdgeOutgoingTransfers = "outgoing_transfers"
 	// EdgeIncomingTransfers holds the string denoting the incoming_transfers edge name in mutations.
 	EdgeIncomingTransfers = "incoming_transfers"
+	// EdgeSavingsFamilies holds the string denoting the savings_families edge name in mutations.
+	EdgeSavingsFamilies = "savings_families"
+	// EdgeBalanceSnapshots holds the string denoting the balance_snapshots edge name in mutations.
+	EdgeBalanceSnapshots = "balance_snapshots"
+	// EdgeFundingAccount holds the string denoting the funding_account edge name in mutations.
+	EdgeFundingAccount = "funding_account"
+	// EdgeFundedAccounts holds the string denoting the funded_accounts edge name in mutations.
+	EdgeFundedAccounts = "funded_accounts"
 	// Table holds the table name of the account in the database.
 	Table = "accounts"
 	// ItemTable is the table that holds the item relation/edge.
@@ -96,6 +114,28 @@ const (
 	IncomingTransfersInverseTable = "savings_transfers"
 	// IncomingTransfersColumn is the table column denoting the incoming_transfers relation/edge.
 	IncomingTransfersColumn = "target_account_id"
+	// SavingsFamiliesTable is the table that holds the savings_families relation/edge.
+	SavingsFamiliesTable = "families"
+	// SavingsFamiliesInverseTable is the table name for the Family entity.
+	// It exists in this package in order to avoid circular dependency with the "family" package.
+	SavingsFamiliesInverseTable = "families"
+	// SavingsFamiliesColumn is the table column denoting the savings_families relation/edge.
+	SavingsFamiliesColumn = "savings_account_id"
+	// BalanceSnapshotsTable is the table that holds the balance_snapshots relation/edge.
+	BalanceSnapshotsTable = "balance_snapshots"
+	// BalanceSnapshotsInverseTable is the table name for the BalanceSnapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "balancesnapshot" package.
+	BalanceSnapshotsInverseTable = "balance_snapshots"
+	// BalanceSnapshotsColumn is the table column denoting the balance_snapshots relation/edge.
+	BalanceSnapshotsColumn = "account_id"
+	// FundingAccountTable is the table that holds the funding_account relation/edge.
+	FundingAccountTable = "accounts"
+	// FundingAccountColumn is the table column denoting the funding_account relation/edge.
+	FundingAccountColumn = "funding_account_id"
+	// FundedAccountsTable is the table that holds the funded_accounts relation/edge.
+	FundedAccountsTable = "accounts"
+	// FundedAccountsColumn is the table column denoting the funded_accounts relation/edge.
+	FundedAccountsColumn = "funding_account_id"
 )
 
 // Columns holds all SQL columns for account fields.
@@ -103,14 +143,19 @@ var Columns = []string{
 	FieldID,
 	FieldItemID,
 	FieldUserID,
-	FieldPlaidID,
+	FieldExternalID,
 	FieldName,
 	FieldType,
 	FieldSubtype,
 	FieldMask,
 	FieldCurrentBalance,
 	FieldAvailableBalance,
+	FieldCurrency,
+	FieldCreditLimit,
+	FieldFundingAccountID,
 	FieldIsActive,
+	FieldClosedAt,
+	FieldBalanceDate,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -126,12 +171,14 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// PlaidIDValidator is a validator for the "plaid_id" field. It is called by the builders before save.
-	PlaidIDValidator func(string) error
+	// ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	ExternalIDValidator func(string) error
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DefaultCurrentBalance holds the default value on creation for the "current_balance" field.
 	DefaultCurrentBalance int64
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -190,9 +237,9 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
-// ByPlaidID orders the results by the plaid_id field.
-func ByPlaidID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldPlaidID, opts...).ToFunc()
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
 }
 
 // ByName orders the results by the name field.
@@ -225,11 +272,36 @@ func ByAvailableBalance(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvailableBalance, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByCreditLimit orders the results by the credit_limit field.
+func ByCreditLimit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreditLimit, opts...).ToFunc()
+}
+
+// ByFundingAccountID orders the results by the funding_account_id field.
+func ByFundingAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFundingAccountID, opts...).ToFunc()
+}
+
 // ByIsActive orders the results by the is_active field.
 func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByBalanceDate orders the results by the balance_date field.
+func ByBalanceDate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBalanceDate, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -309,6 +381,55 @@ func ByIncomingTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newIncomingTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySavingsFamiliesCount orders the results by savings_families count.
+func BySavingsFamiliesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSavingsFamiliesStep(), opts...)
+	}
+}
+
+// BySavingsFamilies orders the results by savings_families terms.
+func BySavingsFamilies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSavingsFamiliesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBalanceSnapshotsCount orders the results by balance_snapshots count.
+func ByBalanceSnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBalanceSnapshotsStep(), opts...)
+	}
+}
+
+// ByBalanceSnapshots orders the results by balance_snapshots terms.
+func ByBalanceSnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBalanceSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByFundingAccountField orders the results by funding_account field.
+func ByFundingAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFundingAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFundedAccountsCount orders the results by funded_accounts count.
+func ByFundedAccountsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFundedAccountsStep(), opts...)
+	}
+}
+
+// ByFundedAccounts orders the results by funded_accounts terms.
+func ByFundedAccounts(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFundedAccountsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newItemStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -351,3 +472,31 @@ func newIncomingTransfersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, IncomingTransfersTable, IncomingTransfersColumn),
 	)
 }
+func newSavingsFamiliesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SavingsFamiliesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SavingsFamiliesTable, SavingsFamiliesColumn),
+	)
+}
+func newBalanceSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BalanceSnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+	)
+}
+func newFundingAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FundingAccountTable, FundingAccountColumn),
+	)
+}
+func newFundedAccountsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FundedAccountsTable, FundedAccountsColumn),
+	)
+}
@@ -66,9 +66,9 @@ func UserID(v uuid.UUID) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldUserID, v))
 }
 
-// PlaidID applies equality check predicate on the "plaid_id" field. It's identical to PlaidIDEQ.
-func PlaidID(v string) predicate.Account {
-	return predicate.Account(sql.FieldEQ(FieldPlaidID, v))
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldExternalID, v))
 }
 
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
@@ -96,11 +96,36 @@ func AvailableBalance(v int64) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldAvailableBalance, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CreditLimit applies equality check predicate on the "credit_limit" field. It's identical to CreditLimitEQ.
+func CreditLimit(v int64) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// FundingAccountID applies equality check predicate on the "funding_account_id" field. It's identical to FundingAccountIDEQ.
+func FundingAccountID(v uuid.UUID) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldFundingAccountID, v))
+}
+
 // IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
 func IsActive(v bool) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldIsActive, v))
 }
 
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// BalanceDate applies equality check predicate on the "balance_date" field. It's identical to BalanceDateEQ.
+func BalanceDate(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldBalanceDate, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
@@ -131,6 +156,16 @@ func ItemIDNotIn(vs ...uuid.UUID) predicate.Account {
 	return predicate.Account(sql.FieldNotIn(FieldItemID, vs...))
 }
 
+// ItemIDIsNil applies the IsNil predicate on the "item_id" field.
+func ItemIDIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldItemID))
+}
+
+// ItemIDNotNil applies the NotNil predicate on the "item_id" field.
+func ItemIDNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldItemID))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v uuid.UUID) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldUserID, v))
@@ -151,69 +186,69 @@ func UserIDNotIn(vs ...uuid.UUID) predicate.Account {
 	return predicate.Account(sql.FieldNotIn(FieldUserID, vs...))
 }
 
-// PlaidIDEQ applies the EQ predicate on the "plaid_id" field.
-func PlaidIDEQ(v string) predicate.Account {
-	return predicate.Account(sql.FieldEQ(FieldPlaidID, v))
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldExternalID, v))
 }
 
-// PlaidIDNEQ applies the NEQ predicate on the "plaid_id" field.
-func PlaidIDNEQ(v string) predicate.Account {
-	return predicate.Account(sql.FieldNEQ(FieldPlaidID, v))
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldExternalID, v))
 }
 
-// PlaidIDIn applies the In predicate on the "plaid_id" field.
-func PlaidIDIn(vs ...string) predicate.Account {
-	return predicate.Account(sql.FieldIn(FieldPlaidID, vs...))
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldExternalID, vs...))
 }
 
-// PlaidIDNotIn applies the NotIn predicate on the "plaid_id" field.
-func PlaidIDNotIn(vs ...string) predicate.Account {
-	return predicate.Account(sql.FieldNotIn(FieldPlaidID, vs...))
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldExternalID, vs...))
 }
 
-// PlaidIDGT applies the GT predicate on the "plaid_id" field.
-func PlaidIDGT(v string) predicate.Account {
-	return predicate.Account(sql.FieldGT(FieldPlaidID, v))
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldExternalID, v))
 }
 
-// PlaidIDGTE applies the GTE predicate on the "plaid_id" field.
-func PlaidIDGTE(v string) predicate.Account {
-	return predicate.Account(sql.FieldGTE(FieldPlaidID, v))
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldExternalID, v))
 }
 
-// PlaidIDLT applies the LT predicate on the "plaid_id" field.
-func PlaidIDLT(v string) predicate.Account {
-	return predicate.Account(sql.FieldLT(FieldPlaidID, v))
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldExternalID, v))
 }
 
-// PlaidIDLTE applies the LTE predicate on the "plaid_id" field.
-func PlaidIDLTE(v string) predicate.Account {
-	return predicate.Account(sql.FieldLTE(FieldPlaidID, v))
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldExternalID, v))
 }
 
-// PlaidIDContains applies the Contains predicate on the "plaid_id" field.
-func PlaidIDContains(v string) predicate.Account {
-	return predicate.Account(sql.FieldContains(FieldPlaidID, v))
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldExternalID, v))
 }
 
-// PlaidIDHasPrefix applies the HasPrefix predicate on the "plaid_id" field.
-func PlaidIDHasPrefix(v string) predicate.Account {
-	return predicate.Account(sql.FieldHasPrefix(FieldPlaidID, v))
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldExternalID, v))
 }
 
-// PlaidIDHasSuffix applies the HasSuffix predicate on the "plaid_id" field.
-func PlaidIDHasSuffix(v string) predicate.Account {
-	return predicate.Account(sql.FieldHasSuffix(FieldPlaidID, v))
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldExternalID, v))
 }
 
-// PlaidIDEqualFold applies the EqualFold predicate on the "plaid_id" field.
-func PlaidIDEqualFold(v string) predicate.Account {
-	return predicate.Account(sql.FieldEqualFold(FieldPlaidID, v))
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldExternalID, v))
 }
 
-// PlaidIDContainsFold applies the ContainsFold predicate on the "plaid_id" field.
-func PlaidIDContainsFold(v string) predicate.Account {
-	return predicate.Account(sql.FieldContainsFold(FieldPlaidID, v))
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldExternalID, v))
 }
 
 // NameEQ applies the EQ predicate on the "name" field.
@@ -541,6 +576,151 @@ func AvailableBalanceNotNil() predicate.Account {
 	return predicate.Account(sql.FieldNotNull(FieldAvailableBalance))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Account {
+	return predicate.Account(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Account {
+	return predicate.Account(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Account {
+	return predicate.Account(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// CreditLimitEQ applies the EQ predicate on the "credit_limit" field.
+func CreditLimitEQ(v int64) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitNEQ applies the NEQ predicate on the "credit_limit" field.
+func CreditLimitNEQ(v int64) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldCreditLimit, v))
+}
+
+// CreditLimitIn applies the In predicate on the "credit_limit" field.
+func CreditLimitIn(vs ...int64) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitNotIn applies the NotIn predicate on the "credit_limit" field.
+func CreditLimitNotIn(vs ...int64) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldCreditLimit, vs...))
+}
+
+// CreditLimitGT applies the GT predicate on the "credit_limit" field.
+func CreditLimitGT(v int64) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldCreditLimit, v))
+}
+
+// CreditLimitGTE applies the GTE predicate on the "credit_limit" field.
+func CreditLimitGTE(v int64) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldCreditLimit, v))
+}
+
+// CreditLimitLT applies the LT predicate on the "credit_limit" field.
+func CreditLimitLT(v int64) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldCreditLimit, v))
+}
+
+// CreditLimitLTE applies the LTE predicate on the "credit_limit" field.
+func CreditLimitLTE(v int64) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldCreditLimit, v))
+}
+
+// CreditLimitIsNil applies the IsNil predicate on the "credit_limit" field.
+func CreditLimitIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldCreditLimit))
+}
+
+// CreditLimitNotNil applies the NotNil predicate on the "credit_limit" field.
+func CreditLimitNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldCreditLimit))
+}
+
+// FundingAccountIDEQ applies the EQ predicate on the "funding_account_id" field.
+func FundingAccountIDEQ(v uuid.UUID) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldFundingAccountID, v))
+}
+
+// FundingAccountIDNEQ applies the NEQ predicate on the "funding_account_id" field.
+func FundingAccountIDNEQ(v uuid.UUID) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldFundingAccountID, v))
+}
+
+// FundingAccountIDIn applies the In predicate on the "funding_account_id" field.
+func FundingAccountIDIn(vs ...uuid.UUID) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldFundingAccountID, vs...))
+}
+
+// FundingAccountIDNotIn applies the NotIn predicate on the "funding_account_id" field.
+func FundingAccountIDNotIn(vs ...uuid.UUID) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldFundingAccountID, vs...))
+}
+
+// FundingAccountIDIsNil applies the IsNil predicate on the "funding_account_id" field.
+func FundingAccountIDIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldFundingAccountID))
+}
+
+// FundingAccountIDNotNil applies the NotNil predicate on the "funding_account_id" field.
+func FundingAccountIDNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldFundingAccountID))
+}
+
 // IsActiveEQ applies the EQ predicate on the "is_active" field.
 func IsActiveEQ(v bool) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldIsActive, v))
@@ -551,6 +731,106 @@ func IsActiveNEQ(v bool) predicate.Account {
 	return predicate.Account(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldClosedAt))
+}
+
+// BalanceDateEQ applies the EQ predicate on the "balance_date" field.
+func BalanceDateEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldEQ(FieldBalanceDate, v))
+}
+
+// BalanceDateNEQ applies the NEQ predicate on the "balance_date" field.
+func BalanceDateNEQ(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNEQ(FieldBalanceDate, v))
+}
+
+// BalanceDateIn applies the In predicate on the "balance_date" field.
+func BalanceDateIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldIn(FieldBalanceDate, vs...))
+}
+
+// BalanceDateNotIn applies the NotIn predicate on the "balance_date" field.
+func BalanceDateNotIn(vs ...time.Time) predicate.Account {
+	return predicate.Account(sql.FieldNotIn(FieldBalanceDate, vs...))
+}
+
+// BalanceDateGT applies the GT predicate on the "balance_date" field.
+func BalanceDateGT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGT(FieldBalanceDate, v))
+}
+
+// BalanceDateGTE applies the GTE predicate on the "balance_date" field.
+func BalanceDateGTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldGTE(FieldBalanceDate, v))
+}
+
+// BalanceDateLT applies the LT predicate on the "balance_date" field.
+func BalanceDateLT(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLT(FieldBalanceDate, v))
+}
+
+// BalanceDateLTE applies the LTE predicate on the "balance_date" field.
+func BalanceDateLTE(v time.Time) predicate.Account {
+	return predicate.Account(sql.FieldLTE(FieldBalanceDate, v))
+}
+
+// BalanceDateIsNil applies the IsNil predicate on the "balance_date" field.
+func BalanceDateIsNil() predicate.Account {
+	return predicate.Account(sql.FieldIsNull(FieldBalanceDate))
+}
+
+// BalanceDateNotNil applies the NotNil predicate on the "balance_date" field.
+func BalanceDateNotNil() predicate.Account {
+	return predicate.Account(sql.FieldNotNull(FieldBalanceDate))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Account {
 	return predicate.Account(sql.FieldEQ(FieldCreatedAt, v))
@@ -769,6 +1049,98 @@ func HasIncomingTransfersWith(preds ...predicate.SavingsTransfer) predicate.Acco
 	})
 }
 
+// HasSavingsFamilies applies the HasEdge predicate on the "savings_families" edge.
+func HasSavingsFamilies() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SavingsFamiliesTable, SavingsFamiliesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSavingsFamiliesWith applies the HasEdge predicate on the "savings_families" edge with a given conditions (other predicates).
+func HasSavingsFamiliesWith(preds ...predicate.Family) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newSavingsFamiliesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBalanceSnapshots applies the HasEdge predicate on the "balance_snapshots" edge.
+func HasBalanceSnapshots() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BalanceSnapshotsTable, BalanceSnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBalanceSnapshotsWith applies the HasEdge predicate on the "balance_snapshots" edge with a given conditions (other predicates).
+func HasBalanceSnapshotsWith(preds ...predicate.BalanceSnapshot) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newBalanceSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFundingAccount applies the HasEdge predicate on the "funding_account" edge.
+func HasFundingAccount() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FundingAccountTable, FundingAccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFundingAccountWith applies the HasEdge predicate on the "funding_account" edge with a given conditions (other predicates).
+func HasFundingAccountWith(preds ...predicate.Account) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newFundingAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFundedAccounts applies the HasEdge predicate on the "funded_accounts" edge.
+func HasFundedAccounts() predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FundedAccountsTable, FundedAccountsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFundedAccountsWith applies the HasEdge predicate on the "funded_accounts" edge with a given conditions (other predicates).
+func HasFundedAccountsWith(preds ...predicate.Account) predicate.Account {
+	return predicate.Account(func(s *sql.Selector) {
+		step := newFundedAccountsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Account) predicate.Account {
 	return predicate.Account(sql.AndPredicates(predicates...))
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/balancesnapshot"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/item"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/savingstransfer"
@@ -35,15 +37,23 @@ func (_c *AccountCreate) SetItemID(v uuid.UUID) *AccountCreate {
 	return _c
 }
 
+// SetNillableItemID sets the "item_id" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableItemID(v *uuid.UUID) *AccountCreate {
+	if v != nil {
+		_c.SetItemID(*v)
+	}
+	return _c
+}
+
 // SetUserID sets the "user_id" field.
 func (_c *AccountCreate) SetUserID(v uuid.UUID) *AccountCreate {
 	_c.mutation.SetUserID(v)
 	return _c
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_c *AccountCreate) SetPlaidID(v string) *AccountCreate {
-	_c.mutation.SetPlaidID(v)
+// SetExternalID sets the "external_id" field.
+func (_c *AccountCreate) SetExternalID(v string) *AccountCreate {
+	_c.mutation.SetExternalID(v)
 	return _c
 }
 
@@ -123,6 +133,48 @@ func (_c *AccountCreate) SetNillableAvailableBalance(v *int64) *AccountCreate {
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *AccountCreate) SetCurrency(v string) *AccountCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableCurrency(v *string) *AccountCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_c *AccountCreate) SetCreditLimit(v int64) *AccountCreate {
+	_c.mutation.SetCreditLimit(v)
+	return _c
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableCreditLimit(v *int64) *AccountCreate {
+	if v != nil {
+		_c.SetCreditLimit(*v)
+	}
+	return _c
+}
+
+// SetFundingAccountID sets the "funding_account_id" field.
+func (_c *AccountCreate) SetFundingAccountID(v uuid.UUID) *AccountCreate {
+	_c.mutation.SetFundingAccountID(v)
+	return _c
+}
+
+// SetNillableFundingAccountID sets the "funding_account_id" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableFundingAccountID(v *uuid.UUID) *AccountCreate {
+	if v != nil {
+		_c.SetFundingAccountID(*v)
+	}
+	return _c
+}
+
 // SetIsActive sets the "is_active" field.
 func (_c *AccountCreate) SetIsActive(v bool) *AccountCreate {
 	_c.mutation.SetIsActive(v)
@@ -137,6 +189,34 @@ func (_c *AccountCreate) SetNillableIsActive(v *bool) *AccountCreate {
 	return _c
 }
 
+// SetClosedAt sets the "closed_at" field.
+func (_c *AccountCreate) SetClosedAt(v time.Time) *AccountCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableClosedAt(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetBalanceDate sets the "balance_date" field.
+func (_c *AccountCreate) SetBalanceDate(v time.Time) *AccountCreate {
+	_c.mutation.SetBalanceDate(v)
+	return _c
+}
+
+// SetNillableBalanceDate sets the "balance_date" field if the given value is not nil.
+func (_c *AccountCreate) SetNillableBalanceDate(v *time.Time) *AccountCreate {
+	if v != nil {
+		_c.SetBalanceDate(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *AccountCreate) SetCreatedAt(v time.Time) *AccountCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -249,6 +329,56 @@ func (_c *AccountCreate) AddIncomingTransfers(v ...*SavingsTransfer) *AccountCre
 	return _c.AddIncomingTransferIDs(ids...)
 }
 
+// AddSavingsFamilyIDs adds the "savings_families" edge to the Family entity by IDs.
+func (_c *AccountCreate) AddSavingsFamilyIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddSavingsFamilyIDs(ids...)
+	return _c
+}
+
+// AddSavingsFamilies adds the "savings_families" edges to the Family entity.
+func (_c *AccountCreate) AddSavingsFamilies(v ...*Family) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSavingsFamilyIDs(ids...)
+}
+
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the BalanceSnapshot entity by IDs.
+func (_c *AccountCreate) AddBalanceSnapshotIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddBalanceSnapshotIDs(ids...)
+	return _c
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the BalanceSnapshot entity.
+func (_c *AccountCreate) AddBalanceSnapshots(v ...*BalanceSnapshot) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBalanceSnapshotIDs(ids...)
+}
+
+// SetFundingAccount sets the "funding_account" edge to the Account entity.
+func (_c *AccountCreate) SetFundingAccount(v *Account) *AccountCreate {
+	return _c.SetFundingAccountID(v.ID)
+}
+
+// AddFundedAccountIDs adds the "funded_accounts" edge to the Account entity by IDs.
+func (_c *AccountCreate) AddFundedAccountIDs(ids ...uuid.UUID) *AccountCreate {
+	_c.mutation.AddFundedAccountIDs(ids...)
+	return _c
+}
+
+// AddFundedAccounts adds the "funded_accounts" edges to the Account entity.
+func (_c *AccountCreate) AddFundedAccounts(v ...*Account) *AccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFundedAccountIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_c *AccountCreate) Mutation() *AccountMutation {
 	return _c.mutation
@@ -292,6 +422,10 @@ func (_c *AccountCreate) defaults() {
 		v := account.DefaultCurrentBalance
 		_c.mutation.SetCurrentBalance(v)
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := account.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		v := account.DefaultIsActive
 		_c.mutation.SetIsActive(v)
@@ -312,18 +446,15 @@ func (_c *AccountCreate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_c *AccountCreate) check() error {
-	if _, ok := _c.mutation.ItemID(); !ok {
-		return &ValidationError{Name: "item_id", err: errors.New(`ent: missing required field "Account.item_id"`)}
-	}
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Account.user_id"`)}
 	}
-	if _, ok := _c.mutation.PlaidID(); !ok {
-		return &ValidationError{Name: "plaid_id", err: errors.New(`ent: missing required field "Account.plaid_id"`)}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "Account.external_id"`)}
 	}
-	if v, ok := _c.mutation.PlaidID(); ok {
-		if err := account.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Account.plaid_id": %w`, err)}
+	if v, ok := _c.mutation.ExternalID(); ok {
+		if err := account.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Account.external_id": %w`, err)}
 		}
 	}
 	if _, ok := _c.mutation.Name(); !ok {
@@ -345,6 +476,9 @@ func (_c *AccountCreate) check() error {
 	if _, ok := _c.mutation.CurrentBalance(); !ok {
 		return &ValidationError{Name: "current_balance", err: errors.New(`ent: missing required field "Account.current_balance"`)}
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Account.currency"`)}
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Account.is_active"`)}
 	}
@@ -354,9 +488,6 @@ func (_c *AccountCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Account.updated_at"`)}
 	}
-	if len(_c.mutation.ItemIDs()) == 0 {
-		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "Account.item"`)}
-	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Account.user"`)}
 	}
@@ -396,9 +527,9 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
-	if value, ok := _c.mutation.PlaidID(); ok {
-		_spec.SetField(account.FieldPlaidID, field.TypeString, value)
-		_node.PlaidID = value
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(account.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
 	}
 	if value, ok := _c.mutation.Name(); ok {
 		_spec.SetField(account.FieldName, field.TypeString, value)
@@ -424,10 +555,26 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		_spec.SetField(account.FieldAvailableBalance, field.TypeInt64, value)
 		_node.AvailableBalance = &value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(account.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.CreditLimit(); ok {
+		_spec.SetField(account.FieldCreditLimit, field.TypeInt64, value)
+		_node.CreditLimit = &value
+	}
 	if value, ok := _c.mutation.IsActive(); ok {
 		_spec.SetField(account.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(account.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.BalanceDate(); ok {
+		_spec.SetField(account.FieldBalanceDate, field.TypeTime, value)
+		_node.BalanceDate = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(account.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -450,7 +597,7 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.ItemID = nodes[0]
+		_node.ItemID = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
@@ -534,6 +681,71 @@ func (_c *AccountCreate) createSpec() (*Account, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SavingsFamiliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SavingsFamiliesTable,
+			Columns: []string{account.SavingsFamiliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FundingAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.FundingAccountTable,
+			Columns: []string{account.FundingAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FundingAccountID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FundedAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FundedAccountsTable,
+			Columns: []string{account.FundedAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -598,6 +810,12 @@ func (u *AccountUpsert) UpdateItemID() *AccountUpsert {
 	return u
 }
 
+// ClearItemID clears the value of the "item_id" field.
+func (u *AccountUpsert) ClearItemID() *AccountUpsert {
+	u.SetNull(account.FieldItemID)
+	return u
+}
+
 // SetUserID sets the "user_id" field.
 func (u *AccountUpsert) SetUserID(v uuid.UUID) *AccountUpsert {
 	u.Set(account.FieldUserID, v)
@@ -610,15 +828,15 @@ func (u *AccountUpsert) UpdateUserID() *AccountUpsert {
 	return u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *AccountUpsert) SetPlaidID(v string) *AccountUpsert {
-	u.Set(account.FieldPlaidID, v)
+// SetExternalID sets the "external_id" field.
+func (u *AccountUpsert) SetExternalID(v string) *AccountUpsert {
+	u.Set(account.FieldExternalID, v)
 	return u
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *AccountUpsert) UpdatePlaidID() *AccountUpsert {
-	u.SetExcluded(account.FieldPlaidID)
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateExternalID() *AccountUpsert {
+	u.SetExcluded(account.FieldExternalID)
 	return u
 }
 
@@ -724,6 +942,60 @@ func (u *AccountUpsert) ClearAvailableBalance() *AccountUpsert {
 	return u
 }
 
+// SetCurrency sets the "currency" field.
+func (u *AccountUpsert) SetCurrency(v string) *AccountUpsert {
+	u.Set(account.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateCurrency() *AccountUpsert {
+	u.SetExcluded(account.FieldCurrency)
+	return u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *AccountUpsert) SetCreditLimit(v int64) *AccountUpsert {
+	u.Set(account.FieldCreditLimit, v)
+	return u
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateCreditLimit() *AccountUpsert {
+	u.SetExcluded(account.FieldCreditLimit)
+	return u
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *AccountUpsert) AddCreditLimit(v int64) *AccountUpsert {
+	u.Add(account.FieldCreditLimit, v)
+	return u
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *AccountUpsert) ClearCreditLimit() *AccountUpsert {
+	u.SetNull(account.FieldCreditLimit)
+	return u
+}
+
+// SetFundingAccountID sets the "funding_account_id" field.
+func (u *AccountUpsert) SetFundingAccountID(v uuid.UUID) *AccountUpsert {
+	u.Set(account.FieldFundingAccountID, v)
+	return u
+}
+
+// UpdateFundingAccountID sets the "funding_account_id" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateFundingAccountID() *AccountUpsert {
+	u.SetExcluded(account.FieldFundingAccountID)
+	return u
+}
+
+// ClearFundingAccountID clears the value of the "funding_account_id" field.
+func (u *AccountUpsert) ClearFundingAccountID() *AccountUpsert {
+	u.SetNull(account.FieldFundingAccountID)
+	return u
+}
+
 // SetIsActive sets the "is_active" field.
 func (u *AccountUpsert) SetIsActive(v bool) *AccountUpsert {
 	u.Set(account.FieldIsActive, v)
@@ -736,6 +1008,42 @@ func (u *AccountUpsert) UpdateIsActive() *AccountUpsert {
 	return u
 }
 
+// SetClosedAt sets the "closed_at" field.
+func (u *AccountUpsert) SetClosedAt(v time.Time) *AccountUpsert {
+	u.Set(account.FieldClosedAt, v)
+	return u
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateClosedAt() *AccountUpsert {
+	u.SetExcluded(account.FieldClosedAt)
+	return u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *AccountUpsert) ClearClosedAt() *AccountUpsert {
+	u.SetNull(account.FieldClosedAt)
+	return u
+}
+
+// SetBalanceDate sets the "balance_date" field.
+func (u *AccountUpsert) SetBalanceDate(v time.Time) *AccountUpsert {
+	u.Set(account.FieldBalanceDate, v)
+	return u
+}
+
+// UpdateBalanceDate sets the "balance_date" field to the value that was provided on create.
+func (u *AccountUpsert) UpdateBalanceDate() *AccountUpsert {
+	u.SetExcluded(account.FieldBalanceDate)
+	return u
+}
+
+// ClearBalanceDate clears the value of the "balance_date" field.
+func (u *AccountUpsert) ClearBalanceDate() *AccountUpsert {
+	u.SetNull(account.FieldBalanceDate)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *AccountUpsert) SetUpdatedAt(v time.Time) *AccountUpsert {
 	u.Set(account.FieldUpdatedAt, v)
@@ -813,6 +1121,13 @@ func (u *AccountUpsertOne) UpdateItemID() *AccountUpsertOne {
 	})
 }
 
+// ClearItemID clears the value of the "item_id" field.
+func (u *AccountUpsertOne) ClearItemID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearItemID()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *AccountUpsertOne) SetUserID(v uuid.UUID) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
@@ -827,17 +1142,17 @@ func (u *AccountUpsertOne) UpdateUserID() *AccountUpsertOne {
 	})
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *AccountUpsertOne) SetPlaidID(v string) *AccountUpsertOne {
+// SetExternalID sets the "external_id" field.
+func (u *AccountUpsertOne) SetExternalID(v string) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
-		s.SetPlaidID(v)
+		s.SetExternalID(v)
 	})
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *AccountUpsertOne) UpdatePlaidID() *AccountUpsertOne {
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateExternalID() *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
-		s.UpdatePlaidID()
+		s.UpdateExternalID()
 	})
 }
 
@@ -960,6 +1275,69 @@ func (u *AccountUpsertOne) ClearAvailableBalance() *AccountUpsertOne {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *AccountUpsertOne) SetCurrency(v string) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateCurrency() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *AccountUpsertOne) SetCreditLimit(v int64) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCreditLimit(v)
+	})
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *AccountUpsertOne) AddCreditLimit(v int64) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddCreditLimit(v)
+	})
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateCreditLimit() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCreditLimit()
+	})
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *AccountUpsertOne) ClearCreditLimit() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearCreditLimit()
+	})
+}
+
+// SetFundingAccountID sets the "funding_account_id" field.
+func (u *AccountUpsertOne) SetFundingAccountID(v uuid.UUID) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetFundingAccountID(v)
+	})
+}
+
+// UpdateFundingAccountID sets the "funding_account_id" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateFundingAccountID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateFundingAccountID()
+	})
+}
+
+// ClearFundingAccountID clears the value of the "funding_account_id" field.
+func (u *AccountUpsertOne) ClearFundingAccountID() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearFundingAccountID()
+	})
+}
+
 // SetIsActive sets the "is_active" field.
 func (u *AccountUpsertOne) SetIsActive(v bool) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
@@ -974,6 +1352,48 @@ func (u *AccountUpsertOne) UpdateIsActive() *AccountUpsertOne {
 	})
 }
 
+// SetClosedAt sets the "closed_at" field.
+func (u *AccountUpsertOne) SetClosedAt(v time.Time) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateClosedAt() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *AccountUpsertOne) ClearClosedAt() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetBalanceDate sets the "balance_date" field.
+func (u *AccountUpsertOne) SetBalanceDate(v time.Time) *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetBalanceDate(v)
+	})
+}
+
+// UpdateBalanceDate sets the "balance_date" field to the value that was provided on create.
+func (u *AccountUpsertOne) UpdateBalanceDate() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateBalanceDate()
+	})
+}
+
+// ClearBalanceDate clears the value of the "balance_date" field.
+func (u *AccountUpsertOne) ClearBalanceDate() *AccountUpsertOne {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearBalanceDate()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *AccountUpsertOne) SetUpdatedAt(v time.Time) *AccountUpsertOne {
 	return u.Update(func(s *AccountUpsert) {
@@ -1220,6 +1640,13 @@ func (u *AccountUpsertBulk) UpdateItemID() *AccountUpsertBulk {
 	})
 }
 
+// ClearItemID clears the value of the "item_id" field.
+func (u *AccountUpsertBulk) ClearItemID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearItemID()
+	})
+}
+
 // SetUserID sets the "user_id" field.
 func (u *AccountUpsertBulk) SetUserID(v uuid.UUID) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
@@ -1234,17 +1661,17 @@ func (u *AccountUpsertBulk) UpdateUserID() *AccountUpsertBulk {
 	})
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *AccountUpsertBulk) SetPlaidID(v string) *AccountUpsertBulk {
+// SetExternalID sets the "external_id" field.
+func (u *AccountUpsertBulk) SetExternalID(v string) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
-		s.SetPlaidID(v)
+		s.SetExternalID(v)
 	})
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *AccountUpsertBulk) UpdatePlaidID() *AccountUpsertBulk {
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateExternalID() *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
-		s.UpdatePlaidID()
+		s.UpdateExternalID()
 	})
 }
 
@@ -1367,6 +1794,69 @@ func (u *AccountUpsertBulk) ClearAvailableBalance() *AccountUpsertBulk {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *AccountUpsertBulk) SetCurrency(v string) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateCurrency() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (u *AccountUpsertBulk) SetCreditLimit(v int64) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetCreditLimit(v)
+	})
+}
+
+// AddCreditLimit adds v to the "credit_limit" field.
+func (u *AccountUpsertBulk) AddCreditLimit(v int64) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.AddCreditLimit(v)
+	})
+}
+
+// UpdateCreditLimit sets the "credit_limit" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateCreditLimit() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateCreditLimit()
+	})
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (u *AccountUpsertBulk) ClearCreditLimit() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearCreditLimit()
+	})
+}
+
+// SetFundingAccountID sets the "funding_account_id" field.
+func (u *AccountUpsertBulk) SetFundingAccountID(v uuid.UUID) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetFundingAccountID(v)
+	})
+}
+
+// UpdateFundingAccountID sets the "funding_account_id" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateFundingAccountID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateFundingAccountID()
+	})
+}
+
+// ClearFundingAccountID clears the value of the "funding_account_id" field.
+func (u *AccountUpsertBulk) ClearFundingAccountID() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearFundingAccountID()
+	})
+}
+
 // SetIsActive sets the "is_active" field.
 func (u *AccountUpsertBulk) SetIsActive(v bool) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
@@ -1381,6 +1871,48 @@ func (u *AccountUpsertBulk) UpdateIsActive() *AccountUpsertBulk {
 	})
 }
 
+// SetClosedAt sets the "closed_at" field.
+func (u *AccountUpsertBulk) SetClosedAt(v time.Time) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetClosedAt(v)
+	})
+}
+
+// UpdateClosedAt sets the "closed_at" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateClosedAt() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateClosedAt()
+	})
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (u *AccountUpsertBulk) ClearClosedAt() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearClosedAt()
+	})
+}
+
+// SetBalanceDate sets the "balance_date" field.
+func (u *AccountUpsertBulk) SetBalanceDate(v time.Time) *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.SetBalanceDate(v)
+	})
+}
+
+// UpdateBalanceDate sets the "balance_date" field to the value that was provided on create.
+func (u *AccountUpsertBulk) UpdateBalanceDate() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.UpdateBalanceDate()
+	})
+}
+
+// ClearBalanceDate clears the value of the "balance_date" field.
+func (u *AccountUpsertBulk) ClearBalanceDate() *AccountUpsertBulk {
+	return u.Update(func(s *AccountUpsert) {
+		s.ClearBalanceDate()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *AccountUpsertBulk) SetUpdatedAt(v time.Time) *AccountUpsertBulk {
 	return u.Update(func(s *AccountUpsert) {
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"math"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/balancesnapshot"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/item"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/rule"
@@ -36,6 +38,10 @@ type AccountQuery struct {
 	withTargetRules       *RuleQuery
 	withOutgoingTransfers *SavingsTransferQuery
 	withIncomingTransfers *SavingsTransferQuery
+	withSavingsFamilies   *FamilyQuery
+	withBalanceSnapshots  *BalanceSnapshotQuery
+	withFundingAccount    *AccountQuery
+	withFundedAccounts    *AccountQuery
 	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -205,6 +211,94 @@ func (_q *AccountQuery) QueryIncomingTransfers() *SavingsTransferQuery {
 	return query
 }
 
+// QuerySavingsFamilies chains the current query on the "savings_families" edge.
+func (_q *AccountQuery) QuerySavingsFamilies() *FamilyQuery {
+	query := (&FamilyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.SavingsFamiliesTable, account.SavingsFamiliesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBalanceSnapshots chains the current query on the "balance_snapshots" edge.
+func (_q *AccountQuery) QueryBalanceSnapshots() *BalanceSnapshotQuery {
+	query := (&BalanceSnapshotClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(balancesnapshot.Table, balancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BalanceSnapshotsTable, account.BalanceSnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFundingAccount chains the current query on the "funding_account" edge.
+func (_q *AccountQuery) QueryFundingAccount() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, account.FundingAccountTable, account.FundingAccountColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFundedAccounts chains the current query on the "funded_accounts" edge.
+func (_q *AccountQuery) QueryFundedAccounts() *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, selector),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.FundedAccountsTable, account.FundedAccountsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Account entity from the query.
 // Returns a *NotFoundError when no Account was found.
 func (_q *AccountQuery) First(ctx context.Context) (*Account, error) {
@@ -403,6 +497,10 @@ func (_q *AccountQuery) Clone() *AccountQuery {
 		withTargetRules:       _q.withTargetRules.Clone(),
 		withOutgoingTransfers: _q.withOutgoingTransfers.Clone(),
 		withIncomingTransfers: _q.withIncomingTransfers.Clone(),
+		withSavingsFamilies:   _q.withSavingsFamilies.Clone(),
+		withBalanceSnapshots:  _q.withBalanceSnapshots.Clone(),
+		withFundingAccount:    _q.withFundingAccount.Clone(),
+		withFundedAccounts:    _q.withFundedAccounts.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -476,6 +574,50 @@ func (_q *AccountQuery) WithIncomingTransfers(opts ...func(*SavingsTransferQuery
 	return _q
 }
 
+// WithSavingsFamilies tells the query-builder to eager-load the nodes that are connected to
+// the "savings_families" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithSavingsFamilies(opts ...func(*FamilyQuery)) *AccountQuery {
+	query := (&FamilyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSavingsFamilies = query
+	return _q
+}
+
+// WithBalanceSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "balance_snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithBalanceSnapshots(opts ...func(*BalanceSnapshotQuery)) *AccountQuery {
+	query := (&BalanceSnapshotClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBalanceSnapshots = query
+	return _q
+}
+
+// WithFundingAccount tells the query-builder to eager-load the nodes that are connected to
+// the "funding_account" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithFundingAccount(opts ...func(*AccountQuery)) *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFundingAccount = query
+	return _q
+}
+
+// WithFundedAccounts tells the query-builder to eager-load the nodes that are connected to
+// the "funded_accounts" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AccountQuery) WithFundedAccounts(opts ...func(*AccountQuery)) *AccountQuery {
+	query := (&AccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFundedAccounts = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -554,13 +696,17 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 	var (
 		nodes       = []*Account{}
 		_spec       = _q.querySpec()
-		loadedTypes = [6]bool{
+		loadedTypes = [10]bool{
 			_q.withItem != nil,
 			_q.withUser != nil,
 			_q.withTransactions != nil,
 			_q.withTargetRules != nil,
 			_q.withOutgoingTransfers != nil,
 			_q.withIncomingTransfers != nil,
+			_q.withSavingsFamilies != nil,
+			_q.withBalanceSnapshots != nil,
+			_q.withFundingAccount != nil,
+			_q.withFundedAccounts != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -624,6 +770,33 @@ func (_q *AccountQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Acco
 			return nil, err
 		}
 	}
+	if query := _q.withSavingsFamilies; query != nil {
+		if err := _q.loadSavingsFamilies(ctx, query, nodes,
+			func(n *Account) { n.Edges.SavingsFamilies = []*Family{} },
+			func(n *Account, e *Family) { n.Edges.SavingsFamilies = append(n.Edges.SavingsFamilies, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBalanceSnapshots; query != nil {
+		if err := _q.loadBalanceSnapshots(ctx, query, nodes,
+			func(n *Account) { n.Edges.BalanceSnapshots = []*BalanceSnapshot{} },
+			func(n *Account, e *BalanceSnapshot) { n.Edges.BalanceSnapshots = append(n.Edges.BalanceSnapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFundingAccount; query != nil {
+		if err := _q.loadFundingAccount(ctx, query, nodes, nil,
+			func(n *Account, e *Account) { n.Edges.FundingAccount = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFundedAccounts; query != nil {
+		if err := _q.loadFundedAccounts(ctx, query, nodes,
+			func(n *Account) { n.Edges.FundedAccounts = []*Account{} },
+			func(n *Account, e *Account) { n.Edges.FundedAccounts = append(n.Edges.FundedAccounts, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -631,7 +804,10 @@ func (_q *AccountQuery) loadItem(ctx context.Context, query *ItemQuery, nodes []
 	ids := make([]uuid.UUID, 0, len(nodes))
 	nodeids := make(map[uuid.UUID][]*Account)
 	for i := range nodes {
-		fk := nodes[i].ItemID
+		if nodes[i].ItemID == nil {
+			continue
+		}
+		fk := *nodes[i].ItemID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -805,6 +981,134 @@ func (_q *AccountQuery) loadIncomingTransfers(ctx context.Context, query *Saving
 	}
 	return nil
 }
+func (_q *AccountQuery) loadSavingsFamilies(ctx context.Context, query *FamilyQuery, nodes []*Account, init func(*Account), assign func(*Account, *Family)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(family.FieldSavingsAccountID)
+	}
+	query.Where(predicate.Family(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.SavingsFamiliesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SavingsAccountID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "savings_account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "savings_account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadBalanceSnapshots(ctx context.Context, query *BalanceSnapshotQuery, nodes []*Account, init func(*Account), assign func(*Account, *BalanceSnapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(balancesnapshot.FieldAccountID)
+	}
+	query.Where(predicate.BalanceSnapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.BalanceSnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.AccountID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "account_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AccountQuery) loadFundingAccount(ctx context.Context, query *AccountQuery, nodes []*Account, init func(*Account), assign func(*Account, *Account)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Account)
+	for i := range nodes {
+		if nodes[i].FundingAccountID == nil {
+			continue
+		}
+		fk := *nodes[i].FundingAccountID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(account.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "funding_account_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *AccountQuery) loadFundedAccounts(ctx context.Context, query *AccountQuery, nodes []*Account, init func(*Account), assign func(*Account, *Account)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Account)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(account.FieldFundingAccountID)
+	}
+	query.Where(predicate.Account(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(account.FundedAccountsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FundingAccountID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "funding_account_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "funding_account_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *AccountQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -840,6 +1144,9 @@ func (_q *AccountQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withUser != nil {
 			_spec.Node.AddColumnOnce(account.FieldUserID)
 		}
+		if _q.withFundingAccount != nil {
+			_spec.Node.AddColumnOnce(account.FieldFundingAccountID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/balancesnapshot"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/item"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/rule"
@@ -49,6 +51,12 @@ func (_u *AccountUpdate) SetNillableItemID(v *uuid.UUID) *AccountUpdate {
 	return _u
 }
 
+// ClearItemID clears the value of the "item_id" field.
+func (_u *AccountUpdate) ClearItemID() *AccountUpdate {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *AccountUpdate) SetUserID(v uuid.UUID) *AccountUpdate {
 	_u.mutation.SetUserID(v)
@@ -63,16 +71,16 @@ func (_u *AccountUpdate) SetNillableUserID(v *uuid.UUID) *AccountUpdate {
 	return _u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_u *AccountUpdate) SetPlaidID(v string) *AccountUpdate {
-	_u.mutation.SetPlaidID(v)
+// SetExternalID sets the "external_id" field.
+func (_u *AccountUpdate) SetExternalID(v string) *AccountUpdate {
+	_u.mutation.SetExternalID(v)
 	return _u
 }
 
-// SetNillablePlaidID sets the "plaid_id" field if the given value is not nil.
-func (_u *AccountUpdate) SetNillablePlaidID(v *string) *AccountUpdate {
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableExternalID(v *string) *AccountUpdate {
 	if v != nil {
-		_u.SetPlaidID(*v)
+		_u.SetExternalID(*v)
 	}
 	return _u
 }
@@ -193,6 +201,67 @@ func (_u *AccountUpdate) ClearAvailableBalance() *AccountUpdate {
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *AccountUpdate) SetCurrency(v string) *AccountUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableCurrency(v *string) *AccountUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_u *AccountUpdate) SetCreditLimit(v int64) *AccountUpdate {
+	_u.mutation.ResetCreditLimit()
+	_u.mutation.SetCreditLimit(v)
+	return _u
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableCreditLimit(v *int64) *AccountUpdate {
+	if v != nil {
+		_u.SetCreditLimit(*v)
+	}
+	return _u
+}
+
+// AddCreditLimit adds value to the "credit_limit" field.
+func (_u *AccountUpdate) AddCreditLimit(v int64) *AccountUpdate {
+	_u.mutation.AddCreditLimit(v)
+	return _u
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (_u *AccountUpdate) ClearCreditLimit() *AccountUpdate {
+	_u.mutation.ClearCreditLimit()
+	return _u
+}
+
+// SetFundingAccountID sets the "funding_account_id" field.
+func (_u *AccountUpdate) SetFundingAccountID(v uuid.UUID) *AccountUpdate {
+	_u.mutation.SetFundingAccountID(v)
+	return _u
+}
+
+// SetNillableFundingAccountID sets the "funding_account_id" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableFundingAccountID(v *uuid.UUID) *AccountUpdate {
+	if v != nil {
+		_u.SetFundingAccountID(*v)
+	}
+	return _u
+}
+
+// ClearFundingAccountID clears the value of the "funding_account_id" field.
+func (_u *AccountUpdate) ClearFundingAccountID() *AccountUpdate {
+	_u.mutation.ClearFundingAccountID()
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *AccountUpdate) SetIsActive(v bool) *AccountUpdate {
 	_u.mutation.SetIsActive(v)
@@ -207,6 +276,46 @@ func (_u *AccountUpdate) SetNillableIsActive(v *bool) *AccountUpdate {
 	return _u
 }
 
+// SetClosedAt sets the "closed_at" field.
+func (_u *AccountUpdate) SetClosedAt(v time.Time) *AccountUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableClosedAt(v *time.Time) *AccountUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *AccountUpdate) ClearClosedAt() *AccountUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetBalanceDate sets the "balance_date" field.
+func (_u *AccountUpdate) SetBalanceDate(v time.Time) *AccountUpdate {
+	_u.mutation.SetBalanceDate(v)
+	return _u
+}
+
+// SetNillableBalanceDate sets the "balance_date" field if the given value is not nil.
+func (_u *AccountUpdate) SetNillableBalanceDate(v *time.Time) *AccountUpdate {
+	if v != nil {
+		_u.SetBalanceDate(*v)
+	}
+	return _u
+}
+
+// ClearBalanceDate clears the value of the "balance_date" field.
+func (_u *AccountUpdate) ClearBalanceDate() *AccountUpdate {
+	_u.mutation.ClearBalanceDate()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *AccountUpdate) SetUpdatedAt(v time.Time) *AccountUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -283,6 +392,56 @@ func (_u *AccountUpdate) AddIncomingTransfers(v ...*SavingsTransfer) *AccountUpd
 	return _u.AddIncomingTransferIDs(ids...)
 }
 
+// AddSavingsFamilyIDs adds the "savings_families" edge to the Family entity by IDs.
+func (_u *AccountUpdate) AddSavingsFamilyIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddSavingsFamilyIDs(ids...)
+	return _u
+}
+
+// AddSavingsFamilies adds the "savings_families" edges to the Family entity.
+func (_u *AccountUpdate) AddSavingsFamilies(v ...*Family) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavingsFamilyIDs(ids...)
+}
+
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the BalanceSnapshot entity by IDs.
+func (_u *AccountUpdate) AddBalanceSnapshotIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the BalanceSnapshot entity.
+func (_u *AccountUpdate) AddBalanceSnapshots(v ...*BalanceSnapshot) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBalanceSnapshotIDs(ids...)
+}
+
+// SetFundingAccount sets the "funding_account" edge to the Account entity.
+func (_u *AccountUpdate) SetFundingAccount(v *Account) *AccountUpdate {
+	return _u.SetFundingAccountID(v.ID)
+}
+
+// AddFundedAccountIDs adds the "funded_accounts" edge to the Account entity by IDs.
+func (_u *AccountUpdate) AddFundedAccountIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.AddFundedAccountIDs(ids...)
+	return _u
+}
+
+// AddFundedAccounts adds the "funded_accounts" edges to the Account entity.
+func (_u *AccountUpdate) AddFundedAccounts(v ...*Account) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFundedAccountIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_u *AccountUpdate) Mutation() *AccountMutation {
 	return _u.mutation
@@ -384,6 +543,75 @@ func (_u *AccountUpdate) RemoveIncomingTransfers(v ...*SavingsTransfer) *Account
 	return _u.RemoveIncomingTransferIDs(ids...)
 }
 
+// ClearSavingsFamilies clears all "savings_families" edges to the Family entity.
+func (_u *AccountUpdate) ClearSavingsFamilies() *AccountUpdate {
+	_u.mutation.ClearSavingsFamilies()
+	return _u
+}
+
+// RemoveSavingsFamilyIDs removes the "savings_families" edge to Family entities by IDs.
+func (_u *AccountUpdate) RemoveSavingsFamilyIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveSavingsFamilyIDs(ids...)
+	return _u
+}
+
+// RemoveSavingsFamilies removes "savings_families" edges to Family entities.
+func (_u *AccountUpdate) RemoveSavingsFamilies(v ...*Family) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavingsFamilyIDs(ids...)
+}
+
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the BalanceSnapshot entity.
+func (_u *AccountUpdate) ClearBalanceSnapshots() *AccountUpdate {
+	_u.mutation.ClearBalanceSnapshots()
+	return _u
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to BalanceSnapshot entities by IDs.
+func (_u *AccountUpdate) RemoveBalanceSnapshotIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to BalanceSnapshot entities.
+func (_u *AccountUpdate) RemoveBalanceSnapshots(v ...*BalanceSnapshot) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearFundingAccount clears the "funding_account" edge to the Account entity.
+func (_u *AccountUpdate) ClearFundingAccount() *AccountUpdate {
+	_u.mutation.ClearFundingAccount()
+	return _u
+}
+
+// ClearFundedAccounts clears all "funded_accounts" edges to the Account entity.
+func (_u *AccountUpdate) ClearFundedAccounts() *AccountUpdate {
+	_u.mutation.ClearFundedAccounts()
+	return _u
+}
+
+// RemoveFundedAccountIDs removes the "funded_accounts" edge to Account entities by IDs.
+func (_u *AccountUpdate) RemoveFundedAccountIDs(ids ...uuid.UUID) *AccountUpdate {
+	_u.mutation.RemoveFundedAccountIDs(ids...)
+	return _u
+}
+
+// RemoveFundedAccounts removes "funded_accounts" edges to Account entities.
+func (_u *AccountUpdate) RemoveFundedAccounts(v ...*Account) *AccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFundedAccountIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *AccountUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -422,9 +650,9 @@ func (_u *AccountUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *AccountUpdate) check() error {
-	if v, ok := _u.mutation.PlaidID(); ok {
-		if err := account.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Account.plaid_id": %w`, err)}
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := account.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Account.external_id": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.Name(); ok {
@@ -437,9 +665,6 @@ func (_u *AccountUpdate) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Account.type": %w`, err)}
 		}
 	}
-	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Account.item"`)
-	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Account.user"`)
 	}
@@ -464,8 +689,8 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.PlaidID(); ok {
-		_spec.SetField(account.FieldPlaidID, field.TypeString, value)
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(account.FieldExternalID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(account.FieldName, field.TypeString, value)
@@ -500,9 +725,33 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AvailableBalanceCleared() {
 		_spec.ClearField(account.FieldAvailableBalance, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(account.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreditLimit(); ok {
+		_spec.SetField(account.FieldCreditLimit, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(account.FieldCreditLimit, field.TypeInt64, value)
+	}
+	if _u.mutation.CreditLimitCleared() {
+		_spec.ClearField(account.FieldCreditLimit, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(account.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(account.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(account.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BalanceDate(); ok {
+		_spec.SetField(account.FieldBalanceDate, field.TypeTime, value)
+	}
+	if _u.mutation.BalanceDateCleared() {
+		_spec.ClearField(account.FieldBalanceDate, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -744,6 +993,170 @@ func (_u *AccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SavingsFamiliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SavingsFamiliesTable,
+			Columns: []string{account.SavingsFamiliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavingsFamiliesIDs(); len(nodes) > 0 && !_u.mutation.SavingsFamiliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SavingsFamiliesTable,
+			Columns: []string{account.SavingsFamiliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavingsFamiliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SavingsFamiliesTable,
+			Columns: []string{account.SavingsFamiliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FundingAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.FundingAccountTable,
+			Columns: []string{account.FundingAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FundingAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.FundingAccountTable,
+			Columns: []string{account.FundingAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FundedAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FundedAccountsTable,
+			Columns: []string{account.FundedAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFundedAccountsIDs(); len(nodes) > 0 && !_u.mutation.FundedAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FundedAccountsTable,
+			Columns: []string{account.FundedAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FundedAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FundedAccountsTable,
+			Columns: []string{account.FundedAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -780,6 +1193,12 @@ func (_u *AccountUpdateOne) SetNillableItemID(v *uuid.UUID) *AccountUpdateOne {
 	return _u
 }
 
+// ClearItemID clears the value of the "item_id" field.
+func (_u *AccountUpdateOne) ClearItemID() *AccountUpdateOne {
+	_u.mutation.ClearItemID()
+	return _u
+}
+
 // SetUserID sets the "user_id" field.
 func (_u *AccountUpdateOne) SetUserID(v uuid.UUID) *AccountUpdateOne {
 	_u.mutation.SetUserID(v)
@@ -794,16 +1213,16 @@ func (_u *AccountUpdateOne) SetNillableUserID(v *uuid.UUID) *AccountUpdateOne {
 	return _u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_u *AccountUpdateOne) SetPlaidID(v string) *AccountUpdateOne {
-	_u.mutation.SetPlaidID(v)
+// SetExternalID sets the "external_id" field.
+func (_u *AccountUpdateOne) SetExternalID(v string) *AccountUpdateOne {
+	_u.mutation.SetExternalID(v)
 	return _u
 }
 
-// SetNillablePlaidID sets the "plaid_id" field if the given value is not nil.
-func (_u *AccountUpdateOne) SetNillablePlaidID(v *string) *AccountUpdateOne {
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableExternalID(v *string) *AccountUpdateOne {
 	if v != nil {
-		_u.SetPlaidID(*v)
+		_u.SetExternalID(*v)
 	}
 	return _u
 }
@@ -924,6 +1343,67 @@ func (_u *AccountUpdateOne) ClearAvailableBalance() *AccountUpdateOne {
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *AccountUpdateOne) SetCurrency(v string) *AccountUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableCurrency(v *string) *AccountUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (_u *AccountUpdateOne) SetCreditLimit(v int64) *AccountUpdateOne {
+	_u.mutation.ResetCreditLimit()
+	_u.mutation.SetCreditLimit(v)
+	return _u
+}
+
+// SetNillableCreditLimit sets the "credit_limit" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableCreditLimit(v *int64) *AccountUpdateOne {
+	if v != nil {
+		_u.SetCreditLimit(*v)
+	}
+	return _u
+}
+
+// AddCreditLimit adds value to the "credit_limit" field.
+func (_u *AccountUpdateOne) AddCreditLimit(v int64) *AccountUpdateOne {
+	_u.mutation.AddCreditLimit(v)
+	return _u
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (_u *AccountUpdateOne) ClearCreditLimit() *AccountUpdateOne {
+	_u.mutation.ClearCreditLimit()
+	return _u
+}
+
+// SetFundingAccountID sets the "funding_account_id" field.
+func (_u *AccountUpdateOne) SetFundingAccountID(v uuid.UUID) *AccountUpdateOne {
+	_u.mutation.SetFundingAccountID(v)
+	return _u
+}
+
+// SetNillableFundingAccountID sets the "funding_account_id" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableFundingAccountID(v *uuid.UUID) *AccountUpdateOne {
+	if v != nil {
+		_u.SetFundingAccountID(*v)
+	}
+	return _u
+}
+
+// ClearFundingAccountID clears the value of the "funding_account_id" field.
+func (_u *AccountUpdateOne) ClearFundingAccountID() *AccountUpdateOne {
+	_u.mutation.ClearFundingAccountID()
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *AccountUpdateOne) SetIsActive(v bool) *AccountUpdateOne {
 	_u.mutation.SetIsActive(v)
@@ -938,6 +1418,46 @@ func (_u *AccountUpdateOne) SetNillableIsActive(v *bool) *AccountUpdateOne {
 	return _u
 }
 
+// SetClosedAt sets the "closed_at" field.
+func (_u *AccountUpdateOne) SetClosedAt(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableClosedAt(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *AccountUpdateOne) ClearClosedAt() *AccountUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetBalanceDate sets the "balance_date" field.
+func (_u *AccountUpdateOne) SetBalanceDate(v time.Time) *AccountUpdateOne {
+	_u.mutation.SetBalanceDate(v)
+	return _u
+}
+
+// SetNillableBalanceDate sets the "balance_date" field if the given value is not nil.
+func (_u *AccountUpdateOne) SetNillableBalanceDate(v *time.Time) *AccountUpdateOne {
+	if v != nil {
+		_u.SetBalanceDate(*v)
+	}
+	return _u
+}
+
+// ClearBalanceDate clears the value of the "balance_date" field.
+func (_u *AccountUpdateOne) ClearBalanceDate() *AccountUpdateOne {
+	_u.mutation.ClearBalanceDate()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *AccountUpdateOne) SetUpdatedAt(v time.Time) *AccountUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -1014,6 +1534,56 @@ func (_u *AccountUpdateOne) AddIncomingTransfers(v ...*SavingsTransfer) *Account
 	return _u.AddIncomingTransferIDs(ids...)
 }
 
+// AddSavingsFamilyIDs adds the "savings_families" edge to the Family entity by IDs.
+func (_u *AccountUpdateOne) AddSavingsFamilyIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddSavingsFamilyIDs(ids...)
+	return _u
+}
+
+// AddSavingsFamilies adds the "savings_families" edges to the Family entity.
+func (_u *AccountUpdateOne) AddSavingsFamilies(v ...*Family) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSavingsFamilyIDs(ids...)
+}
+
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the BalanceSnapshot entity by IDs.
+func (_u *AccountUpdateOne) AddBalanceSnapshotIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// AddBalanceSnapshots adds the "balance_snapshots" edges to the BalanceSnapshot entity.
+func (_u *AccountUpdateOne) AddBalanceSnapshots(v ...*BalanceSnapshot) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBalanceSnapshotIDs(ids...)
+}
+
+// SetFundingAccount sets the "funding_account" edge to the Account entity.
+func (_u *AccountUpdateOne) SetFundingAccount(v *Account) *AccountUpdateOne {
+	return _u.SetFundingAccountID(v.ID)
+}
+
+// AddFundedAccountIDs adds the "funded_accounts" edge to the Account entity by IDs.
+func (_u *AccountUpdateOne) AddFundedAccountIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.AddFundedAccountIDs(ids...)
+	return _u
+}
+
+// AddFundedAccounts adds the "funded_accounts" edges to the Account entity.
+func (_u *AccountUpdateOne) AddFundedAccounts(v ...*Account) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFundedAccountIDs(ids...)
+}
+
 // Mutation returns the AccountMutation object of the builder.
 func (_u *AccountUpdateOne) Mutation() *AccountMutation {
 	return _u.mutation
@@ -1115,6 +1685,75 @@ func (_u *AccountUpdateOne) RemoveIncomingTransfers(v ...*SavingsTransfer) *Acco
 	return _u.RemoveIncomingTransferIDs(ids...)
 }
 
+// ClearSavingsFamilies clears all "savings_families" edges to the Family entity.
+func (_u *AccountUpdateOne) ClearSavingsFamilies() *AccountUpdateOne {
+	_u.mutation.ClearSavingsFamilies()
+	return _u
+}
+
+// RemoveSavingsFamilyIDs removes the "savings_families" edge to Family entities by IDs.
+func (_u *AccountUpdateOne) RemoveSavingsFamilyIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveSavingsFamilyIDs(ids...)
+	return _u
+}
+
+// RemoveSavingsFamilies removes "savings_families" edges to Family entities.
+func (_u *AccountUpdateOne) RemoveSavingsFamilies(v ...*Family) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSavingsFamilyIDs(ids...)
+}
+
+// ClearBalanceSnapshots clears all "balance_snapshots" edges to the BalanceSnapshot entity.
+func (_u *AccountUpdateOne) ClearBalanceSnapshots() *AccountUpdateOne {
+	_u.mutation.ClearBalanceSnapshots()
+	return _u
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to BalanceSnapshot entities by IDs.
+func (_u *AccountUpdateOne) RemoveBalanceSnapshotIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveBalanceSnapshotIDs(ids...)
+	return _u
+}
+
+// RemoveBalanceSnapshots removes "balance_snapshots" edges to BalanceSnapshot entities.
+func (_u *AccountUpdateOne) RemoveBalanceSnapshots(v ...*BalanceSnapshot) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBalanceSnapshotIDs(ids...)
+}
+
+// ClearFundingAccount clears the "funding_account" edge to the Account entity.
+func (_u *AccountUpdateOne) ClearFundingAccount() *AccountUpdateOne {
+	_u.mutation.ClearFundingAccount()
+	return _u
+}
+
+// ClearFundedAccounts clears all "funded_accounts" edges to the Account entity.
+func (_u *AccountUpdateOne) ClearFundedAccounts() *AccountUpdateOne {
+	_u.mutation.ClearFundedAccounts()
+	return _u
+}
+
+// RemoveFundedAccountIDs removes the "funded_accounts" edge to Account entities by IDs.
+func (_u *AccountUpdateOne) RemoveFundedAccountIDs(ids ...uuid.UUID) *AccountUpdateOne {
+	_u.mutation.RemoveFundedAccountIDs(ids...)
+	return _u
+}
+
+// RemoveFundedAccounts removes "funded_accounts" edges to Account entities.
+func (_u *AccountUpdateOne) RemoveFundedAccounts(v ...*Account) *AccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFundedAccountIDs(ids...)
+}
+
 // Where appends a list predicates to the AccountUpdate builder.
 func (_u *AccountUpdateOne) Where(ps ...predicate.Account) *AccountUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1166,9 +1805,9 @@ func (_u *AccountUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *AccountUpdateOne) check() error {
-	if v, ok := _u.mutation.PlaidID(); ok {
-		if err := account.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Account.plaid_id": %w`, err)}
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := account.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Account.external_id": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.Name(); ok {
@@ -1181,9 +1820,6 @@ func (_u *AccountUpdateOne) check() error {
 			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Account.type": %w`, err)}
 		}
 	}
-	if _u.mutation.ItemCleared() && len(_u.mutation.ItemIDs()) > 0 {
-		return errors.New(`ent: clearing a required unique edge "Account.item"`)
-	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Account.user"`)
 	}
@@ -1225,8 +1861,8 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 			}
 		}
 	}
-	if value, ok := _u.mutation.PlaidID(); ok {
-		_spec.SetField(account.FieldPlaidID, field.TypeString, value)
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(account.FieldExternalID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Name(); ok {
 		_spec.SetField(account.FieldName, field.TypeString, value)
@@ -1261,9 +1897,33 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 	if _u.mutation.AvailableBalanceCleared() {
 		_spec.ClearField(account.FieldAvailableBalance, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(account.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CreditLimit(); ok {
+		_spec.SetField(account.FieldCreditLimit, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedCreditLimit(); ok {
+		_spec.AddField(account.FieldCreditLimit, field.TypeInt64, value)
+	}
+	if _u.mutation.CreditLimitCleared() {
+		_spec.ClearField(account.FieldCreditLimit, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(account.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(account.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(account.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.BalanceDate(); ok {
+		_spec.SetField(account.FieldBalanceDate, field.TypeTime, value)
+	}
+	if _u.mutation.BalanceDateCleared() {
+		_spec.ClearField(account.FieldBalanceDate, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(account.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1505,6 +2165,170 @@ func (_u *AccountUpdateOne) sqlSave(ctx context.Context) (_node *Account, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SavingsFamiliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SavingsFamiliesTable,
+			Columns: []string{account.SavingsFamiliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSavingsFamiliesIDs(); len(nodes) > 0 && !_u.mutation.SavingsFamiliesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SavingsFamiliesTable,
+			Columns: []string{account.SavingsFamiliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SavingsFamiliesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.SavingsFamiliesTable,
+			Columns: []string{account.SavingsFamiliesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBalanceSnapshotsIDs(); len(nodes) > 0 && !_u.mutation.BalanceSnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BalanceSnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.BalanceSnapshotsTable,
+			Columns: []string{account.BalanceSnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(balancesnapshot.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FundingAccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.FundingAccountTable,
+			Columns: []string{account.FundingAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FundingAccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   account.FundingAccountTable,
+			Columns: []string{account.FundingAccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FundedAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FundedAccountsTable,
+			Columns: []string{account.FundedAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFundedAccountsIDs(); len(nodes) > 0 && !_u.mutation.FundedAccountsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FundedAccountsTable,
+			Columns: []string{account.FundedAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FundedAccountsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   account.FundedAccountsTable,
+			Columns: []string{account.FundedAccountsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(account.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Account{config: _u.config}
 	_spec.Assign = _node.assignValues
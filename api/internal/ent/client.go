@@ -12,12 +12,22 @@ import (
 	"regulation/internal/ent/migrate"
 
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/backfilljob"
+	"regulation/internal/ent/balancesnapshot"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/budgetalert"
+	"regulation/internal/ent/family"
+	"regulation/internal/ent/familymember"
+	"regulation/internal/ent/goal"
 	"regulation/internal/ent/item"
+	"regulation/internal/ent/monthlyreport"
 	"regulation/internal/ent/pushsubscription"
+	"regulation/internal/ent/recurringcharge"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/savingstransfer"
 	"regulation/internal/ent/synccursor"
+	"regulation/internal/ent/syncrun"
 	"regulation/internal/ent/transaction"
 	"regulation/internal/ent/user"
 
@@ -37,10 +47,28 @@ type Client struct {
 	Schema *migrate.Schema
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// BackfillJob is the client for interacting with the BackfillJob builders.
+	BackfillJob *BackfillJobClient
+	// BalanceSnapshot is the client for interacting with the BalanceSnapshot builders.
+	BalanceSnapshot *BalanceSnapshotClient
+	// Budget is the client for interacting with the Budget builders.
+	Budget *BudgetClient
+	// BudgetAlert is the client for interacting with the BudgetAlert builders.
+	BudgetAlert *BudgetAlertClient
+	// Family is the client for interacting with the Family builders.
+	Family *FamilyClient
+	// FamilyMember is the client for interacting with the FamilyMember builders.
+	FamilyMember *FamilyMemberClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// MonthlyReport is the client for interacting with the MonthlyReport builders.
+	MonthlyReport *MonthlyReportClient
 	// PushSubscription is the client for interacting with the PushSubscription builders.
 	PushSubscription *PushSubscriptionClient
+	// RecurringCharge is the client for interacting with the RecurringCharge builders.
+	RecurringCharge *RecurringChargeClient
 	// Rule is the client for interacting with the Rule builders.
 	Rule *RuleClient
 	// RuleExecution is the client for interacting with the RuleExecution builders.
@@ -49,6 +77,8 @@ type Client struct {
 	SavingsTransfer *SavingsTransferClient
 	// SyncCursor is the client for interacting with the SyncCursor builders.
 	SyncCursor *SyncCursorClient
+	// SyncRun is the client for interacting with the SyncRun builders.
+	SyncRun *SyncRunClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// User is the client for interacting with the User builders.
@@ -65,12 +95,22 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Account = NewAccountClient(c.config)
+	c.BackfillJob = NewBackfillJobClient(c.config)
+	c.BalanceSnapshot = NewBalanceSnapshotClient(c.config)
+	c.Budget = NewBudgetClient(c.config)
+	c.BudgetAlert = NewBudgetAlertClient(c.config)
+	c.Family = NewFamilyClient(c.config)
+	c.FamilyMember = NewFamilyMemberClient(c.config)
+	c.Goal = NewGoalClient(c.config)
 	c.Item = NewItemClient(c.config)
+	c.MonthlyReport = NewMonthlyReportClient(c.config)
 	c.PushSubscription = NewPushSubscriptionClient(c.config)
+	c.RecurringCharge = NewRecurringChargeClient(c.config)
 	c.Rule = NewRuleClient(c.config)
 	c.RuleExecution = NewRuleExecutionClient(c.config)
 	c.SavingsTransfer = NewSavingsTransferClient(c.config)
 	c.SyncCursor = NewSyncCursorClient(c.config)
+	c.SyncRun = NewSyncRunClient(c.config)
 	c.Transaction = NewTransactionClient(c.config)
 	c.User = NewUserClient(c.config)
 }
@@ -166,12 +206,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:              ctx,
 		config:           cfg,
 		Account:          NewAccountClient(cfg),
+		BackfillJob:      NewBackfillJobClient(cfg),
+		BalanceSnapshot:  NewBalanceSnapshotClient(cfg),
+		Budget:           NewBudgetClient(cfg),
+		BudgetAlert:      NewBudgetAlertClient(cfg),
+		Family:           NewFamilyClient(cfg),
+		FamilyMember:     NewFamilyMemberClient(cfg),
+		Goal:             NewGoalClient(cfg),
 		Item:             NewItemClient(cfg),
+		MonthlyReport:    NewMonthlyReportClient(cfg),
 		PushSubscription: NewPushSubscriptionClient(cfg),
+		RecurringCharge:  NewRecurringChargeClient(cfg),
 		Rule:             NewRuleClient(cfg),
 		RuleExecution:    NewRuleExecutionClient(cfg),
 		SavingsTransfer:  NewSavingsTransferClient(cfg),
 		SyncCursor:       NewSyncCursorClient(cfg),
+		SyncRun:          NewSyncRunClient(cfg),
 		Transaction:      NewTransactionClient(cfg),
 		User:             NewUserClient(cfg),
 	}, nil
@@ -194,12 +244,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:              ctx,
 		config:           cfg,
 		Account:          NewAccountClient(cfg),
+		BackfillJob:      NewBackfillJobClient(cfg),
+		BalanceSnapshot:  NewBalanceSnapshotClient(cfg),
+		Budget:           NewBudgetClient(cfg),
+		BudgetAlert:      NewBudgetAlertClient(cfg),
+		Family:           NewFamilyClient(cfg),
+		FamilyMember:     NewFamilyMemberClient(cfg),
+		Goal:             NewGoalClient(cfg),
 		Item:             NewItemClient(cfg),
+		MonthlyReport:    NewMonthlyReportClient(cfg),
 		PushSubscription: NewPushSubscriptionClient(cfg),
+		RecurringCharge:  NewRecurringChargeClient(cfg),
 		Rule:             NewRuleClient(cfg),
 		RuleExecution:    NewRuleExecutionClient(cfg),
 		SavingsTransfer:  NewSavingsTransferClient(cfg),
 		SyncCursor:       NewSyncCursorClient(cfg),
+		SyncRun:          NewSyncRunClient(cfg),
 		Transaction:      NewTransactionClient(cfg),
 		User:             NewUserClient(cfg),
 	}, nil
@@ -231,8 +291,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Account, c.Item, c.PushSubscription, c.Rule, c.RuleExecution,
-		c.SavingsTransfer, c.SyncCursor, c.Transaction, c.User,
+		c.Account, c.BackfillJob, c.BalanceSnapshot, c.Budget, c.BudgetAlert, c.Family,
+		c.FamilyMember, c.Goal, c.Item, c.MonthlyReport, c.PushSubscription,
+		c.RecurringCharge, c.Rule, c.RuleExecution, c.SavingsTransfer, c.SyncCursor,
+		c.SyncRun, c.Transaction, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -242,8 +304,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Account, c.Item, c.PushSubscription, c.Rule, c.RuleExecution,
-		c.SavingsTransfer, c.SyncCursor, c.Transaction, c.User,
+		c.Account, c.BackfillJob, c.BalanceSnapshot, c.Budget, c.BudgetAlert, c.Family,
+		c.FamilyMember, c.Goal, c.Item, c.MonthlyReport, c.PushSubscription,
+		c.RecurringCharge, c.Rule, c.RuleExecution, c.SavingsTransfer, c.SyncCursor,
+		c.SyncRun, c.Transaction, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -254,10 +318,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AccountMutation:
 		return c.Account.mutate(ctx, m)
+	case *BackfillJobMutation:
+		return c.BackfillJob.mutate(ctx, m)
+	case *BalanceSnapshotMutation:
+		return c.BalanceSnapshot.mutate(ctx, m)
+	case *BudgetMutation:
+		return c.Budget.mutate(ctx, m)
+	case *BudgetAlertMutation:
+		return c.BudgetAlert.mutate(ctx, m)
+	case *FamilyMutation:
+		return c.Family.mutate(ctx, m)
+	case *FamilyMemberMutation:
+		return c.FamilyMember.mutate(ctx, m)
+	case *GoalMutation:
+		return c.Goal.mutate(ctx, m)
 	case *ItemMutation:
 		return c.Item.mutate(ctx, m)
+	case *MonthlyReportMutation:
+		return c.MonthlyReport.mutate(ctx, m)
 	case *PushSubscriptionMutation:
 		return c.PushSubscription.mutate(ctx, m)
+	case *RecurringChargeMutation:
+		return c.RecurringCharge.mutate(ctx, m)
 	case *RuleMutation:
 		return c.Rule.mutate(ctx, m)
 	case *RuleExecutionMutation:
@@ -266,6 +348,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.SavingsTransfer.mutate(ctx, m)
 	case *SyncCursorMutation:
 		return c.SyncCursor.mutate(ctx, m)
+	case *SyncRunMutation:
+		return c.SyncRun.mutate(ctx, m)
 	case *TransactionMutation:
 		return c.Transaction.mutate(ctx, m)
 	case *UserMutation:
@@ -479,6 +563,70 @@ func (c *AccountClient) QueryIncomingTransfers(_m *Account) *SavingsTransferQuer
 	return query
 }
 
+// QuerySavingsFamilies queries the savings_families edge of a Account.
+func (c *AccountClient) QuerySavingsFamilies(_m *Account) *FamilyQuery {
+	query := (&FamilyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.SavingsFamiliesTable, account.SavingsFamiliesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBalanceSnapshots queries the balance_snapshots edge of a Account.
+func (c *AccountClient) QueryBalanceSnapshots(_m *Account) *BalanceSnapshotQuery {
+	query := (&BalanceSnapshotClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(balancesnapshot.Table, balancesnapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.BalanceSnapshotsTable, account.BalanceSnapshotsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFundingAccount queries the funding_account edge of a Account.
+func (c *AccountClient) QueryFundingAccount(_m *Account) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, account.FundingAccountTable, account.FundingAccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFundedAccounts queries the funded_accounts edge of a Account.
+func (c *AccountClient) QueryFundedAccounts(_m *Account) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(account.Table, account.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, account.FundedAccountsTable, account.FundedAccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AccountClient) Hooks() []Hook {
 	return c.hooks.Account
@@ -504,107 +652,107 @@ func (c *AccountClient) mutate(ctx context.Context, m *AccountMutation) (Value,
 	}
 }
 
-// ItemClient is a client for the Item schema.
-type ItemClient struct {
+// BackfillJobClient is a client for the BackfillJob schema.
+type BackfillJobClient struct {
 	config
 }
 
-// NewItemClient returns a client for the Item from the given config.
-func NewItemClient(c config) *ItemClient {
-	return &ItemClient{config: c}
+// NewBackfillJobClient returns a client for the BackfillJob from the given config.
+func NewBackfillJobClient(c config) *BackfillJobClient {
+	return &BackfillJobClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
-func (c *ItemClient) Use(hooks ...Hook) {
-	c.hooks.Item = append(c.hooks.Item, hooks...)
+// A call to `Use(f, g, h)` equals to `backfilljob.Hooks(f(g(h())))`.
+func (c *BackfillJobClient) Use(hooks ...Hook) {
+	c.hooks.BackfillJob = append(c.hooks.BackfillJob, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
-func (c *ItemClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Item = append(c.inters.Item, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `backfilljob.Intercept(f(g(h())))`.
+func (c *BackfillJobClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BackfillJob = append(c.inters.BackfillJob, interceptors...)
 }
 
-// Create returns a builder for creating a Item entity.
-func (c *ItemClient) Create() *ItemCreate {
-	mutation := newItemMutation(c.config, OpCreate)
-	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BackfillJob entity.
+func (c *BackfillJobClient) Create() *BackfillJobCreate {
+	mutation := newBackfillJobMutation(c.config, OpCreate)
+	return &BackfillJobCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Item entities.
-func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
-	return &ItemCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BackfillJob entities.
+func (c *BackfillJobClient) CreateBulk(builders ...*BackfillJobCreate) *BackfillJobCreateBulk {
+	return &BackfillJobCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ItemClient) MapCreateBulk(slice any, setFunc func(*ItemCreate, int)) *ItemCreateBulk {
+func (c *BackfillJobClient) MapCreateBulk(slice any, setFunc func(*BackfillJobCreate, int)) *BackfillJobCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ItemCreateBulk{err: fmt.Errorf("calling to ItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BackfillJobCreateBulk{err: fmt.Errorf("calling to BackfillJobClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ItemCreate, rv.Len())
+	builders := make([]*BackfillJobCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ItemCreateBulk{config: c.config, builders: builders}
+	return &BackfillJobCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Item.
-func (c *ItemClient) Update() *ItemUpdate {
-	mutation := newItemMutation(c.config, OpUpdate)
-	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BackfillJob.
+func (c *BackfillJobClient) Update() *BackfillJobUpdate {
+	mutation := newBackfillJobMutation(c.config, OpUpdate)
+	return &BackfillJobUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ItemClient) UpdateOne(_m *Item) *ItemUpdateOne {
-	mutation := newItemMutation(c.config, OpUpdateOne, withItem(_m))
-	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BackfillJobClient) UpdateOne(_m *BackfillJob) *BackfillJobUpdateOne {
+	mutation := newBackfillJobMutation(c.config, OpUpdateOne, withBackfillJob(_m))
+	return &BackfillJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ItemClient) UpdateOneID(id uuid.UUID) *ItemUpdateOne {
-	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
-	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BackfillJobClient) UpdateOneID(id uuid.UUID) *BackfillJobUpdateOne {
+	mutation := newBackfillJobMutation(c.config, OpUpdateOne, withBackfillJobID(id))
+	return &BackfillJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Item.
-func (c *ItemClient) Delete() *ItemDelete {
-	mutation := newItemMutation(c.config, OpDelete)
-	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BackfillJob.
+func (c *BackfillJobClient) Delete() *BackfillJobDelete {
+	mutation := newBackfillJobMutation(c.config, OpDelete)
+	return &BackfillJobDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ItemClient) DeleteOne(_m *Item) *ItemDeleteOne {
+func (c *BackfillJobClient) DeleteOne(_m *BackfillJob) *BackfillJobDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ItemClient) DeleteOneID(id uuid.UUID) *ItemDeleteOne {
-	builder := c.Delete().Where(item.ID(id))
+func (c *BackfillJobClient) DeleteOneID(id uuid.UUID) *BackfillJobDeleteOne {
+	builder := c.Delete().Where(backfilljob.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ItemDeleteOne{builder}
+	return &BackfillJobDeleteOne{builder}
 }
 
-// Query returns a query builder for Item.
-func (c *ItemClient) Query() *ItemQuery {
-	return &ItemQuery{
+// Query returns a query builder for BackfillJob.
+func (c *BackfillJobClient) Query() *BackfillJobQuery {
+	return &BackfillJobQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeItem},
+		ctx:    &QueryContext{Type: TypeBackfillJob},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Item entity by its id.
-func (c *ItemClient) Get(ctx context.Context, id uuid.UUID) (*Item, error) {
-	return c.Query().Where(item.ID(id)).Only(ctx)
+// Get returns a BackfillJob entity by its id.
+func (c *BackfillJobClient) Get(ctx context.Context, id uuid.UUID) (*BackfillJob, error) {
+	return c.Query().Where(backfilljob.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ItemClient) GetX(ctx context.Context, id uuid.UUID) *Item {
+func (c *BackfillJobClient) GetX(ctx context.Context, id uuid.UUID) *BackfillJob {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -612,15 +760,15 @@ func (c *ItemClient) GetX(ctx context.Context, id uuid.UUID) *Item {
 	return obj
 }
 
-// QueryUser queries the user edge of a Item.
-func (c *ItemClient) QueryUser(_m *Item) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryItem queries the item edge of a BackfillJob.
+func (c *BackfillJobClient) QueryItem(_m *BackfillJob) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, item.UserTable, item.UserColumn),
+			sqlgraph.From(backfilljob.Table, backfilljob.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, backfilljob.ItemTable, backfilljob.ItemColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -628,15 +776,148 @@ func (c *ItemClient) QueryUser(_m *Item) *UserQuery {
 	return query
 }
 
-// QueryAccounts queries the accounts edge of a Item.
-func (c *ItemClient) QueryAccounts(_m *Item) *AccountQuery {
+// Hooks returns the client hooks.
+func (c *BackfillJobClient) Hooks() []Hook {
+	return c.hooks.BackfillJob
+}
+
+// Interceptors returns the client interceptors.
+func (c *BackfillJobClient) Interceptors() []Interceptor {
+	return c.inters.BackfillJob
+}
+
+func (c *BackfillJobClient) mutate(ctx context.Context, m *BackfillJobMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BackfillJobCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BackfillJobUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BackfillJobUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BackfillJobDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BackfillJob mutation op: %q", m.Op())
+	}
+}
+
+// BalanceSnapshotClient is a client for the BalanceSnapshot schema.
+type BalanceSnapshotClient struct {
+	config
+}
+
+// NewBalanceSnapshotClient returns a client for the BalanceSnapshot from the given config.
+func NewBalanceSnapshotClient(c config) *BalanceSnapshotClient {
+	return &BalanceSnapshotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `balancesnapshot.Hooks(f(g(h())))`.
+func (c *BalanceSnapshotClient) Use(hooks ...Hook) {
+	c.hooks.BalanceSnapshot = append(c.hooks.BalanceSnapshot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `balancesnapshot.Intercept(f(g(h())))`.
+func (c *BalanceSnapshotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BalanceSnapshot = append(c.inters.BalanceSnapshot, interceptors...)
+}
+
+// Create returns a builder for creating a BalanceSnapshot entity.
+func (c *BalanceSnapshotClient) Create() *BalanceSnapshotCreate {
+	mutation := newBalanceSnapshotMutation(c.config, OpCreate)
+	return &BalanceSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BalanceSnapshot entities.
+func (c *BalanceSnapshotClient) CreateBulk(builders ...*BalanceSnapshotCreate) *BalanceSnapshotCreateBulk {
+	return &BalanceSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BalanceSnapshotClient) MapCreateBulk(slice any, setFunc func(*BalanceSnapshotCreate, int)) *BalanceSnapshotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BalanceSnapshotCreateBulk{err: fmt.Errorf("calling to BalanceSnapshotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BalanceSnapshotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BalanceSnapshotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Update() *BalanceSnapshotUpdate {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdate)
+	return &BalanceSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BalanceSnapshotClient) UpdateOne(_m *BalanceSnapshot) *BalanceSnapshotUpdateOne {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdateOne, withBalanceSnapshot(_m))
+	return &BalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BalanceSnapshotClient) UpdateOneID(id uuid.UUID) *BalanceSnapshotUpdateOne {
+	mutation := newBalanceSnapshotMutation(c.config, OpUpdateOne, withBalanceSnapshotID(id))
+	return &BalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Delete() *BalanceSnapshotDelete {
+	mutation := newBalanceSnapshotMutation(c.config, OpDelete)
+	return &BalanceSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BalanceSnapshotClient) DeleteOne(_m *BalanceSnapshot) *BalanceSnapshotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BalanceSnapshotClient) DeleteOneID(id uuid.UUID) *BalanceSnapshotDeleteOne {
+	builder := c.Delete().Where(balancesnapshot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BalanceSnapshotDeleteOne{builder}
+}
+
+// Query returns a query builder for BalanceSnapshot.
+func (c *BalanceSnapshotClient) Query() *BalanceSnapshotQuery {
+	return &BalanceSnapshotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBalanceSnapshot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BalanceSnapshot entity by its id.
+func (c *BalanceSnapshotClient) Get(ctx context.Context, id uuid.UUID) (*BalanceSnapshot, error) {
+	return c.Query().Where(balancesnapshot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BalanceSnapshotClient) GetX(ctx context.Context, id uuid.UUID) *BalanceSnapshot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a BalanceSnapshot.
+func (c *BalanceSnapshotClient) QueryAccount(_m *BalanceSnapshot) *AccountQuery {
 	query := (&AccountClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.From(balancesnapshot.Table, balancesnapshot.FieldID, id),
 			sqlgraph.To(account.Table, account.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, item.AccountsTable, item.AccountsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, balancesnapshot.AccountTable, balancesnapshot.AccountColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -644,15 +925,1416 @@ func (c *ItemClient) QueryAccounts(_m *Item) *AccountQuery {
 	return query
 }
 
-// QuerySyncCursor queries the sync_cursor edge of a Item.
-func (c *ItemClient) QuerySyncCursor(_m *Item) *SyncCursorQuery {
-	query := (&SyncCursorClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(item.Table, item.FieldID, id),
-			sqlgraph.To(synccursor.Table, synccursor.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, item.SyncCursorTable, item.SyncCursorColumn),
+// Hooks returns the client hooks.
+func (c *BalanceSnapshotClient) Hooks() []Hook {
+	return c.hooks.BalanceSnapshot
+}
+
+// Interceptors returns the client interceptors.
+func (c *BalanceSnapshotClient) Interceptors() []Interceptor {
+	return c.inters.BalanceSnapshot
+}
+
+func (c *BalanceSnapshotClient) mutate(ctx context.Context, m *BalanceSnapshotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BalanceSnapshotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BalanceSnapshotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BalanceSnapshotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BalanceSnapshotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BalanceSnapshot mutation op: %q", m.Op())
+	}
+}
+
+// BudgetClient is a client for the Budget schema.
+type BudgetClient struct {
+	config
+}
+
+// NewBudgetClient returns a client for the Budget from the given config.
+func NewBudgetClient(c config) *BudgetClient {
+	return &BudgetClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `budget.Hooks(f(g(h())))`.
+func (c *BudgetClient) Use(hooks ...Hook) {
+	c.hooks.Budget = append(c.hooks.Budget, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `budget.Intercept(f(g(h())))`.
+func (c *BudgetClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Budget = append(c.inters.Budget, interceptors...)
+}
+
+// Create returns a builder for creating a Budget entity.
+func (c *BudgetClient) Create() *BudgetCreate {
+	mutation := newBudgetMutation(c.config, OpCreate)
+	return &BudgetCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Budget entities.
+func (c *BudgetClient) CreateBulk(builders ...*BudgetCreate) *BudgetCreateBulk {
+	return &BudgetCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BudgetClient) MapCreateBulk(slice any, setFunc func(*BudgetCreate, int)) *BudgetCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BudgetCreateBulk{err: fmt.Errorf("calling to BudgetClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BudgetCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BudgetCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Budget.
+func (c *BudgetClient) Update() *BudgetUpdate {
+	mutation := newBudgetMutation(c.config, OpUpdate)
+	return &BudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BudgetClient) UpdateOne(_m *Budget) *BudgetUpdateOne {
+	mutation := newBudgetMutation(c.config, OpUpdateOne, withBudget(_m))
+	return &BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BudgetClient) UpdateOneID(id uuid.UUID) *BudgetUpdateOne {
+	mutation := newBudgetMutation(c.config, OpUpdateOne, withBudgetID(id))
+	return &BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Budget.
+func (c *BudgetClient) Delete() *BudgetDelete {
+	mutation := newBudgetMutation(c.config, OpDelete)
+	return &BudgetDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BudgetClient) DeleteOne(_m *Budget) *BudgetDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BudgetClient) DeleteOneID(id uuid.UUID) *BudgetDeleteOne {
+	builder := c.Delete().Where(budget.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BudgetDeleteOne{builder}
+}
+
+// Query returns a query builder for Budget.
+func (c *BudgetClient) Query() *BudgetQuery {
+	return &BudgetQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBudget},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Budget entity by its id.
+func (c *BudgetClient) Get(ctx context.Context, id uuid.UUID) (*Budget, error) {
+	return c.Query().Where(budget.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BudgetClient) GetX(ctx context.Context, id uuid.UUID) *Budget {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Budget.
+func (c *BudgetClient) QueryUser(_m *Budget) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budget.Table, budget.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budget.UserTable, budget.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFamily queries the family edge of a Budget.
+func (c *BudgetClient) QueryFamily(_m *Budget) *FamilyQuery {
+	query := (&FamilyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budget.Table, budget.FieldID, id),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budget.FamilyTable, budget.FamilyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAlerts queries the alerts edge of a Budget.
+func (c *BudgetClient) QueryAlerts(_m *Budget) *BudgetAlertQuery {
+	query := (&BudgetAlertClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budget.Table, budget.FieldID, id),
+			sqlgraph.To(budgetalert.Table, budgetalert.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, budget.AlertsTable, budget.AlertsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRules queries the rules edge of a Budget.
+func (c *BudgetClient) QueryRules(_m *Budget) *RuleQuery {
+	query := (&RuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budget.Table, budget.FieldID, id),
+			sqlgraph.To(rule.Table, rule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, budget.RulesTable, budget.RulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BudgetClient) Hooks() []Hook {
+	return c.hooks.Budget
+}
+
+// Interceptors returns the client interceptors.
+func (c *BudgetClient) Interceptors() []Interceptor {
+	return c.inters.Budget
+}
+
+func (c *BudgetClient) mutate(ctx context.Context, m *BudgetMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BudgetCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BudgetUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BudgetUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BudgetDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Budget mutation op: %q", m.Op())
+	}
+}
+
+// BudgetAlertClient is a client for the BudgetAlert schema.
+type BudgetAlertClient struct {
+	config
+}
+
+// NewBudgetAlertClient returns a client for the BudgetAlert from the given config.
+func NewBudgetAlertClient(c config) *BudgetAlertClient {
+	return &BudgetAlertClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `budgetalert.Hooks(f(g(h())))`.
+func (c *BudgetAlertClient) Use(hooks ...Hook) {
+	c.hooks.BudgetAlert = append(c.hooks.BudgetAlert, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `budgetalert.Intercept(f(g(h())))`.
+func (c *BudgetAlertClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BudgetAlert = append(c.inters.BudgetAlert, interceptors...)
+}
+
+// Create returns a builder for creating a BudgetAlert entity.
+func (c *BudgetAlertClient) Create() *BudgetAlertCreate {
+	mutation := newBudgetAlertMutation(c.config, OpCreate)
+	return &BudgetAlertCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BudgetAlert entities.
+func (c *BudgetAlertClient) CreateBulk(builders ...*BudgetAlertCreate) *BudgetAlertCreateBulk {
+	return &BudgetAlertCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BudgetAlertClient) MapCreateBulk(slice any, setFunc func(*BudgetAlertCreate, int)) *BudgetAlertCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BudgetAlertCreateBulk{err: fmt.Errorf("calling to BudgetAlertClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BudgetAlertCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BudgetAlertCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BudgetAlert.
+func (c *BudgetAlertClient) Update() *BudgetAlertUpdate {
+	mutation := newBudgetAlertMutation(c.config, OpUpdate)
+	return &BudgetAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BudgetAlertClient) UpdateOne(_m *BudgetAlert) *BudgetAlertUpdateOne {
+	mutation := newBudgetAlertMutation(c.config, OpUpdateOne, withBudgetAlert(_m))
+	return &BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BudgetAlertClient) UpdateOneID(id uuid.UUID) *BudgetAlertUpdateOne {
+	mutation := newBudgetAlertMutation(c.config, OpUpdateOne, withBudgetAlertID(id))
+	return &BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BudgetAlert.
+func (c *BudgetAlertClient) Delete() *BudgetAlertDelete {
+	mutation := newBudgetAlertMutation(c.config, OpDelete)
+	return &BudgetAlertDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BudgetAlertClient) DeleteOne(_m *BudgetAlert) *BudgetAlertDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BudgetAlertClient) DeleteOneID(id uuid.UUID) *BudgetAlertDeleteOne {
+	builder := c.Delete().Where(budgetalert.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BudgetAlertDeleteOne{builder}
+}
+
+// Query returns a query builder for BudgetAlert.
+func (c *BudgetAlertClient) Query() *BudgetAlertQuery {
+	return &BudgetAlertQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBudgetAlert},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BudgetAlert entity by its id.
+func (c *BudgetAlertClient) Get(ctx context.Context, id uuid.UUID) (*BudgetAlert, error) {
+	return c.Query().Where(budgetalert.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BudgetAlertClient) GetX(ctx context.Context, id uuid.UUID) *BudgetAlert {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryBudget queries the budget edge of a BudgetAlert.
+func (c *BudgetAlertClient) QueryBudget(_m *BudgetAlert) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(budgetalert.Table, budgetalert.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, budgetalert.BudgetTable, budgetalert.BudgetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BudgetAlertClient) Hooks() []Hook {
+	return c.hooks.BudgetAlert
+}
+
+// Interceptors returns the client interceptors.
+func (c *BudgetAlertClient) Interceptors() []Interceptor {
+	return c.inters.BudgetAlert
+}
+
+func (c *BudgetAlertClient) mutate(ctx context.Context, m *BudgetAlertMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BudgetAlertCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BudgetAlertUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BudgetAlertUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BudgetAlertDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BudgetAlert mutation op: %q", m.Op())
+	}
+}
+
+// FamilyClient is a client for the Family schema.
+type FamilyClient struct {
+	config
+}
+
+// NewFamilyClient returns a client for the Family from the given config.
+func NewFamilyClient(c config) *FamilyClient {
+	return &FamilyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `family.Hooks(f(g(h())))`.
+func (c *FamilyClient) Use(hooks ...Hook) {
+	c.hooks.Family = append(c.hooks.Family, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `family.Intercept(f(g(h())))`.
+func (c *FamilyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Family = append(c.inters.Family, interceptors...)
+}
+
+// Create returns a builder for creating a Family entity.
+func (c *FamilyClient) Create() *FamilyCreate {
+	mutation := newFamilyMutation(c.config, OpCreate)
+	return &FamilyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Family entities.
+func (c *FamilyClient) CreateBulk(builders ...*FamilyCreate) *FamilyCreateBulk {
+	return &FamilyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FamilyClient) MapCreateBulk(slice any, setFunc func(*FamilyCreate, int)) *FamilyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FamilyCreateBulk{err: fmt.Errorf("calling to FamilyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FamilyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FamilyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Family.
+func (c *FamilyClient) Update() *FamilyUpdate {
+	mutation := newFamilyMutation(c.config, OpUpdate)
+	return &FamilyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FamilyClient) UpdateOne(_m *Family) *FamilyUpdateOne {
+	mutation := newFamilyMutation(c.config, OpUpdateOne, withFamily(_m))
+	return &FamilyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FamilyClient) UpdateOneID(id uuid.UUID) *FamilyUpdateOne {
+	mutation := newFamilyMutation(c.config, OpUpdateOne, withFamilyID(id))
+	return &FamilyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Family.
+func (c *FamilyClient) Delete() *FamilyDelete {
+	mutation := newFamilyMutation(c.config, OpDelete)
+	return &FamilyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FamilyClient) DeleteOne(_m *Family) *FamilyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FamilyClient) DeleteOneID(id uuid.UUID) *FamilyDeleteOne {
+	builder := c.Delete().Where(family.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FamilyDeleteOne{builder}
+}
+
+// Query returns a query builder for Family.
+func (c *FamilyClient) Query() *FamilyQuery {
+	return &FamilyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFamily},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Family entity by its id.
+func (c *FamilyClient) Get(ctx context.Context, id uuid.UUID) (*Family, error) {
+	return c.Query().Where(family.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FamilyClient) GetX(ctx context.Context, id uuid.UUID) *Family {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySavingsAccount queries the savings_account edge of a Family.
+func (c *FamilyClient) QuerySavingsAccount(_m *Family) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(family.Table, family.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, family.SavingsAccountTable, family.SavingsAccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMembers queries the members edge of a Family.
+func (c *FamilyClient) QueryMembers(_m *Family) *FamilyMemberQuery {
+	query := (&FamilyMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(family.Table, family.FieldID, id),
+			sqlgraph.To(familymember.Table, familymember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, family.MembersTable, family.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRules queries the rules edge of a Family.
+func (c *FamilyClient) QueryRules(_m *Family) *RuleQuery {
+	query := (&RuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(family.Table, family.FieldID, id),
+			sqlgraph.To(rule.Table, rule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, family.RulesTable, family.RulesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRuleExecutions queries the rule_executions edge of a Family.
+func (c *FamilyClient) QueryRuleExecutions(_m *Family) *RuleExecutionQuery {
+	query := (&RuleExecutionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(family.Table, family.FieldID, id),
+			sqlgraph.To(ruleexecution.Table, ruleexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, family.RuleExecutionsTable, family.RuleExecutionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGoals queries the goals edge of a Family.
+func (c *FamilyClient) QueryGoals(_m *Family) *GoalQuery {
+	query := (&GoalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(family.Table, family.FieldID, id),
+			sqlgraph.To(goal.Table, goal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, family.GoalsTable, family.GoalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgets queries the budgets edge of a Family.
+func (c *FamilyClient) QueryBudgets(_m *Family) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(family.Table, family.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, family.BudgetsTable, family.BudgetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FamilyClient) Hooks() []Hook {
+	return c.hooks.Family
+}
+
+// Interceptors returns the client interceptors.
+func (c *FamilyClient) Interceptors() []Interceptor {
+	return c.inters.Family
+}
+
+func (c *FamilyClient) mutate(ctx context.Context, m *FamilyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FamilyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FamilyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FamilyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FamilyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Family mutation op: %q", m.Op())
+	}
+}
+
+// FamilyMemberClient is a client for the FamilyMember schema.
+type FamilyMemberClient struct {
+	config
+}
+
+// NewFamilyMemberClient returns a client for the FamilyMember from the given config.
+func NewFamilyMemberClient(c config) *FamilyMemberClient {
+	return &FamilyMemberClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `familymember.Hooks(f(g(h())))`.
+func (c *FamilyMemberClient) Use(hooks ...Hook) {
+	c.hooks.FamilyMember = append(c.hooks.FamilyMember, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `familymember.Intercept(f(g(h())))`.
+func (c *FamilyMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FamilyMember = append(c.inters.FamilyMember, interceptors...)
+}
+
+// Create returns a builder for creating a FamilyMember entity.
+func (c *FamilyMemberClient) Create() *FamilyMemberCreate {
+	mutation := newFamilyMemberMutation(c.config, OpCreate)
+	return &FamilyMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of FamilyMember entities.
+func (c *FamilyMemberClient) CreateBulk(builders ...*FamilyMemberCreate) *FamilyMemberCreateBulk {
+	return &FamilyMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *FamilyMemberClient) MapCreateBulk(slice any, setFunc func(*FamilyMemberCreate, int)) *FamilyMemberCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &FamilyMemberCreateBulk{err: fmt.Errorf("calling to FamilyMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*FamilyMemberCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &FamilyMemberCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for FamilyMember.
+func (c *FamilyMemberClient) Update() *FamilyMemberUpdate {
+	mutation := newFamilyMemberMutation(c.config, OpUpdate)
+	return &FamilyMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *FamilyMemberClient) UpdateOne(_m *FamilyMember) *FamilyMemberUpdateOne {
+	mutation := newFamilyMemberMutation(c.config, OpUpdateOne, withFamilyMember(_m))
+	return &FamilyMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *FamilyMemberClient) UpdateOneID(id uuid.UUID) *FamilyMemberUpdateOne {
+	mutation := newFamilyMemberMutation(c.config, OpUpdateOne, withFamilyMemberID(id))
+	return &FamilyMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for FamilyMember.
+func (c *FamilyMemberClient) Delete() *FamilyMemberDelete {
+	mutation := newFamilyMemberMutation(c.config, OpDelete)
+	return &FamilyMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *FamilyMemberClient) DeleteOne(_m *FamilyMember) *FamilyMemberDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *FamilyMemberClient) DeleteOneID(id uuid.UUID) *FamilyMemberDeleteOne {
+	builder := c.Delete().Where(familymember.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &FamilyMemberDeleteOne{builder}
+}
+
+// Query returns a query builder for FamilyMember.
+func (c *FamilyMemberClient) Query() *FamilyMemberQuery {
+	return &FamilyMemberQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeFamilyMember},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a FamilyMember entity by its id.
+func (c *FamilyMemberClient) Get(ctx context.Context, id uuid.UUID) (*FamilyMember, error) {
+	return c.Query().Where(familymember.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *FamilyMemberClient) GetX(ctx context.Context, id uuid.UUID) *FamilyMember {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFamily queries the family edge of a FamilyMember.
+func (c *FamilyMemberClient) QueryFamily(_m *FamilyMember) *FamilyQuery {
+	query := (&FamilyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(familymember.Table, familymember.FieldID, id),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, familymember.FamilyTable, familymember.FamilyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a FamilyMember.
+func (c *FamilyMemberClient) QueryUser(_m *FamilyMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(familymember.Table, familymember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, familymember.UserTable, familymember.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *FamilyMemberClient) Hooks() []Hook {
+	return c.hooks.FamilyMember
+}
+
+// Interceptors returns the client interceptors.
+func (c *FamilyMemberClient) Interceptors() []Interceptor {
+	return c.inters.FamilyMember
+}
+
+func (c *FamilyMemberClient) mutate(ctx context.Context, m *FamilyMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FamilyMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FamilyMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FamilyMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FamilyMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FamilyMember mutation op: %q", m.Op())
+	}
+}
+
+// GoalClient is a client for the Goal schema.
+type GoalClient struct {
+	config
+}
+
+// NewGoalClient returns a client for the Goal from the given config.
+func NewGoalClient(c config) *GoalClient {
+	return &GoalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `goal.Hooks(f(g(h())))`.
+func (c *GoalClient) Use(hooks ...Hook) {
+	c.hooks.Goal = append(c.hooks.Goal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `goal.Intercept(f(g(h())))`.
+func (c *GoalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Goal = append(c.inters.Goal, interceptors...)
+}
+
+// Create returns a builder for creating a Goal entity.
+func (c *GoalClient) Create() *GoalCreate {
+	mutation := newGoalMutation(c.config, OpCreate)
+	return &GoalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Goal entities.
+func (c *GoalClient) CreateBulk(builders ...*GoalCreate) *GoalCreateBulk {
+	return &GoalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *GoalClient) MapCreateBulk(slice any, setFunc func(*GoalCreate, int)) *GoalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &GoalCreateBulk{err: fmt.Errorf("calling to GoalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*GoalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &GoalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Goal.
+func (c *GoalClient) Update() *GoalUpdate {
+	mutation := newGoalMutation(c.config, OpUpdate)
+	return &GoalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *GoalClient) UpdateOne(_m *Goal) *GoalUpdateOne {
+	mutation := newGoalMutation(c.config, OpUpdateOne, withGoal(_m))
+	return &GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *GoalClient) UpdateOneID(id uuid.UUID) *GoalUpdateOne {
+	mutation := newGoalMutation(c.config, OpUpdateOne, withGoalID(id))
+	return &GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Goal.
+func (c *GoalClient) Delete() *GoalDelete {
+	mutation := newGoalMutation(c.config, OpDelete)
+	return &GoalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *GoalClient) DeleteOne(_m *Goal) *GoalDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *GoalClient) DeleteOneID(id uuid.UUID) *GoalDeleteOne {
+	builder := c.Delete().Where(goal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &GoalDeleteOne{builder}
+}
+
+// Query returns a query builder for Goal.
+func (c *GoalClient) Query() *GoalQuery {
+	return &GoalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeGoal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Goal entity by its id.
+func (c *GoalClient) Get(ctx context.Context, id uuid.UUID) (*Goal, error) {
+	return c.Query().Where(goal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *GoalClient) GetX(ctx context.Context, id uuid.UUID) *Goal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Goal.
+func (c *GoalClient) QueryUser(_m *Goal) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goal.Table, goal.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, goal.UserTable, goal.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFamily queries the family edge of a Goal.
+func (c *GoalClient) QueryFamily(_m *Goal) *FamilyQuery {
+	query := (&FamilyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(goal.Table, goal.FieldID, id),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, goal.FamilyTable, goal.FamilyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *GoalClient) Hooks() []Hook {
+	return c.hooks.Goal
+}
+
+// Interceptors returns the client interceptors.
+func (c *GoalClient) Interceptors() []Interceptor {
+	return c.inters.Goal
+}
+
+func (c *GoalClient) mutate(ctx context.Context, m *GoalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&GoalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&GoalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&GoalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&GoalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Goal mutation op: %q", m.Op())
+	}
+}
+
+// ItemClient is a client for the Item schema.
+type ItemClient struct {
+	config
+}
+
+// NewItemClient returns a client for the Item from the given config.
+func NewItemClient(c config) *ItemClient {
+	return &ItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `item.Hooks(f(g(h())))`.
+func (c *ItemClient) Use(hooks ...Hook) {
+	c.hooks.Item = append(c.hooks.Item, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `item.Intercept(f(g(h())))`.
+func (c *ItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Item = append(c.inters.Item, interceptors...)
+}
+
+// Create returns a builder for creating a Item entity.
+func (c *ItemClient) Create() *ItemCreate {
+	mutation := newItemMutation(c.config, OpCreate)
+	return &ItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Item entities.
+func (c *ItemClient) CreateBulk(builders ...*ItemCreate) *ItemCreateBulk {
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ItemClient) MapCreateBulk(slice any, setFunc func(*ItemCreate, int)) *ItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ItemCreateBulk{err: fmt.Errorf("calling to ItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Item.
+func (c *ItemClient) Update() *ItemUpdate {
+	mutation := newItemMutation(c.config, OpUpdate)
+	return &ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ItemClient) UpdateOne(_m *Item) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItem(_m))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ItemClient) UpdateOneID(id uuid.UUID) *ItemUpdateOne {
+	mutation := newItemMutation(c.config, OpUpdateOne, withItemID(id))
+	return &ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Item.
+func (c *ItemClient) Delete() *ItemDelete {
+	mutation := newItemMutation(c.config, OpDelete)
+	return &ItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ItemClient) DeleteOne(_m *Item) *ItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ItemClient) DeleteOneID(id uuid.UUID) *ItemDeleteOne {
+	builder := c.Delete().Where(item.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ItemDeleteOne{builder}
+}
+
+// Query returns a query builder for Item.
+func (c *ItemClient) Query() *ItemQuery {
+	return &ItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Item entity by its id.
+func (c *ItemClient) Get(ctx context.Context, id uuid.UUID) (*Item, error) {
+	return c.Query().Where(item.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ItemClient) GetX(ctx context.Context, id uuid.UUID) *Item {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Item.
+func (c *ItemClient) QueryUser(_m *Item) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, item.UserTable, item.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAccounts queries the accounts edge of a Item.
+func (c *ItemClient) QueryAccounts(_m *Item) *AccountQuery {
+	query := (&AccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(account.Table, account.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.AccountsTable, item.AccountsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySyncCursor queries the sync_cursor edge of a Item.
+func (c *ItemClient) QuerySyncCursor(_m *Item) *SyncCursorQuery {
+	query := (&SyncCursorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(synccursor.Table, synccursor.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, item.SyncCursorTable, item.SyncCursorColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySyncRuns queries the sync_runs edge of a Item.
+func (c *ItemClient) QuerySyncRuns(_m *Item) *SyncRunQuery {
+	query := (&SyncRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(syncrun.Table, syncrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.SyncRunsTable, item.SyncRunsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBackfillJob queries the backfill_job edge of a Item.
+func (c *ItemClient) QueryBackfillJob(_m *Item) *BackfillJobQuery {
+	query := (&BackfillJobClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, id),
+			sqlgraph.To(backfilljob.Table, backfilljob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, item.BackfillJobTable, item.BackfillJobColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ItemClient) Hooks() []Hook {
+	hooks := c.hooks.Item
+	return append(hooks[:len(hooks):len(hooks)], item.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *ItemClient) Interceptors() []Interceptor {
+	return c.inters.Item
+}
+
+func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
+	}
+}
+
+// MonthlyReportClient is a client for the MonthlyReport schema.
+type MonthlyReportClient struct {
+	config
+}
+
+// NewMonthlyReportClient returns a client for the MonthlyReport from the given config.
+func NewMonthlyReportClient(c config) *MonthlyReportClient {
+	return &MonthlyReportClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `monthlyreport.Hooks(f(g(h())))`.
+func (c *MonthlyReportClient) Use(hooks ...Hook) {
+	c.hooks.MonthlyReport = append(c.hooks.MonthlyReport, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `monthlyreport.Intercept(f(g(h())))`.
+func (c *MonthlyReportClient) Intercept(interceptors ...Interceptor) {
+	c.inters.MonthlyReport = append(c.inters.MonthlyReport, interceptors...)
+}
+
+// Create returns a builder for creating a MonthlyReport entity.
+func (c *MonthlyReportClient) Create() *MonthlyReportCreate {
+	mutation := newMonthlyReportMutation(c.config, OpCreate)
+	return &MonthlyReportCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of MonthlyReport entities.
+func (c *MonthlyReportClient) CreateBulk(builders ...*MonthlyReportCreate) *MonthlyReportCreateBulk {
+	return &MonthlyReportCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *MonthlyReportClient) MapCreateBulk(slice any, setFunc func(*MonthlyReportCreate, int)) *MonthlyReportCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &MonthlyReportCreateBulk{err: fmt.Errorf("calling to MonthlyReportClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*MonthlyReportCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &MonthlyReportCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for MonthlyReport.
+func (c *MonthlyReportClient) Update() *MonthlyReportUpdate {
+	mutation := newMonthlyReportMutation(c.config, OpUpdate)
+	return &MonthlyReportUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *MonthlyReportClient) UpdateOne(_m *MonthlyReport) *MonthlyReportUpdateOne {
+	mutation := newMonthlyReportMutation(c.config, OpUpdateOne, withMonthlyReport(_m))
+	return &MonthlyReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *MonthlyReportClient) UpdateOneID(id uuid.UUID) *MonthlyReportUpdateOne {
+	mutation := newMonthlyReportMutation(c.config, OpUpdateOne, withMonthlyReportID(id))
+	return &MonthlyReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for MonthlyReport.
+func (c *MonthlyReportClient) Delete() *MonthlyReportDelete {
+	mutation := newMonthlyReportMutation(c.config, OpDelete)
+	return &MonthlyReportDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *MonthlyReportClient) DeleteOne(_m *MonthlyReport) *MonthlyReportDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *MonthlyReportClient) DeleteOneID(id uuid.UUID) *MonthlyReportDeleteOne {
+	builder := c.Delete().Where(monthlyreport.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &MonthlyReportDeleteOne{builder}
+}
+
+// Query returns a query builder for MonthlyReport.
+func (c *MonthlyReportClient) Query() *MonthlyReportQuery {
+	return &MonthlyReportQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeMonthlyReport},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a MonthlyReport entity by its id.
+func (c *MonthlyReportClient) Get(ctx context.Context, id uuid.UUID) (*MonthlyReport, error) {
+	return c.Query().Where(monthlyreport.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *MonthlyReportClient) GetX(ctx context.Context, id uuid.UUID) *MonthlyReport {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a MonthlyReport.
+func (c *MonthlyReportClient) QueryUser(_m *MonthlyReport) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(monthlyreport.Table, monthlyreport.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, monthlyreport.UserTable, monthlyreport.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *MonthlyReportClient) Hooks() []Hook {
+	return c.hooks.MonthlyReport
+}
+
+// Interceptors returns the client interceptors.
+func (c *MonthlyReportClient) Interceptors() []Interceptor {
+	return c.inters.MonthlyReport
+}
+
+func (c *MonthlyReportClient) mutate(ctx context.Context, m *MonthlyReportMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&MonthlyReportCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&MonthlyReportUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&MonthlyReportUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&MonthlyReportDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown MonthlyReport mutation op: %q", m.Op())
+	}
+}
+
+// PushSubscriptionClient is a client for the PushSubscription schema.
+type PushSubscriptionClient struct {
+	config
+}
+
+// NewPushSubscriptionClient returns a client for the PushSubscription from the given config.
+func NewPushSubscriptionClient(c config) *PushSubscriptionClient {
+	return &PushSubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `pushsubscription.Hooks(f(g(h())))`.
+func (c *PushSubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.PushSubscription = append(c.hooks.PushSubscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `pushsubscription.Intercept(f(g(h())))`.
+func (c *PushSubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PushSubscription = append(c.inters.PushSubscription, interceptors...)
+}
+
+// Create returns a builder for creating a PushSubscription entity.
+func (c *PushSubscriptionClient) Create() *PushSubscriptionCreate {
+	mutation := newPushSubscriptionMutation(c.config, OpCreate)
+	return &PushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PushSubscription entities.
+func (c *PushSubscriptionClient) CreateBulk(builders ...*PushSubscriptionCreate) *PushSubscriptionCreateBulk {
+	return &PushSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PushSubscriptionClient) MapCreateBulk(slice any, setFunc func(*PushSubscriptionCreate, int)) *PushSubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PushSubscriptionCreateBulk{err: fmt.Errorf("calling to PushSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PushSubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PushSubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PushSubscription.
+func (c *PushSubscriptionClient) Update() *PushSubscriptionUpdate {
+	mutation := newPushSubscriptionMutation(c.config, OpUpdate)
+	return &PushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PushSubscriptionClient) UpdateOne(_m *PushSubscription) *PushSubscriptionUpdateOne {
+	mutation := newPushSubscriptionMutation(c.config, OpUpdateOne, withPushSubscription(_m))
+	return &PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PushSubscriptionClient) UpdateOneID(id uuid.UUID) *PushSubscriptionUpdateOne {
+	mutation := newPushSubscriptionMutation(c.config, OpUpdateOne, withPushSubscriptionID(id))
+	return &PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PushSubscription.
+func (c *PushSubscriptionClient) Delete() *PushSubscriptionDelete {
+	mutation := newPushSubscriptionMutation(c.config, OpDelete)
+	return &PushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PushSubscriptionClient) DeleteOne(_m *PushSubscription) *PushSubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PushSubscriptionClient) DeleteOneID(id uuid.UUID) *PushSubscriptionDeleteOne {
+	builder := c.Delete().Where(pushsubscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PushSubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for PushSubscription.
+func (c *PushSubscriptionClient) Query() *PushSubscriptionQuery {
+	return &PushSubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePushSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PushSubscription entity by its id.
+func (c *PushSubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*PushSubscription, error) {
+	return c.Query().Where(pushsubscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PushSubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *PushSubscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a PushSubscription.
+func (c *PushSubscriptionClient) QueryUser(_m *PushSubscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(pushsubscription.Table, pushsubscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, pushsubscription.UserTable, pushsubscription.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -661,131 +2343,131 @@ func (c *ItemClient) QuerySyncCursor(_m *Item) *SyncCursorQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ItemClient) Hooks() []Hook {
-	return c.hooks.Item
+func (c *PushSubscriptionClient) Hooks() []Hook {
+	return c.hooks.PushSubscription
 }
 
 // Interceptors returns the client interceptors.
-func (c *ItemClient) Interceptors() []Interceptor {
-	return c.inters.Item
+func (c *PushSubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.PushSubscription
 }
 
-func (c *ItemClient) mutate(ctx context.Context, m *ItemMutation) (Value, error) {
+func (c *PushSubscriptionClient) mutate(ctx context.Context, m *PushSubscriptionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Item mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PushSubscription mutation op: %q", m.Op())
 	}
 }
 
-// PushSubscriptionClient is a client for the PushSubscription schema.
-type PushSubscriptionClient struct {
+// RecurringChargeClient is a client for the RecurringCharge schema.
+type RecurringChargeClient struct {
 	config
 }
 
-// NewPushSubscriptionClient returns a client for the PushSubscription from the given config.
-func NewPushSubscriptionClient(c config) *PushSubscriptionClient {
-	return &PushSubscriptionClient{config: c}
+// NewRecurringChargeClient returns a client for the RecurringCharge from the given config.
+func NewRecurringChargeClient(c config) *RecurringChargeClient {
+	return &RecurringChargeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `pushsubscription.Hooks(f(g(h())))`.
-func (c *PushSubscriptionClient) Use(hooks ...Hook) {
-	c.hooks.PushSubscription = append(c.hooks.PushSubscription, hooks...)
+// A call to `Use(f, g, h)` equals to `recurringcharge.Hooks(f(g(h())))`.
+func (c *RecurringChargeClient) Use(hooks ...Hook) {
+	c.hooks.RecurringCharge = append(c.hooks.RecurringCharge, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `pushsubscription.Intercept(f(g(h())))`.
-func (c *PushSubscriptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.PushSubscription = append(c.inters.PushSubscription, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `recurringcharge.Intercept(f(g(h())))`.
+func (c *RecurringChargeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RecurringCharge = append(c.inters.RecurringCharge, interceptors...)
 }
 
-// Create returns a builder for creating a PushSubscription entity.
-func (c *PushSubscriptionClient) Create() *PushSubscriptionCreate {
-	mutation := newPushSubscriptionMutation(c.config, OpCreate)
-	return &PushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a RecurringCharge entity.
+func (c *RecurringChargeClient) Create() *RecurringChargeCreate {
+	mutation := newRecurringChargeMutation(c.config, OpCreate)
+	return &RecurringChargeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of PushSubscription entities.
-func (c *PushSubscriptionClient) CreateBulk(builders ...*PushSubscriptionCreate) *PushSubscriptionCreateBulk {
-	return &PushSubscriptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of RecurringCharge entities.
+func (c *RecurringChargeClient) CreateBulk(builders ...*RecurringChargeCreate) *RecurringChargeCreateBulk {
+	return &RecurringChargeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PushSubscriptionClient) MapCreateBulk(slice any, setFunc func(*PushSubscriptionCreate, int)) *PushSubscriptionCreateBulk {
+func (c *RecurringChargeClient) MapCreateBulk(slice any, setFunc func(*RecurringChargeCreate, int)) *RecurringChargeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PushSubscriptionCreateBulk{err: fmt.Errorf("calling to PushSubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &RecurringChargeCreateBulk{err: fmt.Errorf("calling to RecurringChargeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PushSubscriptionCreate, rv.Len())
+	builders := make([]*RecurringChargeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PushSubscriptionCreateBulk{config: c.config, builders: builders}
+	return &RecurringChargeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for PushSubscription.
-func (c *PushSubscriptionClient) Update() *PushSubscriptionUpdate {
-	mutation := newPushSubscriptionMutation(c.config, OpUpdate)
-	return &PushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for RecurringCharge.
+func (c *RecurringChargeClient) Update() *RecurringChargeUpdate {
+	mutation := newRecurringChargeMutation(c.config, OpUpdate)
+	return &RecurringChargeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PushSubscriptionClient) UpdateOne(_m *PushSubscription) *PushSubscriptionUpdateOne {
-	mutation := newPushSubscriptionMutation(c.config, OpUpdateOne, withPushSubscription(_m))
-	return &PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RecurringChargeClient) UpdateOne(_m *RecurringCharge) *RecurringChargeUpdateOne {
+	mutation := newRecurringChargeMutation(c.config, OpUpdateOne, withRecurringCharge(_m))
+	return &RecurringChargeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PushSubscriptionClient) UpdateOneID(id uuid.UUID) *PushSubscriptionUpdateOne {
-	mutation := newPushSubscriptionMutation(c.config, OpUpdateOne, withPushSubscriptionID(id))
-	return &PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *RecurringChargeClient) UpdateOneID(id uuid.UUID) *RecurringChargeUpdateOne {
+	mutation := newRecurringChargeMutation(c.config, OpUpdateOne, withRecurringChargeID(id))
+	return &RecurringChargeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for PushSubscription.
-func (c *PushSubscriptionClient) Delete() *PushSubscriptionDelete {
-	mutation := newPushSubscriptionMutation(c.config, OpDelete)
-	return &PushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for RecurringCharge.
+func (c *RecurringChargeClient) Delete() *RecurringChargeDelete {
+	mutation := newRecurringChargeMutation(c.config, OpDelete)
+	return &RecurringChargeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PushSubscriptionClient) DeleteOne(_m *PushSubscription) *PushSubscriptionDeleteOne {
+func (c *RecurringChargeClient) DeleteOne(_m *RecurringCharge) *RecurringChargeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PushSubscriptionClient) DeleteOneID(id uuid.UUID) *PushSubscriptionDeleteOne {
-	builder := c.Delete().Where(pushsubscription.ID(id))
+func (c *RecurringChargeClient) DeleteOneID(id uuid.UUID) *RecurringChargeDeleteOne {
+	builder := c.Delete().Where(recurringcharge.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PushSubscriptionDeleteOne{builder}
+	return &RecurringChargeDeleteOne{builder}
 }
 
-// Query returns a query builder for PushSubscription.
-func (c *PushSubscriptionClient) Query() *PushSubscriptionQuery {
-	return &PushSubscriptionQuery{
+// Query returns a query builder for RecurringCharge.
+func (c *RecurringChargeClient) Query() *RecurringChargeQuery {
+	return &RecurringChargeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePushSubscription},
+		ctx:    &QueryContext{Type: TypeRecurringCharge},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PushSubscription entity by its id.
-func (c *PushSubscriptionClient) Get(ctx context.Context, id uuid.UUID) (*PushSubscription, error) {
-	return c.Query().Where(pushsubscription.ID(id)).Only(ctx)
+// Get returns a RecurringCharge entity by its id.
+func (c *RecurringChargeClient) Get(ctx context.Context, id uuid.UUID) (*RecurringCharge, error) {
+	return c.Query().Where(recurringcharge.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PushSubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *PushSubscription {
+func (c *RecurringChargeClient) GetX(ctx context.Context, id uuid.UUID) *RecurringCharge {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -793,15 +2475,15 @@ func (c *PushSubscriptionClient) GetX(ctx context.Context, id uuid.UUID) *PushSu
 	return obj
 }
 
-// QueryUser queries the user edge of a PushSubscription.
-func (c *PushSubscriptionClient) QueryUser(_m *PushSubscription) *UserQuery {
+// QueryUser queries the user edge of a RecurringCharge.
+func (c *RecurringChargeClient) QueryUser(_m *RecurringCharge) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(pushsubscription.Table, pushsubscription.FieldID, id),
+			sqlgraph.From(recurringcharge.Table, recurringcharge.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, pushsubscription.UserTable, pushsubscription.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, recurringcharge.UserTable, recurringcharge.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -810,27 +2492,27 @@ func (c *PushSubscriptionClient) QueryUser(_m *PushSubscription) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PushSubscriptionClient) Hooks() []Hook {
-	return c.hooks.PushSubscription
+func (c *RecurringChargeClient) Hooks() []Hook {
+	return c.hooks.RecurringCharge
 }
 
 // Interceptors returns the client interceptors.
-func (c *PushSubscriptionClient) Interceptors() []Interceptor {
-	return c.inters.PushSubscription
+func (c *RecurringChargeClient) Interceptors() []Interceptor {
+	return c.inters.RecurringCharge
 }
 
-func (c *PushSubscriptionClient) mutate(ctx context.Context, m *PushSubscriptionMutation) (Value, error) {
+func (c *RecurringChargeClient) mutate(ctx context.Context, m *RecurringChargeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PushSubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecurringChargeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PushSubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecurringChargeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PushSubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RecurringChargeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PushSubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RecurringChargeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PushSubscription mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RecurringCharge mutation op: %q", m.Op())
 	}
 }
 
@@ -974,6 +2656,38 @@ func (c *RuleClient) QueryTargetAccount(_m *Rule) *AccountQuery {
 	return query
 }
 
+// QueryFamily queries the family edge of a Rule.
+func (c *RuleClient) QueryFamily(_m *Rule) *FamilyQuery {
+	query := (&FamilyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rule.Table, rule.FieldID, id),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rule.FamilyTable, rule.FamilyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudget queries the budget edge of a Rule.
+func (c *RuleClient) QueryBudget(_m *Rule) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rule.Table, rule.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rule.BudgetTable, rule.BudgetColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryExecutions queries the executions edge of a Rule.
 func (c *RuleClient) QueryExecutions(_m *Rule) *RuleExecutionQuery {
 	query := (&RuleExecutionClient{config: c.config}).Query()
@@ -1171,6 +2885,22 @@ func (c *RuleExecutionClient) QueryUser(_m *RuleExecution) *UserQuery {
 	return query
 }
 
+// QueryFamily queries the family edge of a RuleExecution.
+func (c *RuleExecutionClient) QueryFamily(_m *RuleExecution) *FamilyQuery {
+	query := (&FamilyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ruleexecution.Table, ruleexecution.FieldID, id),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ruleexecution.FamilyTable, ruleexecution.FamilyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryTransfer queries the transfer edge of a RuleExecution.
 func (c *RuleExecutionClient) QueryTransfer(_m *RuleExecution) *SavingsTransferQuery {
 	query := (&SavingsTransferClient{config: c.config}).Query()
@@ -1558,6 +3288,155 @@ func (c *SyncCursorClient) mutate(ctx context.Context, m *SyncCursorMutation) (V
 	}
 }
 
+// SyncRunClient is a client for the SyncRun schema.
+type SyncRunClient struct {
+	config
+}
+
+// NewSyncRunClient returns a client for the SyncRun from the given config.
+func NewSyncRunClient(c config) *SyncRunClient {
+	return &SyncRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `syncrun.Hooks(f(g(h())))`.
+func (c *SyncRunClient) Use(hooks ...Hook) {
+	c.hooks.SyncRun = append(c.hooks.SyncRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `syncrun.Intercept(f(g(h())))`.
+func (c *SyncRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SyncRun = append(c.inters.SyncRun, interceptors...)
+}
+
+// Create returns a builder for creating a SyncRun entity.
+func (c *SyncRunClient) Create() *SyncRunCreate {
+	mutation := newSyncRunMutation(c.config, OpCreate)
+	return &SyncRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SyncRun entities.
+func (c *SyncRunClient) CreateBulk(builders ...*SyncRunCreate) *SyncRunCreateBulk {
+	return &SyncRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SyncRunClient) MapCreateBulk(slice any, setFunc func(*SyncRunCreate, int)) *SyncRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SyncRunCreateBulk{err: fmt.Errorf("calling to SyncRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SyncRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SyncRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SyncRun.
+func (c *SyncRunClient) Update() *SyncRunUpdate {
+	mutation := newSyncRunMutation(c.config, OpUpdate)
+	return &SyncRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SyncRunClient) UpdateOne(_m *SyncRun) *SyncRunUpdateOne {
+	mutation := newSyncRunMutation(c.config, OpUpdateOne, withSyncRun(_m))
+	return &SyncRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SyncRunClient) UpdateOneID(id uuid.UUID) *SyncRunUpdateOne {
+	mutation := newSyncRunMutation(c.config, OpUpdateOne, withSyncRunID(id))
+	return &SyncRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SyncRun.
+func (c *SyncRunClient) Delete() *SyncRunDelete {
+	mutation := newSyncRunMutation(c.config, OpDelete)
+	return &SyncRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SyncRunClient) DeleteOne(_m *SyncRun) *SyncRunDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SyncRunClient) DeleteOneID(id uuid.UUID) *SyncRunDeleteOne {
+	builder := c.Delete().Where(syncrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SyncRunDeleteOne{builder}
+}
+
+// Query returns a query builder for SyncRun.
+func (c *SyncRunClient) Query() *SyncRunQuery {
+	return &SyncRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSyncRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SyncRun entity by its id.
+func (c *SyncRunClient) Get(ctx context.Context, id uuid.UUID) (*SyncRun, error) {
+	return c.Query().Where(syncrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SyncRunClient) GetX(ctx context.Context, id uuid.UUID) *SyncRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryItem queries the item edge of a SyncRun.
+func (c *SyncRunClient) QueryItem(_m *SyncRun) *ItemQuery {
+	query := (&ItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(syncrun.Table, syncrun.FieldID, id),
+			sqlgraph.To(item.Table, item.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, syncrun.ItemTable, syncrun.ItemColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SyncRunClient) Hooks() []Hook {
+	return c.hooks.SyncRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *SyncRunClient) Interceptors() []Interceptor {
+	return c.inters.SyncRun
+}
+
+func (c *SyncRunClient) mutate(ctx context.Context, m *SyncRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SyncRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SyncRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SyncRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SyncRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SyncRun mutation op: %q", m.Op())
+	}
+}
+
 // TransactionClient is a client for the Transaction schema.
 type TransactionClient struct {
 	config
@@ -1959,6 +3838,86 @@ func (c *UserClient) QuerySavingsTransfers(_m *User) *SavingsTransferQuery {
 	return query
 }
 
+// QueryFamilyMembership queries the family_membership edge of a User.
+func (c *UserClient) QueryFamilyMembership(_m *User) *FamilyMemberQuery {
+	query := (&FamilyMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(familymember.Table, familymember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.FamilyMembershipTable, user.FamilyMembershipColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryGoals queries the goals edge of a User.
+func (c *UserClient) QueryGoals(_m *User) *GoalQuery {
+	query := (&GoalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(goal.Table, goal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GoalsTable, user.GoalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMonthlyReports queries the monthly_reports edge of a User.
+func (c *UserClient) QueryMonthlyReports(_m *User) *MonthlyReportQuery {
+	query := (&MonthlyReportClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(monthlyreport.Table, monthlyreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MonthlyReportsTable, user.MonthlyReportsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBudgets queries the budgets edge of a User.
+func (c *UserClient) QueryBudgets(_m *User) *BudgetQuery {
+	query := (&BudgetClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BudgetsTable, user.BudgetsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRecurringCharges queries the recurring_charges edge of a User.
+func (c *UserClient) QueryRecurringCharges(_m *User) *RecurringChargeQuery {
+	query := (&RecurringChargeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(recurringcharge.Table, recurringcharge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecurringChargesTable, user.RecurringChargesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1987,12 +3946,16 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Account, Item, PushSubscription, Rule, RuleExecution, SavingsTransfer,
-		SyncCursor, Transaction, User []ent.Hook
+		Account, BackfillJob, BalanceSnapshot, Budget, BudgetAlert, Family,
+		FamilyMember, Goal, Item, MonthlyReport, PushSubscription, RecurringCharge,
+		Rule, RuleExecution, SavingsTransfer, SyncCursor, SyncRun, Transaction,
+		User []ent.Hook
 	}
 	inters struct {
-		Account, Item, PushSubscription, Rule, RuleExecution, SavingsTransfer,
-		SyncCursor, Transaction, User []ent.Interceptor
+		Account, BackfillJob, BalanceSnapshot, Budget, BudgetAlert, Family,
+		FamilyMember, Goal, Item, MonthlyReport, PushSubscription, RecurringCharge,
+		Rule, RuleExecution, SavingsTransfer, SyncCursor, SyncRun, Transaction,
+		User []ent.Interceptor
 	}
 )
 
@@ -8,12 +8,22 @@ import (
 	"fmt"
 	"reflect"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/backfilljob"
+	"regulation/internal/ent/balancesnapshot"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/budgetalert"
+	"regulation/internal/ent/family"
+	"regulation/internal/ent/familymember"
+	"regulation/internal/ent/goal"
 	"regulation/internal/ent/item"
+	"regulation/internal/ent/monthlyreport"
 	"regulation/internal/ent/pushsubscription"
+	"regulation/internal/ent/recurringcharge"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/savingstransfer"
 	"regulation/internal/ent/synccursor"
+	"regulation/internal/ent/syncrun"
 	"regulation/internal/ent/transaction"
 	"regulation/internal/ent/user"
 	"sync"
@@ -82,12 +92,22 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			account.Table:          account.ValidColumn,
+			backfilljob.Table:      backfilljob.ValidColumn,
+			balancesnapshot.Table:  balancesnapshot.ValidColumn,
+			budget.Table:           budget.ValidColumn,
+			budgetalert.Table:      budgetalert.ValidColumn,
+			family.Table:           family.ValidColumn,
+			familymember.Table:     familymember.ValidColumn,
+			goal.Table:             goal.ValidColumn,
 			item.Table:             item.ValidColumn,
+			monthlyreport.Table:    monthlyreport.ValidColumn,
 			pushsubscription.Table: pushsubscription.ValidColumn,
+			recurringcharge.Table:  recurringcharge.ValidColumn,
 			rule.Table:             rule.ValidColumn,
 			ruleexecution.Table:    ruleexecution.ValidColumn,
 			savingstransfer.Table:  savingstransfer.ValidColumn,
 			synccursor.Table:       synccursor.ValidColumn,
+			syncrun.Table:          syncrun.ValidColumn,
 			transaction.Table:      transaction.ValidColumn,
 			user.Table:             user.ValidColumn,
 		})
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"regulation/internal/ent/backfilljob"
 	"regulation/internal/ent/item"
 	"regulation/internal/ent/synccursor"
 	"regulation/internal/ent/user"
@@ -22,14 +23,28 @@ type Item struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// FK to User who owns this connection
 	UserID uuid.UUID `json:"user_id,omitempty"`
-	// Plaid's unique identifier for this item
-	PlaidID string `json:"plaid_id,omitempty"`
-	// Encrypted Plaid access token for API calls
+	// Aggregator the item is linked through, such as plaid
+	Provider string `json:"provider,omitempty"`
+	// Aggregator's unique identifier for this item
+	ExternalID string `json:"external_id,omitempty"`
+	// Envelope-encrypted aggregator access token for API calls
 	AccessToken string `json:"-"`
+	// Key-encryption key the access token is sealed with, empty for legacy plaintext
+	AccessTokenKeyID string `json:"access_token_key_id,omitempty"`
 	// Name of the financial institution
 	InstitutionName string `json:"institution_name,omitempty"`
+	// Aggregator's institution ID; nil until looked up, empty if the aggregator does not know it
+	InstitutionID *string `json:"institution_id,omitempty"`
+	// Base64-encoded PNG logo of the institution
+	InstitutionLogo string `json:"institution_logo,omitempty"`
+	// Primary brand color of the institution as a hex code
+	InstitutionColor string `json:"institution_color,omitempty"`
+	// Homepage of the institution
+	InstitutionURL string `json:"institution_url,omitempty"`
 	// Whether this connection is still active
 	IsActive bool `json:"is_active,omitempty"`
+	// Sync health; login_required and error items are parked until a sync succeeds again
+	Status item.Status `json:"status,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -48,9 +63,13 @@ type ItemEdges struct {
 	Accounts []*Account `json:"accounts,omitempty"`
 	// SyncCursor holds the value of the sync_cursor edge.
 	SyncCursor *SyncCursor `json:"sync_cursor,omitempty"`
+	// SyncRuns holds the value of the sync_runs edge.
+	SyncRuns []*SyncRun `json:"sync_runs,omitempty"`
+	// BackfillJob holds the value of the backfill_job edge.
+	BackfillJob *BackfillJob `json:"backfill_job,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -84,6 +103,26 @@ func (e ItemEdges) SyncCursorOrErr() (*SyncCursor, error) {
 	return nil, &NotLoadedError{edge: "sync_cursor"}
 }
 
+// SyncRunsOrErr returns the SyncRuns value or an error if the edge
+// was not loaded in eager-loading.
+func (e ItemEdges) SyncRunsOrErr() ([]*SyncRun, error) {
+	if e.loadedTypes[3] {
+		return e.SyncRuns, nil
+	}
+	return nil, &NotLoadedError{edge: "sync_runs"}
+}
+
+// BackfillJobOrErr returns the BackfillJob value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ItemEdges) BackfillJobOrErr() (*BackfillJob, error) {
+	if e.BackfillJob != nil {
+		return e.BackfillJob, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: backfilljob.Label}
+	}
+	return nil, &NotLoadedError{edge: "backfill_job"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Item) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -91,12 +130,14 @@ func (*Item) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case item.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case item.FieldPlaidID, item.FieldAccessToken, item.FieldInstitutionName:
+		case item.FieldProvider, item.FieldExternalID, item.FieldAccessTokenKeyID, item.FieldInstitutionName, item.FieldInstitutionID, item.FieldInstitutionLogo, item.FieldInstitutionColor, item.FieldInstitutionURL, item.FieldStatus:
 			values[i] = new(sql.NullString)
 		case item.FieldCreatedAt, item.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case item.FieldID, item.FieldUserID:
 			values[i] = new(uuid.UUID)
+		case item.FieldAccessToken:
+			values[i] = item.ValueScanner.AccessToken.ScanValue()
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -124,17 +165,29 @@ func (_m *Item) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.UserID = *value
 			}
-		case item.FieldPlaidID:
+		case item.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case item.FieldExternalID:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field plaid_id", values[i])
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
 			} else if value.Valid {
-				_m.PlaidID = value.String
+				_m.ExternalID = value.String
 			}
 		case item.FieldAccessToken:
+			if value, err := item.ValueScanner.AccessToken.FromValue(values[i]); err != nil {
+				return err
+			} else {
+				_m.AccessToken = value
+			}
+		case item.FieldAccessTokenKeyID:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field access_token", values[i])
+				return fmt.Errorf("unexpected type %T for field access_token_key_id", values[i])
 			} else if value.Valid {
-				_m.AccessToken = value.String
+				_m.AccessTokenKeyID = value.String
 			}
 		case item.FieldInstitutionName:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -142,12 +195,43 @@ func (_m *Item) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.InstitutionName = value.String
 			}
+		case item.FieldInstitutionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field institution_id", values[i])
+			} else if value.Valid {
+				_m.InstitutionID = new(string)
+				*_m.InstitutionID = value.String
+			}
+		case item.FieldInstitutionLogo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field institution_logo", values[i])
+			} else if value.Valid {
+				_m.InstitutionLogo = value.String
+			}
+		case item.FieldInstitutionColor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field institution_color", values[i])
+			} else if value.Valid {
+				_m.InstitutionColor = value.String
+			}
+		case item.FieldInstitutionURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field institution_url", values[i])
+			} else if value.Valid {
+				_m.InstitutionURL = value.String
+			}
 		case item.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_active", values[i])
 			} else if value.Valid {
 				_m.IsActive = value.Bool
 			}
+		case item.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = item.Status(value.String)
+			}
 		case item.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -188,6 +272,16 @@ func (_m *Item) QuerySyncCursor() *SyncCursorQuery {
 	return NewItemClient(_m.config).QuerySyncCursor(_m)
 }
 
+// QuerySyncRuns queries the "sync_runs" edge of the Item entity.
+func (_m *Item) QuerySyncRuns() *SyncRunQuery {
+	return NewItemClient(_m.config).QuerySyncRuns(_m)
+}
+
+// QueryBackfillJob queries the "backfill_job" edge of the Item entity.
+func (_m *Item) QueryBackfillJob() *BackfillJobQuery {
+	return NewItemClient(_m.config).QueryBackfillJob(_m)
+}
+
 // Update returns a builder for updating this Item.
 // Note that you need to call Item.Unwrap() before calling this method if this Item
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -214,17 +308,40 @@ func (_m *Item) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
-	builder.WriteString("plaid_id=")
-	builder.WriteString(_m.PlaidID)
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
 	builder.WriteString(", ")
 	builder.WriteString("access_token=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("access_token_key_id=")
+	builder.WriteString(_m.AccessTokenKeyID)
+	builder.WriteString(", ")
 	builder.WriteString("institution_name=")
 	builder.WriteString(_m.InstitutionName)
 	builder.WriteString(", ")
+	if v := _m.InstitutionID; v != nil {
+		builder.WriteString("institution_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("institution_logo=")
+	builder.WriteString(_m.InstitutionLogo)
+	builder.WriteString(", ")
+	builder.WriteString("institution_color=")
+	builder.WriteString(_m.InstitutionColor)
+	builder.WriteString(", ")
+	builder.WriteString("institution_url=")
+	builder.WriteString(_m.InstitutionURL)
+	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,10 +3,13 @@
 package item
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
 )
 
@@ -17,14 +20,28 @@ const (
 	FieldID = "id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
-	// FieldPlaidID holds the string denoting the plaid_id field in the database.
-	FieldPlaidID = "plaid_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
 	// FieldAccessToken holds the string denoting the access_token field in the database.
 	FieldAccessToken = "access_token"
+	// FieldAccessTokenKeyID holds the string denoting the access_token_key_id field in the database.
+	FieldAccessTokenKeyID = "access_token_key_id"
 	// FieldInstitutionName holds the string denoting the institution_name field in the database.
 	FieldInstitutionName = "institution_name"
+	// FieldInstitutionID holds the string denoting the institution_id field in the database.
+	FieldInstitutionID = "institution_id"
+	// FieldInstitutionLogo holds the string denoting the institution_logo field in the database.
+	FieldInstitutionLogo = "institution_logo"
+	// FieldInstitutionColor holds the string denoting the institution_color field in the database.
+	FieldInstitutionColor = "institution_color"
+	// FieldInstitutionURL holds the string denoting the institution_url field in the database.
+	FieldInstitutionURL = "institution_url"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -35,6 +52,10 @@ const (
 	EdgeAccounts = "accounts"
 	// EdgeSyncCursor holds the string denoting the sync_cursor edge name in mutations.
 	EdgeSyncCursor = "sync_cursor"
+	// EdgeSyncRuns holds the string denoting the sync_runs edge name in mutations.
+	EdgeSyncRuns = "sync_runs"
+	// EdgeBackfillJob holds the string denoting the backfill_job edge name in mutations.
+	EdgeBackfillJob = "backfill_job"
 	// Table holds the table name of the item in the database.
 	Table = "items"
 	// UserTable is the table that holds the user relation/edge.
@@ -58,16 +79,37 @@ const (
 	SyncCursorInverseTable = "sync_cursors"
 	// SyncCursorColumn is the table column denoting the sync_cursor relation/edge.
 	SyncCursorColumn = "item_id"
+	// SyncRunsTable is the table that holds the sync_runs relation/edge.
+	SyncRunsTable = "sync_runs"
+	// SyncRunsInverseTable is the table name for the SyncRun entity.
+	// It exists in this package in order to avoid circular dependency with the "syncrun" package.
+	SyncRunsInverseTable = "sync_runs"
+	// SyncRunsColumn is the table column denoting the sync_runs relation/edge.
+	SyncRunsColumn = "item_id"
+	// BackfillJobTable is the table that holds the backfill_job relation/edge.
+	BackfillJobTable = "backfill_jobs"
+	// BackfillJobInverseTable is the table name for the BackfillJob entity.
+	// It exists in this package in order to avoid circular dependency with the "backfilljob" package.
+	BackfillJobInverseTable = "backfill_jobs"
+	// BackfillJobColumn is the table column denoting the backfill_job relation/edge.
+	BackfillJobColumn = "item_id"
 )
 
 // Columns holds all SQL columns for item fields.
 var Columns = []string{
 	FieldID,
 	FieldUserID,
-	FieldPlaidID,
+	FieldProvider,
+	FieldExternalID,
 	FieldAccessToken,
+	FieldAccessTokenKeyID,
 	FieldInstitutionName,
+	FieldInstitutionID,
+	FieldInstitutionLogo,
+	FieldInstitutionColor,
+	FieldInstitutionURL,
 	FieldIsActive,
+	FieldStatus,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -82,11 +124,23 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "regulation/internal/ent/runtime"
 var (
-	// PlaidIDValidator is a validator for the "plaid_id" field. It is called by the builders before save.
-	PlaidIDValidator func(string) error
+	Hooks [1]ent.Hook
+	// DefaultProvider holds the default value on creation for the "provider" field.
+	DefaultProvider string
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	ExternalIDValidator func(string) error
 	// AccessTokenValidator is a validator for the "access_token" field. It is called by the builders before save.
 	AccessTokenValidator func(string) error
+	// DefaultAccessTokenKeyID holds the default value on creation for the "access_token_key_id" field.
+	DefaultAccessTokenKeyID string
 	// InstitutionNameValidator is a validator for the "institution_name" field. It is called by the builders before save.
 	InstitutionNameValidator func(string) error
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
@@ -99,8 +153,41 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
+	// ValueScanner of all Item fields.
+	ValueScanner struct {
+		AccessToken field.TypeValueScanner[string]
+	}
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusHealthy is the default value of the Status enum.
+const DefaultStatus = StatusHealthy
+
+// Status values.
+const (
+	StatusHealthy         Status = "healthy"
+	StatusLoginRequired   Status = "login_required"
+	StatusInstitutionDown Status = "institution_down"
+	StatusRateLimited     Status = "rate_limited"
+	StatusError           Status = "error"
 )
 
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusHealthy, StatusLoginRequired, StatusInstitutionDown, StatusRateLimited, StatusError:
+		return nil
+	default:
+		return fmt.Errorf("item: invalid enum value for status field: %q", s)
+	}
+}
+
 // OrderOption defines the ordering options for the Item queries.
 type OrderOption func(*sql.Selector)
 
@@ -114,9 +201,14 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
-// ByPlaidID orders the results by the plaid_id field.
-func ByPlaidID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldPlaidID, opts...).ToFunc()
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
 }
 
 // ByAccessToken orders the results by the access_token field.
@@ -124,16 +216,46 @@ func ByAccessToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccessToken, opts...).ToFunc()
 }
 
+// ByAccessTokenKeyID orders the results by the access_token_key_id field.
+func ByAccessTokenKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessTokenKeyID, opts...).ToFunc()
+}
+
 // ByInstitutionName orders the results by the institution_name field.
 func ByInstitutionName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldInstitutionName, opts...).ToFunc()
 }
 
+// ByInstitutionID orders the results by the institution_id field.
+func ByInstitutionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstitutionID, opts...).ToFunc()
+}
+
+// ByInstitutionLogo orders the results by the institution_logo field.
+func ByInstitutionLogo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstitutionLogo, opts...).ToFunc()
+}
+
+// ByInstitutionColor orders the results by the institution_color field.
+func ByInstitutionColor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstitutionColor, opts...).ToFunc()
+}
+
+// ByInstitutionURL orders the results by the institution_url field.
+func ByInstitutionURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInstitutionURL, opts...).ToFunc()
+}
+
 // ByIsActive orders the results by the is_active field.
 func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -171,6 +293,27 @@ func BySyncCursorField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSyncCursorStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// BySyncRunsCount orders the results by sync_runs count.
+func BySyncRunsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSyncRunsStep(), opts...)
+	}
+}
+
+// BySyncRuns orders the results by sync_runs terms.
+func BySyncRuns(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSyncRunsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBackfillJobField orders the results by backfill_job field.
+func ByBackfillJobField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBackfillJobStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -192,3 +335,17 @@ func newSyncCursorStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, SyncCursorTable, SyncCursorColumn),
 	)
 }
+func newSyncRunsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SyncRunsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SyncRunsTable, SyncRunsColumn),
+	)
+}
+func newBackfillJobStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BackfillJobInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, BackfillJobTable, BackfillJobColumn),
+	)
+}
@@ -3,6 +3,7 @@
 package item
 
 import (
+	"fmt"
 	"regulation/internal/ent/predicate"
 	"time"
 
@@ -61,14 +62,25 @@ func UserID(v uuid.UUID) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldUserID, v))
 }
 
-// PlaidID applies equality check predicate on the "plaid_id" field. It's identical to PlaidIDEQ.
-func PlaidID(v string) predicate.Item {
-	return predicate.Item(sql.FieldEQ(FieldPlaidID, v))
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldProvider, v))
+}
+
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldExternalID, v))
 }
 
 // AccessToken applies equality check predicate on the "access_token" field. It's identical to AccessTokenEQ.
 func AccessToken(v string) predicate.Item {
-	return predicate.Item(sql.FieldEQ(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	return predicate.ItemOrErr(sql.FieldEQ(FieldAccessToken, vc), err)
+}
+
+// AccessTokenKeyID applies equality check predicate on the "access_token_key_id" field. It's identical to AccessTokenKeyIDEQ.
+func AccessTokenKeyID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAccessTokenKeyID, v))
 }
 
 // InstitutionName applies equality check predicate on the "institution_name" field. It's identical to InstitutionNameEQ.
@@ -76,6 +88,26 @@ func InstitutionName(v string) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldInstitutionName, v))
 }
 
+// InstitutionID applies equality check predicate on the "institution_id" field. It's identical to InstitutionIDEQ.
+func InstitutionID(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldInstitutionID, v))
+}
+
+// InstitutionLogo applies equality check predicate on the "institution_logo" field. It's identical to InstitutionLogoEQ.
+func InstitutionLogo(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldInstitutionLogo, v))
+}
+
+// InstitutionColor applies equality check predicate on the "institution_color" field. It's identical to InstitutionColorEQ.
+func InstitutionColor(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldInstitutionColor, v))
+}
+
+// InstitutionURL applies equality check predicate on the "institution_url" field. It's identical to InstitutionURLEQ.
+func InstitutionURL(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldInstitutionURL, v))
+}
+
 // IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
 func IsActive(v bool) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldIsActive, v))
@@ -111,134 +143,313 @@ func UserIDNotIn(vs ...uuid.UUID) predicate.Item {
 	return predicate.Item(sql.FieldNotIn(FieldUserID, vs...))
 }
 
-// PlaidIDEQ applies the EQ predicate on the "plaid_id" field.
-func PlaidIDEQ(v string) predicate.Item {
-	return predicate.Item(sql.FieldEQ(FieldPlaidID, v))
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldProvider, v))
 }
 
-// PlaidIDNEQ applies the NEQ predicate on the "plaid_id" field.
-func PlaidIDNEQ(v string) predicate.Item {
-	return predicate.Item(sql.FieldNEQ(FieldPlaidID, v))
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldProvider, v))
 }
 
-// PlaidIDIn applies the In predicate on the "plaid_id" field.
-func PlaidIDIn(vs ...string) predicate.Item {
-	return predicate.Item(sql.FieldIn(FieldPlaidID, vs...))
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldProvider, vs...))
 }
 
-// PlaidIDNotIn applies the NotIn predicate on the "plaid_id" field.
-func PlaidIDNotIn(vs ...string) predicate.Item {
-	return predicate.Item(sql.FieldNotIn(FieldPlaidID, vs...))
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldProvider, vs...))
 }
 
-// PlaidIDGT applies the GT predicate on the "plaid_id" field.
-func PlaidIDGT(v string) predicate.Item {
-	return predicate.Item(sql.FieldGT(FieldPlaidID, v))
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldProvider, v))
 }
 
-// PlaidIDGTE applies the GTE predicate on the "plaid_id" field.
-func PlaidIDGTE(v string) predicate.Item {
-	return predicate.Item(sql.FieldGTE(FieldPlaidID, v))
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldProvider, v))
 }
 
-// PlaidIDLT applies the LT predicate on the "plaid_id" field.
-func PlaidIDLT(v string) predicate.Item {
-	return predicate.Item(sql.FieldLT(FieldPlaidID, v))
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldProvider, v))
 }
 
-// PlaidIDLTE applies the LTE predicate on the "plaid_id" field.
-func PlaidIDLTE(v string) predicate.Item {
-	return predicate.Item(sql.FieldLTE(FieldPlaidID, v))
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldProvider, v))
 }
 
-// PlaidIDContains applies the Contains predicate on the "plaid_id" field.
-func PlaidIDContains(v string) predicate.Item {
-	return predicate.Item(sql.FieldContains(FieldPlaidID, v))
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldProvider, v))
 }
 
-// PlaidIDHasPrefix applies the HasPrefix predicate on the "plaid_id" field.
-func PlaidIDHasPrefix(v string) predicate.Item {
-	return predicate.Item(sql.FieldHasPrefix(FieldPlaidID, v))
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldProvider, v))
 }
 
-// PlaidIDHasSuffix applies the HasSuffix predicate on the "plaid_id" field.
-func PlaidIDHasSuffix(v string) predicate.Item {
-	return predicate.Item(sql.FieldHasSuffix(FieldPlaidID, v))
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldProvider, v))
 }
 
-// PlaidIDEqualFold applies the EqualFold predicate on the "plaid_id" field.
-func PlaidIDEqualFold(v string) predicate.Item {
-	return predicate.Item(sql.FieldEqualFold(FieldPlaidID, v))
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldProvider, v))
 }
 
-// PlaidIDContainsFold applies the ContainsFold predicate on the "plaid_id" field.
-func PlaidIDContainsFold(v string) predicate.Item {
-	return predicate.Item(sql.FieldContainsFold(FieldPlaidID, v))
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldExternalID, v))
+}
+
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldExternalID, v))
+}
+
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldExternalID, vs...))
+}
+
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldExternalID, vs...))
+}
+
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldExternalID, v))
+}
+
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldExternalID, v))
+}
+
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldExternalID, v))
+}
+
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldExternalID, v))
+}
+
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldExternalID, v))
+}
+
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldExternalID, v))
+}
+
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldExternalID, v))
+}
+
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldExternalID, v))
+}
+
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldExternalID, v))
 }
 
 // AccessTokenEQ applies the EQ predicate on the "access_token" field.
 func AccessTokenEQ(v string) predicate.Item {
-	return predicate.Item(sql.FieldEQ(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	return predicate.ItemOrErr(sql.FieldEQ(FieldAccessToken, vc), err)
 }
 
 // AccessTokenNEQ applies the NEQ predicate on the "access_token" field.
 func AccessTokenNEQ(v string) predicate.Item {
-	return predicate.Item(sql.FieldNEQ(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	return predicate.ItemOrErr(sql.FieldNEQ(FieldAccessToken, vc), err)
 }
 
 // AccessTokenIn applies the In predicate on the "access_token" field.
 func AccessTokenIn(vs ...string) predicate.Item {
-	return predicate.Item(sql.FieldIn(FieldAccessToken, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.AccessToken.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.ItemOrErr(sql.FieldIn(FieldAccessToken, v...), err)
 }
 
 // AccessTokenNotIn applies the NotIn predicate on the "access_token" field.
 func AccessTokenNotIn(vs ...string) predicate.Item {
-	return predicate.Item(sql.FieldNotIn(FieldAccessToken, vs...))
+	var (
+		err error
+		v   = make([]any, len(vs))
+	)
+	for i := range v {
+		if v[i], err = ValueScanner.AccessToken.Value(vs[i]); err != nil {
+			break
+		}
+	}
+	return predicate.ItemOrErr(sql.FieldNotIn(FieldAccessToken, v...), err)
 }
 
 // AccessTokenGT applies the GT predicate on the "access_token" field.
 func AccessTokenGT(v string) predicate.Item {
-	return predicate.Item(sql.FieldGT(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	return predicate.ItemOrErr(sql.FieldGT(FieldAccessToken, vc), err)
 }
 
 // AccessTokenGTE applies the GTE predicate on the "access_token" field.
 func AccessTokenGTE(v string) predicate.Item {
-	return predicate.Item(sql.FieldGTE(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	return predicate.ItemOrErr(sql.FieldGTE(FieldAccessToken, vc), err)
 }
 
 // AccessTokenLT applies the LT predicate on the "access_token" field.
 func AccessTokenLT(v string) predicate.Item {
-	return predicate.Item(sql.FieldLT(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	return predicate.ItemOrErr(sql.FieldLT(FieldAccessToken, vc), err)
 }
 
 // AccessTokenLTE applies the LTE predicate on the "access_token" field.
 func AccessTokenLTE(v string) predicate.Item {
-	return predicate.Item(sql.FieldLTE(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	return predicate.ItemOrErr(sql.FieldLTE(FieldAccessToken, vc), err)
 }
 
 // AccessTokenContains applies the Contains predicate on the "access_token" field.
 func AccessTokenContains(v string) predicate.Item {
-	return predicate.Item(sql.FieldContains(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("access_token value is not a string: %T", vc)
+	}
+	return predicate.ItemOrErr(sql.FieldContains(FieldAccessToken, vcs), err)
 }
 
 // AccessTokenHasPrefix applies the HasPrefix predicate on the "access_token" field.
 func AccessTokenHasPrefix(v string) predicate.Item {
-	return predicate.Item(sql.FieldHasPrefix(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("access_token value is not a string: %T", vc)
+	}
+	return predicate.ItemOrErr(sql.FieldHasPrefix(FieldAccessToken, vcs), err)
 }
 
 // AccessTokenHasSuffix applies the HasSuffix predicate on the "access_token" field.
 func AccessTokenHasSuffix(v string) predicate.Item {
-	return predicate.Item(sql.FieldHasSuffix(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("access_token value is not a string: %T", vc)
+	}
+	return predicate.ItemOrErr(sql.FieldHasSuffix(FieldAccessToken, vcs), err)
 }
 
 // AccessTokenEqualFold applies the EqualFold predicate on the "access_token" field.
 func AccessTokenEqualFold(v string) predicate.Item {
-	return predicate.Item(sql.FieldEqualFold(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("access_token value is not a string: %T", vc)
+	}
+	return predicate.ItemOrErr(sql.FieldEqualFold(FieldAccessToken, vcs), err)
 }
 
 // AccessTokenContainsFold applies the ContainsFold predicate on the "access_token" field.
 func AccessTokenContainsFold(v string) predicate.Item {
-	return predicate.Item(sql.FieldContainsFold(FieldAccessToken, v))
+	vc, err := ValueScanner.AccessToken.Value(v)
+	vcs, ok := vc.(string)
+	if err == nil && !ok {
+		err = fmt.Errorf("access_token value is not a string: %T", vc)
+	}
+	return predicate.ItemOrErr(sql.FieldContainsFold(FieldAccessToken, vcs), err)
+}
+
+// AccessTokenKeyIDEQ applies the EQ predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDNEQ applies the NEQ predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDIn applies the In predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldAccessTokenKeyID, vs...))
+}
+
+// AccessTokenKeyIDNotIn applies the NotIn predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldAccessTokenKeyID, vs...))
+}
+
+// AccessTokenKeyIDGT applies the GT predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDGTE applies the GTE predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDLT applies the LT predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDLTE applies the LTE predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDContains applies the Contains predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDHasPrefix applies the HasPrefix predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDHasSuffix applies the HasSuffix predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDEqualFold applies the EqualFold predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldAccessTokenKeyID, v))
+}
+
+// AccessTokenKeyIDContainsFold applies the ContainsFold predicate on the "access_token_key_id" field.
+func AccessTokenKeyIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldAccessTokenKeyID, v))
 }
 
 // InstitutionNameEQ applies the EQ predicate on the "institution_name" field.
@@ -306,6 +517,306 @@ func InstitutionNameContainsFold(v string) predicate.Item {
 	return predicate.Item(sql.FieldContainsFold(FieldInstitutionName, v))
 }
 
+// InstitutionIDEQ applies the EQ predicate on the "institution_id" field.
+func InstitutionIDEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldInstitutionID, v))
+}
+
+// InstitutionIDNEQ applies the NEQ predicate on the "institution_id" field.
+func InstitutionIDNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldInstitutionID, v))
+}
+
+// InstitutionIDIn applies the In predicate on the "institution_id" field.
+func InstitutionIDIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldInstitutionID, vs...))
+}
+
+// InstitutionIDNotIn applies the NotIn predicate on the "institution_id" field.
+func InstitutionIDNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldInstitutionID, vs...))
+}
+
+// InstitutionIDGT applies the GT predicate on the "institution_id" field.
+func InstitutionIDGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldInstitutionID, v))
+}
+
+// InstitutionIDGTE applies the GTE predicate on the "institution_id" field.
+func InstitutionIDGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldInstitutionID, v))
+}
+
+// InstitutionIDLT applies the LT predicate on the "institution_id" field.
+func InstitutionIDLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldInstitutionID, v))
+}
+
+// InstitutionIDLTE applies the LTE predicate on the "institution_id" field.
+func InstitutionIDLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldInstitutionID, v))
+}
+
+// InstitutionIDContains applies the Contains predicate on the "institution_id" field.
+func InstitutionIDContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldInstitutionID, v))
+}
+
+// InstitutionIDHasPrefix applies the HasPrefix predicate on the "institution_id" field.
+func InstitutionIDHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldInstitutionID, v))
+}
+
+// InstitutionIDHasSuffix applies the HasSuffix predicate on the "institution_id" field.
+func InstitutionIDHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldInstitutionID, v))
+}
+
+// InstitutionIDIsNil applies the IsNil predicate on the "institution_id" field.
+func InstitutionIDIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldInstitutionID))
+}
+
+// InstitutionIDNotNil applies the NotNil predicate on the "institution_id" field.
+func InstitutionIDNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldInstitutionID))
+}
+
+// InstitutionIDEqualFold applies the EqualFold predicate on the "institution_id" field.
+func InstitutionIDEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldInstitutionID, v))
+}
+
+// InstitutionIDContainsFold applies the ContainsFold predicate on the "institution_id" field.
+func InstitutionIDContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldInstitutionID, v))
+}
+
+// InstitutionLogoEQ applies the EQ predicate on the "institution_logo" field.
+func InstitutionLogoEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoNEQ applies the NEQ predicate on the "institution_logo" field.
+func InstitutionLogoNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoIn applies the In predicate on the "institution_logo" field.
+func InstitutionLogoIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldInstitutionLogo, vs...))
+}
+
+// InstitutionLogoNotIn applies the NotIn predicate on the "institution_logo" field.
+func InstitutionLogoNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldInstitutionLogo, vs...))
+}
+
+// InstitutionLogoGT applies the GT predicate on the "institution_logo" field.
+func InstitutionLogoGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoGTE applies the GTE predicate on the "institution_logo" field.
+func InstitutionLogoGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoLT applies the LT predicate on the "institution_logo" field.
+func InstitutionLogoLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoLTE applies the LTE predicate on the "institution_logo" field.
+func InstitutionLogoLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoContains applies the Contains predicate on the "institution_logo" field.
+func InstitutionLogoContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoHasPrefix applies the HasPrefix predicate on the "institution_logo" field.
+func InstitutionLogoHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoHasSuffix applies the HasSuffix predicate on the "institution_logo" field.
+func InstitutionLogoHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoIsNil applies the IsNil predicate on the "institution_logo" field.
+func InstitutionLogoIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldInstitutionLogo))
+}
+
+// InstitutionLogoNotNil applies the NotNil predicate on the "institution_logo" field.
+func InstitutionLogoNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldInstitutionLogo))
+}
+
+// InstitutionLogoEqualFold applies the EqualFold predicate on the "institution_logo" field.
+func InstitutionLogoEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldInstitutionLogo, v))
+}
+
+// InstitutionLogoContainsFold applies the ContainsFold predicate on the "institution_logo" field.
+func InstitutionLogoContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldInstitutionLogo, v))
+}
+
+// InstitutionColorEQ applies the EQ predicate on the "institution_color" field.
+func InstitutionColorEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldInstitutionColor, v))
+}
+
+// InstitutionColorNEQ applies the NEQ predicate on the "institution_color" field.
+func InstitutionColorNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldInstitutionColor, v))
+}
+
+// InstitutionColorIn applies the In predicate on the "institution_color" field.
+func InstitutionColorIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldInstitutionColor, vs...))
+}
+
+// InstitutionColorNotIn applies the NotIn predicate on the "institution_color" field.
+func InstitutionColorNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldInstitutionColor, vs...))
+}
+
+// InstitutionColorGT applies the GT predicate on the "institution_color" field.
+func InstitutionColorGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldInstitutionColor, v))
+}
+
+// InstitutionColorGTE applies the GTE predicate on the "institution_color" field.
+func InstitutionColorGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldInstitutionColor, v))
+}
+
+// InstitutionColorLT applies the LT predicate on the "institution_color" field.
+func InstitutionColorLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldInstitutionColor, v))
+}
+
+// InstitutionColorLTE applies the LTE predicate on the "institution_color" field.
+func InstitutionColorLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldInstitutionColor, v))
+}
+
+// InstitutionColorContains applies the Contains predicate on the "institution_color" field.
+func InstitutionColorContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldInstitutionColor, v))
+}
+
+// InstitutionColorHasPrefix applies the HasPrefix predicate on the "institution_color" field.
+func InstitutionColorHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldInstitutionColor, v))
+}
+
+// InstitutionColorHasSuffix applies the HasSuffix predicate on the "institution_color" field.
+func InstitutionColorHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldInstitutionColor, v))
+}
+
+// InstitutionColorIsNil applies the IsNil predicate on the "institution_color" field.
+func InstitutionColorIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldInstitutionColor))
+}
+
+// InstitutionColorNotNil applies the NotNil predicate on the "institution_color" field.
+func InstitutionColorNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldInstitutionColor))
+}
+
+// InstitutionColorEqualFold applies the EqualFold predicate on the "institution_color" field.
+func InstitutionColorEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldInstitutionColor, v))
+}
+
+// InstitutionColorContainsFold applies the ContainsFold predicate on the "institution_color" field.
+func InstitutionColorContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldInstitutionColor, v))
+}
+
+// InstitutionURLEQ applies the EQ predicate on the "institution_url" field.
+func InstitutionURLEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldInstitutionURL, v))
+}
+
+// InstitutionURLNEQ applies the NEQ predicate on the "institution_url" field.
+func InstitutionURLNEQ(v string) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldInstitutionURL, v))
+}
+
+// InstitutionURLIn applies the In predicate on the "institution_url" field.
+func InstitutionURLIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldInstitutionURL, vs...))
+}
+
+// InstitutionURLNotIn applies the NotIn predicate on the "institution_url" field.
+func InstitutionURLNotIn(vs ...string) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldInstitutionURL, vs...))
+}
+
+// InstitutionURLGT applies the GT predicate on the "institution_url" field.
+func InstitutionURLGT(v string) predicate.Item {
+	return predicate.Item(sql.FieldGT(FieldInstitutionURL, v))
+}
+
+// InstitutionURLGTE applies the GTE predicate on the "institution_url" field.
+func InstitutionURLGTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldGTE(FieldInstitutionURL, v))
+}
+
+// InstitutionURLLT applies the LT predicate on the "institution_url" field.
+func InstitutionURLLT(v string) predicate.Item {
+	return predicate.Item(sql.FieldLT(FieldInstitutionURL, v))
+}
+
+// InstitutionURLLTE applies the LTE predicate on the "institution_url" field.
+func InstitutionURLLTE(v string) predicate.Item {
+	return predicate.Item(sql.FieldLTE(FieldInstitutionURL, v))
+}
+
+// InstitutionURLContains applies the Contains predicate on the "institution_url" field.
+func InstitutionURLContains(v string) predicate.Item {
+	return predicate.Item(sql.FieldContains(FieldInstitutionURL, v))
+}
+
+// InstitutionURLHasPrefix applies the HasPrefix predicate on the "institution_url" field.
+func InstitutionURLHasPrefix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasPrefix(FieldInstitutionURL, v))
+}
+
+// InstitutionURLHasSuffix applies the HasSuffix predicate on the "institution_url" field.
+func InstitutionURLHasSuffix(v string) predicate.Item {
+	return predicate.Item(sql.FieldHasSuffix(FieldInstitutionURL, v))
+}
+
+// InstitutionURLIsNil applies the IsNil predicate on the "institution_url" field.
+func InstitutionURLIsNil() predicate.Item {
+	return predicate.Item(sql.FieldIsNull(FieldInstitutionURL))
+}
+
+// InstitutionURLNotNil applies the NotNil predicate on the "institution_url" field.
+func InstitutionURLNotNil() predicate.Item {
+	return predicate.Item(sql.FieldNotNull(FieldInstitutionURL))
+}
+
+// InstitutionURLEqualFold applies the EqualFold predicate on the "institution_url" field.
+func InstitutionURLEqualFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldEqualFold(FieldInstitutionURL, v))
+}
+
+// InstitutionURLContainsFold applies the ContainsFold predicate on the "institution_url" field.
+func InstitutionURLContainsFold(v string) predicate.Item {
+	return predicate.Item(sql.FieldContainsFold(FieldInstitutionURL, v))
+}
+
 // IsActiveEQ applies the EQ predicate on the "is_active" field.
 func IsActiveEQ(v bool) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldIsActive, v))
@@ -316,6 +827,26 @@ func IsActiveNEQ(v bool) predicate.Item {
 	return predicate.Item(sql.FieldNEQ(FieldIsActive, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Item {
+	return predicate.Item(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Item {
+	return predicate.Item(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Item {
+	return predicate.Item(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Item {
+	return predicate.Item(sql.FieldNotIn(FieldStatus, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Item {
 	return predicate.Item(sql.FieldEQ(FieldCreatedAt, v))
@@ -465,6 +996,52 @@ func HasSyncCursorWith(preds ...predicate.SyncCursor) predicate.Item {
 	})
 }
 
+// HasSyncRuns applies the HasEdge predicate on the "sync_runs" edge.
+func HasSyncRuns() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SyncRunsTable, SyncRunsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSyncRunsWith applies the HasEdge predicate on the "sync_runs" edge with a given conditions (other predicates).
+func HasSyncRunsWith(preds ...predicate.SyncRun) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newSyncRunsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBackfillJob applies the HasEdge predicate on the "backfill_job" edge.
+func HasBackfillJob() predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, BackfillJobTable, BackfillJobColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBackfillJobWith applies the HasEdge predicate on the "backfill_job" edge with a given conditions (other predicates).
+func HasBackfillJobWith(preds ...predicate.BackfillJob) predicate.Item {
+	return predicate.Item(func(s *sql.Selector) {
+		step := newBackfillJobStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Item) predicate.Item {
 	return predicate.Item(sql.AndPredicates(predicates...))
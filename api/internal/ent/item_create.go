@@ -7,8 +7,10 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/backfilljob"
 	"regulation/internal/ent/item"
 	"regulation/internal/ent/synccursor"
+	"regulation/internal/ent/syncrun"
 	"regulation/internal/ent/user"
 	"time"
 
@@ -33,9 +35,23 @@ func (_c *ItemCreate) SetUserID(v uuid.UUID) *ItemCreate {
 	return _c
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_c *ItemCreate) SetPlaidID(v string) *ItemCreate {
-	_c.mutation.SetPlaidID(v)
+// SetProvider sets the "provider" field.
+func (_c *ItemCreate) SetProvider(v string) *ItemCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableProvider(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetProvider(*v)
+	}
+	return _c
+}
+
+// SetExternalID sets the "external_id" field.
+func (_c *ItemCreate) SetExternalID(v string) *ItemCreate {
+	_c.mutation.SetExternalID(v)
 	return _c
 }
 
@@ -45,12 +61,82 @@ func (_c *ItemCreate) SetAccessToken(v string) *ItemCreate {
 	return _c
 }
 
+// SetAccessTokenKeyID sets the "access_token_key_id" field.
+func (_c *ItemCreate) SetAccessTokenKeyID(v string) *ItemCreate {
+	_c.mutation.SetAccessTokenKeyID(v)
+	return _c
+}
+
+// SetNillableAccessTokenKeyID sets the "access_token_key_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableAccessTokenKeyID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetAccessTokenKeyID(*v)
+	}
+	return _c
+}
+
 // SetInstitutionName sets the "institution_name" field.
 func (_c *ItemCreate) SetInstitutionName(v string) *ItemCreate {
 	_c.mutation.SetInstitutionName(v)
 	return _c
 }
 
+// SetInstitutionID sets the "institution_id" field.
+func (_c *ItemCreate) SetInstitutionID(v string) *ItemCreate {
+	_c.mutation.SetInstitutionID(v)
+	return _c
+}
+
+// SetNillableInstitutionID sets the "institution_id" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableInstitutionID(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetInstitutionID(*v)
+	}
+	return _c
+}
+
+// SetInstitutionLogo sets the "institution_logo" field.
+func (_c *ItemCreate) SetInstitutionLogo(v string) *ItemCreate {
+	_c.mutation.SetInstitutionLogo(v)
+	return _c
+}
+
+// SetNillableInstitutionLogo sets the "institution_logo" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableInstitutionLogo(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetInstitutionLogo(*v)
+	}
+	return _c
+}
+
+// SetInstitutionColor sets the "institution_color" field.
+func (_c *ItemCreate) SetInstitutionColor(v string) *ItemCreate {
+	_c.mutation.SetInstitutionColor(v)
+	return _c
+}
+
+// SetNillableInstitutionColor sets the "institution_color" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableInstitutionColor(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetInstitutionColor(*v)
+	}
+	return _c
+}
+
+// SetInstitutionURL sets the "institution_url" field.
+func (_c *ItemCreate) SetInstitutionURL(v string) *ItemCreate {
+	_c.mutation.SetInstitutionURL(v)
+	return _c
+}
+
+// SetNillableInstitutionURL sets the "institution_url" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableInstitutionURL(v *string) *ItemCreate {
+	if v != nil {
+		_c.SetInstitutionURL(*v)
+	}
+	return _c
+}
+
 // SetIsActive sets the "is_active" field.
 func (_c *ItemCreate) SetIsActive(v bool) *ItemCreate {
 	_c.mutation.SetIsActive(v)
@@ -65,6 +151,20 @@ func (_c *ItemCreate) SetNillableIsActive(v *bool) *ItemCreate {
 	return _c
 }
 
+// SetStatus sets the "status" field.
+func (_c *ItemCreate) SetStatus(v item.Status) *ItemCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *ItemCreate) SetNillableStatus(v *item.Status) *ItemCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ItemCreate) SetCreatedAt(v time.Time) *ItemCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -146,6 +246,40 @@ func (_c *ItemCreate) SetSyncCursor(v *SyncCursor) *ItemCreate {
 	return _c.SetSyncCursorID(v.ID)
 }
 
+// AddSyncRunIDs adds the "sync_runs" edge to the SyncRun entity by IDs.
+func (_c *ItemCreate) AddSyncRunIDs(ids ...uuid.UUID) *ItemCreate {
+	_c.mutation.AddSyncRunIDs(ids...)
+	return _c
+}
+
+// AddSyncRuns adds the "sync_runs" edges to the SyncRun entity.
+func (_c *ItemCreate) AddSyncRuns(v ...*SyncRun) *ItemCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSyncRunIDs(ids...)
+}
+
+// SetBackfillJobID sets the "backfill_job" edge to the BackfillJob entity by ID.
+func (_c *ItemCreate) SetBackfillJobID(id uuid.UUID) *ItemCreate {
+	_c.mutation.SetBackfillJobID(id)
+	return _c
+}
+
+// SetNillableBackfillJobID sets the "backfill_job" edge to the BackfillJob entity by ID if the given value is not nil.
+func (_c *ItemCreate) SetNillableBackfillJobID(id *uuid.UUID) *ItemCreate {
+	if id != nil {
+		_c = _c.SetBackfillJobID(*id)
+	}
+	return _c
+}
+
+// SetBackfillJob sets the "backfill_job" edge to the BackfillJob entity.
+func (_c *ItemCreate) SetBackfillJob(v *BackfillJob) *ItemCreate {
+	return _c.SetBackfillJobID(v.ID)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (_c *ItemCreate) Mutation() *ItemMutation {
 	return _c.mutation
@@ -153,7 +287,9 @@ func (_c *ItemCreate) Mutation() *ItemMutation {
 
 // Save creates the Item in the database.
 func (_c *ItemCreate) Save(ctx context.Context) (*Item, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -180,23 +316,45 @@ func (_c *ItemCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *ItemCreate) defaults() {
+func (_c *ItemCreate) defaults() error {
+	if _, ok := _c.mutation.Provider(); !ok {
+		v := item.DefaultProvider
+		_c.mutation.SetProvider(v)
+	}
+	if _, ok := _c.mutation.AccessTokenKeyID(); !ok {
+		v := item.DefaultAccessTokenKeyID
+		_c.mutation.SetAccessTokenKeyID(v)
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		v := item.DefaultIsActive
 		_c.mutation.SetIsActive(v)
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := item.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
+		if item.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		if item.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if item.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized item.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := item.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -204,12 +362,20 @@ func (_c *ItemCreate) check() error {
 	if _, ok := _c.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Item.user_id"`)}
 	}
-	if _, ok := _c.mutation.PlaidID(); !ok {
-		return &ValidationError{Name: "plaid_id", err: errors.New(`ent: missing required field "Item.plaid_id"`)}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "Item.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := item.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Item.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "Item.external_id"`)}
 	}
-	if v, ok := _c.mutation.PlaidID(); ok {
-		if err := item.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Item.plaid_id": %w`, err)}
+	if v, ok := _c.mutation.ExternalID(); ok {
+		if err := item.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Item.external_id": %w`, err)}
 		}
 	}
 	if _, ok := _c.mutation.AccessToken(); !ok {
@@ -220,6 +386,9 @@ func (_c *ItemCreate) check() error {
 			return &ValidationError{Name: "access_token", err: fmt.Errorf(`ent: validator failed for field "Item.access_token": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.AccessTokenKeyID(); !ok {
+		return &ValidationError{Name: "access_token_key_id", err: errors.New(`ent: missing required field "Item.access_token_key_id"`)}
+	}
 	if _, ok := _c.mutation.InstitutionName(); !ok {
 		return &ValidationError{Name: "institution_name", err: errors.New(`ent: missing required field "Item.institution_name"`)}
 	}
@@ -231,6 +400,14 @@ func (_c *ItemCreate) check() error {
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "Item.is_active"`)}
 	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Item.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Item.created_at"`)}
 	}
@@ -247,7 +424,10 @@ func (_c *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
 	if err := _c.check(); err != nil {
 		return nil, err
 	}
-	_node, _spec := _c.createSpec()
+	_node, _spec, err := _c.createSpec()
+	if err != nil {
+		return nil, err
+	}
 	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
 		if sqlgraph.IsConstraintError(err) {
 			err = &ConstraintError{msg: err.Error(), wrap: err}
@@ -266,7 +446,7 @@ func (_c *ItemCreate) sqlSave(ctx context.Context) (*Item, error) {
 	return _node, nil
 }
 
-func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
+func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec, error) {
 	var (
 		_node = &Item{config: _c.config}
 		_spec = sqlgraph.NewCreateSpec(item.Table, sqlgraph.NewFieldSpec(item.FieldID, field.TypeUUID))
@@ -276,22 +456,54 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
-	if value, ok := _c.mutation.PlaidID(); ok {
-		_spec.SetField(item.FieldPlaidID, field.TypeString, value)
-		_node.PlaidID = value
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(item.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
 	}
 	if value, ok := _c.mutation.AccessToken(); ok {
-		_spec.SetField(item.FieldAccessToken, field.TypeString, value)
+		vv, err := item.ValueScanner.AccessToken.Value(value)
+		if err != nil {
+			return nil, nil, err
+		}
+		_spec.SetField(item.FieldAccessToken, field.TypeString, vv)
 		_node.AccessToken = value
 	}
+	if value, ok := _c.mutation.AccessTokenKeyID(); ok {
+		_spec.SetField(item.FieldAccessTokenKeyID, field.TypeString, value)
+		_node.AccessTokenKeyID = value
+	}
 	if value, ok := _c.mutation.InstitutionName(); ok {
 		_spec.SetField(item.FieldInstitutionName, field.TypeString, value)
 		_node.InstitutionName = value
 	}
+	if value, ok := _c.mutation.InstitutionID(); ok {
+		_spec.SetField(item.FieldInstitutionID, field.TypeString, value)
+		_node.InstitutionID = &value
+	}
+	if value, ok := _c.mutation.InstitutionLogo(); ok {
+		_spec.SetField(item.FieldInstitutionLogo, field.TypeString, value)
+		_node.InstitutionLogo = value
+	}
+	if value, ok := _c.mutation.InstitutionColor(); ok {
+		_spec.SetField(item.FieldInstitutionColor, field.TypeString, value)
+		_node.InstitutionColor = value
+	}
+	if value, ok := _c.mutation.InstitutionURL(); ok {
+		_spec.SetField(item.FieldInstitutionURL, field.TypeString, value)
+		_node.InstitutionURL = value
+	}
 	if value, ok := _c.mutation.IsActive(); ok {
 		_spec.SetField(item.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(item.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -349,7 +561,39 @@ func (_c *ItemCreate) createSpec() (*Item, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
-	return _node, _spec
+	if nodes := _c.mutation.SyncRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SyncRunsTable,
+			Columns: []string{item.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BackfillJobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.BackfillJobTable,
+			Columns: []string{item.BackfillJobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backfilljob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec, nil
 }
 
 // OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
@@ -413,15 +657,27 @@ func (u *ItemUpsert) UpdateUserID() *ItemUpsert {
 	return u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *ItemUpsert) SetPlaidID(v string) *ItemUpsert {
-	u.Set(item.FieldPlaidID, v)
+// SetProvider sets the "provider" field.
+func (u *ItemUpsert) SetProvider(v string) *ItemUpsert {
+	u.Set(item.FieldProvider, v)
+	return u
+}
+
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateProvider() *ItemUpsert {
+	u.SetExcluded(item.FieldProvider)
 	return u
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *ItemUpsert) UpdatePlaidID() *ItemUpsert {
-	u.SetExcluded(item.FieldPlaidID)
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsert) SetExternalID(v string) *ItemUpsert {
+	u.Set(item.FieldExternalID, v)
+	return u
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateExternalID() *ItemUpsert {
+	u.SetExcluded(item.FieldExternalID)
 	return u
 }
 
@@ -437,6 +693,18 @@ func (u *ItemUpsert) UpdateAccessToken() *ItemUpsert {
 	return u
 }
 
+// SetAccessTokenKeyID sets the "access_token_key_id" field.
+func (u *ItemUpsert) SetAccessTokenKeyID(v string) *ItemUpsert {
+	u.Set(item.FieldAccessTokenKeyID, v)
+	return u
+}
+
+// UpdateAccessTokenKeyID sets the "access_token_key_id" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateAccessTokenKeyID() *ItemUpsert {
+	u.SetExcluded(item.FieldAccessTokenKeyID)
+	return u
+}
+
 // SetInstitutionName sets the "institution_name" field.
 func (u *ItemUpsert) SetInstitutionName(v string) *ItemUpsert {
 	u.Set(item.FieldInstitutionName, v)
@@ -449,6 +717,78 @@ func (u *ItemUpsert) UpdateInstitutionName() *ItemUpsert {
 	return u
 }
 
+// SetInstitutionID sets the "institution_id" field.
+func (u *ItemUpsert) SetInstitutionID(v string) *ItemUpsert {
+	u.Set(item.FieldInstitutionID, v)
+	return u
+}
+
+// UpdateInstitutionID sets the "institution_id" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateInstitutionID() *ItemUpsert {
+	u.SetExcluded(item.FieldInstitutionID)
+	return u
+}
+
+// ClearInstitutionID clears the value of the "institution_id" field.
+func (u *ItemUpsert) ClearInstitutionID() *ItemUpsert {
+	u.SetNull(item.FieldInstitutionID)
+	return u
+}
+
+// SetInstitutionLogo sets the "institution_logo" field.
+func (u *ItemUpsert) SetInstitutionLogo(v string) *ItemUpsert {
+	u.Set(item.FieldInstitutionLogo, v)
+	return u
+}
+
+// UpdateInstitutionLogo sets the "institution_logo" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateInstitutionLogo() *ItemUpsert {
+	u.SetExcluded(item.FieldInstitutionLogo)
+	return u
+}
+
+// ClearInstitutionLogo clears the value of the "institution_logo" field.
+func (u *ItemUpsert) ClearInstitutionLogo() *ItemUpsert {
+	u.SetNull(item.FieldInstitutionLogo)
+	return u
+}
+
+// SetInstitutionColor sets the "institution_color" field.
+func (u *ItemUpsert) SetInstitutionColor(v string) *ItemUpsert {
+	u.Set(item.FieldInstitutionColor, v)
+	return u
+}
+
+// UpdateInstitutionColor sets the "institution_color" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateInstitutionColor() *ItemUpsert {
+	u.SetExcluded(item.FieldInstitutionColor)
+	return u
+}
+
+// ClearInstitutionColor clears the value of the "institution_color" field.
+func (u *ItemUpsert) ClearInstitutionColor() *ItemUpsert {
+	u.SetNull(item.FieldInstitutionColor)
+	return u
+}
+
+// SetInstitutionURL sets the "institution_url" field.
+func (u *ItemUpsert) SetInstitutionURL(v string) *ItemUpsert {
+	u.Set(item.FieldInstitutionURL, v)
+	return u
+}
+
+// UpdateInstitutionURL sets the "institution_url" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateInstitutionURL() *ItemUpsert {
+	u.SetExcluded(item.FieldInstitutionURL)
+	return u
+}
+
+// ClearInstitutionURL clears the value of the "institution_url" field.
+func (u *ItemUpsert) ClearInstitutionURL() *ItemUpsert {
+	u.SetNull(item.FieldInstitutionURL)
+	return u
+}
+
 // SetIsActive sets the "is_active" field.
 func (u *ItemUpsert) SetIsActive(v bool) *ItemUpsert {
 	u.Set(item.FieldIsActive, v)
@@ -461,6 +801,18 @@ func (u *ItemUpsert) UpdateIsActive() *ItemUpsert {
 	return u
 }
 
+// SetStatus sets the "status" field.
+func (u *ItemUpsert) SetStatus(v item.Status) *ItemUpsert {
+	u.Set(item.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItemUpsert) UpdateStatus() *ItemUpsert {
+	u.SetExcluded(item.FieldStatus)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ItemUpsert) SetUpdatedAt(v time.Time) *ItemUpsert {
 	u.Set(item.FieldUpdatedAt, v)
@@ -538,17 +890,31 @@ func (u *ItemUpsertOne) UpdateUserID() *ItemUpsertOne {
 	})
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *ItemUpsertOne) SetPlaidID(v string) *ItemUpsertOne {
+// SetProvider sets the "provider" field.
+func (u *ItemUpsertOne) SetProvider(v string) *ItemUpsertOne {
 	return u.Update(func(s *ItemUpsert) {
-		s.SetPlaidID(v)
+		s.SetProvider(v)
 	})
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *ItemUpsertOne) UpdatePlaidID() *ItemUpsertOne {
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateProvider() *ItemUpsertOne {
 	return u.Update(func(s *ItemUpsert) {
-		s.UpdatePlaidID()
+		s.UpdateProvider()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsertOne) SetExternalID(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateExternalID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateExternalID()
 	})
 }
 
@@ -566,6 +932,20 @@ func (u *ItemUpsertOne) UpdateAccessToken() *ItemUpsertOne {
 	})
 }
 
+// SetAccessTokenKeyID sets the "access_token_key_id" field.
+func (u *ItemUpsertOne) SetAccessTokenKeyID(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetAccessTokenKeyID(v)
+	})
+}
+
+// UpdateAccessTokenKeyID sets the "access_token_key_id" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateAccessTokenKeyID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateAccessTokenKeyID()
+	})
+}
+
 // SetInstitutionName sets the "institution_name" field.
 func (u *ItemUpsertOne) SetInstitutionName(v string) *ItemUpsertOne {
 	return u.Update(func(s *ItemUpsert) {
@@ -580,6 +960,90 @@ func (u *ItemUpsertOne) UpdateInstitutionName() *ItemUpsertOne {
 	})
 }
 
+// SetInstitutionID sets the "institution_id" field.
+func (u *ItemUpsertOne) SetInstitutionID(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetInstitutionID(v)
+	})
+}
+
+// UpdateInstitutionID sets the "institution_id" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateInstitutionID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateInstitutionID()
+	})
+}
+
+// ClearInstitutionID clears the value of the "institution_id" field.
+func (u *ItemUpsertOne) ClearInstitutionID() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearInstitutionID()
+	})
+}
+
+// SetInstitutionLogo sets the "institution_logo" field.
+func (u *ItemUpsertOne) SetInstitutionLogo(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetInstitutionLogo(v)
+	})
+}
+
+// UpdateInstitutionLogo sets the "institution_logo" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateInstitutionLogo() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateInstitutionLogo()
+	})
+}
+
+// ClearInstitutionLogo clears the value of the "institution_logo" field.
+func (u *ItemUpsertOne) ClearInstitutionLogo() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearInstitutionLogo()
+	})
+}
+
+// SetInstitutionColor sets the "institution_color" field.
+func (u *ItemUpsertOne) SetInstitutionColor(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetInstitutionColor(v)
+	})
+}
+
+// UpdateInstitutionColor sets the "institution_color" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateInstitutionColor() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateInstitutionColor()
+	})
+}
+
+// ClearInstitutionColor clears the value of the "institution_color" field.
+func (u *ItemUpsertOne) ClearInstitutionColor() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearInstitutionColor()
+	})
+}
+
+// SetInstitutionURL sets the "institution_url" field.
+func (u *ItemUpsertOne) SetInstitutionURL(v string) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetInstitutionURL(v)
+	})
+}
+
+// UpdateInstitutionURL sets the "institution_url" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateInstitutionURL() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateInstitutionURL()
+	})
+}
+
+// ClearInstitutionURL clears the value of the "institution_url" field.
+func (u *ItemUpsertOne) ClearInstitutionURL() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearInstitutionURL()
+	})
+}
+
 // SetIsActive sets the "is_active" field.
 func (u *ItemUpsertOne) SetIsActive(v bool) *ItemUpsertOne {
 	return u.Update(func(s *ItemUpsert) {
@@ -594,6 +1058,20 @@ func (u *ItemUpsertOne) UpdateIsActive() *ItemUpsertOne {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *ItemUpsertOne) SetStatus(v item.Status) *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItemUpsertOne) UpdateStatus() *ItemUpsertOne {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ItemUpsertOne) SetUpdatedAt(v time.Time) *ItemUpsertOne {
 	return u.Update(func(s *ItemUpsert) {
@@ -676,7 +1154,10 @@ func (_c *ItemCreateBulk) Save(ctx context.Context) ([]*Item, error) {
 				}
 				builder.mutation = mutation
 				var err error
-				nodes[i], specs[i] = builder.createSpec()
+				nodes[i], specs[i], err = builder.createSpec()
+				if err != nil {
+					return nil, err
+				}
 				if i < len(mutators)-1 {
 					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
 				} else {
@@ -840,17 +1321,31 @@ func (u *ItemUpsertBulk) UpdateUserID() *ItemUpsertBulk {
 	})
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *ItemUpsertBulk) SetPlaidID(v string) *ItemUpsertBulk {
+// SetProvider sets the "provider" field.
+func (u *ItemUpsertBulk) SetProvider(v string) *ItemUpsertBulk {
 	return u.Update(func(s *ItemUpsert) {
-		s.SetPlaidID(v)
+		s.SetProvider(v)
 	})
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *ItemUpsertBulk) UpdatePlaidID() *ItemUpsertBulk {
+// UpdateProvider sets the "provider" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateProvider() *ItemUpsertBulk {
 	return u.Update(func(s *ItemUpsert) {
-		s.UpdatePlaidID()
+		s.UpdateProvider()
+	})
+}
+
+// SetExternalID sets the "external_id" field.
+func (u *ItemUpsertBulk) SetExternalID(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetExternalID(v)
+	})
+}
+
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateExternalID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateExternalID()
 	})
 }
 
@@ -868,6 +1363,20 @@ func (u *ItemUpsertBulk) UpdateAccessToken() *ItemUpsertBulk {
 	})
 }
 
+// SetAccessTokenKeyID sets the "access_token_key_id" field.
+func (u *ItemUpsertBulk) SetAccessTokenKeyID(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetAccessTokenKeyID(v)
+	})
+}
+
+// UpdateAccessTokenKeyID sets the "access_token_key_id" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateAccessTokenKeyID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateAccessTokenKeyID()
+	})
+}
+
 // SetInstitutionName sets the "institution_name" field.
 func (u *ItemUpsertBulk) SetInstitutionName(v string) *ItemUpsertBulk {
 	return u.Update(func(s *ItemUpsert) {
@@ -882,6 +1391,90 @@ func (u *ItemUpsertBulk) UpdateInstitutionName() *ItemUpsertBulk {
 	})
 }
 
+// SetInstitutionID sets the "institution_id" field.
+func (u *ItemUpsertBulk) SetInstitutionID(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetInstitutionID(v)
+	})
+}
+
+// UpdateInstitutionID sets the "institution_id" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateInstitutionID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateInstitutionID()
+	})
+}
+
+// ClearInstitutionID clears the value of the "institution_id" field.
+func (u *ItemUpsertBulk) ClearInstitutionID() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearInstitutionID()
+	})
+}
+
+// SetInstitutionLogo sets the "institution_logo" field.
+func (u *ItemUpsertBulk) SetInstitutionLogo(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetInstitutionLogo(v)
+	})
+}
+
+// UpdateInstitutionLogo sets the "institution_logo" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateInstitutionLogo() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateInstitutionLogo()
+	})
+}
+
+// ClearInstitutionLogo clears the value of the "institution_logo" field.
+func (u *ItemUpsertBulk) ClearInstitutionLogo() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearInstitutionLogo()
+	})
+}
+
+// SetInstitutionColor sets the "institution_color" field.
+func (u *ItemUpsertBulk) SetInstitutionColor(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetInstitutionColor(v)
+	})
+}
+
+// UpdateInstitutionColor sets the "institution_color" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateInstitutionColor() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateInstitutionColor()
+	})
+}
+
+// ClearInstitutionColor clears the value of the "institution_color" field.
+func (u *ItemUpsertBulk) ClearInstitutionColor() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearInstitutionColor()
+	})
+}
+
+// SetInstitutionURL sets the "institution_url" field.
+func (u *ItemUpsertBulk) SetInstitutionURL(v string) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetInstitutionURL(v)
+	})
+}
+
+// UpdateInstitutionURL sets the "institution_url" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateInstitutionURL() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateInstitutionURL()
+	})
+}
+
+// ClearInstitutionURL clears the value of the "institution_url" field.
+func (u *ItemUpsertBulk) ClearInstitutionURL() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.ClearInstitutionURL()
+	})
+}
+
 // SetIsActive sets the "is_active" field.
 func (u *ItemUpsertBulk) SetIsActive(v bool) *ItemUpsertBulk {
 	return u.Update(func(s *ItemUpsert) {
@@ -896,6 +1489,20 @@ func (u *ItemUpsertBulk) UpdateIsActive() *ItemUpsertBulk {
 	})
 }
 
+// SetStatus sets the "status" field.
+func (u *ItemUpsertBulk) SetStatus(v item.Status) *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *ItemUpsertBulk) UpdateStatus() *ItemUpsertBulk {
+	return u.Update(func(s *ItemUpsert) {
+		s.UpdateStatus()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *ItemUpsertBulk) SetUpdatedAt(v time.Time) *ItemUpsertBulk {
 	return u.Update(func(s *ItemUpsert) {
@@ -8,9 +8,11 @@ import (
 	"fmt"
 	"math"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/backfilljob"
 	"regulation/internal/ent/item"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/synccursor"
+	"regulation/internal/ent/syncrun"
 	"regulation/internal/ent/user"
 
 	"entgo.io/ent"
@@ -24,14 +26,16 @@ import (
 // ItemQuery is the builder for querying Item entities.
 type ItemQuery struct {
 	config
-	ctx            *QueryContext
-	order          []item.OrderOption
-	inters         []Interceptor
-	predicates     []predicate.Item
-	withUser       *UserQuery
-	withAccounts   *AccountQuery
-	withSyncCursor *SyncCursorQuery
-	modifiers      []func(*sql.Selector)
+	ctx             *QueryContext
+	order           []item.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.Item
+	withUser        *UserQuery
+	withAccounts    *AccountQuery
+	withSyncCursor  *SyncCursorQuery
+	withSyncRuns    *SyncRunQuery
+	withBackfillJob *BackfillJobQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -134,6 +138,50 @@ func (_q *ItemQuery) QuerySyncCursor() *SyncCursorQuery {
 	return query
 }
 
+// QuerySyncRuns chains the current query on the "sync_runs" edge.
+func (_q *ItemQuery) QuerySyncRuns() *SyncRunQuery {
+	query := (&SyncRunClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(syncrun.Table, syncrun.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, item.SyncRunsTable, item.SyncRunsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBackfillJob chains the current query on the "backfill_job" edge.
+func (_q *ItemQuery) QueryBackfillJob() *BackfillJobQuery {
+	query := (&BackfillJobClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(item.Table, item.FieldID, selector),
+			sqlgraph.To(backfilljob.Table, backfilljob.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, item.BackfillJobTable, item.BackfillJobColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Item entity from the query.
 // Returns a *NotFoundError when no Item was found.
 func (_q *ItemQuery) First(ctx context.Context) (*Item, error) {
@@ -321,14 +369,16 @@ func (_q *ItemQuery) Clone() *ItemQuery {
 		return nil
 	}
 	return &ItemQuery{
-		config:         _q.config,
-		ctx:            _q.ctx.Clone(),
-		order:          append([]item.OrderOption{}, _q.order...),
-		inters:         append([]Interceptor{}, _q.inters...),
-		predicates:     append([]predicate.Item{}, _q.predicates...),
-		withUser:       _q.withUser.Clone(),
-		withAccounts:   _q.withAccounts.Clone(),
-		withSyncCursor: _q.withSyncCursor.Clone(),
+		config:          _q.config,
+		ctx:             _q.ctx.Clone(),
+		order:           append([]item.OrderOption{}, _q.order...),
+		inters:          append([]Interceptor{}, _q.inters...),
+		predicates:      append([]predicate.Item{}, _q.predicates...),
+		withUser:        _q.withUser.Clone(),
+		withAccounts:    _q.withAccounts.Clone(),
+		withSyncCursor:  _q.withSyncCursor.Clone(),
+		withSyncRuns:    _q.withSyncRuns.Clone(),
+		withBackfillJob: _q.withBackfillJob.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -369,6 +419,28 @@ func (_q *ItemQuery) WithSyncCursor(opts ...func(*SyncCursorQuery)) *ItemQuery {
 	return _q
 }
 
+// WithSyncRuns tells the query-builder to eager-load the nodes that are connected to
+// the "sync_runs" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ItemQuery) WithSyncRuns(opts ...func(*SyncRunQuery)) *ItemQuery {
+	query := (&SyncRunClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSyncRuns = query
+	return _q
+}
+
+// WithBackfillJob tells the query-builder to eager-load the nodes that are connected to
+// the "backfill_job" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ItemQuery) WithBackfillJob(opts ...func(*BackfillJobQuery)) *ItemQuery {
+	query := (&BackfillJobClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBackfillJob = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -447,10 +519,12 @@ func (_q *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 	var (
 		nodes       = []*Item{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withUser != nil,
 			_q.withAccounts != nil,
 			_q.withSyncCursor != nil,
+			_q.withSyncRuns != nil,
+			_q.withBackfillJob != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -493,6 +567,19 @@ func (_q *ItemQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Item, e
 			return nil, err
 		}
 	}
+	if query := _q.withSyncRuns; query != nil {
+		if err := _q.loadSyncRuns(ctx, query, nodes,
+			func(n *Item) { n.Edges.SyncRuns = []*SyncRun{} },
+			func(n *Item, e *SyncRun) { n.Edges.SyncRuns = append(n.Edges.SyncRuns, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBackfillJob; query != nil {
+		if err := _q.loadBackfillJob(ctx, query, nodes, nil,
+			func(n *Item, e *BackfillJob) { n.Edges.BackfillJob = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -547,9 +634,12 @@ func (_q *ItemQuery) loadAccounts(ctx context.Context, query *AccountQuery, node
 	}
 	for _, n := range neighbors {
 		fk := n.ItemID
-		node, ok := nodeids[fk]
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "item_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
 		if !ok {
-			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, *fk, n.ID)
 		}
 		assign(node, n)
 	}
@@ -582,6 +672,63 @@ func (_q *ItemQuery) loadSyncCursor(ctx context.Context, query *SyncCursorQuery,
 	}
 	return nil
 }
+func (_q *ItemQuery) loadSyncRuns(ctx context.Context, query *SyncRunQuery, nodes []*Item, init func(*Item), assign func(*Item, *SyncRun)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(syncrun.FieldItemID)
+	}
+	query.Where(predicate.SyncRun(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.SyncRunsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ItemQuery) loadBackfillJob(ctx context.Context, query *BackfillJobQuery, nodes []*Item, init func(*Item), assign func(*Item, *BackfillJob)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Item)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backfilljob.FieldItemID)
+	}
+	query.Where(predicate.BackfillJob(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(item.BackfillJobColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ItemID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "item_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ItemQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -7,9 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/backfilljob"
 	"regulation/internal/ent/item"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/synccursor"
+	"regulation/internal/ent/syncrun"
 	"regulation/internal/ent/user"
 	"time"
 
@@ -47,16 +49,30 @@ func (_u *ItemUpdate) SetNillableUserID(v *uuid.UUID) *ItemUpdate {
 	return _u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_u *ItemUpdate) SetPlaidID(v string) *ItemUpdate {
-	_u.mutation.SetPlaidID(v)
+// SetProvider sets the "provider" field.
+func (_u *ItemUpdate) SetProvider(v string) *ItemUpdate {
+	_u.mutation.SetProvider(v)
 	return _u
 }
 
-// SetNillablePlaidID sets the "plaid_id" field if the given value is not nil.
-func (_u *ItemUpdate) SetNillablePlaidID(v *string) *ItemUpdate {
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableProvider(v *string) *ItemUpdate {
 	if v != nil {
-		_u.SetPlaidID(*v)
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *ItemUpdate) SetExternalID(v string) *ItemUpdate {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableExternalID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetExternalID(*v)
 	}
 	return _u
 }
@@ -75,6 +91,20 @@ func (_u *ItemUpdate) SetNillableAccessToken(v *string) *ItemUpdate {
 	return _u
 }
 
+// SetAccessTokenKeyID sets the "access_token_key_id" field.
+func (_u *ItemUpdate) SetAccessTokenKeyID(v string) *ItemUpdate {
+	_u.mutation.SetAccessTokenKeyID(v)
+	return _u
+}
+
+// SetNillableAccessTokenKeyID sets the "access_token_key_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableAccessTokenKeyID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetAccessTokenKeyID(*v)
+	}
+	return _u
+}
+
 // SetInstitutionName sets the "institution_name" field.
 func (_u *ItemUpdate) SetInstitutionName(v string) *ItemUpdate {
 	_u.mutation.SetInstitutionName(v)
@@ -89,6 +119,86 @@ func (_u *ItemUpdate) SetNillableInstitutionName(v *string) *ItemUpdate {
 	return _u
 }
 
+// SetInstitutionID sets the "institution_id" field.
+func (_u *ItemUpdate) SetInstitutionID(v string) *ItemUpdate {
+	_u.mutation.SetInstitutionID(v)
+	return _u
+}
+
+// SetNillableInstitutionID sets the "institution_id" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableInstitutionID(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetInstitutionID(*v)
+	}
+	return _u
+}
+
+// ClearInstitutionID clears the value of the "institution_id" field.
+func (_u *ItemUpdate) ClearInstitutionID() *ItemUpdate {
+	_u.mutation.ClearInstitutionID()
+	return _u
+}
+
+// SetInstitutionLogo sets the "institution_logo" field.
+func (_u *ItemUpdate) SetInstitutionLogo(v string) *ItemUpdate {
+	_u.mutation.SetInstitutionLogo(v)
+	return _u
+}
+
+// SetNillableInstitutionLogo sets the "institution_logo" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableInstitutionLogo(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetInstitutionLogo(*v)
+	}
+	return _u
+}
+
+// ClearInstitutionLogo clears the value of the "institution_logo" field.
+func (_u *ItemUpdate) ClearInstitutionLogo() *ItemUpdate {
+	_u.mutation.ClearInstitutionLogo()
+	return _u
+}
+
+// SetInstitutionColor sets the "institution_color" field.
+func (_u *ItemUpdate) SetInstitutionColor(v string) *ItemUpdate {
+	_u.mutation.SetInstitutionColor(v)
+	return _u
+}
+
+// SetNillableInstitutionColor sets the "institution_color" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableInstitutionColor(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetInstitutionColor(*v)
+	}
+	return _u
+}
+
+// ClearInstitutionColor clears the value of the "institution_color" field.
+func (_u *ItemUpdate) ClearInstitutionColor() *ItemUpdate {
+	_u.mutation.ClearInstitutionColor()
+	return _u
+}
+
+// SetInstitutionURL sets the "institution_url" field.
+func (_u *ItemUpdate) SetInstitutionURL(v string) *ItemUpdate {
+	_u.mutation.SetInstitutionURL(v)
+	return _u
+}
+
+// SetNillableInstitutionURL sets the "institution_url" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableInstitutionURL(v *string) *ItemUpdate {
+	if v != nil {
+		_u.SetInstitutionURL(*v)
+	}
+	return _u
+}
+
+// ClearInstitutionURL clears the value of the "institution_url" field.
+func (_u *ItemUpdate) ClearInstitutionURL() *ItemUpdate {
+	_u.mutation.ClearInstitutionURL()
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *ItemUpdate) SetIsActive(v bool) *ItemUpdate {
 	_u.mutation.SetIsActive(v)
@@ -103,6 +213,20 @@ func (_u *ItemUpdate) SetNillableIsActive(v *bool) *ItemUpdate {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *ItemUpdate) SetStatus(v item.Status) *ItemUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ItemUpdate) SetNillableStatus(v *item.Status) *ItemUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ItemUpdate) SetUpdatedAt(v time.Time) *ItemUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -148,6 +272,40 @@ func (_u *ItemUpdate) SetSyncCursor(v *SyncCursor) *ItemUpdate {
 	return _u.SetSyncCursorID(v.ID)
 }
 
+// AddSyncRunIDs adds the "sync_runs" edge to the SyncRun entity by IDs.
+func (_u *ItemUpdate) AddSyncRunIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.AddSyncRunIDs(ids...)
+	return _u
+}
+
+// AddSyncRuns adds the "sync_runs" edges to the SyncRun entity.
+func (_u *ItemUpdate) AddSyncRuns(v ...*SyncRun) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSyncRunIDs(ids...)
+}
+
+// SetBackfillJobID sets the "backfill_job" edge to the BackfillJob entity by ID.
+func (_u *ItemUpdate) SetBackfillJobID(id uuid.UUID) *ItemUpdate {
+	_u.mutation.SetBackfillJobID(id)
+	return _u
+}
+
+// SetNillableBackfillJobID sets the "backfill_job" edge to the BackfillJob entity by ID if the given value is not nil.
+func (_u *ItemUpdate) SetNillableBackfillJobID(id *uuid.UUID) *ItemUpdate {
+	if id != nil {
+		_u = _u.SetBackfillJobID(*id)
+	}
+	return _u
+}
+
+// SetBackfillJob sets the "backfill_job" edge to the BackfillJob entity.
+func (_u *ItemUpdate) SetBackfillJob(v *BackfillJob) *ItemUpdate {
+	return _u.SetBackfillJobID(v.ID)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (_u *ItemUpdate) Mutation() *ItemMutation {
 	return _u.mutation
@@ -186,9 +344,38 @@ func (_u *ItemUpdate) ClearSyncCursor() *ItemUpdate {
 	return _u
 }
 
+// ClearSyncRuns clears all "sync_runs" edges to the SyncRun entity.
+func (_u *ItemUpdate) ClearSyncRuns() *ItemUpdate {
+	_u.mutation.ClearSyncRuns()
+	return _u
+}
+
+// RemoveSyncRunIDs removes the "sync_runs" edge to SyncRun entities by IDs.
+func (_u *ItemUpdate) RemoveSyncRunIDs(ids ...uuid.UUID) *ItemUpdate {
+	_u.mutation.RemoveSyncRunIDs(ids...)
+	return _u
+}
+
+// RemoveSyncRuns removes "sync_runs" edges to SyncRun entities.
+func (_u *ItemUpdate) RemoveSyncRuns(v ...*SyncRun) *ItemUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSyncRunIDs(ids...)
+}
+
+// ClearBackfillJob clears the "backfill_job" edge to the BackfillJob entity.
+func (_u *ItemUpdate) ClearBackfillJob() *ItemUpdate {
+	_u.mutation.ClearBackfillJob()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ItemUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -215,18 +402,27 @@ func (_u *ItemUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ItemUpdate) defaults() {
+func (_u *ItemUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if item.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ItemUpdate) check() error {
-	if v, ok := _u.mutation.PlaidID(); ok {
-		if err := item.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Item.plaid_id": %w`, err)}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := item.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Item.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := item.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Item.external_id": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.AccessToken(); ok {
@@ -239,6 +435,11 @@ func (_u *ItemUpdate) check() error {
 			return &ValidationError{Name: "institution_name", err: fmt.Errorf(`ent: validator failed for field "Item.institution_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Item.user"`)
 	}
@@ -263,18 +464,55 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.PlaidID(); ok {
-		_spec.SetField(item.FieldPlaidID, field.TypeString, value)
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(item.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.AccessToken(); ok {
-		_spec.SetField(item.FieldAccessToken, field.TypeString, value)
+		vv, err := item.ValueScanner.AccessToken.Value(value)
+		if err != nil {
+			return 0, err
+		}
+		_spec.SetField(item.FieldAccessToken, field.TypeString, vv)
+	}
+	if value, ok := _u.mutation.AccessTokenKeyID(); ok {
+		_spec.SetField(item.FieldAccessTokenKeyID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.InstitutionName(); ok {
 		_spec.SetField(item.FieldInstitutionName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.InstitutionID(); ok {
+		_spec.SetField(item.FieldInstitutionID, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionIDCleared() {
+		_spec.ClearField(item.FieldInstitutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstitutionLogo(); ok {
+		_spec.SetField(item.FieldInstitutionLogo, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionLogoCleared() {
+		_spec.ClearField(item.FieldInstitutionLogo, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstitutionColor(); ok {
+		_spec.SetField(item.FieldInstitutionColor, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionColorCleared() {
+		_spec.ClearField(item.FieldInstitutionColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstitutionURL(); ok {
+		_spec.SetField(item.FieldInstitutionURL, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionURLCleared() {
+		_spec.ClearField(item.FieldInstitutionURL, field.TypeString)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(item.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(item.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -381,6 +619,80 @@ func (_u *ItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SyncRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SyncRunsTable,
+			Columns: []string{item.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncrun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSyncRunsIDs(); len(nodes) > 0 && !_u.mutation.SyncRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SyncRunsTable,
+			Columns: []string{item.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SyncRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SyncRunsTable,
+			Columns: []string{item.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BackfillJobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.BackfillJobTable,
+			Columns: []string{item.BackfillJobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backfilljob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BackfillJobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.BackfillJobTable,
+			Columns: []string{item.BackfillJobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backfilljob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -417,16 +729,30 @@ func (_u *ItemUpdateOne) SetNillableUserID(v *uuid.UUID) *ItemUpdateOne {
 	return _u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_u *ItemUpdateOne) SetPlaidID(v string) *ItemUpdateOne {
-	_u.mutation.SetPlaidID(v)
+// SetProvider sets the "provider" field.
+func (_u *ItemUpdateOne) SetProvider(v string) *ItemUpdateOne {
+	_u.mutation.SetProvider(v)
 	return _u
 }
 
-// SetNillablePlaidID sets the "plaid_id" field if the given value is not nil.
-func (_u *ItemUpdateOne) SetNillablePlaidID(v *string) *ItemUpdateOne {
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableProvider(v *string) *ItemUpdateOne {
 	if v != nil {
-		_u.SetPlaidID(*v)
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetExternalID sets the "external_id" field.
+func (_u *ItemUpdateOne) SetExternalID(v string) *ItemUpdateOne {
+	_u.mutation.SetExternalID(v)
+	return _u
+}
+
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableExternalID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetExternalID(*v)
 	}
 	return _u
 }
@@ -445,6 +771,20 @@ func (_u *ItemUpdateOne) SetNillableAccessToken(v *string) *ItemUpdateOne {
 	return _u
 }
 
+// SetAccessTokenKeyID sets the "access_token_key_id" field.
+func (_u *ItemUpdateOne) SetAccessTokenKeyID(v string) *ItemUpdateOne {
+	_u.mutation.SetAccessTokenKeyID(v)
+	return _u
+}
+
+// SetNillableAccessTokenKeyID sets the "access_token_key_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableAccessTokenKeyID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetAccessTokenKeyID(*v)
+	}
+	return _u
+}
+
 // SetInstitutionName sets the "institution_name" field.
 func (_u *ItemUpdateOne) SetInstitutionName(v string) *ItemUpdateOne {
 	_u.mutation.SetInstitutionName(v)
@@ -459,6 +799,86 @@ func (_u *ItemUpdateOne) SetNillableInstitutionName(v *string) *ItemUpdateOne {
 	return _u
 }
 
+// SetInstitutionID sets the "institution_id" field.
+func (_u *ItemUpdateOne) SetInstitutionID(v string) *ItemUpdateOne {
+	_u.mutation.SetInstitutionID(v)
+	return _u
+}
+
+// SetNillableInstitutionID sets the "institution_id" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableInstitutionID(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetInstitutionID(*v)
+	}
+	return _u
+}
+
+// ClearInstitutionID clears the value of the "institution_id" field.
+func (_u *ItemUpdateOne) ClearInstitutionID() *ItemUpdateOne {
+	_u.mutation.ClearInstitutionID()
+	return _u
+}
+
+// SetInstitutionLogo sets the "institution_logo" field.
+func (_u *ItemUpdateOne) SetInstitutionLogo(v string) *ItemUpdateOne {
+	_u.mutation.SetInstitutionLogo(v)
+	return _u
+}
+
+// SetNillableInstitutionLogo sets the "institution_logo" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableInstitutionLogo(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetInstitutionLogo(*v)
+	}
+	return _u
+}
+
+// ClearInstitutionLogo clears the value of the "institution_logo" field.
+func (_u *ItemUpdateOne) ClearInstitutionLogo() *ItemUpdateOne {
+	_u.mutation.ClearInstitutionLogo()
+	return _u
+}
+
+// SetInstitutionColor sets the "institution_color" field.
+func (_u *ItemUpdateOne) SetInstitutionColor(v string) *ItemUpdateOne {
+	_u.mutation.SetInstitutionColor(v)
+	return _u
+}
+
+// SetNillableInstitutionColor sets the "institution_color" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableInstitutionColor(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetInstitutionColor(*v)
+	}
+	return _u
+}
+
+// ClearInstitutionColor clears the value of the "institution_color" field.
+func (_u *ItemUpdateOne) ClearInstitutionColor() *ItemUpdateOne {
+	_u.mutation.ClearInstitutionColor()
+	return _u
+}
+
+// SetInstitutionURL sets the "institution_url" field.
+func (_u *ItemUpdateOne) SetInstitutionURL(v string) *ItemUpdateOne {
+	_u.mutation.SetInstitutionURL(v)
+	return _u
+}
+
+// SetNillableInstitutionURL sets the "institution_url" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableInstitutionURL(v *string) *ItemUpdateOne {
+	if v != nil {
+		_u.SetInstitutionURL(*v)
+	}
+	return _u
+}
+
+// ClearInstitutionURL clears the value of the "institution_url" field.
+func (_u *ItemUpdateOne) ClearInstitutionURL() *ItemUpdateOne {
+	_u.mutation.ClearInstitutionURL()
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *ItemUpdateOne) SetIsActive(v bool) *ItemUpdateOne {
 	_u.mutation.SetIsActive(v)
@@ -473,6 +893,20 @@ func (_u *ItemUpdateOne) SetNillableIsActive(v *bool) *ItemUpdateOne {
 	return _u
 }
 
+// SetStatus sets the "status" field.
+func (_u *ItemUpdateOne) SetStatus(v item.Status) *ItemUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableStatus(v *item.Status) *ItemUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ItemUpdateOne) SetUpdatedAt(v time.Time) *ItemUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -518,6 +952,40 @@ func (_u *ItemUpdateOne) SetSyncCursor(v *SyncCursor) *ItemUpdateOne {
 	return _u.SetSyncCursorID(v.ID)
 }
 
+// AddSyncRunIDs adds the "sync_runs" edge to the SyncRun entity by IDs.
+func (_u *ItemUpdateOne) AddSyncRunIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.AddSyncRunIDs(ids...)
+	return _u
+}
+
+// AddSyncRuns adds the "sync_runs" edges to the SyncRun entity.
+func (_u *ItemUpdateOne) AddSyncRuns(v ...*SyncRun) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSyncRunIDs(ids...)
+}
+
+// SetBackfillJobID sets the "backfill_job" edge to the BackfillJob entity by ID.
+func (_u *ItemUpdateOne) SetBackfillJobID(id uuid.UUID) *ItemUpdateOne {
+	_u.mutation.SetBackfillJobID(id)
+	return _u
+}
+
+// SetNillableBackfillJobID sets the "backfill_job" edge to the BackfillJob entity by ID if the given value is not nil.
+func (_u *ItemUpdateOne) SetNillableBackfillJobID(id *uuid.UUID) *ItemUpdateOne {
+	if id != nil {
+		_u = _u.SetBackfillJobID(*id)
+	}
+	return _u
+}
+
+// SetBackfillJob sets the "backfill_job" edge to the BackfillJob entity.
+func (_u *ItemUpdateOne) SetBackfillJob(v *BackfillJob) *ItemUpdateOne {
+	return _u.SetBackfillJobID(v.ID)
+}
+
 // Mutation returns the ItemMutation object of the builder.
 func (_u *ItemUpdateOne) Mutation() *ItemMutation {
 	return _u.mutation
@@ -556,6 +1024,33 @@ func (_u *ItemUpdateOne) ClearSyncCursor() *ItemUpdateOne {
 	return _u
 }
 
+// ClearSyncRuns clears all "sync_runs" edges to the SyncRun entity.
+func (_u *ItemUpdateOne) ClearSyncRuns() *ItemUpdateOne {
+	_u.mutation.ClearSyncRuns()
+	return _u
+}
+
+// RemoveSyncRunIDs removes the "sync_runs" edge to SyncRun entities by IDs.
+func (_u *ItemUpdateOne) RemoveSyncRunIDs(ids ...uuid.UUID) *ItemUpdateOne {
+	_u.mutation.RemoveSyncRunIDs(ids...)
+	return _u
+}
+
+// RemoveSyncRuns removes "sync_runs" edges to SyncRun entities.
+func (_u *ItemUpdateOne) RemoveSyncRuns(v ...*SyncRun) *ItemUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSyncRunIDs(ids...)
+}
+
+// ClearBackfillJob clears the "backfill_job" edge to the BackfillJob entity.
+func (_u *ItemUpdateOne) ClearBackfillJob() *ItemUpdateOne {
+	_u.mutation.ClearBackfillJob()
+	return _u
+}
+
 // Where appends a list predicates to the ItemUpdate builder.
 func (_u *ItemUpdateOne) Where(ps ...predicate.Item) *ItemUpdateOne {
 	_u.mutation.Where(ps...)
@@ -571,7 +1066,9 @@ func (_u *ItemUpdateOne) Select(field string, fields ...string) *ItemUpdateOne {
 
 // Save executes the query and returns the updated Item entity.
 func (_u *ItemUpdateOne) Save(ctx context.Context) (*Item, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -598,18 +1095,27 @@ func (_u *ItemUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *ItemUpdateOne) defaults() {
+func (_u *ItemUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		if item.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized item.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := item.UpdateDefaultUpdatedAt()
 		_u.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *ItemUpdateOne) check() error {
-	if v, ok := _u.mutation.PlaidID(); ok {
-		if err := item.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Item.plaid_id": %w`, err)}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := item.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "Item.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := item.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Item.external_id": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.AccessToken(); ok {
@@ -622,6 +1128,11 @@ func (_u *ItemUpdateOne) check() error {
 			return &ValidationError{Name: "institution_name", err: fmt.Errorf(`ent: validator failed for field "Item.institution_name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := item.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Item.status": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Item.user"`)
 	}
@@ -663,18 +1174,55 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 			}
 		}
 	}
-	if value, ok := _u.mutation.PlaidID(); ok {
-		_spec.SetField(item.FieldPlaidID, field.TypeString, value)
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(item.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(item.FieldExternalID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.AccessToken(); ok {
-		_spec.SetField(item.FieldAccessToken, field.TypeString, value)
+		vv, err := item.ValueScanner.AccessToken.Value(value)
+		if err != nil {
+			return nil, err
+		}
+		_spec.SetField(item.FieldAccessToken, field.TypeString, vv)
+	}
+	if value, ok := _u.mutation.AccessTokenKeyID(); ok {
+		_spec.SetField(item.FieldAccessTokenKeyID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.InstitutionName(); ok {
 		_spec.SetField(item.FieldInstitutionName, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.InstitutionID(); ok {
+		_spec.SetField(item.FieldInstitutionID, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionIDCleared() {
+		_spec.ClearField(item.FieldInstitutionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstitutionLogo(); ok {
+		_spec.SetField(item.FieldInstitutionLogo, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionLogoCleared() {
+		_spec.ClearField(item.FieldInstitutionLogo, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstitutionColor(); ok {
+		_spec.SetField(item.FieldInstitutionColor, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionColorCleared() {
+		_spec.ClearField(item.FieldInstitutionColor, field.TypeString)
+	}
+	if value, ok := _u.mutation.InstitutionURL(); ok {
+		_spec.SetField(item.FieldInstitutionURL, field.TypeString, value)
+	}
+	if _u.mutation.InstitutionURLCleared() {
+		_spec.ClearField(item.FieldInstitutionURL, field.TypeString)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(item.FieldIsActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(item.FieldStatus, field.TypeEnum, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(item.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -781,6 +1329,80 @@ func (_u *ItemUpdateOne) sqlSave(ctx context.Context) (_node *Item, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SyncRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SyncRunsTable,
+			Columns: []string{item.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncrun.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSyncRunsIDs(); len(nodes) > 0 && !_u.mutation.SyncRunsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SyncRunsTable,
+			Columns: []string{item.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SyncRunsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   item.SyncRunsTable,
+			Columns: []string{item.SyncRunsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(syncrun.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BackfillJobCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.BackfillJobTable,
+			Columns: []string{item.BackfillJobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backfilljob.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BackfillJobIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   item.BackfillJobTable,
+			Columns: []string{item.BackfillJobColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backfilljob.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &Item{config: _u.config}
 	_spec.Assign = _node.assignValues
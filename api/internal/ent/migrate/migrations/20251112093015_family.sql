@@ -0,0 +1,35 @@
+-- Create "families" table
+CREATE TABLE "families" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "savings_account_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "families_accounts_savings_families" FOREIGN KEY ("savings_account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL
+);
+-- Create "family_members" table
+CREATE TABLE "family_members" (
+  "id" uuid NOT NULL,
+  "role" character varying NOT NULL DEFAULT 'member',
+  "created_at" timestamptz NOT NULL,
+  "family_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "family_members_families_members" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION,
+  CONSTRAINT "family_members_users_family_membership" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "family_members_user_id_key" to table: "family_members"
+CREATE UNIQUE INDEX "family_members_user_id_key" ON "family_members" ("user_id");
+-- Create index "familymember_family_id" to table: "family_members"
+CREATE INDEX "familymember_family_id" ON "family_members" ("family_id");
+-- Create index "familymember_user_id" to table: "family_members"
+CREATE UNIQUE INDEX "familymember_user_id" ON "family_members" ("user_id");
+-- Modify "rules" table
+ALTER TABLE "rules" ADD COLUMN "monthly_threshold_cents" bigint NULL, ADD COLUMN "family_id" uuid NULL, ADD CONSTRAINT "rules_families_rules" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "rule_family_id_is_active" to table: "rules"
+CREATE INDEX "rule_family_id_is_active" ON "rules" ("family_id", "is_active");
+-- Modify "rule_executions" table
+ALTER TABLE "rule_executions" ADD COLUMN "family_id" uuid NULL, ADD CONSTRAINT "rule_executions_families_rule_executions" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Create index "ruleexecution_family_id_created_at" to table: "rule_executions"
+CREATE INDEX "ruleexecution_family_id_created_at" ON "rule_executions" ("family_id", "created_at");
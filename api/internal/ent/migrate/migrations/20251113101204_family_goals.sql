@@ -0,0 +1,21 @@
+-- Modify "family_members" table
+ALTER TABLE "family_members" ADD COLUMN "transaction_visibility" character varying NOT NULL DEFAULT 'totals';
+-- Create "goals" table
+CREATE TABLE "goals" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "kind" character varying NOT NULL DEFAULT 'savings',
+  "category" character varying NULL,
+  "target_cents" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "family_id" uuid NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "goals_families_goals" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "goals_users_goals" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "goal_user_id" to table: "goals"
+CREATE INDEX "goal_user_id" ON "goals" ("user_id");
+-- Create index "goal_family_id" to table: "goals"
+CREATE INDEX "goal_family_id" ON "goals" ("family_id");
@@ -0,0 +1,6 @@
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "internal_transfer" boolean NOT NULL DEFAULT false, ADD COLUMN "transfer_pair_id" uuid NULL;
+-- Create index "transaction_internal_transfer" to table: "transactions"
+CREATE INDEX "transaction_internal_transfer" ON "transactions" ("internal_transfer");
+-- Flag transactions already categorized as transfers
+UPDATE "transactions" SET "internal_transfer" = true WHERE "category" = 'Transfer';
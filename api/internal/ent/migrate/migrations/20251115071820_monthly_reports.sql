@@ -0,0 +1,18 @@
+-- Create "monthly_reports" table
+CREATE TABLE "monthly_reports" (
+  "id" uuid NOT NULL,
+  "month" character varying NOT NULL,
+  "income_cents" bigint NOT NULL DEFAULT 0,
+  "spend_cents" bigint NOT NULL DEFAULT 0,
+  "savings_cents" bigint NOT NULL DEFAULT 0,
+  "net_cents" bigint NOT NULL DEFAULT 0,
+  "top_rules" jsonb NULL,
+  "goals" jsonb NULL,
+  "category_changes" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "monthly_reports_users_monthly_reports" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "monthlyreport_user_id_month" to table: "monthly_reports"
+CREATE UNIQUE INDEX "monthlyreport_user_id_month" ON "monthly_reports" ("user_id", "month");
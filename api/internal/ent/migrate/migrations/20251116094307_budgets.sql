@@ -0,0 +1,34 @@
+-- Create "budgets" table
+CREATE TABLE "budgets" (
+  "id" uuid NOT NULL,
+  "category" character varying NOT NULL,
+  "amount_cents" bigint NOT NULL,
+  "alert_thresholds" jsonb NOT NULL,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "family_id" uuid NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "budgets_families_budgets" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON UPDATE NO ACTION ON DELETE SET NULL,
+  CONSTRAINT "budgets_users_budgets" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "budget_user_id_category" to table: "budgets"
+CREATE INDEX "budget_user_id_category" ON "budgets" ("user_id", "category");
+-- Create index "budget_family_id_category" to table: "budgets"
+CREATE INDEX "budget_family_id_category" ON "budgets" ("family_id", "category");
+-- Create "budget_alerts" table
+CREATE TABLE "budget_alerts" (
+  "id" uuid NOT NULL,
+  "month" character varying NOT NULL,
+  "threshold" bigint NOT NULL,
+  "spent_cents" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "budget_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "budget_alerts_budgets_alerts" FOREIGN KEY ("budget_id") REFERENCES "budgets" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "budgetalert_budget_id_month_threshold" to table: "budget_alerts"
+CREATE UNIQUE INDEX "budgetalert_budget_id_month_threshold" ON "budget_alerts" ("budget_id", "month", "threshold");
+-- Modify "rules" table
+ALTER TABLE "rules" ADD COLUMN "budget_id" uuid NULL, ADD CONSTRAINT "rules_budgets_rules" FOREIGN KEY ("budget_id") REFERENCES "budgets" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
@@ -0,0 +1,24 @@
+-- Create "recurring_charges" table
+CREATE TABLE "recurring_charges" (
+  "id" uuid NOT NULL,
+  "merchant_key" character varying NOT NULL,
+  "merchant_name" character varying NOT NULL,
+  "category" character varying NOT NULL DEFAULT 'Misc',
+  "cadence" character varying NOT NULL,
+  "amount_cents" bigint NOT NULL,
+  "previous_amount_cents" bigint NULL,
+  "price_increased" boolean NOT NULL DEFAULT false,
+  "occurrences" bigint NOT NULL,
+  "last_charge_date" timestamptz NOT NULL,
+  "next_charge_date" timestamptz NOT NULL,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recurring_charges_users_recurring_charges" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION
+);
+-- Create index "recurringcharge_user_id_merchant_key_cadence" to table: "recurring_charges"
+CREATE UNIQUE INDEX "recurringcharge_user_id_merchant_key_cadence" ON "recurring_charges" ("user_id", "merchant_key", "cadence");
+-- Create index "recurringcharge_user_id_is_active" to table: "recurring_charges"
+CREATE INDEX "recurringcharge_user_id_is_active" ON "recurring_charges" ("user_id", "is_active");
@@ -0,0 +1,2 @@
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "anomaly_score" double precision NULL, ADD COLUMN "anomaly_reason" character varying NULL;
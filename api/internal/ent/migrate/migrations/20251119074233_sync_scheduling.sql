@@ -0,0 +1,10 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "status" character varying NOT NULL DEFAULT 'healthy';
+-- Create index "item_status" to table: "items"
+CREATE INDEX "item_status" ON "items" ("status");
+-- Modify "sync_cursors" table
+ALTER TABLE "sync_cursors" ADD COLUMN "next_sync_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP;
+-- Existing cursors are due immediately; new cursors get next_sync_at from the application
+ALTER TABLE "sync_cursors" ALTER COLUMN "next_sync_at" DROP DEFAULT;
+-- Create index "synccursor_next_sync_at" to table: "sync_cursors"
+CREATE INDEX "synccursor_next_sync_at" ON "sync_cursors" ("next_sync_at");
@@ -0,0 +1,18 @@
+-- Create "sync_runs" table
+CREATE TABLE "sync_runs" (
+  "id" uuid NOT NULL,
+  "trigger" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'running',
+  "added_count" bigint NOT NULL DEFAULT 0,
+  "modified_count" bigint NOT NULL DEFAULT 0,
+  "removed_count" bigint NOT NULL DEFAULT 0,
+  "rules_triggered" bigint NOT NULL DEFAULT 0,
+  "error" character varying NULL,
+  "started_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "item_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sync_runs_items_sync_runs" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "syncrun_item_id_started_at" to table: "sync_runs"
+CREATE INDEX "syncrun_item_id_started_at" ON "sync_runs" ("item_id", "started_at");
@@ -0,0 +1,17 @@
+-- Create "balance_snapshots" table
+CREATE TABLE "balance_snapshots" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "date" timestamptz NOT NULL,
+  "current_balance" bigint NOT NULL,
+  "available_balance" bigint NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "account_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "balance_snapshots_accounts_balance_snapshots" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE CASCADE
+);
+-- Create index "balancesnapshot_account_id_date" to table: "balance_snapshots"
+CREATE UNIQUE INDEX "balancesnapshot_account_id_date" ON "balance_snapshots" ("account_id", "date");
+-- Create index "balancesnapshot_user_id_date" to table: "balance_snapshots"
+CREATE INDEX "balancesnapshot_user_id_date" ON "balance_snapshots" ("user_id", "date");
@@ -0,0 +1,4 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "access_token_key_id" character varying NOT NULL DEFAULT '';
+-- Create index "item_access_token_key_id" to table: "items"
+CREATE INDEX "item_access_token_key_id" ON "items" ("access_token_key_id");
@@ -0,0 +1,2 @@
+-- Modify "items" table
+ALTER TABLE "items" ADD COLUMN "institution_id" character varying NULL, ADD COLUMN "institution_logo" text NULL, ADD COLUMN "institution_color" character varying NULL, ADD COLUMN "institution_url" character varying NULL;
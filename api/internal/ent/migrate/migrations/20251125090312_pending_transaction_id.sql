@@ -0,0 +1,2 @@
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "pending_transaction_id" character varying NULL;
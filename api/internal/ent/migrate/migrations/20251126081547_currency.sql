@@ -0,0 +1,10 @@
+-- Modify "accounts" table
+ALTER TABLE "accounts" ADD COLUMN "currency" character varying NOT NULL DEFAULT 'USD';
+-- Modify "monthly_reports" table
+ALTER TABLE "monthly_reports" ADD COLUMN "currency" character varying NOT NULL DEFAULT 'USD';
+-- Modify "savings_transfers" table
+ALTER TABLE "savings_transfers" ADD COLUMN "currency" character varying NOT NULL DEFAULT 'USD';
+-- Modify "transactions" table
+ALTER TABLE "transactions" ADD COLUMN "currency" character varying NOT NULL DEFAULT 'USD';
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "home_currency" character varying NOT NULL DEFAULT 'USD';
@@ -0,0 +1,4 @@
+-- Modify "accounts" table
+ALTER TABLE "accounts" ADD COLUMN "credit_limit" bigint NULL, ADD COLUMN "funding_account_id" uuid NULL, ADD CONSTRAINT "accounts_accounts_funded_accounts" FOREIGN KEY ("funding_account_id") REFERENCES "accounts" ("id") ON UPDATE NO ACTION ON DELETE SET NULL;
+-- Re-type credit accounts previously stored as other
+UPDATE "accounts" SET "type" = 'credit' WHERE "type" = 'other' AND "subtype" = 'credit card';
@@ -0,0 +1,2 @@
+-- Modify "accounts" table
+ALTER TABLE "accounts" ALTER COLUMN "item_id" DROP NOT NULL;
@@ -0,0 +1,18 @@
+-- Modify "items" table
+ALTER TABLE "items" RENAME COLUMN "plaid_id" TO "external_id";
+ALTER TABLE "items" ADD COLUMN "provider" character varying NOT NULL DEFAULT 'plaid';
+DROP INDEX "items_plaid_id_key";
+DROP INDEX "item_plaid_id";
+-- Create index "item_provider_external_id" to table: "items"
+CREATE UNIQUE INDEX "item_provider_external_id" ON "items" ("provider", "external_id");
+-- Modify "accounts" table
+ALTER TABLE "accounts" RENAME COLUMN "plaid_id" TO "external_id";
+ALTER INDEX "accounts_plaid_id_key" RENAME TO "accounts_external_id_key";
+ALTER INDEX "account_plaid_id" RENAME TO "account_external_id";
+-- Modify "transactions" table
+ALTER TABLE "transactions" RENAME COLUMN "plaid_id" TO "external_id";
+ALTER TABLE "transactions" RENAME COLUMN "plaid_categories" TO "provider_categories";
+ALTER INDEX "transactions_plaid_id_key" RENAME TO "transactions_external_id_key";
+ALTER INDEX "transaction_plaid_id" RENAME TO "transaction_external_id";
+-- Modify "savings_transfers" table
+ALTER TABLE "savings_transfers" RENAME COLUMN "plaid_transfer_id" TO "external_transfer_id";
@@ -0,0 +1,24 @@
+-- Create "backfill_jobs" table
+CREATE TABLE "backfill_jobs" (
+  "id" uuid NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'queued',
+  "days_requested" bigint NOT NULL,
+  "update_status" character varying NOT NULL DEFAULT 'not_ready',
+  "sync_count" bigint NOT NULL DEFAULT 0,
+  "transactions_synced" bigint NOT NULL DEFAULT 0,
+  "oldest_transaction_date" timestamptz NULL,
+  "starter_rules" jsonb NULL,
+  "starter_analysis" text NULL,
+  "analysis_error" character varying NULL,
+  "error" character varying NULL,
+  "started_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "item_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "backfill_jobs_items_backfill_job" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE
+);
+-- Create index "backfill_jobs_item_id_key" to table: "backfill_jobs"
+CREATE UNIQUE INDEX "backfill_jobs_item_id_key" ON "backfill_jobs" ("item_id");
+-- Create index "backfilljob_status_updated_at" to table: "backfill_jobs"
+CREATE INDEX "backfilljob_status_updated_at" ON "backfill_jobs" ("status", "updated_at");
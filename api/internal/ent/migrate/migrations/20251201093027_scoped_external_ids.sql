@@ -0,0 +1,11 @@
+-- Modify "accounts" table
+DROP INDEX "accounts_external_id_key";
+DROP INDEX "account_external_id";
+DROP INDEX "account_item_id";
+-- Create index "account_item_id_external_id" to table: "accounts"
+CREATE UNIQUE INDEX "account_item_id_external_id" ON "accounts" ("item_id", "external_id");
+-- Modify "transactions" table
+DROP INDEX "transactions_external_id_key";
+DROP INDEX "transaction_external_id";
+-- Create index "transaction_account_id_external_id" to table: "transactions"
+CREATE UNIQUE INDEX "transaction_account_id_external_id" ON "transactions" ("account_id", "external_id");
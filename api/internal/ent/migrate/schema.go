@@ -11,17 +11,22 @@ var (
 	// AccountsColumns holds the columns for the "accounts" table.
 	AccountsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "plaid_id", Type: field.TypeString, Unique: true},
+		{Name: "external_id", Type: field.TypeString},
 		{Name: "name", Type: field.TypeString},
 		{Name: "type", Type: field.TypeEnum, Enums: []string{"checking", "savings", "credit", "other"}, Default: "checking"},
 		{Name: "subtype", Type: field.TypeString, Nullable: true},
 		{Name: "mask", Type: field.TypeString, Nullable: true},
 		{Name: "current_balance", Type: field.TypeInt64, Default: 0},
 		{Name: "available_balance", Type: field.TypeInt64, Nullable: true},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "credit_limit", Type: field.TypeInt64, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "balance_date", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
-		{Name: "item_id", Type: field.TypeUUID},
+		{Name: "funding_account_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "item_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// AccountsTable holds the schema information for the "accounts" table.
@@ -30,15 +35,21 @@ var (
 		Columns:    AccountsColumns,
 		PrimaryKey: []*schema.Column{AccountsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "accounts_accounts_funded_accounts",
+				Columns:    []*schema.Column{AccountsColumns[15]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "accounts_items_accounts",
-				Columns:    []*schema.Column{AccountsColumns[11]},
+				Columns:    []*schema.Column{AccountsColumns[16]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "accounts_users_accounts",
-				Columns:    []*schema.Column{AccountsColumns[12]},
+				Columns:    []*schema.Column{AccountsColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -47,27 +58,286 @@ var (
 			{
 				Name:    "account_user_id_is_active",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[12], AccountsColumns[8]},
+				Columns: []*schema.Column{AccountsColumns[17], AccountsColumns[10]},
+			},
+			{
+				Name:    "account_item_id_external_id",
+				Unique:  true,
+				Columns: []*schema.Column{AccountsColumns[16], AccountsColumns[1]},
+			},
+		},
+	}
+	// BackfillJobsColumns holds the columns for the "backfill_jobs" table.
+	BackfillJobsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"queued", "syncing", "analyzing", "completed", "failed"}, Default: "queued"},
+		{Name: "days_requested", Type: field.TypeInt},
+		{Name: "update_status", Type: field.TypeEnum, Enums: []string{"not_ready", "initial_update_complete", "historical_update_complete"}, Default: "not_ready"},
+		{Name: "sync_count", Type: field.TypeInt, Default: 0},
+		{Name: "transactions_synced", Type: field.TypeInt, Default: 0},
+		{Name: "oldest_transaction_date", Type: field.TypeTime, Nullable: true},
+		{Name: "starter_rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "starter_analysis", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "analysis_error", Type: field.TypeString, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "item_id", Type: field.TypeUUID, Unique: true},
+	}
+	// BackfillJobsTable holds the schema information for the "backfill_jobs" table.
+	BackfillJobsTable = &schema.Table{
+		Name:       "backfill_jobs",
+		Columns:    BackfillJobsColumns,
+		PrimaryKey: []*schema.Column{BackfillJobsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backfill_jobs_items_backfill_job",
+				Columns:    []*schema.Column{BackfillJobsColumns[14]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "backfilljob_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{BackfillJobsColumns[1], BackfillJobsColumns[12]},
+			},
+		},
+	}
+	// BalanceSnapshotsColumns holds the columns for the "balance_snapshots" table.
+	BalanceSnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "date", Type: field.TypeTime},
+		{Name: "current_balance", Type: field.TypeInt64},
+		{Name: "available_balance", Type: field.TypeInt64, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID},
+	}
+	// BalanceSnapshotsTable holds the schema information for the "balance_snapshots" table.
+	BalanceSnapshotsTable = &schema.Table{
+		Name:       "balance_snapshots",
+		Columns:    BalanceSnapshotsColumns,
+		PrimaryKey: []*schema.Column{BalanceSnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "balance_snapshots_accounts_balance_snapshots",
+				Columns:    []*schema.Column{BalanceSnapshotsColumns[7]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.Cascade,
 			},
+		},
+		Indexes: []*schema.Index{
 			{
-				Name:    "account_plaid_id",
+				Name:    "balancesnapshot_account_id_date",
 				Unique:  true,
-				Columns: []*schema.Column{AccountsColumns[1]},
+				Columns: []*schema.Column{BalanceSnapshotsColumns[7], BalanceSnapshotsColumns[2]},
 			},
 			{
-				Name:    "account_item_id",
+				Name:    "balancesnapshot_user_id_date",
 				Unique:  false,
-				Columns: []*schema.Column{AccountsColumns[11]},
+				Columns: []*schema.Column{BalanceSnapshotsColumns[1], BalanceSnapshotsColumns[2]},
+			},
+		},
+	}
+	// BudgetsColumns holds the columns for the "budgets" table.
+	BudgetsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "category", Type: field.TypeEnum, Enums: []string{"Dining", "Groceries", "Transport", "Shopping", "Subscriptions", "Entertainment", "Bills", "Misc"}},
+		{Name: "amount_cents", Type: field.TypeInt64},
+		{Name: "alert_thresholds", Type: field.TypeJSON},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "family_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// BudgetsTable holds the schema information for the "budgets" table.
+	BudgetsTable = &schema.Table{
+		Name:       "budgets",
+		Columns:    BudgetsColumns,
+		PrimaryKey: []*schema.Column{BudgetsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budgets_families_budgets",
+				Columns:    []*schema.Column{BudgetsColumns[7]},
+				RefColumns: []*schema.Column{FamiliesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "budgets_users_budgets",
+				Columns:    []*schema.Column{BudgetsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budget_user_id_category",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetsColumns[8], BudgetsColumns[1]},
+			},
+			{
+				Name:    "budget_family_id_category",
+				Unique:  false,
+				Columns: []*schema.Column{BudgetsColumns[7], BudgetsColumns[1]},
+			},
+		},
+	}
+	// BudgetAlertsColumns holds the columns for the "budget_alerts" table.
+	BudgetAlertsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "month", Type: field.TypeString},
+		{Name: "threshold", Type: field.TypeInt},
+		{Name: "spent_cents", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "budget_id", Type: field.TypeUUID},
+	}
+	// BudgetAlertsTable holds the schema information for the "budget_alerts" table.
+	BudgetAlertsTable = &schema.Table{
+		Name:       "budget_alerts",
+		Columns:    BudgetAlertsColumns,
+		PrimaryKey: []*schema.Column{BudgetAlertsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "budget_alerts_budgets_alerts",
+				Columns:    []*schema.Column{BudgetAlertsColumns[5]},
+				RefColumns: []*schema.Column{BudgetsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "budgetalert_budget_id_month_threshold",
+				Unique:  true,
+				Columns: []*schema.Column{BudgetAlertsColumns[5], BudgetAlertsColumns[1], BudgetAlertsColumns[2]},
+			},
+		},
+	}
+	// FamiliesColumns holds the columns for the "families" table.
+	FamiliesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "savings_account_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// FamiliesTable holds the schema information for the "families" table.
+	FamiliesTable = &schema.Table{
+		Name:       "families",
+		Columns:    FamiliesColumns,
+		PrimaryKey: []*schema.Column{FamiliesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "families_accounts_savings_families",
+				Columns:    []*schema.Column{FamiliesColumns[4]},
+				RefColumns: []*schema.Column{AccountsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// FamilyMembersColumns holds the columns for the "family_members" table.
+	FamilyMembersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "parent", "member", "teen"}, Default: "member"},
+		{Name: "transaction_visibility", Type: field.TypeEnum, Enums: []string{"totals", "itemized"}, Default: "totals"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "family_id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+	}
+	// FamilyMembersTable holds the schema information for the "family_members" table.
+	FamilyMembersTable = &schema.Table{
+		Name:       "family_members",
+		Columns:    FamilyMembersColumns,
+		PrimaryKey: []*schema.Column{FamilyMembersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "family_members_families_members",
+				Columns:    []*schema.Column{FamilyMembersColumns[4]},
+				RefColumns: []*schema.Column{FamiliesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "family_members_users_family_membership",
+				Columns:    []*schema.Column{FamilyMembersColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "familymember_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{FamilyMembersColumns[4]},
+			},
+			{
+				Name:    "familymember_user_id",
+				Unique:  true,
+				Columns: []*schema.Column{FamilyMembersColumns[5]},
+			},
+		},
+	}
+	// GoalsColumns holds the columns for the "goals" table.
+	GoalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"savings", "spending_limit"}, Default: "savings"},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "target_cents", Type: field.TypeInt64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "family_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// GoalsTable holds the schema information for the "goals" table.
+	GoalsTable = &schema.Table{
+		Name:       "goals",
+		Columns:    GoalsColumns,
+		PrimaryKey: []*schema.Column{GoalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "goals_families_goals",
+				Columns:    []*schema.Column{GoalsColumns[7]},
+				RefColumns: []*schema.Column{FamiliesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "goals_users_goals",
+				Columns:    []*schema.Column{GoalsColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "goal_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{GoalsColumns[8]},
+			},
+			{
+				Name:    "goal_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{GoalsColumns[7]},
 			},
 		},
 	}
 	// ItemsColumns holds the columns for the "items" table.
 	ItemsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "plaid_id", Type: field.TypeString, Unique: true},
+		{Name: "provider", Type: field.TypeString, Default: "plaid"},
+		{Name: "external_id", Type: field.TypeString},
 		{Name: "access_token", Type: field.TypeString},
+		{Name: "access_token_key_id", Type: field.TypeString, Default: ""},
 		{Name: "institution_name", Type: field.TypeString},
+		{Name: "institution_id", Type: field.TypeString, Nullable: true},
+		{Name: "institution_logo", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "institution_color", Type: field.TypeString, Nullable: true},
+		{Name: "institution_url", Type: field.TypeString, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"healthy", "login_required", "institution_down", "rate_limited", "error"}, Default: "healthy"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "user_id", Type: field.TypeUUID},
@@ -80,7 +350,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "items_users_items",
-				Columns:    []*schema.Column{ItemsColumns[7]},
+				Columns:    []*schema.Column{ItemsColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -89,12 +359,58 @@ var (
 			{
 				Name:    "item_user_id_is_active",
 				Unique:  false,
-				Columns: []*schema.Column{ItemsColumns[7], ItemsColumns[4]},
+				Columns: []*schema.Column{ItemsColumns[14], ItemsColumns[10]},
+			},
+			{
+				Name:    "item_status",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[11]},
+			},
+			{
+				Name:    "item_access_token_key_id",
+				Unique:  false,
+				Columns: []*schema.Column{ItemsColumns[4]},
 			},
 			{
-				Name:    "item_plaid_id",
+				Name:    "item_provider_external_id",
 				Unique:  true,
-				Columns: []*schema.Column{ItemsColumns[1]},
+				Columns: []*schema.Column{ItemsColumns[1], ItemsColumns[2]},
+			},
+		},
+	}
+	// MonthlyReportsColumns holds the columns for the "monthly_reports" table.
+	MonthlyReportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "month", Type: field.TypeString},
+		{Name: "income_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "spend_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "savings_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "net_cents", Type: field.TypeInt64, Default: 0},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
+		{Name: "top_rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "goals", Type: field.TypeJSON, Nullable: true},
+		{Name: "category_changes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// MonthlyReportsTable holds the schema information for the "monthly_reports" table.
+	MonthlyReportsTable = &schema.Table{
+		Name:       "monthly_reports",
+		Columns:    MonthlyReportsColumns,
+		PrimaryKey: []*schema.Column{MonthlyReportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "monthly_reports_users_monthly_reports",
+				Columns:    []*schema.Column{MonthlyReportsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "monthlyreport_user_id_month",
+				Unique:  true,
+				Columns: []*schema.Column{MonthlyReportsColumns[11], MonthlyReportsColumns[1]},
 			},
 		},
 	}
@@ -135,6 +451,50 @@ var (
 			},
 		},
 	}
+	// RecurringChargesColumns holds the columns for the "recurring_charges" table.
+	RecurringChargesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "merchant_key", Type: field.TypeString},
+		{Name: "merchant_name", Type: field.TypeString},
+		{Name: "category", Type: field.TypeString, Default: "Misc"},
+		{Name: "cadence", Type: field.TypeEnum, Enums: []string{"weekly", "monthly", "annual"}},
+		{Name: "amount_cents", Type: field.TypeInt64},
+		{Name: "previous_amount_cents", Type: field.TypeInt64, Nullable: true},
+		{Name: "price_increased", Type: field.TypeBool, Default: false},
+		{Name: "occurrences", Type: field.TypeInt},
+		{Name: "last_charge_date", Type: field.TypeTime},
+		{Name: "next_charge_date", Type: field.TypeTime},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// RecurringChargesTable holds the schema information for the "recurring_charges" table.
+	RecurringChargesTable = &schema.Table{
+		Name:       "recurring_charges",
+		Columns:    RecurringChargesColumns,
+		PrimaryKey: []*schema.Column{RecurringChargesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "recurring_charges_users_recurring_charges",
+				Columns:    []*schema.Column{RecurringChargesColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "recurringcharge_user_id_merchant_key_cadence",
+				Unique:  true,
+				Columns: []*schema.Column{RecurringChargesColumns[14], RecurringChargesColumns[1], RecurringChargesColumns[4]},
+			},
+			{
+				Name:    "recurringcharge_user_id_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{RecurringChargesColumns[14], RecurringChargesColumns[11]},
+			},
+		},
+	}
 	// RulesColumns holds the columns for the "rules" table.
 	RulesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -142,6 +502,7 @@ var (
 		{Name: "category", Type: field.TypeEnum, Enums: []string{"Dining", "Groceries", "Transport", "Shopping", "Subscriptions", "Entertainment", "Bills", "Misc"}},
 		{Name: "min_amount_cents", Type: field.TypeInt64, Nullable: true},
 		{Name: "max_amount_cents", Type: field.TypeInt64, Nullable: true},
+		{Name: "monthly_threshold_cents", Type: field.TypeInt64, Nullable: true},
 		{Name: "action_type", Type: field.TypeEnum, Enums: []string{"multiply", "fixed"}},
 		{Name: "action_value", Type: field.TypeFloat64},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
@@ -151,6 +512,8 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "target_account_id", Type: field.TypeUUID},
+		{Name: "budget_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "family_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "user_id", Type: field.TypeUUID},
 	}
 	// RulesTable holds the schema information for the "rules" table.
@@ -161,13 +524,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "rules_accounts_target_rules",
-				Columns:    []*schema.Column{RulesColumns[13]},
+				Columns:    []*schema.Column{RulesColumns[14]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "rules_budgets_rules",
+				Columns:    []*schema.Column{RulesColumns[15]},
+				RefColumns: []*schema.Column{BudgetsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "rules_families_rules",
+				Columns:    []*schema.Column{RulesColumns[16]},
+				RefColumns: []*schema.Column{FamiliesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "rules_users_rules",
-				Columns:    []*schema.Column{RulesColumns[14]},
+				Columns:    []*schema.Column{RulesColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -176,7 +551,12 @@ var (
 			{
 				Name:    "rule_user_id_is_active",
 				Unique:  false,
-				Columns: []*schema.Column{RulesColumns[14], RulesColumns[7]},
+				Columns: []*schema.Column{RulesColumns[17], RulesColumns[8]},
+			},
+			{
+				Name:    "rule_family_id_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{RulesColumns[16], RulesColumns[8]},
 			},
 			{
 				Name:    "rule_category",
@@ -186,7 +566,7 @@ var (
 			{
 				Name:    "rule_priority",
 				Unique:  false,
-				Columns: []*schema.Column{RulesColumns[8]},
+				Columns: []*schema.Column{RulesColumns[9]},
 			},
 		},
 	}
@@ -200,6 +580,7 @@ var (
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "family_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "rule_id", Type: field.TypeUUID},
 		{Name: "transaction_id", Type: field.TypeUUID},
 		{Name: "user_id", Type: field.TypeUUID},
@@ -211,20 +592,26 @@ var (
 		PrimaryKey: []*schema.Column{RuleExecutionsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
 			{
-				Symbol:     "rule_executions_rules_executions",
+				Symbol:     "rule_executions_families_rule_executions",
 				Columns:    []*schema.Column{RuleExecutionsColumns[8]},
+				RefColumns: []*schema.Column{FamiliesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "rule_executions_rules_executions",
+				Columns:    []*schema.Column{RuleExecutionsColumns[9]},
 				RefColumns: []*schema.Column{RulesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "rule_executions_transactions_rule_executions",
-				Columns:    []*schema.Column{RuleExecutionsColumns[9]},
+				Columns:    []*schema.Column{RuleExecutionsColumns[10]},
 				RefColumns: []*schema.Column{TransactionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "rule_executions_users_rule_executions",
-				Columns:    []*schema.Column{RuleExecutionsColumns[10]},
+				Columns:    []*schema.Column{RuleExecutionsColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -233,12 +620,17 @@ var (
 			{
 				Name:    "ruleexecution_rule_id_transaction_id",
 				Unique:  true,
-				Columns: []*schema.Column{RuleExecutionsColumns[8], RuleExecutionsColumns[9]},
+				Columns: []*schema.Column{RuleExecutionsColumns[9], RuleExecutionsColumns[10]},
 			},
 			{
 				Name:    "ruleexecution_user_id_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{RuleExecutionsColumns[10], RuleExecutionsColumns[6]},
+				Columns: []*schema.Column{RuleExecutionsColumns[11], RuleExecutionsColumns[6]},
+			},
+			{
+				Name:    "ruleexecution_family_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RuleExecutionsColumns[8], RuleExecutionsColumns[6]},
 			},
 			{
 				Name:    "ruleexecution_status",
@@ -251,8 +643,9 @@ var (
 	SavingsTransfersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "amount_cents", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"suggested", "approved", "executed", "cancelled", "failed"}, Default: "suggested"},
-		{Name: "plaid_transfer_id", Type: field.TypeString, Nullable: true},
+		{Name: "external_transfer_id", Type: field.TypeString, Nullable: true},
 		{Name: "error_message", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "executed_at", Type: field.TypeTime, Nullable: true},
@@ -269,25 +662,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "savings_transfers_accounts_outgoing_transfers",
-				Columns:    []*schema.Column{SavingsTransfersColumns[7]},
+				Columns:    []*schema.Column{SavingsTransfersColumns[8]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "savings_transfers_accounts_incoming_transfers",
-				Columns:    []*schema.Column{SavingsTransfersColumns[8]},
+				Columns:    []*schema.Column{SavingsTransfersColumns[9]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "savings_transfers_rule_executions_transfer",
-				Columns:    []*schema.Column{SavingsTransfersColumns[9]},
+				Columns:    []*schema.Column{SavingsTransfersColumns[10]},
 				RefColumns: []*schema.Column{RuleExecutionsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "savings_transfers_users_savings_transfers",
-				Columns:    []*schema.Column{SavingsTransfersColumns[10]},
+				Columns:    []*schema.Column{SavingsTransfersColumns[11]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -296,17 +689,17 @@ var (
 			{
 				Name:    "savingstransfer_user_id_status",
 				Unique:  false,
-				Columns: []*schema.Column{SavingsTransfersColumns[10], SavingsTransfersColumns[2]},
+				Columns: []*schema.Column{SavingsTransfersColumns[11], SavingsTransfersColumns[3]},
 			},
 			{
 				Name:    "savingstransfer_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{SavingsTransfersColumns[5]},
+				Columns: []*schema.Column{SavingsTransfersColumns[6]},
 			},
 			{
 				Name:    "savingstransfer_rule_execution_id",
 				Unique:  true,
-				Columns: []*schema.Column{SavingsTransfersColumns[9]},
+				Columns: []*schema.Column{SavingsTransfersColumns[10]},
 			},
 		},
 	}
@@ -317,6 +710,7 @@ var (
 		{Name: "last_sync_at", Type: field.TypeTime},
 		{Name: "last_error", Type: field.TypeString, Default: ""},
 		{Name: "consecutive_failures", Type: field.TypeInt, Default: 0},
+		{Name: "next_sync_at", Type: field.TypeTime},
 		{Name: "item_id", Type: field.TypeUUID, Unique: true},
 	}
 	// SyncCursorsTable holds the schema information for the "sync_cursors" table.
@@ -327,7 +721,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sync_cursors_items_sync_cursor",
-				Columns:    []*schema.Column{SyncCursorsColumns[5]},
+				Columns:    []*schema.Column{SyncCursorsColumns[6]},
 				RefColumns: []*schema.Column{ItemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -336,22 +730,68 @@ var (
 			{
 				Name:    "synccursor_item_id",
 				Unique:  true,
+				Columns: []*schema.Column{SyncCursorsColumns[6]},
+			},
+			{
+				Name:    "synccursor_next_sync_at",
+				Unique:  false,
 				Columns: []*schema.Column{SyncCursorsColumns[5]},
 			},
 		},
 	}
+	// SyncRunsColumns holds the columns for the "sync_runs" table.
+	SyncRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "trigger", Type: field.TypeEnum, Enums: []string{"scheduled", "webhook", "manual", "link"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "succeeded", "failed"}, Default: "running"},
+		{Name: "added_count", Type: field.TypeInt, Default: 0},
+		{Name: "modified_count", Type: field.TypeInt, Default: 0},
+		{Name: "removed_count", Type: field.TypeInt, Default: 0},
+		{Name: "rules_triggered", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "item_id", Type: field.TypeUUID},
+	}
+	// SyncRunsTable holds the schema information for the "sync_runs" table.
+	SyncRunsTable = &schema.Table{
+		Name:       "sync_runs",
+		Columns:    SyncRunsColumns,
+		PrimaryKey: []*schema.Column{SyncRunsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sync_runs_items_sync_runs",
+				Columns:    []*schema.Column{SyncRunsColumns[10]},
+				RefColumns: []*schema.Column{ItemsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "syncrun_item_id_started_at",
+				Unique:  false,
+				Columns: []*schema.Column{SyncRunsColumns[10], SyncRunsColumns[8]},
+			},
+		},
+	}
 	// TransactionsColumns holds the columns for the "transactions" table.
 	TransactionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
-		{Name: "plaid_id", Type: field.TypeString, Unique: true},
+		{Name: "external_id", Type: field.TypeString},
 		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString, Default: "USD"},
 		{Name: "date", Type: field.TypeTime},
 		{Name: "name", Type: field.TypeString},
 		{Name: "merchant_name", Type: field.TypeString, Nullable: true},
 		{Name: "category", Type: field.TypeString, Default: "Misc"},
-		{Name: "plaid_categories", Type: field.TypeJSON, Nullable: true},
+		{Name: "provider_categories", Type: field.TypeJSON, Nullable: true},
 		{Name: "pending", Type: field.TypeBool, Default: false},
+		{Name: "pending_transaction_id", Type: field.TypeString, Nullable: true},
 		{Name: "payment_channel", Type: field.TypeString, Nullable: true},
+		{Name: "internal_transfer", Type: field.TypeBool, Default: false},
+		{Name: "transfer_pair_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "anomaly_score", Type: field.TypeFloat64, Nullable: true},
+		{Name: "anomaly_reason", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "account_id", Type: field.TypeUUID},
@@ -364,7 +804,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "transactions_accounts_transactions",
-				Columns:    []*schema.Column{TransactionsColumns[12]},
+				Columns:    []*schema.Column{TransactionsColumns[18]},
 				RefColumns: []*schema.Column{AccountsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -373,22 +813,27 @@ var (
 			{
 				Name:    "transaction_account_id_date",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[12], TransactionsColumns[3]},
+				Columns: []*schema.Column{TransactionsColumns[18], TransactionsColumns[4]},
 			},
 			{
-				Name:    "transaction_plaid_id",
+				Name:    "transaction_account_id_external_id",
 				Unique:  true,
-				Columns: []*schema.Column{TransactionsColumns[1]},
+				Columns: []*schema.Column{TransactionsColumns[18], TransactionsColumns[1]},
 			},
 			{
 				Name:    "transaction_category",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[6]},
+				Columns: []*schema.Column{TransactionsColumns[7]},
 			},
 			{
 				Name:    "transaction_pending",
 				Unique:  false,
-				Columns: []*schema.Column{TransactionsColumns[8]},
+				Columns: []*schema.Column{TransactionsColumns[9]},
+			},
+			{
+				Name:    "transaction_internal_transfer",
+				Unique:  false,
+				Columns: []*schema.Column{TransactionsColumns[12]},
 			},
 		},
 	}
@@ -398,6 +843,7 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true},
 		{Name: "password", Type: field.TypeBytes},
 		{Name: "nickname", Type: field.TypeString},
+		{Name: "home_currency", Type: field.TypeString, Default: "USD"},
 		{Name: "custody_account_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
@@ -408,7 +854,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_users_custody_account",
-				Columns:    []*schema.Column{UsersColumns[4]},
+				Columns:    []*schema.Column{UsersColumns[5]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -417,32 +863,59 @@ var (
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AccountsTable,
+		BackfillJobsTable,
+		BalanceSnapshotsTable,
+		BudgetsTable,
+		BudgetAlertsTable,
+		FamiliesTable,
+		FamilyMembersTable,
+		GoalsTable,
 		ItemsTable,
+		MonthlyReportsTable,
 		PushSubscriptionsTable,
+		RecurringChargesTable,
 		RulesTable,
 		RuleExecutionsTable,
 		SavingsTransfersTable,
 		SyncCursorsTable,
+		SyncRunsTable,
 		TransactionsTable,
 		UsersTable,
 	}
 )
 
 func init() {
-	AccountsTable.ForeignKeys[0].RefTable = ItemsTable
-	AccountsTable.ForeignKeys[1].RefTable = UsersTable
+	AccountsTable.ForeignKeys[0].RefTable = AccountsTable
+	AccountsTable.ForeignKeys[1].RefTable = ItemsTable
+	AccountsTable.ForeignKeys[2].RefTable = UsersTable
+	BackfillJobsTable.ForeignKeys[0].RefTable = ItemsTable
+	BalanceSnapshotsTable.ForeignKeys[0].RefTable = AccountsTable
+	BudgetsTable.ForeignKeys[0].RefTable = FamiliesTable
+	BudgetsTable.ForeignKeys[1].RefTable = UsersTable
+	BudgetAlertsTable.ForeignKeys[0].RefTable = BudgetsTable
+	FamiliesTable.ForeignKeys[0].RefTable = AccountsTable
+	FamilyMembersTable.ForeignKeys[0].RefTable = FamiliesTable
+	FamilyMembersTable.ForeignKeys[1].RefTable = UsersTable
+	GoalsTable.ForeignKeys[0].RefTable = FamiliesTable
+	GoalsTable.ForeignKeys[1].RefTable = UsersTable
 	ItemsTable.ForeignKeys[0].RefTable = UsersTable
+	MonthlyReportsTable.ForeignKeys[0].RefTable = UsersTable
 	PushSubscriptionsTable.ForeignKeys[0].RefTable = UsersTable
+	RecurringChargesTable.ForeignKeys[0].RefTable = UsersTable
 	RulesTable.ForeignKeys[0].RefTable = AccountsTable
-	RulesTable.ForeignKeys[1].RefTable = UsersTable
-	RuleExecutionsTable.ForeignKeys[0].RefTable = RulesTable
-	RuleExecutionsTable.ForeignKeys[1].RefTable = TransactionsTable
-	RuleExecutionsTable.ForeignKeys[2].RefTable = UsersTable
+	RulesTable.ForeignKeys[1].RefTable = BudgetsTable
+	RulesTable.ForeignKeys[2].RefTable = FamiliesTable
+	RulesTable.ForeignKeys[3].RefTable = UsersTable
+	RuleExecutionsTable.ForeignKeys[0].RefTable = FamiliesTable
+	RuleExecutionsTable.ForeignKeys[1].RefTable = RulesTable
+	RuleExecutionsTable.ForeignKeys[2].RefTable = TransactionsTable
+	RuleExecutionsTable.ForeignKeys[3].RefTable = UsersTable
 	SavingsTransfersTable.ForeignKeys[0].RefTable = AccountsTable
 	SavingsTransfersTable.ForeignKeys[1].RefTable = AccountsTable
 	SavingsTransfersTable.ForeignKeys[2].RefTable = RuleExecutionsTable
 	SavingsTransfersTable.ForeignKeys[3].RefTable = UsersTable
 	SyncCursorsTable.ForeignKeys[0].RefTable = ItemsTable
+	SyncRunsTable.ForeignKeys[0].RefTable = ItemsTable
 	TransactionsTable.ForeignKeys[0].RefTable = AccountsTable
 	UsersTable.ForeignKeys[0].RefTable = UsersTable
 }
@@ -1,11 +1,21 @@
 -- Code generated by ent, DO NOT EDIT.
--- atlas:pos accounts[type=table] /home/delta/projects/regulation/api/internal/ent/schema/account.go:15
--- atlas:pos items[type=table] /home/delta/projects/regulation/api/internal/ent/schema/item.go:15
+-- atlas:pos accounts[type=table] /home/delta/projects/regulation/api/internal/ent/schema/account.go:16
+-- atlas:pos backfill_jobs[type=table] /home/delta/projects/regulation/api/internal/ent/schema/backfill_job.go:17
+-- atlas:pos balance_snapshots[type=table] /home/delta/projects/regulation/api/internal/ent/schema/balance_snapshot.go:15
+-- atlas:pos budgets[type=table] /home/delta/projects/regulation/api/internal/ent/schema/budget.go:16
+-- atlas:pos budget_alerts[type=table] /home/delta/projects/regulation/api/internal/ent/schema/budget_alert.go:15
+-- atlas:pos families[type=table] /home/delta/projects/regulation/api/internal/ent/schema/family.go:14
+-- atlas:pos family_members[type=table] /home/delta/projects/regulation/api/internal/ent/schema/family_member.go:16
+-- atlas:pos goals[type=table] /home/delta/projects/regulation/api/internal/ent/schema/goal.go:15
+-- atlas:pos items[type=table] /home/delta/projects/regulation/api/internal/ent/schema/item.go:20
+-- atlas:pos monthly_reports[type=table] /home/delta/projects/regulation/api/internal/ent/schema/monthly_report.go:17
 -- atlas:pos push_subscriptions[type=table] /home/delta/projects/regulation/api/internal/ent/schema/push_subscription.go:15
+-- atlas:pos recurring_charges[type=table] /home/delta/projects/regulation/api/internal/ent/schema/recurring_charge.go:15
 -- atlas:pos rules[type=table] /home/delta/projects/regulation/api/internal/ent/schema/rule.go:15
 -- atlas:pos rule_executions[type=table] /home/delta/projects/regulation/api/internal/ent/schema/rule_execution.go:15
 -- atlas:pos savings_transfers[type=table] /home/delta/projects/regulation/api/internal/ent/schema/savings_transfer.go:15
 -- atlas:pos sync_cursors[type=table] /home/delta/projects/regulation/api/internal/ent/schema/sync_cursor.go:15
+-- atlas:pos sync_runs[type=table] /home/delta/projects/regulation/api/internal/ent/schema/sync_run.go:15
 -- atlas:pos transactions[type=table] /home/delta/projects/regulation/api/internal/ent/schema/transaction.go:15
 -- atlas:pos users[type=table] /home/delta/projects/regulation/api/internal/ent/schema/user.go:11
 
@@ -15,6 +25,7 @@ CREATE TABLE "users" (
   "email" character varying NOT NULL,
   "password" bytea NOT NULL,
   "nickname" character varying NOT NULL,
+  "home_currency" character varying NOT NULL DEFAULT 'USD',
   "custody_account_id" uuid NULL,
   PRIMARY KEY ("id"),
   CONSTRAINT "users_users_custody_account" FOREIGN KEY ("custody_account_id") REFERENCES "users" ("id") ON DELETE SET NULL
@@ -24,49 +35,199 @@ CREATE UNIQUE INDEX "users_email_key" ON "users" ("email");
 -- Create "items" table
 CREATE TABLE "items" (
   "id" uuid NOT NULL,
-  "plaid_id" character varying NOT NULL,
+  "provider" character varying NOT NULL DEFAULT 'plaid',
+  "external_id" character varying NOT NULL,
   "access_token" character varying NOT NULL,
+  "access_token_key_id" character varying NOT NULL DEFAULT '',
   "institution_name" character varying NOT NULL,
+  "institution_id" character varying NULL,
+  "institution_logo" text NULL,
+  "institution_color" character varying NULL,
+  "institution_url" character varying NULL,
   "is_active" boolean NOT NULL DEFAULT true,
+  "status" character varying NOT NULL DEFAULT 'healthy',
   "created_at" timestamptz NOT NULL,
   "updated_at" timestamptz NOT NULL,
   "user_id" uuid NOT NULL,
   PRIMARY KEY ("id"),
   CONSTRAINT "items_users_items" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
 );
--- Create index "items_plaid_id_key" to table: "items"
-CREATE UNIQUE INDEX "items_plaid_id_key" ON "items" ("plaid_id");
 -- Create index "item_user_id_is_active" to table: "items"
 CREATE INDEX "item_user_id_is_active" ON "items" ("user_id", "is_active");
--- Create index "item_plaid_id" to table: "items"
-CREATE UNIQUE INDEX "item_plaid_id" ON "items" ("plaid_id");
+-- Create index "item_status" to table: "items"
+CREATE INDEX "item_status" ON "items" ("status");
+-- Create index "item_access_token_key_id" to table: "items"
+CREATE INDEX "item_access_token_key_id" ON "items" ("access_token_key_id");
+-- Create index "item_provider_external_id" to table: "items"
+CREATE UNIQUE INDEX "item_provider_external_id" ON "items" ("provider", "external_id");
 -- Create "accounts" table
 CREATE TABLE "accounts" (
   "id" uuid NOT NULL,
-  "plaid_id" character varying NOT NULL,
+  "external_id" character varying NOT NULL,
   "name" character varying NOT NULL,
   "type" character varying NOT NULL DEFAULT 'checking',
   "subtype" character varying NULL,
   "mask" character varying NULL,
   "current_balance" bigint NOT NULL DEFAULT 0,
   "available_balance" bigint NULL,
+  "currency" character varying NOT NULL DEFAULT 'USD',
+  "credit_limit" bigint NULL,
   "is_active" boolean NOT NULL DEFAULT true,
+  "closed_at" timestamptz NULL,
+  "balance_date" timestamptz NULL,
   "created_at" timestamptz NOT NULL,
   "updated_at" timestamptz NOT NULL,
-  "item_id" uuid NOT NULL,
+  "funding_account_id" uuid NULL,
+  "item_id" uuid NULL,
   "user_id" uuid NOT NULL,
   PRIMARY KEY ("id"),
+  CONSTRAINT "accounts_accounts_funded_accounts" FOREIGN KEY ("funding_account_id") REFERENCES "accounts" ("id") ON DELETE SET NULL,
   CONSTRAINT "accounts_items_accounts" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE NO ACTION,
   CONSTRAINT "accounts_users_accounts" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
 );
--- Create index "accounts_plaid_id_key" to table: "accounts"
-CREATE UNIQUE INDEX "accounts_plaid_id_key" ON "accounts" ("plaid_id");
 -- Create index "account_user_id_is_active" to table: "accounts"
 CREATE INDEX "account_user_id_is_active" ON "accounts" ("user_id", "is_active");
--- Create index "account_plaid_id" to table: "accounts"
-CREATE UNIQUE INDEX "account_plaid_id" ON "accounts" ("plaid_id");
--- Create index "account_item_id" to table: "accounts"
-CREATE INDEX "account_item_id" ON "accounts" ("item_id");
+-- Create index "account_item_id_external_id" to table: "accounts"
+CREATE UNIQUE INDEX "account_item_id_external_id" ON "accounts" ("item_id", "external_id");
+-- Create "backfill_jobs" table
+CREATE TABLE "backfill_jobs" (
+  "id" uuid NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'queued',
+  "days_requested" bigint NOT NULL,
+  "update_status" character varying NOT NULL DEFAULT 'not_ready',
+  "sync_count" bigint NOT NULL DEFAULT 0,
+  "transactions_synced" bigint NOT NULL DEFAULT 0,
+  "oldest_transaction_date" timestamptz NULL,
+  "starter_rules" jsonb NULL,
+  "starter_analysis" text NULL,
+  "analysis_error" character varying NULL,
+  "error" character varying NULL,
+  "started_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "item_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "backfill_jobs_items_backfill_job" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE
+);
+-- Create index "backfill_jobs_item_id_key" to table: "backfill_jobs"
+CREATE UNIQUE INDEX "backfill_jobs_item_id_key" ON "backfill_jobs" ("item_id");
+-- Create index "backfilljob_status_updated_at" to table: "backfill_jobs"
+CREATE INDEX "backfilljob_status_updated_at" ON "backfill_jobs" ("status", "updated_at");
+-- Create "balance_snapshots" table
+CREATE TABLE "balance_snapshots" (
+  "id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  "date" timestamptz NOT NULL,
+  "current_balance" bigint NOT NULL,
+  "available_balance" bigint NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "account_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "balance_snapshots_accounts_balance_snapshots" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE CASCADE
+);
+-- Create index "balancesnapshot_account_id_date" to table: "balance_snapshots"
+CREATE UNIQUE INDEX "balancesnapshot_account_id_date" ON "balance_snapshots" ("account_id", "date");
+-- Create index "balancesnapshot_user_id_date" to table: "balance_snapshots"
+CREATE INDEX "balancesnapshot_user_id_date" ON "balance_snapshots" ("user_id", "date");
+-- Create "families" table
+CREATE TABLE "families" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "savings_account_id" uuid NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "families_accounts_savings_families" FOREIGN KEY ("savings_account_id") REFERENCES "accounts" ("id") ON DELETE SET NULL
+);
+-- Create "budgets" table
+CREATE TABLE "budgets" (
+  "id" uuid NOT NULL,
+  "category" character varying NOT NULL,
+  "amount_cents" bigint NOT NULL,
+  "alert_thresholds" jsonb NOT NULL,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "family_id" uuid NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "budgets_families_budgets" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON DELETE SET NULL,
+  CONSTRAINT "budgets_users_budgets" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+-- Create index "budget_user_id_category" to table: "budgets"
+CREATE INDEX "budget_user_id_category" ON "budgets" ("user_id", "category");
+-- Create index "budget_family_id_category" to table: "budgets"
+CREATE INDEX "budget_family_id_category" ON "budgets" ("family_id", "category");
+-- Create "budget_alerts" table
+CREATE TABLE "budget_alerts" (
+  "id" uuid NOT NULL,
+  "month" character varying NOT NULL,
+  "threshold" bigint NOT NULL,
+  "spent_cents" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "budget_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "budget_alerts_budgets_alerts" FOREIGN KEY ("budget_id") REFERENCES "budgets" ("id") ON DELETE CASCADE
+);
+-- Create index "budgetalert_budget_id_month_threshold" to table: "budget_alerts"
+CREATE UNIQUE INDEX "budgetalert_budget_id_month_threshold" ON "budget_alerts" ("budget_id", "month", "threshold");
+-- Create "family_members" table
+CREATE TABLE "family_members" (
+  "id" uuid NOT NULL,
+  "role" character varying NOT NULL DEFAULT 'member',
+  "transaction_visibility" character varying NOT NULL DEFAULT 'totals',
+  "created_at" timestamptz NOT NULL,
+  "family_id" uuid NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "family_members_families_members" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON DELETE NO ACTION,
+  CONSTRAINT "family_members_users_family_membership" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+-- Create index "family_members_user_id_key" to table: "family_members"
+CREATE UNIQUE INDEX "family_members_user_id_key" ON "family_members" ("user_id");
+-- Create index "familymember_family_id" to table: "family_members"
+CREATE INDEX "familymember_family_id" ON "family_members" ("family_id");
+-- Create index "familymember_user_id" to table: "family_members"
+CREATE UNIQUE INDEX "familymember_user_id" ON "family_members" ("user_id");
+-- Create "goals" table
+CREATE TABLE "goals" (
+  "id" uuid NOT NULL,
+  "name" character varying NOT NULL,
+  "kind" character varying NOT NULL DEFAULT 'savings',
+  "category" character varying NULL,
+  "target_cents" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "family_id" uuid NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "goals_families_goals" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON DELETE SET NULL,
+  CONSTRAINT "goals_users_goals" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+-- Create index "goal_user_id" to table: "goals"
+CREATE INDEX "goal_user_id" ON "goals" ("user_id");
+-- Create index "goal_family_id" to table: "goals"
+CREATE INDEX "goal_family_id" ON "goals" ("family_id");
+-- Create "monthly_reports" table
+CREATE TABLE "monthly_reports" (
+  "id" uuid NOT NULL,
+  "month" character varying NOT NULL,
+  "income_cents" bigint NOT NULL DEFAULT 0,
+  "spend_cents" bigint NOT NULL DEFAULT 0,
+  "savings_cents" bigint NOT NULL DEFAULT 0,
+  "net_cents" bigint NOT NULL DEFAULT 0,
+  "currency" character varying NOT NULL DEFAULT 'USD',
+  "top_rules" jsonb NULL,
+  "goals" jsonb NULL,
+  "category_changes" jsonb NULL,
+  "created_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "monthly_reports_users_monthly_reports" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+-- Create index "monthlyreport_user_id_month" to table: "monthly_reports"
+CREATE UNIQUE INDEX "monthlyreport_user_id_month" ON "monthly_reports" ("user_id", "month");
 -- Create "push_subscriptions" table
 CREATE TABLE "push_subscriptions" (
   "id" uuid NOT NULL,
@@ -84,6 +245,30 @@ CREATE TABLE "push_subscriptions" (
 CREATE INDEX "pushsubscription_user_id_active" ON "push_subscriptions" ("user_id", "active");
 -- Create index "pushsubscription_endpoint" to table: "push_subscriptions"
 CREATE UNIQUE INDEX "pushsubscription_endpoint" ON "push_subscriptions" ("endpoint");
+-- Create "recurring_charges" table
+CREATE TABLE "recurring_charges" (
+  "id" uuid NOT NULL,
+  "merchant_key" character varying NOT NULL,
+  "merchant_name" character varying NOT NULL,
+  "category" character varying NOT NULL DEFAULT 'Misc',
+  "cadence" character varying NOT NULL,
+  "amount_cents" bigint NOT NULL,
+  "previous_amount_cents" bigint NULL,
+  "price_increased" boolean NOT NULL DEFAULT false,
+  "occurrences" bigint NOT NULL,
+  "last_charge_date" timestamptz NOT NULL,
+  "next_charge_date" timestamptz NOT NULL,
+  "is_active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  "user_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "recurring_charges_users_recurring_charges" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
+);
+-- Create index "recurringcharge_user_id_merchant_key_cadence" to table: "recurring_charges"
+CREATE UNIQUE INDEX "recurringcharge_user_id_merchant_key_cadence" ON "recurring_charges" ("user_id", "merchant_key", "cadence");
+-- Create index "recurringcharge_user_id_is_active" to table: "recurring_charges"
+CREATE INDEX "recurringcharge_user_id_is_active" ON "recurring_charges" ("user_id", "is_active");
 -- Create "rules" table
 CREATE TABLE "rules" (
   "id" uuid NOT NULL,
@@ -91,6 +276,7 @@ CREATE TABLE "rules" (
   "category" character varying NOT NULL,
   "min_amount_cents" bigint NULL,
   "max_amount_cents" bigint NULL,
+  "monthly_threshold_cents" bigint NULL,
   "action_type" character varying NOT NULL,
   "action_value" double precision NOT NULL,
   "is_active" boolean NOT NULL DEFAULT true,
@@ -100,13 +286,19 @@ CREATE TABLE "rules" (
   "created_at" timestamptz NOT NULL,
   "updated_at" timestamptz NOT NULL,
   "target_account_id" uuid NOT NULL,
+  "budget_id" uuid NULL,
+  "family_id" uuid NULL,
   "user_id" uuid NOT NULL,
   PRIMARY KEY ("id"),
   CONSTRAINT "rules_accounts_target_rules" FOREIGN KEY ("target_account_id") REFERENCES "accounts" ("id") ON DELETE NO ACTION,
+  CONSTRAINT "rules_budgets_rules" FOREIGN KEY ("budget_id") REFERENCES "budgets" ("id") ON DELETE SET NULL,
+  CONSTRAINT "rules_families_rules" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON DELETE SET NULL,
   CONSTRAINT "rules_users_rules" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
 );
 -- Create index "rule_user_id_is_active" to table: "rules"
 CREATE INDEX "rule_user_id_is_active" ON "rules" ("user_id", "is_active");
+-- Create index "rule_family_id_is_active" to table: "rules"
+CREATE INDEX "rule_family_id_is_active" ON "rules" ("family_id", "is_active");
 -- Create index "rule_category" to table: "rules"
 CREATE INDEX "rule_category" ON "rules" ("category");
 -- Create index "rule_priority" to table: "rules"
@@ -114,31 +306,37 @@ CREATE INDEX "rule_priority" ON "rules" ("priority");
 -- Create "transactions" table
 CREATE TABLE "transactions" (
   "id" uuid NOT NULL,
-  "plaid_id" character varying NOT NULL,
+  "external_id" character varying NOT NULL,
   "amount" bigint NOT NULL,
+  "currency" character varying NOT NULL DEFAULT 'USD',
   "date" timestamptz NOT NULL,
   "name" character varying NOT NULL,
   "merchant_name" character varying NULL,
   "category" character varying NOT NULL DEFAULT 'Misc',
-  "plaid_categories" jsonb NULL,
+  "provider_categories" jsonb NULL,
   "pending" boolean NOT NULL DEFAULT false,
+  "pending_transaction_id" character varying NULL,
   "payment_channel" character varying NULL,
+  "internal_transfer" boolean NOT NULL DEFAULT false,
+  "transfer_pair_id" uuid NULL,
+  "anomaly_score" double precision NULL,
+  "anomaly_reason" character varying NULL,
   "created_at" timestamptz NOT NULL,
   "updated_at" timestamptz NOT NULL,
   "account_id" uuid NOT NULL,
   PRIMARY KEY ("id"),
   CONSTRAINT "transactions_accounts_transactions" FOREIGN KEY ("account_id") REFERENCES "accounts" ("id") ON DELETE NO ACTION
 );
--- Create index "transactions_plaid_id_key" to table: "transactions"
-CREATE UNIQUE INDEX "transactions_plaid_id_key" ON "transactions" ("plaid_id");
 -- Create index "transaction_account_id_date" to table: "transactions"
 CREATE INDEX "transaction_account_id_date" ON "transactions" ("account_id", "date");
--- Create index "transaction_plaid_id" to table: "transactions"
-CREATE UNIQUE INDEX "transaction_plaid_id" ON "transactions" ("plaid_id");
+-- Create index "transaction_account_id_external_id" to table: "transactions"
+CREATE UNIQUE INDEX "transaction_account_id_external_id" ON "transactions" ("account_id", "external_id");
 -- Create index "transaction_category" to table: "transactions"
 CREATE INDEX "transaction_category" ON "transactions" ("category");
 -- Create index "transaction_pending" to table: "transactions"
 CREATE INDEX "transaction_pending" ON "transactions" ("pending");
+-- Create index "transaction_internal_transfer" to table: "transactions"
+CREATE INDEX "transaction_internal_transfer" ON "transactions" ("internal_transfer");
 -- Create "rule_executions" table
 CREATE TABLE "rule_executions" (
   "id" uuid NOT NULL,
@@ -149,10 +347,12 @@ CREATE TABLE "rule_executions" (
   "error_message" character varying NULL,
   "created_at" timestamptz NOT NULL,
   "completed_at" timestamptz NULL,
+  "family_id" uuid NULL,
   "rule_id" uuid NOT NULL,
   "transaction_id" uuid NOT NULL,
   "user_id" uuid NOT NULL,
   PRIMARY KEY ("id"),
+  CONSTRAINT "rule_executions_families_rule_executions" FOREIGN KEY ("family_id") REFERENCES "families" ("id") ON DELETE SET NULL,
   CONSTRAINT "rule_executions_rules_executions" FOREIGN KEY ("rule_id") REFERENCES "rules" ("id") ON DELETE NO ACTION,
   CONSTRAINT "rule_executions_transactions_rule_executions" FOREIGN KEY ("transaction_id") REFERENCES "transactions" ("id") ON DELETE NO ACTION,
   CONSTRAINT "rule_executions_users_rule_executions" FOREIGN KEY ("user_id") REFERENCES "users" ("id") ON DELETE NO ACTION
@@ -161,14 +361,17 @@ CREATE TABLE "rule_executions" (
 CREATE UNIQUE INDEX "ruleexecution_rule_id_transaction_id" ON "rule_executions" ("rule_id", "transaction_id");
 -- Create index "ruleexecution_user_id_created_at" to table: "rule_executions"
 CREATE INDEX "ruleexecution_user_id_created_at" ON "rule_executions" ("user_id", "created_at");
+-- Create index "ruleexecution_family_id_created_at" to table: "rule_executions"
+CREATE INDEX "ruleexecution_family_id_created_at" ON "rule_executions" ("family_id", "created_at");
 -- Create index "ruleexecution_status" to table: "rule_executions"
 CREATE INDEX "ruleexecution_status" ON "rule_executions" ("status");
 -- Create "savings_transfers" table
 CREATE TABLE "savings_transfers" (
   "id" uuid NOT NULL,
   "amount_cents" bigint NOT NULL,
+  "currency" character varying NOT NULL DEFAULT 'USD',
   "status" character varying NOT NULL DEFAULT 'suggested',
-  "plaid_transfer_id" character varying NULL,
+  "external_transfer_id" character varying NULL,
   "error_message" character varying NULL,
   "created_at" timestamptz NOT NULL,
   "executed_at" timestamptz NULL,
@@ -197,6 +400,7 @@ CREATE TABLE "sync_cursors" (
   "last_sync_at" timestamptz NOT NULL,
   "last_error" character varying NOT NULL DEFAULT '',
   "consecutive_failures" bigint NOT NULL DEFAULT 0,
+  "next_sync_at" timestamptz NOT NULL,
   "item_id" uuid NOT NULL,
   PRIMARY KEY ("id"),
   CONSTRAINT "sync_cursors_items_sync_cursor" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE NO ACTION
@@ -205,4 +409,24 @@ CREATE TABLE "sync_cursors" (
 CREATE UNIQUE INDEX "sync_cursors_item_id_key" ON "sync_cursors" ("item_id");
 -- Create index "synccursor_item_id" to table: "sync_cursors"
 CREATE UNIQUE INDEX "synccursor_item_id" ON "sync_cursors" ("item_id");
+-- Create index "synccursor_next_sync_at" to table: "sync_cursors"
+CREATE INDEX "synccursor_next_sync_at" ON "sync_cursors" ("next_sync_at");
+-- Create "sync_runs" table
+CREATE TABLE "sync_runs" (
+  "id" uuid NOT NULL,
+  "trigger" character varying NOT NULL,
+  "status" character varying NOT NULL DEFAULT 'running',
+  "added_count" bigint NOT NULL DEFAULT 0,
+  "modified_count" bigint NOT NULL DEFAULT 0,
+  "removed_count" bigint NOT NULL DEFAULT 0,
+  "rules_triggered" bigint NOT NULL DEFAULT 0,
+  "error" character varying NULL,
+  "started_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "item_id" uuid NOT NULL,
+  PRIMARY KEY ("id"),
+  CONSTRAINT "sync_runs_items_sync_runs" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE
+);
+-- Create index "syncrun_item_id_started_at" to table: "sync_runs"
+CREATE INDEX "syncrun_item_id_started_at" ON "sync_runs" ("item_id", "started_at");
 
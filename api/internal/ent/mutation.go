@@ -7,13 +7,24 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/backfilljob"
+	"regulation/internal/ent/balancesnapshot"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/budgetalert"
+	"regulation/internal/ent/family"
+	"regulation/internal/ent/familymember"
+	"regulation/internal/ent/goal"
 	"regulation/internal/ent/item"
+	"regulation/internal/ent/monthlyreport"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/pushsubscription"
+	"regulation/internal/ent/recurringcharge"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/savingstransfer"
+	"regulation/internal/ent/schema/schematype"
 	"regulation/internal/ent/synccursor"
+	"regulation/internal/ent/syncrun"
 	"regulation/internal/ent/transaction"
 	"regulation/internal/ent/user"
 	"sync"
@@ -34,12 +45,22 @@ const (
 
 	// Node types.
 	TypeAccount          = "Account"
+	TypeBackfillJob      = "BackfillJob"
+	TypeBalanceSnapshot  = "BalanceSnapshot"
+	TypeBudget           = "Budget"
+	TypeBudgetAlert      = "BudgetAlert"
+	TypeFamily           = "Family"
+	TypeFamilyMember     = "FamilyMember"
+	TypeGoal             = "Goal"
 	TypeItem             = "Item"
+	TypeMonthlyReport    = "MonthlyReport"
 	TypePushSubscription = "PushSubscription"
+	TypeRecurringCharge  = "RecurringCharge"
 	TypeRule             = "Rule"
 	TypeRuleExecution    = "RuleExecution"
 	TypeSavingsTransfer  = "SavingsTransfer"
 	TypeSyncCursor       = "SyncCursor"
+	TypeSyncRun          = "SyncRun"
 	TypeTransaction      = "Transaction"
 	TypeUser             = "User"
 )
@@ -50,7 +71,7 @@ type AccountMutation struct {
 	op                        Op
 	typ                       string
 	id                        *uuid.UUID
-	plaid_id                  *string
+	external_id               *string
 	name                      *string
 	_type                     *account.Type
 	subtype                   *string
@@ -59,7 +80,12 @@ type AccountMutation struct {
 	addcurrent_balance        *int64
 	available_balance         *int64
 	addavailable_balance      *int64
+	currency                  *string
+	credit_limit              *int64
+	addcredit_limit           *int64
 	is_active                 *bool
+	closed_at                 *time.Time
+	balance_date              *time.Time
 	created_at                *time.Time
 	updated_at                *time.Time
 	clearedFields             map[string]struct{}
@@ -79,6 +105,17 @@ type AccountMutation struct {
 	incoming_transfers        map[uuid.UUID]struct{}
 	removedincoming_transfers map[uuid.UUID]struct{}
 	clearedincoming_transfers bool
+	savings_families          map[uuid.UUID]struct{}
+	removedsavings_families   map[uuid.UUID]struct{}
+	clearedsavings_families   bool
+	balance_snapshots         map[uuid.UUID]struct{}
+	removedbalance_snapshots  map[uuid.UUID]struct{}
+	clearedbalance_snapshots  bool
+	funding_account           *uuid.UUID
+	clearedfunding_account    bool
+	funded_accounts           map[uuid.UUID]struct{}
+	removedfunded_accounts    map[uuid.UUID]struct{}
+	clearedfunded_accounts    bool
 	done                      bool
 	oldValue                  func(context.Context) (*Account, error)
 	predicates                []predicate.Account
@@ -205,7 +242,7 @@ func (m *AccountMutation) ItemID() (r uuid.UUID, exists bool) {
 // OldItemID returns the old "item_id" field's value of the Account entity.
 // If the Account object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AccountMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *AccountMutation) OldItemID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
 	}
@@ -219,9 +256,22 @@ func (m *AccountMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error
 	return oldValue.ItemID, nil
 }
 
+// ClearItemID clears the value of the "item_id" field.
+func (m *AccountMutation) ClearItemID() {
+	m.item = nil
+	m.clearedFields[account.FieldItemID] = struct{}{}
+}
+
+// ItemIDCleared returns if the "item_id" field was cleared in this mutation.
+func (m *AccountMutation) ItemIDCleared() bool {
+	_, ok := m.clearedFields[account.FieldItemID]
+	return ok
+}
+
 // ResetItemID resets all changes to the "item_id" field.
 func (m *AccountMutation) ResetItemID() {
 	m.item = nil
+	delete(m.clearedFields, account.FieldItemID)
 }
 
 // SetUserID sets the "user_id" field.
@@ -260,40 +310,40 @@ func (m *AccountMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (m *AccountMutation) SetPlaidID(s string) {
-	m.plaid_id = &s
+// SetExternalID sets the "external_id" field.
+func (m *AccountMutation) SetExternalID(s string) {
+	m.external_id = &s
 }
 
-// PlaidID returns the value of the "plaid_id" field in the mutation.
-func (m *AccountMutation) PlaidID() (r string, exists bool) {
-	v := m.plaid_id
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *AccountMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlaidID returns the old "plaid_id" field's value of the Account entity.
+// OldExternalID returns the old "external_id" field's value of the Account entity.
 // If the Account object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AccountMutation) OldPlaidID(ctx context.Context) (v string, err error) {
+func (m *AccountMutation) OldExternalID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaidID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaidID requires an ID field in the mutation")
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaidID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
 	}
-	return oldValue.PlaidID, nil
+	return oldValue.ExternalID, nil
 }
 
-// ResetPlaidID resets all changes to the "plaid_id" field.
-func (m *AccountMutation) ResetPlaidID() {
-	m.plaid_id = nil
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *AccountMutation) ResetExternalID() {
+	m.external_id = nil
 }
 
 // SetName sets the "name" field.
@@ -592,6 +642,161 @@ func (m *AccountMutation) ResetAvailableBalance() {
 	delete(m.clearedFields, account.FieldAvailableBalance)
 }
 
+// SetCurrency sets the "currency" field.
+func (m *AccountMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *AccountMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *AccountMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetCreditLimit sets the "credit_limit" field.
+func (m *AccountMutation) SetCreditLimit(i int64) {
+	m.credit_limit = &i
+	m.addcredit_limit = nil
+}
+
+// CreditLimit returns the value of the "credit_limit" field in the mutation.
+func (m *AccountMutation) CreditLimit() (r int64, exists bool) {
+	v := m.credit_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreditLimit returns the old "credit_limit" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldCreditLimit(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreditLimit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreditLimit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreditLimit: %w", err)
+	}
+	return oldValue.CreditLimit, nil
+}
+
+// AddCreditLimit adds i to the "credit_limit" field.
+func (m *AccountMutation) AddCreditLimit(i int64) {
+	if m.addcredit_limit != nil {
+		*m.addcredit_limit += i
+	} else {
+		m.addcredit_limit = &i
+	}
+}
+
+// AddedCreditLimit returns the value that was added to the "credit_limit" field in this mutation.
+func (m *AccountMutation) AddedCreditLimit() (r int64, exists bool) {
+	v := m.addcredit_limit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearCreditLimit clears the value of the "credit_limit" field.
+func (m *AccountMutation) ClearCreditLimit() {
+	m.credit_limit = nil
+	m.addcredit_limit = nil
+	m.clearedFields[account.FieldCreditLimit] = struct{}{}
+}
+
+// CreditLimitCleared returns if the "credit_limit" field was cleared in this mutation.
+func (m *AccountMutation) CreditLimitCleared() bool {
+	_, ok := m.clearedFields[account.FieldCreditLimit]
+	return ok
+}
+
+// ResetCreditLimit resets all changes to the "credit_limit" field.
+func (m *AccountMutation) ResetCreditLimit() {
+	m.credit_limit = nil
+	m.addcredit_limit = nil
+	delete(m.clearedFields, account.FieldCreditLimit)
+}
+
+// SetFundingAccountID sets the "funding_account_id" field.
+func (m *AccountMutation) SetFundingAccountID(u uuid.UUID) {
+	m.funding_account = &u
+}
+
+// FundingAccountID returns the value of the "funding_account_id" field in the mutation.
+func (m *AccountMutation) FundingAccountID() (r uuid.UUID, exists bool) {
+	v := m.funding_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFundingAccountID returns the old "funding_account_id" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldFundingAccountID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFundingAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFundingAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFundingAccountID: %w", err)
+	}
+	return oldValue.FundingAccountID, nil
+}
+
+// ClearFundingAccountID clears the value of the "funding_account_id" field.
+func (m *AccountMutation) ClearFundingAccountID() {
+	m.funding_account = nil
+	m.clearedFields[account.FieldFundingAccountID] = struct{}{}
+}
+
+// FundingAccountIDCleared returns if the "funding_account_id" field was cleared in this mutation.
+func (m *AccountMutation) FundingAccountIDCleared() bool {
+	_, ok := m.clearedFields[account.FieldFundingAccountID]
+	return ok
+}
+
+// ResetFundingAccountID resets all changes to the "funding_account_id" field.
+func (m *AccountMutation) ResetFundingAccountID() {
+	m.funding_account = nil
+	delete(m.clearedFields, account.FieldFundingAccountID)
+}
+
 // SetIsActive sets the "is_active" field.
 func (m *AccountMutation) SetIsActive(b bool) {
 	m.is_active = &b
@@ -628,6 +833,104 @@ func (m *AccountMutation) ResetIsActive() {
 	m.is_active = nil
 }
 
+// SetClosedAt sets the "closed_at" field.
+func (m *AccountMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
+}
+
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *AccountMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedAt returns the old "closed_at" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
+	}
+	return oldValue.ClosedAt, nil
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *AccountMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[account.FieldClosedAt] = struct{}{}
+}
+
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *AccountMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[account.FieldClosedAt]
+	return ok
+}
+
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *AccountMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, account.FieldClosedAt)
+}
+
+// SetBalanceDate sets the "balance_date" field.
+func (m *AccountMutation) SetBalanceDate(t time.Time) {
+	m.balance_date = &t
+}
+
+// BalanceDate returns the value of the "balance_date" field in the mutation.
+func (m *AccountMutation) BalanceDate() (r time.Time, exists bool) {
+	v := m.balance_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBalanceDate returns the old "balance_date" field's value of the Account entity.
+// If the Account object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AccountMutation) OldBalanceDate(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBalanceDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBalanceDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBalanceDate: %w", err)
+	}
+	return oldValue.BalanceDate, nil
+}
+
+// ClearBalanceDate clears the value of the "balance_date" field.
+func (m *AccountMutation) ClearBalanceDate() {
+	m.balance_date = nil
+	m.clearedFields[account.FieldBalanceDate] = struct{}{}
+}
+
+// BalanceDateCleared returns if the "balance_date" field was cleared in this mutation.
+func (m *AccountMutation) BalanceDateCleared() bool {
+	_, ok := m.clearedFields[account.FieldBalanceDate]
+	return ok
+}
+
+// ResetBalanceDate resets all changes to the "balance_date" field.
+func (m *AccountMutation) ResetBalanceDate() {
+	m.balance_date = nil
+	delete(m.clearedFields, account.FieldBalanceDate)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *AccountMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -708,7 +1011,7 @@ func (m *AccountMutation) ClearItem() {
 
 // ItemCleared reports if the "item" edge to the Item entity was cleared.
 func (m *AccountMutation) ItemCleared() bool {
-	return m.cleareditem
+	return m.ItemIDCleared() || m.cleareditem
 }
 
 // ItemIDs returns the "item" edge IDs in the mutation.
@@ -970,6 +1273,195 @@ func (m *AccountMutation) ResetIncomingTransfers() {
 	m.removedincoming_transfers = nil
 }
 
+// AddSavingsFamilyIDs adds the "savings_families" edge to the Family entity by ids.
+func (m *AccountMutation) AddSavingsFamilyIDs(ids ...uuid.UUID) {
+	if m.savings_families == nil {
+		m.savings_families = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.savings_families[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSavingsFamilies clears the "savings_families" edge to the Family entity.
+func (m *AccountMutation) ClearSavingsFamilies() {
+	m.clearedsavings_families = true
+}
+
+// SavingsFamiliesCleared reports if the "savings_families" edge to the Family entity was cleared.
+func (m *AccountMutation) SavingsFamiliesCleared() bool {
+	return m.clearedsavings_families
+}
+
+// RemoveSavingsFamilyIDs removes the "savings_families" edge to the Family entity by IDs.
+func (m *AccountMutation) RemoveSavingsFamilyIDs(ids ...uuid.UUID) {
+	if m.removedsavings_families == nil {
+		m.removedsavings_families = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.savings_families, ids[i])
+		m.removedsavings_families[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSavingsFamilies returns the removed IDs of the "savings_families" edge to the Family entity.
+func (m *AccountMutation) RemovedSavingsFamiliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedsavings_families {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SavingsFamiliesIDs returns the "savings_families" edge IDs in the mutation.
+func (m *AccountMutation) SavingsFamiliesIDs() (ids []uuid.UUID) {
+	for id := range m.savings_families {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSavingsFamilies resets all changes to the "savings_families" edge.
+func (m *AccountMutation) ResetSavingsFamilies() {
+	m.savings_families = nil
+	m.clearedsavings_families = false
+	m.removedsavings_families = nil
+}
+
+// AddBalanceSnapshotIDs adds the "balance_snapshots" edge to the BalanceSnapshot entity by ids.
+func (m *AccountMutation) AddBalanceSnapshotIDs(ids ...uuid.UUID) {
+	if m.balance_snapshots == nil {
+		m.balance_snapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.balance_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBalanceSnapshots clears the "balance_snapshots" edge to the BalanceSnapshot entity.
+func (m *AccountMutation) ClearBalanceSnapshots() {
+	m.clearedbalance_snapshots = true
+}
+
+// BalanceSnapshotsCleared reports if the "balance_snapshots" edge to the BalanceSnapshot entity was cleared.
+func (m *AccountMutation) BalanceSnapshotsCleared() bool {
+	return m.clearedbalance_snapshots
+}
+
+// RemoveBalanceSnapshotIDs removes the "balance_snapshots" edge to the BalanceSnapshot entity by IDs.
+func (m *AccountMutation) RemoveBalanceSnapshotIDs(ids ...uuid.UUID) {
+	if m.removedbalance_snapshots == nil {
+		m.removedbalance_snapshots = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.balance_snapshots, ids[i])
+		m.removedbalance_snapshots[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBalanceSnapshots returns the removed IDs of the "balance_snapshots" edge to the BalanceSnapshot entity.
+func (m *AccountMutation) RemovedBalanceSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbalance_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BalanceSnapshotsIDs returns the "balance_snapshots" edge IDs in the mutation.
+func (m *AccountMutation) BalanceSnapshotsIDs() (ids []uuid.UUID) {
+	for id := range m.balance_snapshots {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBalanceSnapshots resets all changes to the "balance_snapshots" edge.
+func (m *AccountMutation) ResetBalanceSnapshots() {
+	m.balance_snapshots = nil
+	m.clearedbalance_snapshots = false
+	m.removedbalance_snapshots = nil
+}
+
+// ClearFundingAccount clears the "funding_account" edge to the Account entity.
+func (m *AccountMutation) ClearFundingAccount() {
+	m.clearedfunding_account = true
+	m.clearedFields[account.FieldFundingAccountID] = struct{}{}
+}
+
+// FundingAccountCleared reports if the "funding_account" edge to the Account entity was cleared.
+func (m *AccountMutation) FundingAccountCleared() bool {
+	return m.FundingAccountIDCleared() || m.clearedfunding_account
+}
+
+// FundingAccountIDs returns the "funding_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FundingAccountID instead. It exists only for internal usage by the builders.
+func (m *AccountMutation) FundingAccountIDs() (ids []uuid.UUID) {
+	if id := m.funding_account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFundingAccount resets all changes to the "funding_account" edge.
+func (m *AccountMutation) ResetFundingAccount() {
+	m.funding_account = nil
+	m.clearedfunding_account = false
+}
+
+// AddFundedAccountIDs adds the "funded_accounts" edge to the Account entity by ids.
+func (m *AccountMutation) AddFundedAccountIDs(ids ...uuid.UUID) {
+	if m.funded_accounts == nil {
+		m.funded_accounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.funded_accounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFundedAccounts clears the "funded_accounts" edge to the Account entity.
+func (m *AccountMutation) ClearFundedAccounts() {
+	m.clearedfunded_accounts = true
+}
+
+// FundedAccountsCleared reports if the "funded_accounts" edge to the Account entity was cleared.
+func (m *AccountMutation) FundedAccountsCleared() bool {
+	return m.clearedfunded_accounts
+}
+
+// RemoveFundedAccountIDs removes the "funded_accounts" edge to the Account entity by IDs.
+func (m *AccountMutation) RemoveFundedAccountIDs(ids ...uuid.UUID) {
+	if m.removedfunded_accounts == nil {
+		m.removedfunded_accounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.funded_accounts, ids[i])
+		m.removedfunded_accounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFundedAccounts returns the removed IDs of the "funded_accounts" edge to the Account entity.
+func (m *AccountMutation) RemovedFundedAccountsIDs() (ids []uuid.UUID) {
+	for id := range m.removedfunded_accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FundedAccountsIDs returns the "funded_accounts" edge IDs in the mutation.
+func (m *AccountMutation) FundedAccountsIDs() (ids []uuid.UUID) {
+	for id := range m.funded_accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFundedAccounts resets all changes to the "funded_accounts" edge.
+func (m *AccountMutation) ResetFundedAccounts() {
+	m.funded_accounts = nil
+	m.clearedfunded_accounts = false
+	m.removedfunded_accounts = nil
+}
+
 // Where appends a list predicates to the AccountMutation builder.
 func (m *AccountMutation) Where(ps ...predicate.Account) {
 	m.predicates = append(m.predicates, ps...)
@@ -1004,15 +1496,15 @@ func (m *AccountMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AccountMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+	fields := make([]string, 0, 17)
 	if m.item != nil {
 		fields = append(fields, account.FieldItemID)
 	}
 	if m.user != nil {
 		fields = append(fields, account.FieldUserID)
 	}
-	if m.plaid_id != nil {
-		fields = append(fields, account.FieldPlaidID)
+	if m.external_id != nil {
+		fields = append(fields, account.FieldExternalID)
 	}
 	if m.name != nil {
 		fields = append(fields, account.FieldName)
@@ -1032,9 +1524,24 @@ func (m *AccountMutation) Fields() []string {
 	if m.available_balance != nil {
 		fields = append(fields, account.FieldAvailableBalance)
 	}
+	if m.currency != nil {
+		fields = append(fields, account.FieldCurrency)
+	}
+	if m.credit_limit != nil {
+		fields = append(fields, account.FieldCreditLimit)
+	}
+	if m.funding_account != nil {
+		fields = append(fields, account.FieldFundingAccountID)
+	}
 	if m.is_active != nil {
 		fields = append(fields, account.FieldIsActive)
 	}
+	if m.closed_at != nil {
+		fields = append(fields, account.FieldClosedAt)
+	}
+	if m.balance_date != nil {
+		fields = append(fields, account.FieldBalanceDate)
+	}
 	if m.created_at != nil {
 		fields = append(fields, account.FieldCreatedAt)
 	}
@@ -1053,8 +1560,8 @@ func (m *AccountMutation) Field(name string) (ent.Value, bool) {
 		return m.ItemID()
 	case account.FieldUserID:
 		return m.UserID()
-	case account.FieldPlaidID:
-		return m.PlaidID()
+	case account.FieldExternalID:
+		return m.ExternalID()
 	case account.FieldName:
 		return m.Name()
 	case account.FieldType:
@@ -1067,8 +1574,18 @@ func (m *AccountMutation) Field(name string) (ent.Value, bool) {
 		return m.CurrentBalance()
 	case account.FieldAvailableBalance:
 		return m.AvailableBalance()
+	case account.FieldCurrency:
+		return m.Currency()
+	case account.FieldCreditLimit:
+		return m.CreditLimit()
+	case account.FieldFundingAccountID:
+		return m.FundingAccountID()
 	case account.FieldIsActive:
 		return m.IsActive()
+	case account.FieldClosedAt:
+		return m.ClosedAt()
+	case account.FieldBalanceDate:
+		return m.BalanceDate()
 	case account.FieldCreatedAt:
 		return m.CreatedAt()
 	case account.FieldUpdatedAt:
@@ -1086,8 +1603,8 @@ func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldItemID(ctx)
 	case account.FieldUserID:
 		return m.OldUserID(ctx)
-	case account.FieldPlaidID:
-		return m.OldPlaidID(ctx)
+	case account.FieldExternalID:
+		return m.OldExternalID(ctx)
 	case account.FieldName:
 		return m.OldName(ctx)
 	case account.FieldType:
@@ -1100,8 +1617,18 @@ func (m *AccountMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldCurrentBalance(ctx)
 	case account.FieldAvailableBalance:
 		return m.OldAvailableBalance(ctx)
+	case account.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case account.FieldCreditLimit:
+		return m.OldCreditLimit(ctx)
+	case account.FieldFundingAccountID:
+		return m.OldFundingAccountID(ctx)
 	case account.FieldIsActive:
 		return m.OldIsActive(ctx)
+	case account.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	case account.FieldBalanceDate:
+		return m.OldBalanceDate(ctx)
 	case account.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case account.FieldUpdatedAt:
@@ -1129,12 +1656,12 @@ func (m *AccountMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUserID(v)
 		return nil
-	case account.FieldPlaidID:
+	case account.FieldExternalID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlaidID(v)
+		m.SetExternalID(v)
 		return nil
 	case account.FieldName:
 		v, ok := value.(string)
@@ -1178,6 +1705,27 @@ func (m *AccountMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAvailableBalance(v)
 		return nil
+	case account.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case account.FieldCreditLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreditLimit(v)
+		return nil
+	case account.FieldFundingAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFundingAccountID(v)
+		return nil
 	case account.FieldIsActive:
 		v, ok := value.(bool)
 		if !ok {
@@ -1185,14 +1733,28 @@ func (m *AccountMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsActive(v)
 		return nil
-	case account.FieldCreatedAt:
+	case account.FieldClosedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetClosedAt(v)
 		return nil
-	case account.FieldUpdatedAt:
+	case account.FieldBalanceDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBalanceDate(v)
+		return nil
+	case account.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case account.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1213,6 +1775,9 @@ func (m *AccountMutation) AddedFields() []string {
 	if m.addavailable_balance != nil {
 		fields = append(fields, account.FieldAvailableBalance)
 	}
+	if m.addcredit_limit != nil {
+		fields = append(fields, account.FieldCreditLimit)
+	}
 	return fields
 }
 
@@ -1225,6 +1790,8 @@ func (m *AccountMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedCurrentBalance()
 	case account.FieldAvailableBalance:
 		return m.AddedAvailableBalance()
+	case account.FieldCreditLimit:
+		return m.AddedCreditLimit()
 	}
 	return nil, false
 }
@@ -1248,6 +1815,13 @@ func (m *AccountMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddAvailableBalance(v)
 		return nil
+	case account.FieldCreditLimit:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCreditLimit(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Account numeric field %s", name)
 }
@@ -1256,6 +1830,9 @@ func (m *AccountMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *AccountMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(account.FieldItemID) {
+		fields = append(fields, account.FieldItemID)
+	}
 	if m.FieldCleared(account.FieldSubtype) {
 		fields = append(fields, account.FieldSubtype)
 	}
@@ -1265,6 +1842,18 @@ func (m *AccountMutation) ClearedFields() []string {
 	if m.FieldCleared(account.FieldAvailableBalance) {
 		fields = append(fields, account.FieldAvailableBalance)
 	}
+	if m.FieldCleared(account.FieldCreditLimit) {
+		fields = append(fields, account.FieldCreditLimit)
+	}
+	if m.FieldCleared(account.FieldFundingAccountID) {
+		fields = append(fields, account.FieldFundingAccountID)
+	}
+	if m.FieldCleared(account.FieldClosedAt) {
+		fields = append(fields, account.FieldClosedAt)
+	}
+	if m.FieldCleared(account.FieldBalanceDate) {
+		fields = append(fields, account.FieldBalanceDate)
+	}
 	return fields
 }
 
@@ -1279,6 +1868,9 @@ func (m *AccountMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *AccountMutation) ClearField(name string) error {
 	switch name {
+	case account.FieldItemID:
+		m.ClearItemID()
+		return nil
 	case account.FieldSubtype:
 		m.ClearSubtype()
 		return nil
@@ -1288,6 +1880,18 @@ func (m *AccountMutation) ClearField(name string) error {
 	case account.FieldAvailableBalance:
 		m.ClearAvailableBalance()
 		return nil
+	case account.FieldCreditLimit:
+		m.ClearCreditLimit()
+		return nil
+	case account.FieldFundingAccountID:
+		m.ClearFundingAccountID()
+		return nil
+	case account.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
+	case account.FieldBalanceDate:
+		m.ClearBalanceDate()
+		return nil
 	}
 	return fmt.Errorf("unknown Account nullable field %s", name)
 }
@@ -1302,8 +1906,8 @@ func (m *AccountMutation) ResetField(name string) error {
 	case account.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case account.FieldPlaidID:
-		m.ResetPlaidID()
+	case account.FieldExternalID:
+		m.ResetExternalID()
 		return nil
 	case account.FieldName:
 		m.ResetName()
@@ -1323,9 +1927,24 @@ func (m *AccountMutation) ResetField(name string) error {
 	case account.FieldAvailableBalance:
 		m.ResetAvailableBalance()
 		return nil
+	case account.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case account.FieldCreditLimit:
+		m.ResetCreditLimit()
+		return nil
+	case account.FieldFundingAccountID:
+		m.ResetFundingAccountID()
+		return nil
 	case account.FieldIsActive:
 		m.ResetIsActive()
 		return nil
+	case account.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	case account.FieldBalanceDate:
+		m.ResetBalanceDate()
+		return nil
 	case account.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -1338,7 +1957,7 @@ func (m *AccountMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *AccountMutation) AddedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 10)
 	if m.item != nil {
 		edges = append(edges, account.EdgeItem)
 	}
@@ -1357,6 +1976,18 @@ func (m *AccountMutation) AddedEdges() []string {
 	if m.incoming_transfers != nil {
 		edges = append(edges, account.EdgeIncomingTransfers)
 	}
+	if m.savings_families != nil {
+		edges = append(edges, account.EdgeSavingsFamilies)
+	}
+	if m.balance_snapshots != nil {
+		edges = append(edges, account.EdgeBalanceSnapshots)
+	}
+	if m.funding_account != nil {
+		edges = append(edges, account.EdgeFundingAccount)
+	}
+	if m.funded_accounts != nil {
+		edges = append(edges, account.EdgeFundedAccounts)
+	}
 	return edges
 }
 
@@ -1396,13 +2027,35 @@ func (m *AccountMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case account.EdgeSavingsFamilies:
+		ids := make([]ent.Value, 0, len(m.savings_families))
+		for id := range m.savings_families {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeBalanceSnapshots:
+		ids := make([]ent.Value, 0, len(m.balance_snapshots))
+		for id := range m.balance_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeFundingAccount:
+		if id := m.funding_account; id != nil {
+			return []ent.Value{*id}
+		}
+	case account.EdgeFundedAccounts:
+		ids := make([]ent.Value, 0, len(m.funded_accounts))
+		for id := range m.funded_accounts {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *AccountMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 10)
 	if m.removedtransactions != nil {
 		edges = append(edges, account.EdgeTransactions)
 	}
@@ -1415,6 +2068,15 @@ func (m *AccountMutation) RemovedEdges() []string {
 	if m.removedincoming_transfers != nil {
 		edges = append(edges, account.EdgeIncomingTransfers)
 	}
+	if m.removedsavings_families != nil {
+		edges = append(edges, account.EdgeSavingsFamilies)
+	}
+	if m.removedbalance_snapshots != nil {
+		edges = append(edges, account.EdgeBalanceSnapshots)
+	}
+	if m.removedfunded_accounts != nil {
+		edges = append(edges, account.EdgeFundedAccounts)
+	}
 	return edges
 }
 
@@ -1446,13 +2108,31 @@ func (m *AccountMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case account.EdgeSavingsFamilies:
+		ids := make([]ent.Value, 0, len(m.removedsavings_families))
+		for id := range m.removedsavings_families {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeBalanceSnapshots:
+		ids := make([]ent.Value, 0, len(m.removedbalance_snapshots))
+		for id := range m.removedbalance_snapshots {
+			ids = append(ids, id)
+		}
+		return ids
+	case account.EdgeFundedAccounts:
+		ids := make([]ent.Value, 0, len(m.removedfunded_accounts))
+		for id := range m.removedfunded_accounts {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *AccountMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 6)
+	edges := make([]string, 0, 10)
 	if m.cleareditem {
 		edges = append(edges, account.EdgeItem)
 	}
@@ -1471,6 +2151,18 @@ func (m *AccountMutation) ClearedEdges() []string {
 	if m.clearedincoming_transfers {
 		edges = append(edges, account.EdgeIncomingTransfers)
 	}
+	if m.clearedsavings_families {
+		edges = append(edges, account.EdgeSavingsFamilies)
+	}
+	if m.clearedbalance_snapshots {
+		edges = append(edges, account.EdgeBalanceSnapshots)
+	}
+	if m.clearedfunding_account {
+		edges = append(edges, account.EdgeFundingAccount)
+	}
+	if m.clearedfunded_accounts {
+		edges = append(edges, account.EdgeFundedAccounts)
+	}
 	return edges
 }
 
@@ -1490,6 +2182,14 @@ func (m *AccountMutation) EdgeCleared(name string) bool {
 		return m.clearedoutgoing_transfers
 	case account.EdgeIncomingTransfers:
 		return m.clearedincoming_transfers
+	case account.EdgeSavingsFamilies:
+		return m.clearedsavings_families
+	case account.EdgeBalanceSnapshots:
+		return m.clearedbalance_snapshots
+	case account.EdgeFundingAccount:
+		return m.clearedfunding_account
+	case account.EdgeFundedAccounts:
+		return m.clearedfunded_accounts
 	}
 	return false
 }
@@ -1504,6 +2204,9 @@ func (m *AccountMutation) ClearEdge(name string) error {
 	case account.EdgeUser:
 		m.ClearUser()
 		return nil
+	case account.EdgeFundingAccount:
+		m.ClearFundingAccount()
+		return nil
 	}
 	return fmt.Errorf("unknown Account unique edge %s", name)
 }
@@ -1530,46 +2233,64 @@ func (m *AccountMutation) ResetEdge(name string) error {
 	case account.EdgeIncomingTransfers:
 		m.ResetIncomingTransfers()
 		return nil
+	case account.EdgeSavingsFamilies:
+		m.ResetSavingsFamilies()
+		return nil
+	case account.EdgeBalanceSnapshots:
+		m.ResetBalanceSnapshots()
+		return nil
+	case account.EdgeFundingAccount:
+		m.ResetFundingAccount()
+		return nil
+	case account.EdgeFundedAccounts:
+		m.ResetFundedAccounts()
+		return nil
 	}
 	return fmt.Errorf("unknown Account edge %s", name)
 }
 
-// ItemMutation represents an operation that mutates the Item nodes in the graph.
-type ItemMutation struct {
+// BackfillJobMutation represents an operation that mutates the BackfillJob nodes in the graph.
+type BackfillJobMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	plaid_id           *string
-	access_token       *string
-	institution_name   *string
-	is_active          *bool
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	user               *uuid.UUID
-	cleareduser        bool
-	accounts           map[uuid.UUID]struct{}
-	removedaccounts    map[uuid.UUID]struct{}
-	clearedaccounts    bool
-	sync_cursor        *uuid.UUID
-	clearedsync_cursor bool
-	done               bool
-	oldValue           func(context.Context) (*Item, error)
-	predicates         []predicate.Item
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	status                  *backfilljob.Status
+	days_requested          *int
+	adddays_requested       *int
+	update_status           *backfilljob.UpdateStatus
+	sync_count              *int
+	addsync_count           *int
+	transactions_synced     *int
+	addtransactions_synced  *int
+	oldest_transaction_date *time.Time
+	starter_rules           *[]schematype.StarterRule
+	appendstarter_rules     []schematype.StarterRule
+	starter_analysis        *string
+	analysis_error          *string
+	error                   *string
+	started_at              *time.Time
+	updated_at              *time.Time
+	finished_at             *time.Time
+	clearedFields           map[string]struct{}
+	item                    *uuid.UUID
+	cleareditem             bool
+	done                    bool
+	oldValue                func(context.Context) (*BackfillJob, error)
+	predicates              []predicate.BackfillJob
 }
 
-var _ ent.Mutation = (*ItemMutation)(nil)
+var _ ent.Mutation = (*BackfillJobMutation)(nil)
 
-// itemOption allows management of the mutation configuration using functional options.
-type itemOption func(*ItemMutation)
+// backfilljobOption allows management of the mutation configuration using functional options.
+type backfilljobOption func(*BackfillJobMutation)
 
-// newItemMutation creates new mutation for the Item entity.
-func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
-	m := &ItemMutation{
+// newBackfillJobMutation creates new mutation for the BackfillJob entity.
+func newBackfillJobMutation(c config, op Op, opts ...backfilljobOption) *BackfillJobMutation {
+	m := &BackfillJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeItem,
+		typ:           TypeBackfillJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1578,20 +2299,20 @@ func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
 	return m
 }
 
-// withItemID sets the ID field of the mutation.
-func withItemID(id uuid.UUID) itemOption {
-	return func(m *ItemMutation) {
+// withBackfillJobID sets the ID field of the mutation.
+func withBackfillJobID(id uuid.UUID) backfilljobOption {
+	return func(m *BackfillJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Item
+			value *BackfillJob
 		)
-		m.oldValue = func(ctx context.Context) (*Item, error) {
+		m.oldValue = func(ctx context.Context) (*BackfillJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Item.Get(ctx, id)
+					value, err = m.Client().BackfillJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1600,10 +2321,10 @@ func withItemID(id uuid.UUID) itemOption {
 	}
 }
 
-// withItem sets the old Item of the mutation.
-func withItem(node *Item) itemOption {
-	return func(m *ItemMutation) {
-		m.oldValue = func(context.Context) (*Item, error) {
+// withBackfillJob sets the old BackfillJob of the mutation.
+func withBackfillJob(node *BackfillJob) backfilljobOption {
+	return func(m *BackfillJobMutation) {
+		m.oldValue = func(context.Context) (*BackfillJob, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1612,7 +2333,7 @@ func withItem(node *Item) itemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ItemMutation) Client() *Client {
+func (m BackfillJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1620,7 +2341,7 @@ func (m ItemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ItemMutation) Tx() (*Tx, error) {
+func (m BackfillJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1630,14 +2351,14 @@ func (m ItemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Item entities.
-func (m *ItemMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of BackfillJob entities.
+func (m *BackfillJobMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BackfillJobMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1648,7 +2369,7 @@ func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BackfillJobMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1657,393 +2378,706 @@ func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BackfillJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *ItemMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetItemID sets the "item_id" field.
+func (m *BackfillJobMutation) SetItemID(u uuid.UUID) {
+	m.item = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *ItemMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *BackfillJobMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldItemID returns the old "item_id" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BackfillJobMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldItemID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.ItemID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *ItemMutation) ResetUserID() {
-	m.user = nil
+// ResetItemID resets all changes to the "item_id" field.
+func (m *BackfillJobMutation) ResetItemID() {
+	m.item = nil
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (m *ItemMutation) SetPlaidID(s string) {
-	m.plaid_id = &s
+// SetStatus sets the "status" field.
+func (m *BackfillJobMutation) SetStatus(b backfilljob.Status) {
+	m.status = &b
 }
 
-// PlaidID returns the value of the "plaid_id" field in the mutation.
-func (m *ItemMutation) PlaidID() (r string, exists bool) {
-	v := m.plaid_id
+// Status returns the value of the "status" field in the mutation.
+func (m *BackfillJobMutation) Status() (r backfilljob.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlaidID returns the old "plaid_id" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldPlaidID(ctx context.Context) (v string, err error) {
+func (m *BackfillJobMutation) OldStatus(ctx context.Context) (v backfilljob.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaidID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaidID requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaidID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.PlaidID, nil
+	return oldValue.Status, nil
 }
 
-// ResetPlaidID resets all changes to the "plaid_id" field.
-func (m *ItemMutation) ResetPlaidID() {
-	m.plaid_id = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *BackfillJobMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetAccessToken sets the "access_token" field.
-func (m *ItemMutation) SetAccessToken(s string) {
-	m.access_token = &s
+// SetDaysRequested sets the "days_requested" field.
+func (m *BackfillJobMutation) SetDaysRequested(i int) {
+	m.days_requested = &i
+	m.adddays_requested = nil
 }
 
-// AccessToken returns the value of the "access_token" field in the mutation.
-func (m *ItemMutation) AccessToken() (r string, exists bool) {
-	v := m.access_token
+// DaysRequested returns the value of the "days_requested" field in the mutation.
+func (m *BackfillJobMutation) DaysRequested() (r int, exists bool) {
+	v := m.days_requested
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccessToken returns the old "access_token" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldDaysRequested returns the old "days_requested" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+func (m *BackfillJobMutation) OldDaysRequested(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldDaysRequested is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+		return v, errors.New("OldDaysRequested requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldDaysRequested: %w", err)
 	}
-	return oldValue.AccessToken, nil
+	return oldValue.DaysRequested, nil
 }
 
-// ResetAccessToken resets all changes to the "access_token" field.
-func (m *ItemMutation) ResetAccessToken() {
-	m.access_token = nil
+// AddDaysRequested adds i to the "days_requested" field.
+func (m *BackfillJobMutation) AddDaysRequested(i int) {
+	if m.adddays_requested != nil {
+		*m.adddays_requested += i
+	} else {
+		m.adddays_requested = &i
+	}
 }
 
-// SetInstitutionName sets the "institution_name" field.
-func (m *ItemMutation) SetInstitutionName(s string) {
-	m.institution_name = &s
+// AddedDaysRequested returns the value that was added to the "days_requested" field in this mutation.
+func (m *BackfillJobMutation) AddedDaysRequested() (r int, exists bool) {
+	v := m.adddays_requested
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// InstitutionName returns the value of the "institution_name" field in the mutation.
-func (m *ItemMutation) InstitutionName() (r string, exists bool) {
-	v := m.institution_name
+// ResetDaysRequested resets all changes to the "days_requested" field.
+func (m *BackfillJobMutation) ResetDaysRequested() {
+	m.days_requested = nil
+	m.adddays_requested = nil
+}
+
+// SetUpdateStatus sets the "update_status" field.
+func (m *BackfillJobMutation) SetUpdateStatus(bs backfilljob.UpdateStatus) {
+	m.update_status = &bs
+}
+
+// UpdateStatus returns the value of the "update_status" field in the mutation.
+func (m *BackfillJobMutation) UpdateStatus() (r backfilljob.UpdateStatus, exists bool) {
+	v := m.update_status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInstitutionName returns the old "institution_name" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdateStatus returns the old "update_status" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldInstitutionName(ctx context.Context) (v string, err error) {
+func (m *BackfillJobMutation) OldUpdateStatus(ctx context.Context) (v backfilljob.UpdateStatus, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInstitutionName is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdateStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInstitutionName requires an ID field in the mutation")
+		return v, errors.New("OldUpdateStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInstitutionName: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdateStatus: %w", err)
 	}
-	return oldValue.InstitutionName, nil
+	return oldValue.UpdateStatus, nil
 }
 
-// ResetInstitutionName resets all changes to the "institution_name" field.
-func (m *ItemMutation) ResetInstitutionName() {
-	m.institution_name = nil
+// ResetUpdateStatus resets all changes to the "update_status" field.
+func (m *BackfillJobMutation) ResetUpdateStatus() {
+	m.update_status = nil
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *ItemMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// SetSyncCount sets the "sync_count" field.
+func (m *BackfillJobMutation) SetSyncCount(i int) {
+	m.sync_count = &i
+	m.addsync_count = nil
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *ItemMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// SyncCount returns the value of the "sync_count" field in the mutation.
+func (m *BackfillJobMutation) SyncCount() (r int, exists bool) {
+	v := m.sync_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldSyncCount returns the old "sync_count" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *BackfillJobMutation) OldSyncCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldSyncCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+		return v, errors.New("OldSyncCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldSyncCount: %w", err)
 	}
-	return oldValue.IsActive, nil
+	return oldValue.SyncCount, nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *ItemMutation) ResetIsActive() {
-	m.is_active = nil
+// AddSyncCount adds i to the "sync_count" field.
+func (m *BackfillJobMutation) AddSyncCount(i int) {
+	if m.addsync_count != nil {
+		*m.addsync_count += i
+	} else {
+		m.addsync_count = &i
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ItemMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedSyncCount returns the value that was added to the "sync_count" field in this mutation.
+func (m *BackfillJobMutation) AddedSyncCount() (r int, exists bool) {
+	v := m.addsync_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetSyncCount resets all changes to the "sync_count" field.
+func (m *BackfillJobMutation) ResetSyncCount() {
+	m.sync_count = nil
+	m.addsync_count = nil
+}
+
+// SetTransactionsSynced sets the "transactions_synced" field.
+func (m *BackfillJobMutation) SetTransactionsSynced(i int) {
+	m.transactions_synced = &i
+	m.addtransactions_synced = nil
+}
+
+// TransactionsSynced returns the value of the "transactions_synced" field in the mutation.
+func (m *BackfillJobMutation) TransactionsSynced() (r int, exists bool) {
+	v := m.transactions_synced
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldTransactionsSynced returns the old "transactions_synced" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackfillJobMutation) OldTransactionsSynced(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTransactionsSynced is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTransactionsSynced requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTransactionsSynced: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.TransactionsSynced, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ItemMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddTransactionsSynced adds i to the "transactions_synced" field.
+func (m *BackfillJobMutation) AddTransactionsSynced(i int) {
+	if m.addtransactions_synced != nil {
+		*m.addtransactions_synced += i
+	} else {
+		m.addtransactions_synced = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ItemMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedTransactionsSynced returns the value that was added to the "transactions_synced" field in this mutation.
+func (m *BackfillJobMutation) AddedTransactionsSynced() (r int, exists bool) {
+	v := m.addtransactions_synced
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetTransactionsSynced resets all changes to the "transactions_synced" field.
+func (m *BackfillJobMutation) ResetTransactionsSynced() {
+	m.transactions_synced = nil
+	m.addtransactions_synced = nil
+}
+
+// SetOldestTransactionDate sets the "oldest_transaction_date" field.
+func (m *BackfillJobMutation) SetOldestTransactionDate(t time.Time) {
+	m.oldest_transaction_date = &t
+}
+
+// OldestTransactionDate returns the value of the "oldest_transaction_date" field in the mutation.
+func (m *BackfillJobMutation) OldestTransactionDate() (r time.Time, exists bool) {
+	v := m.oldest_transaction_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Item entity.
-// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// OldOldestTransactionDate returns the old "oldest_transaction_date" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BackfillJobMutation) OldOldestTransactionDate(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldOldestTransactionDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldOldestTransactionDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldOldestTransactionDate: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.OldestTransactionDate, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ItemMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearOldestTransactionDate clears the value of the "oldest_transaction_date" field.
+func (m *BackfillJobMutation) ClearOldestTransactionDate() {
+	m.oldest_transaction_date = nil
+	m.clearedFields[backfilljob.FieldOldestTransactionDate] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *ItemMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[item.FieldUserID] = struct{}{}
+// OldestTransactionDateCleared returns if the "oldest_transaction_date" field was cleared in this mutation.
+func (m *BackfillJobMutation) OldestTransactionDateCleared() bool {
+	_, ok := m.clearedFields[backfilljob.FieldOldestTransactionDate]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ItemMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetOldestTransactionDate resets all changes to the "oldest_transaction_date" field.
+func (m *BackfillJobMutation) ResetOldestTransactionDate() {
+	m.oldest_transaction_date = nil
+	delete(m.clearedFields, backfilljob.FieldOldestTransactionDate)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SetStarterRules sets the "starter_rules" field.
+func (m *BackfillJobMutation) SetStarterRules(sr []schematype.StarterRule) {
+	m.starter_rules = &sr
+	m.appendstarter_rules = nil
+}
+
+// StarterRules returns the value of the "starter_rules" field in the mutation.
+func (m *BackfillJobMutation) StarterRules() (r []schematype.StarterRule, exists bool) {
+	v := m.starter_rules
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *ItemMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldStarterRules returns the old "starter_rules" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackfillJobMutation) OldStarterRules(ctx context.Context) (v []schematype.StarterRule, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStarterRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStarterRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStarterRules: %w", err)
+	}
+	return oldValue.StarterRules, nil
 }
 
-// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
-func (m *ItemMutation) AddAccountIDs(ids ...uuid.UUID) {
-	if m.accounts == nil {
-		m.accounts = make(map[uuid.UUID]struct{})
+// AppendStarterRules adds sr to the "starter_rules" field.
+func (m *BackfillJobMutation) AppendStarterRules(sr []schematype.StarterRule) {
+	m.appendstarter_rules = append(m.appendstarter_rules, sr...)
+}
+
+// AppendedStarterRules returns the list of values that were appended to the "starter_rules" field in this mutation.
+func (m *BackfillJobMutation) AppendedStarterRules() ([]schematype.StarterRule, bool) {
+	if len(m.appendstarter_rules) == 0 {
+		return nil, false
 	}
-	for i := range ids {
-		m.accounts[ids[i]] = struct{}{}
+	return m.appendstarter_rules, true
+}
+
+// ClearStarterRules clears the value of the "starter_rules" field.
+func (m *BackfillJobMutation) ClearStarterRules() {
+	m.starter_rules = nil
+	m.appendstarter_rules = nil
+	m.clearedFields[backfilljob.FieldStarterRules] = struct{}{}
+}
+
+// StarterRulesCleared returns if the "starter_rules" field was cleared in this mutation.
+func (m *BackfillJobMutation) StarterRulesCleared() bool {
+	_, ok := m.clearedFields[backfilljob.FieldStarterRules]
+	return ok
+}
+
+// ResetStarterRules resets all changes to the "starter_rules" field.
+func (m *BackfillJobMutation) ResetStarterRules() {
+	m.starter_rules = nil
+	m.appendstarter_rules = nil
+	delete(m.clearedFields, backfilljob.FieldStarterRules)
+}
+
+// SetStarterAnalysis sets the "starter_analysis" field.
+func (m *BackfillJobMutation) SetStarterAnalysis(s string) {
+	m.starter_analysis = &s
+}
+
+// StarterAnalysis returns the value of the "starter_analysis" field in the mutation.
+func (m *BackfillJobMutation) StarterAnalysis() (r string, exists bool) {
+	v := m.starter_analysis
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAccounts clears the "accounts" edge to the Account entity.
-func (m *ItemMutation) ClearAccounts() {
-	m.clearedaccounts = true
+// OldStarterAnalysis returns the old "starter_analysis" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackfillJobMutation) OldStarterAnalysis(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStarterAnalysis is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStarterAnalysis requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStarterAnalysis: %w", err)
+	}
+	return oldValue.StarterAnalysis, nil
 }
 
-// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
-func (m *ItemMutation) AccountsCleared() bool {
-	return m.clearedaccounts
+// ClearStarterAnalysis clears the value of the "starter_analysis" field.
+func (m *BackfillJobMutation) ClearStarterAnalysis() {
+	m.starter_analysis = nil
+	m.clearedFields[backfilljob.FieldStarterAnalysis] = struct{}{}
 }
 
-// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
-func (m *ItemMutation) RemoveAccountIDs(ids ...uuid.UUID) {
-	if m.removedaccounts == nil {
-		m.removedaccounts = make(map[uuid.UUID]struct{})
+// StarterAnalysisCleared returns if the "starter_analysis" field was cleared in this mutation.
+func (m *BackfillJobMutation) StarterAnalysisCleared() bool {
+	_, ok := m.clearedFields[backfilljob.FieldStarterAnalysis]
+	return ok
+}
+
+// ResetStarterAnalysis resets all changes to the "starter_analysis" field.
+func (m *BackfillJobMutation) ResetStarterAnalysis() {
+	m.starter_analysis = nil
+	delete(m.clearedFields, backfilljob.FieldStarterAnalysis)
+}
+
+// SetAnalysisError sets the "analysis_error" field.
+func (m *BackfillJobMutation) SetAnalysisError(s string) {
+	m.analysis_error = &s
+}
+
+// AnalysisError returns the value of the "analysis_error" field in the mutation.
+func (m *BackfillJobMutation) AnalysisError() (r string, exists bool) {
+	v := m.analysis_error
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.accounts, ids[i])
-		m.removedaccounts[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldAnalysisError returns the old "analysis_error" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackfillJobMutation) OldAnalysisError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnalysisError is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnalysisError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnalysisError: %w", err)
+	}
+	return oldValue.AnalysisError, nil
 }
 
-// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
-func (m *ItemMutation) RemovedAccountsIDs() (ids []uuid.UUID) {
-	for id := range m.removedaccounts {
-		ids = append(ids, id)
+// ClearAnalysisError clears the value of the "analysis_error" field.
+func (m *BackfillJobMutation) ClearAnalysisError() {
+	m.analysis_error = nil
+	m.clearedFields[backfilljob.FieldAnalysisError] = struct{}{}
+}
+
+// AnalysisErrorCleared returns if the "analysis_error" field was cleared in this mutation.
+func (m *BackfillJobMutation) AnalysisErrorCleared() bool {
+	_, ok := m.clearedFields[backfilljob.FieldAnalysisError]
+	return ok
+}
+
+// ResetAnalysisError resets all changes to the "analysis_error" field.
+func (m *BackfillJobMutation) ResetAnalysisError() {
+	m.analysis_error = nil
+	delete(m.clearedFields, backfilljob.FieldAnalysisError)
+}
+
+// SetError sets the "error" field.
+func (m *BackfillJobMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *BackfillJobMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AccountsIDs returns the "accounts" edge IDs in the mutation.
-func (m *ItemMutation) AccountsIDs() (ids []uuid.UUID) {
-	for id := range m.accounts {
-		ids = append(ids, id)
+// OldError returns the old "error" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackfillJobMutation) OldError(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
 }
 
-// ResetAccounts resets all changes to the "accounts" edge.
-func (m *ItemMutation) ResetAccounts() {
-	m.accounts = nil
-	m.clearedaccounts = false
-	m.removedaccounts = nil
+// ClearError clears the value of the "error" field.
+func (m *BackfillJobMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[backfilljob.FieldError] = struct{}{}
 }
 
-// SetSyncCursorID sets the "sync_cursor" edge to the SyncCursor entity by id.
-func (m *ItemMutation) SetSyncCursorID(id uuid.UUID) {
-	m.sync_cursor = &id
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *BackfillJobMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[backfilljob.FieldError]
+	return ok
 }
 
-// ClearSyncCursor clears the "sync_cursor" edge to the SyncCursor entity.
-func (m *ItemMutation) ClearSyncCursor() {
-	m.clearedsync_cursor = true
+// ResetError resets all changes to the "error" field.
+func (m *BackfillJobMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, backfilljob.FieldError)
 }
 
-// SyncCursorCleared reports if the "sync_cursor" edge to the SyncCursor entity was cleared.
-func (m *ItemMutation) SyncCursorCleared() bool {
-	return m.clearedsync_cursor
+// SetStartedAt sets the "started_at" field.
+func (m *BackfillJobMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// SyncCursorID returns the "sync_cursor" edge ID in the mutation.
-func (m *ItemMutation) SyncCursorID() (id uuid.UUID, exists bool) {
-	if m.sync_cursor != nil {
-		return *m.sync_cursor, true
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *BackfillJobMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// SyncCursorIDs returns the "sync_cursor" edge IDs in the mutation.
+// OldStartedAt returns the old "started_at" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackfillJobMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
+	}
+	return oldValue.StartedAt, nil
+}
+
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *BackfillJobMutation) ResetStartedAt() {
+	m.started_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BackfillJobMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BackfillJobMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackfillJobMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BackfillJobMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *BackfillJobMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *BackfillJobMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the BackfillJob entity.
+// If the BackfillJob object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BackfillJobMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *BackfillJobMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[backfilljob.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *BackfillJobMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[backfilljob.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *BackfillJobMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, backfilljob.FieldFinishedAt)
+}
+
+// ClearItem clears the "item" edge to the Item entity.
+func (m *BackfillJobMutation) ClearItem() {
+	m.cleareditem = true
+	m.clearedFields[backfilljob.FieldItemID] = struct{}{}
+}
+
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *BackfillJobMutation) ItemCleared() bool {
+	return m.cleareditem
+}
+
+// ItemIDs returns the "item" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SyncCursorID instead. It exists only for internal usage by the builders.
-func (m *ItemMutation) SyncCursorIDs() (ids []uuid.UUID) {
-	if id := m.sync_cursor; id != nil {
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *BackfillJobMutation) ItemIDs() (ids []uuid.UUID) {
+	if id := m.item; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSyncCursor resets all changes to the "sync_cursor" edge.
-func (m *ItemMutation) ResetSyncCursor() {
-	m.sync_cursor = nil
-	m.clearedsync_cursor = false
+// ResetItem resets all changes to the "item" edge.
+func (m *BackfillJobMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
-// Where appends a list predicates to the ItemMutation builder.
-func (m *ItemMutation) Where(ps ...predicate.Item) {
+// Where appends a list predicates to the BackfillJobMutation builder.
+func (m *BackfillJobMutation) Where(ps ...predicate.BackfillJob) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BackfillJobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Item, len(ps))
+func (m *BackfillJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BackfillJob, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2051,45 +3085,66 @@ func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ItemMutation) Op() Op {
+func (m *BackfillJobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ItemMutation) SetOp(op Op) {
+func (m *BackfillJobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Item).
-func (m *ItemMutation) Type() string {
+// Type returns the node type of this mutation (BackfillJob).
+func (m *BackfillJobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ItemMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.user != nil {
-		fields = append(fields, item.FieldUserID)
-	}
-	if m.plaid_id != nil {
-		fields = append(fields, item.FieldPlaidID)
+func (m *BackfillJobMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.item != nil {
+		fields = append(fields, backfilljob.FieldItemID)
 	}
-	if m.access_token != nil {
-		fields = append(fields, item.FieldAccessToken)
+	if m.status != nil {
+		fields = append(fields, backfilljob.FieldStatus)
 	}
-	if m.institution_name != nil {
-		fields = append(fields, item.FieldInstitutionName)
+	if m.days_requested != nil {
+		fields = append(fields, backfilljob.FieldDaysRequested)
 	}
-	if m.is_active != nil {
-		fields = append(fields, item.FieldIsActive)
+	if m.update_status != nil {
+		fields = append(fields, backfilljob.FieldUpdateStatus)
 	}
-	if m.created_at != nil {
-		fields = append(fields, item.FieldCreatedAt)
+	if m.sync_count != nil {
+		fields = append(fields, backfilljob.FieldSyncCount)
+	}
+	if m.transactions_synced != nil {
+		fields = append(fields, backfilljob.FieldTransactionsSynced)
+	}
+	if m.oldest_transaction_date != nil {
+		fields = append(fields, backfilljob.FieldOldestTransactionDate)
+	}
+	if m.starter_rules != nil {
+		fields = append(fields, backfilljob.FieldStarterRules)
+	}
+	if m.starter_analysis != nil {
+		fields = append(fields, backfilljob.FieldStarterAnalysis)
+	}
+	if m.analysis_error != nil {
+		fields = append(fields, backfilljob.FieldAnalysisError)
+	}
+	if m.error != nil {
+		fields = append(fields, backfilljob.FieldError)
+	}
+	if m.started_at != nil {
+		fields = append(fields, backfilljob.FieldStartedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, item.FieldUpdatedAt)
+		fields = append(fields, backfilljob.FieldUpdatedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, backfilljob.FieldFinishedAt)
 	}
 	return fields
 }
@@ -2097,22 +3152,36 @@ func (m *ItemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+func (m *BackfillJobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case item.FieldUserID:
-		return m.UserID()
-	case item.FieldPlaidID:
-		return m.PlaidID()
-	case item.FieldAccessToken:
-		return m.AccessToken()
-	case item.FieldInstitutionName:
-		return m.InstitutionName()
-	case item.FieldIsActive:
-		return m.IsActive()
-	case item.FieldCreatedAt:
-		return m.CreatedAt()
-	case item.FieldUpdatedAt:
+	case backfilljob.FieldItemID:
+		return m.ItemID()
+	case backfilljob.FieldStatus:
+		return m.Status()
+	case backfilljob.FieldDaysRequested:
+		return m.DaysRequested()
+	case backfilljob.FieldUpdateStatus:
+		return m.UpdateStatus()
+	case backfilljob.FieldSyncCount:
+		return m.SyncCount()
+	case backfilljob.FieldTransactionsSynced:
+		return m.TransactionsSynced()
+	case backfilljob.FieldOldestTransactionDate:
+		return m.OldestTransactionDate()
+	case backfilljob.FieldStarterRules:
+		return m.StarterRules()
+	case backfilljob.FieldStarterAnalysis:
+		return m.StarterAnalysis()
+	case backfilljob.FieldAnalysisError:
+		return m.AnalysisError()
+	case backfilljob.FieldError:
+		return m.Error()
+	case backfilljob.FieldStartedAt:
+		return m.StartedAt()
+	case backfilljob.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case backfilljob.FieldFinishedAt:
+		return m.FinishedAt()
 	}
 	return nil, false
 }
@@ -2120,185 +3189,331 @@ func (m *ItemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BackfillJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case item.FieldUserID:
-		return m.OldUserID(ctx)
-	case item.FieldPlaidID:
-		return m.OldPlaidID(ctx)
-	case item.FieldAccessToken:
-		return m.OldAccessToken(ctx)
-	case item.FieldInstitutionName:
-		return m.OldInstitutionName(ctx)
-	case item.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case item.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case item.FieldUpdatedAt:
+	case backfilljob.FieldItemID:
+		return m.OldItemID(ctx)
+	case backfilljob.FieldStatus:
+		return m.OldStatus(ctx)
+	case backfilljob.FieldDaysRequested:
+		return m.OldDaysRequested(ctx)
+	case backfilljob.FieldUpdateStatus:
+		return m.OldUpdateStatus(ctx)
+	case backfilljob.FieldSyncCount:
+		return m.OldSyncCount(ctx)
+	case backfilljob.FieldTransactionsSynced:
+		return m.OldTransactionsSynced(ctx)
+	case backfilljob.FieldOldestTransactionDate:
+		return m.OldOldestTransactionDate(ctx)
+	case backfilljob.FieldStarterRules:
+		return m.OldStarterRules(ctx)
+	case backfilljob.FieldStarterAnalysis:
+		return m.OldStarterAnalysis(ctx)
+	case backfilljob.FieldAnalysisError:
+		return m.OldAnalysisError(ctx)
+	case backfilljob.FieldError:
+		return m.OldError(ctx)
+	case backfilljob.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case backfilljob.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case backfilljob.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Item field %s", name)
+	return nil, fmt.Errorf("unknown BackfillJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) SetField(name string, value ent.Value) error {
+func (m *BackfillJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case item.FieldUserID:
+	case backfilljob.FieldItemID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetItemID(v)
 		return nil
-	case item.FieldPlaidID:
-		v, ok := value.(string)
+	case backfilljob.FieldStatus:
+		v, ok := value.(backfilljob.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlaidID(v)
+		m.SetStatus(v)
 		return nil
-	case item.FieldAccessToken:
+	case backfilljob.FieldDaysRequested:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDaysRequested(v)
+		return nil
+	case backfilljob.FieldUpdateStatus:
+		v, ok := value.(backfilljob.UpdateStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateStatus(v)
+		return nil
+	case backfilljob.FieldSyncCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSyncCount(v)
+		return nil
+	case backfilljob.FieldTransactionsSynced:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionsSynced(v)
+		return nil
+	case backfilljob.FieldOldestTransactionDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOldestTransactionDate(v)
+		return nil
+	case backfilljob.FieldStarterRules:
+		v, ok := value.([]schematype.StarterRule)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStarterRules(v)
+		return nil
+	case backfilljob.FieldStarterAnalysis:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccessToken(v)
+		m.SetStarterAnalysis(v)
 		return nil
-	case item.FieldInstitutionName:
+	case backfilljob.FieldAnalysisError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInstitutionName(v)
+		m.SetAnalysisError(v)
 		return nil
-	case item.FieldIsActive:
-		v, ok := value.(bool)
+	case backfilljob.FieldError:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsActive(v)
+		m.SetError(v)
 		return nil
-	case item.FieldCreatedAt:
+	case backfilljob.FieldStartedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetStartedAt(v)
 		return nil
-	case item.FieldUpdatedAt:
+	case backfilljob.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case backfilljob.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown BackfillJob field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ItemMutation) AddedFields() []string {
-	return nil
+func (m *BackfillJobMutation) AddedFields() []string {
+	var fields []string
+	if m.adddays_requested != nil {
+		fields = append(fields, backfilljob.FieldDaysRequested)
+	}
+	if m.addsync_count != nil {
+		fields = append(fields, backfilljob.FieldSyncCount)
+	}
+	if m.addtransactions_synced != nil {
+		fields = append(fields, backfilljob.FieldTransactionsSynced)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BackfillJobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case backfilljob.FieldDaysRequested:
+		return m.AddedDaysRequested()
+	case backfilljob.FieldSyncCount:
+		return m.AddedSyncCount()
+	case backfilljob.FieldTransactionsSynced:
+		return m.AddedTransactionsSynced()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ItemMutation) AddField(name string, value ent.Value) error {
+func (m *BackfillJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case backfilljob.FieldDaysRequested:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDaysRequested(v)
+		return nil
+	case backfilljob.FieldSyncCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSyncCount(v)
+		return nil
+	case backfilljob.FieldTransactionsSynced:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTransactionsSynced(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Item numeric field %s", name)
+	return fmt.Errorf("unknown BackfillJob numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ItemMutation) ClearedFields() []string {
-	return nil
+func (m *BackfillJobMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backfilljob.FieldOldestTransactionDate) {
+		fields = append(fields, backfilljob.FieldOldestTransactionDate)
+	}
+	if m.FieldCleared(backfilljob.FieldStarterRules) {
+		fields = append(fields, backfilljob.FieldStarterRules)
+	}
+	if m.FieldCleared(backfilljob.FieldStarterAnalysis) {
+		fields = append(fields, backfilljob.FieldStarterAnalysis)
+	}
+	if m.FieldCleared(backfilljob.FieldAnalysisError) {
+		fields = append(fields, backfilljob.FieldAnalysisError)
+	}
+	if m.FieldCleared(backfilljob.FieldError) {
+		fields = append(fields, backfilljob.FieldError)
+	}
+	if m.FieldCleared(backfilljob.FieldFinishedAt) {
+		fields = append(fields, backfilljob.FieldFinishedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ItemMutation) FieldCleared(name string) bool {
+func (m *BackfillJobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ItemMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Item nullable field %s", name)
+func (m *BackfillJobMutation) ClearField(name string) error {
+	switch name {
+	case backfilljob.FieldOldestTransactionDate:
+		m.ClearOldestTransactionDate()
+		return nil
+	case backfilljob.FieldStarterRules:
+		m.ClearStarterRules()
+		return nil
+	case backfilljob.FieldStarterAnalysis:
+		m.ClearStarterAnalysis()
+		return nil
+	case backfilljob.FieldAnalysisError:
+		m.ClearAnalysisError()
+		return nil
+	case backfilljob.FieldError:
+		m.ClearError()
+		return nil
+	case backfilljob.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BackfillJob nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ItemMutation) ResetField(name string) error {
+func (m *BackfillJobMutation) ResetField(name string) error {
 	switch name {
-	case item.FieldUserID:
-		m.ResetUserID()
+	case backfilljob.FieldItemID:
+		m.ResetItemID()
 		return nil
-	case item.FieldPlaidID:
-		m.ResetPlaidID()
+	case backfilljob.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case item.FieldAccessToken:
-		m.ResetAccessToken()
+	case backfilljob.FieldDaysRequested:
+		m.ResetDaysRequested()
 		return nil
-	case item.FieldInstitutionName:
-		m.ResetInstitutionName()
+	case backfilljob.FieldUpdateStatus:
+		m.ResetUpdateStatus()
 		return nil
-	case item.FieldIsActive:
-		m.ResetIsActive()
+	case backfilljob.FieldSyncCount:
+		m.ResetSyncCount()
 		return nil
-	case item.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case backfilljob.FieldTransactionsSynced:
+		m.ResetTransactionsSynced()
 		return nil
-	case item.FieldUpdatedAt:
+	case backfilljob.FieldOldestTransactionDate:
+		m.ResetOldestTransactionDate()
+		return nil
+	case backfilljob.FieldStarterRules:
+		m.ResetStarterRules()
+		return nil
+	case backfilljob.FieldStarterAnalysis:
+		m.ResetStarterAnalysis()
+		return nil
+	case backfilljob.FieldAnalysisError:
+		m.ResetAnalysisError()
+		return nil
+	case backfilljob.FieldError:
+		m.ResetError()
+		return nil
+	case backfilljob.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case backfilljob.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case backfilljob.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown Item field %s", name)
+	return fmt.Errorf("unknown BackfillJob field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ItemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, item.EdgeUser)
-	}
-	if m.accounts != nil {
-		edges = append(edges, item.EdgeAccounts)
-	}
-	if m.sync_cursor != nil {
-		edges = append(edges, item.EdgeSyncCursor)
+func (m *BackfillJobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.item != nil {
+		edges = append(edges, backfilljob.EdgeItem)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+func (m *BackfillJobMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case item.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case item.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.accounts))
-		for id := range m.accounts {
-			ids = append(ids, id)
-		}
-		return ids
-	case item.EdgeSyncCursor:
-		if id := m.sync_cursor; id != nil {
+	case backfilljob.EdgeItem:
+		if id := m.item; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2306,119 +3521,91 @@ func (m *ItemMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ItemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedaccounts != nil {
-		edges = append(edges, item.EdgeAccounts)
-	}
+func (m *BackfillJobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case item.EdgeAccounts:
-		ids := make([]ent.Value, 0, len(m.removedaccounts))
-		for id := range m.removedaccounts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BackfillJobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ItemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, item.EdgeUser)
-	}
-	if m.clearedaccounts {
-		edges = append(edges, item.EdgeAccounts)
-	}
-	if m.clearedsync_cursor {
-		edges = append(edges, item.EdgeSyncCursor)
+func (m *BackfillJobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditem {
+		edges = append(edges, backfilljob.EdgeItem)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ItemMutation) EdgeCleared(name string) bool {
+func (m *BackfillJobMutation) EdgeCleared(name string) bool {
 	switch name {
-	case item.EdgeUser:
-		return m.cleareduser
-	case item.EdgeAccounts:
-		return m.clearedaccounts
-	case item.EdgeSyncCursor:
-		return m.clearedsync_cursor
+	case backfilljob.EdgeItem:
+		return m.cleareditem
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ItemMutation) ClearEdge(name string) error {
+func (m *BackfillJobMutation) ClearEdge(name string) error {
 	switch name {
-	case item.EdgeUser:
-		m.ClearUser()
-		return nil
-	case item.EdgeSyncCursor:
-		m.ClearSyncCursor()
+	case backfilljob.EdgeItem:
+		m.ClearItem()
 		return nil
 	}
-	return fmt.Errorf("unknown Item unique edge %s", name)
+	return fmt.Errorf("unknown BackfillJob unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ItemMutation) ResetEdge(name string) error {
+func (m *BackfillJobMutation) ResetEdge(name string) error {
 	switch name {
-	case item.EdgeUser:
-		m.ResetUser()
-		return nil
-	case item.EdgeAccounts:
-		m.ResetAccounts()
-		return nil
-	case item.EdgeSyncCursor:
-		m.ResetSyncCursor()
+	case backfilljob.EdgeItem:
+		m.ResetItem()
 		return nil
 	}
-	return fmt.Errorf("unknown Item edge %s", name)
+	return fmt.Errorf("unknown BackfillJob edge %s", name)
 }
 
-// PushSubscriptionMutation represents an operation that mutates the PushSubscription nodes in the graph.
-type PushSubscriptionMutation struct {
+// BalanceSnapshotMutation represents an operation that mutates the BalanceSnapshot nodes in the graph.
+type BalanceSnapshotMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *uuid.UUID
-	endpoint      *string
-	p256dh        *string
-	auth          *string
-	active        *bool
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *uuid.UUID
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*PushSubscription, error)
-	predicates    []predicate.PushSubscription
-}
-
-var _ ent.Mutation = (*PushSubscriptionMutation)(nil)
-
-// pushsubscriptionOption allows management of the mutation configuration using functional options.
-type pushsubscriptionOption func(*PushSubscriptionMutation)
-
-// newPushSubscriptionMutation creates new mutation for the PushSubscription entity.
-func newPushSubscriptionMutation(c config, op Op, opts ...pushsubscriptionOption) *PushSubscriptionMutation {
-	m := &PushSubscriptionMutation{
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	user_id              *uuid.UUID
+	date                 *time.Time
+	current_balance      *int64
+	addcurrent_balance   *int64
+	available_balance    *int64
+	addavailable_balance *int64
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	account              *uuid.UUID
+	clearedaccount       bool
+	done                 bool
+	oldValue             func(context.Context) (*BalanceSnapshot, error)
+	predicates           []predicate.BalanceSnapshot
+}
+
+var _ ent.Mutation = (*BalanceSnapshotMutation)(nil)
+
+// balancesnapshotOption allows management of the mutation configuration using functional options.
+type balancesnapshotOption func(*BalanceSnapshotMutation)
+
+// newBalanceSnapshotMutation creates new mutation for the BalanceSnapshot entity.
+func newBalanceSnapshotMutation(c config, op Op, opts ...balancesnapshotOption) *BalanceSnapshotMutation {
+	m := &BalanceSnapshotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePushSubscription,
+		typ:           TypeBalanceSnapshot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2427,20 +3614,20 @@ func newPushSubscriptionMutation(c config, op Op, opts ...pushsubscriptionOption
 	return m
 }
 
-// withPushSubscriptionID sets the ID field of the mutation.
-func withPushSubscriptionID(id uuid.UUID) pushsubscriptionOption {
-	return func(m *PushSubscriptionMutation) {
+// withBalanceSnapshotID sets the ID field of the mutation.
+func withBalanceSnapshotID(id uuid.UUID) balancesnapshotOption {
+	return func(m *BalanceSnapshotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PushSubscription
+			value *BalanceSnapshot
 		)
-		m.oldValue = func(ctx context.Context) (*PushSubscription, error) {
+		m.oldValue = func(ctx context.Context) (*BalanceSnapshot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PushSubscription.Get(ctx, id)
+					value, err = m.Client().BalanceSnapshot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2449,10 +3636,10 @@ func withPushSubscriptionID(id uuid.UUID) pushsubscriptionOption {
 	}
 }
 
-// withPushSubscription sets the old PushSubscription of the mutation.
-func withPushSubscription(node *PushSubscription) pushsubscriptionOption {
-	return func(m *PushSubscriptionMutation) {
-		m.oldValue = func(context.Context) (*PushSubscription, error) {
+// withBalanceSnapshot sets the old BalanceSnapshot of the mutation.
+func withBalanceSnapshot(node *BalanceSnapshot) balancesnapshotOption {
+	return func(m *BalanceSnapshotMutation) {
+		m.oldValue = func(context.Context) (*BalanceSnapshot, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2461,7 +3648,7 @@ func withPushSubscription(node *PushSubscription) pushsubscriptionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PushSubscriptionMutation) Client() *Client {
+func (m BalanceSnapshotMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2469,7 +3656,7 @@ func (m PushSubscriptionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PushSubscriptionMutation) Tx() (*Tx, error) {
+func (m BalanceSnapshotMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2479,14 +3666,14 @@ func (m PushSubscriptionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of PushSubscription entities.
-func (m *PushSubscriptionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of BalanceSnapshot entities.
+func (m *BalanceSnapshotMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PushSubscriptionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BalanceSnapshotMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2497,7 +3684,7 @@ func (m *PushSubscriptionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PushSubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BalanceSnapshotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2506,199 +3693,253 @@ func (m *PushSubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PushSubscription.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BalanceSnapshot.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PushSubscriptionMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetAccountID sets the "account_id" field.
+func (m *BalanceSnapshotMutation) SetAccountID(u uuid.UUID) {
+	m.account = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PushSubscriptionMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *BalanceSnapshotMutation) AccountID() (r uuid.UUID, exists bool) {
+	v := m.account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the PushSubscription entity.
-// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// OldAccountID returns the old "account_id" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PushSubscriptionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BalanceSnapshotMutation) OldAccountID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.AccountID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PushSubscriptionMutation) ResetUserID() {
-	m.user = nil
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *BalanceSnapshotMutation) ResetAccountID() {
+	m.account = nil
 }
 
-// SetEndpoint sets the "endpoint" field.
-func (m *PushSubscriptionMutation) SetEndpoint(s string) {
-	m.endpoint = &s
+// SetUserID sets the "user_id" field.
+func (m *BalanceSnapshotMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// Endpoint returns the value of the "endpoint" field in the mutation.
-func (m *PushSubscriptionMutation) Endpoint() (r string, exists bool) {
-	v := m.endpoint
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *BalanceSnapshotMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndpoint returns the old "endpoint" field's value of the PushSubscription entity.
-// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PushSubscriptionMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+func (m *BalanceSnapshotMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Endpoint, nil
+	return oldValue.UserID, nil
 }
 
-// ResetEndpoint resets all changes to the "endpoint" field.
-func (m *PushSubscriptionMutation) ResetEndpoint() {
-	m.endpoint = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *BalanceSnapshotMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetP256dh sets the "p256dh" field.
-func (m *PushSubscriptionMutation) SetP256dh(s string) {
-	m.p256dh = &s
+// SetDate sets the "date" field.
+func (m *BalanceSnapshotMutation) SetDate(t time.Time) {
+	m.date = &t
 }
 
-// P256dh returns the value of the "p256dh" field in the mutation.
-func (m *PushSubscriptionMutation) P256dh() (r string, exists bool) {
-	v := m.p256dh
+// Date returns the value of the "date" field in the mutation.
+func (m *BalanceSnapshotMutation) Date() (r time.Time, exists bool) {
+	v := m.date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldP256dh returns the old "p256dh" field's value of the PushSubscription entity.
-// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// OldDate returns the old "date" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PushSubscriptionMutation) OldP256dh(ctx context.Context) (v string, err error) {
+func (m *BalanceSnapshotMutation) OldDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldP256dh is only allowed on UpdateOne operations")
+		return v, errors.New("OldDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldP256dh requires an ID field in the mutation")
+		return v, errors.New("OldDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldP256dh: %w", err)
+		return v, fmt.Errorf("querying old value for OldDate: %w", err)
 	}
-	return oldValue.P256dh, nil
+	return oldValue.Date, nil
 }
 
-// ResetP256dh resets all changes to the "p256dh" field.
-func (m *PushSubscriptionMutation) ResetP256dh() {
-	m.p256dh = nil
+// ResetDate resets all changes to the "date" field.
+func (m *BalanceSnapshotMutation) ResetDate() {
+	m.date = nil
 }
 
-// SetAuth sets the "auth" field.
-func (m *PushSubscriptionMutation) SetAuth(s string) {
-	m.auth = &s
+// SetCurrentBalance sets the "current_balance" field.
+func (m *BalanceSnapshotMutation) SetCurrentBalance(i int64) {
+	m.current_balance = &i
+	m.addcurrent_balance = nil
 }
 
-// Auth returns the value of the "auth" field in the mutation.
-func (m *PushSubscriptionMutation) Auth() (r string, exists bool) {
-	v := m.auth
+// CurrentBalance returns the value of the "current_balance" field in the mutation.
+func (m *BalanceSnapshotMutation) CurrentBalance() (r int64, exists bool) {
+	v := m.current_balance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuth returns the old "auth" field's value of the PushSubscription entity.
-// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrentBalance returns the old "current_balance" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PushSubscriptionMutation) OldAuth(ctx context.Context) (v string, err error) {
+func (m *BalanceSnapshotMutation) OldCurrentBalance(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuth is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrentBalance is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuth requires an ID field in the mutation")
+		return v, errors.New("OldCurrentBalance requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuth: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrentBalance: %w", err)
 	}
-	return oldValue.Auth, nil
+	return oldValue.CurrentBalance, nil
 }
 
-// ResetAuth resets all changes to the "auth" field.
-func (m *PushSubscriptionMutation) ResetAuth() {
-	m.auth = nil
+// AddCurrentBalance adds i to the "current_balance" field.
+func (m *BalanceSnapshotMutation) AddCurrentBalance(i int64) {
+	if m.addcurrent_balance != nil {
+		*m.addcurrent_balance += i
+	} else {
+		m.addcurrent_balance = &i
+	}
 }
 
-// SetActive sets the "active" field.
-func (m *PushSubscriptionMutation) SetActive(b bool) {
-	m.active = &b
+// AddedCurrentBalance returns the value that was added to the "current_balance" field in this mutation.
+func (m *BalanceSnapshotMutation) AddedCurrentBalance() (r int64, exists bool) {
+	v := m.addcurrent_balance
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *PushSubscriptionMutation) Active() (r bool, exists bool) {
-	v := m.active
+// ResetCurrentBalance resets all changes to the "current_balance" field.
+func (m *BalanceSnapshotMutation) ResetCurrentBalance() {
+	m.current_balance = nil
+	m.addcurrent_balance = nil
+}
+
+// SetAvailableBalance sets the "available_balance" field.
+func (m *BalanceSnapshotMutation) SetAvailableBalance(i int64) {
+	m.available_balance = &i
+	m.addavailable_balance = nil
+}
+
+// AvailableBalance returns the value of the "available_balance" field in the mutation.
+func (m *BalanceSnapshotMutation) AvailableBalance() (r int64, exists bool) {
+	v := m.available_balance
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the PushSubscription entity.
-// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// OldAvailableBalance returns the old "available_balance" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PushSubscriptionMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *BalanceSnapshotMutation) OldAvailableBalance(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldAvailableBalance is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldAvailableBalance requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldAvailableBalance: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.AvailableBalance, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *PushSubscriptionMutation) ResetActive() {
-	m.active = nil
+// AddAvailableBalance adds i to the "available_balance" field.
+func (m *BalanceSnapshotMutation) AddAvailableBalance(i int64) {
+	if m.addavailable_balance != nil {
+		*m.addavailable_balance += i
+	} else {
+		m.addavailable_balance = &i
+	}
+}
+
+// AddedAvailableBalance returns the value that was added to the "available_balance" field in this mutation.
+func (m *BalanceSnapshotMutation) AddedAvailableBalance() (r int64, exists bool) {
+	v := m.addavailable_balance
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAvailableBalance clears the value of the "available_balance" field.
+func (m *BalanceSnapshotMutation) ClearAvailableBalance() {
+	m.available_balance = nil
+	m.addavailable_balance = nil
+	m.clearedFields[balancesnapshot.FieldAvailableBalance] = struct{}{}
+}
+
+// AvailableBalanceCleared returns if the "available_balance" field was cleared in this mutation.
+func (m *BalanceSnapshotMutation) AvailableBalanceCleared() bool {
+	_, ok := m.clearedFields[balancesnapshot.FieldAvailableBalance]
+	return ok
+}
+
+// ResetAvailableBalance resets all changes to the "available_balance" field.
+func (m *BalanceSnapshotMutation) ResetAvailableBalance() {
+	m.available_balance = nil
+	m.addavailable_balance = nil
+	delete(m.clearedFields, balancesnapshot.FieldAvailableBalance)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PushSubscriptionMutation) SetCreatedAt(t time.Time) {
+func (m *BalanceSnapshotMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PushSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *BalanceSnapshotMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2706,10 +3947,10 @@ func (m *PushSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PushSubscription entity.
-// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PushSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BalanceSnapshotMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2724,17 +3965,17 @@ func (m *PushSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PushSubscriptionMutation) ResetCreatedAt() {
+func (m *BalanceSnapshotMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PushSubscriptionMutation) SetUpdatedAt(t time.Time) {
+func (m *BalanceSnapshotMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PushSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *BalanceSnapshotMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2742,10 +3983,10 @@ func (m *PushSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PushSubscription entity.
-// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the BalanceSnapshot entity.
+// If the BalanceSnapshot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PushSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *BalanceSnapshotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2760,46 +4001,46 @@ func (m *PushSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Tim
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PushSubscriptionMutation) ResetUpdatedAt() {
+func (m *BalanceSnapshotMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PushSubscriptionMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[pushsubscription.FieldUserID] = struct{}{}
+// ClearAccount clears the "account" edge to the Account entity.
+func (m *BalanceSnapshotMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[balancesnapshot.FieldAccountID] = struct{}{}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PushSubscriptionMutation) UserCleared() bool {
-	return m.cleareduser
+// AccountCleared reports if the "account" edge to the Account entity was cleared.
+func (m *BalanceSnapshotMutation) AccountCleared() bool {
+	return m.clearedaccount
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// AccountIDs returns the "account" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PushSubscriptionMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *BalanceSnapshotMutation) AccountIDs() (ids []uuid.UUID) {
+	if id := m.account; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PushSubscriptionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetAccount resets all changes to the "account" edge.
+func (m *BalanceSnapshotMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
 }
 
-// Where appends a list predicates to the PushSubscriptionMutation builder.
-func (m *PushSubscriptionMutation) Where(ps ...predicate.PushSubscription) {
+// Where appends a list predicates to the BalanceSnapshotMutation builder.
+func (m *BalanceSnapshotMutation) Where(ps ...predicate.BalanceSnapshot) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PushSubscriptionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the BalanceSnapshotMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PushSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PushSubscription, len(ps))
+func (m *BalanceSnapshotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BalanceSnapshot, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2807,45 +4048,45 @@ func (m *PushSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PushSubscriptionMutation) Op() Op {
+func (m *BalanceSnapshotMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PushSubscriptionMutation) SetOp(op Op) {
+func (m *BalanceSnapshotMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PushSubscription).
-func (m *PushSubscriptionMutation) Type() string {
+// Type returns the node type of this mutation (BalanceSnapshot).
+func (m *BalanceSnapshotMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PushSubscriptionMutation) Fields() []string {
+func (m *BalanceSnapshotMutation) Fields() []string {
 	fields := make([]string, 0, 7)
-	if m.user != nil {
-		fields = append(fields, pushsubscription.FieldUserID)
+	if m.account != nil {
+		fields = append(fields, balancesnapshot.FieldAccountID)
 	}
-	if m.endpoint != nil {
-		fields = append(fields, pushsubscription.FieldEndpoint)
+	if m.user_id != nil {
+		fields = append(fields, balancesnapshot.FieldUserID)
 	}
-	if m.p256dh != nil {
-		fields = append(fields, pushsubscription.FieldP256dh)
+	if m.date != nil {
+		fields = append(fields, balancesnapshot.FieldDate)
 	}
-	if m.auth != nil {
-		fields = append(fields, pushsubscription.FieldAuth)
+	if m.current_balance != nil {
+		fields = append(fields, balancesnapshot.FieldCurrentBalance)
 	}
-	if m.active != nil {
-		fields = append(fields, pushsubscription.FieldActive)
+	if m.available_balance != nil {
+		fields = append(fields, balancesnapshot.FieldAvailableBalance)
 	}
 	if m.created_at != nil {
-		fields = append(fields, pushsubscription.FieldCreatedAt)
+		fields = append(fields, balancesnapshot.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, pushsubscription.FieldUpdatedAt)
+		fields = append(fields, balancesnapshot.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2853,21 +4094,21 @@ func (m *PushSubscriptionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PushSubscriptionMutation) Field(name string) (ent.Value, bool) {
+func (m *BalanceSnapshotMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case pushsubscription.FieldUserID:
+	case balancesnapshot.FieldAccountID:
+		return m.AccountID()
+	case balancesnapshot.FieldUserID:
 		return m.UserID()
-	case pushsubscription.FieldEndpoint:
-		return m.Endpoint()
-	case pushsubscription.FieldP256dh:
-		return m.P256dh()
-	case pushsubscription.FieldAuth:
-		return m.Auth()
-	case pushsubscription.FieldActive:
-		return m.Active()
-	case pushsubscription.FieldCreatedAt:
+	case balancesnapshot.FieldDate:
+		return m.Date()
+	case balancesnapshot.FieldCurrentBalance:
+		return m.CurrentBalance()
+	case balancesnapshot.FieldAvailableBalance:
+		return m.AvailableBalance()
+	case balancesnapshot.FieldCreatedAt:
 		return m.CreatedAt()
-	case pushsubscription.FieldUpdatedAt:
+	case balancesnapshot.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2876,74 +4117,74 @@ func (m *PushSubscriptionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PushSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *BalanceSnapshotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case pushsubscription.FieldUserID:
+	case balancesnapshot.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case balancesnapshot.FieldUserID:
 		return m.OldUserID(ctx)
-	case pushsubscription.FieldEndpoint:
-		return m.OldEndpoint(ctx)
-	case pushsubscription.FieldP256dh:
-		return m.OldP256dh(ctx)
-	case pushsubscription.FieldAuth:
-		return m.OldAuth(ctx)
-	case pushsubscription.FieldActive:
-		return m.OldActive(ctx)
-	case pushsubscription.FieldCreatedAt:
+	case balancesnapshot.FieldDate:
+		return m.OldDate(ctx)
+	case balancesnapshot.FieldCurrentBalance:
+		return m.OldCurrentBalance(ctx)
+	case balancesnapshot.FieldAvailableBalance:
+		return m.OldAvailableBalance(ctx)
+	case balancesnapshot.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case pushsubscription.FieldUpdatedAt:
+	case balancesnapshot.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PushSubscription field %s", name)
+	return nil, fmt.Errorf("unknown BalanceSnapshot field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PushSubscriptionMutation) SetField(name string, value ent.Value) error {
+func (m *BalanceSnapshotMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case pushsubscription.FieldUserID:
+	case balancesnapshot.FieldAccountID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetAccountID(v)
 		return nil
-	case pushsubscription.FieldEndpoint:
-		v, ok := value.(string)
+	case balancesnapshot.FieldUserID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEndpoint(v)
+		m.SetUserID(v)
 		return nil
-	case pushsubscription.FieldP256dh:
-		v, ok := value.(string)
+	case balancesnapshot.FieldDate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetP256dh(v)
+		m.SetDate(v)
 		return nil
-	case pushsubscription.FieldAuth:
-		v, ok := value.(string)
+	case balancesnapshot.FieldCurrentBalance:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuth(v)
+		m.SetCurrentBalance(v)
 		return nil
-	case pushsubscription.FieldActive:
-		v, ok := value.(bool)
+	case balancesnapshot.FieldAvailableBalance:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetAvailableBalance(v)
 		return nil
-	case pushsubscription.FieldCreatedAt:
+	case balancesnapshot.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case pushsubscription.FieldUpdatedAt:
+	case balancesnapshot.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2951,94 +4192,130 @@ func (m *PushSubscriptionMutation) SetField(name string, value ent.Value) error
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PushSubscription field %s", name)
+	return fmt.Errorf("unknown BalanceSnapshot field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PushSubscriptionMutation) AddedFields() []string {
-	return nil
+func (m *BalanceSnapshotMutation) AddedFields() []string {
+	var fields []string
+	if m.addcurrent_balance != nil {
+		fields = append(fields, balancesnapshot.FieldCurrentBalance)
+	}
+	if m.addavailable_balance != nil {
+		fields = append(fields, balancesnapshot.FieldAvailableBalance)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PushSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BalanceSnapshotMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case balancesnapshot.FieldCurrentBalance:
+		return m.AddedCurrentBalance()
+	case balancesnapshot.FieldAvailableBalance:
+		return m.AddedAvailableBalance()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PushSubscriptionMutation) AddField(name string, value ent.Value) error {
+func (m *BalanceSnapshotMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case balancesnapshot.FieldCurrentBalance:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentBalance(v)
+		return nil
+	case balancesnapshot.FieldAvailableBalance:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvailableBalance(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PushSubscription numeric field %s", name)
+	return fmt.Errorf("unknown BalanceSnapshot numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PushSubscriptionMutation) ClearedFields() []string {
-	return nil
+func (m *BalanceSnapshotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(balancesnapshot.FieldAvailableBalance) {
+		fields = append(fields, balancesnapshot.FieldAvailableBalance)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PushSubscriptionMutation) FieldCleared(name string) bool {
+func (m *BalanceSnapshotMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PushSubscriptionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PushSubscription nullable field %s", name)
+func (m *BalanceSnapshotMutation) ClearField(name string) error {
+	switch name {
+	case balancesnapshot.FieldAvailableBalance:
+		m.ClearAvailableBalance()
+		return nil
+	}
+	return fmt.Errorf("unknown BalanceSnapshot nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PushSubscriptionMutation) ResetField(name string) error {
+func (m *BalanceSnapshotMutation) ResetField(name string) error {
 	switch name {
-	case pushsubscription.FieldUserID:
-		m.ResetUserID()
+	case balancesnapshot.FieldAccountID:
+		m.ResetAccountID()
 		return nil
-	case pushsubscription.FieldEndpoint:
-		m.ResetEndpoint()
+	case balancesnapshot.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case pushsubscription.FieldP256dh:
-		m.ResetP256dh()
+	case balancesnapshot.FieldDate:
+		m.ResetDate()
 		return nil
-	case pushsubscription.FieldAuth:
-		m.ResetAuth()
+	case balancesnapshot.FieldCurrentBalance:
+		m.ResetCurrentBalance()
 		return nil
-	case pushsubscription.FieldActive:
-		m.ResetActive()
+	case balancesnapshot.FieldAvailableBalance:
+		m.ResetAvailableBalance()
 		return nil
-	case pushsubscription.FieldCreatedAt:
+	case balancesnapshot.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case pushsubscription.FieldUpdatedAt:
+	case balancesnapshot.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PushSubscription field %s", name)
+	return fmt.Errorf("unknown BalanceSnapshot field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PushSubscriptionMutation) AddedEdges() []string {
+func (m *BalanceSnapshotMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, pushsubscription.EdgeUser)
+	if m.account != nil {
+		edges = append(edges, balancesnapshot.EdgeAccount)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PushSubscriptionMutation) AddedIDs(name string) []ent.Value {
+func (m *BalanceSnapshotMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case pushsubscription.EdgeUser:
-		if id := m.user; id != nil {
+	case balancesnapshot.EdgeAccount:
+		if id := m.account; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -3046,106 +4323,99 @@ func (m *PushSubscriptionMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PushSubscriptionMutation) RemovedEdges() []string {
+func (m *BalanceSnapshotMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PushSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+func (m *BalanceSnapshotMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PushSubscriptionMutation) ClearedEdges() []string {
+func (m *BalanceSnapshotMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, pushsubscription.EdgeUser)
+	if m.clearedaccount {
+		edges = append(edges, balancesnapshot.EdgeAccount)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PushSubscriptionMutation) EdgeCleared(name string) bool {
+func (m *BalanceSnapshotMutation) EdgeCleared(name string) bool {
 	switch name {
-	case pushsubscription.EdgeUser:
-		return m.cleareduser
+	case balancesnapshot.EdgeAccount:
+		return m.clearedaccount
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PushSubscriptionMutation) ClearEdge(name string) error {
+func (m *BalanceSnapshotMutation) ClearEdge(name string) error {
 	switch name {
-	case pushsubscription.EdgeUser:
-		m.ClearUser()
+	case balancesnapshot.EdgeAccount:
+		m.ClearAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown PushSubscription unique edge %s", name)
+	return fmt.Errorf("unknown BalanceSnapshot unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PushSubscriptionMutation) ResetEdge(name string) error {
+func (m *BalanceSnapshotMutation) ResetEdge(name string) error {
 	switch name {
-	case pushsubscription.EdgeUser:
-		m.ResetUser()
+	case balancesnapshot.EdgeAccount:
+		m.ResetAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown PushSubscription edge %s", name)
+	return fmt.Errorf("unknown BalanceSnapshot edge %s", name)
 }
 
-// RuleMutation represents an operation that mutates the Rule nodes in the graph.
-type RuleMutation struct {
+// BudgetMutation represents an operation that mutates the Budget nodes in the graph.
+type BudgetMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	name                  *string
-	category              *rule.Category
-	min_amount_cents      *int64
-	addmin_amount_cents   *int64
-	max_amount_cents      *int64
-	addmax_amount_cents   *int64
-	action_type           *rule.ActionType
-	action_value          *float64
-	addaction_value       *float64
-	is_active             *bool
-	priority              *int
-	addpriority           *int
-	execution_count       *int
-	addexecution_count    *int
-	total_saved_cents     *int64
-	addtotal_saved_cents  *int64
-	created_at            *time.Time
-	updated_at            *time.Time
-	clearedFields         map[string]struct{}
-	user                  *uuid.UUID
-	cleareduser           bool
-	target_account        *uuid.UUID
-	clearedtarget_account bool
-	executions            map[uuid.UUID]struct{}
-	removedexecutions     map[uuid.UUID]struct{}
-	clearedexecutions     bool
-	done                  bool
-	oldValue              func(context.Context) (*Rule, error)
-	predicates            []predicate.Rule
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	category               *budget.Category
+	amount_cents           *int64
+	addamount_cents        *int64
+	alert_thresholds       *[]int
+	appendalert_thresholds []int
+	is_active              *bool
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	family                 *uuid.UUID
+	clearedfamily          bool
+	alerts                 map[uuid.UUID]struct{}
+	removedalerts          map[uuid.UUID]struct{}
+	clearedalerts          bool
+	rules                  map[uuid.UUID]struct{}
+	removedrules           map[uuid.UUID]struct{}
+	clearedrules           bool
+	done                   bool
+	oldValue               func(context.Context) (*Budget, error)
+	predicates             []predicate.Budget
 }
 
-var _ ent.Mutation = (*RuleMutation)(nil)
+var _ ent.Mutation = (*BudgetMutation)(nil)
 
-// ruleOption allows management of the mutation configuration using functional options.
-type ruleOption func(*RuleMutation)
+// budgetOption allows management of the mutation configuration using functional options.
+type budgetOption func(*BudgetMutation)
 
-// newRuleMutation creates new mutation for the Rule entity.
-func newRuleMutation(c config, op Op, opts ...ruleOption) *RuleMutation {
-	m := &RuleMutation{
+// newBudgetMutation creates new mutation for the Budget entity.
+func newBudgetMutation(c config, op Op, opts ...budgetOption) *BudgetMutation {
+	m := &BudgetMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRule,
+		typ:           TypeBudget,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3154,20 +4424,20 @@ func newRuleMutation(c config, op Op, opts ...ruleOption) *RuleMutation {
 	return m
 }
 
-// withRuleID sets the ID field of the mutation.
-func withRuleID(id uuid.UUID) ruleOption {
-	return func(m *RuleMutation) {
+// withBudgetID sets the ID field of the mutation.
+func withBudgetID(id uuid.UUID) budgetOption {
+	return func(m *BudgetMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Rule
+			value *Budget
 		)
-		m.oldValue = func(ctx context.Context) (*Rule, error) {
+		m.oldValue = func(ctx context.Context) (*Budget, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Rule.Get(ctx, id)
+					value, err = m.Client().Budget.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3176,10 +4446,10 @@ func withRuleID(id uuid.UUID) ruleOption {
 	}
 }
 
-// withRule sets the old Rule of the mutation.
-func withRule(node *Rule) ruleOption {
-	return func(m *RuleMutation) {
-		m.oldValue = func(context.Context) (*Rule, error) {
+// withBudget sets the old Budget of the mutation.
+func withBudget(node *Budget) budgetOption {
+	return func(m *BudgetMutation) {
+		m.oldValue = func(context.Context) (*Budget, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3188,7 +4458,7 @@ func withRule(node *Rule) ruleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RuleMutation) Client() *Client {
+func (m BudgetMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3196,7 +4466,7 @@ func (m RuleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RuleMutation) Tx() (*Tx, error) {
+func (m BudgetMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3206,14 +4476,14 @@ func (m RuleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Rule entities.
-func (m *RuleMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Budget entities.
+func (m *BudgetMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RuleMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BudgetMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3224,7 +4494,7 @@ func (m *RuleMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BudgetMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3233,19 +4503,19 @@ func (m *RuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Rule.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Budget.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *RuleMutation) SetUserID(u uuid.UUID) {
+func (m *BudgetMutation) SetUserID(u uuid.UUID) {
 	m.user = &u
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *RuleMutation) UserID() (r uuid.UUID, exists bool) {
+func (m *BudgetMutation) UserID() (r uuid.UUID, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -3253,10 +4523,10 @@ func (m *RuleMutation) UserID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BudgetMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -3271,53 +4541,66 @@ func (m *RuleMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *RuleMutation) ResetUserID() {
+func (m *BudgetMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetName sets the "name" field.
-func (m *RuleMutation) SetName(s string) {
-	m.name = &s
+// SetFamilyID sets the "family_id" field.
+func (m *BudgetMutation) SetFamilyID(u uuid.UUID) {
+	m.family = &u
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RuleMutation) Name() (r string, exists bool) {
-	v := m.name
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *BudgetMutation) FamilyID() (r uuid.UUID, exists bool) {
+	v := m.family
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// OldFamilyID returns the old "family_id" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *BudgetMutation) OldFamilyID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.FamilyID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RuleMutation) ResetName() {
-	m.name = nil
+// ClearFamilyID clears the value of the "family_id" field.
+func (m *BudgetMutation) ClearFamilyID() {
+	m.family = nil
+	m.clearedFields[budget.FieldFamilyID] = struct{}{}
+}
+
+// FamilyIDCleared returns if the "family_id" field was cleared in this mutation.
+func (m *BudgetMutation) FamilyIDCleared() bool {
+	_, ok := m.clearedFields[budget.FieldFamilyID]
+	return ok
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *BudgetMutation) ResetFamilyID() {
+	m.family = nil
+	delete(m.clearedFields, budget.FieldFamilyID)
 }
 
 // SetCategory sets the "category" field.
-func (m *RuleMutation) SetCategory(r rule.Category) {
-	m.category = &r
+func (m *BudgetMutation) SetCategory(b budget.Category) {
+	m.category = &b
 }
 
 // Category returns the value of the "category" field in the mutation.
-func (m *RuleMutation) Category() (r rule.Category, exists bool) {
+func (m *BudgetMutation) Category() (r budget.Category, exists bool) {
 	v := m.category
 	if v == nil {
 		return
@@ -3325,10 +4608,10 @@ func (m *RuleMutation) Category() (r rule.Category, exists bool) {
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldCategory(ctx context.Context) (v rule.Category, err error) {
+func (m *BudgetMutation) OldCategory(ctx context.Context) (v budget.Category, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
@@ -3343,944 +4626,1322 @@ func (m *RuleMutation) OldCategory(ctx context.Context) (v rule.Category, err er
 }
 
 // ResetCategory resets all changes to the "category" field.
-func (m *RuleMutation) ResetCategory() {
+func (m *BudgetMutation) ResetCategory() {
 	m.category = nil
 }
 
-// SetMinAmountCents sets the "min_amount_cents" field.
-func (m *RuleMutation) SetMinAmountCents(i int64) {
-	m.min_amount_cents = &i
-	m.addmin_amount_cents = nil
+// SetAmountCents sets the "amount_cents" field.
+func (m *BudgetMutation) SetAmountCents(i int64) {
+	m.amount_cents = &i
+	m.addamount_cents = nil
 }
 
-// MinAmountCents returns the value of the "min_amount_cents" field in the mutation.
-func (m *RuleMutation) MinAmountCents() (r int64, exists bool) {
-	v := m.min_amount_cents
+// AmountCents returns the value of the "amount_cents" field in the mutation.
+func (m *BudgetMutation) AmountCents() (r int64, exists bool) {
+	v := m.amount_cents
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMinAmountCents returns the old "min_amount_cents" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// OldAmountCents returns the old "amount_cents" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldMinAmountCents(ctx context.Context) (v *int64, err error) {
+func (m *BudgetMutation) OldAmountCents(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMinAmountCents is only allowed on UpdateOne operations")
+		return v, errors.New("OldAmountCents is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMinAmountCents requires an ID field in the mutation")
+		return v, errors.New("OldAmountCents requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMinAmountCents: %w", err)
+		return v, fmt.Errorf("querying old value for OldAmountCents: %w", err)
 	}
-	return oldValue.MinAmountCents, nil
+	return oldValue.AmountCents, nil
 }
 
-// AddMinAmountCents adds i to the "min_amount_cents" field.
-func (m *RuleMutation) AddMinAmountCents(i int64) {
-	if m.addmin_amount_cents != nil {
-		*m.addmin_amount_cents += i
+// AddAmountCents adds i to the "amount_cents" field.
+func (m *BudgetMutation) AddAmountCents(i int64) {
+	if m.addamount_cents != nil {
+		*m.addamount_cents += i
 	} else {
-		m.addmin_amount_cents = &i
+		m.addamount_cents = &i
 	}
 }
 
-// AddedMinAmountCents returns the value that was added to the "min_amount_cents" field in this mutation.
-func (m *RuleMutation) AddedMinAmountCents() (r int64, exists bool) {
-	v := m.addmin_amount_cents
+// AddedAmountCents returns the value that was added to the "amount_cents" field in this mutation.
+func (m *BudgetMutation) AddedAmountCents() (r int64, exists bool) {
+	v := m.addamount_cents
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ClearMinAmountCents clears the value of the "min_amount_cents" field.
-func (m *RuleMutation) ClearMinAmountCents() {
-	m.min_amount_cents = nil
-	m.addmin_amount_cents = nil
-	m.clearedFields[rule.FieldMinAmountCents] = struct{}{}
-}
-
-// MinAmountCentsCleared returns if the "min_amount_cents" field was cleared in this mutation.
-func (m *RuleMutation) MinAmountCentsCleared() bool {
-	_, ok := m.clearedFields[rule.FieldMinAmountCents]
-	return ok
-}
-
-// ResetMinAmountCents resets all changes to the "min_amount_cents" field.
-func (m *RuleMutation) ResetMinAmountCents() {
-	m.min_amount_cents = nil
-	m.addmin_amount_cents = nil
-	delete(m.clearedFields, rule.FieldMinAmountCents)
+// ResetAmountCents resets all changes to the "amount_cents" field.
+func (m *BudgetMutation) ResetAmountCents() {
+	m.amount_cents = nil
+	m.addamount_cents = nil
 }
 
-// SetMaxAmountCents sets the "max_amount_cents" field.
-func (m *RuleMutation) SetMaxAmountCents(i int64) {
-	m.max_amount_cents = &i
-	m.addmax_amount_cents = nil
+// SetAlertThresholds sets the "alert_thresholds" field.
+func (m *BudgetMutation) SetAlertThresholds(i []int) {
+	m.alert_thresholds = &i
+	m.appendalert_thresholds = nil
 }
 
-// MaxAmountCents returns the value of the "max_amount_cents" field in the mutation.
-func (m *RuleMutation) MaxAmountCents() (r int64, exists bool) {
-	v := m.max_amount_cents
+// AlertThresholds returns the value of the "alert_thresholds" field in the mutation.
+func (m *BudgetMutation) AlertThresholds() (r []int, exists bool) {
+	v := m.alert_thresholds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxAmountCents returns the old "max_amount_cents" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// OldAlertThresholds returns the old "alert_thresholds" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldMaxAmountCents(ctx context.Context) (v *int64, err error) {
+func (m *BudgetMutation) OldAlertThresholds(ctx context.Context) (v []int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxAmountCents is only allowed on UpdateOne operations")
+		return v, errors.New("OldAlertThresholds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxAmountCents requires an ID field in the mutation")
+		return v, errors.New("OldAlertThresholds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxAmountCents: %w", err)
+		return v, fmt.Errorf("querying old value for OldAlertThresholds: %w", err)
 	}
-	return oldValue.MaxAmountCents, nil
+	return oldValue.AlertThresholds, nil
 }
 
-// AddMaxAmountCents adds i to the "max_amount_cents" field.
-func (m *RuleMutation) AddMaxAmountCents(i int64) {
-	if m.addmax_amount_cents != nil {
-		*m.addmax_amount_cents += i
-	} else {
-		m.addmax_amount_cents = &i
-	}
+// AppendAlertThresholds adds i to the "alert_thresholds" field.
+func (m *BudgetMutation) AppendAlertThresholds(i []int) {
+	m.appendalert_thresholds = append(m.appendalert_thresholds, i...)
 }
 
-// AddedMaxAmountCents returns the value that was added to the "max_amount_cents" field in this mutation.
-func (m *RuleMutation) AddedMaxAmountCents() (r int64, exists bool) {
-	v := m.addmax_amount_cents
-	if v == nil {
-		return
+// AppendedAlertThresholds returns the list of values that were appended to the "alert_thresholds" field in this mutation.
+func (m *BudgetMutation) AppendedAlertThresholds() ([]int, bool) {
+	if len(m.appendalert_thresholds) == 0 {
+		return nil, false
 	}
-	return *v, true
-}
-
-// ClearMaxAmountCents clears the value of the "max_amount_cents" field.
-func (m *RuleMutation) ClearMaxAmountCents() {
-	m.max_amount_cents = nil
-	m.addmax_amount_cents = nil
-	m.clearedFields[rule.FieldMaxAmountCents] = struct{}{}
-}
-
-// MaxAmountCentsCleared returns if the "max_amount_cents" field was cleared in this mutation.
-func (m *RuleMutation) MaxAmountCentsCleared() bool {
-	_, ok := m.clearedFields[rule.FieldMaxAmountCents]
-	return ok
+	return m.appendalert_thresholds, true
 }
 
-// ResetMaxAmountCents resets all changes to the "max_amount_cents" field.
-func (m *RuleMutation) ResetMaxAmountCents() {
-	m.max_amount_cents = nil
-	m.addmax_amount_cents = nil
-	delete(m.clearedFields, rule.FieldMaxAmountCents)
+// ResetAlertThresholds resets all changes to the "alert_thresholds" field.
+func (m *BudgetMutation) ResetAlertThresholds() {
+	m.alert_thresholds = nil
+	m.appendalert_thresholds = nil
 }
 
-// SetActionType sets the "action_type" field.
-func (m *RuleMutation) SetActionType(rt rule.ActionType) {
-	m.action_type = &rt
+// SetIsActive sets the "is_active" field.
+func (m *BudgetMutation) SetIsActive(b bool) {
+	m.is_active = &b
 }
 
-// ActionType returns the value of the "action_type" field in the mutation.
-func (m *RuleMutation) ActionType() (r rule.ActionType, exists bool) {
-	v := m.action_type
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *BudgetMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActionType returns the old "action_type" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// OldIsActive returns the old "is_active" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldActionType(ctx context.Context) (v rule.ActionType, err error) {
+func (m *BudgetMutation) OldIsActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActionType is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActionType requires an ID field in the mutation")
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActionType: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
 	}
-	return oldValue.ActionType, nil
+	return oldValue.IsActive, nil
 }
 
-// ResetActionType resets all changes to the "action_type" field.
-func (m *RuleMutation) ResetActionType() {
-	m.action_type = nil
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *BudgetMutation) ResetIsActive() {
+	m.is_active = nil
 }
 
-// SetActionValue sets the "action_value" field.
-func (m *RuleMutation) SetActionValue(f float64) {
-	m.action_value = &f
-	m.addaction_value = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *BudgetMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ActionValue returns the value of the "action_value" field in the mutation.
-func (m *RuleMutation) ActionValue() (r float64, exists bool) {
-	v := m.action_value
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BudgetMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActionValue returns the old "action_value" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldActionValue(ctx context.Context) (v float64, err error) {
+func (m *BudgetMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActionValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActionValue requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActionValue: %w", err)
-	}
-	return oldValue.ActionValue, nil
-}
-
-// AddActionValue adds f to the "action_value" field.
-func (m *RuleMutation) AddActionValue(f float64) {
-	if m.addaction_value != nil {
-		*m.addaction_value += f
-	} else {
-		m.addaction_value = &f
-	}
-}
-
-// AddedActionValue returns the value that was added to the "action_value" field in this mutation.
-func (m *RuleMutation) AddedActionValue() (r float64, exists bool) {
-	v := m.addaction_value
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetActionValue resets all changes to the "action_value" field.
-func (m *RuleMutation) ResetActionValue() {
-	m.action_value = nil
-	m.addaction_value = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BudgetMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTargetAccountID sets the "target_account_id" field.
-func (m *RuleMutation) SetTargetAccountID(u uuid.UUID) {
-	m.target_account = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BudgetMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// TargetAccountID returns the value of the "target_account_id" field in the mutation.
-func (m *RuleMutation) TargetAccountID() (r uuid.UUID, exists bool) {
-	v := m.target_account
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BudgetMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTargetAccountID returns the old "target_account_id" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Budget entity.
+// If the Budget object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldTargetAccountID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BudgetMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetAccountID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetAccountID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetAccountID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.TargetAccountID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetTargetAccountID resets all changes to the "target_account_id" field.
-func (m *RuleMutation) ResetTargetAccountID() {
-	m.target_account = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BudgetMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *RuleMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// ClearUser clears the "user" edge to the User entity.
+func (m *BudgetMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[budget.FieldUserID] = struct{}{}
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *RuleMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *BudgetMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// OldIsActive returns the old "is_active" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *BudgetMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.IsActive, nil
+	return
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *RuleMutation) ResetIsActive() {
-	m.is_active = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *BudgetMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// SetPriority sets the "priority" field.
-func (m *RuleMutation) SetPriority(i int) {
-	m.priority = &i
-	m.addpriority = nil
+// ClearFamily clears the "family" edge to the Family entity.
+func (m *BudgetMutation) ClearFamily() {
+	m.clearedfamily = true
+	m.clearedFields[budget.FieldFamilyID] = struct{}{}
 }
 
-// Priority returns the value of the "priority" field in the mutation.
-func (m *RuleMutation) Priority() (r int, exists bool) {
-	v := m.priority
-	if v == nil {
-		return
-	}
-	return *v, true
+// FamilyCleared reports if the "family" edge to the Family entity was cleared.
+func (m *BudgetMutation) FamilyCleared() bool {
+	return m.FamilyIDCleared() || m.clearedfamily
 }
 
-// OldPriority returns the old "priority" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldPriority(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+// FamilyIDs returns the "family" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FamilyID instead. It exists only for internal usage by the builders.
+func (m *BudgetMutation) FamilyIDs() (ids []uuid.UUID) {
+	if id := m.family; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPriority requires an ID field in the mutation")
+	return
+}
+
+// ResetFamily resets all changes to the "family" edge.
+func (m *BudgetMutation) ResetFamily() {
+	m.family = nil
+	m.clearedfamily = false
+}
+
+// AddAlertIDs adds the "alerts" edge to the BudgetAlert entity by ids.
+func (m *BudgetMutation) AddAlertIDs(ids ...uuid.UUID) {
+	if m.alerts == nil {
+		m.alerts = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	for i := range ids {
+		m.alerts[ids[i]] = struct{}{}
 	}
-	return oldValue.Priority, nil
 }
 
-// AddPriority adds i to the "priority" field.
-func (m *RuleMutation) AddPriority(i int) {
-	if m.addpriority != nil {
-		*m.addpriority += i
-	} else {
-		m.addpriority = &i
-	}
-}
-
-// AddedPriority returns the value that was added to the "priority" field in this mutation.
-func (m *RuleMutation) AddedPriority() (r int, exists bool) {
-	v := m.addpriority
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetPriority resets all changes to the "priority" field.
-func (m *RuleMutation) ResetPriority() {
-	m.priority = nil
-	m.addpriority = nil
+// ClearAlerts clears the "alerts" edge to the BudgetAlert entity.
+func (m *BudgetMutation) ClearAlerts() {
+	m.clearedalerts = true
 }
 
-// SetExecutionCount sets the "execution_count" field.
-func (m *RuleMutation) SetExecutionCount(i int) {
-	m.execution_count = &i
-	m.addexecution_count = nil
+// AlertsCleared reports if the "alerts" edge to the BudgetAlert entity was cleared.
+func (m *BudgetMutation) AlertsCleared() bool {
+	return m.clearedalerts
 }
 
-// ExecutionCount returns the value of the "execution_count" field in the mutation.
-func (m *RuleMutation) ExecutionCount() (r int, exists bool) {
-	v := m.execution_count
-	if v == nil {
-		return
+// RemoveAlertIDs removes the "alerts" edge to the BudgetAlert entity by IDs.
+func (m *BudgetMutation) RemoveAlertIDs(ids ...uuid.UUID) {
+	if m.removedalerts == nil {
+		m.removedalerts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.alerts, ids[i])
+		m.removedalerts[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldExecutionCount returns the old "execution_count" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldExecutionCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionCount: %w", err)
+// RemovedAlerts returns the removed IDs of the "alerts" edge to the BudgetAlert entity.
+func (m *BudgetMutation) RemovedAlertsIDs() (ids []uuid.UUID) {
+	for id := range m.removedalerts {
+		ids = append(ids, id)
 	}
-	return oldValue.ExecutionCount, nil
+	return
 }
 
-// AddExecutionCount adds i to the "execution_count" field.
-func (m *RuleMutation) AddExecutionCount(i int) {
-	if m.addexecution_count != nil {
-		*m.addexecution_count += i
-	} else {
-		m.addexecution_count = &i
+// AlertsIDs returns the "alerts" edge IDs in the mutation.
+func (m *BudgetMutation) AlertsIDs() (ids []uuid.UUID) {
+	for id := range m.alerts {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedExecutionCount returns the value that was added to the "execution_count" field in this mutation.
-func (m *RuleMutation) AddedExecutionCount() (r int, exists bool) {
-	v := m.addexecution_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetAlerts resets all changes to the "alerts" edge.
+func (m *BudgetMutation) ResetAlerts() {
+	m.alerts = nil
+	m.clearedalerts = false
+	m.removedalerts = nil
 }
 
-// ResetExecutionCount resets all changes to the "execution_count" field.
-func (m *RuleMutation) ResetExecutionCount() {
-	m.execution_count = nil
-	m.addexecution_count = nil
+// AddRuleIDs adds the "rules" edge to the Rule entity by ids.
+func (m *BudgetMutation) AddRuleIDs(ids ...uuid.UUID) {
+	if m.rules == nil {
+		m.rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.rules[ids[i]] = struct{}{}
+	}
 }
 
-// SetTotalSavedCents sets the "total_saved_cents" field.
-func (m *RuleMutation) SetTotalSavedCents(i int64) {
-	m.total_saved_cents = &i
-	m.addtotal_saved_cents = nil
+// ClearRules clears the "rules" edge to the Rule entity.
+func (m *BudgetMutation) ClearRules() {
+	m.clearedrules = true
 }
 
-// TotalSavedCents returns the value of the "total_saved_cents" field in the mutation.
-func (m *RuleMutation) TotalSavedCents() (r int64, exists bool) {
-	v := m.total_saved_cents
-	if v == nil {
-		return
-	}
-	return *v, true
+// RulesCleared reports if the "rules" edge to the Rule entity was cleared.
+func (m *BudgetMutation) RulesCleared() bool {
+	return m.clearedrules
 }
 
-// OldTotalSavedCents returns the old "total_saved_cents" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldTotalSavedCents(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalSavedCents is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalSavedCents requires an ID field in the mutation")
+// RemoveRuleIDs removes the "rules" edge to the Rule entity by IDs.
+func (m *BudgetMutation) RemoveRuleIDs(ids ...uuid.UUID) {
+	if m.removedrules == nil {
+		m.removedrules = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalSavedCents: %w", err)
+	for i := range ids {
+		delete(m.rules, ids[i])
+		m.removedrules[ids[i]] = struct{}{}
 	}
-	return oldValue.TotalSavedCents, nil
 }
 
-// AddTotalSavedCents adds i to the "total_saved_cents" field.
-func (m *RuleMutation) AddTotalSavedCents(i int64) {
-	if m.addtotal_saved_cents != nil {
-		*m.addtotal_saved_cents += i
-	} else {
-		m.addtotal_saved_cents = &i
+// RemovedRules returns the removed IDs of the "rules" edge to the Rule entity.
+func (m *BudgetMutation) RemovedRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrules {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedTotalSavedCents returns the value that was added to the "total_saved_cents" field in this mutation.
-func (m *RuleMutation) AddedTotalSavedCents() (r int64, exists bool) {
-	v := m.addtotal_saved_cents
-	if v == nil {
-		return
+// RulesIDs returns the "rules" edge IDs in the mutation.
+func (m *BudgetMutation) RulesIDs() (ids []uuid.UUID) {
+	for id := range m.rules {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetTotalSavedCents resets all changes to the "total_saved_cents" field.
-func (m *RuleMutation) ResetTotalSavedCents() {
-	m.total_saved_cents = nil
-	m.addtotal_saved_cents = nil
+// ResetRules resets all changes to the "rules" edge.
+func (m *BudgetMutation) ResetRules() {
+	m.rules = nil
+	m.clearedrules = false
+	m.removedrules = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RuleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// Where appends a list predicates to the BudgetMutation builder.
+func (m *BudgetMutation) Where(ps ...predicate.Budget) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RuleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the BudgetMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BudgetMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Budget, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
-	}
-	return oldValue.CreatedAt, nil
+// Op returns the operation name.
+func (m *BudgetMutation) Op() Op {
+	return m.op
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RuleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// SetOp allows setting the mutation operation.
+func (m *BudgetMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RuleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// Type returns the node type of this mutation (Budget).
+func (m *BudgetMutation) Type() string {
+	return m.typ
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RuleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BudgetMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user != nil {
+		fields = append(fields, budget.FieldUserID)
 	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Rule entity.
-// If the Rule object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	if m.family != nil {
+		fields = append(fields, budget.FieldFamilyID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.category != nil {
+		fields = append(fields, budget.FieldCategory)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.amount_cents != nil {
+		fields = append(fields, budget.FieldAmountCents)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RuleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *RuleMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[rule.FieldUserID] = struct{}{}
+	if m.alert_thresholds != nil {
+		fields = append(fields, budget.FieldAlertThresholds)
+	}
+	if m.is_active != nil {
+		fields = append(fields, budget.FieldIsActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, budget.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, budget.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *RuleMutation) UserCleared() bool {
-	return m.cleareduser
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BudgetMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case budget.FieldUserID:
+		return m.UserID()
+	case budget.FieldFamilyID:
+		return m.FamilyID()
+	case budget.FieldCategory:
+		return m.Category()
+	case budget.FieldAmountCents:
+		return m.AmountCents()
+	case budget.FieldAlertThresholds:
+		return m.AlertThresholds()
+	case budget.FieldIsActive:
+		return m.IsActive()
+	case budget.FieldCreatedAt:
+		return m.CreatedAt()
+	case budget.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *RuleMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BudgetMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case budget.FieldUserID:
+		return m.OldUserID(ctx)
+	case budget.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case budget.FieldCategory:
+		return m.OldCategory(ctx)
+	case budget.FieldAmountCents:
+		return m.OldAmountCents(ctx)
+	case budget.FieldAlertThresholds:
+		return m.OldAlertThresholds(ctx)
+	case budget.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case budget.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case budget.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return
+	return nil, fmt.Errorf("unknown Budget field %s", name)
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *RuleMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// ClearTargetAccount clears the "target_account" edge to the Account entity.
-func (m *RuleMutation) ClearTargetAccount() {
-	m.clearedtarget_account = true
-	m.clearedFields[rule.FieldTargetAccountID] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BudgetMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case budget.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case budget.FieldFamilyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case budget.FieldCategory:
+		v, ok := value.(budget.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case budget.FieldAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountCents(v)
+		return nil
+	case budget.FieldAlertThresholds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlertThresholds(v)
+		return nil
+	case budget.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case budget.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case budget.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Budget field %s", name)
 }
 
-// TargetAccountCleared reports if the "target_account" edge to the Account entity was cleared.
-func (m *RuleMutation) TargetAccountCleared() bool {
-	return m.clearedtarget_account
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BudgetMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount_cents != nil {
+		fields = append(fields, budget.FieldAmountCents)
+	}
+	return fields
 }
 
-// TargetAccountIDs returns the "target_account" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TargetAccountID instead. It exists only for internal usage by the builders.
-func (m *RuleMutation) TargetAccountIDs() (ids []uuid.UUID) {
-	if id := m.target_account; id != nil {
-		ids = append(ids, *id)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BudgetMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case budget.FieldAmountCents:
+		return m.AddedAmountCents()
 	}
-	return
+	return nil, false
 }
 
-// ResetTargetAccount resets all changes to the "target_account" edge.
-func (m *RuleMutation) ResetTargetAccount() {
-	m.target_account = nil
-	m.clearedtarget_account = false
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BudgetMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case budget.FieldAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountCents(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Budget numeric field %s", name)
 }
 
-// AddExecutionIDs adds the "executions" edge to the RuleExecution entity by ids.
-func (m *RuleMutation) AddExecutionIDs(ids ...uuid.UUID) {
-	if m.executions == nil {
-		m.executions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.executions[ids[i]] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BudgetMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(budget.FieldFamilyID) {
+		fields = append(fields, budget.FieldFamilyID)
 	}
+	return fields
 }
 
-// ClearExecutions clears the "executions" edge to the RuleExecution entity.
-func (m *RuleMutation) ClearExecutions() {
-	m.clearedexecutions = true
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BudgetMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ExecutionsCleared reports if the "executions" edge to the RuleExecution entity was cleared.
-func (m *RuleMutation) ExecutionsCleared() bool {
-	return m.clearedexecutions
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BudgetMutation) ClearField(name string) error {
+	switch name {
+	case budget.FieldFamilyID:
+		m.ClearFamilyID()
+		return nil
+	}
+	return fmt.Errorf("unknown Budget nullable field %s", name)
 }
 
-// RemoveExecutionIDs removes the "executions" edge to the RuleExecution entity by IDs.
-func (m *RuleMutation) RemoveExecutionIDs(ids ...uuid.UUID) {
-	if m.removedexecutions == nil {
-		m.removedexecutions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.executions, ids[i])
-		m.removedexecutions[ids[i]] = struct{}{}
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BudgetMutation) ResetField(name string) error {
+	switch name {
+	case budget.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case budget.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case budget.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case budget.FieldAmountCents:
+		m.ResetAmountCents()
+		return nil
+	case budget.FieldAlertThresholds:
+		m.ResetAlertThresholds()
+		return nil
+	case budget.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case budget.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case budget.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
+	return fmt.Errorf("unknown Budget field %s", name)
 }
 
-// RemovedExecutions returns the removed IDs of the "executions" edge to the RuleExecution entity.
-func (m *RuleMutation) RemovedExecutionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedexecutions {
-		ids = append(ids, id)
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BudgetMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.user != nil {
+		edges = append(edges, budget.EdgeUser)
 	}
-	return
+	if m.family != nil {
+		edges = append(edges, budget.EdgeFamily)
+	}
+	if m.alerts != nil {
+		edges = append(edges, budget.EdgeAlerts)
+	}
+	if m.rules != nil {
+		edges = append(edges, budget.EdgeRules)
+	}
+	return edges
 }
 
-// ExecutionsIDs returns the "executions" edge IDs in the mutation.
-func (m *RuleMutation) ExecutionsIDs() (ids []uuid.UUID) {
-	for id := range m.executions {
-		ids = append(ids, id)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BudgetMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case budget.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case budget.EdgeFamily:
+		if id := m.family; id != nil {
+			return []ent.Value{*id}
+		}
+	case budget.EdgeAlerts:
+		ids := make([]ent.Value, 0, len(m.alerts))
+		for id := range m.alerts {
+			ids = append(ids, id)
+		}
+		return ids
+	case budget.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.rules))
+		for id := range m.rules {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return
+	return nil
 }
 
-// ResetExecutions resets all changes to the "executions" edge.
-func (m *RuleMutation) ResetExecutions() {
-	m.executions = nil
-	m.clearedexecutions = false
-	m.removedexecutions = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BudgetMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedalerts != nil {
+		edges = append(edges, budget.EdgeAlerts)
+	}
+	if m.removedrules != nil {
+		edges = append(edges, budget.EdgeRules)
+	}
+	return edges
 }
 
-// Where appends a list predicates to the RuleMutation builder.
-func (m *RuleMutation) Where(ps ...predicate.Rule) {
-	m.predicates = append(m.predicates, ps...)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BudgetMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case budget.EdgeAlerts:
+		ids := make([]ent.Value, 0, len(m.removedalerts))
+		for id := range m.removedalerts {
+			ids = append(ids, id)
+		}
+		return ids
+	case budget.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.removedrules))
+		for id := range m.removedrules {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// WhereP appends storage-level predicates to the RuleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RuleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Rule, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BudgetMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.cleareduser {
+		edges = append(edges, budget.EdgeUser)
 	}
-	m.Where(p...)
+	if m.clearedfamily {
+		edges = append(edges, budget.EdgeFamily)
+	}
+	if m.clearedalerts {
+		edges = append(edges, budget.EdgeAlerts)
+	}
+	if m.clearedrules {
+		edges = append(edges, budget.EdgeRules)
+	}
+	return edges
 }
 
-// Op returns the operation name.
-func (m *RuleMutation) Op() Op {
-	return m.op
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BudgetMutation) EdgeCleared(name string) bool {
+	switch name {
+	case budget.EdgeUser:
+		return m.cleareduser
+	case budget.EdgeFamily:
+		return m.clearedfamily
+	case budget.EdgeAlerts:
+		return m.clearedalerts
+	case budget.EdgeRules:
+		return m.clearedrules
+	}
+	return false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *RuleMutation) SetOp(op Op) {
-	m.op = op
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BudgetMutation) ClearEdge(name string) error {
+	switch name {
+	case budget.EdgeUser:
+		m.ClearUser()
+		return nil
+	case budget.EdgeFamily:
+		m.ClearFamily()
+		return nil
+	}
+	return fmt.Errorf("unknown Budget unique edge %s", name)
 }
 
-// Type returns the node type of this mutation (Rule).
-func (m *RuleMutation) Type() string {
-	return m.typ
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BudgetMutation) ResetEdge(name string) error {
+	switch name {
+	case budget.EdgeUser:
+		m.ResetUser()
+		return nil
+	case budget.EdgeFamily:
+		m.ResetFamily()
+		return nil
+	case budget.EdgeAlerts:
+		m.ResetAlerts()
+		return nil
+	case budget.EdgeRules:
+		m.ResetRules()
+		return nil
+	}
+	return fmt.Errorf("unknown Budget edge %s", name)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *RuleMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.user != nil {
-		fields = append(fields, rule.FieldUserID)
+// BudgetAlertMutation represents an operation that mutates the BudgetAlert nodes in the graph.
+type BudgetAlertMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	month          *string
+	threshold      *int
+	addthreshold   *int
+	spent_cents    *int64
+	addspent_cents *int64
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	budget         *uuid.UUID
+	clearedbudget  bool
+	done           bool
+	oldValue       func(context.Context) (*BudgetAlert, error)
+	predicates     []predicate.BudgetAlert
+}
+
+var _ ent.Mutation = (*BudgetAlertMutation)(nil)
+
+// budgetalertOption allows management of the mutation configuration using functional options.
+type budgetalertOption func(*BudgetAlertMutation)
+
+// newBudgetAlertMutation creates new mutation for the BudgetAlert entity.
+func newBudgetAlertMutation(c config, op Op, opts ...budgetalertOption) *BudgetAlertMutation {
+	m := &BudgetAlertMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBudgetAlert,
+		clearedFields: make(map[string]struct{}),
 	}
-	if m.name != nil {
-		fields = append(fields, rule.FieldName)
+	for _, opt := range opts {
+		opt(m)
 	}
-	if m.category != nil {
-		fields = append(fields, rule.FieldCategory)
+	return m
+}
+
+// withBudgetAlertID sets the ID field of the mutation.
+func withBudgetAlertID(id uuid.UUID) budgetalertOption {
+	return func(m *BudgetAlertMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BudgetAlert
+		)
+		m.oldValue = func(ctx context.Context) (*BudgetAlert, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BudgetAlert.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.min_amount_cents != nil {
-		fields = append(fields, rule.FieldMinAmountCents)
+}
+
+// withBudgetAlert sets the old BudgetAlert of the mutation.
+func withBudgetAlert(node *BudgetAlert) budgetalertOption {
+	return func(m *BudgetAlertMutation) {
+		m.oldValue = func(context.Context) (*BudgetAlert, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	if m.max_amount_cents != nil {
-		fields = append(fields, rule.FieldMaxAmountCents)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BudgetAlertMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BudgetAlertMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	if m.action_type != nil {
-		fields = append(fields, rule.FieldActionType)
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BudgetAlert entities.
+func (m *BudgetAlertMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BudgetAlertMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	if m.action_value != nil {
-		fields = append(fields, rule.FieldActionValue)
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BudgetAlertMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BudgetAlert.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	if m.target_account != nil {
-		fields = append(fields, rule.FieldTargetAccountID)
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (m *BudgetAlertMutation) SetBudgetID(u uuid.UUID) {
+	m.budget = &u
+}
+
+// BudgetID returns the value of the "budget_id" field in the mutation.
+func (m *BudgetAlertMutation) BudgetID() (r uuid.UUID, exists bool) {
+	v := m.budget
+	if v == nil {
+		return
 	}
-	if m.is_active != nil {
-		fields = append(fields, rule.FieldIsActive)
+	return *v, true
+}
+
+// OldBudgetID returns the old "budget_id" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldBudgetID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBudgetID is only allowed on UpdateOne operations")
 	}
-	if m.priority != nil {
-		fields = append(fields, rule.FieldPriority)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBudgetID requires an ID field in the mutation")
 	}
-	if m.execution_count != nil {
-		fields = append(fields, rule.FieldExecutionCount)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBudgetID: %w", err)
 	}
-	if m.total_saved_cents != nil {
-		fields = append(fields, rule.FieldTotalSavedCents)
+	return oldValue.BudgetID, nil
+}
+
+// ResetBudgetID resets all changes to the "budget_id" field.
+func (m *BudgetAlertMutation) ResetBudgetID() {
+	m.budget = nil
+}
+
+// SetMonth sets the "month" field.
+func (m *BudgetAlertMutation) SetMonth(s string) {
+	m.month = &s
+}
+
+// Month returns the value of the "month" field in the mutation.
+func (m *BudgetAlertMutation) Month() (r string, exists bool) {
+	v := m.month
+	if v == nil {
+		return
 	}
-	if m.created_at != nil {
-		fields = append(fields, rule.FieldCreatedAt)
+	return *v, true
+}
+
+// OldMonth returns the old "month" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldMonth(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonth is only allowed on UpdateOne operations")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, rule.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonth requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonth: %w", err)
+	}
+	return oldValue.Month, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *RuleMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case rule.FieldUserID:
-		return m.UserID()
-	case rule.FieldName:
-		return m.Name()
-	case rule.FieldCategory:
-		return m.Category()
-	case rule.FieldMinAmountCents:
-		return m.MinAmountCents()
-	case rule.FieldMaxAmountCents:
-		return m.MaxAmountCents()
-	case rule.FieldActionType:
-		return m.ActionType()
-	case rule.FieldActionValue:
-		return m.ActionValue()
-	case rule.FieldTargetAccountID:
-		return m.TargetAccountID()
-	case rule.FieldIsActive:
-		return m.IsActive()
-	case rule.FieldPriority:
-		return m.Priority()
-	case rule.FieldExecutionCount:
-		return m.ExecutionCount()
-	case rule.FieldTotalSavedCents:
-		return m.TotalSavedCents()
-	case rule.FieldCreatedAt:
-		return m.CreatedAt()
-	case rule.FieldUpdatedAt:
-		return m.UpdatedAt()
+// ResetMonth resets all changes to the "month" field.
+func (m *BudgetAlertMutation) ResetMonth() {
+	m.month = nil
+}
+
+// SetThreshold sets the "threshold" field.
+func (m *BudgetAlertMutation) SetThreshold(i int) {
+	m.threshold = &i
+	m.addthreshold = nil
+}
+
+// Threshold returns the value of the "threshold" field in the mutation.
+func (m *BudgetAlertMutation) Threshold() (r int, exists bool) {
+	v := m.threshold
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *RuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case rule.FieldUserID:
-		return m.OldUserID(ctx)
-	case rule.FieldName:
-		return m.OldName(ctx)
-	case rule.FieldCategory:
-		return m.OldCategory(ctx)
-	case rule.FieldMinAmountCents:
-		return m.OldMinAmountCents(ctx)
-	case rule.FieldMaxAmountCents:
-		return m.OldMaxAmountCents(ctx)
-	case rule.FieldActionType:
-		return m.OldActionType(ctx)
-	case rule.FieldActionValue:
-		return m.OldActionValue(ctx)
-	case rule.FieldTargetAccountID:
-		return m.OldTargetAccountID(ctx)
-	case rule.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case rule.FieldPriority:
-		return m.OldPriority(ctx)
-	case rule.FieldExecutionCount:
-		return m.OldExecutionCount(ctx)
-	case rule.FieldTotalSavedCents:
-		return m.OldTotalSavedCents(ctx)
-	case rule.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case rule.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// OldThreshold returns the old "threshold" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldThreshold(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThreshold is only allowed on UpdateOne operations")
 	}
-	return nil, fmt.Errorf("unknown Rule field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThreshold: %w", err)
+	}
+	return oldValue.Threshold, nil
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *RuleMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case rule.FieldUserID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case rule.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case rule.FieldCategory:
-		v, ok := value.(rule.Category)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case rule.FieldMinAmountCents:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMinAmountCents(v)
-		return nil
-	case rule.FieldMaxAmountCents:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMaxAmountCents(v)
-		return nil
-	case rule.FieldActionType:
-		v, ok := value.(rule.ActionType)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActionType(v)
-		return nil
-	case rule.FieldActionValue:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetActionValue(v)
-		return nil
-	case rule.FieldTargetAccountID:
+// AddThreshold adds i to the "threshold" field.
+func (m *BudgetAlertMutation) AddThreshold(i int) {
+	if m.addthreshold != nil {
+		*m.addthreshold += i
+	} else {
+		m.addthreshold = &i
+	}
+}
+
+// AddedThreshold returns the value that was added to the "threshold" field in this mutation.
+func (m *BudgetAlertMutation) AddedThreshold() (r int, exists bool) {
+	v := m.addthreshold
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetThreshold resets all changes to the "threshold" field.
+func (m *BudgetAlertMutation) ResetThreshold() {
+	m.threshold = nil
+	m.addthreshold = nil
+}
+
+// SetSpentCents sets the "spent_cents" field.
+func (m *BudgetAlertMutation) SetSpentCents(i int64) {
+	m.spent_cents = &i
+	m.addspent_cents = nil
+}
+
+// SpentCents returns the value of the "spent_cents" field in the mutation.
+func (m *BudgetAlertMutation) SpentCents() (r int64, exists bool) {
+	v := m.spent_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpentCents returns the old "spent_cents" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldSpentCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpentCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpentCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpentCents: %w", err)
+	}
+	return oldValue.SpentCents, nil
+}
+
+// AddSpentCents adds i to the "spent_cents" field.
+func (m *BudgetAlertMutation) AddSpentCents(i int64) {
+	if m.addspent_cents != nil {
+		*m.addspent_cents += i
+	} else {
+		m.addspent_cents = &i
+	}
+}
+
+// AddedSpentCents returns the value that was added to the "spent_cents" field in this mutation.
+func (m *BudgetAlertMutation) AddedSpentCents() (r int64, exists bool) {
+	v := m.addspent_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpentCents resets all changes to the "spent_cents" field.
+func (m *BudgetAlertMutation) ResetSpentCents() {
+	m.spent_cents = nil
+	m.addspent_cents = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BudgetAlertMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BudgetAlertMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BudgetAlert entity.
+// If the BudgetAlert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BudgetAlertMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BudgetAlertMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearBudget clears the "budget" edge to the Budget entity.
+func (m *BudgetAlertMutation) ClearBudget() {
+	m.clearedbudget = true
+	m.clearedFields[budgetalert.FieldBudgetID] = struct{}{}
+}
+
+// BudgetCleared reports if the "budget" edge to the Budget entity was cleared.
+func (m *BudgetAlertMutation) BudgetCleared() bool {
+	return m.clearedbudget
+}
+
+// BudgetIDs returns the "budget" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BudgetID instead. It exists only for internal usage by the builders.
+func (m *BudgetAlertMutation) BudgetIDs() (ids []uuid.UUID) {
+	if id := m.budget; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBudget resets all changes to the "budget" edge.
+func (m *BudgetAlertMutation) ResetBudget() {
+	m.budget = nil
+	m.clearedbudget = false
+}
+
+// Where appends a list predicates to the BudgetAlertMutation builder.
+func (m *BudgetAlertMutation) Where(ps ...predicate.BudgetAlert) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BudgetAlertMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BudgetAlertMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BudgetAlert, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BudgetAlertMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BudgetAlertMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BudgetAlert).
+func (m *BudgetAlertMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BudgetAlertMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.budget != nil {
+		fields = append(fields, budgetalert.FieldBudgetID)
+	}
+	if m.month != nil {
+		fields = append(fields, budgetalert.FieldMonth)
+	}
+	if m.threshold != nil {
+		fields = append(fields, budgetalert.FieldThreshold)
+	}
+	if m.spent_cents != nil {
+		fields = append(fields, budgetalert.FieldSpentCents)
+	}
+	if m.created_at != nil {
+		fields = append(fields, budgetalert.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BudgetAlertMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case budgetalert.FieldBudgetID:
+		return m.BudgetID()
+	case budgetalert.FieldMonth:
+		return m.Month()
+	case budgetalert.FieldThreshold:
+		return m.Threshold()
+	case budgetalert.FieldSpentCents:
+		return m.SpentCents()
+	case budgetalert.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BudgetAlertMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case budgetalert.FieldBudgetID:
+		return m.OldBudgetID(ctx)
+	case budgetalert.FieldMonth:
+		return m.OldMonth(ctx)
+	case budgetalert.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case budgetalert.FieldSpentCents:
+		return m.OldSpentCents(ctx)
+	case budgetalert.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BudgetAlert field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BudgetAlertMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case budgetalert.FieldBudgetID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetAccountID(v)
-		return nil
-	case rule.FieldIsActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsActive(v)
+		m.SetBudgetID(v)
 		return nil
-	case rule.FieldPriority:
-		v, ok := value.(int)
+	case budgetalert.FieldMonth:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPriority(v)
+		m.SetMonth(v)
 		return nil
-	case rule.FieldExecutionCount:
+	case budgetalert.FieldThreshold:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExecutionCount(v)
+		m.SetThreshold(v)
 		return nil
-	case rule.FieldTotalSavedCents:
+	case budgetalert.FieldSpentCents:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalSavedCents(v)
+		m.SetSpentCents(v)
 		return nil
-	case rule.FieldCreatedAt:
+	case budgetalert.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case rule.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Rule field %s", name)
+	return fmt.Errorf("unknown BudgetAlert field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RuleMutation) AddedFields() []string {
+func (m *BudgetAlertMutation) AddedFields() []string {
 	var fields []string
-	if m.addmin_amount_cents != nil {
-		fields = append(fields, rule.FieldMinAmountCents)
-	}
-	if m.addmax_amount_cents != nil {
-		fields = append(fields, rule.FieldMaxAmountCents)
-	}
-	if m.addaction_value != nil {
-		fields = append(fields, rule.FieldActionValue)
-	}
-	if m.addpriority != nil {
-		fields = append(fields, rule.FieldPriority)
-	}
-	if m.addexecution_count != nil {
-		fields = append(fields, rule.FieldExecutionCount)
+	if m.addthreshold != nil {
+		fields = append(fields, budgetalert.FieldThreshold)
 	}
-	if m.addtotal_saved_cents != nil {
-		fields = append(fields, rule.FieldTotalSavedCents)
+	if m.addspent_cents != nil {
+		fields = append(fields, budgetalert.FieldSpentCents)
 	}
 	return fields
 }
@@ -4288,20 +5949,12 @@ func (m *RuleMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RuleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *BudgetAlertMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case rule.FieldMinAmountCents:
-		return m.AddedMinAmountCents()
-	case rule.FieldMaxAmountCents:
-		return m.AddedMaxAmountCents()
-	case rule.FieldActionValue:
-		return m.AddedActionValue()
-	case rule.FieldPriority:
-		return m.AddedPriority()
-	case rule.FieldExecutionCount:
-		return m.AddedExecutionCount()
-	case rule.FieldTotalSavedCents:
-		return m.AddedTotalSavedCents()
+	case budgetalert.FieldThreshold:
+		return m.AddedThreshold()
+	case budgetalert.FieldSpentCents:
+		return m.AddedSpentCents()
 	}
 	return nil, false
 }
@@ -4309,297 +5962,185 @@ func (m *RuleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RuleMutation) AddField(name string, value ent.Value) error {
+func (m *BudgetAlertMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case rule.FieldMinAmountCents:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMinAmountCents(v)
-		return nil
-	case rule.FieldMaxAmountCents:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMaxAmountCents(v)
-		return nil
-	case rule.FieldActionValue:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddActionValue(v)
-		return nil
-	case rule.FieldPriority:
+	case budgetalert.FieldThreshold:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddPriority(v)
-		return nil
-	case rule.FieldExecutionCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExecutionCount(v)
+		m.AddThreshold(v)
 		return nil
-	case rule.FieldTotalSavedCents:
+	case budgetalert.FieldSpentCents:
 		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalSavedCents(v)
+		m.AddSpentCents(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Rule numeric field %s", name)
+	return fmt.Errorf("unknown BudgetAlert numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RuleMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(rule.FieldMinAmountCents) {
-		fields = append(fields, rule.FieldMinAmountCents)
-	}
-	if m.FieldCleared(rule.FieldMaxAmountCents) {
-		fields = append(fields, rule.FieldMaxAmountCents)
-	}
-	return fields
+func (m *BudgetAlertMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RuleMutation) FieldCleared(name string) bool {
+func (m *BudgetAlertMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RuleMutation) ClearField(name string) error {
-	switch name {
-	case rule.FieldMinAmountCents:
-		m.ClearMinAmountCents()
-		return nil
-	case rule.FieldMaxAmountCents:
-		m.ClearMaxAmountCents()
-		return nil
-	}
-	return fmt.Errorf("unknown Rule nullable field %s", name)
+func (m *BudgetAlertMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown BudgetAlert nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RuleMutation) ResetField(name string) error {
+func (m *BudgetAlertMutation) ResetField(name string) error {
 	switch name {
-	case rule.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case rule.FieldName:
-		m.ResetName()
-		return nil
-	case rule.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case rule.FieldMinAmountCents:
-		m.ResetMinAmountCents()
-		return nil
-	case rule.FieldMaxAmountCents:
-		m.ResetMaxAmountCents()
-		return nil
-	case rule.FieldActionType:
-		m.ResetActionType()
-		return nil
-	case rule.FieldActionValue:
-		m.ResetActionValue()
-		return nil
-	case rule.FieldTargetAccountID:
-		m.ResetTargetAccountID()
+	case budgetalert.FieldBudgetID:
+		m.ResetBudgetID()
 		return nil
-	case rule.FieldIsActive:
-		m.ResetIsActive()
-		return nil
-	case rule.FieldPriority:
-		m.ResetPriority()
+	case budgetalert.FieldMonth:
+		m.ResetMonth()
 		return nil
-	case rule.FieldExecutionCount:
-		m.ResetExecutionCount()
+	case budgetalert.FieldThreshold:
+		m.ResetThreshold()
 		return nil
-	case rule.FieldTotalSavedCents:
-		m.ResetTotalSavedCents()
+	case budgetalert.FieldSpentCents:
+		m.ResetSpentCents()
 		return nil
-	case rule.FieldCreatedAt:
+	case budgetalert.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case rule.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Rule field %s", name)
+	return fmt.Errorf("unknown BudgetAlert field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RuleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, rule.EdgeUser)
-	}
-	if m.target_account != nil {
-		edges = append(edges, rule.EdgeTargetAccount)
-	}
-	if m.executions != nil {
-		edges = append(edges, rule.EdgeExecutions)
+func (m *BudgetAlertMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.budget != nil {
+		edges = append(edges, budgetalert.EdgeBudget)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RuleMutation) AddedIDs(name string) []ent.Value {
+func (m *BudgetAlertMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case rule.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case rule.EdgeTargetAccount:
-		if id := m.target_account; id != nil {
+	case budgetalert.EdgeBudget:
+		if id := m.budget; id != nil {
 			return []ent.Value{*id}
 		}
-	case rule.EdgeExecutions:
-		ids := make([]ent.Value, 0, len(m.executions))
-		for id := range m.executions {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RuleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedexecutions != nil {
-		edges = append(edges, rule.EdgeExecutions)
-	}
+func (m *BudgetAlertMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RuleMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case rule.EdgeExecutions:
-		ids := make([]ent.Value, 0, len(m.removedexecutions))
-		for id := range m.removedexecutions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *BudgetAlertMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RuleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, rule.EdgeUser)
-	}
-	if m.clearedtarget_account {
-		edges = append(edges, rule.EdgeTargetAccount)
-	}
-	if m.clearedexecutions {
-		edges = append(edges, rule.EdgeExecutions)
+func (m *BudgetAlertMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedbudget {
+		edges = append(edges, budgetalert.EdgeBudget)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RuleMutation) EdgeCleared(name string) bool {
+func (m *BudgetAlertMutation) EdgeCleared(name string) bool {
 	switch name {
-	case rule.EdgeUser:
-		return m.cleareduser
-	case rule.EdgeTargetAccount:
-		return m.clearedtarget_account
-	case rule.EdgeExecutions:
-		return m.clearedexecutions
+	case budgetalert.EdgeBudget:
+		return m.clearedbudget
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RuleMutation) ClearEdge(name string) error {
+func (m *BudgetAlertMutation) ClearEdge(name string) error {
 	switch name {
-	case rule.EdgeUser:
-		m.ClearUser()
-		return nil
-	case rule.EdgeTargetAccount:
-		m.ClearTargetAccount()
+	case budgetalert.EdgeBudget:
+		m.ClearBudget()
 		return nil
 	}
-	return fmt.Errorf("unknown Rule unique edge %s", name)
+	return fmt.Errorf("unknown BudgetAlert unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RuleMutation) ResetEdge(name string) error {
+func (m *BudgetAlertMutation) ResetEdge(name string) error {
 	switch name {
-	case rule.EdgeUser:
-		m.ResetUser()
-		return nil
-	case rule.EdgeTargetAccount:
-		m.ResetTargetAccount()
-		return nil
-	case rule.EdgeExecutions:
-		m.ResetExecutions()
+	case budgetalert.EdgeBudget:
+		m.ResetBudget()
 		return nil
 	}
-	return fmt.Errorf("unknown Rule edge %s", name)
+	return fmt.Errorf("unknown BudgetAlert edge %s", name)
 }
 
-// RuleExecutionMutation represents an operation that mutates the RuleExecution nodes in the graph.
-type RuleExecutionMutation struct {
+// FamilyMutation represents an operation that mutates the Family nodes in the graph.
+type FamilyMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	amount_cents       *int64
-	addamount_cents    *int64
-	source_account_id  *uuid.UUID
-	target_account_id  *uuid.UUID
-	status             *ruleexecution.Status
-	error_message      *string
-	created_at         *time.Time
-	completed_at       *time.Time
-	clearedFields      map[string]struct{}
-	rule               *uuid.UUID
-	clearedrule        bool
-	transaction        *uuid.UUID
-	clearedtransaction bool
-	user               *uuid.UUID
-	cleareduser        bool
-	transfer           *uuid.UUID
-	clearedtransfer    bool
-	done               bool
-	oldValue           func(context.Context) (*RuleExecution, error)
-	predicates         []predicate.RuleExecution
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	name                   *string
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	savings_account        *uuid.UUID
+	clearedsavings_account bool
+	members                map[uuid.UUID]struct{}
+	removedmembers         map[uuid.UUID]struct{}
+	clearedmembers         bool
+	rules                  map[uuid.UUID]struct{}
+	removedrules           map[uuid.UUID]struct{}
+	clearedrules           bool
+	rule_executions        map[uuid.UUID]struct{}
+	removedrule_executions map[uuid.UUID]struct{}
+	clearedrule_executions bool
+	goals                  map[uuid.UUID]struct{}
+	removedgoals           map[uuid.UUID]struct{}
+	clearedgoals           bool
+	budgets                map[uuid.UUID]struct{}
+	removedbudgets         map[uuid.UUID]struct{}
+	clearedbudgets         bool
+	done                   bool
+	oldValue               func(context.Context) (*Family, error)
+	predicates             []predicate.Family
 }
 
-var _ ent.Mutation = (*RuleExecutionMutation)(nil)
+var _ ent.Mutation = (*FamilyMutation)(nil)
 
-// ruleexecutionOption allows management of the mutation configuration using functional options.
-type ruleexecutionOption func(*RuleExecutionMutation)
+// familyOption allows management of the mutation configuration using functional options.
+type familyOption func(*FamilyMutation)
 
-// newRuleExecutionMutation creates new mutation for the RuleExecution entity.
-func newRuleExecutionMutation(c config, op Op, opts ...ruleexecutionOption) *RuleExecutionMutation {
-	m := &RuleExecutionMutation{
+// newFamilyMutation creates new mutation for the Family entity.
+func newFamilyMutation(c config, op Op, opts ...familyOption) *FamilyMutation {
+	m := &FamilyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRuleExecution,
+		typ:           TypeFamily,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4608,20 +6149,20 @@ func newRuleExecutionMutation(c config, op Op, opts ...ruleexecutionOption) *Rul
 	return m
 }
 
-// withRuleExecutionID sets the ID field of the mutation.
-func withRuleExecutionID(id uuid.UUID) ruleexecutionOption {
-	return func(m *RuleExecutionMutation) {
+// withFamilyID sets the ID field of the mutation.
+func withFamilyID(id uuid.UUID) familyOption {
+	return func(m *FamilyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RuleExecution
+			value *Family
 		)
-		m.oldValue = func(ctx context.Context) (*RuleExecution, error) {
+		m.oldValue = func(ctx context.Context) (*Family, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RuleExecution.Get(ctx, id)
+					value, err = m.Client().Family.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4630,10 +6171,10 @@ func withRuleExecutionID(id uuid.UUID) ruleexecutionOption {
 	}
 }
 
-// withRuleExecution sets the old RuleExecution of the mutation.
-func withRuleExecution(node *RuleExecution) ruleexecutionOption {
-	return func(m *RuleExecutionMutation) {
-		m.oldValue = func(context.Context) (*RuleExecution, error) {
+// withFamily sets the old Family of the mutation.
+func withFamily(node *Family) familyOption {
+	return func(m *FamilyMutation) {
+		m.oldValue = func(context.Context) (*Family, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4642,7 +6183,7 @@ func withRuleExecution(node *RuleExecution) ruleexecutionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RuleExecutionMutation) Client() *Client {
+func (m FamilyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4650,7 +6191,7 @@ func (m RuleExecutionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RuleExecutionMutation) Tx() (*Tx, error) {
+func (m FamilyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4660,14 +6201,14 @@ func (m RuleExecutionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of RuleExecution entities.
-func (m *RuleExecutionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Family entities.
+func (m *FamilyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RuleExecutionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *FamilyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4678,7 +6219,7 @@ func (m *RuleExecutionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RuleExecutionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *FamilyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4687,547 +6228,475 @@ func (m *RuleExecutionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RuleExecution.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Family.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRuleID sets the "rule_id" field.
-func (m *RuleExecutionMutation) SetRuleID(u uuid.UUID) {
-	m.rule = &u
+// SetName sets the "name" field.
+func (m *FamilyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// RuleID returns the value of the "rule_id" field in the mutation.
-func (m *RuleExecutionMutation) RuleID() (r uuid.UUID, exists bool) {
-	v := m.rule
+// Name returns the value of the "name" field in the mutation.
+func (m *FamilyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRuleID returns the old "rule_id" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Family entity.
+// If the Family object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldRuleID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *FamilyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRuleID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRuleID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRuleID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.RuleID, nil
+	return oldValue.Name, nil
 }
 
-// ResetRuleID resets all changes to the "rule_id" field.
-func (m *RuleExecutionMutation) ResetRuleID() {
-	m.rule = nil
+// ResetName resets all changes to the "name" field.
+func (m *FamilyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetTransactionID sets the "transaction_id" field.
-func (m *RuleExecutionMutation) SetTransactionID(u uuid.UUID) {
-	m.transaction = &u
+// SetSavingsAccountID sets the "savings_account_id" field.
+func (m *FamilyMutation) SetSavingsAccountID(u uuid.UUID) {
+	m.savings_account = &u
 }
 
-// TransactionID returns the value of the "transaction_id" field in the mutation.
-func (m *RuleExecutionMutation) TransactionID() (r uuid.UUID, exists bool) {
-	v := m.transaction
+// SavingsAccountID returns the value of the "savings_account_id" field in the mutation.
+func (m *FamilyMutation) SavingsAccountID() (r uuid.UUID, exists bool) {
+	v := m.savings_account
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTransactionID returns the old "transaction_id" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldSavingsAccountID returns the old "savings_account_id" field's value of the Family entity.
+// If the Family object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldTransactionID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *FamilyMutation) OldSavingsAccountID(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSavingsAccountID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+		return v, errors.New("OldSavingsAccountID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSavingsAccountID: %w", err)
 	}
-	return oldValue.TransactionID, nil
+	return oldValue.SavingsAccountID, nil
 }
 
-// ResetTransactionID resets all changes to the "transaction_id" field.
-func (m *RuleExecutionMutation) ResetTransactionID() {
-	m.transaction = nil
+// ClearSavingsAccountID clears the value of the "savings_account_id" field.
+func (m *FamilyMutation) ClearSavingsAccountID() {
+	m.savings_account = nil
+	m.clearedFields[family.FieldSavingsAccountID] = struct{}{}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *RuleExecutionMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SavingsAccountIDCleared returns if the "savings_account_id" field was cleared in this mutation.
+func (m *FamilyMutation) SavingsAccountIDCleared() bool {
+	_, ok := m.clearedFields[family.FieldSavingsAccountID]
+	return ok
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *RuleExecutionMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// ResetSavingsAccountID resets all changes to the "savings_account_id" field.
+func (m *FamilyMutation) ResetSavingsAccountID() {
+	m.savings_account = nil
+	delete(m.clearedFields, family.FieldSavingsAccountID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FamilyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FamilyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Family entity.
+// If the Family object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *FamilyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *RuleExecutionMutation) ResetUserID() {
-	m.user = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FamilyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetAmountCents sets the "amount_cents" field.
-func (m *RuleExecutionMutation) SetAmountCents(i int64) {
-	m.amount_cents = &i
-	m.addamount_cents = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FamilyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// AmountCents returns the value of the "amount_cents" field in the mutation.
-func (m *RuleExecutionMutation) AmountCents() (r int64, exists bool) {
-	v := m.amount_cents
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FamilyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAmountCents returns the old "amount_cents" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Family entity.
+// If the Family object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldAmountCents(ctx context.Context) (v int64, err error) {
+func (m *FamilyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmountCents is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmountCents requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmountCents: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.AmountCents, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddAmountCents adds i to the "amount_cents" field.
-func (m *RuleExecutionMutation) AddAmountCents(i int64) {
-	if m.addamount_cents != nil {
-		*m.addamount_cents += i
-	} else {
-		m.addamount_cents = &i
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FamilyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedAmountCents returns the value that was added to the "amount_cents" field in this mutation.
-func (m *RuleExecutionMutation) AddedAmountCents() (r int64, exists bool) {
-	v := m.addamount_cents
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearSavingsAccount clears the "savings_account" edge to the Account entity.
+func (m *FamilyMutation) ClearSavingsAccount() {
+	m.clearedsavings_account = true
+	m.clearedFields[family.FieldSavingsAccountID] = struct{}{}
 }
 
-// ResetAmountCents resets all changes to the "amount_cents" field.
-func (m *RuleExecutionMutation) ResetAmountCents() {
-	m.amount_cents = nil
-	m.addamount_cents = nil
+// SavingsAccountCleared reports if the "savings_account" edge to the Account entity was cleared.
+func (m *FamilyMutation) SavingsAccountCleared() bool {
+	return m.SavingsAccountIDCleared() || m.clearedsavings_account
 }
 
-// SetSourceAccountID sets the "source_account_id" field.
-func (m *RuleExecutionMutation) SetSourceAccountID(u uuid.UUID) {
-	m.source_account_id = &u
+// SavingsAccountIDs returns the "savings_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SavingsAccountID instead. It exists only for internal usage by the builders.
+func (m *FamilyMutation) SavingsAccountIDs() (ids []uuid.UUID) {
+	if id := m.savings_account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// SourceAccountID returns the value of the "source_account_id" field in the mutation.
-func (m *RuleExecutionMutation) SourceAccountID() (r uuid.UUID, exists bool) {
-	v := m.source_account_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetSavingsAccount resets all changes to the "savings_account" edge.
+func (m *FamilyMutation) ResetSavingsAccount() {
+	m.savings_account = nil
+	m.clearedsavings_account = false
 }
 
-// OldSourceAccountID returns the old "source_account_id" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldSourceAccountID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceAccountID is only allowed on UpdateOne operations")
+// AddMemberIDs adds the "members" edge to the FamilyMember entity by ids.
+func (m *FamilyMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceAccountID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceAccountID: %w", err)
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
 	}
-	return oldValue.SourceAccountID, nil
 }
 
-// ResetSourceAccountID resets all changes to the "source_account_id" field.
-func (m *RuleExecutionMutation) ResetSourceAccountID() {
-	m.source_account_id = nil
+// ClearMembers clears the "members" edge to the FamilyMember entity.
+func (m *FamilyMutation) ClearMembers() {
+	m.clearedmembers = true
 }
 
-// SetTargetAccountID sets the "target_account_id" field.
-func (m *RuleExecutionMutation) SetTargetAccountID(u uuid.UUID) {
-	m.target_account_id = &u
+// MembersCleared reports if the "members" edge to the FamilyMember entity was cleared.
+func (m *FamilyMutation) MembersCleared() bool {
+	return m.clearedmembers
 }
 
-// TargetAccountID returns the value of the "target_account_id" field in the mutation.
-func (m *RuleExecutionMutation) TargetAccountID() (r uuid.UUID, exists bool) {
-	v := m.target_account_id
-	if v == nil {
-		return
+// RemoveMemberIDs removes the "members" edge to the FamilyMember entity by IDs.
+func (m *FamilyMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldTargetAccountID returns the old "target_account_id" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldTargetAccountID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetAccountID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetAccountID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetAccountID: %w", err)
+// RemovedMembers returns the removed IDs of the "members" edge to the FamilyMember entity.
+func (m *FamilyMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
 	}
-	return oldValue.TargetAccountID, nil
+	return
 }
 
-// ResetTargetAccountID resets all changes to the "target_account_id" field.
-func (m *RuleExecutionMutation) ResetTargetAccountID() {
-	m.target_account_id = nil
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *FamilyMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetStatus sets the "status" field.
-func (m *RuleExecutionMutation) SetStatus(r ruleexecution.Status) {
-	m.status = &r
+// ResetMembers resets all changes to the "members" edge.
+func (m *FamilyMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *RuleExecutionMutation) Status() (r ruleexecution.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// AddRuleIDs adds the "rules" edge to the Rule entity by ids.
+func (m *FamilyMutation) AddRuleIDs(ids ...uuid.UUID) {
+	if m.rules == nil {
+		m.rules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.rules[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldStatus(ctx context.Context) (v ruleexecution.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
-	}
-	return oldValue.Status, nil
+// ClearRules clears the "rules" edge to the Rule entity.
+func (m *FamilyMutation) ClearRules() {
+	m.clearedrules = true
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *RuleExecutionMutation) ResetStatus() {
-	m.status = nil
+// RulesCleared reports if the "rules" edge to the Rule entity was cleared.
+func (m *FamilyMutation) RulesCleared() bool {
+	return m.clearedrules
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *RuleExecutionMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// RemoveRuleIDs removes the "rules" edge to the Rule entity by IDs.
+func (m *FamilyMutation) RemoveRuleIDs(ids ...uuid.UUID) {
+	if m.removedrules == nil {
+		m.removedrules = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.rules, ids[i])
+		m.removedrules[ids[i]] = struct{}{}
+	}
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *RuleExecutionMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
-	if v == nil {
-		return
+// RemovedRules returns the removed IDs of the "rules" edge to the Rule entity.
+func (m *FamilyMutation) RemovedRulesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrules {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+// RulesIDs returns the "rules" edge IDs in the mutation.
+func (m *FamilyMutation) RulesIDs() (ids []uuid.UUID) {
+	for id := range m.rules {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	return
+}
+
+// ResetRules resets all changes to the "rules" edge.
+func (m *FamilyMutation) ResetRules() {
+	m.rules = nil
+	m.clearedrules = false
+	m.removedrules = nil
+}
+
+// AddRuleExecutionIDs adds the "rule_executions" edge to the RuleExecution entity by ids.
+func (m *FamilyMutation) AddRuleExecutionIDs(ids ...uuid.UUID) {
+	if m.rule_executions == nil {
+		m.rule_executions = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	for i := range ids {
+		m.rule_executions[ids[i]] = struct{}{}
 	}
-	return oldValue.ErrorMessage, nil
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *RuleExecutionMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[ruleexecution.FieldErrorMessage] = struct{}{}
+// ClearRuleExecutions clears the "rule_executions" edge to the RuleExecution entity.
+func (m *FamilyMutation) ClearRuleExecutions() {
+	m.clearedrule_executions = true
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *RuleExecutionMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[ruleexecution.FieldErrorMessage]
-	return ok
+// RuleExecutionsCleared reports if the "rule_executions" edge to the RuleExecution entity was cleared.
+func (m *FamilyMutation) RuleExecutionsCleared() bool {
+	return m.clearedrule_executions
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *RuleExecutionMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, ruleexecution.FieldErrorMessage)
+// RemoveRuleExecutionIDs removes the "rule_executions" edge to the RuleExecution entity by IDs.
+func (m *FamilyMutation) RemoveRuleExecutionIDs(ids ...uuid.UUID) {
+	if m.removedrule_executions == nil {
+		m.removedrule_executions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.rule_executions, ids[i])
+		m.removedrule_executions[ids[i]] = struct{}{}
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RuleExecutionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// RemovedRuleExecutions returns the removed IDs of the "rule_executions" edge to the RuleExecution entity.
+func (m *FamilyMutation) RemovedRuleExecutionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrule_executions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RuleExecutionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// RuleExecutionsIDs returns the "rule_executions" edge IDs in the mutation.
+func (m *FamilyMutation) RuleExecutionsIDs() (ids []uuid.UUID) {
+	for id := range m.rule_executions {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+// ResetRuleExecutions resets all changes to the "rule_executions" edge.
+func (m *FamilyMutation) ResetRuleExecutions() {
+	m.rule_executions = nil
+	m.clearedrule_executions = false
+	m.removedrule_executions = nil
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by ids.
+func (m *FamilyMutation) AddGoalIDs(ids ...uuid.UUID) {
+	if m.goals == nil {
+		m.goals = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	for i := range ids {
+		m.goals[ids[i]] = struct{}{}
 	}
-	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RuleExecutionMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ClearGoals clears the "goals" edge to the Goal entity.
+func (m *FamilyMutation) ClearGoals() {
+	m.clearedgoals = true
 }
 
-// SetCompletedAt sets the "completed_at" field.
-func (m *RuleExecutionMutation) SetCompletedAt(t time.Time) {
-	m.completed_at = &t
+// GoalsCleared reports if the "goals" edge to the Goal entity was cleared.
+func (m *FamilyMutation) GoalsCleared() bool {
+	return m.clearedgoals
 }
 
-// CompletedAt returns the value of the "completed_at" field in the mutation.
-func (m *RuleExecutionMutation) CompletedAt() (r time.Time, exists bool) {
-	v := m.completed_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldCompletedAt returns the old "completed_at" field's value of the RuleExecution entity.
-// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RuleExecutionMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+// RemoveGoalIDs removes the "goals" edge to the Goal entity by IDs.
+func (m *FamilyMutation) RemoveGoalIDs(ids ...uuid.UUID) {
+	if m.removedgoals == nil {
+		m.removedgoals = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	for i := range ids {
+		delete(m.goals, ids[i])
+		m.removedgoals[ids[i]] = struct{}{}
 	}
-	return oldValue.CompletedAt, nil
-}
-
-// ClearCompletedAt clears the value of the "completed_at" field.
-func (m *RuleExecutionMutation) ClearCompletedAt() {
-	m.completed_at = nil
-	m.clearedFields[ruleexecution.FieldCompletedAt] = struct{}{}
-}
-
-// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
-func (m *RuleExecutionMutation) CompletedAtCleared() bool {
-	_, ok := m.clearedFields[ruleexecution.FieldCompletedAt]
-	return ok
-}
-
-// ResetCompletedAt resets all changes to the "completed_at" field.
-func (m *RuleExecutionMutation) ResetCompletedAt() {
-	m.completed_at = nil
-	delete(m.clearedFields, ruleexecution.FieldCompletedAt)
-}
-
-// ClearRule clears the "rule" edge to the Rule entity.
-func (m *RuleExecutionMutation) ClearRule() {
-	m.clearedrule = true
-	m.clearedFields[ruleexecution.FieldRuleID] = struct{}{}
-}
-
-// RuleCleared reports if the "rule" edge to the Rule entity was cleared.
-func (m *RuleExecutionMutation) RuleCleared() bool {
-	return m.clearedrule
 }
 
-// RuleIDs returns the "rule" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RuleID instead. It exists only for internal usage by the builders.
-func (m *RuleExecutionMutation) RuleIDs() (ids []uuid.UUID) {
-	if id := m.rule; id != nil {
-		ids = append(ids, *id)
+// RemovedGoals returns the removed IDs of the "goals" edge to the Goal entity.
+func (m *FamilyMutation) RemovedGoalsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgoals {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetRule resets all changes to the "rule" edge.
-func (m *RuleExecutionMutation) ResetRule() {
-	m.rule = nil
-	m.clearedrule = false
-}
-
-// ClearTransaction clears the "transaction" edge to the Transaction entity.
-func (m *RuleExecutionMutation) ClearTransaction() {
-	m.clearedtransaction = true
-	m.clearedFields[ruleexecution.FieldTransactionID] = struct{}{}
-}
-
-// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
-func (m *RuleExecutionMutation) TransactionCleared() bool {
-	return m.clearedtransaction
-}
-
-// TransactionIDs returns the "transaction" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TransactionID instead. It exists only for internal usage by the builders.
-func (m *RuleExecutionMutation) TransactionIDs() (ids []uuid.UUID) {
-	if id := m.transaction; id != nil {
-		ids = append(ids, *id)
+// GoalsIDs returns the "goals" edge IDs in the mutation.
+func (m *FamilyMutation) GoalsIDs() (ids []uuid.UUID) {
+	for id := range m.goals {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTransaction resets all changes to the "transaction" edge.
-func (m *RuleExecutionMutation) ResetTransaction() {
-	m.transaction = nil
-	m.clearedtransaction = false
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *RuleExecutionMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[ruleexecution.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *RuleExecutionMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetGoals resets all changes to the "goals" edge.
+func (m *FamilyMutation) ResetGoals() {
+	m.goals = nil
+	m.clearedgoals = false
+	m.removedgoals = nil
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *RuleExecutionMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by ids.
+func (m *FamilyMutation) AddBudgetIDs(ids ...uuid.UUID) {
+	if m.budgets == nil {
+		m.budgets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.budgets[ids[i]] = struct{}{}
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *RuleExecutionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
 }
 
-// SetTransferID sets the "transfer" edge to the SavingsTransfer entity by id.
-func (m *RuleExecutionMutation) SetTransferID(id uuid.UUID) {
-	m.transfer = &id
+// ClearBudgets clears the "budgets" edge to the Budget entity.
+func (m *FamilyMutation) ClearBudgets() {
+	m.clearedbudgets = true
 }
 
-// ClearTransfer clears the "transfer" edge to the SavingsTransfer entity.
-func (m *RuleExecutionMutation) ClearTransfer() {
-	m.clearedtransfer = true
+// BudgetsCleared reports if the "budgets" edge to the Budget entity was cleared.
+func (m *FamilyMutation) BudgetsCleared() bool {
+	return m.clearedbudgets
 }
 
-// TransferCleared reports if the "transfer" edge to the SavingsTransfer entity was cleared.
-func (m *RuleExecutionMutation) TransferCleared() bool {
-	return m.clearedtransfer
+// RemoveBudgetIDs removes the "budgets" edge to the Budget entity by IDs.
+func (m *FamilyMutation) RemoveBudgetIDs(ids ...uuid.UUID) {
+	if m.removedbudgets == nil {
+		m.removedbudgets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.budgets, ids[i])
+		m.removedbudgets[ids[i]] = struct{}{}
+	}
 }
 
-// TransferID returns the "transfer" edge ID in the mutation.
-func (m *RuleExecutionMutation) TransferID() (id uuid.UUID, exists bool) {
-	if m.transfer != nil {
-		return *m.transfer, true
+// RemovedBudgets returns the removed IDs of the "budgets" edge to the Budget entity.
+func (m *FamilyMutation) RemovedBudgetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbudgets {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// TransferIDs returns the "transfer" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TransferID instead. It exists only for internal usage by the builders.
-func (m *RuleExecutionMutation) TransferIDs() (ids []uuid.UUID) {
-	if id := m.transfer; id != nil {
-		ids = append(ids, *id)
+// BudgetsIDs returns the "budgets" edge IDs in the mutation.
+func (m *FamilyMutation) BudgetsIDs() (ids []uuid.UUID) {
+	for id := range m.budgets {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetTransfer resets all changes to the "transfer" edge.
-func (m *RuleExecutionMutation) ResetTransfer() {
-	m.transfer = nil
-	m.clearedtransfer = false
+// ResetBudgets resets all changes to the "budgets" edge.
+func (m *FamilyMutation) ResetBudgets() {
+	m.budgets = nil
+	m.clearedbudgets = false
+	m.removedbudgets = nil
 }
 
-// Where appends a list predicates to the RuleExecutionMutation builder.
-func (m *RuleExecutionMutation) Where(ps ...predicate.RuleExecution) {
+// Where appends a list predicates to the FamilyMutation builder.
+func (m *FamilyMutation) Where(ps ...predicate.Family) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RuleExecutionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FamilyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RuleExecutionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RuleExecution, len(ps))
+func (m *FamilyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Family, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5235,54 +6704,36 @@ func (m *RuleExecutionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RuleExecutionMutation) Op() Op {
+func (m *FamilyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RuleExecutionMutation) SetOp(op Op) {
+func (m *FamilyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RuleExecution).
-func (m *RuleExecutionMutation) Type() string {
+// Type returns the node type of this mutation (Family).
+func (m *FamilyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RuleExecutionMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.rule != nil {
-		fields = append(fields, ruleexecution.FieldRuleID)
-	}
-	if m.transaction != nil {
-		fields = append(fields, ruleexecution.FieldTransactionID)
-	}
-	if m.user != nil {
-		fields = append(fields, ruleexecution.FieldUserID)
-	}
-	if m.amount_cents != nil {
-		fields = append(fields, ruleexecution.FieldAmountCents)
-	}
-	if m.source_account_id != nil {
-		fields = append(fields, ruleexecution.FieldSourceAccountID)
-	}
-	if m.target_account_id != nil {
-		fields = append(fields, ruleexecution.FieldTargetAccountID)
-	}
-	if m.status != nil {
-		fields = append(fields, ruleexecution.FieldStatus)
+func (m *FamilyMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.name != nil {
+		fields = append(fields, family.FieldName)
 	}
-	if m.error_message != nil {
-		fields = append(fields, ruleexecution.FieldErrorMessage)
+	if m.savings_account != nil {
+		fields = append(fields, family.FieldSavingsAccountID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, ruleexecution.FieldCreatedAt)
+		fields = append(fields, family.FieldCreatedAt)
 	}
-	if m.completed_at != nil {
-		fields = append(fields, ruleexecution.FieldCompletedAt)
+	if m.updated_at != nil {
+		fields = append(fields, family.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5290,28 +6741,16 @@ func (m *RuleExecutionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RuleExecutionMutation) Field(name string) (ent.Value, bool) {
+func (m *FamilyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case ruleexecution.FieldRuleID:
-		return m.RuleID()
-	case ruleexecution.FieldTransactionID:
-		return m.TransactionID()
-	case ruleexecution.FieldUserID:
-		return m.UserID()
-	case ruleexecution.FieldAmountCents:
-		return m.AmountCents()
-	case ruleexecution.FieldSourceAccountID:
-		return m.SourceAccountID()
-	case ruleexecution.FieldTargetAccountID:
-		return m.TargetAccountID()
-	case ruleexecution.FieldStatus:
-		return m.Status()
-	case ruleexecution.FieldErrorMessage:
-		return m.ErrorMessage()
-	case ruleexecution.FieldCreatedAt:
+	case family.FieldName:
+		return m.Name()
+	case family.FieldSavingsAccountID:
+		return m.SavingsAccountID()
+	case family.FieldCreatedAt:
 		return m.CreatedAt()
-	case ruleexecution.FieldCompletedAt:
-		return m.CompletedAt()
+	case family.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5319,386 +6758,363 @@ func (m *RuleExecutionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RuleExecutionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FamilyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case ruleexecution.FieldRuleID:
-		return m.OldRuleID(ctx)
-	case ruleexecution.FieldTransactionID:
-		return m.OldTransactionID(ctx)
-	case ruleexecution.FieldUserID:
-		return m.OldUserID(ctx)
-	case ruleexecution.FieldAmountCents:
-		return m.OldAmountCents(ctx)
-	case ruleexecution.FieldSourceAccountID:
-		return m.OldSourceAccountID(ctx)
-	case ruleexecution.FieldTargetAccountID:
-		return m.OldTargetAccountID(ctx)
-	case ruleexecution.FieldStatus:
-		return m.OldStatus(ctx)
-	case ruleexecution.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case ruleexecution.FieldCreatedAt:
+	case family.FieldName:
+		return m.OldName(ctx)
+	case family.FieldSavingsAccountID:
+		return m.OldSavingsAccountID(ctx)
+	case family.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case ruleexecution.FieldCompletedAt:
-		return m.OldCompletedAt(ctx)
+	case family.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RuleExecution field %s", name)
+	return nil, fmt.Errorf("unknown Family field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RuleExecutionMutation) SetField(name string, value ent.Value) error {
+func (m *FamilyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case ruleexecution.FieldRuleID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRuleID(v)
-		return nil
-	case ruleexecution.FieldTransactionID:
-		v, ok := value.(uuid.UUID)
+	case family.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTransactionID(v)
+		m.SetName(v)
 		return nil
-	case ruleexecution.FieldUserID:
+	case family.FieldSavingsAccountID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
-		return nil
-	case ruleexecution.FieldAmountCents:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAmountCents(v)
+		m.SetSavingsAccountID(v)
 		return nil
-	case ruleexecution.FieldSourceAccountID:
-		v, ok := value.(uuid.UUID)
+	case family.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSourceAccountID(v)
+		m.SetCreatedAt(v)
 		return nil
-	case ruleexecution.FieldTargetAccountID:
-		v, ok := value.(uuid.UUID)
+	case family.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetAccountID(v)
-		return nil
-	case ruleexecution.FieldStatus:
-		v, ok := value.(ruleexecution.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case ruleexecution.FieldErrorMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorMessage(v)
-		return nil
-	case ruleexecution.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case ruleexecution.FieldCompletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCompletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RuleExecution field %s", name)
+	return fmt.Errorf("unknown Family field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RuleExecutionMutation) AddedFields() []string {
-	var fields []string
-	if m.addamount_cents != nil {
-		fields = append(fields, ruleexecution.FieldAmountCents)
-	}
-	return fields
+func (m *FamilyMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RuleExecutionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case ruleexecution.FieldAmountCents:
-		return m.AddedAmountCents()
-	}
+func (m *FamilyMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RuleExecutionMutation) AddField(name string, value ent.Value) error {
+func (m *FamilyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case ruleexecution.FieldAmountCents:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAmountCents(v)
-		return nil
 	}
-	return fmt.Errorf("unknown RuleExecution numeric field %s", name)
+	return fmt.Errorf("unknown Family numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RuleExecutionMutation) ClearedFields() []string {
+func (m *FamilyMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(ruleexecution.FieldErrorMessage) {
-		fields = append(fields, ruleexecution.FieldErrorMessage)
-	}
-	if m.FieldCleared(ruleexecution.FieldCompletedAt) {
-		fields = append(fields, ruleexecution.FieldCompletedAt)
+	if m.FieldCleared(family.FieldSavingsAccountID) {
+		fields = append(fields, family.FieldSavingsAccountID)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RuleExecutionMutation) FieldCleared(name string) bool {
+func (m *FamilyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RuleExecutionMutation) ClearField(name string) error {
+func (m *FamilyMutation) ClearField(name string) error {
 	switch name {
-	case ruleexecution.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case ruleexecution.FieldCompletedAt:
-		m.ClearCompletedAt()
+	case family.FieldSavingsAccountID:
+		m.ClearSavingsAccountID()
 		return nil
 	}
-	return fmt.Errorf("unknown RuleExecution nullable field %s", name)
+	return fmt.Errorf("unknown Family nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RuleExecutionMutation) ResetField(name string) error {
+func (m *FamilyMutation) ResetField(name string) error {
 	switch name {
-	case ruleexecution.FieldRuleID:
-		m.ResetRuleID()
-		return nil
-	case ruleexecution.FieldTransactionID:
-		m.ResetTransactionID()
-		return nil
-	case ruleexecution.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case ruleexecution.FieldAmountCents:
-		m.ResetAmountCents()
-		return nil
-	case ruleexecution.FieldSourceAccountID:
-		m.ResetSourceAccountID()
-		return nil
-	case ruleexecution.FieldTargetAccountID:
-		m.ResetTargetAccountID()
-		return nil
-	case ruleexecution.FieldStatus:
-		m.ResetStatus()
+	case family.FieldName:
+		m.ResetName()
 		return nil
-	case ruleexecution.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case family.FieldSavingsAccountID:
+		m.ResetSavingsAccountID()
 		return nil
-	case ruleexecution.FieldCreatedAt:
+	case family.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case ruleexecution.FieldCompletedAt:
-		m.ResetCompletedAt()
+	case family.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown RuleExecution field %s", name)
+	return fmt.Errorf("unknown Family field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RuleExecutionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.rule != nil {
-		edges = append(edges, ruleexecution.EdgeRule)
+func (m *FamilyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.savings_account != nil {
+		edges = append(edges, family.EdgeSavingsAccount)
 	}
-	if m.transaction != nil {
-		edges = append(edges, ruleexecution.EdgeTransaction)
+	if m.members != nil {
+		edges = append(edges, family.EdgeMembers)
 	}
-	if m.user != nil {
-		edges = append(edges, ruleexecution.EdgeUser)
+	if m.rules != nil {
+		edges = append(edges, family.EdgeRules)
 	}
-	if m.transfer != nil {
-		edges = append(edges, ruleexecution.EdgeTransfer)
+	if m.rule_executions != nil {
+		edges = append(edges, family.EdgeRuleExecutions)
+	}
+	if m.goals != nil {
+		edges = append(edges, family.EdgeGoals)
+	}
+	if m.budgets != nil {
+		edges = append(edges, family.EdgeBudgets)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RuleExecutionMutation) AddedIDs(name string) []ent.Value {
+func (m *FamilyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case ruleexecution.EdgeRule:
-		if id := m.rule; id != nil {
+	case family.EdgeSavingsAccount:
+		if id := m.savings_account; id != nil {
 			return []ent.Value{*id}
 		}
-	case ruleexecution.EdgeTransaction:
-		if id := m.transaction; id != nil {
-			return []ent.Value{*id}
+	case family.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
 		}
-	case ruleexecution.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case family.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.rules))
+		for id := range m.rules {
+			ids = append(ids, id)
 		}
-	case ruleexecution.EdgeTransfer:
-		if id := m.transfer; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case family.EdgeRuleExecutions:
+		ids := make([]ent.Value, 0, len(m.rule_executions))
+		for id := range m.rule_executions {
+			ids = append(ids, id)
+		}
+		return ids
+	case family.EdgeGoals:
+		ids := make([]ent.Value, 0, len(m.goals))
+		for id := range m.goals {
+			ids = append(ids, id)
 		}
+		return ids
+	case family.EdgeBudgets:
+		ids := make([]ent.Value, 0, len(m.budgets))
+		for id := range m.budgets {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RuleExecutionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *FamilyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedmembers != nil {
+		edges = append(edges, family.EdgeMembers)
+	}
+	if m.removedrules != nil {
+		edges = append(edges, family.EdgeRules)
+	}
+	if m.removedrule_executions != nil {
+		edges = append(edges, family.EdgeRuleExecutions)
+	}
+	if m.removedgoals != nil {
+		edges = append(edges, family.EdgeGoals)
+	}
+	if m.removedbudgets != nil {
+		edges = append(edges, family.EdgeBudgets)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RuleExecutionMutation) RemovedIDs(name string) []ent.Value {
+func (m *FamilyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case family.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case family.EdgeRules:
+		ids := make([]ent.Value, 0, len(m.removedrules))
+		for id := range m.removedrules {
+			ids = append(ids, id)
+		}
+		return ids
+	case family.EdgeRuleExecutions:
+		ids := make([]ent.Value, 0, len(m.removedrule_executions))
+		for id := range m.removedrule_executions {
+			ids = append(ids, id)
+		}
+		return ids
+	case family.EdgeGoals:
+		ids := make([]ent.Value, 0, len(m.removedgoals))
+		for id := range m.removedgoals {
+			ids = append(ids, id)
+		}
+		return ids
+	case family.EdgeBudgets:
+		ids := make([]ent.Value, 0, len(m.removedbudgets))
+		for id := range m.removedbudgets {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RuleExecutionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedrule {
-		edges = append(edges, ruleexecution.EdgeRule)
+func (m *FamilyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedsavings_account {
+		edges = append(edges, family.EdgeSavingsAccount)
 	}
-	if m.clearedtransaction {
-		edges = append(edges, ruleexecution.EdgeTransaction)
+	if m.clearedmembers {
+		edges = append(edges, family.EdgeMembers)
 	}
-	if m.cleareduser {
-		edges = append(edges, ruleexecution.EdgeUser)
+	if m.clearedrules {
+		edges = append(edges, family.EdgeRules)
 	}
-	if m.clearedtransfer {
-		edges = append(edges, ruleexecution.EdgeTransfer)
+	if m.clearedrule_executions {
+		edges = append(edges, family.EdgeRuleExecutions)
+	}
+	if m.clearedgoals {
+		edges = append(edges, family.EdgeGoals)
+	}
+	if m.clearedbudgets {
+		edges = append(edges, family.EdgeBudgets)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RuleExecutionMutation) EdgeCleared(name string) bool {
+func (m *FamilyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case ruleexecution.EdgeRule:
-		return m.clearedrule
-	case ruleexecution.EdgeTransaction:
-		return m.clearedtransaction
-	case ruleexecution.EdgeUser:
-		return m.cleareduser
-	case ruleexecution.EdgeTransfer:
-		return m.clearedtransfer
+	case family.EdgeSavingsAccount:
+		return m.clearedsavings_account
+	case family.EdgeMembers:
+		return m.clearedmembers
+	case family.EdgeRules:
+		return m.clearedrules
+	case family.EdgeRuleExecutions:
+		return m.clearedrule_executions
+	case family.EdgeGoals:
+		return m.clearedgoals
+	case family.EdgeBudgets:
+		return m.clearedbudgets
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RuleExecutionMutation) ClearEdge(name string) error {
+func (m *FamilyMutation) ClearEdge(name string) error {
 	switch name {
-	case ruleexecution.EdgeRule:
-		m.ClearRule()
-		return nil
-	case ruleexecution.EdgeTransaction:
-		m.ClearTransaction()
-		return nil
-	case ruleexecution.EdgeUser:
-		m.ClearUser()
-		return nil
-	case ruleexecution.EdgeTransfer:
-		m.ClearTransfer()
+	case family.EdgeSavingsAccount:
+		m.ClearSavingsAccount()
 		return nil
 	}
-	return fmt.Errorf("unknown RuleExecution unique edge %s", name)
+	return fmt.Errorf("unknown Family unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RuleExecutionMutation) ResetEdge(name string) error {
+func (m *FamilyMutation) ResetEdge(name string) error {
 	switch name {
-	case ruleexecution.EdgeRule:
-		m.ResetRule()
+	case family.EdgeSavingsAccount:
+		m.ResetSavingsAccount()
 		return nil
-	case ruleexecution.EdgeTransaction:
-		m.ResetTransaction()
+	case family.EdgeMembers:
+		m.ResetMembers()
 		return nil
-	case ruleexecution.EdgeUser:
-		m.ResetUser()
+	case family.EdgeRules:
+		m.ResetRules()
 		return nil
-	case ruleexecution.EdgeTransfer:
-		m.ResetTransfer()
+	case family.EdgeRuleExecutions:
+		m.ResetRuleExecutions()
+		return nil
+	case family.EdgeGoals:
+		m.ResetGoals()
+		return nil
+	case family.EdgeBudgets:
+		m.ResetBudgets()
 		return nil
 	}
-	return fmt.Errorf("unknown RuleExecution edge %s", name)
+	return fmt.Errorf("unknown Family edge %s", name)
 }
 
-// SavingsTransferMutation represents an operation that mutates the SavingsTransfer nodes in the graph.
-type SavingsTransferMutation struct {
+// FamilyMemberMutation represents an operation that mutates the FamilyMember nodes in the graph.
+type FamilyMemberMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	amount_cents          *int64
-	addamount_cents       *int64
-	status                *savingstransfer.Status
-	plaid_transfer_id     *string
-	error_message         *string
-	created_at            *time.Time
-	executed_at           *time.Time
-	clearedFields         map[string]struct{}
-	rule_execution        *uuid.UUID
-	clearedrule_execution bool
-	user                  *uuid.UUID
-	cleareduser           bool
-	source_account        *uuid.UUID
-	clearedsource_account bool
-	target_account        *uuid.UUID
-	clearedtarget_account bool
-	done                  bool
-	oldValue              func(context.Context) (*SavingsTransfer, error)
-	predicates            []predicate.SavingsTransfer
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	role                   *familymember.Role
+	transaction_visibility *familymember.TransactionVisibility
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	family                 *uuid.UUID
+	clearedfamily          bool
+	user                   *uuid.UUID
+	cleareduser            bool
+	done                   bool
+	oldValue               func(context.Context) (*FamilyMember, error)
+	predicates             []predicate.FamilyMember
 }
 
-var _ ent.Mutation = (*SavingsTransferMutation)(nil)
+var _ ent.Mutation = (*FamilyMemberMutation)(nil)
 
-// savingstransferOption allows management of the mutation configuration using functional options.
-type savingstransferOption func(*SavingsTransferMutation)
+// familymemberOption allows management of the mutation configuration using functional options.
+type familymemberOption func(*FamilyMemberMutation)
 
-// newSavingsTransferMutation creates new mutation for the SavingsTransfer entity.
-func newSavingsTransferMutation(c config, op Op, opts ...savingstransferOption) *SavingsTransferMutation {
-	m := &SavingsTransferMutation{
+// newFamilyMemberMutation creates new mutation for the FamilyMember entity.
+func newFamilyMemberMutation(c config, op Op, opts ...familymemberOption) *FamilyMemberMutation {
+	m := &FamilyMemberMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSavingsTransfer,
+		typ:           TypeFamilyMember,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5707,20 +7123,20 @@ func newSavingsTransferMutation(c config, op Op, opts ...savingstransferOption)
 	return m
 }
 
-// withSavingsTransferID sets the ID field of the mutation.
-func withSavingsTransferID(id uuid.UUID) savingstransferOption {
-	return func(m *SavingsTransferMutation) {
+// withFamilyMemberID sets the ID field of the mutation.
+func withFamilyMemberID(id uuid.UUID) familymemberOption {
+	return func(m *FamilyMemberMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SavingsTransfer
+			value *FamilyMember
 		)
-		m.oldValue = func(ctx context.Context) (*SavingsTransfer, error) {
+		m.oldValue = func(ctx context.Context) (*FamilyMember, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SavingsTransfer.Get(ctx, id)
+					value, err = m.Client().FamilyMember.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5729,10 +7145,10 @@ func withSavingsTransferID(id uuid.UUID) savingstransferOption {
 	}
 }
 
-// withSavingsTransfer sets the old SavingsTransfer of the mutation.
-func withSavingsTransfer(node *SavingsTransfer) savingstransferOption {
-	return func(m *SavingsTransferMutation) {
-		m.oldValue = func(context.Context) (*SavingsTransfer, error) {
+// withFamilyMember sets the old FamilyMember of the mutation.
+func withFamilyMember(node *FamilyMember) familymemberOption {
+	return func(m *FamilyMemberMutation) {
+		m.oldValue = func(context.Context) (*FamilyMember, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5741,7 +7157,7 @@ func withSavingsTransfer(node *SavingsTransfer) savingstransferOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SavingsTransferMutation) Client() *Client {
+func (m FamilyMemberMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5749,7 +7165,7 @@ func (m SavingsTransferMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SavingsTransferMutation) Tx() (*Tx, error) {
+func (m FamilyMemberMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5759,14 +7175,14 @@ func (m SavingsTransferMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SavingsTransfer entities.
-func (m *SavingsTransferMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of FamilyMember entities.
+func (m *FamilyMemberMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SavingsTransferMutation) ID() (id uuid.UUID, exists bool) {
+func (m *FamilyMemberMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5777,7 +7193,7 @@ func (m *SavingsTransferMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SavingsTransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *FamilyMemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5786,548 +7202,10555 @@ func (m *SavingsTransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SavingsTransfer.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FamilyMember.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetRuleExecutionID sets the "rule_execution_id" field.
-func (m *SavingsTransferMutation) SetRuleExecutionID(u uuid.UUID) {
-	m.rule_execution = &u
+// SetFamilyID sets the "family_id" field.
+func (m *FamilyMemberMutation) SetFamilyID(u uuid.UUID) {
+	m.family = &u
 }
 
-// RuleExecutionID returns the value of the "rule_execution_id" field in the mutation.
-func (m *SavingsTransferMutation) RuleExecutionID() (r uuid.UUID, exists bool) {
-	v := m.rule_execution
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *FamilyMemberMutation) FamilyID() (r uuid.UUID, exists bool) {
+	v := m.family
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FamilyMemberMutation) OldFamilyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *FamilyMemberMutation) ResetFamilyID() {
+	m.family = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *FamilyMemberMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *FamilyMemberMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FamilyMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *FamilyMemberMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRole sets the "role" field.
+func (m *FamilyMemberMutation) SetRole(f familymember.Role) {
+	m.role = &f
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *FamilyMemberMutation) Role() (r familymember.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FamilyMemberMutation) OldRole(ctx context.Context) (v familymember.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *FamilyMemberMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetTransactionVisibility sets the "transaction_visibility" field.
+func (m *FamilyMemberMutation) SetTransactionVisibility(fv familymember.TransactionVisibility) {
+	m.transaction_visibility = &fv
+}
+
+// TransactionVisibility returns the value of the "transaction_visibility" field in the mutation.
+func (m *FamilyMemberMutation) TransactionVisibility() (r familymember.TransactionVisibility, exists bool) {
+	v := m.transaction_visibility
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionVisibility returns the old "transaction_visibility" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FamilyMemberMutation) OldTransactionVisibility(ctx context.Context) (v familymember.TransactionVisibility, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionVisibility is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionVisibility requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionVisibility: %w", err)
+	}
+	return oldValue.TransactionVisibility, nil
+}
+
+// ResetTransactionVisibility resets all changes to the "transaction_visibility" field.
+func (m *FamilyMemberMutation) ResetTransactionVisibility() {
+	m.transaction_visibility = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FamilyMemberMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FamilyMemberMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FamilyMember entity.
+// If the FamilyMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FamilyMemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FamilyMemberMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearFamily clears the "family" edge to the Family entity.
+func (m *FamilyMemberMutation) ClearFamily() {
+	m.clearedfamily = true
+	m.clearedFields[familymember.FieldFamilyID] = struct{}{}
+}
+
+// FamilyCleared reports if the "family" edge to the Family entity was cleared.
+func (m *FamilyMemberMutation) FamilyCleared() bool {
+	return m.clearedfamily
+}
+
+// FamilyIDs returns the "family" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FamilyID instead. It exists only for internal usage by the builders.
+func (m *FamilyMemberMutation) FamilyIDs() (ids []uuid.UUID) {
+	if id := m.family; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFamily resets all changes to the "family" edge.
+func (m *FamilyMemberMutation) ResetFamily() {
+	m.family = nil
+	m.clearedfamily = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *FamilyMemberMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[familymember.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *FamilyMemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *FamilyMemberMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *FamilyMemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the FamilyMemberMutation builder.
+func (m *FamilyMemberMutation) Where(ps ...predicate.FamilyMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FamilyMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FamilyMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FamilyMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FamilyMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FamilyMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FamilyMember).
+func (m *FamilyMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FamilyMemberMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.family != nil {
+		fields = append(fields, familymember.FieldFamilyID)
+	}
+	if m.user != nil {
+		fields = append(fields, familymember.FieldUserID)
+	}
+	if m.role != nil {
+		fields = append(fields, familymember.FieldRole)
+	}
+	if m.transaction_visibility != nil {
+		fields = append(fields, familymember.FieldTransactionVisibility)
+	}
+	if m.created_at != nil {
+		fields = append(fields, familymember.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FamilyMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case familymember.FieldFamilyID:
+		return m.FamilyID()
+	case familymember.FieldUserID:
+		return m.UserID()
+	case familymember.FieldRole:
+		return m.Role()
+	case familymember.FieldTransactionVisibility:
+		return m.TransactionVisibility()
+	case familymember.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FamilyMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case familymember.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case familymember.FieldUserID:
+		return m.OldUserID(ctx)
+	case familymember.FieldRole:
+		return m.OldRole(ctx)
+	case familymember.FieldTransactionVisibility:
+		return m.OldTransactionVisibility(ctx)
+	case familymember.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown FamilyMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FamilyMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case familymember.FieldFamilyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case familymember.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case familymember.FieldRole:
+		v, ok := value.(familymember.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case familymember.FieldTransactionVisibility:
+		v, ok := value.(familymember.TransactionVisibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionVisibility(v)
+		return nil
+	case familymember.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FamilyMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FamilyMemberMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FamilyMemberMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FamilyMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown FamilyMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FamilyMemberMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FamilyMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FamilyMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FamilyMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FamilyMemberMutation) ResetField(name string) error {
+	switch name {
+	case familymember.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case familymember.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case familymember.FieldRole:
+		m.ResetRole()
+		return nil
+	case familymember.FieldTransactionVisibility:
+		m.ResetTransactionVisibility()
+		return nil
+	case familymember.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown FamilyMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FamilyMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.family != nil {
+		edges = append(edges, familymember.EdgeFamily)
+	}
+	if m.user != nil {
+		edges = append(edges, familymember.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FamilyMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case familymember.EdgeFamily:
+		if id := m.family; id != nil {
+			return []ent.Value{*id}
+		}
+	case familymember.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FamilyMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FamilyMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FamilyMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedfamily {
+		edges = append(edges, familymember.EdgeFamily)
+	}
+	if m.cleareduser {
+		edges = append(edges, familymember.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FamilyMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case familymember.EdgeFamily:
+		return m.clearedfamily
+	case familymember.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FamilyMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case familymember.EdgeFamily:
+		m.ClearFamily()
+		return nil
+	case familymember.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown FamilyMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FamilyMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case familymember.EdgeFamily:
+		m.ResetFamily()
+		return nil
+	case familymember.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown FamilyMember edge %s", name)
+}
+
+// GoalMutation represents an operation that mutates the Goal nodes in the graph.
+type GoalMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	name            *string
+	kind            *goal.Kind
+	category        *string
+	target_cents    *int64
+	addtarget_cents *int64
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	family          *uuid.UUID
+	clearedfamily   bool
+	done            bool
+	oldValue        func(context.Context) (*Goal, error)
+	predicates      []predicate.Goal
+}
+
+var _ ent.Mutation = (*GoalMutation)(nil)
+
+// goalOption allows management of the mutation configuration using functional options.
+type goalOption func(*GoalMutation)
+
+// newGoalMutation creates new mutation for the Goal entity.
+func newGoalMutation(c config, op Op, opts ...goalOption) *GoalMutation {
+	m := &GoalMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeGoal,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withGoalID sets the ID field of the mutation.
+func withGoalID(id uuid.UUID) goalOption {
+	return func(m *GoalMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Goal
+		)
+		m.oldValue = func(ctx context.Context) (*Goal, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Goal.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withGoal sets the old Goal of the mutation.
+func withGoal(node *Goal) goalOption {
+	return func(m *GoalMutation) {
+		m.oldValue = func(context.Context) (*Goal, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m GoalMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m GoalMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Goal entities.
+func (m *GoalMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *GoalMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *GoalMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Goal.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *GoalMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *GoalMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *GoalMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *GoalMutation) SetFamilyID(u uuid.UUID) {
+	m.family = &u
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *GoalMutation) FamilyID() (r uuid.UUID, exists bool) {
+	v := m.family
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldFamilyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (m *GoalMutation) ClearFamilyID() {
+	m.family = nil
+	m.clearedFields[goal.FieldFamilyID] = struct{}{}
+}
+
+// FamilyIDCleared returns if the "family_id" field was cleared in this mutation.
+func (m *GoalMutation) FamilyIDCleared() bool {
+	_, ok := m.clearedFields[goal.FieldFamilyID]
+	return ok
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *GoalMutation) ResetFamilyID() {
+	m.family = nil
+	delete(m.clearedFields, goal.FieldFamilyID)
+}
+
+// SetName sets the "name" field.
+func (m *GoalMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *GoalMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *GoalMutation) ResetName() {
+	m.name = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *GoalMutation) SetKind(_go goal.Kind) {
+	m.kind = &_go
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *GoalMutation) Kind() (r goal.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldKind(ctx context.Context) (v goal.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *GoalMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *GoalMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *GoalMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldCategory(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ClearCategory clears the value of the "category" field.
+func (m *GoalMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[goal.FieldCategory] = struct{}{}
+}
+
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *GoalMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[goal.FieldCategory]
+	return ok
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *GoalMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, goal.FieldCategory)
+}
+
+// SetTargetCents sets the "target_cents" field.
+func (m *GoalMutation) SetTargetCents(i int64) {
+	m.target_cents = &i
+	m.addtarget_cents = nil
+}
+
+// TargetCents returns the value of the "target_cents" field in the mutation.
+func (m *GoalMutation) TargetCents() (r int64, exists bool) {
+	v := m.target_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetCents returns the old "target_cents" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldTargetCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetCents: %w", err)
+	}
+	return oldValue.TargetCents, nil
+}
+
+// AddTargetCents adds i to the "target_cents" field.
+func (m *GoalMutation) AddTargetCents(i int64) {
+	if m.addtarget_cents != nil {
+		*m.addtarget_cents += i
+	} else {
+		m.addtarget_cents = &i
+	}
+}
+
+// AddedTargetCents returns the value that was added to the "target_cents" field in this mutation.
+func (m *GoalMutation) AddedTargetCents() (r int64, exists bool) {
+	v := m.addtarget_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTargetCents resets all changes to the "target_cents" field.
+func (m *GoalMutation) ResetTargetCents() {
+	m.target_cents = nil
+	m.addtarget_cents = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *GoalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *GoalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *GoalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *GoalMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *GoalMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Goal entity.
+// If the Goal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *GoalMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *GoalMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *GoalMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[goal.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *GoalMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *GoalMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *GoalMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearFamily clears the "family" edge to the Family entity.
+func (m *GoalMutation) ClearFamily() {
+	m.clearedfamily = true
+	m.clearedFields[goal.FieldFamilyID] = struct{}{}
+}
+
+// FamilyCleared reports if the "family" edge to the Family entity was cleared.
+func (m *GoalMutation) FamilyCleared() bool {
+	return m.FamilyIDCleared() || m.clearedfamily
+}
+
+// FamilyIDs returns the "family" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FamilyID instead. It exists only for internal usage by the builders.
+func (m *GoalMutation) FamilyIDs() (ids []uuid.UUID) {
+	if id := m.family; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFamily resets all changes to the "family" edge.
+func (m *GoalMutation) ResetFamily() {
+	m.family = nil
+	m.clearedfamily = false
+}
+
+// Where appends a list predicates to the GoalMutation builder.
+func (m *GoalMutation) Where(ps ...predicate.Goal) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the GoalMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *GoalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Goal, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *GoalMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *GoalMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Goal).
+func (m *GoalMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *GoalMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user != nil {
+		fields = append(fields, goal.FieldUserID)
+	}
+	if m.family != nil {
+		fields = append(fields, goal.FieldFamilyID)
+	}
+	if m.name != nil {
+		fields = append(fields, goal.FieldName)
+	}
+	if m.kind != nil {
+		fields = append(fields, goal.FieldKind)
+	}
+	if m.category != nil {
+		fields = append(fields, goal.FieldCategory)
+	}
+	if m.target_cents != nil {
+		fields = append(fields, goal.FieldTargetCents)
+	}
+	if m.created_at != nil {
+		fields = append(fields, goal.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, goal.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *GoalMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldUserID:
+		return m.UserID()
+	case goal.FieldFamilyID:
+		return m.FamilyID()
+	case goal.FieldName:
+		return m.Name()
+	case goal.FieldKind:
+		return m.Kind()
+	case goal.FieldCategory:
+		return m.Category()
+	case goal.FieldTargetCents:
+		return m.TargetCents()
+	case goal.FieldCreatedAt:
+		return m.CreatedAt()
+	case goal.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *GoalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case goal.FieldUserID:
+		return m.OldUserID(ctx)
+	case goal.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case goal.FieldName:
+		return m.OldName(ctx)
+	case goal.FieldKind:
+		return m.OldKind(ctx)
+	case goal.FieldCategory:
+		return m.OldCategory(ctx)
+	case goal.FieldTargetCents:
+		return m.OldTargetCents(ctx)
+	case goal.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case goal.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Goal field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case goal.FieldFamilyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case goal.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case goal.FieldKind:
+		v, ok := value.(goal.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case goal.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case goal.FieldTargetCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetCents(v)
+		return nil
+	case goal.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case goal.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *GoalMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_cents != nil {
+		fields = append(fields, goal.FieldTargetCents)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *GoalMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case goal.FieldTargetCents:
+		return m.AddedTargetCents()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *GoalMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case goal.FieldTargetCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetCents(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Goal numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *GoalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(goal.FieldFamilyID) {
+		fields = append(fields, goal.FieldFamilyID)
+	}
+	if m.FieldCleared(goal.FieldCategory) {
+		fields = append(fields, goal.FieldCategory)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *GoalMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *GoalMutation) ClearField(name string) error {
+	switch name {
+	case goal.FieldFamilyID:
+		m.ClearFamilyID()
+		return nil
+	case goal.FieldCategory:
+		m.ClearCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *GoalMutation) ResetField(name string) error {
+	switch name {
+	case goal.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case goal.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case goal.FieldName:
+		m.ResetName()
+		return nil
+	case goal.FieldKind:
+		m.ResetKind()
+		return nil
+	case goal.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case goal.FieldTargetCents:
+		m.ResetTargetCents()
+		return nil
+	case goal.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case goal.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *GoalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, goal.EdgeUser)
+	}
+	if m.family != nil {
+		edges = append(edges, goal.EdgeFamily)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *GoalMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case goal.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case goal.EdgeFamily:
+		if id := m.family; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *GoalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *GoalMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *GoalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, goal.EdgeUser)
+	}
+	if m.clearedfamily {
+		edges = append(edges, goal.EdgeFamily)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *GoalMutation) EdgeCleared(name string) bool {
+	switch name {
+	case goal.EdgeUser:
+		return m.cleareduser
+	case goal.EdgeFamily:
+		return m.clearedfamily
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *GoalMutation) ClearEdge(name string) error {
+	switch name {
+	case goal.EdgeUser:
+		m.ClearUser()
+		return nil
+	case goal.EdgeFamily:
+		m.ClearFamily()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *GoalMutation) ResetEdge(name string) error {
+	switch name {
+	case goal.EdgeUser:
+		m.ResetUser()
+		return nil
+	case goal.EdgeFamily:
+		m.ResetFamily()
+		return nil
+	}
+	return fmt.Errorf("unknown Goal edge %s", name)
+}
+
+// ItemMutation represents an operation that mutates the Item nodes in the graph.
+type ItemMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	provider            *string
+	external_id         *string
+	access_token        *string
+	access_token_key_id *string
+	institution_name    *string
+	institution_id      *string
+	institution_logo    *string
+	institution_color   *string
+	institution_url     *string
+	is_active           *bool
+	status              *item.Status
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	user                *uuid.UUID
+	cleareduser         bool
+	accounts            map[uuid.UUID]struct{}
+	removedaccounts     map[uuid.UUID]struct{}
+	clearedaccounts     bool
+	sync_cursor         *uuid.UUID
+	clearedsync_cursor  bool
+	sync_runs           map[uuid.UUID]struct{}
+	removedsync_runs    map[uuid.UUID]struct{}
+	clearedsync_runs    bool
+	backfill_job        *uuid.UUID
+	clearedbackfill_job bool
+	done                bool
+	oldValue            func(context.Context) (*Item, error)
+	predicates          []predicate.Item
+}
+
+var _ ent.Mutation = (*ItemMutation)(nil)
+
+// itemOption allows management of the mutation configuration using functional options.
+type itemOption func(*ItemMutation)
+
+// newItemMutation creates new mutation for the Item entity.
+func newItemMutation(c config, op Op, opts ...itemOption) *ItemMutation {
+	m := &ItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withItemID sets the ID field of the mutation.
+func withItemID(id uuid.UUID) itemOption {
+	return func(m *ItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Item
+		)
+		m.oldValue = func(ctx context.Context) (*Item, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Item.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withItem sets the old Item of the mutation.
+func withItem(node *Item) itemOption {
+	return func(m *ItemMutation) {
+		m.oldValue = func(context.Context) (*Item, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Item entities.
+func (m *ItemMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ItemMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Item.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ItemMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ItemMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ItemMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *ItemMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *ItemMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *ItemMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetExternalID sets the "external_id" field.
+func (m *ItemMutation) SetExternalID(s string) {
+	m.external_id = &s
+}
+
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *ItemMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalID returns the old "external_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldExternalID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
+	}
+	return oldValue.ExternalID, nil
+}
+
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *ItemMutation) ResetExternalID() {
+	m.external_id = nil
+}
+
+// SetAccessToken sets the "access_token" field.
+func (m *ItemMutation) SetAccessToken(s string) {
+	m.access_token = &s
+}
+
+// AccessToken returns the value of the "access_token" field in the mutation.
+func (m *ItemMutation) AccessToken() (r string, exists bool) {
+	v := m.access_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessToken returns the old "access_token" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldAccessToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessToken: %w", err)
+	}
+	return oldValue.AccessToken, nil
+}
+
+// ResetAccessToken resets all changes to the "access_token" field.
+func (m *ItemMutation) ResetAccessToken() {
+	m.access_token = nil
+}
+
+// SetAccessTokenKeyID sets the "access_token_key_id" field.
+func (m *ItemMutation) SetAccessTokenKeyID(s string) {
+	m.access_token_key_id = &s
+}
+
+// AccessTokenKeyID returns the value of the "access_token_key_id" field in the mutation.
+func (m *ItemMutation) AccessTokenKeyID() (r string, exists bool) {
+	v := m.access_token_key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccessTokenKeyID returns the old "access_token_key_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldAccessTokenKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccessTokenKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccessTokenKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccessTokenKeyID: %w", err)
+	}
+	return oldValue.AccessTokenKeyID, nil
+}
+
+// ResetAccessTokenKeyID resets all changes to the "access_token_key_id" field.
+func (m *ItemMutation) ResetAccessTokenKeyID() {
+	m.access_token_key_id = nil
+}
+
+// SetInstitutionName sets the "institution_name" field.
+func (m *ItemMutation) SetInstitutionName(s string) {
+	m.institution_name = &s
+}
+
+// InstitutionName returns the value of the "institution_name" field in the mutation.
+func (m *ItemMutation) InstitutionName() (r string, exists bool) {
+	v := m.institution_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstitutionName returns the old "institution_name" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldInstitutionName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstitutionName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstitutionName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstitutionName: %w", err)
+	}
+	return oldValue.InstitutionName, nil
+}
+
+// ResetInstitutionName resets all changes to the "institution_name" field.
+func (m *ItemMutation) ResetInstitutionName() {
+	m.institution_name = nil
+}
+
+// SetInstitutionID sets the "institution_id" field.
+func (m *ItemMutation) SetInstitutionID(s string) {
+	m.institution_id = &s
+}
+
+// InstitutionID returns the value of the "institution_id" field in the mutation.
+func (m *ItemMutation) InstitutionID() (r string, exists bool) {
+	v := m.institution_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstitutionID returns the old "institution_id" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldInstitutionID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstitutionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstitutionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstitutionID: %w", err)
+	}
+	return oldValue.InstitutionID, nil
+}
+
+// ClearInstitutionID clears the value of the "institution_id" field.
+func (m *ItemMutation) ClearInstitutionID() {
+	m.institution_id = nil
+	m.clearedFields[item.FieldInstitutionID] = struct{}{}
+}
+
+// InstitutionIDCleared returns if the "institution_id" field was cleared in this mutation.
+func (m *ItemMutation) InstitutionIDCleared() bool {
+	_, ok := m.clearedFields[item.FieldInstitutionID]
+	return ok
+}
+
+// ResetInstitutionID resets all changes to the "institution_id" field.
+func (m *ItemMutation) ResetInstitutionID() {
+	m.institution_id = nil
+	delete(m.clearedFields, item.FieldInstitutionID)
+}
+
+// SetInstitutionLogo sets the "institution_logo" field.
+func (m *ItemMutation) SetInstitutionLogo(s string) {
+	m.institution_logo = &s
+}
+
+// InstitutionLogo returns the value of the "institution_logo" field in the mutation.
+func (m *ItemMutation) InstitutionLogo() (r string, exists bool) {
+	v := m.institution_logo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstitutionLogo returns the old "institution_logo" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldInstitutionLogo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstitutionLogo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstitutionLogo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstitutionLogo: %w", err)
+	}
+	return oldValue.InstitutionLogo, nil
+}
+
+// ClearInstitutionLogo clears the value of the "institution_logo" field.
+func (m *ItemMutation) ClearInstitutionLogo() {
+	m.institution_logo = nil
+	m.clearedFields[item.FieldInstitutionLogo] = struct{}{}
+}
+
+// InstitutionLogoCleared returns if the "institution_logo" field was cleared in this mutation.
+func (m *ItemMutation) InstitutionLogoCleared() bool {
+	_, ok := m.clearedFields[item.FieldInstitutionLogo]
+	return ok
+}
+
+// ResetInstitutionLogo resets all changes to the "institution_logo" field.
+func (m *ItemMutation) ResetInstitutionLogo() {
+	m.institution_logo = nil
+	delete(m.clearedFields, item.FieldInstitutionLogo)
+}
+
+// SetInstitutionColor sets the "institution_color" field.
+func (m *ItemMutation) SetInstitutionColor(s string) {
+	m.institution_color = &s
+}
+
+// InstitutionColor returns the value of the "institution_color" field in the mutation.
+func (m *ItemMutation) InstitutionColor() (r string, exists bool) {
+	v := m.institution_color
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstitutionColor returns the old "institution_color" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldInstitutionColor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstitutionColor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstitutionColor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstitutionColor: %w", err)
+	}
+	return oldValue.InstitutionColor, nil
+}
+
+// ClearInstitutionColor clears the value of the "institution_color" field.
+func (m *ItemMutation) ClearInstitutionColor() {
+	m.institution_color = nil
+	m.clearedFields[item.FieldInstitutionColor] = struct{}{}
+}
+
+// InstitutionColorCleared returns if the "institution_color" field was cleared in this mutation.
+func (m *ItemMutation) InstitutionColorCleared() bool {
+	_, ok := m.clearedFields[item.FieldInstitutionColor]
+	return ok
+}
+
+// ResetInstitutionColor resets all changes to the "institution_color" field.
+func (m *ItemMutation) ResetInstitutionColor() {
+	m.institution_color = nil
+	delete(m.clearedFields, item.FieldInstitutionColor)
+}
+
+// SetInstitutionURL sets the "institution_url" field.
+func (m *ItemMutation) SetInstitutionURL(s string) {
+	m.institution_url = &s
+}
+
+// InstitutionURL returns the value of the "institution_url" field in the mutation.
+func (m *ItemMutation) InstitutionURL() (r string, exists bool) {
+	v := m.institution_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInstitutionURL returns the old "institution_url" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldInstitutionURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInstitutionURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInstitutionURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInstitutionURL: %w", err)
+	}
+	return oldValue.InstitutionURL, nil
+}
+
+// ClearInstitutionURL clears the value of the "institution_url" field.
+func (m *ItemMutation) ClearInstitutionURL() {
+	m.institution_url = nil
+	m.clearedFields[item.FieldInstitutionURL] = struct{}{}
+}
+
+// InstitutionURLCleared returns if the "institution_url" field was cleared in this mutation.
+func (m *ItemMutation) InstitutionURLCleared() bool {
+	_, ok := m.clearedFields[item.FieldInstitutionURL]
+	return ok
+}
+
+// ResetInstitutionURL resets all changes to the "institution_url" field.
+func (m *ItemMutation) ResetInstitutionURL() {
+	m.institution_url = nil
+	delete(m.clearedFields, item.FieldInstitutionURL)
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *ItemMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *ItemMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *ItemMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ItemMutation) SetStatus(i item.Status) {
+	m.status = &i
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ItemMutation) Status() (r item.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldStatus(ctx context.Context) (v item.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ItemMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ItemMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ItemMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Item entity.
+// If the Item object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ItemMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[item.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ItemMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *ItemMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddAccountIDs adds the "accounts" edge to the Account entity by ids.
+func (m *ItemMutation) AddAccountIDs(ids ...uuid.UUID) {
+	if m.accounts == nil {
+		m.accounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.accounts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAccounts clears the "accounts" edge to the Account entity.
+func (m *ItemMutation) ClearAccounts() {
+	m.clearedaccounts = true
+}
+
+// AccountsCleared reports if the "accounts" edge to the Account entity was cleared.
+func (m *ItemMutation) AccountsCleared() bool {
+	return m.clearedaccounts
+}
+
+// RemoveAccountIDs removes the "accounts" edge to the Account entity by IDs.
+func (m *ItemMutation) RemoveAccountIDs(ids ...uuid.UUID) {
+	if m.removedaccounts == nil {
+		m.removedaccounts = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.accounts, ids[i])
+		m.removedaccounts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAccounts returns the removed IDs of the "accounts" edge to the Account entity.
+func (m *ItemMutation) RemovedAccountsIDs() (ids []uuid.UUID) {
+	for id := range m.removedaccounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AccountsIDs returns the "accounts" edge IDs in the mutation.
+func (m *ItemMutation) AccountsIDs() (ids []uuid.UUID) {
+	for id := range m.accounts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAccounts resets all changes to the "accounts" edge.
+func (m *ItemMutation) ResetAccounts() {
+	m.accounts = nil
+	m.clearedaccounts = false
+	m.removedaccounts = nil
+}
+
+// SetSyncCursorID sets the "sync_cursor" edge to the SyncCursor entity by id.
+func (m *ItemMutation) SetSyncCursorID(id uuid.UUID) {
+	m.sync_cursor = &id
+}
+
+// ClearSyncCursor clears the "sync_cursor" edge to the SyncCursor entity.
+func (m *ItemMutation) ClearSyncCursor() {
+	m.clearedsync_cursor = true
+}
+
+// SyncCursorCleared reports if the "sync_cursor" edge to the SyncCursor entity was cleared.
+func (m *ItemMutation) SyncCursorCleared() bool {
+	return m.clearedsync_cursor
+}
+
+// SyncCursorID returns the "sync_cursor" edge ID in the mutation.
+func (m *ItemMutation) SyncCursorID() (id uuid.UUID, exists bool) {
+	if m.sync_cursor != nil {
+		return *m.sync_cursor, true
+	}
+	return
+}
+
+// SyncCursorIDs returns the "sync_cursor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SyncCursorID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) SyncCursorIDs() (ids []uuid.UUID) {
+	if id := m.sync_cursor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSyncCursor resets all changes to the "sync_cursor" edge.
+func (m *ItemMutation) ResetSyncCursor() {
+	m.sync_cursor = nil
+	m.clearedsync_cursor = false
+}
+
+// AddSyncRunIDs adds the "sync_runs" edge to the SyncRun entity by ids.
+func (m *ItemMutation) AddSyncRunIDs(ids ...uuid.UUID) {
+	if m.sync_runs == nil {
+		m.sync_runs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sync_runs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSyncRuns clears the "sync_runs" edge to the SyncRun entity.
+func (m *ItemMutation) ClearSyncRuns() {
+	m.clearedsync_runs = true
+}
+
+// SyncRunsCleared reports if the "sync_runs" edge to the SyncRun entity was cleared.
+func (m *ItemMutation) SyncRunsCleared() bool {
+	return m.clearedsync_runs
+}
+
+// RemoveSyncRunIDs removes the "sync_runs" edge to the SyncRun entity by IDs.
+func (m *ItemMutation) RemoveSyncRunIDs(ids ...uuid.UUID) {
+	if m.removedsync_runs == nil {
+		m.removedsync_runs = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sync_runs, ids[i])
+		m.removedsync_runs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSyncRuns returns the removed IDs of the "sync_runs" edge to the SyncRun entity.
+func (m *ItemMutation) RemovedSyncRunsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsync_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SyncRunsIDs returns the "sync_runs" edge IDs in the mutation.
+func (m *ItemMutation) SyncRunsIDs() (ids []uuid.UUID) {
+	for id := range m.sync_runs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSyncRuns resets all changes to the "sync_runs" edge.
+func (m *ItemMutation) ResetSyncRuns() {
+	m.sync_runs = nil
+	m.clearedsync_runs = false
+	m.removedsync_runs = nil
+}
+
+// SetBackfillJobID sets the "backfill_job" edge to the BackfillJob entity by id.
+func (m *ItemMutation) SetBackfillJobID(id uuid.UUID) {
+	m.backfill_job = &id
+}
+
+// ClearBackfillJob clears the "backfill_job" edge to the BackfillJob entity.
+func (m *ItemMutation) ClearBackfillJob() {
+	m.clearedbackfill_job = true
+}
+
+// BackfillJobCleared reports if the "backfill_job" edge to the BackfillJob entity was cleared.
+func (m *ItemMutation) BackfillJobCleared() bool {
+	return m.clearedbackfill_job
+}
+
+// BackfillJobID returns the "backfill_job" edge ID in the mutation.
+func (m *ItemMutation) BackfillJobID() (id uuid.UUID, exists bool) {
+	if m.backfill_job != nil {
+		return *m.backfill_job, true
+	}
+	return
+}
+
+// BackfillJobIDs returns the "backfill_job" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BackfillJobID instead. It exists only for internal usage by the builders.
+func (m *ItemMutation) BackfillJobIDs() (ids []uuid.UUID) {
+	if id := m.backfill_job; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBackfillJob resets all changes to the "backfill_job" edge.
+func (m *ItemMutation) ResetBackfillJob() {
+	m.backfill_job = nil
+	m.clearedbackfill_job = false
+}
+
+// Where appends a list predicates to the ItemMutation builder.
+func (m *ItemMutation) Where(ps ...predicate.Item) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Item, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ItemMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ItemMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Item).
+func (m *ItemMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ItemMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user != nil {
+		fields = append(fields, item.FieldUserID)
+	}
+	if m.provider != nil {
+		fields = append(fields, item.FieldProvider)
+	}
+	if m.external_id != nil {
+		fields = append(fields, item.FieldExternalID)
+	}
+	if m.access_token != nil {
+		fields = append(fields, item.FieldAccessToken)
+	}
+	if m.access_token_key_id != nil {
+		fields = append(fields, item.FieldAccessTokenKeyID)
+	}
+	if m.institution_name != nil {
+		fields = append(fields, item.FieldInstitutionName)
+	}
+	if m.institution_id != nil {
+		fields = append(fields, item.FieldInstitutionID)
+	}
+	if m.institution_logo != nil {
+		fields = append(fields, item.FieldInstitutionLogo)
+	}
+	if m.institution_color != nil {
+		fields = append(fields, item.FieldInstitutionColor)
+	}
+	if m.institution_url != nil {
+		fields = append(fields, item.FieldInstitutionURL)
+	}
+	if m.is_active != nil {
+		fields = append(fields, item.FieldIsActive)
+	}
+	if m.status != nil {
+		fields = append(fields, item.FieldStatus)
+	}
+	if m.created_at != nil {
+		fields = append(fields, item.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, item.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case item.FieldUserID:
+		return m.UserID()
+	case item.FieldProvider:
+		return m.Provider()
+	case item.FieldExternalID:
+		return m.ExternalID()
+	case item.FieldAccessToken:
+		return m.AccessToken()
+	case item.FieldAccessTokenKeyID:
+		return m.AccessTokenKeyID()
+	case item.FieldInstitutionName:
+		return m.InstitutionName()
+	case item.FieldInstitutionID:
+		return m.InstitutionID()
+	case item.FieldInstitutionLogo:
+		return m.InstitutionLogo()
+	case item.FieldInstitutionColor:
+		return m.InstitutionColor()
+	case item.FieldInstitutionURL:
+		return m.InstitutionURL()
+	case item.FieldIsActive:
+		return m.IsActive()
+	case item.FieldStatus:
+		return m.Status()
+	case item.FieldCreatedAt:
+		return m.CreatedAt()
+	case item.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case item.FieldUserID:
+		return m.OldUserID(ctx)
+	case item.FieldProvider:
+		return m.OldProvider(ctx)
+	case item.FieldExternalID:
+		return m.OldExternalID(ctx)
+	case item.FieldAccessToken:
+		return m.OldAccessToken(ctx)
+	case item.FieldAccessTokenKeyID:
+		return m.OldAccessTokenKeyID(ctx)
+	case item.FieldInstitutionName:
+		return m.OldInstitutionName(ctx)
+	case item.FieldInstitutionID:
+		return m.OldInstitutionID(ctx)
+	case item.FieldInstitutionLogo:
+		return m.OldInstitutionLogo(ctx)
+	case item.FieldInstitutionColor:
+		return m.OldInstitutionColor(ctx)
+	case item.FieldInstitutionURL:
+		return m.OldInstitutionURL(ctx)
+	case item.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case item.FieldStatus:
+		return m.OldStatus(ctx)
+	case item.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case item.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Item field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case item.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case item.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case item.FieldExternalID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalID(v)
+		return nil
+	case item.FieldAccessToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessToken(v)
+		return nil
+	case item.FieldAccessTokenKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessTokenKeyID(v)
+		return nil
+	case item.FieldInstitutionName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstitutionName(v)
+		return nil
+	case item.FieldInstitutionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstitutionID(v)
+		return nil
+	case item.FieldInstitutionLogo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstitutionLogo(v)
+		return nil
+	case item.FieldInstitutionColor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstitutionColor(v)
+		return nil
+	case item.FieldInstitutionURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInstitutionURL(v)
+		return nil
+	case item.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case item.FieldStatus:
+		v, ok := value.(item.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case item.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case item.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ItemMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ItemMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Item numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(item.FieldInstitutionID) {
+		fields = append(fields, item.FieldInstitutionID)
+	}
+	if m.FieldCleared(item.FieldInstitutionLogo) {
+		fields = append(fields, item.FieldInstitutionLogo)
+	}
+	if m.FieldCleared(item.FieldInstitutionColor) {
+		fields = append(fields, item.FieldInstitutionColor)
+	}
+	if m.FieldCleared(item.FieldInstitutionURL) {
+		fields = append(fields, item.FieldInstitutionURL)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ItemMutation) ClearField(name string) error {
+	switch name {
+	case item.FieldInstitutionID:
+		m.ClearInstitutionID()
+		return nil
+	case item.FieldInstitutionLogo:
+		m.ClearInstitutionLogo()
+		return nil
+	case item.FieldInstitutionColor:
+		m.ClearInstitutionColor()
+		return nil
+	case item.FieldInstitutionURL:
+		m.ClearInstitutionURL()
+		return nil
+	}
+	return fmt.Errorf("unknown Item nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ItemMutation) ResetField(name string) error {
+	switch name {
+	case item.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case item.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case item.FieldExternalID:
+		m.ResetExternalID()
+		return nil
+	case item.FieldAccessToken:
+		m.ResetAccessToken()
+		return nil
+	case item.FieldAccessTokenKeyID:
+		m.ResetAccessTokenKeyID()
+		return nil
+	case item.FieldInstitutionName:
+		m.ResetInstitutionName()
+		return nil
+	case item.FieldInstitutionID:
+		m.ResetInstitutionID()
+		return nil
+	case item.FieldInstitutionLogo:
+		m.ResetInstitutionLogo()
+		return nil
+	case item.FieldInstitutionColor:
+		m.ResetInstitutionColor()
+		return nil
+	case item.FieldInstitutionURL:
+		m.ResetInstitutionURL()
+		return nil
+	case item.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case item.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case item.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case item.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Item field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.user != nil {
+		edges = append(edges, item.EdgeUser)
+	}
+	if m.accounts != nil {
+		edges = append(edges, item.EdgeAccounts)
+	}
+	if m.sync_cursor != nil {
+		edges = append(edges, item.EdgeSyncCursor)
+	}
+	if m.sync_runs != nil {
+		edges = append(edges, item.EdgeSyncRuns)
+	}
+	if m.backfill_job != nil {
+		edges = append(edges, item.EdgeBackfillJob)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.accounts))
+		for id := range m.accounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeSyncCursor:
+		if id := m.sync_cursor; id != nil {
+			return []ent.Value{*id}
+		}
+	case item.EdgeSyncRuns:
+		ids := make([]ent.Value, 0, len(m.sync_runs))
+		for id := range m.sync_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeBackfillJob:
+		if id := m.backfill_job; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedaccounts != nil {
+		edges = append(edges, item.EdgeAccounts)
+	}
+	if m.removedsync_runs != nil {
+		edges = append(edges, item.EdgeSyncRuns)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ItemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case item.EdgeAccounts:
+		ids := make([]ent.Value, 0, len(m.removedaccounts))
+		for id := range m.removedaccounts {
+			ids = append(ids, id)
+		}
+		return ids
+	case item.EdgeSyncRuns:
+		ids := make([]ent.Value, 0, len(m.removedsync_runs))
+		for id := range m.removedsync_runs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.cleareduser {
+		edges = append(edges, item.EdgeUser)
+	}
+	if m.clearedaccounts {
+		edges = append(edges, item.EdgeAccounts)
+	}
+	if m.clearedsync_cursor {
+		edges = append(edges, item.EdgeSyncCursor)
+	}
+	if m.clearedsync_runs {
+		edges = append(edges, item.EdgeSyncRuns)
+	}
+	if m.clearedbackfill_job {
+		edges = append(edges, item.EdgeBackfillJob)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case item.EdgeUser:
+		return m.cleareduser
+	case item.EdgeAccounts:
+		return m.clearedaccounts
+	case item.EdgeSyncCursor:
+		return m.clearedsync_cursor
+	case item.EdgeSyncRuns:
+		return m.clearedsync_runs
+	case item.EdgeBackfillJob:
+		return m.clearedbackfill_job
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ItemMutation) ClearEdge(name string) error {
+	switch name {
+	case item.EdgeUser:
+		m.ClearUser()
+		return nil
+	case item.EdgeSyncCursor:
+		m.ClearSyncCursor()
+		return nil
+	case item.EdgeBackfillJob:
+		m.ClearBackfillJob()
+		return nil
+	}
+	return fmt.Errorf("unknown Item unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ItemMutation) ResetEdge(name string) error {
+	switch name {
+	case item.EdgeUser:
+		m.ResetUser()
+		return nil
+	case item.EdgeAccounts:
+		m.ResetAccounts()
+		return nil
+	case item.EdgeSyncCursor:
+		m.ResetSyncCursor()
+		return nil
+	case item.EdgeSyncRuns:
+		m.ResetSyncRuns()
+		return nil
+	case item.EdgeBackfillJob:
+		m.ResetBackfillJob()
+		return nil
+	}
+	return fmt.Errorf("unknown Item edge %s", name)
+}
+
+// MonthlyReportMutation represents an operation that mutates the MonthlyReport nodes in the graph.
+type MonthlyReportMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	month                  *string
+	income_cents           *int64
+	addincome_cents        *int64
+	spend_cents            *int64
+	addspend_cents         *int64
+	savings_cents          *int64
+	addsavings_cents       *int64
+	net_cents              *int64
+	addnet_cents           *int64
+	currency               *string
+	top_rules              *[]schematype.ReportRule
+	appendtop_rules        []schematype.ReportRule
+	goals                  *[]schematype.ReportGoal
+	appendgoals            []schematype.ReportGoal
+	category_changes       *[]schematype.ReportCategoryChange
+	appendcategory_changes []schematype.ReportCategoryChange
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	done                   bool
+	oldValue               func(context.Context) (*MonthlyReport, error)
+	predicates             []predicate.MonthlyReport
+}
+
+var _ ent.Mutation = (*MonthlyReportMutation)(nil)
+
+// monthlyreportOption allows management of the mutation configuration using functional options.
+type monthlyreportOption func(*MonthlyReportMutation)
+
+// newMonthlyReportMutation creates new mutation for the MonthlyReport entity.
+func newMonthlyReportMutation(c config, op Op, opts ...monthlyreportOption) *MonthlyReportMutation {
+	m := &MonthlyReportMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMonthlyReport,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMonthlyReportID sets the ID field of the mutation.
+func withMonthlyReportID(id uuid.UUID) monthlyreportOption {
+	return func(m *MonthlyReportMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MonthlyReport
+		)
+		m.oldValue = func(ctx context.Context) (*MonthlyReport, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MonthlyReport.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMonthlyReport sets the old MonthlyReport of the mutation.
+func withMonthlyReport(node *MonthlyReport) monthlyreportOption {
+	return func(m *MonthlyReportMutation) {
+		m.oldValue = func(context.Context) (*MonthlyReport, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MonthlyReportMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MonthlyReportMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MonthlyReport entities.
+func (m *MonthlyReportMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MonthlyReportMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MonthlyReportMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MonthlyReport.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *MonthlyReportMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *MonthlyReportMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *MonthlyReportMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetMonth sets the "month" field.
+func (m *MonthlyReportMutation) SetMonth(s string) {
+	m.month = &s
+}
+
+// Month returns the value of the "month" field in the mutation.
+func (m *MonthlyReportMutation) Month() (r string, exists bool) {
+	v := m.month
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonth returns the old "month" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldMonth(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonth: %w", err)
+	}
+	return oldValue.Month, nil
+}
+
+// ResetMonth resets all changes to the "month" field.
+func (m *MonthlyReportMutation) ResetMonth() {
+	m.month = nil
+}
+
+// SetIncomeCents sets the "income_cents" field.
+func (m *MonthlyReportMutation) SetIncomeCents(i int64) {
+	m.income_cents = &i
+	m.addincome_cents = nil
+}
+
+// IncomeCents returns the value of the "income_cents" field in the mutation.
+func (m *MonthlyReportMutation) IncomeCents() (r int64, exists bool) {
+	v := m.income_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIncomeCents returns the old "income_cents" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldIncomeCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIncomeCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIncomeCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIncomeCents: %w", err)
+	}
+	return oldValue.IncomeCents, nil
+}
+
+// AddIncomeCents adds i to the "income_cents" field.
+func (m *MonthlyReportMutation) AddIncomeCents(i int64) {
+	if m.addincome_cents != nil {
+		*m.addincome_cents += i
+	} else {
+		m.addincome_cents = &i
+	}
+}
+
+// AddedIncomeCents returns the value that was added to the "income_cents" field in this mutation.
+func (m *MonthlyReportMutation) AddedIncomeCents() (r int64, exists bool) {
+	v := m.addincome_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIncomeCents resets all changes to the "income_cents" field.
+func (m *MonthlyReportMutation) ResetIncomeCents() {
+	m.income_cents = nil
+	m.addincome_cents = nil
+}
+
+// SetSpendCents sets the "spend_cents" field.
+func (m *MonthlyReportMutation) SetSpendCents(i int64) {
+	m.spend_cents = &i
+	m.addspend_cents = nil
+}
+
+// SpendCents returns the value of the "spend_cents" field in the mutation.
+func (m *MonthlyReportMutation) SpendCents() (r int64, exists bool) {
+	v := m.spend_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSpendCents returns the old "spend_cents" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldSpendCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSpendCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSpendCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSpendCents: %w", err)
+	}
+	return oldValue.SpendCents, nil
+}
+
+// AddSpendCents adds i to the "spend_cents" field.
+func (m *MonthlyReportMutation) AddSpendCents(i int64) {
+	if m.addspend_cents != nil {
+		*m.addspend_cents += i
+	} else {
+		m.addspend_cents = &i
+	}
+}
+
+// AddedSpendCents returns the value that was added to the "spend_cents" field in this mutation.
+func (m *MonthlyReportMutation) AddedSpendCents() (r int64, exists bool) {
+	v := m.addspend_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSpendCents resets all changes to the "spend_cents" field.
+func (m *MonthlyReportMutation) ResetSpendCents() {
+	m.spend_cents = nil
+	m.addspend_cents = nil
+}
+
+// SetSavingsCents sets the "savings_cents" field.
+func (m *MonthlyReportMutation) SetSavingsCents(i int64) {
+	m.savings_cents = &i
+	m.addsavings_cents = nil
+}
+
+// SavingsCents returns the value of the "savings_cents" field in the mutation.
+func (m *MonthlyReportMutation) SavingsCents() (r int64, exists bool) {
+	v := m.savings_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSavingsCents returns the old "savings_cents" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldSavingsCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSavingsCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSavingsCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSavingsCents: %w", err)
+	}
+	return oldValue.SavingsCents, nil
+}
+
+// AddSavingsCents adds i to the "savings_cents" field.
+func (m *MonthlyReportMutation) AddSavingsCents(i int64) {
+	if m.addsavings_cents != nil {
+		*m.addsavings_cents += i
+	} else {
+		m.addsavings_cents = &i
+	}
+}
+
+// AddedSavingsCents returns the value that was added to the "savings_cents" field in this mutation.
+func (m *MonthlyReportMutation) AddedSavingsCents() (r int64, exists bool) {
+	v := m.addsavings_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSavingsCents resets all changes to the "savings_cents" field.
+func (m *MonthlyReportMutation) ResetSavingsCents() {
+	m.savings_cents = nil
+	m.addsavings_cents = nil
+}
+
+// SetNetCents sets the "net_cents" field.
+func (m *MonthlyReportMutation) SetNetCents(i int64) {
+	m.net_cents = &i
+	m.addnet_cents = nil
+}
+
+// NetCents returns the value of the "net_cents" field in the mutation.
+func (m *MonthlyReportMutation) NetCents() (r int64, exists bool) {
+	v := m.net_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNetCents returns the old "net_cents" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldNetCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNetCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNetCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNetCents: %w", err)
+	}
+	return oldValue.NetCents, nil
+}
+
+// AddNetCents adds i to the "net_cents" field.
+func (m *MonthlyReportMutation) AddNetCents(i int64) {
+	if m.addnet_cents != nil {
+		*m.addnet_cents += i
+	} else {
+		m.addnet_cents = &i
+	}
+}
+
+// AddedNetCents returns the value that was added to the "net_cents" field in this mutation.
+func (m *MonthlyReportMutation) AddedNetCents() (r int64, exists bool) {
+	v := m.addnet_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNetCents resets all changes to the "net_cents" field.
+func (m *MonthlyReportMutation) ResetNetCents() {
+	m.net_cents = nil
+	m.addnet_cents = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *MonthlyReportMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *MonthlyReportMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *MonthlyReportMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetTopRules sets the "top_rules" field.
+func (m *MonthlyReportMutation) SetTopRules(sr []schematype.ReportRule) {
+	m.top_rules = &sr
+	m.appendtop_rules = nil
+}
+
+// TopRules returns the value of the "top_rules" field in the mutation.
+func (m *MonthlyReportMutation) TopRules() (r []schematype.ReportRule, exists bool) {
+	v := m.top_rules
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTopRules returns the old "top_rules" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldTopRules(ctx context.Context) (v []schematype.ReportRule, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTopRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTopRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTopRules: %w", err)
+	}
+	return oldValue.TopRules, nil
+}
+
+// AppendTopRules adds sr to the "top_rules" field.
+func (m *MonthlyReportMutation) AppendTopRules(sr []schematype.ReportRule) {
+	m.appendtop_rules = append(m.appendtop_rules, sr...)
+}
+
+// AppendedTopRules returns the list of values that were appended to the "top_rules" field in this mutation.
+func (m *MonthlyReportMutation) AppendedTopRules() ([]schematype.ReportRule, bool) {
+	if len(m.appendtop_rules) == 0 {
+		return nil, false
+	}
+	return m.appendtop_rules, true
+}
+
+// ClearTopRules clears the value of the "top_rules" field.
+func (m *MonthlyReportMutation) ClearTopRules() {
+	m.top_rules = nil
+	m.appendtop_rules = nil
+	m.clearedFields[monthlyreport.FieldTopRules] = struct{}{}
+}
+
+// TopRulesCleared returns if the "top_rules" field was cleared in this mutation.
+func (m *MonthlyReportMutation) TopRulesCleared() bool {
+	_, ok := m.clearedFields[monthlyreport.FieldTopRules]
+	return ok
+}
+
+// ResetTopRules resets all changes to the "top_rules" field.
+func (m *MonthlyReportMutation) ResetTopRules() {
+	m.top_rules = nil
+	m.appendtop_rules = nil
+	delete(m.clearedFields, monthlyreport.FieldTopRules)
+}
+
+// SetGoals sets the "goals" field.
+func (m *MonthlyReportMutation) SetGoals(sg []schematype.ReportGoal) {
+	m.goals = &sg
+	m.appendgoals = nil
+}
+
+// Goals returns the value of the "goals" field in the mutation.
+func (m *MonthlyReportMutation) Goals() (r []schematype.ReportGoal, exists bool) {
+	v := m.goals
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGoals returns the old "goals" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldGoals(ctx context.Context) (v []schematype.ReportGoal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGoals is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGoals requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGoals: %w", err)
+	}
+	return oldValue.Goals, nil
+}
+
+// AppendGoals adds sg to the "goals" field.
+func (m *MonthlyReportMutation) AppendGoals(sg []schematype.ReportGoal) {
+	m.appendgoals = append(m.appendgoals, sg...)
+}
+
+// AppendedGoals returns the list of values that were appended to the "goals" field in this mutation.
+func (m *MonthlyReportMutation) AppendedGoals() ([]schematype.ReportGoal, bool) {
+	if len(m.appendgoals) == 0 {
+		return nil, false
+	}
+	return m.appendgoals, true
+}
+
+// ClearGoals clears the value of the "goals" field.
+func (m *MonthlyReportMutation) ClearGoals() {
+	m.goals = nil
+	m.appendgoals = nil
+	m.clearedFields[monthlyreport.FieldGoals] = struct{}{}
+}
+
+// GoalsCleared returns if the "goals" field was cleared in this mutation.
+func (m *MonthlyReportMutation) GoalsCleared() bool {
+	_, ok := m.clearedFields[monthlyreport.FieldGoals]
+	return ok
+}
+
+// ResetGoals resets all changes to the "goals" field.
+func (m *MonthlyReportMutation) ResetGoals() {
+	m.goals = nil
+	m.appendgoals = nil
+	delete(m.clearedFields, monthlyreport.FieldGoals)
+}
+
+// SetCategoryChanges sets the "category_changes" field.
+func (m *MonthlyReportMutation) SetCategoryChanges(scc []schematype.ReportCategoryChange) {
+	m.category_changes = &scc
+	m.appendcategory_changes = nil
+}
+
+// CategoryChanges returns the value of the "category_changes" field in the mutation.
+func (m *MonthlyReportMutation) CategoryChanges() (r []schematype.ReportCategoryChange, exists bool) {
+	v := m.category_changes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryChanges returns the old "category_changes" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldCategoryChanges(ctx context.Context) (v []schematype.ReportCategoryChange, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryChanges is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryChanges requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryChanges: %w", err)
+	}
+	return oldValue.CategoryChanges, nil
+}
+
+// AppendCategoryChanges adds scc to the "category_changes" field.
+func (m *MonthlyReportMutation) AppendCategoryChanges(scc []schematype.ReportCategoryChange) {
+	m.appendcategory_changes = append(m.appendcategory_changes, scc...)
+}
+
+// AppendedCategoryChanges returns the list of values that were appended to the "category_changes" field in this mutation.
+func (m *MonthlyReportMutation) AppendedCategoryChanges() ([]schematype.ReportCategoryChange, bool) {
+	if len(m.appendcategory_changes) == 0 {
+		return nil, false
+	}
+	return m.appendcategory_changes, true
+}
+
+// ClearCategoryChanges clears the value of the "category_changes" field.
+func (m *MonthlyReportMutation) ClearCategoryChanges() {
+	m.category_changes = nil
+	m.appendcategory_changes = nil
+	m.clearedFields[monthlyreport.FieldCategoryChanges] = struct{}{}
+}
+
+// CategoryChangesCleared returns if the "category_changes" field was cleared in this mutation.
+func (m *MonthlyReportMutation) CategoryChangesCleared() bool {
+	_, ok := m.clearedFields[monthlyreport.FieldCategoryChanges]
+	return ok
+}
+
+// ResetCategoryChanges resets all changes to the "category_changes" field.
+func (m *MonthlyReportMutation) ResetCategoryChanges() {
+	m.category_changes = nil
+	m.appendcategory_changes = nil
+	delete(m.clearedFields, monthlyreport.FieldCategoryChanges)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MonthlyReportMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MonthlyReportMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MonthlyReport entity.
+// If the MonthlyReport object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MonthlyReportMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MonthlyReportMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MonthlyReportMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[monthlyreport.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MonthlyReportMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MonthlyReportMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MonthlyReportMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MonthlyReportMutation builder.
+func (m *MonthlyReportMutation) Where(ps ...predicate.MonthlyReport) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MonthlyReportMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MonthlyReportMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MonthlyReport, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MonthlyReportMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MonthlyReportMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MonthlyReport).
+func (m *MonthlyReportMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MonthlyReportMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.user != nil {
+		fields = append(fields, monthlyreport.FieldUserID)
+	}
+	if m.month != nil {
+		fields = append(fields, monthlyreport.FieldMonth)
+	}
+	if m.income_cents != nil {
+		fields = append(fields, monthlyreport.FieldIncomeCents)
+	}
+	if m.spend_cents != nil {
+		fields = append(fields, monthlyreport.FieldSpendCents)
+	}
+	if m.savings_cents != nil {
+		fields = append(fields, monthlyreport.FieldSavingsCents)
+	}
+	if m.net_cents != nil {
+		fields = append(fields, monthlyreport.FieldNetCents)
+	}
+	if m.currency != nil {
+		fields = append(fields, monthlyreport.FieldCurrency)
+	}
+	if m.top_rules != nil {
+		fields = append(fields, monthlyreport.FieldTopRules)
+	}
+	if m.goals != nil {
+		fields = append(fields, monthlyreport.FieldGoals)
+	}
+	if m.category_changes != nil {
+		fields = append(fields, monthlyreport.FieldCategoryChanges)
+	}
+	if m.created_at != nil {
+		fields = append(fields, monthlyreport.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MonthlyReportMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case monthlyreport.FieldUserID:
+		return m.UserID()
+	case monthlyreport.FieldMonth:
+		return m.Month()
+	case monthlyreport.FieldIncomeCents:
+		return m.IncomeCents()
+	case monthlyreport.FieldSpendCents:
+		return m.SpendCents()
+	case monthlyreport.FieldSavingsCents:
+		return m.SavingsCents()
+	case monthlyreport.FieldNetCents:
+		return m.NetCents()
+	case monthlyreport.FieldCurrency:
+		return m.Currency()
+	case monthlyreport.FieldTopRules:
+		return m.TopRules()
+	case monthlyreport.FieldGoals:
+		return m.Goals()
+	case monthlyreport.FieldCategoryChanges:
+		return m.CategoryChanges()
+	case monthlyreport.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MonthlyReportMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case monthlyreport.FieldUserID:
+		return m.OldUserID(ctx)
+	case monthlyreport.FieldMonth:
+		return m.OldMonth(ctx)
+	case monthlyreport.FieldIncomeCents:
+		return m.OldIncomeCents(ctx)
+	case monthlyreport.FieldSpendCents:
+		return m.OldSpendCents(ctx)
+	case monthlyreport.FieldSavingsCents:
+		return m.OldSavingsCents(ctx)
+	case monthlyreport.FieldNetCents:
+		return m.OldNetCents(ctx)
+	case monthlyreport.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case monthlyreport.FieldTopRules:
+		return m.OldTopRules(ctx)
+	case monthlyreport.FieldGoals:
+		return m.OldGoals(ctx)
+	case monthlyreport.FieldCategoryChanges:
+		return m.OldCategoryChanges(ctx)
+	case monthlyreport.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MonthlyReport field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonthlyReportMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case monthlyreport.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case monthlyreport.FieldMonth:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonth(v)
+		return nil
+	case monthlyreport.FieldIncomeCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIncomeCents(v)
+		return nil
+	case monthlyreport.FieldSpendCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSpendCents(v)
+		return nil
+	case monthlyreport.FieldSavingsCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSavingsCents(v)
+		return nil
+	case monthlyreport.FieldNetCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNetCents(v)
+		return nil
+	case monthlyreport.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case monthlyreport.FieldTopRules:
+		v, ok := value.([]schematype.ReportRule)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTopRules(v)
+		return nil
+	case monthlyreport.FieldGoals:
+		v, ok := value.([]schematype.ReportGoal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGoals(v)
+		return nil
+	case monthlyreport.FieldCategoryChanges:
+		v, ok := value.([]schematype.ReportCategoryChange)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryChanges(v)
+		return nil
+	case monthlyreport.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyReport field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MonthlyReportMutation) AddedFields() []string {
+	var fields []string
+	if m.addincome_cents != nil {
+		fields = append(fields, monthlyreport.FieldIncomeCents)
+	}
+	if m.addspend_cents != nil {
+		fields = append(fields, monthlyreport.FieldSpendCents)
+	}
+	if m.addsavings_cents != nil {
+		fields = append(fields, monthlyreport.FieldSavingsCents)
+	}
+	if m.addnet_cents != nil {
+		fields = append(fields, monthlyreport.FieldNetCents)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MonthlyReportMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case monthlyreport.FieldIncomeCents:
+		return m.AddedIncomeCents()
+	case monthlyreport.FieldSpendCents:
+		return m.AddedSpendCents()
+	case monthlyreport.FieldSavingsCents:
+		return m.AddedSavingsCents()
+	case monthlyreport.FieldNetCents:
+		return m.AddedNetCents()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MonthlyReportMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case monthlyreport.FieldIncomeCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIncomeCents(v)
+		return nil
+	case monthlyreport.FieldSpendCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSpendCents(v)
+		return nil
+	case monthlyreport.FieldSavingsCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSavingsCents(v)
+		return nil
+	case monthlyreport.FieldNetCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNetCents(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyReport numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MonthlyReportMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(monthlyreport.FieldTopRules) {
+		fields = append(fields, monthlyreport.FieldTopRules)
+	}
+	if m.FieldCleared(monthlyreport.FieldGoals) {
+		fields = append(fields, monthlyreport.FieldGoals)
+	}
+	if m.FieldCleared(monthlyreport.FieldCategoryChanges) {
+		fields = append(fields, monthlyreport.FieldCategoryChanges)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MonthlyReportMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MonthlyReportMutation) ClearField(name string) error {
+	switch name {
+	case monthlyreport.FieldTopRules:
+		m.ClearTopRules()
+		return nil
+	case monthlyreport.FieldGoals:
+		m.ClearGoals()
+		return nil
+	case monthlyreport.FieldCategoryChanges:
+		m.ClearCategoryChanges()
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyReport nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MonthlyReportMutation) ResetField(name string) error {
+	switch name {
+	case monthlyreport.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case monthlyreport.FieldMonth:
+		m.ResetMonth()
+		return nil
+	case monthlyreport.FieldIncomeCents:
+		m.ResetIncomeCents()
+		return nil
+	case monthlyreport.FieldSpendCents:
+		m.ResetSpendCents()
+		return nil
+	case monthlyreport.FieldSavingsCents:
+		m.ResetSavingsCents()
+		return nil
+	case monthlyreport.FieldNetCents:
+		m.ResetNetCents()
+		return nil
+	case monthlyreport.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case monthlyreport.FieldTopRules:
+		m.ResetTopRules()
+		return nil
+	case monthlyreport.FieldGoals:
+		m.ResetGoals()
+		return nil
+	case monthlyreport.FieldCategoryChanges:
+		m.ResetCategoryChanges()
+		return nil
+	case monthlyreport.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyReport field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MonthlyReportMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, monthlyreport.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MonthlyReportMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case monthlyreport.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MonthlyReportMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MonthlyReportMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MonthlyReportMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, monthlyreport.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MonthlyReportMutation) EdgeCleared(name string) bool {
+	switch name {
+	case monthlyreport.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MonthlyReportMutation) ClearEdge(name string) error {
+	switch name {
+	case monthlyreport.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyReport unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MonthlyReportMutation) ResetEdge(name string) error {
+	switch name {
+	case monthlyreport.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MonthlyReport edge %s", name)
+}
+
+// PushSubscriptionMutation represents an operation that mutates the PushSubscription nodes in the graph.
+type PushSubscriptionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	endpoint      *string
+	p256dh        *string
+	auth          *string
+	active        *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PushSubscription, error)
+	predicates    []predicate.PushSubscription
+}
+
+var _ ent.Mutation = (*PushSubscriptionMutation)(nil)
+
+// pushsubscriptionOption allows management of the mutation configuration using functional options.
+type pushsubscriptionOption func(*PushSubscriptionMutation)
+
+// newPushSubscriptionMutation creates new mutation for the PushSubscription entity.
+func newPushSubscriptionMutation(c config, op Op, opts ...pushsubscriptionOption) *PushSubscriptionMutation {
+	m := &PushSubscriptionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePushSubscription,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPushSubscriptionID sets the ID field of the mutation.
+func withPushSubscriptionID(id uuid.UUID) pushsubscriptionOption {
+	return func(m *PushSubscriptionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PushSubscription
+		)
+		m.oldValue = func(ctx context.Context) (*PushSubscription, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PushSubscription.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPushSubscription sets the old PushSubscription of the mutation.
+func withPushSubscription(node *PushSubscription) pushsubscriptionOption {
+	return func(m *PushSubscriptionMutation) {
+		m.oldValue = func(context.Context) (*PushSubscription, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PushSubscriptionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PushSubscriptionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PushSubscription entities.
+func (m *PushSubscriptionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PushSubscriptionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PushSubscriptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PushSubscription.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PushSubscriptionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PushSubscriptionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PushSubscription entity.
+// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushSubscriptionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PushSubscriptionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetEndpoint sets the "endpoint" field.
+func (m *PushSubscriptionMutation) SetEndpoint(s string) {
+	m.endpoint = &s
+}
+
+// Endpoint returns the value of the "endpoint" field in the mutation.
+func (m *PushSubscriptionMutation) Endpoint() (r string, exists bool) {
+	v := m.endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndpoint returns the old "endpoint" field's value of the PushSubscription entity.
+// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushSubscriptionMutation) OldEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndpoint: %w", err)
+	}
+	return oldValue.Endpoint, nil
+}
+
+// ResetEndpoint resets all changes to the "endpoint" field.
+func (m *PushSubscriptionMutation) ResetEndpoint() {
+	m.endpoint = nil
+}
+
+// SetP256dh sets the "p256dh" field.
+func (m *PushSubscriptionMutation) SetP256dh(s string) {
+	m.p256dh = &s
+}
+
+// P256dh returns the value of the "p256dh" field in the mutation.
+func (m *PushSubscriptionMutation) P256dh() (r string, exists bool) {
+	v := m.p256dh
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldP256dh returns the old "p256dh" field's value of the PushSubscription entity.
+// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushSubscriptionMutation) OldP256dh(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldP256dh is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldP256dh requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldP256dh: %w", err)
+	}
+	return oldValue.P256dh, nil
+}
+
+// ResetP256dh resets all changes to the "p256dh" field.
+func (m *PushSubscriptionMutation) ResetP256dh() {
+	m.p256dh = nil
+}
+
+// SetAuth sets the "auth" field.
+func (m *PushSubscriptionMutation) SetAuth(s string) {
+	m.auth = &s
+}
+
+// Auth returns the value of the "auth" field in the mutation.
+func (m *PushSubscriptionMutation) Auth() (r string, exists bool) {
+	v := m.auth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuth returns the old "auth" field's value of the PushSubscription entity.
+// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushSubscriptionMutation) OldAuth(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuth: %w", err)
+	}
+	return oldValue.Auth, nil
+}
+
+// ResetAuth resets all changes to the "auth" field.
+func (m *PushSubscriptionMutation) ResetAuth() {
+	m.auth = nil
+}
+
+// SetActive sets the "active" field.
+func (m *PushSubscriptionMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *PushSubscriptionMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the PushSubscription entity.
+// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushSubscriptionMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *PushSubscriptionMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PushSubscriptionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PushSubscriptionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PushSubscription entity.
+// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushSubscriptionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PushSubscriptionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PushSubscriptionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PushSubscriptionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PushSubscription entity.
+// If the PushSubscription object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PushSubscriptionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PushSubscriptionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PushSubscriptionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[pushsubscription.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PushSubscriptionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PushSubscriptionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PushSubscriptionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PushSubscriptionMutation builder.
+func (m *PushSubscriptionMutation) Where(ps ...predicate.PushSubscription) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PushSubscriptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PushSubscriptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PushSubscription, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PushSubscriptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PushSubscriptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PushSubscription).
+func (m *PushSubscriptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PushSubscriptionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user != nil {
+		fields = append(fields, pushsubscription.FieldUserID)
+	}
+	if m.endpoint != nil {
+		fields = append(fields, pushsubscription.FieldEndpoint)
+	}
+	if m.p256dh != nil {
+		fields = append(fields, pushsubscription.FieldP256dh)
+	}
+	if m.auth != nil {
+		fields = append(fields, pushsubscription.FieldAuth)
+	}
+	if m.active != nil {
+		fields = append(fields, pushsubscription.FieldActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, pushsubscription.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, pushsubscription.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PushSubscriptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case pushsubscription.FieldUserID:
+		return m.UserID()
+	case pushsubscription.FieldEndpoint:
+		return m.Endpoint()
+	case pushsubscription.FieldP256dh:
+		return m.P256dh()
+	case pushsubscription.FieldAuth:
+		return m.Auth()
+	case pushsubscription.FieldActive:
+		return m.Active()
+	case pushsubscription.FieldCreatedAt:
+		return m.CreatedAt()
+	case pushsubscription.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PushSubscriptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case pushsubscription.FieldUserID:
+		return m.OldUserID(ctx)
+	case pushsubscription.FieldEndpoint:
+		return m.OldEndpoint(ctx)
+	case pushsubscription.FieldP256dh:
+		return m.OldP256dh(ctx)
+	case pushsubscription.FieldAuth:
+		return m.OldAuth(ctx)
+	case pushsubscription.FieldActive:
+		return m.OldActive(ctx)
+	case pushsubscription.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case pushsubscription.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PushSubscription field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PushSubscriptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case pushsubscription.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case pushsubscription.FieldEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndpoint(v)
+		return nil
+	case pushsubscription.FieldP256dh:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetP256dh(v)
+		return nil
+	case pushsubscription.FieldAuth:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuth(v)
+		return nil
+	case pushsubscription.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case pushsubscription.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case pushsubscription.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PushSubscription field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PushSubscriptionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PushSubscriptionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PushSubscriptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PushSubscription numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PushSubscriptionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PushSubscriptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PushSubscriptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PushSubscription nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PushSubscriptionMutation) ResetField(name string) error {
+	switch name {
+	case pushsubscription.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case pushsubscription.FieldEndpoint:
+		m.ResetEndpoint()
+		return nil
+	case pushsubscription.FieldP256dh:
+		m.ResetP256dh()
+		return nil
+	case pushsubscription.FieldAuth:
+		m.ResetAuth()
+		return nil
+	case pushsubscription.FieldActive:
+		m.ResetActive()
+		return nil
+	case pushsubscription.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case pushsubscription.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PushSubscription field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PushSubscriptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, pushsubscription.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PushSubscriptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case pushsubscription.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PushSubscriptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PushSubscriptionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PushSubscriptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, pushsubscription.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PushSubscriptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case pushsubscription.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PushSubscriptionMutation) ClearEdge(name string) error {
+	switch name {
+	case pushsubscription.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PushSubscription unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PushSubscriptionMutation) ResetEdge(name string) error {
+	switch name {
+	case pushsubscription.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown PushSubscription edge %s", name)
+}
+
+// RecurringChargeMutation represents an operation that mutates the RecurringCharge nodes in the graph.
+type RecurringChargeMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *uuid.UUID
+	merchant_key             *string
+	merchant_name            *string
+	category                 *string
+	cadence                  *recurringcharge.Cadence
+	amount_cents             *int64
+	addamount_cents          *int64
+	previous_amount_cents    *int64
+	addprevious_amount_cents *int64
+	price_increased          *bool
+	occurrences              *int
+	addoccurrences           *int
+	last_charge_date         *time.Time
+	next_charge_date         *time.Time
+	is_active                *bool
+	created_at               *time.Time
+	updated_at               *time.Time
+	clearedFields            map[string]struct{}
+	user                     *uuid.UUID
+	cleareduser              bool
+	done                     bool
+	oldValue                 func(context.Context) (*RecurringCharge, error)
+	predicates               []predicate.RecurringCharge
+}
+
+var _ ent.Mutation = (*RecurringChargeMutation)(nil)
+
+// recurringchargeOption allows management of the mutation configuration using functional options.
+type recurringchargeOption func(*RecurringChargeMutation)
+
+// newRecurringChargeMutation creates new mutation for the RecurringCharge entity.
+func newRecurringChargeMutation(c config, op Op, opts ...recurringchargeOption) *RecurringChargeMutation {
+	m := &RecurringChargeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRecurringCharge,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRecurringChargeID sets the ID field of the mutation.
+func withRecurringChargeID(id uuid.UUID) recurringchargeOption {
+	return func(m *RecurringChargeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RecurringCharge
+		)
+		m.oldValue = func(ctx context.Context) (*RecurringCharge, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RecurringCharge.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRecurringCharge sets the old RecurringCharge of the mutation.
+func withRecurringCharge(node *RecurringCharge) recurringchargeOption {
+	return func(m *RecurringChargeMutation) {
+		m.oldValue = func(context.Context) (*RecurringCharge, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RecurringChargeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RecurringChargeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RecurringCharge entities.
+func (m *RecurringChargeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RecurringChargeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RecurringChargeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RecurringCharge.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RecurringChargeMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RecurringChargeMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RecurringChargeMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetMerchantKey sets the "merchant_key" field.
+func (m *RecurringChargeMutation) SetMerchantKey(s string) {
+	m.merchant_key = &s
+}
+
+// MerchantKey returns the value of the "merchant_key" field in the mutation.
+func (m *RecurringChargeMutation) MerchantKey() (r string, exists bool) {
+	v := m.merchant_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMerchantKey returns the old "merchant_key" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldMerchantKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMerchantKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMerchantKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMerchantKey: %w", err)
+	}
+	return oldValue.MerchantKey, nil
+}
+
+// ResetMerchantKey resets all changes to the "merchant_key" field.
+func (m *RecurringChargeMutation) ResetMerchantKey() {
+	m.merchant_key = nil
+}
+
+// SetMerchantName sets the "merchant_name" field.
+func (m *RecurringChargeMutation) SetMerchantName(s string) {
+	m.merchant_name = &s
+}
+
+// MerchantName returns the value of the "merchant_name" field in the mutation.
+func (m *RecurringChargeMutation) MerchantName() (r string, exists bool) {
+	v := m.merchant_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMerchantName returns the old "merchant_name" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldMerchantName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMerchantName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMerchantName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMerchantName: %w", err)
+	}
+	return oldValue.MerchantName, nil
+}
+
+// ResetMerchantName resets all changes to the "merchant_name" field.
+func (m *RecurringChargeMutation) ResetMerchantName() {
+	m.merchant_name = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *RecurringChargeMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *RecurringChargeMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *RecurringChargeMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetCadence sets the "cadence" field.
+func (m *RecurringChargeMutation) SetCadence(r recurringcharge.Cadence) {
+	m.cadence = &r
+}
+
+// Cadence returns the value of the "cadence" field in the mutation.
+func (m *RecurringChargeMutation) Cadence() (r recurringcharge.Cadence, exists bool) {
+	v := m.cadence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCadence returns the old "cadence" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldCadence(ctx context.Context) (v recurringcharge.Cadence, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCadence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCadence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCadence: %w", err)
+	}
+	return oldValue.Cadence, nil
+}
+
+// ResetCadence resets all changes to the "cadence" field.
+func (m *RecurringChargeMutation) ResetCadence() {
+	m.cadence = nil
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (m *RecurringChargeMutation) SetAmountCents(i int64) {
+	m.amount_cents = &i
+	m.addamount_cents = nil
+}
+
+// AmountCents returns the value of the "amount_cents" field in the mutation.
+func (m *RecurringChargeMutation) AmountCents() (r int64, exists bool) {
+	v := m.amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmountCents returns the old "amount_cents" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldAmountCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmountCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmountCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmountCents: %w", err)
+	}
+	return oldValue.AmountCents, nil
+}
+
+// AddAmountCents adds i to the "amount_cents" field.
+func (m *RecurringChargeMutation) AddAmountCents(i int64) {
+	if m.addamount_cents != nil {
+		*m.addamount_cents += i
+	} else {
+		m.addamount_cents = &i
+	}
+}
+
+// AddedAmountCents returns the value that was added to the "amount_cents" field in this mutation.
+func (m *RecurringChargeMutation) AddedAmountCents() (r int64, exists bool) {
+	v := m.addamount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmountCents resets all changes to the "amount_cents" field.
+func (m *RecurringChargeMutation) ResetAmountCents() {
+	m.amount_cents = nil
+	m.addamount_cents = nil
+}
+
+// SetPreviousAmountCents sets the "previous_amount_cents" field.
+func (m *RecurringChargeMutation) SetPreviousAmountCents(i int64) {
+	m.previous_amount_cents = &i
+	m.addprevious_amount_cents = nil
+}
+
+// PreviousAmountCents returns the value of the "previous_amount_cents" field in the mutation.
+func (m *RecurringChargeMutation) PreviousAmountCents() (r int64, exists bool) {
+	v := m.previous_amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPreviousAmountCents returns the old "previous_amount_cents" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldPreviousAmountCents(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPreviousAmountCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPreviousAmountCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPreviousAmountCents: %w", err)
+	}
+	return oldValue.PreviousAmountCents, nil
+}
+
+// AddPreviousAmountCents adds i to the "previous_amount_cents" field.
+func (m *RecurringChargeMutation) AddPreviousAmountCents(i int64) {
+	if m.addprevious_amount_cents != nil {
+		*m.addprevious_amount_cents += i
+	} else {
+		m.addprevious_amount_cents = &i
+	}
+}
+
+// AddedPreviousAmountCents returns the value that was added to the "previous_amount_cents" field in this mutation.
+func (m *RecurringChargeMutation) AddedPreviousAmountCents() (r int64, exists bool) {
+	v := m.addprevious_amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearPreviousAmountCents clears the value of the "previous_amount_cents" field.
+func (m *RecurringChargeMutation) ClearPreviousAmountCents() {
+	m.previous_amount_cents = nil
+	m.addprevious_amount_cents = nil
+	m.clearedFields[recurringcharge.FieldPreviousAmountCents] = struct{}{}
+}
+
+// PreviousAmountCentsCleared returns if the "previous_amount_cents" field was cleared in this mutation.
+func (m *RecurringChargeMutation) PreviousAmountCentsCleared() bool {
+	_, ok := m.clearedFields[recurringcharge.FieldPreviousAmountCents]
+	return ok
+}
+
+// ResetPreviousAmountCents resets all changes to the "previous_amount_cents" field.
+func (m *RecurringChargeMutation) ResetPreviousAmountCents() {
+	m.previous_amount_cents = nil
+	m.addprevious_amount_cents = nil
+	delete(m.clearedFields, recurringcharge.FieldPreviousAmountCents)
+}
+
+// SetPriceIncreased sets the "price_increased" field.
+func (m *RecurringChargeMutation) SetPriceIncreased(b bool) {
+	m.price_increased = &b
+}
+
+// PriceIncreased returns the value of the "price_increased" field in the mutation.
+func (m *RecurringChargeMutation) PriceIncreased() (r bool, exists bool) {
+	v := m.price_increased
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriceIncreased returns the old "price_increased" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldPriceIncreased(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriceIncreased is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriceIncreased requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriceIncreased: %w", err)
+	}
+	return oldValue.PriceIncreased, nil
+}
+
+// ResetPriceIncreased resets all changes to the "price_increased" field.
+func (m *RecurringChargeMutation) ResetPriceIncreased() {
+	m.price_increased = nil
+}
+
+// SetOccurrences sets the "occurrences" field.
+func (m *RecurringChargeMutation) SetOccurrences(i int) {
+	m.occurrences = &i
+	m.addoccurrences = nil
+}
+
+// Occurrences returns the value of the "occurrences" field in the mutation.
+func (m *RecurringChargeMutation) Occurrences() (r int, exists bool) {
+	v := m.occurrences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOccurrences returns the old "occurrences" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldOccurrences(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOccurrences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOccurrences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOccurrences: %w", err)
+	}
+	return oldValue.Occurrences, nil
+}
+
+// AddOccurrences adds i to the "occurrences" field.
+func (m *RecurringChargeMutation) AddOccurrences(i int) {
+	if m.addoccurrences != nil {
+		*m.addoccurrences += i
+	} else {
+		m.addoccurrences = &i
+	}
+}
+
+// AddedOccurrences returns the value that was added to the "occurrences" field in this mutation.
+func (m *RecurringChargeMutation) AddedOccurrences() (r int, exists bool) {
+	v := m.addoccurrences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOccurrences resets all changes to the "occurrences" field.
+func (m *RecurringChargeMutation) ResetOccurrences() {
+	m.occurrences = nil
+	m.addoccurrences = nil
+}
+
+// SetLastChargeDate sets the "last_charge_date" field.
+func (m *RecurringChargeMutation) SetLastChargeDate(t time.Time) {
+	m.last_charge_date = &t
+}
+
+// LastChargeDate returns the value of the "last_charge_date" field in the mutation.
+func (m *RecurringChargeMutation) LastChargeDate() (r time.Time, exists bool) {
+	v := m.last_charge_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastChargeDate returns the old "last_charge_date" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldLastChargeDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastChargeDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastChargeDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastChargeDate: %w", err)
+	}
+	return oldValue.LastChargeDate, nil
+}
+
+// ResetLastChargeDate resets all changes to the "last_charge_date" field.
+func (m *RecurringChargeMutation) ResetLastChargeDate() {
+	m.last_charge_date = nil
+}
+
+// SetNextChargeDate sets the "next_charge_date" field.
+func (m *RecurringChargeMutation) SetNextChargeDate(t time.Time) {
+	m.next_charge_date = &t
+}
+
+// NextChargeDate returns the value of the "next_charge_date" field in the mutation.
+func (m *RecurringChargeMutation) NextChargeDate() (r time.Time, exists bool) {
+	v := m.next_charge_date
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextChargeDate returns the old "next_charge_date" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldNextChargeDate(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextChargeDate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextChargeDate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextChargeDate: %w", err)
+	}
+	return oldValue.NextChargeDate, nil
+}
+
+// ResetNextChargeDate resets all changes to the "next_charge_date" field.
+func (m *RecurringChargeMutation) ResetNextChargeDate() {
+	m.next_charge_date = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *RecurringChargeMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *RecurringChargeMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *RecurringChargeMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RecurringChargeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RecurringChargeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RecurringChargeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RecurringChargeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RecurringChargeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RecurringCharge entity.
+// If the RecurringCharge object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RecurringChargeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RecurringChargeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RecurringChargeMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[recurringcharge.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RecurringChargeMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RecurringChargeMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RecurringChargeMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RecurringChargeMutation builder.
+func (m *RecurringChargeMutation) Where(ps ...predicate.RecurringCharge) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RecurringChargeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RecurringChargeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RecurringCharge, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RecurringChargeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RecurringChargeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RecurringCharge).
+func (m *RecurringChargeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RecurringChargeMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user != nil {
+		fields = append(fields, recurringcharge.FieldUserID)
+	}
+	if m.merchant_key != nil {
+		fields = append(fields, recurringcharge.FieldMerchantKey)
+	}
+	if m.merchant_name != nil {
+		fields = append(fields, recurringcharge.FieldMerchantName)
+	}
+	if m.category != nil {
+		fields = append(fields, recurringcharge.FieldCategory)
+	}
+	if m.cadence != nil {
+		fields = append(fields, recurringcharge.FieldCadence)
+	}
+	if m.amount_cents != nil {
+		fields = append(fields, recurringcharge.FieldAmountCents)
+	}
+	if m.previous_amount_cents != nil {
+		fields = append(fields, recurringcharge.FieldPreviousAmountCents)
+	}
+	if m.price_increased != nil {
+		fields = append(fields, recurringcharge.FieldPriceIncreased)
+	}
+	if m.occurrences != nil {
+		fields = append(fields, recurringcharge.FieldOccurrences)
+	}
+	if m.last_charge_date != nil {
+		fields = append(fields, recurringcharge.FieldLastChargeDate)
+	}
+	if m.next_charge_date != nil {
+		fields = append(fields, recurringcharge.FieldNextChargeDate)
+	}
+	if m.is_active != nil {
+		fields = append(fields, recurringcharge.FieldIsActive)
+	}
+	if m.created_at != nil {
+		fields = append(fields, recurringcharge.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, recurringcharge.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RecurringChargeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case recurringcharge.FieldUserID:
+		return m.UserID()
+	case recurringcharge.FieldMerchantKey:
+		return m.MerchantKey()
+	case recurringcharge.FieldMerchantName:
+		return m.MerchantName()
+	case recurringcharge.FieldCategory:
+		return m.Category()
+	case recurringcharge.FieldCadence:
+		return m.Cadence()
+	case recurringcharge.FieldAmountCents:
+		return m.AmountCents()
+	case recurringcharge.FieldPreviousAmountCents:
+		return m.PreviousAmountCents()
+	case recurringcharge.FieldPriceIncreased:
+		return m.PriceIncreased()
+	case recurringcharge.FieldOccurrences:
+		return m.Occurrences()
+	case recurringcharge.FieldLastChargeDate:
+		return m.LastChargeDate()
+	case recurringcharge.FieldNextChargeDate:
+		return m.NextChargeDate()
+	case recurringcharge.FieldIsActive:
+		return m.IsActive()
+	case recurringcharge.FieldCreatedAt:
+		return m.CreatedAt()
+	case recurringcharge.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RecurringChargeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case recurringcharge.FieldUserID:
+		return m.OldUserID(ctx)
+	case recurringcharge.FieldMerchantKey:
+		return m.OldMerchantKey(ctx)
+	case recurringcharge.FieldMerchantName:
+		return m.OldMerchantName(ctx)
+	case recurringcharge.FieldCategory:
+		return m.OldCategory(ctx)
+	case recurringcharge.FieldCadence:
+		return m.OldCadence(ctx)
+	case recurringcharge.FieldAmountCents:
+		return m.OldAmountCents(ctx)
+	case recurringcharge.FieldPreviousAmountCents:
+		return m.OldPreviousAmountCents(ctx)
+	case recurringcharge.FieldPriceIncreased:
+		return m.OldPriceIncreased(ctx)
+	case recurringcharge.FieldOccurrences:
+		return m.OldOccurrences(ctx)
+	case recurringcharge.FieldLastChargeDate:
+		return m.OldLastChargeDate(ctx)
+	case recurringcharge.FieldNextChargeDate:
+		return m.OldNextChargeDate(ctx)
+	case recurringcharge.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case recurringcharge.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case recurringcharge.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RecurringCharge field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringChargeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case recurringcharge.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case recurringcharge.FieldMerchantKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchantKey(v)
+		return nil
+	case recurringcharge.FieldMerchantName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchantName(v)
+		return nil
+	case recurringcharge.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case recurringcharge.FieldCadence:
+		v, ok := value.(recurringcharge.Cadence)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCadence(v)
+		return nil
+	case recurringcharge.FieldAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountCents(v)
+		return nil
+	case recurringcharge.FieldPreviousAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPreviousAmountCents(v)
+		return nil
+	case recurringcharge.FieldPriceIncreased:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriceIncreased(v)
+		return nil
+	case recurringcharge.FieldOccurrences:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOccurrences(v)
+		return nil
+	case recurringcharge.FieldLastChargeDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastChargeDate(v)
+		return nil
+	case recurringcharge.FieldNextChargeDate:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextChargeDate(v)
+		return nil
+	case recurringcharge.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case recurringcharge.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case recurringcharge.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringCharge field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RecurringChargeMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount_cents != nil {
+		fields = append(fields, recurringcharge.FieldAmountCents)
+	}
+	if m.addprevious_amount_cents != nil {
+		fields = append(fields, recurringcharge.FieldPreviousAmountCents)
+	}
+	if m.addoccurrences != nil {
+		fields = append(fields, recurringcharge.FieldOccurrences)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RecurringChargeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case recurringcharge.FieldAmountCents:
+		return m.AddedAmountCents()
+	case recurringcharge.FieldPreviousAmountCents:
+		return m.AddedPreviousAmountCents()
+	case recurringcharge.FieldOccurrences:
+		return m.AddedOccurrences()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RecurringChargeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case recurringcharge.FieldAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountCents(v)
+		return nil
+	case recurringcharge.FieldPreviousAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPreviousAmountCents(v)
+		return nil
+	case recurringcharge.FieldOccurrences:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOccurrences(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringCharge numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RecurringChargeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(recurringcharge.FieldPreviousAmountCents) {
+		fields = append(fields, recurringcharge.FieldPreviousAmountCents)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RecurringChargeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RecurringChargeMutation) ClearField(name string) error {
+	switch name {
+	case recurringcharge.FieldPreviousAmountCents:
+		m.ClearPreviousAmountCents()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringCharge nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RecurringChargeMutation) ResetField(name string) error {
+	switch name {
+	case recurringcharge.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case recurringcharge.FieldMerchantKey:
+		m.ResetMerchantKey()
+		return nil
+	case recurringcharge.FieldMerchantName:
+		m.ResetMerchantName()
+		return nil
+	case recurringcharge.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case recurringcharge.FieldCadence:
+		m.ResetCadence()
+		return nil
+	case recurringcharge.FieldAmountCents:
+		m.ResetAmountCents()
+		return nil
+	case recurringcharge.FieldPreviousAmountCents:
+		m.ResetPreviousAmountCents()
+		return nil
+	case recurringcharge.FieldPriceIncreased:
+		m.ResetPriceIncreased()
+		return nil
+	case recurringcharge.FieldOccurrences:
+		m.ResetOccurrences()
+		return nil
+	case recurringcharge.FieldLastChargeDate:
+		m.ResetLastChargeDate()
+		return nil
+	case recurringcharge.FieldNextChargeDate:
+		m.ResetNextChargeDate()
+		return nil
+	case recurringcharge.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case recurringcharge.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case recurringcharge.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringCharge field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RecurringChargeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, recurringcharge.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RecurringChargeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case recurringcharge.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RecurringChargeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RecurringChargeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RecurringChargeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, recurringcharge.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RecurringChargeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case recurringcharge.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RecurringChargeMutation) ClearEdge(name string) error {
+	switch name {
+	case recurringcharge.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringCharge unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RecurringChargeMutation) ResetEdge(name string) error {
+	switch name {
+	case recurringcharge.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RecurringCharge edge %s", name)
+}
+
+// RuleMutation represents an operation that mutates the Rule nodes in the graph.
+type RuleMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	name                       *string
+	category                   *rule.Category
+	min_amount_cents           *int64
+	addmin_amount_cents        *int64
+	max_amount_cents           *int64
+	addmax_amount_cents        *int64
+	monthly_threshold_cents    *int64
+	addmonthly_threshold_cents *int64
+	action_type                *rule.ActionType
+	action_value               *float64
+	addaction_value            *float64
+	is_active                  *bool
+	priority                   *int
+	addpriority                *int
+	execution_count            *int
+	addexecution_count         *int
+	total_saved_cents          *int64
+	addtotal_saved_cents       *int64
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	user                       *uuid.UUID
+	cleareduser                bool
+	target_account             *uuid.UUID
+	clearedtarget_account      bool
+	family                     *uuid.UUID
+	clearedfamily              bool
+	budget                     *uuid.UUID
+	clearedbudget              bool
+	executions                 map[uuid.UUID]struct{}
+	removedexecutions          map[uuid.UUID]struct{}
+	clearedexecutions          bool
+	done                       bool
+	oldValue                   func(context.Context) (*Rule, error)
+	predicates                 []predicate.Rule
+}
+
+var _ ent.Mutation = (*RuleMutation)(nil)
+
+// ruleOption allows management of the mutation configuration using functional options.
+type ruleOption func(*RuleMutation)
+
+// newRuleMutation creates new mutation for the Rule entity.
+func newRuleMutation(c config, op Op, opts ...ruleOption) *RuleMutation {
+	m := &RuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRuleID sets the ID field of the mutation.
+func withRuleID(id uuid.UUID) ruleOption {
+	return func(m *RuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Rule
+		)
+		m.oldValue = func(ctx context.Context) (*Rule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Rule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRule sets the old Rule of the mutation.
+func withRule(node *Rule) ruleOption {
+	return func(m *RuleMutation) {
+		m.oldValue = func(context.Context) (*Rule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Rule entities.
+func (m *RuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Rule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RuleMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RuleMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RuleMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *RuleMutation) SetFamilyID(u uuid.UUID) {
+	m.family = &u
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *RuleMutation) FamilyID() (r uuid.UUID, exists bool) {
+	v := m.family
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldFamilyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (m *RuleMutation) ClearFamilyID() {
+	m.family = nil
+	m.clearedFields[rule.FieldFamilyID] = struct{}{}
+}
+
+// FamilyIDCleared returns if the "family_id" field was cleared in this mutation.
+func (m *RuleMutation) FamilyIDCleared() bool {
+	_, ok := m.clearedFields[rule.FieldFamilyID]
+	return ok
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *RuleMutation) ResetFamilyID() {
+	m.family = nil
+	delete(m.clearedFields, rule.FieldFamilyID)
+}
+
+// SetName sets the "name" field.
+func (m *RuleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RuleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RuleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *RuleMutation) SetCategory(r rule.Category) {
+	m.category = &r
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *RuleMutation) Category() (r rule.Category, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldCategory(ctx context.Context) (v rule.Category, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *RuleMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetMinAmountCents sets the "min_amount_cents" field.
+func (m *RuleMutation) SetMinAmountCents(i int64) {
+	m.min_amount_cents = &i
+	m.addmin_amount_cents = nil
+}
+
+// MinAmountCents returns the value of the "min_amount_cents" field in the mutation.
+func (m *RuleMutation) MinAmountCents() (r int64, exists bool) {
+	v := m.min_amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMinAmountCents returns the old "min_amount_cents" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldMinAmountCents(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMinAmountCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMinAmountCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMinAmountCents: %w", err)
+	}
+	return oldValue.MinAmountCents, nil
+}
+
+// AddMinAmountCents adds i to the "min_amount_cents" field.
+func (m *RuleMutation) AddMinAmountCents(i int64) {
+	if m.addmin_amount_cents != nil {
+		*m.addmin_amount_cents += i
+	} else {
+		m.addmin_amount_cents = &i
+	}
+}
+
+// AddedMinAmountCents returns the value that was added to the "min_amount_cents" field in this mutation.
+func (m *RuleMutation) AddedMinAmountCents() (r int64, exists bool) {
+	v := m.addmin_amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMinAmountCents clears the value of the "min_amount_cents" field.
+func (m *RuleMutation) ClearMinAmountCents() {
+	m.min_amount_cents = nil
+	m.addmin_amount_cents = nil
+	m.clearedFields[rule.FieldMinAmountCents] = struct{}{}
+}
+
+// MinAmountCentsCleared returns if the "min_amount_cents" field was cleared in this mutation.
+func (m *RuleMutation) MinAmountCentsCleared() bool {
+	_, ok := m.clearedFields[rule.FieldMinAmountCents]
+	return ok
+}
+
+// ResetMinAmountCents resets all changes to the "min_amount_cents" field.
+func (m *RuleMutation) ResetMinAmountCents() {
+	m.min_amount_cents = nil
+	m.addmin_amount_cents = nil
+	delete(m.clearedFields, rule.FieldMinAmountCents)
+}
+
+// SetMaxAmountCents sets the "max_amount_cents" field.
+func (m *RuleMutation) SetMaxAmountCents(i int64) {
+	m.max_amount_cents = &i
+	m.addmax_amount_cents = nil
+}
+
+// MaxAmountCents returns the value of the "max_amount_cents" field in the mutation.
+func (m *RuleMutation) MaxAmountCents() (r int64, exists bool) {
+	v := m.max_amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxAmountCents returns the old "max_amount_cents" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldMaxAmountCents(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxAmountCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxAmountCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxAmountCents: %w", err)
+	}
+	return oldValue.MaxAmountCents, nil
+}
+
+// AddMaxAmountCents adds i to the "max_amount_cents" field.
+func (m *RuleMutation) AddMaxAmountCents(i int64) {
+	if m.addmax_amount_cents != nil {
+		*m.addmax_amount_cents += i
+	} else {
+		m.addmax_amount_cents = &i
+	}
+}
+
+// AddedMaxAmountCents returns the value that was added to the "max_amount_cents" field in this mutation.
+func (m *RuleMutation) AddedMaxAmountCents() (r int64, exists bool) {
+	v := m.addmax_amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMaxAmountCents clears the value of the "max_amount_cents" field.
+func (m *RuleMutation) ClearMaxAmountCents() {
+	m.max_amount_cents = nil
+	m.addmax_amount_cents = nil
+	m.clearedFields[rule.FieldMaxAmountCents] = struct{}{}
+}
+
+// MaxAmountCentsCleared returns if the "max_amount_cents" field was cleared in this mutation.
+func (m *RuleMutation) MaxAmountCentsCleared() bool {
+	_, ok := m.clearedFields[rule.FieldMaxAmountCents]
+	return ok
+}
+
+// ResetMaxAmountCents resets all changes to the "max_amount_cents" field.
+func (m *RuleMutation) ResetMaxAmountCents() {
+	m.max_amount_cents = nil
+	m.addmax_amount_cents = nil
+	delete(m.clearedFields, rule.FieldMaxAmountCents)
+}
+
+// SetMonthlyThresholdCents sets the "monthly_threshold_cents" field.
+func (m *RuleMutation) SetMonthlyThresholdCents(i int64) {
+	m.monthly_threshold_cents = &i
+	m.addmonthly_threshold_cents = nil
+}
+
+// MonthlyThresholdCents returns the value of the "monthly_threshold_cents" field in the mutation.
+func (m *RuleMutation) MonthlyThresholdCents() (r int64, exists bool) {
+	v := m.monthly_threshold_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMonthlyThresholdCents returns the old "monthly_threshold_cents" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldMonthlyThresholdCents(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMonthlyThresholdCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMonthlyThresholdCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMonthlyThresholdCents: %w", err)
+	}
+	return oldValue.MonthlyThresholdCents, nil
+}
+
+// AddMonthlyThresholdCents adds i to the "monthly_threshold_cents" field.
+func (m *RuleMutation) AddMonthlyThresholdCents(i int64) {
+	if m.addmonthly_threshold_cents != nil {
+		*m.addmonthly_threshold_cents += i
+	} else {
+		m.addmonthly_threshold_cents = &i
+	}
+}
+
+// AddedMonthlyThresholdCents returns the value that was added to the "monthly_threshold_cents" field in this mutation.
+func (m *RuleMutation) AddedMonthlyThresholdCents() (r int64, exists bool) {
+	v := m.addmonthly_threshold_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMonthlyThresholdCents clears the value of the "monthly_threshold_cents" field.
+func (m *RuleMutation) ClearMonthlyThresholdCents() {
+	m.monthly_threshold_cents = nil
+	m.addmonthly_threshold_cents = nil
+	m.clearedFields[rule.FieldMonthlyThresholdCents] = struct{}{}
+}
+
+// MonthlyThresholdCentsCleared returns if the "monthly_threshold_cents" field was cleared in this mutation.
+func (m *RuleMutation) MonthlyThresholdCentsCleared() bool {
+	_, ok := m.clearedFields[rule.FieldMonthlyThresholdCents]
+	return ok
+}
+
+// ResetMonthlyThresholdCents resets all changes to the "monthly_threshold_cents" field.
+func (m *RuleMutation) ResetMonthlyThresholdCents() {
+	m.monthly_threshold_cents = nil
+	m.addmonthly_threshold_cents = nil
+	delete(m.clearedFields, rule.FieldMonthlyThresholdCents)
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (m *RuleMutation) SetBudgetID(u uuid.UUID) {
+	m.budget = &u
+}
+
+// BudgetID returns the value of the "budget_id" field in the mutation.
+func (m *RuleMutation) BudgetID() (r uuid.UUID, exists bool) {
+	v := m.budget
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBudgetID returns the old "budget_id" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldBudgetID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBudgetID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBudgetID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBudgetID: %w", err)
+	}
+	return oldValue.BudgetID, nil
+}
+
+// ClearBudgetID clears the value of the "budget_id" field.
+func (m *RuleMutation) ClearBudgetID() {
+	m.budget = nil
+	m.clearedFields[rule.FieldBudgetID] = struct{}{}
+}
+
+// BudgetIDCleared returns if the "budget_id" field was cleared in this mutation.
+func (m *RuleMutation) BudgetIDCleared() bool {
+	_, ok := m.clearedFields[rule.FieldBudgetID]
+	return ok
+}
+
+// ResetBudgetID resets all changes to the "budget_id" field.
+func (m *RuleMutation) ResetBudgetID() {
+	m.budget = nil
+	delete(m.clearedFields, rule.FieldBudgetID)
+}
+
+// SetActionType sets the "action_type" field.
+func (m *RuleMutation) SetActionType(rt rule.ActionType) {
+	m.action_type = &rt
+}
+
+// ActionType returns the value of the "action_type" field in the mutation.
+func (m *RuleMutation) ActionType() (r rule.ActionType, exists bool) {
+	v := m.action_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActionType returns the old "action_type" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldActionType(ctx context.Context) (v rule.ActionType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActionType: %w", err)
+	}
+	return oldValue.ActionType, nil
+}
+
+// ResetActionType resets all changes to the "action_type" field.
+func (m *RuleMutation) ResetActionType() {
+	m.action_type = nil
+}
+
+// SetActionValue sets the "action_value" field.
+func (m *RuleMutation) SetActionValue(f float64) {
+	m.action_value = &f
+	m.addaction_value = nil
+}
+
+// ActionValue returns the value of the "action_value" field in the mutation.
+func (m *RuleMutation) ActionValue() (r float64, exists bool) {
+	v := m.action_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActionValue returns the old "action_value" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldActionValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActionValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActionValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActionValue: %w", err)
+	}
+	return oldValue.ActionValue, nil
+}
+
+// AddActionValue adds f to the "action_value" field.
+func (m *RuleMutation) AddActionValue(f float64) {
+	if m.addaction_value != nil {
+		*m.addaction_value += f
+	} else {
+		m.addaction_value = &f
+	}
+}
+
+// AddedActionValue returns the value that was added to the "action_value" field in this mutation.
+func (m *RuleMutation) AddedActionValue() (r float64, exists bool) {
+	v := m.addaction_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetActionValue resets all changes to the "action_value" field.
+func (m *RuleMutation) ResetActionValue() {
+	m.action_value = nil
+	m.addaction_value = nil
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (m *RuleMutation) SetTargetAccountID(u uuid.UUID) {
+	m.target_account = &u
+}
+
+// TargetAccountID returns the value of the "target_account_id" field in the mutation.
+func (m *RuleMutation) TargetAccountID() (r uuid.UUID, exists bool) {
+	v := m.target_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetAccountID returns the old "target_account_id" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldTargetAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetAccountID: %w", err)
+	}
+	return oldValue.TargetAccountID, nil
+}
+
+// ResetTargetAccountID resets all changes to the "target_account_id" field.
+func (m *RuleMutation) ResetTargetAccountID() {
+	m.target_account = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *RuleMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *RuleMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *RuleMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetPriority sets the "priority" field.
+func (m *RuleMutation) SetPriority(i int) {
+	m.priority = &i
+	m.addpriority = nil
+}
+
+// Priority returns the value of the "priority" field in the mutation.
+func (m *RuleMutation) Priority() (r int, exists bool) {
+	v := m.priority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPriority returns the old "priority" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldPriority(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPriority is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPriority requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPriority: %w", err)
+	}
+	return oldValue.Priority, nil
+}
+
+// AddPriority adds i to the "priority" field.
+func (m *RuleMutation) AddPriority(i int) {
+	if m.addpriority != nil {
+		*m.addpriority += i
+	} else {
+		m.addpriority = &i
+	}
+}
+
+// AddedPriority returns the value that was added to the "priority" field in this mutation.
+func (m *RuleMutation) AddedPriority() (r int, exists bool) {
+	v := m.addpriority
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPriority resets all changes to the "priority" field.
+func (m *RuleMutation) ResetPriority() {
+	m.priority = nil
+	m.addpriority = nil
+}
+
+// SetExecutionCount sets the "execution_count" field.
+func (m *RuleMutation) SetExecutionCount(i int) {
+	m.execution_count = &i
+	m.addexecution_count = nil
+}
+
+// ExecutionCount returns the value of the "execution_count" field in the mutation.
+func (m *RuleMutation) ExecutionCount() (r int, exists bool) {
+	v := m.execution_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExecutionCount returns the old "execution_count" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldExecutionCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutionCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutionCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutionCount: %w", err)
+	}
+	return oldValue.ExecutionCount, nil
+}
+
+// AddExecutionCount adds i to the "execution_count" field.
+func (m *RuleMutation) AddExecutionCount(i int) {
+	if m.addexecution_count != nil {
+		*m.addexecution_count += i
+	} else {
+		m.addexecution_count = &i
+	}
+}
+
+// AddedExecutionCount returns the value that was added to the "execution_count" field in this mutation.
+func (m *RuleMutation) AddedExecutionCount() (r int, exists bool) {
+	v := m.addexecution_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetExecutionCount resets all changes to the "execution_count" field.
+func (m *RuleMutation) ResetExecutionCount() {
+	m.execution_count = nil
+	m.addexecution_count = nil
+}
+
+// SetTotalSavedCents sets the "total_saved_cents" field.
+func (m *RuleMutation) SetTotalSavedCents(i int64) {
+	m.total_saved_cents = &i
+	m.addtotal_saved_cents = nil
+}
+
+// TotalSavedCents returns the value of the "total_saved_cents" field in the mutation.
+func (m *RuleMutation) TotalSavedCents() (r int64, exists bool) {
+	v := m.total_saved_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalSavedCents returns the old "total_saved_cents" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldTotalSavedCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalSavedCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalSavedCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalSavedCents: %w", err)
+	}
+	return oldValue.TotalSavedCents, nil
+}
+
+// AddTotalSavedCents adds i to the "total_saved_cents" field.
+func (m *RuleMutation) AddTotalSavedCents(i int64) {
+	if m.addtotal_saved_cents != nil {
+		*m.addtotal_saved_cents += i
+	} else {
+		m.addtotal_saved_cents = &i
+	}
+}
+
+// AddedTotalSavedCents returns the value that was added to the "total_saved_cents" field in this mutation.
+func (m *RuleMutation) AddedTotalSavedCents() (r int64, exists bool) {
+	v := m.addtotal_saved_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalSavedCents resets all changes to the "total_saved_cents" field.
+func (m *RuleMutation) ResetTotalSavedCents() {
+	m.total_saved_cents = nil
+	m.addtotal_saved_cents = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Rule entity.
+// If the Rule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RuleMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[rule.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RuleMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RuleMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RuleMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearTargetAccount clears the "target_account" edge to the Account entity.
+func (m *RuleMutation) ClearTargetAccount() {
+	m.clearedtarget_account = true
+	m.clearedFields[rule.FieldTargetAccountID] = struct{}{}
+}
+
+// TargetAccountCleared reports if the "target_account" edge to the Account entity was cleared.
+func (m *RuleMutation) TargetAccountCleared() bool {
+	return m.clearedtarget_account
+}
+
+// TargetAccountIDs returns the "target_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetAccountID instead. It exists only for internal usage by the builders.
+func (m *RuleMutation) TargetAccountIDs() (ids []uuid.UUID) {
+	if id := m.target_account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTargetAccount resets all changes to the "target_account" edge.
+func (m *RuleMutation) ResetTargetAccount() {
+	m.target_account = nil
+	m.clearedtarget_account = false
+}
+
+// ClearFamily clears the "family" edge to the Family entity.
+func (m *RuleMutation) ClearFamily() {
+	m.clearedfamily = true
+	m.clearedFields[rule.FieldFamilyID] = struct{}{}
+}
+
+// FamilyCleared reports if the "family" edge to the Family entity was cleared.
+func (m *RuleMutation) FamilyCleared() bool {
+	return m.FamilyIDCleared() || m.clearedfamily
+}
+
+// FamilyIDs returns the "family" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FamilyID instead. It exists only for internal usage by the builders.
+func (m *RuleMutation) FamilyIDs() (ids []uuid.UUID) {
+	if id := m.family; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFamily resets all changes to the "family" edge.
+func (m *RuleMutation) ResetFamily() {
+	m.family = nil
+	m.clearedfamily = false
+}
+
+// ClearBudget clears the "budget" edge to the Budget entity.
+func (m *RuleMutation) ClearBudget() {
+	m.clearedbudget = true
+	m.clearedFields[rule.FieldBudgetID] = struct{}{}
+}
+
+// BudgetCleared reports if the "budget" edge to the Budget entity was cleared.
+func (m *RuleMutation) BudgetCleared() bool {
+	return m.BudgetIDCleared() || m.clearedbudget
+}
+
+// BudgetIDs returns the "budget" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BudgetID instead. It exists only for internal usage by the builders.
+func (m *RuleMutation) BudgetIDs() (ids []uuid.UUID) {
+	if id := m.budget; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBudget resets all changes to the "budget" edge.
+func (m *RuleMutation) ResetBudget() {
+	m.budget = nil
+	m.clearedbudget = false
+}
+
+// AddExecutionIDs adds the "executions" edge to the RuleExecution entity by ids.
+func (m *RuleMutation) AddExecutionIDs(ids ...uuid.UUID) {
+	if m.executions == nil {
+		m.executions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.executions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExecutions clears the "executions" edge to the RuleExecution entity.
+func (m *RuleMutation) ClearExecutions() {
+	m.clearedexecutions = true
+}
+
+// ExecutionsCleared reports if the "executions" edge to the RuleExecution entity was cleared.
+func (m *RuleMutation) ExecutionsCleared() bool {
+	return m.clearedexecutions
+}
+
+// RemoveExecutionIDs removes the "executions" edge to the RuleExecution entity by IDs.
+func (m *RuleMutation) RemoveExecutionIDs(ids ...uuid.UUID) {
+	if m.removedexecutions == nil {
+		m.removedexecutions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.executions, ids[i])
+		m.removedexecutions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExecutions returns the removed IDs of the "executions" edge to the RuleExecution entity.
+func (m *RuleMutation) RemovedExecutionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedexecutions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExecutionsIDs returns the "executions" edge IDs in the mutation.
+func (m *RuleMutation) ExecutionsIDs() (ids []uuid.UUID) {
+	for id := range m.executions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExecutions resets all changes to the "executions" edge.
+func (m *RuleMutation) ResetExecutions() {
+	m.executions = nil
+	m.clearedexecutions = false
+	m.removedexecutions = nil
+}
+
+// Where appends a list predicates to the RuleMutation builder.
+func (m *RuleMutation) Where(ps ...predicate.Rule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Rule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Rule).
+func (m *RuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RuleMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.user != nil {
+		fields = append(fields, rule.FieldUserID)
+	}
+	if m.family != nil {
+		fields = append(fields, rule.FieldFamilyID)
+	}
+	if m.name != nil {
+		fields = append(fields, rule.FieldName)
+	}
+	if m.category != nil {
+		fields = append(fields, rule.FieldCategory)
+	}
+	if m.min_amount_cents != nil {
+		fields = append(fields, rule.FieldMinAmountCents)
+	}
+	if m.max_amount_cents != nil {
+		fields = append(fields, rule.FieldMaxAmountCents)
+	}
+	if m.monthly_threshold_cents != nil {
+		fields = append(fields, rule.FieldMonthlyThresholdCents)
+	}
+	if m.budget != nil {
+		fields = append(fields, rule.FieldBudgetID)
+	}
+	if m.action_type != nil {
+		fields = append(fields, rule.FieldActionType)
+	}
+	if m.action_value != nil {
+		fields = append(fields, rule.FieldActionValue)
+	}
+	if m.target_account != nil {
+		fields = append(fields, rule.FieldTargetAccountID)
+	}
+	if m.is_active != nil {
+		fields = append(fields, rule.FieldIsActive)
+	}
+	if m.priority != nil {
+		fields = append(fields, rule.FieldPriority)
+	}
+	if m.execution_count != nil {
+		fields = append(fields, rule.FieldExecutionCount)
+	}
+	if m.total_saved_cents != nil {
+		fields = append(fields, rule.FieldTotalSavedCents)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rule.FieldUserID:
+		return m.UserID()
+	case rule.FieldFamilyID:
+		return m.FamilyID()
+	case rule.FieldName:
+		return m.Name()
+	case rule.FieldCategory:
+		return m.Category()
+	case rule.FieldMinAmountCents:
+		return m.MinAmountCents()
+	case rule.FieldMaxAmountCents:
+		return m.MaxAmountCents()
+	case rule.FieldMonthlyThresholdCents:
+		return m.MonthlyThresholdCents()
+	case rule.FieldBudgetID:
+		return m.BudgetID()
+	case rule.FieldActionType:
+		return m.ActionType()
+	case rule.FieldActionValue:
+		return m.ActionValue()
+	case rule.FieldTargetAccountID:
+		return m.TargetAccountID()
+	case rule.FieldIsActive:
+		return m.IsActive()
+	case rule.FieldPriority:
+		return m.Priority()
+	case rule.FieldExecutionCount:
+		return m.ExecutionCount()
+	case rule.FieldTotalSavedCents:
+		return m.TotalSavedCents()
+	case rule.FieldCreatedAt:
+		return m.CreatedAt()
+	case rule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rule.FieldUserID:
+		return m.OldUserID(ctx)
+	case rule.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case rule.FieldName:
+		return m.OldName(ctx)
+	case rule.FieldCategory:
+		return m.OldCategory(ctx)
+	case rule.FieldMinAmountCents:
+		return m.OldMinAmountCents(ctx)
+	case rule.FieldMaxAmountCents:
+		return m.OldMaxAmountCents(ctx)
+	case rule.FieldMonthlyThresholdCents:
+		return m.OldMonthlyThresholdCents(ctx)
+	case rule.FieldBudgetID:
+		return m.OldBudgetID(ctx)
+	case rule.FieldActionType:
+		return m.OldActionType(ctx)
+	case rule.FieldActionValue:
+		return m.OldActionValue(ctx)
+	case rule.FieldTargetAccountID:
+		return m.OldTargetAccountID(ctx)
+	case rule.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case rule.FieldPriority:
+		return m.OldPriority(ctx)
+	case rule.FieldExecutionCount:
+		return m.OldExecutionCount(ctx)
+	case rule.FieldTotalSavedCents:
+		return m.OldTotalSavedCents(ctx)
+	case rule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Rule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rule.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case rule.FieldFamilyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case rule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case rule.FieldCategory:
+		v, ok := value.(rule.Category)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case rule.FieldMinAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMinAmountCents(v)
+		return nil
+	case rule.FieldMaxAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxAmountCents(v)
+		return nil
+	case rule.FieldMonthlyThresholdCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMonthlyThresholdCents(v)
+		return nil
+	case rule.FieldBudgetID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBudgetID(v)
+		return nil
+	case rule.FieldActionType:
+		v, ok := value.(rule.ActionType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActionType(v)
+		return nil
+	case rule.FieldActionValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActionValue(v)
+		return nil
+	case rule.FieldTargetAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetAccountID(v)
+		return nil
+	case rule.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case rule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPriority(v)
+		return nil
+	case rule.FieldExecutionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutionCount(v)
+		return nil
+	case rule.FieldTotalSavedCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalSavedCents(v)
+		return nil
+	case rule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addmin_amount_cents != nil {
+		fields = append(fields, rule.FieldMinAmountCents)
+	}
+	if m.addmax_amount_cents != nil {
+		fields = append(fields, rule.FieldMaxAmountCents)
+	}
+	if m.addmonthly_threshold_cents != nil {
+		fields = append(fields, rule.FieldMonthlyThresholdCents)
+	}
+	if m.addaction_value != nil {
+		fields = append(fields, rule.FieldActionValue)
+	}
+	if m.addpriority != nil {
+		fields = append(fields, rule.FieldPriority)
+	}
+	if m.addexecution_count != nil {
+		fields = append(fields, rule.FieldExecutionCount)
+	}
+	if m.addtotal_saved_cents != nil {
+		fields = append(fields, rule.FieldTotalSavedCents)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rule.FieldMinAmountCents:
+		return m.AddedMinAmountCents()
+	case rule.FieldMaxAmountCents:
+		return m.AddedMaxAmountCents()
+	case rule.FieldMonthlyThresholdCents:
+		return m.AddedMonthlyThresholdCents()
+	case rule.FieldActionValue:
+		return m.AddedActionValue()
+	case rule.FieldPriority:
+		return m.AddedPriority()
+	case rule.FieldExecutionCount:
+		return m.AddedExecutionCount()
+	case rule.FieldTotalSavedCents:
+		return m.AddedTotalSavedCents()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rule.FieldMinAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMinAmountCents(v)
+		return nil
+	case rule.FieldMaxAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxAmountCents(v)
+		return nil
+	case rule.FieldMonthlyThresholdCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMonthlyThresholdCents(v)
+		return nil
+	case rule.FieldActionValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddActionValue(v)
+		return nil
+	case rule.FieldPriority:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPriority(v)
+		return nil
+	case rule.FieldExecutionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExecutionCount(v)
+		return nil
+	case rule.FieldTotalSavedCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalSavedCents(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Rule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rule.FieldFamilyID) {
+		fields = append(fields, rule.FieldFamilyID)
+	}
+	if m.FieldCleared(rule.FieldMinAmountCents) {
+		fields = append(fields, rule.FieldMinAmountCents)
+	}
+	if m.FieldCleared(rule.FieldMaxAmountCents) {
+		fields = append(fields, rule.FieldMaxAmountCents)
+	}
+	if m.FieldCleared(rule.FieldMonthlyThresholdCents) {
+		fields = append(fields, rule.FieldMonthlyThresholdCents)
+	}
+	if m.FieldCleared(rule.FieldBudgetID) {
+		fields = append(fields, rule.FieldBudgetID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RuleMutation) ClearField(name string) error {
+	switch name {
+	case rule.FieldFamilyID:
+		m.ClearFamilyID()
+		return nil
+	case rule.FieldMinAmountCents:
+		m.ClearMinAmountCents()
+		return nil
+	case rule.FieldMaxAmountCents:
+		m.ClearMaxAmountCents()
+		return nil
+	case rule.FieldMonthlyThresholdCents:
+		m.ClearMonthlyThresholdCents()
+		return nil
+	case rule.FieldBudgetID:
+		m.ClearBudgetID()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RuleMutation) ResetField(name string) error {
+	switch name {
+	case rule.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case rule.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case rule.FieldName:
+		m.ResetName()
+		return nil
+	case rule.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case rule.FieldMinAmountCents:
+		m.ResetMinAmountCents()
+		return nil
+	case rule.FieldMaxAmountCents:
+		m.ResetMaxAmountCents()
+		return nil
+	case rule.FieldMonthlyThresholdCents:
+		m.ResetMonthlyThresholdCents()
+		return nil
+	case rule.FieldBudgetID:
+		m.ResetBudgetID()
+		return nil
+	case rule.FieldActionType:
+		m.ResetActionType()
+		return nil
+	case rule.FieldActionValue:
+		m.ResetActionValue()
+		return nil
+	case rule.FieldTargetAccountID:
+		m.ResetTargetAccountID()
+		return nil
+	case rule.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case rule.FieldPriority:
+		m.ResetPriority()
+		return nil
+	case rule.FieldExecutionCount:
+		m.ResetExecutionCount()
+		return nil
+	case rule.FieldTotalSavedCents:
+		m.ResetTotalSavedCents()
+		return nil
+	case rule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.user != nil {
+		edges = append(edges, rule.EdgeUser)
+	}
+	if m.target_account != nil {
+		edges = append(edges, rule.EdgeTargetAccount)
+	}
+	if m.family != nil {
+		edges = append(edges, rule.EdgeFamily)
+	}
+	if m.budget != nil {
+		edges = append(edges, rule.EdgeBudget)
+	}
+	if m.executions != nil {
+		edges = append(edges, rule.EdgeExecutions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rule.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case rule.EdgeTargetAccount:
+		if id := m.target_account; id != nil {
+			return []ent.Value{*id}
+		}
+	case rule.EdgeFamily:
+		if id := m.family; id != nil {
+			return []ent.Value{*id}
+		}
+	case rule.EdgeBudget:
+		if id := m.budget; id != nil {
+			return []ent.Value{*id}
+		}
+	case rule.EdgeExecutions:
+		ids := make([]ent.Value, 0, len(m.executions))
+		for id := range m.executions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedexecutions != nil {
+		edges = append(edges, rule.EdgeExecutions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RuleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case rule.EdgeExecutions:
+		ids := make([]ent.Value, 0, len(m.removedexecutions))
+		for id := range m.removedexecutions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.cleareduser {
+		edges = append(edges, rule.EdgeUser)
+	}
+	if m.clearedtarget_account {
+		edges = append(edges, rule.EdgeTargetAccount)
+	}
+	if m.clearedfamily {
+		edges = append(edges, rule.EdgeFamily)
+	}
+	if m.clearedbudget {
+		edges = append(edges, rule.EdgeBudget)
+	}
+	if m.clearedexecutions {
+		edges = append(edges, rule.EdgeExecutions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rule.EdgeUser:
+		return m.cleareduser
+	case rule.EdgeTargetAccount:
+		return m.clearedtarget_account
+	case rule.EdgeFamily:
+		return m.clearedfamily
+	case rule.EdgeBudget:
+		return m.clearedbudget
+	case rule.EdgeExecutions:
+		return m.clearedexecutions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RuleMutation) ClearEdge(name string) error {
+	switch name {
+	case rule.EdgeUser:
+		m.ClearUser()
+		return nil
+	case rule.EdgeTargetAccount:
+		m.ClearTargetAccount()
+		return nil
+	case rule.EdgeFamily:
+		m.ClearFamily()
+		return nil
+	case rule.EdgeBudget:
+		m.ClearBudget()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RuleMutation) ResetEdge(name string) error {
+	switch name {
+	case rule.EdgeUser:
+		m.ResetUser()
+		return nil
+	case rule.EdgeTargetAccount:
+		m.ResetTargetAccount()
+		return nil
+	case rule.EdgeFamily:
+		m.ResetFamily()
+		return nil
+	case rule.EdgeBudget:
+		m.ResetBudget()
+		return nil
+	case rule.EdgeExecutions:
+		m.ResetExecutions()
+		return nil
+	}
+	return fmt.Errorf("unknown Rule edge %s", name)
+}
+
+// RuleExecutionMutation represents an operation that mutates the RuleExecution nodes in the graph.
+type RuleExecutionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	amount_cents       *int64
+	addamount_cents    *int64
+	source_account_id  *uuid.UUID
+	target_account_id  *uuid.UUID
+	status             *ruleexecution.Status
+	error_message      *string
+	created_at         *time.Time
+	completed_at       *time.Time
+	clearedFields      map[string]struct{}
+	rule               *uuid.UUID
+	clearedrule        bool
+	transaction        *uuid.UUID
+	clearedtransaction bool
+	user               *uuid.UUID
+	cleareduser        bool
+	family             *uuid.UUID
+	clearedfamily      bool
+	transfer           *uuid.UUID
+	clearedtransfer    bool
+	done               bool
+	oldValue           func(context.Context) (*RuleExecution, error)
+	predicates         []predicate.RuleExecution
+}
+
+var _ ent.Mutation = (*RuleExecutionMutation)(nil)
+
+// ruleexecutionOption allows management of the mutation configuration using functional options.
+type ruleexecutionOption func(*RuleExecutionMutation)
+
+// newRuleExecutionMutation creates new mutation for the RuleExecution entity.
+func newRuleExecutionMutation(c config, op Op, opts ...ruleexecutionOption) *RuleExecutionMutation {
+	m := &RuleExecutionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRuleExecution,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRuleExecutionID sets the ID field of the mutation.
+func withRuleExecutionID(id uuid.UUID) ruleexecutionOption {
+	return func(m *RuleExecutionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RuleExecution
+		)
+		m.oldValue = func(ctx context.Context) (*RuleExecution, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RuleExecution.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRuleExecution sets the old RuleExecution of the mutation.
+func withRuleExecution(node *RuleExecution) ruleexecutionOption {
+	return func(m *RuleExecutionMutation) {
+		m.oldValue = func(context.Context) (*RuleExecution, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RuleExecutionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RuleExecutionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RuleExecution entities.
+func (m *RuleExecutionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RuleExecutionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RuleExecutionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RuleExecution.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRuleID sets the "rule_id" field.
+func (m *RuleExecutionMutation) SetRuleID(u uuid.UUID) {
+	m.rule = &u
+}
+
+// RuleID returns the value of the "rule_id" field in the mutation.
+func (m *RuleExecutionMutation) RuleID() (r uuid.UUID, exists bool) {
+	v := m.rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleID returns the old "rule_id" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldRuleID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleID: %w", err)
+	}
+	return oldValue.RuleID, nil
+}
+
+// ResetRuleID resets all changes to the "rule_id" field.
+func (m *RuleExecutionMutation) ResetRuleID() {
+	m.rule = nil
+}
+
+// SetTransactionID sets the "transaction_id" field.
+func (m *RuleExecutionMutation) SetTransactionID(u uuid.UUID) {
+	m.transaction = &u
+}
+
+// TransactionID returns the value of the "transaction_id" field in the mutation.
+func (m *RuleExecutionMutation) TransactionID() (r uuid.UUID, exists bool) {
+	v := m.transaction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransactionID returns the old "transaction_id" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldTransactionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransactionID: %w", err)
+	}
+	return oldValue.TransactionID, nil
+}
+
+// ResetTransactionID resets all changes to the "transaction_id" field.
+func (m *RuleExecutionMutation) ResetTransactionID() {
+	m.transaction = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RuleExecutionMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RuleExecutionMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RuleExecutionMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *RuleExecutionMutation) SetFamilyID(u uuid.UUID) {
+	m.family = &u
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *RuleExecutionMutation) FamilyID() (r uuid.UUID, exists bool) {
+	v := m.family
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldFamilyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (m *RuleExecutionMutation) ClearFamilyID() {
+	m.family = nil
+	m.clearedFields[ruleexecution.FieldFamilyID] = struct{}{}
+}
+
+// FamilyIDCleared returns if the "family_id" field was cleared in this mutation.
+func (m *RuleExecutionMutation) FamilyIDCleared() bool {
+	_, ok := m.clearedFields[ruleexecution.FieldFamilyID]
+	return ok
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *RuleExecutionMutation) ResetFamilyID() {
+	m.family = nil
+	delete(m.clearedFields, ruleexecution.FieldFamilyID)
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (m *RuleExecutionMutation) SetAmountCents(i int64) {
+	m.amount_cents = &i
+	m.addamount_cents = nil
+}
+
+// AmountCents returns the value of the "amount_cents" field in the mutation.
+func (m *RuleExecutionMutation) AmountCents() (r int64, exists bool) {
+	v := m.amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmountCents returns the old "amount_cents" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldAmountCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmountCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmountCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmountCents: %w", err)
+	}
+	return oldValue.AmountCents, nil
+}
+
+// AddAmountCents adds i to the "amount_cents" field.
+func (m *RuleExecutionMutation) AddAmountCents(i int64) {
+	if m.addamount_cents != nil {
+		*m.addamount_cents += i
+	} else {
+		m.addamount_cents = &i
+	}
+}
+
+// AddedAmountCents returns the value that was added to the "amount_cents" field in this mutation.
+func (m *RuleExecutionMutation) AddedAmountCents() (r int64, exists bool) {
+	v := m.addamount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmountCents resets all changes to the "amount_cents" field.
+func (m *RuleExecutionMutation) ResetAmountCents() {
+	m.amount_cents = nil
+	m.addamount_cents = nil
+}
+
+// SetSourceAccountID sets the "source_account_id" field.
+func (m *RuleExecutionMutation) SetSourceAccountID(u uuid.UUID) {
+	m.source_account_id = &u
+}
+
+// SourceAccountID returns the value of the "source_account_id" field in the mutation.
+func (m *RuleExecutionMutation) SourceAccountID() (r uuid.UUID, exists bool) {
+	v := m.source_account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceAccountID returns the old "source_account_id" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldSourceAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceAccountID: %w", err)
+	}
+	return oldValue.SourceAccountID, nil
+}
+
+// ResetSourceAccountID resets all changes to the "source_account_id" field.
+func (m *RuleExecutionMutation) ResetSourceAccountID() {
+	m.source_account_id = nil
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (m *RuleExecutionMutation) SetTargetAccountID(u uuid.UUID) {
+	m.target_account_id = &u
+}
+
+// TargetAccountID returns the value of the "target_account_id" field in the mutation.
+func (m *RuleExecutionMutation) TargetAccountID() (r uuid.UUID, exists bool) {
+	v := m.target_account_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetAccountID returns the old "target_account_id" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldTargetAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetAccountID: %w", err)
+	}
+	return oldValue.TargetAccountID, nil
+}
+
+// ResetTargetAccountID resets all changes to the "target_account_id" field.
+func (m *RuleExecutionMutation) ResetTargetAccountID() {
+	m.target_account_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RuleExecutionMutation) SetStatus(r ruleexecution.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RuleExecutionMutation) Status() (r ruleexecution.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldStatus(ctx context.Context) (v ruleexecution.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RuleExecutionMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *RuleExecutionMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *RuleExecutionMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *RuleExecutionMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[ruleexecution.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *RuleExecutionMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[ruleexecution.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *RuleExecutionMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, ruleexecution.FieldErrorMessage)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RuleExecutionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RuleExecutionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RuleExecutionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *RuleExecutionMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *RuleExecutionMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the RuleExecution entity.
+// If the RuleExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleExecutionMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *RuleExecutionMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[ruleexecution.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *RuleExecutionMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[ruleexecution.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *RuleExecutionMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, ruleexecution.FieldCompletedAt)
+}
+
+// ClearRule clears the "rule" edge to the Rule entity.
+func (m *RuleExecutionMutation) ClearRule() {
+	m.clearedrule = true
+	m.clearedFields[ruleexecution.FieldRuleID] = struct{}{}
+}
+
+// RuleCleared reports if the "rule" edge to the Rule entity was cleared.
+func (m *RuleExecutionMutation) RuleCleared() bool {
+	return m.clearedrule
+}
+
+// RuleIDs returns the "rule" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RuleID instead. It exists only for internal usage by the builders.
+func (m *RuleExecutionMutation) RuleIDs() (ids []uuid.UUID) {
+	if id := m.rule; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRule resets all changes to the "rule" edge.
+func (m *RuleExecutionMutation) ResetRule() {
+	m.rule = nil
+	m.clearedrule = false
+}
+
+// ClearTransaction clears the "transaction" edge to the Transaction entity.
+func (m *RuleExecutionMutation) ClearTransaction() {
+	m.clearedtransaction = true
+	m.clearedFields[ruleexecution.FieldTransactionID] = struct{}{}
+}
+
+// TransactionCleared reports if the "transaction" edge to the Transaction entity was cleared.
+func (m *RuleExecutionMutation) TransactionCleared() bool {
+	return m.clearedtransaction
+}
+
+// TransactionIDs returns the "transaction" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransactionID instead. It exists only for internal usage by the builders.
+func (m *RuleExecutionMutation) TransactionIDs() (ids []uuid.UUID) {
+	if id := m.transaction; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransaction resets all changes to the "transaction" edge.
+func (m *RuleExecutionMutation) ResetTransaction() {
+	m.transaction = nil
+	m.clearedtransaction = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RuleExecutionMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[ruleexecution.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RuleExecutionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RuleExecutionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RuleExecutionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearFamily clears the "family" edge to the Family entity.
+func (m *RuleExecutionMutation) ClearFamily() {
+	m.clearedfamily = true
+	m.clearedFields[ruleexecution.FieldFamilyID] = struct{}{}
+}
+
+// FamilyCleared reports if the "family" edge to the Family entity was cleared.
+func (m *RuleExecutionMutation) FamilyCleared() bool {
+	return m.FamilyIDCleared() || m.clearedfamily
+}
+
+// FamilyIDs returns the "family" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FamilyID instead. It exists only for internal usage by the builders.
+func (m *RuleExecutionMutation) FamilyIDs() (ids []uuid.UUID) {
+	if id := m.family; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFamily resets all changes to the "family" edge.
+func (m *RuleExecutionMutation) ResetFamily() {
+	m.family = nil
+	m.clearedfamily = false
+}
+
+// SetTransferID sets the "transfer" edge to the SavingsTransfer entity by id.
+func (m *RuleExecutionMutation) SetTransferID(id uuid.UUID) {
+	m.transfer = &id
+}
+
+// ClearTransfer clears the "transfer" edge to the SavingsTransfer entity.
+func (m *RuleExecutionMutation) ClearTransfer() {
+	m.clearedtransfer = true
+}
+
+// TransferCleared reports if the "transfer" edge to the SavingsTransfer entity was cleared.
+func (m *RuleExecutionMutation) TransferCleared() bool {
+	return m.clearedtransfer
+}
+
+// TransferID returns the "transfer" edge ID in the mutation.
+func (m *RuleExecutionMutation) TransferID() (id uuid.UUID, exists bool) {
+	if m.transfer != nil {
+		return *m.transfer, true
+	}
+	return
+}
+
+// TransferIDs returns the "transfer" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TransferID instead. It exists only for internal usage by the builders.
+func (m *RuleExecutionMutation) TransferIDs() (ids []uuid.UUID) {
+	if id := m.transfer; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTransfer resets all changes to the "transfer" edge.
+func (m *RuleExecutionMutation) ResetTransfer() {
+	m.transfer = nil
+	m.clearedtransfer = false
+}
+
+// Where appends a list predicates to the RuleExecutionMutation builder.
+func (m *RuleExecutionMutation) Where(ps ...predicate.RuleExecution) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RuleExecutionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RuleExecutionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RuleExecution, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RuleExecutionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RuleExecutionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RuleExecution).
+func (m *RuleExecutionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RuleExecutionMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.rule != nil {
+		fields = append(fields, ruleexecution.FieldRuleID)
+	}
+	if m.transaction != nil {
+		fields = append(fields, ruleexecution.FieldTransactionID)
+	}
+	if m.user != nil {
+		fields = append(fields, ruleexecution.FieldUserID)
+	}
+	if m.family != nil {
+		fields = append(fields, ruleexecution.FieldFamilyID)
+	}
+	if m.amount_cents != nil {
+		fields = append(fields, ruleexecution.FieldAmountCents)
+	}
+	if m.source_account_id != nil {
+		fields = append(fields, ruleexecution.FieldSourceAccountID)
+	}
+	if m.target_account_id != nil {
+		fields = append(fields, ruleexecution.FieldTargetAccountID)
+	}
+	if m.status != nil {
+		fields = append(fields, ruleexecution.FieldStatus)
+	}
+	if m.error_message != nil {
+		fields = append(fields, ruleexecution.FieldErrorMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ruleexecution.FieldCreatedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, ruleexecution.FieldCompletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RuleExecutionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ruleexecution.FieldRuleID:
+		return m.RuleID()
+	case ruleexecution.FieldTransactionID:
+		return m.TransactionID()
+	case ruleexecution.FieldUserID:
+		return m.UserID()
+	case ruleexecution.FieldFamilyID:
+		return m.FamilyID()
+	case ruleexecution.FieldAmountCents:
+		return m.AmountCents()
+	case ruleexecution.FieldSourceAccountID:
+		return m.SourceAccountID()
+	case ruleexecution.FieldTargetAccountID:
+		return m.TargetAccountID()
+	case ruleexecution.FieldStatus:
+		return m.Status()
+	case ruleexecution.FieldErrorMessage:
+		return m.ErrorMessage()
+	case ruleexecution.FieldCreatedAt:
+		return m.CreatedAt()
+	case ruleexecution.FieldCompletedAt:
+		return m.CompletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RuleExecutionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ruleexecution.FieldRuleID:
+		return m.OldRuleID(ctx)
+	case ruleexecution.FieldTransactionID:
+		return m.OldTransactionID(ctx)
+	case ruleexecution.FieldUserID:
+		return m.OldUserID(ctx)
+	case ruleexecution.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case ruleexecution.FieldAmountCents:
+		return m.OldAmountCents(ctx)
+	case ruleexecution.FieldSourceAccountID:
+		return m.OldSourceAccountID(ctx)
+	case ruleexecution.FieldTargetAccountID:
+		return m.OldTargetAccountID(ctx)
+	case ruleexecution.FieldStatus:
+		return m.OldStatus(ctx)
+	case ruleexecution.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case ruleexecution.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ruleexecution.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RuleExecution field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RuleExecutionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ruleexecution.FieldRuleID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleID(v)
+		return nil
+	case ruleexecution.FieldTransactionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransactionID(v)
+		return nil
+	case ruleexecution.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case ruleexecution.FieldFamilyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case ruleexecution.FieldAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountCents(v)
+		return nil
+	case ruleexecution.FieldSourceAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceAccountID(v)
+		return nil
+	case ruleexecution.FieldTargetAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetAccountID(v)
+		return nil
+	case ruleexecution.FieldStatus:
+		v, ok := value.(ruleexecution.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case ruleexecution.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case ruleexecution.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ruleexecution.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RuleExecution field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RuleExecutionMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount_cents != nil {
+		fields = append(fields, ruleexecution.FieldAmountCents)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RuleExecutionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ruleexecution.FieldAmountCents:
+		return m.AddedAmountCents()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RuleExecutionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ruleexecution.FieldAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountCents(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RuleExecution numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RuleExecutionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ruleexecution.FieldFamilyID) {
+		fields = append(fields, ruleexecution.FieldFamilyID)
+	}
+	if m.FieldCleared(ruleexecution.FieldErrorMessage) {
+		fields = append(fields, ruleexecution.FieldErrorMessage)
+	}
+	if m.FieldCleared(ruleexecution.FieldCompletedAt) {
+		fields = append(fields, ruleexecution.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RuleExecutionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RuleExecutionMutation) ClearField(name string) error {
+	switch name {
+	case ruleexecution.FieldFamilyID:
+		m.ClearFamilyID()
+		return nil
+	case ruleexecution.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case ruleexecution.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RuleExecution nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RuleExecutionMutation) ResetField(name string) error {
+	switch name {
+	case ruleexecution.FieldRuleID:
+		m.ResetRuleID()
+		return nil
+	case ruleexecution.FieldTransactionID:
+		m.ResetTransactionID()
+		return nil
+	case ruleexecution.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case ruleexecution.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case ruleexecution.FieldAmountCents:
+		m.ResetAmountCents()
+		return nil
+	case ruleexecution.FieldSourceAccountID:
+		m.ResetSourceAccountID()
+		return nil
+	case ruleexecution.FieldTargetAccountID:
+		m.ResetTargetAccountID()
+		return nil
+	case ruleexecution.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case ruleexecution.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case ruleexecution.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ruleexecution.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RuleExecution field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RuleExecutionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.rule != nil {
+		edges = append(edges, ruleexecution.EdgeRule)
+	}
+	if m.transaction != nil {
+		edges = append(edges, ruleexecution.EdgeTransaction)
+	}
+	if m.user != nil {
+		edges = append(edges, ruleexecution.EdgeUser)
+	}
+	if m.family != nil {
+		edges = append(edges, ruleexecution.EdgeFamily)
+	}
+	if m.transfer != nil {
+		edges = append(edges, ruleexecution.EdgeTransfer)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RuleExecutionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ruleexecution.EdgeRule:
+		if id := m.rule; id != nil {
+			return []ent.Value{*id}
+		}
+	case ruleexecution.EdgeTransaction:
+		if id := m.transaction; id != nil {
+			return []ent.Value{*id}
+		}
+	case ruleexecution.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case ruleexecution.EdgeFamily:
+		if id := m.family; id != nil {
+			return []ent.Value{*id}
+		}
+	case ruleexecution.EdgeTransfer:
+		if id := m.transfer; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RuleExecutionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RuleExecutionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RuleExecutionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedrule {
+		edges = append(edges, ruleexecution.EdgeRule)
+	}
+	if m.clearedtransaction {
+		edges = append(edges, ruleexecution.EdgeTransaction)
+	}
+	if m.cleareduser {
+		edges = append(edges, ruleexecution.EdgeUser)
+	}
+	if m.clearedfamily {
+		edges = append(edges, ruleexecution.EdgeFamily)
+	}
+	if m.clearedtransfer {
+		edges = append(edges, ruleexecution.EdgeTransfer)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RuleExecutionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ruleexecution.EdgeRule:
+		return m.clearedrule
+	case ruleexecution.EdgeTransaction:
+		return m.clearedtransaction
+	case ruleexecution.EdgeUser:
+		return m.cleareduser
+	case ruleexecution.EdgeFamily:
+		return m.clearedfamily
+	case ruleexecution.EdgeTransfer:
+		return m.clearedtransfer
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RuleExecutionMutation) ClearEdge(name string) error {
+	switch name {
+	case ruleexecution.EdgeRule:
+		m.ClearRule()
+		return nil
+	case ruleexecution.EdgeTransaction:
+		m.ClearTransaction()
+		return nil
+	case ruleexecution.EdgeUser:
+		m.ClearUser()
+		return nil
+	case ruleexecution.EdgeFamily:
+		m.ClearFamily()
+		return nil
+	case ruleexecution.EdgeTransfer:
+		m.ClearTransfer()
+		return nil
+	}
+	return fmt.Errorf("unknown RuleExecution unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RuleExecutionMutation) ResetEdge(name string) error {
+	switch name {
+	case ruleexecution.EdgeRule:
+		m.ResetRule()
+		return nil
+	case ruleexecution.EdgeTransaction:
+		m.ResetTransaction()
+		return nil
+	case ruleexecution.EdgeUser:
+		m.ResetUser()
+		return nil
+	case ruleexecution.EdgeFamily:
+		m.ResetFamily()
+		return nil
+	case ruleexecution.EdgeTransfer:
+		m.ResetTransfer()
+		return nil
+	}
+	return fmt.Errorf("unknown RuleExecution edge %s", name)
+}
+
+// SavingsTransferMutation represents an operation that mutates the SavingsTransfer nodes in the graph.
+type SavingsTransferMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	amount_cents          *int64
+	addamount_cents       *int64
+	currency              *string
+	status                *savingstransfer.Status
+	external_transfer_id  *string
+	error_message         *string
+	created_at            *time.Time
+	executed_at           *time.Time
+	clearedFields         map[string]struct{}
+	rule_execution        *uuid.UUID
+	clearedrule_execution bool
+	user                  *uuid.UUID
+	cleareduser           bool
+	source_account        *uuid.UUID
+	clearedsource_account bool
+	target_account        *uuid.UUID
+	clearedtarget_account bool
+	done                  bool
+	oldValue              func(context.Context) (*SavingsTransfer, error)
+	predicates            []predicate.SavingsTransfer
+}
+
+var _ ent.Mutation = (*SavingsTransferMutation)(nil)
+
+// savingstransferOption allows management of the mutation configuration using functional options.
+type savingstransferOption func(*SavingsTransferMutation)
+
+// newSavingsTransferMutation creates new mutation for the SavingsTransfer entity.
+func newSavingsTransferMutation(c config, op Op, opts ...savingstransferOption) *SavingsTransferMutation {
+	m := &SavingsTransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSavingsTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSavingsTransferID sets the ID field of the mutation.
+func withSavingsTransferID(id uuid.UUID) savingstransferOption {
+	return func(m *SavingsTransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SavingsTransfer
+		)
+		m.oldValue = func(ctx context.Context) (*SavingsTransfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SavingsTransfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSavingsTransfer sets the old SavingsTransfer of the mutation.
+func withSavingsTransfer(node *SavingsTransfer) savingstransferOption {
+	return func(m *SavingsTransferMutation) {
+		m.oldValue = func(context.Context) (*SavingsTransfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SavingsTransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SavingsTransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SavingsTransfer entities.
+func (m *SavingsTransferMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SavingsTransferMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SavingsTransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SavingsTransfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRuleExecutionID sets the "rule_execution_id" field.
+func (m *SavingsTransferMutation) SetRuleExecutionID(u uuid.UUID) {
+	m.rule_execution = &u
+}
+
+// RuleExecutionID returns the value of the "rule_execution_id" field in the mutation.
+func (m *SavingsTransferMutation) RuleExecutionID() (r uuid.UUID, exists bool) {
+	v := m.rule_execution
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleExecutionID returns the old "rule_execution_id" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldRuleExecutionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleExecutionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleExecutionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleExecutionID: %w", err)
+	}
+	return oldValue.RuleExecutionID, nil
+}
+
+// ResetRuleExecutionID resets all changes to the "rule_execution_id" field.
+func (m *SavingsTransferMutation) ResetRuleExecutionID() {
+	m.rule_execution = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SavingsTransferMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SavingsTransferMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SavingsTransferMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetSourceAccountID sets the "source_account_id" field.
+func (m *SavingsTransferMutation) SetSourceAccountID(u uuid.UUID) {
+	m.source_account = &u
+}
+
+// SourceAccountID returns the value of the "source_account_id" field in the mutation.
+func (m *SavingsTransferMutation) SourceAccountID() (r uuid.UUID, exists bool) {
+	v := m.source_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSourceAccountID returns the old "source_account_id" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldSourceAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceAccountID: %w", err)
+	}
+	return oldValue.SourceAccountID, nil
+}
+
+// ResetSourceAccountID resets all changes to the "source_account_id" field.
+func (m *SavingsTransferMutation) ResetSourceAccountID() {
+	m.source_account = nil
+}
+
+// SetTargetAccountID sets the "target_account_id" field.
+func (m *SavingsTransferMutation) SetTargetAccountID(u uuid.UUID) {
+	m.target_account = &u
+}
+
+// TargetAccountID returns the value of the "target_account_id" field in the mutation.
+func (m *SavingsTransferMutation) TargetAccountID() (r uuid.UUID, exists bool) {
+	v := m.target_account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTargetAccountID returns the old "target_account_id" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldTargetAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetAccountID: %w", err)
+	}
+	return oldValue.TargetAccountID, nil
+}
+
+// ResetTargetAccountID resets all changes to the "target_account_id" field.
+func (m *SavingsTransferMutation) ResetTargetAccountID() {
+	m.target_account = nil
+}
+
+// SetAmountCents sets the "amount_cents" field.
+func (m *SavingsTransferMutation) SetAmountCents(i int64) {
+	m.amount_cents = &i
+	m.addamount_cents = nil
+}
+
+// AmountCents returns the value of the "amount_cents" field in the mutation.
+func (m *SavingsTransferMutation) AmountCents() (r int64, exists bool) {
+	v := m.amount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmountCents returns the old "amount_cents" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldAmountCents(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmountCents is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmountCents requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmountCents: %w", err)
+	}
+	return oldValue.AmountCents, nil
+}
+
+// AddAmountCents adds i to the "amount_cents" field.
+func (m *SavingsTransferMutation) AddAmountCents(i int64) {
+	if m.addamount_cents != nil {
+		*m.addamount_cents += i
+	} else {
+		m.addamount_cents = &i
+	}
+}
+
+// AddedAmountCents returns the value that was added to the "amount_cents" field in this mutation.
+func (m *SavingsTransferMutation) AddedAmountCents() (r int64, exists bool) {
+	v := m.addamount_cents
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmountCents resets all changes to the "amount_cents" field.
+func (m *SavingsTransferMutation) ResetAmountCents() {
+	m.amount_cents = nil
+	m.addamount_cents = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *SavingsTransferMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *SavingsTransferMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *SavingsTransferMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SavingsTransferMutation) SetStatus(s savingstransfer.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SavingsTransferMutation) Status() (r savingstransfer.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldStatus(ctx context.Context) (v savingstransfer.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SavingsTransferMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExternalTransferID sets the "external_transfer_id" field.
+func (m *SavingsTransferMutation) SetExternalTransferID(s string) {
+	m.external_transfer_id = &s
+}
+
+// ExternalTransferID returns the value of the "external_transfer_id" field in the mutation.
+func (m *SavingsTransferMutation) ExternalTransferID() (r string, exists bool) {
+	v := m.external_transfer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExternalTransferID returns the old "external_transfer_id" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldExternalTransferID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExternalTransferID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExternalTransferID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExternalTransferID: %w", err)
+	}
+	return oldValue.ExternalTransferID, nil
+}
+
+// ClearExternalTransferID clears the value of the "external_transfer_id" field.
+func (m *SavingsTransferMutation) ClearExternalTransferID() {
+	m.external_transfer_id = nil
+	m.clearedFields[savingstransfer.FieldExternalTransferID] = struct{}{}
+}
+
+// ExternalTransferIDCleared returns if the "external_transfer_id" field was cleared in this mutation.
+func (m *SavingsTransferMutation) ExternalTransferIDCleared() bool {
+	_, ok := m.clearedFields[savingstransfer.FieldExternalTransferID]
+	return ok
+}
+
+// ResetExternalTransferID resets all changes to the "external_transfer_id" field.
+func (m *SavingsTransferMutation) ResetExternalTransferID() {
+	m.external_transfer_id = nil
+	delete(m.clearedFields, savingstransfer.FieldExternalTransferID)
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (m *SavingsTransferMutation) SetErrorMessage(s string) {
+	m.error_message = &s
+}
+
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *SavingsTransferMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrorMessage returns the old "error_message" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *SavingsTransferMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[savingstransfer.FieldErrorMessage] = struct{}{}
+}
+
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *SavingsTransferMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[savingstransfer.FieldErrorMessage]
+	return ok
+}
+
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *SavingsTransferMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, savingstransfer.FieldErrorMessage)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SavingsTransferMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SavingsTransferMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SavingsTransferMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (m *SavingsTransferMutation) SetExecutedAt(t time.Time) {
+	m.executed_at = &t
+}
+
+// ExecutedAt returns the value of the "executed_at" field in the mutation.
+func (m *SavingsTransferMutation) ExecutedAt() (r time.Time, exists bool) {
+	v := m.executed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExecutedAt returns the old "executed_at" field's value of the SavingsTransfer entity.
+// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SavingsTransferMutation) OldExecutedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutedAt: %w", err)
+	}
+	return oldValue.ExecutedAt, nil
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (m *SavingsTransferMutation) ClearExecutedAt() {
+	m.executed_at = nil
+	m.clearedFields[savingstransfer.FieldExecutedAt] = struct{}{}
+}
+
+// ExecutedAtCleared returns if the "executed_at" field was cleared in this mutation.
+func (m *SavingsTransferMutation) ExecutedAtCleared() bool {
+	_, ok := m.clearedFields[savingstransfer.FieldExecutedAt]
+	return ok
+}
+
+// ResetExecutedAt resets all changes to the "executed_at" field.
+func (m *SavingsTransferMutation) ResetExecutedAt() {
+	m.executed_at = nil
+	delete(m.clearedFields, savingstransfer.FieldExecutedAt)
+}
+
+// ClearRuleExecution clears the "rule_execution" edge to the RuleExecution entity.
+func (m *SavingsTransferMutation) ClearRuleExecution() {
+	m.clearedrule_execution = true
+	m.clearedFields[savingstransfer.FieldRuleExecutionID] = struct{}{}
+}
+
+// RuleExecutionCleared reports if the "rule_execution" edge to the RuleExecution entity was cleared.
+func (m *SavingsTransferMutation) RuleExecutionCleared() bool {
+	return m.clearedrule_execution
+}
+
+// RuleExecutionIDs returns the "rule_execution" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RuleExecutionID instead. It exists only for internal usage by the builders.
+func (m *SavingsTransferMutation) RuleExecutionIDs() (ids []uuid.UUID) {
+	if id := m.rule_execution; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRuleExecution resets all changes to the "rule_execution" edge.
+func (m *SavingsTransferMutation) ResetRuleExecution() {
+	m.rule_execution = nil
+	m.clearedrule_execution = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SavingsTransferMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[savingstransfer.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SavingsTransferMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SavingsTransferMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SavingsTransferMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearSourceAccount clears the "source_account" edge to the Account entity.
+func (m *SavingsTransferMutation) ClearSourceAccount() {
+	m.clearedsource_account = true
+	m.clearedFields[savingstransfer.FieldSourceAccountID] = struct{}{}
+}
+
+// SourceAccountCleared reports if the "source_account" edge to the Account entity was cleared.
+func (m *SavingsTransferMutation) SourceAccountCleared() bool {
+	return m.clearedsource_account
+}
+
+// SourceAccountIDs returns the "source_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceAccountID instead. It exists only for internal usage by the builders.
+func (m *SavingsTransferMutation) SourceAccountIDs() (ids []uuid.UUID) {
+	if id := m.source_account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSourceAccount resets all changes to the "source_account" edge.
+func (m *SavingsTransferMutation) ResetSourceAccount() {
+	m.source_account = nil
+	m.clearedsource_account = false
+}
+
+// ClearTargetAccount clears the "target_account" edge to the Account entity.
+func (m *SavingsTransferMutation) ClearTargetAccount() {
+	m.clearedtarget_account = true
+	m.clearedFields[savingstransfer.FieldTargetAccountID] = struct{}{}
+}
+
+// TargetAccountCleared reports if the "target_account" edge to the Account entity was cleared.
+func (m *SavingsTransferMutation) TargetAccountCleared() bool {
+	return m.clearedtarget_account
+}
+
+// TargetAccountIDs returns the "target_account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TargetAccountID instead. It exists only for internal usage by the builders.
+func (m *SavingsTransferMutation) TargetAccountIDs() (ids []uuid.UUID) {
+	if id := m.target_account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTargetAccount resets all changes to the "target_account" edge.
+func (m *SavingsTransferMutation) ResetTargetAccount() {
+	m.target_account = nil
+	m.clearedtarget_account = false
+}
+
+// Where appends a list predicates to the SavingsTransferMutation builder.
+func (m *SavingsTransferMutation) Where(ps ...predicate.SavingsTransfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SavingsTransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SavingsTransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SavingsTransfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SavingsTransferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SavingsTransferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SavingsTransfer).
+func (m *SavingsTransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SavingsTransferMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.rule_execution != nil {
+		fields = append(fields, savingstransfer.FieldRuleExecutionID)
+	}
+	if m.user != nil {
+		fields = append(fields, savingstransfer.FieldUserID)
+	}
+	if m.source_account != nil {
+		fields = append(fields, savingstransfer.FieldSourceAccountID)
+	}
+	if m.target_account != nil {
+		fields = append(fields, savingstransfer.FieldTargetAccountID)
+	}
+	if m.amount_cents != nil {
+		fields = append(fields, savingstransfer.FieldAmountCents)
+	}
+	if m.currency != nil {
+		fields = append(fields, savingstransfer.FieldCurrency)
+	}
+	if m.status != nil {
+		fields = append(fields, savingstransfer.FieldStatus)
+	}
+	if m.external_transfer_id != nil {
+		fields = append(fields, savingstransfer.FieldExternalTransferID)
+	}
+	if m.error_message != nil {
+		fields = append(fields, savingstransfer.FieldErrorMessage)
+	}
+	if m.created_at != nil {
+		fields = append(fields, savingstransfer.FieldCreatedAt)
+	}
+	if m.executed_at != nil {
+		fields = append(fields, savingstransfer.FieldExecutedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SavingsTransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case savingstransfer.FieldRuleExecutionID:
+		return m.RuleExecutionID()
+	case savingstransfer.FieldUserID:
+		return m.UserID()
+	case savingstransfer.FieldSourceAccountID:
+		return m.SourceAccountID()
+	case savingstransfer.FieldTargetAccountID:
+		return m.TargetAccountID()
+	case savingstransfer.FieldAmountCents:
+		return m.AmountCents()
+	case savingstransfer.FieldCurrency:
+		return m.Currency()
+	case savingstransfer.FieldStatus:
+		return m.Status()
+	case savingstransfer.FieldExternalTransferID:
+		return m.ExternalTransferID()
+	case savingstransfer.FieldErrorMessage:
+		return m.ErrorMessage()
+	case savingstransfer.FieldCreatedAt:
+		return m.CreatedAt()
+	case savingstransfer.FieldExecutedAt:
+		return m.ExecutedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SavingsTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case savingstransfer.FieldRuleExecutionID:
+		return m.OldRuleExecutionID(ctx)
+	case savingstransfer.FieldUserID:
+		return m.OldUserID(ctx)
+	case savingstransfer.FieldSourceAccountID:
+		return m.OldSourceAccountID(ctx)
+	case savingstransfer.FieldTargetAccountID:
+		return m.OldTargetAccountID(ctx)
+	case savingstransfer.FieldAmountCents:
+		return m.OldAmountCents(ctx)
+	case savingstransfer.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case savingstransfer.FieldStatus:
+		return m.OldStatus(ctx)
+	case savingstransfer.FieldExternalTransferID:
+		return m.OldExternalTransferID(ctx)
+	case savingstransfer.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case savingstransfer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case savingstransfer.FieldExecutedAt:
+		return m.OldExecutedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SavingsTransfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavingsTransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case savingstransfer.FieldRuleExecutionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRuleExecutionID(v)
+		return nil
+	case savingstransfer.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case savingstransfer.FieldSourceAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceAccountID(v)
+		return nil
+	case savingstransfer.FieldTargetAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetAccountID(v)
+		return nil
+	case savingstransfer.FieldAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmountCents(v)
+		return nil
+	case savingstransfer.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case savingstransfer.FieldStatus:
+		v, ok := value.(savingstransfer.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case savingstransfer.FieldExternalTransferID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExternalTransferID(v)
+		return nil
+	case savingstransfer.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case savingstransfer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case savingstransfer.FieldExecutedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SavingsTransfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SavingsTransferMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount_cents != nil {
+		fields = append(fields, savingstransfer.FieldAmountCents)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SavingsTransferMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case savingstransfer.FieldAmountCents:
+		return m.AddedAmountCents()
+	}
+	return nil, false
 }
 
-// OldRuleExecutionID returns the old "rule_execution_id" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldRuleExecutionID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRuleExecutionID is only allowed on UpdateOne operations")
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SavingsTransferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case savingstransfer.FieldAmountCents:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmountCents(v)
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRuleExecutionID requires an ID field in the mutation")
+	return fmt.Errorf("unknown SavingsTransfer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SavingsTransferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(savingstransfer.FieldExternalTransferID) {
+		fields = append(fields, savingstransfer.FieldExternalTransferID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRuleExecutionID: %w", err)
+	if m.FieldCleared(savingstransfer.FieldErrorMessage) {
+		fields = append(fields, savingstransfer.FieldErrorMessage)
 	}
-	return oldValue.RuleExecutionID, nil
+	if m.FieldCleared(savingstransfer.FieldExecutedAt) {
+		fields = append(fields, savingstransfer.FieldExecutedAt)
+	}
+	return fields
 }
 
-// ResetRuleExecutionID resets all changes to the "rule_execution_id" field.
-func (m *SavingsTransferMutation) ResetRuleExecutionID() {
-	m.rule_execution = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SavingsTransferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUserID sets the "user_id" field.
-func (m *SavingsTransferMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SavingsTransferMutation) ClearField(name string) error {
+	switch name {
+	case savingstransfer.FieldExternalTransferID:
+		m.ClearExternalTransferID()
+		return nil
+	case savingstransfer.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case savingstransfer.FieldExecutedAt:
+		m.ClearExecutedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SavingsTransfer nullable field %s", name)
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *SavingsTransferMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SavingsTransferMutation) ResetField(name string) error {
+	switch name {
+	case savingstransfer.FieldRuleExecutionID:
+		m.ResetRuleExecutionID()
+		return nil
+	case savingstransfer.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case savingstransfer.FieldSourceAccountID:
+		m.ResetSourceAccountID()
+		return nil
+	case savingstransfer.FieldTargetAccountID:
+		m.ResetTargetAccountID()
+		return nil
+	case savingstransfer.FieldAmountCents:
+		m.ResetAmountCents()
+		return nil
+	case savingstransfer.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case savingstransfer.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case savingstransfer.FieldExternalTransferID:
+		m.ResetExternalTransferID()
+		return nil
+	case savingstransfer.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case savingstransfer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case savingstransfer.FieldExecutedAt:
+		m.ResetExecutedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown SavingsTransfer field %s", name)
 }
 
-// OldUserID returns the old "user_id" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SavingsTransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.rule_execution != nil {
+		edges = append(edges, savingstransfer.EdgeRuleExecution)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+	if m.user != nil {
+		edges = append(edges, savingstransfer.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	if m.source_account != nil {
+		edges = append(edges, savingstransfer.EdgeSourceAccount)
 	}
-	return oldValue.UserID, nil
+	if m.target_account != nil {
+		edges = append(edges, savingstransfer.EdgeTargetAccount)
+	}
+	return edges
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *SavingsTransferMutation) ResetUserID() {
-	m.user = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SavingsTransferMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case savingstransfer.EdgeRuleExecution:
+		if id := m.rule_execution; id != nil {
+			return []ent.Value{*id}
+		}
+	case savingstransfer.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case savingstransfer.EdgeSourceAccount:
+		if id := m.source_account; id != nil {
+			return []ent.Value{*id}
+		}
+	case savingstransfer.EdgeTargetAccount:
+		if id := m.target_account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// SetSourceAccountID sets the "source_account_id" field.
-func (m *SavingsTransferMutation) SetSourceAccountID(u uuid.UUID) {
-	m.source_account = &u
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SavingsTransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	return edges
 }
 
-// SourceAccountID returns the value of the "source_account_id" field in the mutation.
-func (m *SavingsTransferMutation) SourceAccountID() (r uuid.UUID, exists bool) {
-	v := m.source_account
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SavingsTransferMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OldSourceAccountID returns the old "source_account_id" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldSourceAccountID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSourceAccountID is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SavingsTransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedrule_execution {
+		edges = append(edges, savingstransfer.EdgeRuleExecution)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSourceAccountID requires an ID field in the mutation")
+	if m.cleareduser {
+		edges = append(edges, savingstransfer.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSourceAccountID: %w", err)
+	if m.clearedsource_account {
+		edges = append(edges, savingstransfer.EdgeSourceAccount)
 	}
-	return oldValue.SourceAccountID, nil
-}
-
-// ResetSourceAccountID resets all changes to the "source_account_id" field.
-func (m *SavingsTransferMutation) ResetSourceAccountID() {
-	m.source_account = nil
+	if m.clearedtarget_account {
+		edges = append(edges, savingstransfer.EdgeTargetAccount)
+	}
+	return edges
 }
 
-// SetTargetAccountID sets the "target_account_id" field.
-func (m *SavingsTransferMutation) SetTargetAccountID(u uuid.UUID) {
-	m.target_account = &u
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SavingsTransferMutation) EdgeCleared(name string) bool {
+	switch name {
+	case savingstransfer.EdgeRuleExecution:
+		return m.clearedrule_execution
+	case savingstransfer.EdgeUser:
+		return m.cleareduser
+	case savingstransfer.EdgeSourceAccount:
+		return m.clearedsource_account
+	case savingstransfer.EdgeTargetAccount:
+		return m.clearedtarget_account
+	}
+	return false
 }
 
-// TargetAccountID returns the value of the "target_account_id" field in the mutation.
-func (m *SavingsTransferMutation) TargetAccountID() (r uuid.UUID, exists bool) {
-	v := m.target_account
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SavingsTransferMutation) ClearEdge(name string) error {
+	switch name {
+	case savingstransfer.EdgeRuleExecution:
+		m.ClearRuleExecution()
+		return nil
+	case savingstransfer.EdgeUser:
+		m.ClearUser()
+		return nil
+	case savingstransfer.EdgeSourceAccount:
+		m.ClearSourceAccount()
+		return nil
+	case savingstransfer.EdgeTargetAccount:
+		m.ClearTargetAccount()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown SavingsTransfer unique edge %s", name)
 }
 
-// OldTargetAccountID returns the old "target_account_id" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldTargetAccountID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTargetAccountID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTargetAccountID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTargetAccountID: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SavingsTransferMutation) ResetEdge(name string) error {
+	switch name {
+	case savingstransfer.EdgeRuleExecution:
+		m.ResetRuleExecution()
+		return nil
+	case savingstransfer.EdgeUser:
+		m.ResetUser()
+		return nil
+	case savingstransfer.EdgeSourceAccount:
+		m.ResetSourceAccount()
+		return nil
+	case savingstransfer.EdgeTargetAccount:
+		m.ResetTargetAccount()
+		return nil
 	}
-	return oldValue.TargetAccountID, nil
+	return fmt.Errorf("unknown SavingsTransfer edge %s", name)
 }
 
-// ResetTargetAccountID resets all changes to the "target_account_id" field.
-func (m *SavingsTransferMutation) ResetTargetAccountID() {
-	m.target_account = nil
+// SyncCursorMutation represents an operation that mutates the SyncCursor nodes in the graph.
+type SyncCursorMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *uuid.UUID
+	cursor                  *string
+	last_sync_at            *time.Time
+	last_error              *string
+	consecutive_failures    *int
+	addconsecutive_failures *int
+	next_sync_at            *time.Time
+	clearedFields           map[string]struct{}
+	item                    *uuid.UUID
+	cleareditem             bool
+	done                    bool
+	oldValue                func(context.Context) (*SyncCursor, error)
+	predicates              []predicate.SyncCursor
 }
 
-// SetAmountCents sets the "amount_cents" field.
-func (m *SavingsTransferMutation) SetAmountCents(i int64) {
-	m.amount_cents = &i
-	m.addamount_cents = nil
-}
+var _ ent.Mutation = (*SyncCursorMutation)(nil)
 
-// AmountCents returns the value of the "amount_cents" field in the mutation.
-func (m *SavingsTransferMutation) AmountCents() (r int64, exists bool) {
-	v := m.amount_cents
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// synccursorOption allows management of the mutation configuration using functional options.
+type synccursorOption func(*SyncCursorMutation)
 
-// OldAmountCents returns the old "amount_cents" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldAmountCents(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAmountCents is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAmountCents requires an ID field in the mutation")
+// newSyncCursorMutation creates new mutation for the SyncCursor entity.
+func newSyncCursorMutation(c config, op Op, opts ...synccursorOption) *SyncCursorMutation {
+	m := &SyncCursorMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSyncCursor,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAmountCents: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.AmountCents, nil
+	return m
 }
 
-// AddAmountCents adds i to the "amount_cents" field.
-func (m *SavingsTransferMutation) AddAmountCents(i int64) {
-	if m.addamount_cents != nil {
-		*m.addamount_cents += i
-	} else {
-		m.addamount_cents = &i
+// withSyncCursorID sets the ID field of the mutation.
+func withSyncCursorID(id uuid.UUID) synccursorOption {
+	return func(m *SyncCursorMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SyncCursor
+		)
+		m.oldValue = func(ctx context.Context) (*SyncCursor, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SyncCursor.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// AddedAmountCents returns the value that was added to the "amount_cents" field in this mutation.
-func (m *SavingsTransferMutation) AddedAmountCents() (r int64, exists bool) {
-	v := m.addamount_cents
-	if v == nil {
-		return
+// withSyncCursor sets the old SyncCursor of the mutation.
+func withSyncCursor(node *SyncCursor) synccursorOption {
+	return func(m *SyncCursorMutation) {
+		m.oldValue = func(context.Context) (*SyncCursor, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// ResetAmountCents resets all changes to the "amount_cents" field.
-func (m *SavingsTransferMutation) ResetAmountCents() {
-	m.amount_cents = nil
-	m.addamount_cents = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SyncCursorMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetStatus sets the "status" field.
-func (m *SavingsTransferMutation) SetStatus(s savingstransfer.Status) {
-	m.status = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SyncCursorMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *SavingsTransferMutation) Status() (r savingstransfer.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SyncCursor entities.
+func (m *SyncCursorMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// OldStatus returns the old "status" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldStatus(ctx context.Context) (v savingstransfer.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SyncCursorMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.Status, nil
+	return *m.id, true
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *SavingsTransferMutation) ResetStatus() {
-	m.status = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SyncCursorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SyncCursor.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetPlaidTransferID sets the "plaid_transfer_id" field.
-func (m *SavingsTransferMutation) SetPlaidTransferID(s string) {
-	m.plaid_transfer_id = &s
+// SetItemID sets the "item_id" field.
+func (m *SyncCursorMutation) SetItemID(u uuid.UUID) {
+	m.item = &u
 }
 
-// PlaidTransferID returns the value of the "plaid_transfer_id" field in the mutation.
-func (m *SavingsTransferMutation) PlaidTransferID() (r string, exists bool) {
-	v := m.plaid_transfer_id
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *SyncCursorMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlaidTransferID returns the old "plaid_transfer_id" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// OldItemID returns the old "item_id" field's value of the SyncCursor entity.
+// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldPlaidTransferID(ctx context.Context) (v string, err error) {
+func (m *SyncCursorMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaidTransferID is only allowed on UpdateOne operations")
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaidTransferID requires an ID field in the mutation")
+		return v, errors.New("OldItemID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaidTransferID: %w", err)
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
 	}
-	return oldValue.PlaidTransferID, nil
-}
-
-// ClearPlaidTransferID clears the value of the "plaid_transfer_id" field.
-func (m *SavingsTransferMutation) ClearPlaidTransferID() {
-	m.plaid_transfer_id = nil
-	m.clearedFields[savingstransfer.FieldPlaidTransferID] = struct{}{}
-}
-
-// PlaidTransferIDCleared returns if the "plaid_transfer_id" field was cleared in this mutation.
-func (m *SavingsTransferMutation) PlaidTransferIDCleared() bool {
-	_, ok := m.clearedFields[savingstransfer.FieldPlaidTransferID]
-	return ok
+	return oldValue.ItemID, nil
 }
 
-// ResetPlaidTransferID resets all changes to the "plaid_transfer_id" field.
-func (m *SavingsTransferMutation) ResetPlaidTransferID() {
-	m.plaid_transfer_id = nil
-	delete(m.clearedFields, savingstransfer.FieldPlaidTransferID)
+// ResetItemID resets all changes to the "item_id" field.
+func (m *SyncCursorMutation) ResetItemID() {
+	m.item = nil
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *SavingsTransferMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// SetCursor sets the "cursor" field.
+func (m *SyncCursorMutation) SetCursor(s string) {
+	m.cursor = &s
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *SavingsTransferMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
+// Cursor returns the value of the "cursor" field in the mutation.
+func (m *SyncCursorMutation) Cursor() (r string, exists bool) {
+	v := m.cursor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// OldCursor returns the old "cursor" field's value of the SyncCursor entity.
+// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldErrorMessage(ctx context.Context) (v string, err error) {
+func (m *SyncCursorMutation) OldCursor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldCursor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+		return v, errors.New("OldCursor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldCursor: %w", err)
 	}
-	return oldValue.ErrorMessage, nil
-}
-
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *SavingsTransferMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[savingstransfer.FieldErrorMessage] = struct{}{}
-}
-
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *SavingsTransferMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[savingstransfer.FieldErrorMessage]
-	return ok
+	return oldValue.Cursor, nil
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *SavingsTransferMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, savingstransfer.FieldErrorMessage)
+// ResetCursor resets all changes to the "cursor" field.
+func (m *SyncCursorMutation) ResetCursor() {
+	m.cursor = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SavingsTransferMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLastSyncAt sets the "last_sync_at" field.
+func (m *SyncCursorMutation) SetLastSyncAt(t time.Time) {
+	m.last_sync_at = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SavingsTransferMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// LastSyncAt returns the value of the "last_sync_at" field in the mutation.
+func (m *SyncCursorMutation) LastSyncAt() (r time.Time, exists bool) {
+	v := m.last_sync_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// OldLastSyncAt returns the old "last_sync_at" field's value of the SyncCursor entity.
+// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SyncCursorMutation) OldLastSyncAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastSyncAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastSyncAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastSyncAt: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.LastSyncAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SavingsTransferMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetLastSyncAt resets all changes to the "last_sync_at" field.
+func (m *SyncCursorMutation) ResetLastSyncAt() {
+	m.last_sync_at = nil
 }
 
-// SetExecutedAt sets the "executed_at" field.
-func (m *SavingsTransferMutation) SetExecutedAt(t time.Time) {
-	m.executed_at = &t
+// SetLastError sets the "last_error" field.
+func (m *SyncCursorMutation) SetLastError(s string) {
+	m.last_error = &s
 }
 
-// ExecutedAt returns the value of the "executed_at" field in the mutation.
-func (m *SavingsTransferMutation) ExecutedAt() (r time.Time, exists bool) {
-	v := m.executed_at
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *SyncCursorMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExecutedAt returns the old "executed_at" field's value of the SavingsTransfer entity.
-// If the SavingsTransfer object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the SyncCursor entity.
+// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SavingsTransferMutation) OldExecutedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *SyncCursorMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.ExecutedAt, nil
-}
-
-// ClearExecutedAt clears the value of the "executed_at" field.
-func (m *SavingsTransferMutation) ClearExecutedAt() {
-	m.executed_at = nil
-	m.clearedFields[savingstransfer.FieldExecutedAt] = struct{}{}
-}
-
-// ExecutedAtCleared returns if the "executed_at" field was cleared in this mutation.
-func (m *SavingsTransferMutation) ExecutedAtCleared() bool {
-	_, ok := m.clearedFields[savingstransfer.FieldExecutedAt]
-	return ok
-}
-
-// ResetExecutedAt resets all changes to the "executed_at" field.
-func (m *SavingsTransferMutation) ResetExecutedAt() {
-	m.executed_at = nil
-	delete(m.clearedFields, savingstransfer.FieldExecutedAt)
+	return oldValue.LastError, nil
 }
 
-// ClearRuleExecution clears the "rule_execution" edge to the RuleExecution entity.
-func (m *SavingsTransferMutation) ClearRuleExecution() {
-	m.clearedrule_execution = true
-	m.clearedFields[savingstransfer.FieldRuleExecutionID] = struct{}{}
+// ResetLastError resets all changes to the "last_error" field.
+func (m *SyncCursorMutation) ResetLastError() {
+	m.last_error = nil
 }
 
-// RuleExecutionCleared reports if the "rule_execution" edge to the RuleExecution entity was cleared.
-func (m *SavingsTransferMutation) RuleExecutionCleared() bool {
-	return m.clearedrule_execution
+// SetConsecutiveFailures sets the "consecutive_failures" field.
+func (m *SyncCursorMutation) SetConsecutiveFailures(i int) {
+	m.consecutive_failures = &i
+	m.addconsecutive_failures = nil
 }
 
-// RuleExecutionIDs returns the "rule_execution" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RuleExecutionID instead. It exists only for internal usage by the builders.
-func (m *SavingsTransferMutation) RuleExecutionIDs() (ids []uuid.UUID) {
-	if id := m.rule_execution; id != nil {
-		ids = append(ids, *id)
+// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
+func (m *SyncCursorMutation) ConsecutiveFailures() (r int, exists bool) {
+	v := m.consecutive_failures
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetRuleExecution resets all changes to the "rule_execution" edge.
-func (m *SavingsTransferMutation) ResetRuleExecution() {
-	m.rule_execution = nil
-	m.clearedrule_execution = false
+	return *v, true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *SavingsTransferMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[savingstransfer.FieldUserID] = struct{}{}
+// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the SyncCursor entity.
+// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncCursorMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+	}
+	return oldValue.ConsecutiveFailures, nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SavingsTransferMutation) UserCleared() bool {
-	return m.cleareduser
+// AddConsecutiveFailures adds i to the "consecutive_failures" field.
+func (m *SyncCursorMutation) AddConsecutiveFailures(i int) {
+	if m.addconsecutive_failures != nil {
+		*m.addconsecutive_failures += i
+	} else {
+		m.addconsecutive_failures = &i
+	}
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *SavingsTransferMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
+func (m *SyncCursorMutation) AddedConsecutiveFailures() (r int, exists bool) {
+	v := m.addconsecutive_failures
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *SavingsTransferMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
+func (m *SyncCursorMutation) ResetConsecutiveFailures() {
+	m.consecutive_failures = nil
+	m.addconsecutive_failures = nil
 }
 
-// ClearSourceAccount clears the "source_account" edge to the Account entity.
-func (m *SavingsTransferMutation) ClearSourceAccount() {
-	m.clearedsource_account = true
-	m.clearedFields[savingstransfer.FieldSourceAccountID] = struct{}{}
+// SetNextSyncAt sets the "next_sync_at" field.
+func (m *SyncCursorMutation) SetNextSyncAt(t time.Time) {
+	m.next_sync_at = &t
 }
 
-// SourceAccountCleared reports if the "source_account" edge to the Account entity was cleared.
-func (m *SavingsTransferMutation) SourceAccountCleared() bool {
-	return m.clearedsource_account
+// NextSyncAt returns the value of the "next_sync_at" field in the mutation.
+func (m *SyncCursorMutation) NextSyncAt() (r time.Time, exists bool) {
+	v := m.next_sync_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SourceAccountIDs returns the "source_account" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SourceAccountID instead. It exists only for internal usage by the builders.
-func (m *SavingsTransferMutation) SourceAccountIDs() (ids []uuid.UUID) {
-	if id := m.source_account; id != nil {
-		ids = append(ids, *id)
+// OldNextSyncAt returns the old "next_sync_at" field's value of the SyncCursor entity.
+// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncCursorMutation) OldNextSyncAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextSyncAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextSyncAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextSyncAt: %w", err)
+	}
+	return oldValue.NextSyncAt, nil
 }
 
-// ResetSourceAccount resets all changes to the "source_account" edge.
-func (m *SavingsTransferMutation) ResetSourceAccount() {
-	m.source_account = nil
-	m.clearedsource_account = false
+// ResetNextSyncAt resets all changes to the "next_sync_at" field.
+func (m *SyncCursorMutation) ResetNextSyncAt() {
+	m.next_sync_at = nil
 }
 
-// ClearTargetAccount clears the "target_account" edge to the Account entity.
-func (m *SavingsTransferMutation) ClearTargetAccount() {
-	m.clearedtarget_account = true
-	m.clearedFields[savingstransfer.FieldTargetAccountID] = struct{}{}
+// ClearItem clears the "item" edge to the Item entity.
+func (m *SyncCursorMutation) ClearItem() {
+	m.cleareditem = true
+	m.clearedFields[synccursor.FieldItemID] = struct{}{}
 }
 
-// TargetAccountCleared reports if the "target_account" edge to the Account entity was cleared.
-func (m *SavingsTransferMutation) TargetAccountCleared() bool {
-	return m.clearedtarget_account
+// ItemCleared reports if the "item" edge to the Item entity was cleared.
+func (m *SyncCursorMutation) ItemCleared() bool {
+	return m.cleareditem
 }
 
-// TargetAccountIDs returns the "target_account" edge IDs in the mutation.
+// ItemIDs returns the "item" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TargetAccountID instead. It exists only for internal usage by the builders.
-func (m *SavingsTransferMutation) TargetAccountIDs() (ids []uuid.UUID) {
-	if id := m.target_account; id != nil {
+// ItemID instead. It exists only for internal usage by the builders.
+func (m *SyncCursorMutation) ItemIDs() (ids []uuid.UUID) {
+	if id := m.item; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetTargetAccount resets all changes to the "target_account" edge.
-func (m *SavingsTransferMutation) ResetTargetAccount() {
-	m.target_account = nil
-	m.clearedtarget_account = false
+// ResetItem resets all changes to the "item" edge.
+func (m *SyncCursorMutation) ResetItem() {
+	m.item = nil
+	m.cleareditem = false
 }
 
-// Where appends a list predicates to the SavingsTransferMutation builder.
-func (m *SavingsTransferMutation) Where(ps ...predicate.SavingsTransfer) {
+// Where appends a list predicates to the SyncCursorMutation builder.
+func (m *SyncCursorMutation) Where(ps ...predicate.SyncCursor) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SavingsTransferMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SyncCursorMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SavingsTransferMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SavingsTransfer, len(ps))
+func (m *SyncCursorMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SyncCursor, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6335,54 +17758,42 @@ func (m *SavingsTransferMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SavingsTransferMutation) Op() Op {
+func (m *SyncCursorMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SavingsTransferMutation) SetOp(op Op) {
+func (m *SyncCursorMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SavingsTransfer).
-func (m *SavingsTransferMutation) Type() string {
+// Type returns the node type of this mutation (SyncCursor).
+func (m *SyncCursorMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SavingsTransferMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.rule_execution != nil {
-		fields = append(fields, savingstransfer.FieldRuleExecutionID)
-	}
-	if m.user != nil {
-		fields = append(fields, savingstransfer.FieldUserID)
-	}
-	if m.source_account != nil {
-		fields = append(fields, savingstransfer.FieldSourceAccountID)
-	}
-	if m.target_account != nil {
-		fields = append(fields, savingstransfer.FieldTargetAccountID)
-	}
-	if m.amount_cents != nil {
-		fields = append(fields, savingstransfer.FieldAmountCents)
+func (m *SyncCursorMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.item != nil {
+		fields = append(fields, synccursor.FieldItemID)
 	}
-	if m.status != nil {
-		fields = append(fields, savingstransfer.FieldStatus)
+	if m.cursor != nil {
+		fields = append(fields, synccursor.FieldCursor)
 	}
-	if m.plaid_transfer_id != nil {
-		fields = append(fields, savingstransfer.FieldPlaidTransferID)
+	if m.last_sync_at != nil {
+		fields = append(fields, synccursor.FieldLastSyncAt)
 	}
-	if m.error_message != nil {
-		fields = append(fields, savingstransfer.FieldErrorMessage)
+	if m.last_error != nil {
+		fields = append(fields, synccursor.FieldLastError)
 	}
-	if m.created_at != nil {
-		fields = append(fields, savingstransfer.FieldCreatedAt)
+	if m.consecutive_failures != nil {
+		fields = append(fields, synccursor.FieldConsecutiveFailures)
 	}
-	if m.executed_at != nil {
-		fields = append(fields, savingstransfer.FieldExecutedAt)
+	if m.next_sync_at != nil {
+		fields = append(fields, synccursor.FieldNextSyncAt)
 	}
 	return fields
 }
@@ -6390,28 +17801,20 @@ func (m *SavingsTransferMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SavingsTransferMutation) Field(name string) (ent.Value, bool) {
+func (m *SyncCursorMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case savingstransfer.FieldRuleExecutionID:
-		return m.RuleExecutionID()
-	case savingstransfer.FieldUserID:
-		return m.UserID()
-	case savingstransfer.FieldSourceAccountID:
-		return m.SourceAccountID()
-	case savingstransfer.FieldTargetAccountID:
-		return m.TargetAccountID()
-	case savingstransfer.FieldAmountCents:
-		return m.AmountCents()
-	case savingstransfer.FieldStatus:
-		return m.Status()
-	case savingstransfer.FieldPlaidTransferID:
-		return m.PlaidTransferID()
-	case savingstransfer.FieldErrorMessage:
-		return m.ErrorMessage()
-	case savingstransfer.FieldCreatedAt:
-		return m.CreatedAt()
-	case savingstransfer.FieldExecutedAt:
-		return m.ExecutedAt()
+	case synccursor.FieldItemID:
+		return m.ItemID()
+	case synccursor.FieldCursor:
+		return m.Cursor()
+	case synccursor.FieldLastSyncAt:
+		return m.LastSyncAt()
+	case synccursor.FieldLastError:
+		return m.LastError()
+	case synccursor.FieldConsecutiveFailures:
+		return m.ConsecutiveFailures()
+	case synccursor.FieldNextSyncAt:
+		return m.NextSyncAt()
 	}
 	return nil, false
 }
@@ -6419,117 +17822,81 @@ func (m *SavingsTransferMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SavingsTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SyncCursorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case savingstransfer.FieldRuleExecutionID:
-		return m.OldRuleExecutionID(ctx)
-	case savingstransfer.FieldUserID:
-		return m.OldUserID(ctx)
-	case savingstransfer.FieldSourceAccountID:
-		return m.OldSourceAccountID(ctx)
-	case savingstransfer.FieldTargetAccountID:
-		return m.OldTargetAccountID(ctx)
-	case savingstransfer.FieldAmountCents:
-		return m.OldAmountCents(ctx)
-	case savingstransfer.FieldStatus:
-		return m.OldStatus(ctx)
-	case savingstransfer.FieldPlaidTransferID:
-		return m.OldPlaidTransferID(ctx)
-	case savingstransfer.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case savingstransfer.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case savingstransfer.FieldExecutedAt:
-		return m.OldExecutedAt(ctx)
+	case synccursor.FieldItemID:
+		return m.OldItemID(ctx)
+	case synccursor.FieldCursor:
+		return m.OldCursor(ctx)
+	case synccursor.FieldLastSyncAt:
+		return m.OldLastSyncAt(ctx)
+	case synccursor.FieldLastError:
+		return m.OldLastError(ctx)
+	case synccursor.FieldConsecutiveFailures:
+		return m.OldConsecutiveFailures(ctx)
+	case synccursor.FieldNextSyncAt:
+		return m.OldNextSyncAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SavingsTransfer field %s", name)
+	return nil, fmt.Errorf("unknown SyncCursor field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SavingsTransferMutation) SetField(name string, value ent.Value) error {
+func (m *SyncCursorMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case savingstransfer.FieldRuleExecutionID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRuleExecutionID(v)
-		return nil
-	case savingstransfer.FieldUserID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case savingstransfer.FieldSourceAccountID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSourceAccountID(v)
-		return nil
-	case savingstransfer.FieldTargetAccountID:
+	case synccursor.FieldItemID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTargetAccountID(v)
-		return nil
-	case savingstransfer.FieldAmountCents:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAmountCents(v)
+		m.SetItemID(v)
 		return nil
-	case savingstransfer.FieldStatus:
-		v, ok := value.(savingstransfer.Status)
+	case synccursor.FieldCursor:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetCursor(v)
 		return nil
-	case savingstransfer.FieldPlaidTransferID:
-		v, ok := value.(string)
+	case synccursor.FieldLastSyncAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlaidTransferID(v)
+		m.SetLastSyncAt(v)
 		return nil
-	case savingstransfer.FieldErrorMessage:
+	case synccursor.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrorMessage(v)
+		m.SetLastError(v)
 		return nil
-	case savingstransfer.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case synccursor.FieldConsecutiveFailures:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetConsecutiveFailures(v)
 		return nil
-	case savingstransfer.FieldExecutedAt:
+	case synccursor.FieldNextSyncAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExecutedAt(v)
+		m.SetNextSyncAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SavingsTransfer field %s", name)
+	return fmt.Errorf("unknown SyncCursor field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SavingsTransferMutation) AddedFields() []string {
+func (m *SyncCursorMutation) AddedFields() []string {
 	var fields []string
-	if m.addamount_cents != nil {
-		fields = append(fields, savingstransfer.FieldAmountCents)
+	if m.addconsecutive_failures != nil {
+		fields = append(fields, synccursor.FieldConsecutiveFailures)
 	}
 	return fields
 }
@@ -6537,10 +17904,10 @@ func (m *SavingsTransferMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SavingsTransferMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SyncCursorMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case savingstransfer.FieldAmountCents:
-		return m.AddedAmountCents()
+	case synccursor.FieldConsecutiveFailures:
+		return m.AddedConsecutiveFailures()
 	}
 	return nil, false
 }
@@ -6548,133 +17915,79 @@ func (m *SavingsTransferMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SavingsTransferMutation) AddField(name string, value ent.Value) error {
+func (m *SyncCursorMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case savingstransfer.FieldAmountCents:
-		v, ok := value.(int64)
+	case synccursor.FieldConsecutiveFailures:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddAmountCents(v)
+		m.AddConsecutiveFailures(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SavingsTransfer numeric field %s", name)
+	return fmt.Errorf("unknown SyncCursor numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SavingsTransferMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(savingstransfer.FieldPlaidTransferID) {
-		fields = append(fields, savingstransfer.FieldPlaidTransferID)
-	}
-	if m.FieldCleared(savingstransfer.FieldErrorMessage) {
-		fields = append(fields, savingstransfer.FieldErrorMessage)
-	}
-	if m.FieldCleared(savingstransfer.FieldExecutedAt) {
-		fields = append(fields, savingstransfer.FieldExecutedAt)
-	}
-	return fields
+func (m *SyncCursorMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SavingsTransferMutation) FieldCleared(name string) bool {
+func (m *SyncCursorMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SavingsTransferMutation) ClearField(name string) error {
-	switch name {
-	case savingstransfer.FieldPlaidTransferID:
-		m.ClearPlaidTransferID()
-		return nil
-	case savingstransfer.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
-	case savingstransfer.FieldExecutedAt:
-		m.ClearExecutedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown SavingsTransfer nullable field %s", name)
+func (m *SyncCursorMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown SyncCursor nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SavingsTransferMutation) ResetField(name string) error {
+func (m *SyncCursorMutation) ResetField(name string) error {
 	switch name {
-	case savingstransfer.FieldRuleExecutionID:
-		m.ResetRuleExecutionID()
-		return nil
-	case savingstransfer.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case savingstransfer.FieldSourceAccountID:
-		m.ResetSourceAccountID()
-		return nil
-	case savingstransfer.FieldTargetAccountID:
-		m.ResetTargetAccountID()
-		return nil
-	case savingstransfer.FieldAmountCents:
-		m.ResetAmountCents()
+	case synccursor.FieldItemID:
+		m.ResetItemID()
 		return nil
-	case savingstransfer.FieldStatus:
-		m.ResetStatus()
+	case synccursor.FieldCursor:
+		m.ResetCursor()
 		return nil
-	case savingstransfer.FieldPlaidTransferID:
-		m.ResetPlaidTransferID()
+	case synccursor.FieldLastSyncAt:
+		m.ResetLastSyncAt()
 		return nil
-	case savingstransfer.FieldErrorMessage:
-		m.ResetErrorMessage()
+	case synccursor.FieldLastError:
+		m.ResetLastError()
 		return nil
-	case savingstransfer.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case synccursor.FieldConsecutiveFailures:
+		m.ResetConsecutiveFailures()
 		return nil
-	case savingstransfer.FieldExecutedAt:
-		m.ResetExecutedAt()
+	case synccursor.FieldNextSyncAt:
+		m.ResetNextSyncAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SavingsTransfer field %s", name)
+	return fmt.Errorf("unknown SyncCursor field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SavingsTransferMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.rule_execution != nil {
-		edges = append(edges, savingstransfer.EdgeRuleExecution)
-	}
-	if m.user != nil {
-		edges = append(edges, savingstransfer.EdgeUser)
-	}
-	if m.source_account != nil {
-		edges = append(edges, savingstransfer.EdgeSourceAccount)
-	}
-	if m.target_account != nil {
-		edges = append(edges, savingstransfer.EdgeTargetAccount)
+func (m *SyncCursorMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.item != nil {
+		edges = append(edges, synccursor.EdgeItem)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SavingsTransferMutation) AddedIDs(name string) []ent.Value {
+func (m *SyncCursorMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case savingstransfer.EdgeRuleExecution:
-		if id := m.rule_execution; id != nil {
-			return []ent.Value{*id}
-		}
-	case savingstransfer.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case savingstransfer.EdgeSourceAccount:
-		if id := m.source_account; id != nil {
-			return []ent.Value{*id}
-		}
-	case savingstransfer.EdgeTargetAccount:
-		if id := m.target_account; id != nil {
+	case synccursor.EdgeItem:
+		if id := m.item; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -6682,121 +17995,96 @@ func (m *SavingsTransferMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SavingsTransferMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
+func (m *SyncCursorMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SavingsTransferMutation) RemovedIDs(name string) []ent.Value {
+func (m *SyncCursorMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SavingsTransferMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedrule_execution {
-		edges = append(edges, savingstransfer.EdgeRuleExecution)
-	}
-	if m.cleareduser {
-		edges = append(edges, savingstransfer.EdgeUser)
-	}
-	if m.clearedsource_account {
-		edges = append(edges, savingstransfer.EdgeSourceAccount)
-	}
-	if m.clearedtarget_account {
-		edges = append(edges, savingstransfer.EdgeTargetAccount)
+func (m *SyncCursorMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareditem {
+		edges = append(edges, synccursor.EdgeItem)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SavingsTransferMutation) EdgeCleared(name string) bool {
+func (m *SyncCursorMutation) EdgeCleared(name string) bool {
 	switch name {
-	case savingstransfer.EdgeRuleExecution:
-		return m.clearedrule_execution
-	case savingstransfer.EdgeUser:
-		return m.cleareduser
-	case savingstransfer.EdgeSourceAccount:
-		return m.clearedsource_account
-	case savingstransfer.EdgeTargetAccount:
-		return m.clearedtarget_account
+	case synccursor.EdgeItem:
+		return m.cleareditem
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SavingsTransferMutation) ClearEdge(name string) error {
+func (m *SyncCursorMutation) ClearEdge(name string) error {
 	switch name {
-	case savingstransfer.EdgeRuleExecution:
-		m.ClearRuleExecution()
-		return nil
-	case savingstransfer.EdgeUser:
-		m.ClearUser()
-		return nil
-	case savingstransfer.EdgeSourceAccount:
-		m.ClearSourceAccount()
-		return nil
-	case savingstransfer.EdgeTargetAccount:
-		m.ClearTargetAccount()
+	case synccursor.EdgeItem:
+		m.ClearItem()
 		return nil
 	}
-	return fmt.Errorf("unknown SavingsTransfer unique edge %s", name)
+	return fmt.Errorf("unknown SyncCursor unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SavingsTransferMutation) ResetEdge(name string) error {
+func (m *SyncCursorMutation) ResetEdge(name string) error {
 	switch name {
-	case savingstransfer.EdgeRuleExecution:
-		m.ResetRuleExecution()
-		return nil
-	case savingstransfer.EdgeUser:
-		m.ResetUser()
-		return nil
-	case savingstransfer.EdgeSourceAccount:
-		m.ResetSourceAccount()
-		return nil
-	case savingstransfer.EdgeTargetAccount:
-		m.ResetTargetAccount()
+	case synccursor.EdgeItem:
+		m.ResetItem()
 		return nil
 	}
-	return fmt.Errorf("unknown SavingsTransfer edge %s", name)
+	return fmt.Errorf("unknown SyncCursor edge %s", name)
 }
 
-// SyncCursorMutation represents an operation that mutates the SyncCursor nodes in the graph.
-type SyncCursorMutation struct {
+// SyncRunMutation represents an operation that mutates the SyncRun nodes in the graph.
+type SyncRunMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *uuid.UUID
-	cursor                  *string
-	last_sync_at            *time.Time
-	last_error              *string
-	consecutive_failures    *int
-	addconsecutive_failures *int
-	clearedFields           map[string]struct{}
-	item                    *uuid.UUID
-	cleareditem             bool
-	done                    bool
-	oldValue                func(context.Context) (*SyncCursor, error)
-	predicates              []predicate.SyncCursor
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	trigger            *syncrun.Trigger
+	status             *syncrun.Status
+	added_count        *int
+	addadded_count     *int
+	modified_count     *int
+	addmodified_count  *int
+	removed_count      *int
+	addremoved_count   *int
+	rules_triggered    *int
+	addrules_triggered *int
+	error              *string
+	started_at         *time.Time
+	finished_at        *time.Time
+	clearedFields      map[string]struct{}
+	item               *uuid.UUID
+	cleareditem        bool
+	done               bool
+	oldValue           func(context.Context) (*SyncRun, error)
+	predicates         []predicate.SyncRun
 }
 
-var _ ent.Mutation = (*SyncCursorMutation)(nil)
+var _ ent.Mutation = (*SyncRunMutation)(nil)
 
-// synccursorOption allows management of the mutation configuration using functional options.
-type synccursorOption func(*SyncCursorMutation)
+// syncrunOption allows management of the mutation configuration using functional options.
+type syncrunOption func(*SyncRunMutation)
 
-// newSyncCursorMutation creates new mutation for the SyncCursor entity.
-func newSyncCursorMutation(c config, op Op, opts ...synccursorOption) *SyncCursorMutation {
-	m := &SyncCursorMutation{
+// newSyncRunMutation creates new mutation for the SyncRun entity.
+func newSyncRunMutation(c config, op Op, opts ...syncrunOption) *SyncRunMutation {
+	m := &SyncRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSyncCursor,
+		typ:           TypeSyncRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6805,20 +18093,20 @@ func newSyncCursorMutation(c config, op Op, opts ...synccursorOption) *SyncCurso
 	return m
 }
 
-// withSyncCursorID sets the ID field of the mutation.
-func withSyncCursorID(id uuid.UUID) synccursorOption {
-	return func(m *SyncCursorMutation) {
+// withSyncRunID sets the ID field of the mutation.
+func withSyncRunID(id uuid.UUID) syncrunOption {
+	return func(m *SyncRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SyncCursor
+			value *SyncRun
 		)
-		m.oldValue = func(ctx context.Context) (*SyncCursor, error) {
+		m.oldValue = func(ctx context.Context) (*SyncRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SyncCursor.Get(ctx, id)
+					value, err = m.Client().SyncRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6827,10 +18115,10 @@ func withSyncCursorID(id uuid.UUID) synccursorOption {
 	}
 }
 
-// withSyncCursor sets the old SyncCursor of the mutation.
-func withSyncCursor(node *SyncCursor) synccursorOption {
-	return func(m *SyncCursorMutation) {
-		m.oldValue = func(context.Context) (*SyncCursor, error) {
+// withSyncRun sets the old SyncRun of the mutation.
+func withSyncRun(node *SyncRun) syncrunOption {
+	return func(m *SyncRunMutation) {
+		m.oldValue = func(context.Context) (*SyncRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6839,7 +18127,7 @@ func withSyncCursor(node *SyncCursor) synccursorOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SyncCursorMutation) Client() *Client {
+func (m SyncRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6847,264 +18135,530 @@ func (m SyncCursorMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SyncCursorMutation) Tx() (*Tx, error) {
+func (m SyncRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SyncRun entities.
+func (m *SyncRunMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SyncRunMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SyncRunMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SyncRun.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetItemID sets the "item_id" field.
+func (m *SyncRunMutation) SetItemID(u uuid.UUID) {
+	m.item = &u
+}
+
+// ItemID returns the value of the "item_id" field in the mutation.
+func (m *SyncRunMutation) ItemID() (r uuid.UUID, exists bool) {
+	v := m.item
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldItemID returns the old "item_id" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncRunMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldItemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+	}
+	return oldValue.ItemID, nil
+}
+
+// ResetItemID resets all changes to the "item_id" field.
+func (m *SyncRunMutation) ResetItemID() {
+	m.item = nil
+}
+
+// SetTrigger sets the "trigger" field.
+func (m *SyncRunMutation) SetTrigger(s syncrun.Trigger) {
+	m.trigger = &s
+}
+
+// Trigger returns the value of the "trigger" field in the mutation.
+func (m *SyncRunMutation) Trigger() (r syncrun.Trigger, exists bool) {
+	v := m.trigger
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrigger returns the old "trigger" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncRunMutation) OldTrigger(ctx context.Context) (v syncrun.Trigger, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrigger is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrigger requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrigger: %w", err)
+	}
+	return oldValue.Trigger, nil
+}
+
+// ResetTrigger resets all changes to the "trigger" field.
+func (m *SyncRunMutation) ResetTrigger() {
+	m.trigger = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *SyncRunMutation) SetStatus(s syncrun.Status) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *SyncRunMutation) Status() (r syncrun.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncRunMutation) OldStatus(ctx context.Context) (v syncrun.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *SyncRunMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAddedCount sets the "added_count" field.
+func (m *SyncRunMutation) SetAddedCount(i int) {
+	m.added_count = &i
+	m.addadded_count = nil
+}
+
+// AddedCount returns the value of the "added_count" field in the mutation.
+func (m *SyncRunMutation) AddedCount() (r int, exists bool) {
+	v := m.added_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddedCount returns the old "added_count" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncRunMutation) OldAddedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddedCount: %w", err)
+	}
+	return oldValue.AddedCount, nil
+}
+
+// AddAddedCount adds i to the "added_count" field.
+func (m *SyncRunMutation) AddAddedCount(i int) {
+	if m.addadded_count != nil {
+		*m.addadded_count += i
+	} else {
+		m.addadded_count = &i
+	}
+}
+
+// AddedAddedCount returns the value that was added to the "added_count" field in this mutation.
+func (m *SyncRunMutation) AddedAddedCount() (r int, exists bool) {
+	v := m.addadded_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAddedCount resets all changes to the "added_count" field.
+func (m *SyncRunMutation) ResetAddedCount() {
+	m.added_count = nil
+	m.addadded_count = nil
+}
+
+// SetModifiedCount sets the "modified_count" field.
+func (m *SyncRunMutation) SetModifiedCount(i int) {
+	m.modified_count = &i
+	m.addmodified_count = nil
+}
+
+// ModifiedCount returns the value of the "modified_count" field in the mutation.
+func (m *SyncRunMutation) ModifiedCount() (r int, exists bool) {
+	v := m.modified_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModifiedCount returns the old "modified_count" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SyncRunMutation) OldModifiedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModifiedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModifiedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModifiedCount: %w", err)
+	}
+	return oldValue.ModifiedCount, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SyncCursor entities.
-func (m *SyncCursorMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// AddModifiedCount adds i to the "modified_count" field.
+func (m *SyncRunMutation) AddModifiedCount(i int) {
+	if m.addmodified_count != nil {
+		*m.addmodified_count += i
+	} else {
+		m.addmodified_count = &i
+	}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *SyncCursorMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// AddedModifiedCount returns the value that was added to the "modified_count" field in this mutation.
+func (m *SyncRunMutation) AddedModifiedCount() (r int, exists bool) {
+	v := m.addmodified_count
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *SyncCursorMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SyncCursor.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetModifiedCount resets all changes to the "modified_count" field.
+func (m *SyncRunMutation) ResetModifiedCount() {
+	m.modified_count = nil
+	m.addmodified_count = nil
 }
 
-// SetItemID sets the "item_id" field.
-func (m *SyncCursorMutation) SetItemID(u uuid.UUID) {
-	m.item = &u
+// SetRemovedCount sets the "removed_count" field.
+func (m *SyncRunMutation) SetRemovedCount(i int) {
+	m.removed_count = &i
+	m.addremoved_count = nil
 }
 
-// ItemID returns the value of the "item_id" field in the mutation.
-func (m *SyncCursorMutation) ItemID() (r uuid.UUID, exists bool) {
-	v := m.item
+// RemovedCount returns the value of the "removed_count" field in the mutation.
+func (m *SyncRunMutation) RemovedCount() (r int, exists bool) {
+	v := m.removed_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldItemID returns the old "item_id" field's value of the SyncCursor entity.
-// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
+// OldRemovedCount returns the old "removed_count" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SyncCursorMutation) OldItemID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SyncRunMutation) OldRemovedCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldItemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemovedCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldItemID requires an ID field in the mutation")
+		return v, errors.New("OldRemovedCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldItemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemovedCount: %w", err)
 	}
-	return oldValue.ItemID, nil
+	return oldValue.RemovedCount, nil
 }
 
-// ResetItemID resets all changes to the "item_id" field.
-func (m *SyncCursorMutation) ResetItemID() {
-	m.item = nil
+// AddRemovedCount adds i to the "removed_count" field.
+func (m *SyncRunMutation) AddRemovedCount(i int) {
+	if m.addremoved_count != nil {
+		*m.addremoved_count += i
+	} else {
+		m.addremoved_count = &i
+	}
 }
 
-// SetCursor sets the "cursor" field.
-func (m *SyncCursorMutation) SetCursor(s string) {
-	m.cursor = &s
+// AddedRemovedCount returns the value that was added to the "removed_count" field in this mutation.
+func (m *SyncRunMutation) AddedRemovedCount() (r int, exists bool) {
+	v := m.addremoved_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Cursor returns the value of the "cursor" field in the mutation.
-func (m *SyncCursorMutation) Cursor() (r string, exists bool) {
-	v := m.cursor
+// ResetRemovedCount resets all changes to the "removed_count" field.
+func (m *SyncRunMutation) ResetRemovedCount() {
+	m.removed_count = nil
+	m.addremoved_count = nil
+}
+
+// SetRulesTriggered sets the "rules_triggered" field.
+func (m *SyncRunMutation) SetRulesTriggered(i int) {
+	m.rules_triggered = &i
+	m.addrules_triggered = nil
+}
+
+// RulesTriggered returns the value of the "rules_triggered" field in the mutation.
+func (m *SyncRunMutation) RulesTriggered() (r int, exists bool) {
+	v := m.rules_triggered
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCursor returns the old "cursor" field's value of the SyncCursor entity.
-// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
+// OldRulesTriggered returns the old "rules_triggered" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SyncCursorMutation) OldCursor(ctx context.Context) (v string, err error) {
+func (m *SyncRunMutation) OldRulesTriggered(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCursor is only allowed on UpdateOne operations")
+		return v, errors.New("OldRulesTriggered is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCursor requires an ID field in the mutation")
+		return v, errors.New("OldRulesTriggered requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCursor: %w", err)
+		return v, fmt.Errorf("querying old value for OldRulesTriggered: %w", err)
 	}
-	return oldValue.Cursor, nil
+	return oldValue.RulesTriggered, nil
 }
 
-// ResetCursor resets all changes to the "cursor" field.
-func (m *SyncCursorMutation) ResetCursor() {
-	m.cursor = nil
+// AddRulesTriggered adds i to the "rules_triggered" field.
+func (m *SyncRunMutation) AddRulesTriggered(i int) {
+	if m.addrules_triggered != nil {
+		*m.addrules_triggered += i
+	} else {
+		m.addrules_triggered = &i
+	}
 }
 
-// SetLastSyncAt sets the "last_sync_at" field.
-func (m *SyncCursorMutation) SetLastSyncAt(t time.Time) {
-	m.last_sync_at = &t
+// AddedRulesTriggered returns the value that was added to the "rules_triggered" field in this mutation.
+func (m *SyncRunMutation) AddedRulesTriggered() (r int, exists bool) {
+	v := m.addrules_triggered
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// LastSyncAt returns the value of the "last_sync_at" field in the mutation.
-func (m *SyncCursorMutation) LastSyncAt() (r time.Time, exists bool) {
-	v := m.last_sync_at
+// ResetRulesTriggered resets all changes to the "rules_triggered" field.
+func (m *SyncRunMutation) ResetRulesTriggered() {
+	m.rules_triggered = nil
+	m.addrules_triggered = nil
+}
+
+// SetError sets the "error" field.
+func (m *SyncRunMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *SyncRunMutation) Error() (r string, exists bool) {
+	v := m.error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastSyncAt returns the old "last_sync_at" field's value of the SyncCursor entity.
-// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
+// OldError returns the old "error" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SyncCursorMutation) OldLastSyncAt(ctx context.Context) (v time.Time, err error) {
+func (m *SyncRunMutation) OldError(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastSyncAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastSyncAt requires an ID field in the mutation")
+		return v, errors.New("OldError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastSyncAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
 	}
-	return oldValue.LastSyncAt, nil
+	return oldValue.Error, nil
 }
 
-// ResetLastSyncAt resets all changes to the "last_sync_at" field.
-func (m *SyncCursorMutation) ResetLastSyncAt() {
-	m.last_sync_at = nil
+// ClearError clears the value of the "error" field.
+func (m *SyncRunMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[syncrun.FieldError] = struct{}{}
 }
 
-// SetLastError sets the "last_error" field.
-func (m *SyncCursorMutation) SetLastError(s string) {
-	m.last_error = &s
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *SyncRunMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[syncrun.FieldError]
+	return ok
 }
 
-// LastError returns the value of the "last_error" field in the mutation.
-func (m *SyncCursorMutation) LastError() (r string, exists bool) {
-	v := m.last_error
+// ResetError resets all changes to the "error" field.
+func (m *SyncRunMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, syncrun.FieldError)
+}
+
+// SetStartedAt sets the "started_at" field.
+func (m *SyncRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
+}
+
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *SyncRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastError returns the old "last_error" field's value of the SyncCursor entity.
-// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SyncCursorMutation) OldLastError(ctx context.Context) (v string, err error) {
+func (m *SyncRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastError requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return oldValue.LastError, nil
+	return oldValue.StartedAt, nil
 }
 
-// ResetLastError resets all changes to the "last_error" field.
-func (m *SyncCursorMutation) ResetLastError() {
-	m.last_error = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *SyncRunMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetConsecutiveFailures sets the "consecutive_failures" field.
-func (m *SyncCursorMutation) SetConsecutiveFailures(i int) {
-	m.consecutive_failures = &i
-	m.addconsecutive_failures = nil
+// SetFinishedAt sets the "finished_at" field.
+func (m *SyncRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// ConsecutiveFailures returns the value of the "consecutive_failures" field in the mutation.
-func (m *SyncCursorMutation) ConsecutiveFailures() (r int, exists bool) {
-	v := m.consecutive_failures
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *SyncRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldConsecutiveFailures returns the old "consecutive_failures" field's value of the SyncCursor entity.
-// If the SyncCursor object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the SyncRun entity.
+// If the SyncRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SyncCursorMutation) OldConsecutiveFailures(ctx context.Context) (v int, err error) {
+func (m *SyncRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldConsecutiveFailures is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldConsecutiveFailures requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldConsecutiveFailures: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.ConsecutiveFailures, nil
+	return oldValue.FinishedAt, nil
 }
 
-// AddConsecutiveFailures adds i to the "consecutive_failures" field.
-func (m *SyncCursorMutation) AddConsecutiveFailures(i int) {
-	if m.addconsecutive_failures != nil {
-		*m.addconsecutive_failures += i
-	} else {
-		m.addconsecutive_failures = &i
-	}
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *SyncRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[syncrun.FieldFinishedAt] = struct{}{}
 }
 
-// AddedConsecutiveFailures returns the value that was added to the "consecutive_failures" field in this mutation.
-func (m *SyncCursorMutation) AddedConsecutiveFailures() (r int, exists bool) {
-	v := m.addconsecutive_failures
-	if v == nil {
-		return
-	}
-	return *v, true
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *SyncRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[syncrun.FieldFinishedAt]
+	return ok
 }
 
-// ResetConsecutiveFailures resets all changes to the "consecutive_failures" field.
-func (m *SyncCursorMutation) ResetConsecutiveFailures() {
-	m.consecutive_failures = nil
-	m.addconsecutive_failures = nil
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *SyncRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, syncrun.FieldFinishedAt)
 }
 
 // ClearItem clears the "item" edge to the Item entity.
-func (m *SyncCursorMutation) ClearItem() {
+func (m *SyncRunMutation) ClearItem() {
 	m.cleareditem = true
-	m.clearedFields[synccursor.FieldItemID] = struct{}{}
+	m.clearedFields[syncrun.FieldItemID] = struct{}{}
 }
 
 // ItemCleared reports if the "item" edge to the Item entity was cleared.
-func (m *SyncCursorMutation) ItemCleared() bool {
+func (m *SyncRunMutation) ItemCleared() bool {
 	return m.cleareditem
 }
 
 // ItemIDs returns the "item" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ItemID instead. It exists only for internal usage by the builders.
-func (m *SyncCursorMutation) ItemIDs() (ids []uuid.UUID) {
+func (m *SyncRunMutation) ItemIDs() (ids []uuid.UUID) {
 	if id := m.item; id != nil {
 		ids = append(ids, *id)
 	}
@@ -7112,20 +18666,20 @@ func (m *SyncCursorMutation) ItemIDs() (ids []uuid.UUID) {
 }
 
 // ResetItem resets all changes to the "item" edge.
-func (m *SyncCursorMutation) ResetItem() {
+func (m *SyncRunMutation) ResetItem() {
 	m.item = nil
 	m.cleareditem = false
 }
 
-// Where appends a list predicates to the SyncCursorMutation builder.
-func (m *SyncCursorMutation) Where(ps ...predicate.SyncCursor) {
+// Where appends a list predicates to the SyncRunMutation builder.
+func (m *SyncRunMutation) Where(ps ...predicate.SyncRun) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the SyncCursorMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SyncRunMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SyncCursorMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SyncCursor, len(ps))
+func (m *SyncRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SyncRun, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7133,39 +18687,54 @@ func (m *SyncCursorMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *SyncCursorMutation) Op() Op {
+func (m *SyncRunMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *SyncCursorMutation) SetOp(op Op) {
+func (m *SyncRunMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (SyncCursor).
-func (m *SyncCursorMutation) Type() string {
+// Type returns the node type of this mutation (SyncRun).
+func (m *SyncRunMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *SyncCursorMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+func (m *SyncRunMutation) Fields() []string {
+	fields := make([]string, 0, 10)
 	if m.item != nil {
-		fields = append(fields, synccursor.FieldItemID)
+		fields = append(fields, syncrun.FieldItemID)
 	}
-	if m.cursor != nil {
-		fields = append(fields, synccursor.FieldCursor)
+	if m.trigger != nil {
+		fields = append(fields, syncrun.FieldTrigger)
 	}
-	if m.last_sync_at != nil {
-		fields = append(fields, synccursor.FieldLastSyncAt)
+	if m.status != nil {
+		fields = append(fields, syncrun.FieldStatus)
 	}
-	if m.last_error != nil {
-		fields = append(fields, synccursor.FieldLastError)
+	if m.added_count != nil {
+		fields = append(fields, syncrun.FieldAddedCount)
 	}
-	if m.consecutive_failures != nil {
-		fields = append(fields, synccursor.FieldConsecutiveFailures)
+	if m.modified_count != nil {
+		fields = append(fields, syncrun.FieldModifiedCount)
+	}
+	if m.removed_count != nil {
+		fields = append(fields, syncrun.FieldRemovedCount)
+	}
+	if m.rules_triggered != nil {
+		fields = append(fields, syncrun.FieldRulesTriggered)
+	}
+	if m.error != nil {
+		fields = append(fields, syncrun.FieldError)
+	}
+	if m.started_at != nil {
+		fields = append(fields, syncrun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, syncrun.FieldFinishedAt)
 	}
 	return fields
 }
@@ -7173,18 +18742,28 @@ func (m *SyncCursorMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SyncCursorMutation) Field(name string) (ent.Value, bool) {
+func (m *SyncRunMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case synccursor.FieldItemID:
+	case syncrun.FieldItemID:
 		return m.ItemID()
-	case synccursor.FieldCursor:
-		return m.Cursor()
-	case synccursor.FieldLastSyncAt:
-		return m.LastSyncAt()
-	case synccursor.FieldLastError:
-		return m.LastError()
-	case synccursor.FieldConsecutiveFailures:
-		return m.ConsecutiveFailures()
+	case syncrun.FieldTrigger:
+		return m.Trigger()
+	case syncrun.FieldStatus:
+		return m.Status()
+	case syncrun.FieldAddedCount:
+		return m.AddedCount()
+	case syncrun.FieldModifiedCount:
+		return m.ModifiedCount()
+	case syncrun.FieldRemovedCount:
+		return m.RemovedCount()
+	case syncrun.FieldRulesTriggered:
+		return m.RulesTriggered()
+	case syncrun.FieldError:
+		return m.Error()
+	case syncrun.FieldStartedAt:
+		return m.StartedAt()
+	case syncrun.FieldFinishedAt:
+		return m.FinishedAt()
 	}
 	return nil, false
 }
@@ -7192,72 +18771,126 @@ func (m *SyncCursorMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SyncCursorMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SyncRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case synccursor.FieldItemID:
+	case syncrun.FieldItemID:
 		return m.OldItemID(ctx)
-	case synccursor.FieldCursor:
-		return m.OldCursor(ctx)
-	case synccursor.FieldLastSyncAt:
-		return m.OldLastSyncAt(ctx)
-	case synccursor.FieldLastError:
-		return m.OldLastError(ctx)
-	case synccursor.FieldConsecutiveFailures:
-		return m.OldConsecutiveFailures(ctx)
-	}
-	return nil, fmt.Errorf("unknown SyncCursor field %s", name)
+	case syncrun.FieldTrigger:
+		return m.OldTrigger(ctx)
+	case syncrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case syncrun.FieldAddedCount:
+		return m.OldAddedCount(ctx)
+	case syncrun.FieldModifiedCount:
+		return m.OldModifiedCount(ctx)
+	case syncrun.FieldRemovedCount:
+		return m.OldRemovedCount(ctx)
+	case syncrun.FieldRulesTriggered:
+		return m.OldRulesTriggered(ctx)
+	case syncrun.FieldError:
+		return m.OldError(ctx)
+	case syncrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case syncrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SyncRun field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SyncCursorMutation) SetField(name string, value ent.Value) error {
+func (m *SyncRunMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case synccursor.FieldItemID:
+	case syncrun.FieldItemID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetItemID(v)
 		return nil
-	case synccursor.FieldCursor:
-		v, ok := value.(string)
+	case syncrun.FieldTrigger:
+		v, ok := value.(syncrun.Trigger)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCursor(v)
+		m.SetTrigger(v)
 		return nil
-	case synccursor.FieldLastSyncAt:
-		v, ok := value.(time.Time)
+	case syncrun.FieldStatus:
+		v, ok := value.(syncrun.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastSyncAt(v)
+		m.SetStatus(v)
 		return nil
-	case synccursor.FieldLastError:
+	case syncrun.FieldAddedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddedCount(v)
+		return nil
+	case syncrun.FieldModifiedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModifiedCount(v)
+		return nil
+	case syncrun.FieldRemovedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemovedCount(v)
+		return nil
+	case syncrun.FieldRulesTriggered:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRulesTriggered(v)
+		return nil
+	case syncrun.FieldError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastError(v)
+		m.SetError(v)
+		return nil
+	case syncrun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
 		return nil
-	case synccursor.FieldConsecutiveFailures:
-		v, ok := value.(int)
+	case syncrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConsecutiveFailures(v)
+		m.SetFinishedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SyncCursor field %s", name)
+	return fmt.Errorf("unknown SyncRun field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SyncCursorMutation) AddedFields() []string {
+func (m *SyncRunMutation) AddedFields() []string {
 	var fields []string
-	if m.addconsecutive_failures != nil {
-		fields = append(fields, synccursor.FieldConsecutiveFailures)
+	if m.addadded_count != nil {
+		fields = append(fields, syncrun.FieldAddedCount)
+	}
+	if m.addmodified_count != nil {
+		fields = append(fields, syncrun.FieldModifiedCount)
+	}
+	if m.addremoved_count != nil {
+		fields = append(fields, syncrun.FieldRemovedCount)
+	}
+	if m.addrules_triggered != nil {
+		fields = append(fields, syncrun.FieldRulesTriggered)
 	}
 	return fields
 }
@@ -7265,10 +18898,16 @@ func (m *SyncCursorMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SyncCursorMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SyncRunMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case synccursor.FieldConsecutiveFailures:
-		return m.AddedConsecutiveFailures()
+	case syncrun.FieldAddedCount:
+		return m.AddedAddedCount()
+	case syncrun.FieldModifiedCount:
+		return m.AddedModifiedCount()
+	case syncrun.FieldRemovedCount:
+		return m.AddedRemovedCount()
+	case syncrun.FieldRulesTriggered:
+		return m.AddedRulesTriggered()
 	}
 	return nil, false
 }
@@ -7276,75 +18915,126 @@ func (m *SyncCursorMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SyncCursorMutation) AddField(name string, value ent.Value) error {
+func (m *SyncRunMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case synccursor.FieldConsecutiveFailures:
+	case syncrun.FieldAddedCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddConsecutiveFailures(v)
+		m.AddAddedCount(v)
+		return nil
+	case syncrun.FieldModifiedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddModifiedCount(v)
+		return nil
+	case syncrun.FieldRemovedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRemovedCount(v)
+		return nil
+	case syncrun.FieldRulesTriggered:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRulesTriggered(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SyncCursor numeric field %s", name)
+	return fmt.Errorf("unknown SyncRun numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SyncCursorMutation) ClearedFields() []string {
-	return nil
+func (m *SyncRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(syncrun.FieldError) {
+		fields = append(fields, syncrun.FieldError)
+	}
+	if m.FieldCleared(syncrun.FieldFinishedAt) {
+		fields = append(fields, syncrun.FieldFinishedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SyncCursorMutation) FieldCleared(name string) bool {
+func (m *SyncRunMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SyncCursorMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown SyncCursor nullable field %s", name)
+func (m *SyncRunMutation) ClearField(name string) error {
+	switch name {
+	case syncrun.FieldError:
+		m.ClearError()
+		return nil
+	case syncrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SyncRun nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SyncCursorMutation) ResetField(name string) error {
+func (m *SyncRunMutation) ResetField(name string) error {
 	switch name {
-	case synccursor.FieldItemID:
+	case syncrun.FieldItemID:
 		m.ResetItemID()
 		return nil
-	case synccursor.FieldCursor:
-		m.ResetCursor()
+	case syncrun.FieldTrigger:
+		m.ResetTrigger()
 		return nil
-	case synccursor.FieldLastSyncAt:
-		m.ResetLastSyncAt()
+	case syncrun.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case synccursor.FieldLastError:
-		m.ResetLastError()
+	case syncrun.FieldAddedCount:
+		m.ResetAddedCount()
 		return nil
-	case synccursor.FieldConsecutiveFailures:
-		m.ResetConsecutiveFailures()
+	case syncrun.FieldModifiedCount:
+		m.ResetModifiedCount()
+		return nil
+	case syncrun.FieldRemovedCount:
+		m.ResetRemovedCount()
+		return nil
+	case syncrun.FieldRulesTriggered:
+		m.ResetRulesTriggered()
+		return nil
+	case syncrun.FieldError:
+		m.ResetError()
+		return nil
+	case syncrun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case syncrun.FieldFinishedAt:
+		m.ResetFinishedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SyncCursor field %s", name)
+	return fmt.Errorf("unknown SyncRun field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SyncCursorMutation) AddedEdges() []string {
+func (m *SyncRunMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.item != nil {
-		edges = append(edges, synccursor.EdgeItem)
+		edges = append(edges, syncrun.EdgeItem)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SyncCursorMutation) AddedIDs(name string) []ent.Value {
+func (m *SyncRunMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case synccursor.EdgeItem:
+	case syncrun.EdgeItem:
 		if id := m.item; id != nil {
 			return []ent.Value{*id}
 		}
@@ -7353,31 +19043,31 @@ func (m *SyncCursorMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SyncCursorMutation) RemovedEdges() []string {
+func (m *SyncRunMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SyncCursorMutation) RemovedIDs(name string) []ent.Value {
+func (m *SyncRunMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SyncCursorMutation) ClearedEdges() []string {
+func (m *SyncRunMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareditem {
-		edges = append(edges, synccursor.EdgeItem)
+		edges = append(edges, syncrun.EdgeItem)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SyncCursorMutation) EdgeCleared(name string) bool {
+func (m *SyncRunMutation) EdgeCleared(name string) bool {
 	switch name {
-	case synccursor.EdgeItem:
+	case syncrun.EdgeItem:
 		return m.cleareditem
 	}
 	return false
@@ -7385,54 +19075,61 @@ func (m *SyncCursorMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SyncCursorMutation) ClearEdge(name string) error {
+func (m *SyncRunMutation) ClearEdge(name string) error {
 	switch name {
-	case synccursor.EdgeItem:
+	case syncrun.EdgeItem:
 		m.ClearItem()
 		return nil
 	}
-	return fmt.Errorf("unknown SyncCursor unique edge %s", name)
+	return fmt.Errorf("unknown SyncRun unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SyncCursorMutation) ResetEdge(name string) error {
+func (m *SyncRunMutation) ResetEdge(name string) error {
 	switch name {
-	case synccursor.EdgeItem:
+	case syncrun.EdgeItem:
 		m.ResetItem()
 		return nil
 	}
-	return fmt.Errorf("unknown SyncCursor edge %s", name)
+	return fmt.Errorf("unknown SyncRun edge %s", name)
 }
 
 // TransactionMutation represents an operation that mutates the Transaction nodes in the graph.
 type TransactionMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	plaid_id               *string
-	amount                 *int64
-	addamount              *int64
-	date                   *time.Time
-	name                   *string
-	merchant_name          *string
-	category               *string
-	plaid_categories       *[]string
-	appendplaid_categories []string
-	pending                *bool
-	payment_channel        *string
-	created_at             *time.Time
-	updated_at             *time.Time
-	clearedFields          map[string]struct{}
-	account                *uuid.UUID
-	clearedaccount         bool
-	rule_executions        map[uuid.UUID]struct{}
-	removedrule_executions map[uuid.UUID]struct{}
-	clearedrule_executions bool
-	done                   bool
-	oldValue               func(context.Context) (*Transaction, error)
-	predicates             []predicate.Transaction
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	external_id               *string
+	amount                    *int64
+	addamount                 *int64
+	currency                  *string
+	date                      *time.Time
+	name                      *string
+	merchant_name             *string
+	category                  *string
+	provider_categories       *[]string
+	appendprovider_categories []string
+	pending                   *bool
+	pending_transaction_id    *string
+	payment_channel           *string
+	internal_transfer         *bool
+	transfer_pair_id          *uuid.UUID
+	anomaly_score             *float64
+	addanomaly_score          *float64
+	anomaly_reason            *string
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	account                   *uuid.UUID
+	clearedaccount            bool
+	rule_executions           map[uuid.UUID]struct{}
+	removedrule_executions    map[uuid.UUID]struct{}
+	clearedrule_executions    bool
+	done                      bool
+	oldValue                  func(context.Context) (*Transaction, error)
+	predicates                []predicate.Transaction
 }
 
 var _ ent.Mutation = (*TransactionMutation)(nil)
@@ -7575,40 +19272,40 @@ func (m *TransactionMutation) ResetAccountID() {
 	m.account = nil
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (m *TransactionMutation) SetPlaidID(s string) {
-	m.plaid_id = &s
+// SetExternalID sets the "external_id" field.
+func (m *TransactionMutation) SetExternalID(s string) {
+	m.external_id = &s
 }
 
-// PlaidID returns the value of the "plaid_id" field in the mutation.
-func (m *TransactionMutation) PlaidID() (r string, exists bool) {
-	v := m.plaid_id
+// ExternalID returns the value of the "external_id" field in the mutation.
+func (m *TransactionMutation) ExternalID() (r string, exists bool) {
+	v := m.external_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlaidID returns the old "plaid_id" field's value of the Transaction entity.
+// OldExternalID returns the old "external_id" field's value of the Transaction entity.
 // If the Transaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldPlaidID(ctx context.Context) (v string, err error) {
+func (m *TransactionMutation) OldExternalID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaidID is only allowed on UpdateOne operations")
+		return v, errors.New("OldExternalID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaidID requires an ID field in the mutation")
+		return v, errors.New("OldExternalID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaidID: %w", err)
+		return v, fmt.Errorf("querying old value for OldExternalID: %w", err)
 	}
-	return oldValue.PlaidID, nil
+	return oldValue.ExternalID, nil
 }
 
-// ResetPlaidID resets all changes to the "plaid_id" field.
-func (m *TransactionMutation) ResetPlaidID() {
-	m.plaid_id = nil
+// ResetExternalID resets all changes to the "external_id" field.
+func (m *TransactionMutation) ResetExternalID() {
+	m.external_id = nil
 }
 
 // SetAmount sets the "amount" field.
@@ -7667,6 +19364,42 @@ func (m *TransactionMutation) ResetAmount() {
 	m.addamount = nil
 }
 
+// SetCurrency sets the "currency" field.
+func (m *TransactionMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *TransactionMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *TransactionMutation) ResetCurrency() {
+	m.currency = nil
+}
+
 // SetDate sets the "date" field.
 func (m *TransactionMutation) SetDate(t time.Time) {
 	m.date = &t
@@ -7824,69 +19557,69 @@ func (m *TransactionMutation) ResetCategory() {
 	m.category = nil
 }
 
-// SetPlaidCategories sets the "plaid_categories" field.
-func (m *TransactionMutation) SetPlaidCategories(s []string) {
-	m.plaid_categories = &s
-	m.appendplaid_categories = nil
+// SetProviderCategories sets the "provider_categories" field.
+func (m *TransactionMutation) SetProviderCategories(s []string) {
+	m.provider_categories = &s
+	m.appendprovider_categories = nil
 }
 
-// PlaidCategories returns the value of the "plaid_categories" field in the mutation.
-func (m *TransactionMutation) PlaidCategories() (r []string, exists bool) {
-	v := m.plaid_categories
+// ProviderCategories returns the value of the "provider_categories" field in the mutation.
+func (m *TransactionMutation) ProviderCategories() (r []string, exists bool) {
+	v := m.provider_categories
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPlaidCategories returns the old "plaid_categories" field's value of the Transaction entity.
+// OldProviderCategories returns the old "provider_categories" field's value of the Transaction entity.
 // If the Transaction object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *TransactionMutation) OldPlaidCategories(ctx context.Context) (v []string, err error) {
+func (m *TransactionMutation) OldProviderCategories(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlaidCategories is only allowed on UpdateOne operations")
+		return v, errors.New("OldProviderCategories is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlaidCategories requires an ID field in the mutation")
+		return v, errors.New("OldProviderCategories requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlaidCategories: %w", err)
+		return v, fmt.Errorf("querying old value for OldProviderCategories: %w", err)
 	}
-	return oldValue.PlaidCategories, nil
+	return oldValue.ProviderCategories, nil
 }
 
-// AppendPlaidCategories adds s to the "plaid_categories" field.
-func (m *TransactionMutation) AppendPlaidCategories(s []string) {
-	m.appendplaid_categories = append(m.appendplaid_categories, s...)
+// AppendProviderCategories adds s to the "provider_categories" field.
+func (m *TransactionMutation) AppendProviderCategories(s []string) {
+	m.appendprovider_categories = append(m.appendprovider_categories, s...)
 }
 
-// AppendedPlaidCategories returns the list of values that were appended to the "plaid_categories" field in this mutation.
-func (m *TransactionMutation) AppendedPlaidCategories() ([]string, bool) {
-	if len(m.appendplaid_categories) == 0 {
+// AppendedProviderCategories returns the list of values that were appended to the "provider_categories" field in this mutation.
+func (m *TransactionMutation) AppendedProviderCategories() ([]string, bool) {
+	if len(m.appendprovider_categories) == 0 {
 		return nil, false
 	}
-	return m.appendplaid_categories, true
+	return m.appendprovider_categories, true
 }
 
-// ClearPlaidCategories clears the value of the "plaid_categories" field.
-func (m *TransactionMutation) ClearPlaidCategories() {
-	m.plaid_categories = nil
-	m.appendplaid_categories = nil
-	m.clearedFields[transaction.FieldPlaidCategories] = struct{}{}
+// ClearProviderCategories clears the value of the "provider_categories" field.
+func (m *TransactionMutation) ClearProviderCategories() {
+	m.provider_categories = nil
+	m.appendprovider_categories = nil
+	m.clearedFields[transaction.FieldProviderCategories] = struct{}{}
 }
 
-// PlaidCategoriesCleared returns if the "plaid_categories" field was cleared in this mutation.
-func (m *TransactionMutation) PlaidCategoriesCleared() bool {
-	_, ok := m.clearedFields[transaction.FieldPlaidCategories]
+// ProviderCategoriesCleared returns if the "provider_categories" field was cleared in this mutation.
+func (m *TransactionMutation) ProviderCategoriesCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldProviderCategories]
 	return ok
 }
 
-// ResetPlaidCategories resets all changes to the "plaid_categories" field.
-func (m *TransactionMutation) ResetPlaidCategories() {
-	m.plaid_categories = nil
-	m.appendplaid_categories = nil
-	delete(m.clearedFields, transaction.FieldPlaidCategories)
+// ResetProviderCategories resets all changes to the "provider_categories" field.
+func (m *TransactionMutation) ResetProviderCategories() {
+	m.provider_categories = nil
+	m.appendprovider_categories = nil
+	delete(m.clearedFields, transaction.FieldProviderCategories)
 }
 
 // SetPending sets the "pending" field.
@@ -7925,6 +19658,55 @@ func (m *TransactionMutation) ResetPending() {
 	m.pending = nil
 }
 
+// SetPendingTransactionID sets the "pending_transaction_id" field.
+func (m *TransactionMutation) SetPendingTransactionID(s string) {
+	m.pending_transaction_id = &s
+}
+
+// PendingTransactionID returns the value of the "pending_transaction_id" field in the mutation.
+func (m *TransactionMutation) PendingTransactionID() (r string, exists bool) {
+	v := m.pending_transaction_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingTransactionID returns the old "pending_transaction_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldPendingTransactionID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingTransactionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingTransactionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingTransactionID: %w", err)
+	}
+	return oldValue.PendingTransactionID, nil
+}
+
+// ClearPendingTransactionID clears the value of the "pending_transaction_id" field.
+func (m *TransactionMutation) ClearPendingTransactionID() {
+	m.pending_transaction_id = nil
+	m.clearedFields[transaction.FieldPendingTransactionID] = struct{}{}
+}
+
+// PendingTransactionIDCleared returns if the "pending_transaction_id" field was cleared in this mutation.
+func (m *TransactionMutation) PendingTransactionIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldPendingTransactionID]
+	return ok
+}
+
+// ResetPendingTransactionID resets all changes to the "pending_transaction_id" field.
+func (m *TransactionMutation) ResetPendingTransactionID() {
+	m.pending_transaction_id = nil
+	delete(m.clearedFields, transaction.FieldPendingTransactionID)
+}
+
 // SetPaymentChannel sets the "payment_channel" field.
 func (m *TransactionMutation) SetPaymentChannel(s string) {
 	m.payment_channel = &s
@@ -7974,6 +19756,210 @@ func (m *TransactionMutation) ResetPaymentChannel() {
 	delete(m.clearedFields, transaction.FieldPaymentChannel)
 }
 
+// SetInternalTransfer sets the "internal_transfer" field.
+func (m *TransactionMutation) SetInternalTransfer(b bool) {
+	m.internal_transfer = &b
+}
+
+// InternalTransfer returns the value of the "internal_transfer" field in the mutation.
+func (m *TransactionMutation) InternalTransfer() (r bool, exists bool) {
+	v := m.internal_transfer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInternalTransfer returns the old "internal_transfer" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldInternalTransfer(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInternalTransfer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInternalTransfer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInternalTransfer: %w", err)
+	}
+	return oldValue.InternalTransfer, nil
+}
+
+// ResetInternalTransfer resets all changes to the "internal_transfer" field.
+func (m *TransactionMutation) ResetInternalTransfer() {
+	m.internal_transfer = nil
+}
+
+// SetTransferPairID sets the "transfer_pair_id" field.
+func (m *TransactionMutation) SetTransferPairID(u uuid.UUID) {
+	m.transfer_pair_id = &u
+}
+
+// TransferPairID returns the value of the "transfer_pair_id" field in the mutation.
+func (m *TransactionMutation) TransferPairID() (r uuid.UUID, exists bool) {
+	v := m.transfer_pair_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTransferPairID returns the old "transfer_pair_id" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldTransferPairID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTransferPairID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTransferPairID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTransferPairID: %w", err)
+	}
+	return oldValue.TransferPairID, nil
+}
+
+// ClearTransferPairID clears the value of the "transfer_pair_id" field.
+func (m *TransactionMutation) ClearTransferPairID() {
+	m.transfer_pair_id = nil
+	m.clearedFields[transaction.FieldTransferPairID] = struct{}{}
+}
+
+// TransferPairIDCleared returns if the "transfer_pair_id" field was cleared in this mutation.
+func (m *TransactionMutation) TransferPairIDCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldTransferPairID]
+	return ok
+}
+
+// ResetTransferPairID resets all changes to the "transfer_pair_id" field.
+func (m *TransactionMutation) ResetTransferPairID() {
+	m.transfer_pair_id = nil
+	delete(m.clearedFields, transaction.FieldTransferPairID)
+}
+
+// SetAnomalyScore sets the "anomaly_score" field.
+func (m *TransactionMutation) SetAnomalyScore(f float64) {
+	m.anomaly_score = &f
+	m.addanomaly_score = nil
+}
+
+// AnomalyScore returns the value of the "anomaly_score" field in the mutation.
+func (m *TransactionMutation) AnomalyScore() (r float64, exists bool) {
+	v := m.anomaly_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnomalyScore returns the old "anomaly_score" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldAnomalyScore(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnomalyScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnomalyScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnomalyScore: %w", err)
+	}
+	return oldValue.AnomalyScore, nil
+}
+
+// AddAnomalyScore adds f to the "anomaly_score" field.
+func (m *TransactionMutation) AddAnomalyScore(f float64) {
+	if m.addanomaly_score != nil {
+		*m.addanomaly_score += f
+	} else {
+		m.addanomaly_score = &f
+	}
+}
+
+// AddedAnomalyScore returns the value that was added to the "anomaly_score" field in this mutation.
+func (m *TransactionMutation) AddedAnomalyScore() (r float64, exists bool) {
+	v := m.addanomaly_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAnomalyScore clears the value of the "anomaly_score" field.
+func (m *TransactionMutation) ClearAnomalyScore() {
+	m.anomaly_score = nil
+	m.addanomaly_score = nil
+	m.clearedFields[transaction.FieldAnomalyScore] = struct{}{}
+}
+
+// AnomalyScoreCleared returns if the "anomaly_score" field was cleared in this mutation.
+func (m *TransactionMutation) AnomalyScoreCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldAnomalyScore]
+	return ok
+}
+
+// ResetAnomalyScore resets all changes to the "anomaly_score" field.
+func (m *TransactionMutation) ResetAnomalyScore() {
+	m.anomaly_score = nil
+	m.addanomaly_score = nil
+	delete(m.clearedFields, transaction.FieldAnomalyScore)
+}
+
+// SetAnomalyReason sets the "anomaly_reason" field.
+func (m *TransactionMutation) SetAnomalyReason(s string) {
+	m.anomaly_reason = &s
+}
+
+// AnomalyReason returns the value of the "anomaly_reason" field in the mutation.
+func (m *TransactionMutation) AnomalyReason() (r string, exists bool) {
+	v := m.anomaly_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnomalyReason returns the old "anomaly_reason" field's value of the Transaction entity.
+// If the Transaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TransactionMutation) OldAnomalyReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnomalyReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnomalyReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnomalyReason: %w", err)
+	}
+	return oldValue.AnomalyReason, nil
+}
+
+// ClearAnomalyReason clears the value of the "anomaly_reason" field.
+func (m *TransactionMutation) ClearAnomalyReason() {
+	m.anomaly_reason = nil
+	m.clearedFields[transaction.FieldAnomalyReason] = struct{}{}
+}
+
+// AnomalyReasonCleared returns if the "anomaly_reason" field was cleared in this mutation.
+func (m *TransactionMutation) AnomalyReasonCleared() bool {
+	_, ok := m.clearedFields[transaction.FieldAnomalyReason]
+	return ok
+}
+
+// ResetAnomalyReason resets all changes to the "anomaly_reason" field.
+func (m *TransactionMutation) ResetAnomalyReason() {
+	m.anomaly_reason = nil
+	delete(m.clearedFields, transaction.FieldAnomalyReason)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *TransactionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -8161,16 +20147,19 @@ func (m *TransactionMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *TransactionMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+	fields := make([]string, 0, 18)
 	if m.account != nil {
 		fields = append(fields, transaction.FieldAccountID)
 	}
-	if m.plaid_id != nil {
-		fields = append(fields, transaction.FieldPlaidID)
+	if m.external_id != nil {
+		fields = append(fields, transaction.FieldExternalID)
 	}
 	if m.amount != nil {
 		fields = append(fields, transaction.FieldAmount)
 	}
+	if m.currency != nil {
+		fields = append(fields, transaction.FieldCurrency)
+	}
 	if m.date != nil {
 		fields = append(fields, transaction.FieldDate)
 	}
@@ -8183,15 +20172,30 @@ func (m *TransactionMutation) Fields() []string {
 	if m.category != nil {
 		fields = append(fields, transaction.FieldCategory)
 	}
-	if m.plaid_categories != nil {
-		fields = append(fields, transaction.FieldPlaidCategories)
+	if m.provider_categories != nil {
+		fields = append(fields, transaction.FieldProviderCategories)
 	}
 	if m.pending != nil {
 		fields = append(fields, transaction.FieldPending)
 	}
+	if m.pending_transaction_id != nil {
+		fields = append(fields, transaction.FieldPendingTransactionID)
+	}
 	if m.payment_channel != nil {
 		fields = append(fields, transaction.FieldPaymentChannel)
 	}
+	if m.internal_transfer != nil {
+		fields = append(fields, transaction.FieldInternalTransfer)
+	}
+	if m.transfer_pair_id != nil {
+		fields = append(fields, transaction.FieldTransferPairID)
+	}
+	if m.anomaly_score != nil {
+		fields = append(fields, transaction.FieldAnomalyScore)
+	}
+	if m.anomaly_reason != nil {
+		fields = append(fields, transaction.FieldAnomalyReason)
+	}
 	if m.created_at != nil {
 		fields = append(fields, transaction.FieldCreatedAt)
 	}
@@ -8208,10 +20212,12 @@ func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case transaction.FieldAccountID:
 		return m.AccountID()
-	case transaction.FieldPlaidID:
-		return m.PlaidID()
+	case transaction.FieldExternalID:
+		return m.ExternalID()
 	case transaction.FieldAmount:
 		return m.Amount()
+	case transaction.FieldCurrency:
+		return m.Currency()
 	case transaction.FieldDate:
 		return m.Date()
 	case transaction.FieldName:
@@ -8220,12 +20226,22 @@ func (m *TransactionMutation) Field(name string) (ent.Value, bool) {
 		return m.MerchantName()
 	case transaction.FieldCategory:
 		return m.Category()
-	case transaction.FieldPlaidCategories:
-		return m.PlaidCategories()
+	case transaction.FieldProviderCategories:
+		return m.ProviderCategories()
 	case transaction.FieldPending:
 		return m.Pending()
+	case transaction.FieldPendingTransactionID:
+		return m.PendingTransactionID()
 	case transaction.FieldPaymentChannel:
 		return m.PaymentChannel()
+	case transaction.FieldInternalTransfer:
+		return m.InternalTransfer()
+	case transaction.FieldTransferPairID:
+		return m.TransferPairID()
+	case transaction.FieldAnomalyScore:
+		return m.AnomalyScore()
+	case transaction.FieldAnomalyReason:
+		return m.AnomalyReason()
 	case transaction.FieldCreatedAt:
 		return m.CreatedAt()
 	case transaction.FieldUpdatedAt:
@@ -8241,10 +20257,12 @@ func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Va
 	switch name {
 	case transaction.FieldAccountID:
 		return m.OldAccountID(ctx)
-	case transaction.FieldPlaidID:
-		return m.OldPlaidID(ctx)
+	case transaction.FieldExternalID:
+		return m.OldExternalID(ctx)
 	case transaction.FieldAmount:
 		return m.OldAmount(ctx)
+	case transaction.FieldCurrency:
+		return m.OldCurrency(ctx)
 	case transaction.FieldDate:
 		return m.OldDate(ctx)
 	case transaction.FieldName:
@@ -8253,12 +20271,22 @@ func (m *TransactionMutation) OldField(ctx context.Context, name string) (ent.Va
 		return m.OldMerchantName(ctx)
 	case transaction.FieldCategory:
 		return m.OldCategory(ctx)
-	case transaction.FieldPlaidCategories:
-		return m.OldPlaidCategories(ctx)
+	case transaction.FieldProviderCategories:
+		return m.OldProviderCategories(ctx)
 	case transaction.FieldPending:
 		return m.OldPending(ctx)
+	case transaction.FieldPendingTransactionID:
+		return m.OldPendingTransactionID(ctx)
 	case transaction.FieldPaymentChannel:
 		return m.OldPaymentChannel(ctx)
+	case transaction.FieldInternalTransfer:
+		return m.OldInternalTransfer(ctx)
+	case transaction.FieldTransferPairID:
+		return m.OldTransferPairID(ctx)
+	case transaction.FieldAnomalyScore:
+		return m.OldAnomalyScore(ctx)
+	case transaction.FieldAnomalyReason:
+		return m.OldAnomalyReason(ctx)
 	case transaction.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case transaction.FieldUpdatedAt:
@@ -8279,12 +20307,12 @@ func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAccountID(v)
 		return nil
-	case transaction.FieldPlaidID:
+	case transaction.FieldExternalID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlaidID(v)
+		m.SetExternalID(v)
 		return nil
 	case transaction.FieldAmount:
 		v, ok := value.(int64)
@@ -8293,6 +20321,13 @@ func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAmount(v)
 		return nil
+	case transaction.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
 	case transaction.FieldDate:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -8321,12 +20356,12 @@ func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetCategory(v)
 		return nil
-	case transaction.FieldPlaidCategories:
+	case transaction.FieldProviderCategories:
 		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPlaidCategories(v)
+		m.SetProviderCategories(v)
 		return nil
 	case transaction.FieldPending:
 		v, ok := value.(bool)
@@ -8335,6 +20370,13 @@ func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPending(v)
 		return nil
+	case transaction.FieldPendingTransactionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPendingTransactionID(v)
+		return nil
 	case transaction.FieldPaymentChannel:
 		v, ok := value.(string)
 		if !ok {
@@ -8342,6 +20384,34 @@ func (m *TransactionMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPaymentChannel(v)
 		return nil
+	case transaction.FieldInternalTransfer:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInternalTransfer(v)
+		return nil
+	case transaction.FieldTransferPairID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTransferPairID(v)
+		return nil
+	case transaction.FieldAnomalyScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnomalyScore(v)
+		return nil
+	case transaction.FieldAnomalyReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnomalyReason(v)
+		return nil
 	case transaction.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -8367,6 +20437,9 @@ func (m *TransactionMutation) AddedFields() []string {
 	if m.addamount != nil {
 		fields = append(fields, transaction.FieldAmount)
 	}
+	if m.addanomaly_score != nil {
+		fields = append(fields, transaction.FieldAnomalyScore)
+	}
 	return fields
 }
 
@@ -8377,6 +20450,8 @@ func (m *TransactionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case transaction.FieldAmount:
 		return m.AddedAmount()
+	case transaction.FieldAnomalyScore:
+		return m.AddedAnomalyScore()
 	}
 	return nil, false
 }
@@ -8393,6 +20468,13 @@ func (m *TransactionMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddAmount(v)
 		return nil
+	case transaction.FieldAnomalyScore:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnomalyScore(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Transaction numeric field %s", name)
 }
@@ -8404,12 +20486,24 @@ func (m *TransactionMutation) ClearedFields() []string {
 	if m.FieldCleared(transaction.FieldMerchantName) {
 		fields = append(fields, transaction.FieldMerchantName)
 	}
-	if m.FieldCleared(transaction.FieldPlaidCategories) {
-		fields = append(fields, transaction.FieldPlaidCategories)
+	if m.FieldCleared(transaction.FieldProviderCategories) {
+		fields = append(fields, transaction.FieldProviderCategories)
+	}
+	if m.FieldCleared(transaction.FieldPendingTransactionID) {
+		fields = append(fields, transaction.FieldPendingTransactionID)
 	}
 	if m.FieldCleared(transaction.FieldPaymentChannel) {
 		fields = append(fields, transaction.FieldPaymentChannel)
 	}
+	if m.FieldCleared(transaction.FieldTransferPairID) {
+		fields = append(fields, transaction.FieldTransferPairID)
+	}
+	if m.FieldCleared(transaction.FieldAnomalyScore) {
+		fields = append(fields, transaction.FieldAnomalyScore)
+	}
+	if m.FieldCleared(transaction.FieldAnomalyReason) {
+		fields = append(fields, transaction.FieldAnomalyReason)
+	}
 	return fields
 }
 
@@ -8427,12 +20521,24 @@ func (m *TransactionMutation) ClearField(name string) error {
 	case transaction.FieldMerchantName:
 		m.ClearMerchantName()
 		return nil
-	case transaction.FieldPlaidCategories:
-		m.ClearPlaidCategories()
+	case transaction.FieldProviderCategories:
+		m.ClearProviderCategories()
+		return nil
+	case transaction.FieldPendingTransactionID:
+		m.ClearPendingTransactionID()
 		return nil
 	case transaction.FieldPaymentChannel:
 		m.ClearPaymentChannel()
 		return nil
+	case transaction.FieldTransferPairID:
+		m.ClearTransferPairID()
+		return nil
+	case transaction.FieldAnomalyScore:
+		m.ClearAnomalyScore()
+		return nil
+	case transaction.FieldAnomalyReason:
+		m.ClearAnomalyReason()
+		return nil
 	}
 	return fmt.Errorf("unknown Transaction nullable field %s", name)
 }
@@ -8444,12 +20550,15 @@ func (m *TransactionMutation) ResetField(name string) error {
 	case transaction.FieldAccountID:
 		m.ResetAccountID()
 		return nil
-	case transaction.FieldPlaidID:
-		m.ResetPlaidID()
+	case transaction.FieldExternalID:
+		m.ResetExternalID()
 		return nil
 	case transaction.FieldAmount:
 		m.ResetAmount()
 		return nil
+	case transaction.FieldCurrency:
+		m.ResetCurrency()
+		return nil
 	case transaction.FieldDate:
 		m.ResetDate()
 		return nil
@@ -8462,15 +20571,30 @@ func (m *TransactionMutation) ResetField(name string) error {
 	case transaction.FieldCategory:
 		m.ResetCategory()
 		return nil
-	case transaction.FieldPlaidCategories:
-		m.ResetPlaidCategories()
+	case transaction.FieldProviderCategories:
+		m.ResetProviderCategories()
 		return nil
 	case transaction.FieldPending:
 		m.ResetPending()
 		return nil
+	case transaction.FieldPendingTransactionID:
+		m.ResetPendingTransactionID()
+		return nil
 	case transaction.FieldPaymentChannel:
 		m.ResetPaymentChannel()
 		return nil
+	case transaction.FieldInternalTransfer:
+		m.ResetInternalTransfer()
+		return nil
+	case transaction.FieldTransferPairID:
+		m.ResetTransferPairID()
+		return nil
+	case transaction.FieldAnomalyScore:
+		m.ResetAnomalyScore()
+		return nil
+	case transaction.FieldAnomalyReason:
+		m.ResetAnomalyReason()
+		return nil
 	case transaction.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -8592,6 +20716,7 @@ type UserMutation struct {
 	email                     *string
 	password                  *[]byte
 	nickname                  *string
+	home_currency             *string
 	clearedFields             map[string]struct{}
 	user                      map[uuid.UUID]struct{}
 	removeduser               map[uuid.UUID]struct{}
@@ -8616,6 +20741,20 @@ type UserMutation struct {
 	savings_transfers         map[uuid.UUID]struct{}
 	removedsavings_transfers  map[uuid.UUID]struct{}
 	clearedsavings_transfers  bool
+	family_membership         *uuid.UUID
+	clearedfamily_membership  bool
+	goals                     map[uuid.UUID]struct{}
+	removedgoals              map[uuid.UUID]struct{}
+	clearedgoals              bool
+	monthly_reports           map[uuid.UUID]struct{}
+	removedmonthly_reports    map[uuid.UUID]struct{}
+	clearedmonthly_reports    bool
+	budgets                   map[uuid.UUID]struct{}
+	removedbudgets            map[uuid.UUID]struct{}
+	clearedbudgets            bool
+	recurring_charges         map[uuid.UUID]struct{}
+	removedrecurring_charges  map[uuid.UUID]struct{}
+	clearedrecurring_charges  bool
 	done                      bool
 	oldValue                  func(context.Context) (*User, error)
 	predicates                []predicate.User
@@ -8882,6 +21021,42 @@ func (m *UserMutation) ResetNickname() {
 	m.nickname = nil
 }
 
+// SetHomeCurrency sets the "home_currency" field.
+func (m *UserMutation) SetHomeCurrency(s string) {
+	m.home_currency = &s
+}
+
+// HomeCurrency returns the value of the "home_currency" field in the mutation.
+func (m *UserMutation) HomeCurrency() (r string, exists bool) {
+	v := m.home_currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHomeCurrency returns the old "home_currency" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldHomeCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHomeCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHomeCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHomeCurrency: %w", err)
+	}
+	return oldValue.HomeCurrency, nil
+}
+
+// ResetHomeCurrency resets all changes to the "home_currency" field.
+func (m *UserMutation) ResetHomeCurrency() {
+	m.home_currency = nil
+}
+
 // AddUserIDs adds the "user" edge to the User entity by ids.
 func (m *UserMutation) AddUserIDs(ids ...uuid.UUID) {
 	if m.user == nil {
@@ -9287,6 +21462,261 @@ func (m *UserMutation) ResetSavingsTransfers() {
 	m.removedsavings_transfers = nil
 }
 
+// SetFamilyMembershipID sets the "family_membership" edge to the FamilyMember entity by id.
+func (m *UserMutation) SetFamilyMembershipID(id uuid.UUID) {
+	m.family_membership = &id
+}
+
+// ClearFamilyMembership clears the "family_membership" edge to the FamilyMember entity.
+func (m *UserMutation) ClearFamilyMembership() {
+	m.clearedfamily_membership = true
+}
+
+// FamilyMembershipCleared reports if the "family_membership" edge to the FamilyMember entity was cleared.
+func (m *UserMutation) FamilyMembershipCleared() bool {
+	return m.clearedfamily_membership
+}
+
+// FamilyMembershipID returns the "family_membership" edge ID in the mutation.
+func (m *UserMutation) FamilyMembershipID() (id uuid.UUID, exists bool) {
+	if m.family_membership != nil {
+		return *m.family_membership, true
+	}
+	return
+}
+
+// FamilyMembershipIDs returns the "family_membership" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FamilyMembershipID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) FamilyMembershipIDs() (ids []uuid.UUID) {
+	if id := m.family_membership; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFamilyMembership resets all changes to the "family_membership" edge.
+func (m *UserMutation) ResetFamilyMembership() {
+	m.family_membership = nil
+	m.clearedfamily_membership = false
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by ids.
+func (m *UserMutation) AddGoalIDs(ids ...uuid.UUID) {
+	if m.goals == nil {
+		m.goals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.goals[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGoals clears the "goals" edge to the Goal entity.
+func (m *UserMutation) ClearGoals() {
+	m.clearedgoals = true
+}
+
+// GoalsCleared reports if the "goals" edge to the Goal entity was cleared.
+func (m *UserMutation) GoalsCleared() bool {
+	return m.clearedgoals
+}
+
+// RemoveGoalIDs removes the "goals" edge to the Goal entity by IDs.
+func (m *UserMutation) RemoveGoalIDs(ids ...uuid.UUID) {
+	if m.removedgoals == nil {
+		m.removedgoals = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.goals, ids[i])
+		m.removedgoals[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGoals returns the removed IDs of the "goals" edge to the Goal entity.
+func (m *UserMutation) RemovedGoalsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgoals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GoalsIDs returns the "goals" edge IDs in the mutation.
+func (m *UserMutation) GoalsIDs() (ids []uuid.UUID) {
+	for id := range m.goals {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGoals resets all changes to the "goals" edge.
+func (m *UserMutation) ResetGoals() {
+	m.goals = nil
+	m.clearedgoals = false
+	m.removedgoals = nil
+}
+
+// AddMonthlyReportIDs adds the "monthly_reports" edge to the MonthlyReport entity by ids.
+func (m *UserMutation) AddMonthlyReportIDs(ids ...uuid.UUID) {
+	if m.monthly_reports == nil {
+		m.monthly_reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.monthly_reports[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMonthlyReports clears the "monthly_reports" edge to the MonthlyReport entity.
+func (m *UserMutation) ClearMonthlyReports() {
+	m.clearedmonthly_reports = true
+}
+
+// MonthlyReportsCleared reports if the "monthly_reports" edge to the MonthlyReport entity was cleared.
+func (m *UserMutation) MonthlyReportsCleared() bool {
+	return m.clearedmonthly_reports
+}
+
+// RemoveMonthlyReportIDs removes the "monthly_reports" edge to the MonthlyReport entity by IDs.
+func (m *UserMutation) RemoveMonthlyReportIDs(ids ...uuid.UUID) {
+	if m.removedmonthly_reports == nil {
+		m.removedmonthly_reports = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.monthly_reports, ids[i])
+		m.removedmonthly_reports[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMonthlyReports returns the removed IDs of the "monthly_reports" edge to the MonthlyReport entity.
+func (m *UserMutation) RemovedMonthlyReportsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmonthly_reports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MonthlyReportsIDs returns the "monthly_reports" edge IDs in the mutation.
+func (m *UserMutation) MonthlyReportsIDs() (ids []uuid.UUID) {
+	for id := range m.monthly_reports {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMonthlyReports resets all changes to the "monthly_reports" edge.
+func (m *UserMutation) ResetMonthlyReports() {
+	m.monthly_reports = nil
+	m.clearedmonthly_reports = false
+	m.removedmonthly_reports = nil
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by ids.
+func (m *UserMutation) AddBudgetIDs(ids ...uuid.UUID) {
+	if m.budgets == nil {
+		m.budgets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.budgets[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBudgets clears the "budgets" edge to the Budget entity.
+func (m *UserMutation) ClearBudgets() {
+	m.clearedbudgets = true
+}
+
+// BudgetsCleared reports if the "budgets" edge to the Budget entity was cleared.
+func (m *UserMutation) BudgetsCleared() bool {
+	return m.clearedbudgets
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to the Budget entity by IDs.
+func (m *UserMutation) RemoveBudgetIDs(ids ...uuid.UUID) {
+	if m.removedbudgets == nil {
+		m.removedbudgets = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.budgets, ids[i])
+		m.removedbudgets[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBudgets returns the removed IDs of the "budgets" edge to the Budget entity.
+func (m *UserMutation) RemovedBudgetsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbudgets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BudgetsIDs returns the "budgets" edge IDs in the mutation.
+func (m *UserMutation) BudgetsIDs() (ids []uuid.UUID) {
+	for id := range m.budgets {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBudgets resets all changes to the "budgets" edge.
+func (m *UserMutation) ResetBudgets() {
+	m.budgets = nil
+	m.clearedbudgets = false
+	m.removedbudgets = nil
+}
+
+// AddRecurringChargeIDs adds the "recurring_charges" edge to the RecurringCharge entity by ids.
+func (m *UserMutation) AddRecurringChargeIDs(ids ...uuid.UUID) {
+	if m.recurring_charges == nil {
+		m.recurring_charges = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.recurring_charges[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRecurringCharges clears the "recurring_charges" edge to the RecurringCharge entity.
+func (m *UserMutation) ClearRecurringCharges() {
+	m.clearedrecurring_charges = true
+}
+
+// RecurringChargesCleared reports if the "recurring_charges" edge to the RecurringCharge entity was cleared.
+func (m *UserMutation) RecurringChargesCleared() bool {
+	return m.clearedrecurring_charges
+}
+
+// RemoveRecurringChargeIDs removes the "recurring_charges" edge to the RecurringCharge entity by IDs.
+func (m *UserMutation) RemoveRecurringChargeIDs(ids ...uuid.UUID) {
+	if m.removedrecurring_charges == nil {
+		m.removedrecurring_charges = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.recurring_charges, ids[i])
+		m.removedrecurring_charges[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRecurringCharges returns the removed IDs of the "recurring_charges" edge to the RecurringCharge entity.
+func (m *UserMutation) RemovedRecurringChargesIDs() (ids []uuid.UUID) {
+	for id := range m.removedrecurring_charges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RecurringChargesIDs returns the "recurring_charges" edge IDs in the mutation.
+func (m *UserMutation) RecurringChargesIDs() (ids []uuid.UUID) {
+	for id := range m.recurring_charges {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRecurringCharges resets all changes to the "recurring_charges" edge.
+func (m *UserMutation) ResetRecurringCharges() {
+	m.recurring_charges = nil
+	m.clearedrecurring_charges = false
+	m.removedrecurring_charges = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -9321,7 +21751,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+	fields := make([]string, 0, 5)
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
@@ -9334,6 +21764,9 @@ func (m *UserMutation) Fields() []string {
 	if m.nickname != nil {
 		fields = append(fields, user.FieldNickname)
 	}
+	if m.home_currency != nil {
+		fields = append(fields, user.FieldHomeCurrency)
+	}
 	return fields
 }
 
@@ -9350,6 +21783,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.CustodyAccountID()
 	case user.FieldNickname:
 		return m.Nickname()
+	case user.FieldHomeCurrency:
+		return m.HomeCurrency()
 	}
 	return nil, false
 }
@@ -9367,6 +21802,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldCustodyAccountID(ctx)
 	case user.FieldNickname:
 		return m.OldNickname(ctx)
+	case user.FieldHomeCurrency:
+		return m.OldHomeCurrency(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -9404,6 +21841,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetNickname(v)
 		return nil
+	case user.FieldHomeCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHomeCurrency(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -9474,13 +21918,16 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldNickname:
 		m.ResetNickname()
 		return nil
+	case user.FieldHomeCurrency:
+		m.ResetHomeCurrency()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 13)
 	if m.user != nil {
 		edges = append(edges, user.EdgeUser)
 	}
@@ -9505,6 +21952,21 @@ func (m *UserMutation) AddedEdges() []string {
 	if m.savings_transfers != nil {
 		edges = append(edges, user.EdgeSavingsTransfers)
 	}
+	if m.family_membership != nil {
+		edges = append(edges, user.EdgeFamilyMembership)
+	}
+	if m.goals != nil {
+		edges = append(edges, user.EdgeGoals)
+	}
+	if m.monthly_reports != nil {
+		edges = append(edges, user.EdgeMonthlyReports)
+	}
+	if m.budgets != nil {
+		edges = append(edges, user.EdgeBudgets)
+	}
+	if m.recurring_charges != nil {
+		edges = append(edges, user.EdgeRecurringCharges)
+	}
 	return edges
 }
 
@@ -9558,13 +22020,41 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeFamilyMembership:
+		if id := m.family_membership; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeGoals:
+		ids := make([]ent.Value, 0, len(m.goals))
+		for id := range m.goals {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMonthlyReports:
+		ids := make([]ent.Value, 0, len(m.monthly_reports))
+		for id := range m.monthly_reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBudgets:
+		ids := make([]ent.Value, 0, len(m.budgets))
+		for id := range m.budgets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecurringCharges:
+		ids := make([]ent.Value, 0, len(m.recurring_charges))
+		for id := range m.recurring_charges {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 13)
 	if m.removeduser != nil {
 		edges = append(edges, user.EdgeUser)
 	}
@@ -9586,6 +22076,18 @@ func (m *UserMutation) RemovedEdges() []string {
 	if m.removedsavings_transfers != nil {
 		edges = append(edges, user.EdgeSavingsTransfers)
 	}
+	if m.removedgoals != nil {
+		edges = append(edges, user.EdgeGoals)
+	}
+	if m.removedmonthly_reports != nil {
+		edges = append(edges, user.EdgeMonthlyReports)
+	}
+	if m.removedbudgets != nil {
+		edges = append(edges, user.EdgeBudgets)
+	}
+	if m.removedrecurring_charges != nil {
+		edges = append(edges, user.EdgeRecurringCharges)
+	}
 	return edges
 }
 
@@ -9635,13 +22137,37 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeGoals:
+		ids := make([]ent.Value, 0, len(m.removedgoals))
+		for id := range m.removedgoals {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMonthlyReports:
+		ids := make([]ent.Value, 0, len(m.removedmonthly_reports))
+		for id := range m.removedmonthly_reports {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeBudgets:
+		ids := make([]ent.Value, 0, len(m.removedbudgets))
+		for id := range m.removedbudgets {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRecurringCharges:
+		ids := make([]ent.Value, 0, len(m.removedrecurring_charges))
+		for id := range m.removedrecurring_charges {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 8)
+	edges := make([]string, 0, 13)
 	if m.cleareduser {
 		edges = append(edges, user.EdgeUser)
 	}
@@ -9666,6 +22192,21 @@ func (m *UserMutation) ClearedEdges() []string {
 	if m.clearedsavings_transfers {
 		edges = append(edges, user.EdgeSavingsTransfers)
 	}
+	if m.clearedfamily_membership {
+		edges = append(edges, user.EdgeFamilyMembership)
+	}
+	if m.clearedgoals {
+		edges = append(edges, user.EdgeGoals)
+	}
+	if m.clearedmonthly_reports {
+		edges = append(edges, user.EdgeMonthlyReports)
+	}
+	if m.clearedbudgets {
+		edges = append(edges, user.EdgeBudgets)
+	}
+	if m.clearedrecurring_charges {
+		edges = append(edges, user.EdgeRecurringCharges)
+	}
 	return edges
 }
 
@@ -9689,6 +22230,16 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedrule_executions
 	case user.EdgeSavingsTransfers:
 		return m.clearedsavings_transfers
+	case user.EdgeFamilyMembership:
+		return m.clearedfamily_membership
+	case user.EdgeGoals:
+		return m.clearedgoals
+	case user.EdgeMonthlyReports:
+		return m.clearedmonthly_reports
+	case user.EdgeBudgets:
+		return m.clearedbudgets
+	case user.EdgeRecurringCharges:
+		return m.clearedrecurring_charges
 	}
 	return false
 }
@@ -9700,6 +22251,9 @@ func (m *UserMutation) ClearEdge(name string) error {
 	case user.EdgeCustodyAccount:
 		m.ClearCustodyAccount()
 		return nil
+	case user.EdgeFamilyMembership:
+		m.ClearFamilyMembership()
+		return nil
 	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
@@ -9732,6 +22286,21 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgeSavingsTransfers:
 		m.ResetSavingsTransfers()
 		return nil
+	case user.EdgeFamilyMembership:
+		m.ResetFamilyMembership()
+		return nil
+	case user.EdgeGoals:
+		m.ResetGoals()
+		return nil
+	case user.EdgeMonthlyReports:
+		m.ResetMonthlyReports()
+		return nil
+	case user.EdgeBudgets:
+		m.ResetBudgets()
+		return nil
+	case user.EdgeRecurringCharges:
+		m.ResetRecurringCharges()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -9,12 +9,50 @@ import (
 // Account is the predicate function for account builders.
 type Account func(*sql.Selector)
 
+// BackfillJob is the predicate function for backfilljob builders.
+type BackfillJob func(*sql.Selector)
+
+// BalanceSnapshot is the predicate function for balancesnapshot builders.
+type BalanceSnapshot func(*sql.Selector)
+
+// Budget is the predicate function for budget builders.
+type Budget func(*sql.Selector)
+
+// BudgetAlert is the predicate function for budgetalert builders.
+type BudgetAlert func(*sql.Selector)
+
+// Family is the predicate function for family builders.
+type Family func(*sql.Selector)
+
+// FamilyMember is the predicate function for familymember builders.
+type FamilyMember func(*sql.Selector)
+
+// Goal is the predicate function for goal builders.
+type Goal func(*sql.Selector)
+
 // Item is the predicate function for item builders.
 type Item func(*sql.Selector)
 
+// ItemOrErr calls the predicate only if the error is not nit.
+func ItemOrErr(p Item, err error) Item {
+	return func(s *sql.Selector) {
+		if err != nil {
+			s.AddError(err)
+			return
+		}
+		p(s)
+	}
+}
+
+// MonthlyReport is the predicate function for monthlyreport builders.
+type MonthlyReport func(*sql.Selector)
+
 // PushSubscription is the predicate function for pushsubscription builders.
 type PushSubscription func(*sql.Selector)
 
+// RecurringCharge is the predicate function for recurringcharge builders.
+type RecurringCharge func(*sql.Selector)
+
 // Rule is the predicate function for rule builders.
 type Rule func(*sql.Selector)
 
@@ -27,6 +65,9 @@ type SavingsTransfer func(*sql.Selector)
 // SyncCursor is the predicate function for synccursor builders.
 type SyncCursor func(*sql.Selector)
 
+// SyncRun is the predicate function for syncrun builders.
+type SyncRun func(*sql.Selector)
+
 // Transaction is the predicate function for transaction builders.
 type Transaction func(*sql.Selector)
 
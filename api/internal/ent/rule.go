@@ -5,6 +5,8 @@ package ent
 import (
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/user"
 	"strings"
@@ -22,6 +24,8 @@ type Rule struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// FK to User who owns this rule
 	UserID uuid.UUID `json:"user_id,omitempty"`
+	// FK to Family for family-level rules evaluated over all members
+	FamilyID *uuid.UUID `json:"family_id,omitempty"`
 	// User-friendly rule name (e.g., 'Dining 2x Save')
 	Name string `json:"name,omitempty"`
 	// Transaction category to match
@@ -30,6 +34,10 @@ type Rule struct {
 	MinAmountCents *int64 `json:"min_amount_cents,omitempty"`
 	// Maximum transaction amount to trigger (cents)
 	MaxAmountCents *int64 `json:"max_amount_cents,omitempty"`
+	// Month-to-date category spend that must be exceeded before the rule applies (cents)
+	MonthlyThresholdCents *int64 `json:"monthly_threshold_cents,omitempty"`
+	// FK to Budget whose amount is used as the monthly threshold
+	BudgetID *uuid.UUID `json:"budget_id,omitempty"`
 	// Type of savings action: multiply transaction amount or fixed amount
 	ActionType rule.ActionType `json:"action_type,omitempty"`
 	// Multiplier (e.g., 1.5 for 1.5x) or fixed amount in dollars
@@ -60,11 +68,15 @@ type RuleEdges struct {
 	User *User `json:"user,omitempty"`
 	// TargetAccount holds the value of the target_account edge.
 	TargetAccount *Account `json:"target_account,omitempty"`
+	// Family holds the value of the family edge.
+	Family *Family `json:"family,omitempty"`
+	// Budget holds the value of the budget edge.
+	Budget *Budget `json:"budget,omitempty"`
 	// Executions holds the value of the executions edge.
 	Executions []*RuleExecution `json:"executions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [5]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -89,10 +101,32 @@ func (e RuleEdges) TargetAccountOrErr() (*Account, error) {
 	return nil, &NotLoadedError{edge: "target_account"}
 }
 
+// FamilyOrErr returns the Family value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleEdges) FamilyOrErr() (*Family, error) {
+	if e.Family != nil {
+		return e.Family, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: family.Label}
+	}
+	return nil, &NotLoadedError{edge: "family"}
+}
+
+// BudgetOrErr returns the Budget value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleEdges) BudgetOrErr() (*Budget, error) {
+	if e.Budget != nil {
+		return e.Budget, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: budget.Label}
+	}
+	return nil, &NotLoadedError{edge: "budget"}
+}
+
 // ExecutionsOrErr returns the Executions value or an error if the edge
 // was not loaded in eager-loading.
 func (e RuleEdges) ExecutionsOrErr() ([]*RuleExecution, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[4] {
 		return e.Executions, nil
 	}
 	return nil, &NotLoadedError{edge: "executions"}
@@ -103,11 +137,13 @@ func (*Rule) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case rule.FieldFamilyID, rule.FieldBudgetID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case rule.FieldIsActive:
 			values[i] = new(sql.NullBool)
 		case rule.FieldActionValue:
 			values[i] = new(sql.NullFloat64)
-		case rule.FieldMinAmountCents, rule.FieldMaxAmountCents, rule.FieldPriority, rule.FieldExecutionCount, rule.FieldTotalSavedCents:
+		case rule.FieldMinAmountCents, rule.FieldMaxAmountCents, rule.FieldMonthlyThresholdCents, rule.FieldPriority, rule.FieldExecutionCount, rule.FieldTotalSavedCents:
 			values[i] = new(sql.NullInt64)
 		case rule.FieldName, rule.FieldCategory, rule.FieldActionType:
 			values[i] = new(sql.NullString)
@@ -142,6 +178,13 @@ func (_m *Rule) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.UserID = *value
 			}
+		case rule.FieldFamilyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field family_id", values[i])
+			} else if value.Valid {
+				_m.FamilyID = new(uuid.UUID)
+				*_m.FamilyID = *value.S.(*uuid.UUID)
+			}
 		case rule.FieldName:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field name", values[i])
@@ -168,6 +211,20 @@ func (_m *Rule) assignValues(columns []string, values []any) error {
 				_m.MaxAmountCents = new(int64)
 				*_m.MaxAmountCents = value.Int64
 			}
+		case rule.FieldMonthlyThresholdCents:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field monthly_threshold_cents", values[i])
+			} else if value.Valid {
+				_m.MonthlyThresholdCents = new(int64)
+				*_m.MonthlyThresholdCents = value.Int64
+			}
+		case rule.FieldBudgetID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field budget_id", values[i])
+			} else if value.Valid {
+				_m.BudgetID = new(uuid.UUID)
+				*_m.BudgetID = *value.S.(*uuid.UUID)
+			}
 		case rule.FieldActionType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field action_type", values[i])
@@ -245,6 +302,16 @@ func (_m *Rule) QueryTargetAccount() *AccountQuery {
 	return NewRuleClient(_m.config).QueryTargetAccount(_m)
 }
 
+// QueryFamily queries the "family" edge of the Rule entity.
+func (_m *Rule) QueryFamily() *FamilyQuery {
+	return NewRuleClient(_m.config).QueryFamily(_m)
+}
+
+// QueryBudget queries the "budget" edge of the Rule entity.
+func (_m *Rule) QueryBudget() *BudgetQuery {
+	return NewRuleClient(_m.config).QueryBudget(_m)
+}
+
 // QueryExecutions queries the "executions" edge of the Rule entity.
 func (_m *Rule) QueryExecutions() *RuleExecutionQuery {
 	return NewRuleClient(_m.config).QueryExecutions(_m)
@@ -276,6 +343,11 @@ func (_m *Rule) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
+	if v := _m.FamilyID; v != nil {
+		builder.WriteString("family_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
@@ -292,6 +364,16 @@ func (_m *Rule) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.MonthlyThresholdCents; v != nil {
+		builder.WriteString("monthly_threshold_cents=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.BudgetID; v != nil {
+		builder.WriteString("budget_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("action_type=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ActionType))
 	builder.WriteString(", ")
@@ -18,6 +18,8 @@ const (
 	FieldID = "id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldFamilyID holds the string denoting the family_id field in the database.
+	FieldFamilyID = "family_id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
 	// FieldCategory holds the string denoting the category field in the database.
@@ -26,6 +28,10 @@ const (
 	FieldMinAmountCents = "min_amount_cents"
 	// FieldMaxAmountCents holds the string denoting the max_amount_cents field in the database.
 	FieldMaxAmountCents = "max_amount_cents"
+	// FieldMonthlyThresholdCents holds the string denoting the monthly_threshold_cents field in the database.
+	FieldMonthlyThresholdCents = "monthly_threshold_cents"
+	// FieldBudgetID holds the string denoting the budget_id field in the database.
+	FieldBudgetID = "budget_id"
 	// FieldActionType holds the string denoting the action_type field in the database.
 	FieldActionType = "action_type"
 	// FieldActionValue holds the string denoting the action_value field in the database.
@@ -48,6 +54,10 @@ const (
 	EdgeUser = "user"
 	// EdgeTargetAccount holds the string denoting the target_account edge name in mutations.
 	EdgeTargetAccount = "target_account"
+	// EdgeFamily holds the string denoting the family edge name in mutations.
+	EdgeFamily = "family"
+	// EdgeBudget holds the string denoting the budget edge name in mutations.
+	EdgeBudget = "budget"
 	// EdgeExecutions holds the string denoting the executions edge name in mutations.
 	EdgeExecutions = "executions"
 	// Table holds the table name of the rule in the database.
@@ -66,6 +76,20 @@ const (
 	TargetAccountInverseTable = "accounts"
 	// TargetAccountColumn is the table column denoting the target_account relation/edge.
 	TargetAccountColumn = "target_account_id"
+	// FamilyTable is the table that holds the family relation/edge.
+	FamilyTable = "rules"
+	// FamilyInverseTable is the table name for the Family entity.
+	// It exists in this package in order to avoid circular dependency with the "family" package.
+	FamilyInverseTable = "families"
+	// FamilyColumn is the table column denoting the family relation/edge.
+	FamilyColumn = "family_id"
+	// BudgetTable is the table that holds the budget relation/edge.
+	BudgetTable = "rules"
+	// BudgetInverseTable is the table name for the Budget entity.
+	// It exists in this package in order to avoid circular dependency with the "budget" package.
+	BudgetInverseTable = "budgets"
+	// BudgetColumn is the table column denoting the budget relation/edge.
+	BudgetColumn = "budget_id"
 	// ExecutionsTable is the table that holds the executions relation/edge.
 	ExecutionsTable = "rule_executions"
 	// ExecutionsInverseTable is the table name for the RuleExecution entity.
@@ -79,10 +103,13 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldUserID,
+	FieldFamilyID,
 	FieldName,
 	FieldCategory,
 	FieldMinAmountCents,
 	FieldMaxAmountCents,
+	FieldMonthlyThresholdCents,
+	FieldBudgetID,
 	FieldActionType,
 	FieldActionValue,
 	FieldTargetAccountID,
@@ -190,6 +217,11 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByFamilyID orders the results by the family_id field.
+func ByFamilyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFamilyID, opts...).ToFunc()
+}
+
 // ByName orders the results by the name field.
 func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
@@ -210,6 +242,16 @@ func ByMaxAmountCents(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxAmountCents, opts...).ToFunc()
 }
 
+// ByMonthlyThresholdCents orders the results by the monthly_threshold_cents field.
+func ByMonthlyThresholdCents(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMonthlyThresholdCents, opts...).ToFunc()
+}
+
+// ByBudgetID orders the results by the budget_id field.
+func ByBudgetID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBudgetID, opts...).ToFunc()
+}
+
 // ByActionType orders the results by the action_type field.
 func ByActionType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldActionType, opts...).ToFunc()
@@ -269,6 +311,20 @@ func ByTargetAccountField(field string, opts ...sql.OrderTermOption) OrderOption
 	}
 }
 
+// ByFamilyField orders the results by family field.
+func ByFamilyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFamilyStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByBudgetField orders the results by budget field.
+func ByBudgetField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByExecutionsCount orders the results by executions count.
 func ByExecutionsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -296,6 +352,20 @@ func newTargetAccountStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, TargetAccountTable, TargetAccountColumn),
 	)
 }
+func newFamilyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FamilyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FamilyTable, FamilyColumn),
+	)
+}
+func newBudgetStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BudgetTable, BudgetColumn),
+	)
+}
 func newExecutionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -61,6 +61,11 @@ func UserID(v uuid.UUID) predicate.Rule {
 	return predicate.Rule(sql.FieldEQ(FieldUserID, v))
 }
 
+// FamilyID applies equality check predicate on the "family_id" field. It's identical to FamilyIDEQ.
+func FamilyID(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldFamilyID, v))
+}
+
 // Name applies equality check predicate on the "name" field. It's identical to NameEQ.
 func Name(v string) predicate.Rule {
 	return predicate.Rule(sql.FieldEQ(FieldName, v))
@@ -76,6 +81,16 @@ func MaxAmountCents(v int64) predicate.Rule {
 	return predicate.Rule(sql.FieldEQ(FieldMaxAmountCents, v))
 }
 
+// MonthlyThresholdCents applies equality check predicate on the "monthly_threshold_cents" field. It's identical to MonthlyThresholdCentsEQ.
+func MonthlyThresholdCents(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldMonthlyThresholdCents, v))
+}
+
+// BudgetID applies equality check predicate on the "budget_id" field. It's identical to BudgetIDEQ.
+func BudgetID(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldBudgetID, v))
+}
+
 // ActionValue applies equality check predicate on the "action_value" field. It's identical to ActionValueEQ.
 func ActionValue(v float64) predicate.Rule {
 	return predicate.Rule(sql.FieldEQ(FieldActionValue, v))
@@ -136,6 +151,36 @@ func UserIDNotIn(vs ...uuid.UUID) predicate.Rule {
 	return predicate.Rule(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// FamilyIDEQ applies the EQ predicate on the "family_id" field.
+func FamilyIDEQ(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldFamilyID, v))
+}
+
+// FamilyIDNEQ applies the NEQ predicate on the "family_id" field.
+func FamilyIDNEQ(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldFamilyID, v))
+}
+
+// FamilyIDIn applies the In predicate on the "family_id" field.
+func FamilyIDIn(vs ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldFamilyID, vs...))
+}
+
+// FamilyIDNotIn applies the NotIn predicate on the "family_id" field.
+func FamilyIDNotIn(vs ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldFamilyID, vs...))
+}
+
+// FamilyIDIsNil applies the IsNil predicate on the "family_id" field.
+func FamilyIDIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldFamilyID))
+}
+
+// FamilyIDNotNil applies the NotNil predicate on the "family_id" field.
+func FamilyIDNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldFamilyID))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Rule {
 	return predicate.Rule(sql.FieldEQ(FieldName, v))
@@ -321,6 +366,86 @@ func MaxAmountCentsNotNil() predicate.Rule {
 	return predicate.Rule(sql.FieldNotNull(FieldMaxAmountCents))
 }
 
+// MonthlyThresholdCentsEQ applies the EQ predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsEQ(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldMonthlyThresholdCents, v))
+}
+
+// MonthlyThresholdCentsNEQ applies the NEQ predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsNEQ(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldMonthlyThresholdCents, v))
+}
+
+// MonthlyThresholdCentsIn applies the In predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsIn(vs ...int64) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldMonthlyThresholdCents, vs...))
+}
+
+// MonthlyThresholdCentsNotIn applies the NotIn predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsNotIn(vs ...int64) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldMonthlyThresholdCents, vs...))
+}
+
+// MonthlyThresholdCentsGT applies the GT predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsGT(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldGT(FieldMonthlyThresholdCents, v))
+}
+
+// MonthlyThresholdCentsGTE applies the GTE predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsGTE(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldGTE(FieldMonthlyThresholdCents, v))
+}
+
+// MonthlyThresholdCentsLT applies the LT predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsLT(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldLT(FieldMonthlyThresholdCents, v))
+}
+
+// MonthlyThresholdCentsLTE applies the LTE predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsLTE(v int64) predicate.Rule {
+	return predicate.Rule(sql.FieldLTE(FieldMonthlyThresholdCents, v))
+}
+
+// MonthlyThresholdCentsIsNil applies the IsNil predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldMonthlyThresholdCents))
+}
+
+// MonthlyThresholdCentsNotNil applies the NotNil predicate on the "monthly_threshold_cents" field.
+func MonthlyThresholdCentsNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldMonthlyThresholdCents))
+}
+
+// BudgetIDEQ applies the EQ predicate on the "budget_id" field.
+func BudgetIDEQ(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldEQ(FieldBudgetID, v))
+}
+
+// BudgetIDNEQ applies the NEQ predicate on the "budget_id" field.
+func BudgetIDNEQ(v uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNEQ(FieldBudgetID, v))
+}
+
+// BudgetIDIn applies the In predicate on the "budget_id" field.
+func BudgetIDIn(vs ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldIn(FieldBudgetID, vs...))
+}
+
+// BudgetIDNotIn applies the NotIn predicate on the "budget_id" field.
+func BudgetIDNotIn(vs ...uuid.UUID) predicate.Rule {
+	return predicate.Rule(sql.FieldNotIn(FieldBudgetID, vs...))
+}
+
+// BudgetIDIsNil applies the IsNil predicate on the "budget_id" field.
+func BudgetIDIsNil() predicate.Rule {
+	return predicate.Rule(sql.FieldIsNull(FieldBudgetID))
+}
+
+// BudgetIDNotNil applies the NotNil predicate on the "budget_id" field.
+func BudgetIDNotNil() predicate.Rule {
+	return predicate.Rule(sql.FieldNotNull(FieldBudgetID))
+}
+
 // ActionTypeEQ applies the EQ predicate on the "action_type" field.
 func ActionTypeEQ(v ActionType) predicate.Rule {
 	return predicate.Rule(sql.FieldEQ(FieldActionType, v))
@@ -657,6 +782,52 @@ func HasTargetAccountWith(preds ...predicate.Account) predicate.Rule {
 	})
 }
 
+// HasFamily applies the HasEdge predicate on the "family" edge.
+func HasFamily() predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FamilyTable, FamilyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFamilyWith applies the HasEdge predicate on the "family" edge with a given conditions (other predicates).
+func HasFamilyWith(preds ...predicate.Family) predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := newFamilyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBudget applies the HasEdge predicate on the "budget" edge.
+func HasBudget() predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BudgetTable, BudgetColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetWith applies the HasEdge predicate on the "budget" edge with a given conditions (other predicates).
+func HasBudgetWith(preds ...predicate.Budget) predicate.Rule {
+	return predicate.Rule(func(s *sql.Selector) {
+		step := newBudgetStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasExecutions applies the HasEdge predicate on the "executions" edge.
 func HasExecutions() predicate.Rule {
 	return predicate.Rule(func(s *sql.Selector) {
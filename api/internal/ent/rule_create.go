@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/user"
@@ -33,6 +35,20 @@ func (_c *RuleCreate) SetUserID(v uuid.UUID) *RuleCreate {
 	return _c
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_c *RuleCreate) SetFamilyID(v uuid.UUID) *RuleCreate {
+	_c.mutation.SetFamilyID(v)
+	return _c
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableFamilyID(v *uuid.UUID) *RuleCreate {
+	if v != nil {
+		_c.SetFamilyID(*v)
+	}
+	return _c
+}
+
 // SetName sets the "name" field.
 func (_c *RuleCreate) SetName(v string) *RuleCreate {
 	_c.mutation.SetName(v)
@@ -73,6 +89,34 @@ func (_c *RuleCreate) SetNillableMaxAmountCents(v *int64) *RuleCreate {
 	return _c
 }
 
+// SetMonthlyThresholdCents sets the "monthly_threshold_cents" field.
+func (_c *RuleCreate) SetMonthlyThresholdCents(v int64) *RuleCreate {
+	_c.mutation.SetMonthlyThresholdCents(v)
+	return _c
+}
+
+// SetNillableMonthlyThresholdCents sets the "monthly_threshold_cents" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableMonthlyThresholdCents(v *int64) *RuleCreate {
+	if v != nil {
+		_c.SetMonthlyThresholdCents(*v)
+	}
+	return _c
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (_c *RuleCreate) SetBudgetID(v uuid.UUID) *RuleCreate {
+	_c.mutation.SetBudgetID(v)
+	return _c
+}
+
+// SetNillableBudgetID sets the "budget_id" field if the given value is not nil.
+func (_c *RuleCreate) SetNillableBudgetID(v *uuid.UUID) *RuleCreate {
+	if v != nil {
+		_c.SetBudgetID(*v)
+	}
+	return _c
+}
+
 // SetActionType sets the "action_type" field.
 func (_c *RuleCreate) SetActionType(v rule.ActionType) *RuleCreate {
 	_c.mutation.SetActionType(v)
@@ -199,6 +243,16 @@ func (_c *RuleCreate) SetTargetAccount(v *Account) *RuleCreate {
 	return _c.SetTargetAccountID(v.ID)
 }
 
+// SetFamily sets the "family" edge to the Family entity.
+func (_c *RuleCreate) SetFamily(v *Family) *RuleCreate {
+	return _c.SetFamilyID(v.ID)
+}
+
+// SetBudget sets the "budget" edge to the Budget entity.
+func (_c *RuleCreate) SetBudget(v *Budget) *RuleCreate {
+	return _c.SetBudgetID(v.ID)
+}
+
 // AddExecutionIDs adds the "executions" edge to the RuleExecution entity by IDs.
 func (_c *RuleCreate) AddExecutionIDs(ids ...uuid.UUID) *RuleCreate {
 	_c.mutation.AddExecutionIDs(ids...)
@@ -390,6 +444,10 @@ func (_c *RuleCreate) createSpec() (*Rule, *sqlgraph.CreateSpec) {
 		_spec.SetField(rule.FieldMaxAmountCents, field.TypeInt64, value)
 		_node.MaxAmountCents = &value
 	}
+	if value, ok := _c.mutation.MonthlyThresholdCents(); ok {
+		_spec.SetField(rule.FieldMonthlyThresholdCents, field.TypeInt64, value)
+		_node.MonthlyThresholdCents = &value
+	}
 	if value, ok := _c.mutation.ActionType(); ok {
 		_spec.SetField(rule.FieldActionType, field.TypeEnum, value)
 		_node.ActionType = value
@@ -456,6 +514,40 @@ func (_c *RuleCreate) createSpec() (*Rule, *sqlgraph.CreateSpec) {
 		_node.TargetAccountID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FamilyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.FamilyTable,
+			Columns: []string{rule.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FamilyID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.BudgetTable,
+			Columns: []string{rule.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BudgetID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.ExecutionsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -536,6 +628,24 @@ func (u *RuleUpsert) UpdateUserID() *RuleUpsert {
 	return u
 }
 
+// SetFamilyID sets the "family_id" field.
+func (u *RuleUpsert) SetFamilyID(v uuid.UUID) *RuleUpsert {
+	u.Set(rule.FieldFamilyID, v)
+	return u
+}
+
+// UpdateFamilyID sets the "family_id" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateFamilyID() *RuleUpsert {
+	u.SetExcluded(rule.FieldFamilyID)
+	return u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (u *RuleUpsert) ClearFamilyID() *RuleUpsert {
+	u.SetNull(rule.FieldFamilyID)
+	return u
+}
+
 // SetName sets the "name" field.
 func (u *RuleUpsert) SetName(v string) *RuleUpsert {
 	u.Set(rule.FieldName, v)
@@ -608,6 +718,48 @@ func (u *RuleUpsert) ClearMaxAmountCents() *RuleUpsert {
 	return u
 }
 
+// SetMonthlyThresholdCents sets the "monthly_threshold_cents" field.
+func (u *RuleUpsert) SetMonthlyThresholdCents(v int64) *RuleUpsert {
+	u.Set(rule.FieldMonthlyThresholdCents, v)
+	return u
+}
+
+// UpdateMonthlyThresholdCents sets the "monthly_threshold_cents" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateMonthlyThresholdCents() *RuleUpsert {
+	u.SetExcluded(rule.FieldMonthlyThresholdCents)
+	return u
+}
+
+// AddMonthlyThresholdCents adds v to the "monthly_threshold_cents" field.
+func (u *RuleUpsert) AddMonthlyThresholdCents(v int64) *RuleUpsert {
+	u.Add(rule.FieldMonthlyThresholdCents, v)
+	return u
+}
+
+// ClearMonthlyThresholdCents clears the value of the "monthly_threshold_cents" field.
+func (u *RuleUpsert) ClearMonthlyThresholdCents() *RuleUpsert {
+	u.SetNull(rule.FieldMonthlyThresholdCents)
+	return u
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (u *RuleUpsert) SetBudgetID(v uuid.UUID) *RuleUpsert {
+	u.Set(rule.FieldBudgetID, v)
+	return u
+}
+
+// UpdateBudgetID sets the "budget_id" field to the value that was provided on create.
+func (u *RuleUpsert) UpdateBudgetID() *RuleUpsert {
+	u.SetExcluded(rule.FieldBudgetID)
+	return u
+}
+
+// ClearBudgetID clears the value of the "budget_id" field.
+func (u *RuleUpsert) ClearBudgetID() *RuleUpsert {
+	u.SetNull(rule.FieldBudgetID)
+	return u
+}
+
 // SetActionType sets the "action_type" field.
 func (u *RuleUpsert) SetActionType(v rule.ActionType) *RuleUpsert {
 	u.Set(rule.FieldActionType, v)
@@ -793,6 +945,27 @@ func (u *RuleUpsertOne) UpdateUserID() *RuleUpsertOne {
 	})
 }
 
+// SetFamilyID sets the "family_id" field.
+func (u *RuleUpsertOne) SetFamilyID(v uuid.UUID) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetFamilyID(v)
+	})
+}
+
+// UpdateFamilyID sets the "family_id" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateFamilyID() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateFamilyID()
+	})
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (u *RuleUpsertOne) ClearFamilyID() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearFamilyID()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *RuleUpsertOne) SetName(v string) *RuleUpsertOne {
 	return u.Update(func(s *RuleUpsert) {
@@ -877,6 +1050,55 @@ func (u *RuleUpsertOne) ClearMaxAmountCents() *RuleUpsertOne {
 	})
 }
 
+// SetMonthlyThresholdCents sets the "monthly_threshold_cents" field.
+func (u *RuleUpsertOne) SetMonthlyThresholdCents(v int64) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetMonthlyThresholdCents(v)
+	})
+}
+
+// AddMonthlyThresholdCents adds v to the "monthly_threshold_cents" field.
+func (u *RuleUpsertOne) AddMonthlyThresholdCents(v int64) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.AddMonthlyThresholdCents(v)
+	})
+}
+
+// UpdateMonthlyThresholdCents sets the "monthly_threshold_cents" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateMonthlyThresholdCents() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateMonthlyThresholdCents()
+	})
+}
+
+// ClearMonthlyThresholdCents clears the value of the "monthly_threshold_cents" field.
+func (u *RuleUpsertOne) ClearMonthlyThresholdCents() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearMonthlyThresholdCents()
+	})
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (u *RuleUpsertOne) SetBudgetID(v uuid.UUID) *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetBudgetID(v)
+	})
+}
+
+// UpdateBudgetID sets the "budget_id" field to the value that was provided on create.
+func (u *RuleUpsertOne) UpdateBudgetID() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateBudgetID()
+	})
+}
+
+// ClearBudgetID clears the value of the "budget_id" field.
+func (u *RuleUpsertOne) ClearBudgetID() *RuleUpsertOne {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearBudgetID()
+	})
+}
+
 // SetActionType sets the "action_type" field.
 func (u *RuleUpsertOne) SetActionType(v rule.ActionType) *RuleUpsertOne {
 	return u.Update(func(s *RuleUpsert) {
@@ -1249,6 +1471,27 @@ func (u *RuleUpsertBulk) UpdateUserID() *RuleUpsertBulk {
 	})
 }
 
+// SetFamilyID sets the "family_id" field.
+func (u *RuleUpsertBulk) SetFamilyID(v uuid.UUID) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetFamilyID(v)
+	})
+}
+
+// UpdateFamilyID sets the "family_id" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateFamilyID() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateFamilyID()
+	})
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (u *RuleUpsertBulk) ClearFamilyID() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearFamilyID()
+	})
+}
+
 // SetName sets the "name" field.
 func (u *RuleUpsertBulk) SetName(v string) *RuleUpsertBulk {
 	return u.Update(func(s *RuleUpsert) {
@@ -1333,6 +1576,55 @@ func (u *RuleUpsertBulk) ClearMaxAmountCents() *RuleUpsertBulk {
 	})
 }
 
+// SetMonthlyThresholdCents sets the "monthly_threshold_cents" field.
+func (u *RuleUpsertBulk) SetMonthlyThresholdCents(v int64) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetMonthlyThresholdCents(v)
+	})
+}
+
+// AddMonthlyThresholdCents adds v to the "monthly_threshold_cents" field.
+func (u *RuleUpsertBulk) AddMonthlyThresholdCents(v int64) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.AddMonthlyThresholdCents(v)
+	})
+}
+
+// UpdateMonthlyThresholdCents sets the "monthly_threshold_cents" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateMonthlyThresholdCents() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateMonthlyThresholdCents()
+	})
+}
+
+// ClearMonthlyThresholdCents clears the value of the "monthly_threshold_cents" field.
+func (u *RuleUpsertBulk) ClearMonthlyThresholdCents() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearMonthlyThresholdCents()
+	})
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (u *RuleUpsertBulk) SetBudgetID(v uuid.UUID) *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.SetBudgetID(v)
+	})
+}
+
+// UpdateBudgetID sets the "budget_id" field to the value that was provided on create.
+func (u *RuleUpsertBulk) UpdateBudgetID() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.UpdateBudgetID()
+	})
+}
+
+// ClearBudgetID clears the value of the "budget_id" field.
+func (u *RuleUpsertBulk) ClearBudgetID() *RuleUpsertBulk {
+	return u.Update(func(s *RuleUpsert) {
+		s.ClearBudgetID()
+	})
+}
+
 // SetActionType sets the "action_type" field.
 func (u *RuleUpsertBulk) SetActionType(v rule.ActionType) *RuleUpsertBulk {
 	return u.Update(func(s *RuleUpsert) {
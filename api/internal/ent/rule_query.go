@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"math"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
@@ -30,6 +32,8 @@ type RuleQuery struct {
 	predicates        []predicate.Rule
 	withUser          *UserQuery
 	withTargetAccount *AccountQuery
+	withFamily        *FamilyQuery
+	withBudget        *BudgetQuery
 	withExecutions    *RuleExecutionQuery
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -112,6 +116,50 @@ func (_q *RuleQuery) QueryTargetAccount() *AccountQuery {
 	return query
 }
 
+// QueryFamily chains the current query on the "family" edge.
+func (_q *RuleQuery) QueryFamily() *FamilyQuery {
+	query := (&FamilyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rule.Table, rule.FieldID, selector),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rule.FamilyTable, rule.FamilyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBudget chains the current query on the "budget" edge.
+func (_q *RuleQuery) QueryBudget() *BudgetQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rule.Table, rule.FieldID, selector),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rule.BudgetTable, rule.BudgetColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryExecutions chains the current query on the "executions" edge.
 func (_q *RuleQuery) QueryExecutions() *RuleExecutionQuery {
 	query := (&RuleExecutionClient{config: _q.config}).Query()
@@ -328,6 +376,8 @@ func (_q *RuleQuery) Clone() *RuleQuery {
 		predicates:        append([]predicate.Rule{}, _q.predicates...),
 		withUser:          _q.withUser.Clone(),
 		withTargetAccount: _q.withTargetAccount.Clone(),
+		withFamily:        _q.withFamily.Clone(),
+		withBudget:        _q.withBudget.Clone(),
 		withExecutions:    _q.withExecutions.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
@@ -358,6 +408,28 @@ func (_q *RuleQuery) WithTargetAccount(opts ...func(*AccountQuery)) *RuleQuery {
 	return _q
 }
 
+// WithFamily tells the query-builder to eager-load the nodes that are connected to
+// the "family" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RuleQuery) WithFamily(opts ...func(*FamilyQuery)) *RuleQuery {
+	query := (&FamilyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFamily = query
+	return _q
+}
+
+// WithBudget tells the query-builder to eager-load the nodes that are connected to
+// the "budget" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RuleQuery) WithBudget(opts ...func(*BudgetQuery)) *RuleQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBudget = query
+	return _q
+}
+
 // WithExecutions tells the query-builder to eager-load the nodes that are connected to
 // the "executions" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *RuleQuery) WithExecutions(opts ...func(*RuleExecutionQuery)) *RuleQuery {
@@ -447,9 +519,11 @@ func (_q *RuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Rule, e
 	var (
 		nodes       = []*Rule{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [5]bool{
 			_q.withUser != nil,
 			_q.withTargetAccount != nil,
+			_q.withFamily != nil,
+			_q.withBudget != nil,
 			_q.withExecutions != nil,
 		}
 	)
@@ -486,6 +560,18 @@ func (_q *RuleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Rule, e
 			return nil, err
 		}
 	}
+	if query := _q.withFamily; query != nil {
+		if err := _q.loadFamily(ctx, query, nodes, nil,
+			func(n *Rule, e *Family) { n.Edges.Family = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBudget; query != nil {
+		if err := _q.loadBudget(ctx, query, nodes, nil,
+			func(n *Rule, e *Budget) { n.Edges.Budget = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withExecutions; query != nil {
 		if err := _q.loadExecutions(ctx, query, nodes,
 			func(n *Rule) { n.Edges.Executions = []*RuleExecution{} },
@@ -554,6 +640,70 @@ func (_q *RuleQuery) loadTargetAccount(ctx context.Context, query *AccountQuery,
 	}
 	return nil
 }
+func (_q *RuleQuery) loadFamily(ctx context.Context, query *FamilyQuery, nodes []*Rule, init func(*Rule), assign func(*Rule, *Family)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Rule)
+	for i := range nodes {
+		if nodes[i].FamilyID == nil {
+			continue
+		}
+		fk := *nodes[i].FamilyID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(family.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "family_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *RuleQuery) loadBudget(ctx context.Context, query *BudgetQuery, nodes []*Rule, init func(*Rule), assign func(*Rule, *Budget)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Rule)
+	for i := range nodes {
+		if nodes[i].BudgetID == nil {
+			continue
+		}
+		fk := *nodes[i].BudgetID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(budget.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "budget_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *RuleQuery) loadExecutions(ctx context.Context, query *RuleExecutionQuery, nodes []*Rule, init func(*Rule), assign func(*Rule, *RuleExecution)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Rule)
@@ -619,6 +769,12 @@ func (_q *RuleQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withTargetAccount != nil {
 			_spec.Node.AddColumnOnce(rule.FieldTargetAccountID)
 		}
+		if _q.withFamily != nil {
+			_spec.Node.AddColumnOnce(rule.FieldFamilyID)
+		}
+		if _q.withBudget != nil {
+			_spec.Node.AddColumnOnce(rule.FieldBudgetID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
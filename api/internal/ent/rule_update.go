@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
@@ -47,6 +49,26 @@ func (_u *RuleUpdate) SetNillableUserID(v *uuid.UUID) *RuleUpdate {
 	return _u
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_u *RuleUpdate) SetFamilyID(v uuid.UUID) *RuleUpdate {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableFamilyID(v *uuid.UUID) *RuleUpdate {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *RuleUpdate) ClearFamilyID() *RuleUpdate {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *RuleUpdate) SetName(v string) *RuleUpdate {
 	_u.mutation.SetName(v)
@@ -129,6 +151,53 @@ func (_u *RuleUpdate) ClearMaxAmountCents() *RuleUpdate {
 	return _u
 }
 
+// SetMonthlyThresholdCents sets the "monthly_threshold_cents" field.
+func (_u *RuleUpdate) SetMonthlyThresholdCents(v int64) *RuleUpdate {
+	_u.mutation.ResetMonthlyThresholdCents()
+	_u.mutation.SetMonthlyThresholdCents(v)
+	return _u
+}
+
+// SetNillableMonthlyThresholdCents sets the "monthly_threshold_cents" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableMonthlyThresholdCents(v *int64) *RuleUpdate {
+	if v != nil {
+		_u.SetMonthlyThresholdCents(*v)
+	}
+	return _u
+}
+
+// AddMonthlyThresholdCents adds value to the "monthly_threshold_cents" field.
+func (_u *RuleUpdate) AddMonthlyThresholdCents(v int64) *RuleUpdate {
+	_u.mutation.AddMonthlyThresholdCents(v)
+	return _u
+}
+
+// ClearMonthlyThresholdCents clears the value of the "monthly_threshold_cents" field.
+func (_u *RuleUpdate) ClearMonthlyThresholdCents() *RuleUpdate {
+	_u.mutation.ClearMonthlyThresholdCents()
+	return _u
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (_u *RuleUpdate) SetBudgetID(v uuid.UUID) *RuleUpdate {
+	_u.mutation.SetBudgetID(v)
+	return _u
+}
+
+// SetNillableBudgetID sets the "budget_id" field if the given value is not nil.
+func (_u *RuleUpdate) SetNillableBudgetID(v *uuid.UUID) *RuleUpdate {
+	if v != nil {
+		_u.SetBudgetID(*v)
+	}
+	return _u
+}
+
+// ClearBudgetID clears the value of the "budget_id" field.
+func (_u *RuleUpdate) ClearBudgetID() *RuleUpdate {
+	_u.mutation.ClearBudgetID()
+	return _u
+}
+
 // SetActionType sets the "action_type" field.
 func (_u *RuleUpdate) SetActionType(v rule.ActionType) *RuleUpdate {
 	_u.mutation.SetActionType(v)
@@ -271,6 +340,16 @@ func (_u *RuleUpdate) SetTargetAccount(v *Account) *RuleUpdate {
 	return _u.SetTargetAccountID(v.ID)
 }
 
+// SetFamily sets the "family" edge to the Family entity.
+func (_u *RuleUpdate) SetFamily(v *Family) *RuleUpdate {
+	return _u.SetFamilyID(v.ID)
+}
+
+// SetBudget sets the "budget" edge to the Budget entity.
+func (_u *RuleUpdate) SetBudget(v *Budget) *RuleUpdate {
+	return _u.SetBudgetID(v.ID)
+}
+
 // AddExecutionIDs adds the "executions" edge to the RuleExecution entity by IDs.
 func (_u *RuleUpdate) AddExecutionIDs(ids ...uuid.UUID) *RuleUpdate {
 	_u.mutation.AddExecutionIDs(ids...)
@@ -303,6 +382,18 @@ func (_u *RuleUpdate) ClearTargetAccount() *RuleUpdate {
 	return _u
 }
 
+// ClearFamily clears the "family" edge to the Family entity.
+func (_u *RuleUpdate) ClearFamily() *RuleUpdate {
+	_u.mutation.ClearFamily()
+	return _u
+}
+
+// ClearBudget clears the "budget" edge to the Budget entity.
+func (_u *RuleUpdate) ClearBudget() *RuleUpdate {
+	_u.mutation.ClearBudget()
+	return _u
+}
+
 // ClearExecutions clears all "executions" edges to the RuleExecution entity.
 func (_u *RuleUpdate) ClearExecutions() *RuleUpdate {
 	_u.mutation.ClearExecutions()
@@ -428,6 +519,15 @@ func (_u *RuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MaxAmountCentsCleared() {
 		_spec.ClearField(rule.FieldMaxAmountCents, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.MonthlyThresholdCents(); ok {
+		_spec.SetField(rule.FieldMonthlyThresholdCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyThresholdCents(); ok {
+		_spec.AddField(rule.FieldMonthlyThresholdCents, field.TypeInt64, value)
+	}
+	if _u.mutation.MonthlyThresholdCentsCleared() {
+		_spec.ClearField(rule.FieldMonthlyThresholdCents, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.ActionType(); ok {
 		_spec.SetField(rule.FieldActionType, field.TypeEnum, value)
 	}
@@ -519,6 +619,64 @@ func (_u *RuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FamilyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.FamilyTable,
+			Columns: []string{rule.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FamilyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.FamilyTable,
+			Columns: []string{rule.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BudgetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.BudgetTable,
+			Columns: []string{rule.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.BudgetTable,
+			Columns: []string{rule.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ExecutionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -600,6 +758,26 @@ func (_u *RuleUpdateOne) SetNillableUserID(v *uuid.UUID) *RuleUpdateOne {
 	return _u
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_u *RuleUpdateOne) SetFamilyID(v uuid.UUID) *RuleUpdateOne {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableFamilyID(v *uuid.UUID) *RuleUpdateOne {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *RuleUpdateOne) ClearFamilyID() *RuleUpdateOne {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
 // SetName sets the "name" field.
 func (_u *RuleUpdateOne) SetName(v string) *RuleUpdateOne {
 	_u.mutation.SetName(v)
@@ -682,6 +860,53 @@ func (_u *RuleUpdateOne) ClearMaxAmountCents() *RuleUpdateOne {
 	return _u
 }
 
+// SetMonthlyThresholdCents sets the "monthly_threshold_cents" field.
+func (_u *RuleUpdateOne) SetMonthlyThresholdCents(v int64) *RuleUpdateOne {
+	_u.mutation.ResetMonthlyThresholdCents()
+	_u.mutation.SetMonthlyThresholdCents(v)
+	return _u
+}
+
+// SetNillableMonthlyThresholdCents sets the "monthly_threshold_cents" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableMonthlyThresholdCents(v *int64) *RuleUpdateOne {
+	if v != nil {
+		_u.SetMonthlyThresholdCents(*v)
+	}
+	return _u
+}
+
+// AddMonthlyThresholdCents adds value to the "monthly_threshold_cents" field.
+func (_u *RuleUpdateOne) AddMonthlyThresholdCents(v int64) *RuleUpdateOne {
+	_u.mutation.AddMonthlyThresholdCents(v)
+	return _u
+}
+
+// ClearMonthlyThresholdCents clears the value of the "monthly_threshold_cents" field.
+func (_u *RuleUpdateOne) ClearMonthlyThresholdCents() *RuleUpdateOne {
+	_u.mutation.ClearMonthlyThresholdCents()
+	return _u
+}
+
+// SetBudgetID sets the "budget_id" field.
+func (_u *RuleUpdateOne) SetBudgetID(v uuid.UUID) *RuleUpdateOne {
+	_u.mutation.SetBudgetID(v)
+	return _u
+}
+
+// SetNillableBudgetID sets the "budget_id" field if the given value is not nil.
+func (_u *RuleUpdateOne) SetNillableBudgetID(v *uuid.UUID) *RuleUpdateOne {
+	if v != nil {
+		_u.SetBudgetID(*v)
+	}
+	return _u
+}
+
+// ClearBudgetID clears the value of the "budget_id" field.
+func (_u *RuleUpdateOne) ClearBudgetID() *RuleUpdateOne {
+	_u.mutation.ClearBudgetID()
+	return _u
+}
+
 // SetActionType sets the "action_type" field.
 func (_u *RuleUpdateOne) SetActionType(v rule.ActionType) *RuleUpdateOne {
 	_u.mutation.SetActionType(v)
@@ -824,6 +1049,16 @@ func (_u *RuleUpdateOne) SetTargetAccount(v *Account) *RuleUpdateOne {
 	return _u.SetTargetAccountID(v.ID)
 }
 
+// SetFamily sets the "family" edge to the Family entity.
+func (_u *RuleUpdateOne) SetFamily(v *Family) *RuleUpdateOne {
+	return _u.SetFamilyID(v.ID)
+}
+
+// SetBudget sets the "budget" edge to the Budget entity.
+func (_u *RuleUpdateOne) SetBudget(v *Budget) *RuleUpdateOne {
+	return _u.SetBudgetID(v.ID)
+}
+
 // AddExecutionIDs adds the "executions" edge to the RuleExecution entity by IDs.
 func (_u *RuleUpdateOne) AddExecutionIDs(ids ...uuid.UUID) *RuleUpdateOne {
 	_u.mutation.AddExecutionIDs(ids...)
@@ -856,6 +1091,18 @@ func (_u *RuleUpdateOne) ClearTargetAccount() *RuleUpdateOne {
 	return _u
 }
 
+// ClearFamily clears the "family" edge to the Family entity.
+func (_u *RuleUpdateOne) ClearFamily() *RuleUpdateOne {
+	_u.mutation.ClearFamily()
+	return _u
+}
+
+// ClearBudget clears the "budget" edge to the Budget entity.
+func (_u *RuleUpdateOne) ClearBudget() *RuleUpdateOne {
+	_u.mutation.ClearBudget()
+	return _u
+}
+
 // ClearExecutions clears all "executions" edges to the RuleExecution entity.
 func (_u *RuleUpdateOne) ClearExecutions() *RuleUpdateOne {
 	_u.mutation.ClearExecutions()
@@ -1011,6 +1258,15 @@ func (_u *RuleUpdateOne) sqlSave(ctx context.Context) (_node *Rule, err error) {
 	if _u.mutation.MaxAmountCentsCleared() {
 		_spec.ClearField(rule.FieldMaxAmountCents, field.TypeInt64)
 	}
+	if value, ok := _u.mutation.MonthlyThresholdCents(); ok {
+		_spec.SetField(rule.FieldMonthlyThresholdCents, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedMonthlyThresholdCents(); ok {
+		_spec.AddField(rule.FieldMonthlyThresholdCents, field.TypeInt64, value)
+	}
+	if _u.mutation.MonthlyThresholdCentsCleared() {
+		_spec.ClearField(rule.FieldMonthlyThresholdCents, field.TypeInt64)
+	}
 	if value, ok := _u.mutation.ActionType(); ok {
 		_spec.SetField(rule.FieldActionType, field.TypeEnum, value)
 	}
@@ -1102,6 +1358,64 @@ func (_u *RuleUpdateOne) sqlSave(ctx context.Context) (_node *Rule, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FamilyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.FamilyTable,
+			Columns: []string{rule.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FamilyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.FamilyTable,
+			Columns: []string{rule.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BudgetCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.BudgetTable,
+			Columns: []string{rule.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rule.BudgetTable,
+			Columns: []string{rule.BudgetColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.ExecutionsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
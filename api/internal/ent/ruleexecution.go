@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/savingstransfer"
@@ -28,9 +29,11 @@ type RuleExecution struct {
 	TransactionID uuid.UUID `json:"transaction_id,omitempty"`
 	// FK to User (denormalized for quick queries)
 	UserID uuid.UUID `json:"user_id,omitempty"`
+	// FK to Family when triggered by a family rule
+	FamilyID *uuid.UUID `json:"family_id,omitempty"`
 	// Calculated savings amount in cents
 	AmountCents int64 `json:"amount_cents,omitempty"`
-	// FK to Account where transaction occurred
+	// FK to Account savings are taken from: where the transaction occurred, or a credit card's funding account
 	SourceAccountID uuid.UUID `json:"source_account_id,omitempty"`
 	// FK to Account where savings should go
 	TargetAccountID uuid.UUID `json:"target_account_id,omitempty"`
@@ -56,11 +59,13 @@ type RuleExecutionEdges struct {
 	Transaction *Transaction `json:"transaction,omitempty"`
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Family holds the value of the family edge.
+	Family *Family `json:"family,omitempty"`
 	// Transfer holds the value of the transfer edge.
 	Transfer *SavingsTransfer `json:"transfer,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [5]bool
 }
 
 // RuleOrErr returns the Rule value or an error if the edge
@@ -96,12 +101,23 @@ func (e RuleExecutionEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// FamilyOrErr returns the Family value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RuleExecutionEdges) FamilyOrErr() (*Family, error) {
+	if e.Family != nil {
+		return e.Family, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: family.Label}
+	}
+	return nil, &NotLoadedError{edge: "family"}
+}
+
 // TransferOrErr returns the Transfer value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e RuleExecutionEdges) TransferOrErr() (*SavingsTransfer, error) {
 	if e.Transfer != nil {
 		return e.Transfer, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[4] {
 		return nil, &NotFoundError{label: savingstransfer.Label}
 	}
 	return nil, &NotLoadedError{edge: "transfer"}
@@ -112,6 +128,8 @@ func (*RuleExecution) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case ruleexecution.FieldFamilyID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case ruleexecution.FieldAmountCents:
 			values[i] = new(sql.NullInt64)
 		case ruleexecution.FieldStatus, ruleexecution.FieldErrorMessage:
@@ -159,6 +177,13 @@ func (_m *RuleExecution) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.UserID = *value
 			}
+		case ruleexecution.FieldFamilyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field family_id", values[i])
+			} else if value.Valid {
+				_m.FamilyID = new(uuid.UUID)
+				*_m.FamilyID = *value.S.(*uuid.UUID)
+			}
 		case ruleexecution.FieldAmountCents:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field amount_cents", values[i])
@@ -230,6 +255,11 @@ func (_m *RuleExecution) QueryUser() *UserQuery {
 	return NewRuleExecutionClient(_m.config).QueryUser(_m)
 }
 
+// QueryFamily queries the "family" edge of the RuleExecution entity.
+func (_m *RuleExecution) QueryFamily() *FamilyQuery {
+	return NewRuleExecutionClient(_m.config).QueryFamily(_m)
+}
+
 // QueryTransfer queries the "transfer" edge of the RuleExecution entity.
 func (_m *RuleExecution) QueryTransfer() *SavingsTransferQuery {
 	return NewRuleExecutionClient(_m.config).QueryTransfer(_m)
@@ -267,6 +297,11 @@ func (_m *RuleExecution) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
+	if v := _m.FamilyID; v != nil {
+		builder.WriteString("family_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("amount_cents=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AmountCents))
 	builder.WriteString(", ")
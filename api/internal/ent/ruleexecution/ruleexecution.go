@@ -22,6 +22,8 @@ const (
 	FieldTransactionID = "transaction_id"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldFamilyID holds the string denoting the family_id field in the database.
+	FieldFamilyID = "family_id"
 	// FieldAmountCents holds the string denoting the amount_cents field in the database.
 	FieldAmountCents = "amount_cents"
 	// FieldSourceAccountID holds the string denoting the source_account_id field in the database.
@@ -42,6 +44,8 @@ const (
 	EdgeTransaction = "transaction"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeFamily holds the string denoting the family edge name in mutations.
+	EdgeFamily = "family"
 	// EdgeTransfer holds the string denoting the transfer edge name in mutations.
 	EdgeTransfer = "transfer"
 	// Table holds the table name of the ruleexecution in the database.
@@ -67,6 +71,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// FamilyTable is the table that holds the family relation/edge.
+	FamilyTable = "rule_executions"
+	// FamilyInverseTable is the table name for the Family entity.
+	// It exists in this package in order to avoid circular dependency with the "family" package.
+	FamilyInverseTable = "families"
+	// FamilyColumn is the table column denoting the family relation/edge.
+	FamilyColumn = "family_id"
 	// TransferTable is the table that holds the transfer relation/edge.
 	TransferTable = "savings_transfers"
 	// TransferInverseTable is the table name for the SavingsTransfer entity.
@@ -82,6 +93,7 @@ var Columns = []string{
 	FieldRuleID,
 	FieldTransactionID,
 	FieldUserID,
+	FieldFamilyID,
 	FieldAmountCents,
 	FieldSourceAccountID,
 	FieldTargetAccountID,
@@ -158,6 +170,11 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByFamilyID orders the results by the family_id field.
+func ByFamilyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFamilyID, opts...).ToFunc()
+}
+
 // ByAmountCents orders the results by the amount_cents field.
 func ByAmountCents(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmountCents, opts...).ToFunc()
@@ -214,6 +231,13 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByFamilyField orders the results by family field.
+func ByFamilyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFamilyStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByTransferField orders the results by transfer field.
 func ByTransferField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -241,6 +265,13 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newFamilyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FamilyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FamilyTable, FamilyColumn),
+	)
+}
 func newTransferStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -71,6 +71,11 @@ func UserID(v uuid.UUID) predicate.RuleExecution {
 	return predicate.RuleExecution(sql.FieldEQ(FieldUserID, v))
 }
 
+// FamilyID applies equality check predicate on the "family_id" field. It's identical to FamilyIDEQ.
+func FamilyID(v uuid.UUID) predicate.RuleExecution {
+	return predicate.RuleExecution(sql.FieldEQ(FieldFamilyID, v))
+}
+
 // AmountCents applies equality check predicate on the "amount_cents" field. It's identical to AmountCentsEQ.
 func AmountCents(v int64) predicate.RuleExecution {
 	return predicate.RuleExecution(sql.FieldEQ(FieldAmountCents, v))
@@ -161,6 +166,36 @@ func UserIDNotIn(vs ...uuid.UUID) predicate.RuleExecution {
 	return predicate.RuleExecution(sql.FieldNotIn(FieldUserID, vs...))
 }
 
+// FamilyIDEQ applies the EQ predicate on the "family_id" field.
+func FamilyIDEQ(v uuid.UUID) predicate.RuleExecution {
+	return predicate.RuleExecution(sql.FieldEQ(FieldFamilyID, v))
+}
+
+// FamilyIDNEQ applies the NEQ predicate on the "family_id" field.
+func FamilyIDNEQ(v uuid.UUID) predicate.RuleExecution {
+	return predicate.RuleExecution(sql.FieldNEQ(FieldFamilyID, v))
+}
+
+// FamilyIDIn applies the In predicate on the "family_id" field.
+func FamilyIDIn(vs ...uuid.UUID) predicate.RuleExecution {
+	return predicate.RuleExecution(sql.FieldIn(FieldFamilyID, vs...))
+}
+
+// FamilyIDNotIn applies the NotIn predicate on the "family_id" field.
+func FamilyIDNotIn(vs ...uuid.UUID) predicate.RuleExecution {
+	return predicate.RuleExecution(sql.FieldNotIn(FieldFamilyID, vs...))
+}
+
+// FamilyIDIsNil applies the IsNil predicate on the "family_id" field.
+func FamilyIDIsNil() predicate.RuleExecution {
+	return predicate.RuleExecution(sql.FieldIsNull(FieldFamilyID))
+}
+
+// FamilyIDNotNil applies the NotNil predicate on the "family_id" field.
+func FamilyIDNotNil() predicate.RuleExecution {
+	return predicate.RuleExecution(sql.FieldNotNull(FieldFamilyID))
+}
+
 // AmountCentsEQ applies the EQ predicate on the "amount_cents" field.
 func AmountCentsEQ(v int64) predicate.RuleExecution {
 	return predicate.RuleExecution(sql.FieldEQ(FieldAmountCents, v))
@@ -535,6 +570,29 @@ func HasUserWith(preds ...predicate.User) predicate.RuleExecution {
 	})
 }
 
+// HasFamily applies the HasEdge predicate on the "family" edge.
+func HasFamily() predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FamilyTable, FamilyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFamilyWith applies the HasEdge predicate on the "family" edge with a given conditions (other predicates).
+func HasFamilyWith(preds ...predicate.Family) predicate.RuleExecution {
+	return predicate.RuleExecution(func(s *sql.Selector) {
+		step := newFamilyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasTransfer applies the HasEdge predicate on the "transfer" edge.
 func HasTransfer() predicate.RuleExecution {
 	return predicate.RuleExecution(func(s *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/savingstransfer"
@@ -46,6 +47,20 @@ func (_c *RuleExecutionCreate) SetUserID(v uuid.UUID) *RuleExecutionCreate {
 	return _c
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_c *RuleExecutionCreate) SetFamilyID(v uuid.UUID) *RuleExecutionCreate {
+	_c.mutation.SetFamilyID(v)
+	return _c
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_c *RuleExecutionCreate) SetNillableFamilyID(v *uuid.UUID) *RuleExecutionCreate {
+	if v != nil {
+		_c.SetFamilyID(*v)
+	}
+	return _c
+}
+
 // SetAmountCents sets the "amount_cents" field.
 func (_c *RuleExecutionCreate) SetAmountCents(v int64) *RuleExecutionCreate {
 	_c.mutation.SetAmountCents(v)
@@ -149,6 +164,11 @@ func (_c *RuleExecutionCreate) SetUser(v *User) *RuleExecutionCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// SetFamily sets the "family" edge to the Family entity.
+func (_c *RuleExecutionCreate) SetFamily(v *Family) *RuleExecutionCreate {
+	return _c.SetFamilyID(v.ID)
+}
+
 // SetTransferID sets the "transfer" edge to the SavingsTransfer entity by ID.
 func (_c *RuleExecutionCreate) SetTransferID(id uuid.UUID) *RuleExecutionCreate {
 	_c.mutation.SetTransferID(id)
@@ -372,6 +392,23 @@ func (_c *RuleExecutionCreate) createSpec() (*RuleExecution, *sqlgraph.CreateSpe
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FamilyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ruleexecution.FamilyTable,
+			Columns: []string{ruleexecution.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FamilyID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.TransferIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -476,6 +513,24 @@ func (u *RuleExecutionUpsert) UpdateUserID() *RuleExecutionUpsert {
 	return u
 }
 
+// SetFamilyID sets the "family_id" field.
+func (u *RuleExecutionUpsert) SetFamilyID(v uuid.UUID) *RuleExecutionUpsert {
+	u.Set(ruleexecution.FieldFamilyID, v)
+	return u
+}
+
+// UpdateFamilyID sets the "family_id" field to the value that was provided on create.
+func (u *RuleExecutionUpsert) UpdateFamilyID() *RuleExecutionUpsert {
+	u.SetExcluded(ruleexecution.FieldFamilyID)
+	return u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (u *RuleExecutionUpsert) ClearFamilyID() *RuleExecutionUpsert {
+	u.SetNull(ruleexecution.FieldFamilyID)
+	return u
+}
+
 // SetAmountCents sets the "amount_cents" field.
 func (u *RuleExecutionUpsert) SetAmountCents(v int64) *RuleExecutionUpsert {
 	u.Set(ruleexecution.FieldAmountCents, v)
@@ -659,6 +714,27 @@ func (u *RuleExecutionUpsertOne) UpdateUserID() *RuleExecutionUpsertOne {
 	})
 }
 
+// SetFamilyID sets the "family_id" field.
+func (u *RuleExecutionUpsertOne) SetFamilyID(v uuid.UUID) *RuleExecutionUpsertOne {
+	return u.Update(func(s *RuleExecutionUpsert) {
+		s.SetFamilyID(v)
+	})
+}
+
+// UpdateFamilyID sets the "family_id" field to the value that was provided on create.
+func (u *RuleExecutionUpsertOne) UpdateFamilyID() *RuleExecutionUpsertOne {
+	return u.Update(func(s *RuleExecutionUpsert) {
+		s.UpdateFamilyID()
+	})
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (u *RuleExecutionUpsertOne) ClearFamilyID() *RuleExecutionUpsertOne {
+	return u.Update(func(s *RuleExecutionUpsert) {
+		s.ClearFamilyID()
+	})
+}
+
 // SetAmountCents sets the "amount_cents" field.
 func (u *RuleExecutionUpsertOne) SetAmountCents(v int64) *RuleExecutionUpsertOne {
 	return u.Update(func(s *RuleExecutionUpsert) {
@@ -1024,6 +1100,27 @@ func (u *RuleExecutionUpsertBulk) UpdateUserID() *RuleExecutionUpsertBulk {
 	})
 }
 
+// SetFamilyID sets the "family_id" field.
+func (u *RuleExecutionUpsertBulk) SetFamilyID(v uuid.UUID) *RuleExecutionUpsertBulk {
+	return u.Update(func(s *RuleExecutionUpsert) {
+		s.SetFamilyID(v)
+	})
+}
+
+// UpdateFamilyID sets the "family_id" field to the value that was provided on create.
+func (u *RuleExecutionUpsertBulk) UpdateFamilyID() *RuleExecutionUpsertBulk {
+	return u.Update(func(s *RuleExecutionUpsert) {
+		s.UpdateFamilyID()
+	})
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (u *RuleExecutionUpsertBulk) ClearFamilyID() *RuleExecutionUpsertBulk {
+	return u.Update(func(s *RuleExecutionUpsert) {
+		s.ClearFamilyID()
+	})
+}
+
 // SetAmountCents sets the "amount_cents" field.
 func (u *RuleExecutionUpsertBulk) SetAmountCents(v int64) *RuleExecutionUpsertBulk {
 	return u.Update(func(s *RuleExecutionUpsert) {
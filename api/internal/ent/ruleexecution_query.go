@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
@@ -32,6 +33,7 @@ type RuleExecutionQuery struct {
 	withRule        *RuleQuery
 	withTransaction *TransactionQuery
 	withUser        *UserQuery
+	withFamily      *FamilyQuery
 	withTransfer    *SavingsTransferQuery
 	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
@@ -136,6 +138,28 @@ func (_q *RuleExecutionQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryFamily chains the current query on the "family" edge.
+func (_q *RuleExecutionQuery) QueryFamily() *FamilyQuery {
+	query := (&FamilyClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ruleexecution.Table, ruleexecution.FieldID, selector),
+			sqlgraph.To(family.Table, family.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ruleexecution.FamilyTable, ruleexecution.FamilyColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryTransfer chains the current query on the "transfer" edge.
 func (_q *RuleExecutionQuery) QueryTransfer() *SavingsTransferQuery {
 	query := (&SavingsTransferClient{config: _q.config}).Query()
@@ -353,6 +377,7 @@ func (_q *RuleExecutionQuery) Clone() *RuleExecutionQuery {
 		withRule:        _q.withRule.Clone(),
 		withTransaction: _q.withTransaction.Clone(),
 		withUser:        _q.withUser.Clone(),
+		withFamily:      _q.withFamily.Clone(),
 		withTransfer:    _q.withTransfer.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
@@ -394,6 +419,17 @@ func (_q *RuleExecutionQuery) WithUser(opts ...func(*UserQuery)) *RuleExecutionQ
 	return _q
 }
 
+// WithFamily tells the query-builder to eager-load the nodes that are connected to
+// the "family" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *RuleExecutionQuery) WithFamily(opts ...func(*FamilyQuery)) *RuleExecutionQuery {
+	query := (&FamilyClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFamily = query
+	return _q
+}
+
 // WithTransfer tells the query-builder to eager-load the nodes that are connected to
 // the "transfer" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *RuleExecutionQuery) WithTransfer(opts ...func(*SavingsTransferQuery)) *RuleExecutionQuery {
@@ -483,10 +519,11 @@ func (_q *RuleExecutionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	var (
 		nodes       = []*RuleExecution{}
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [5]bool{
 			_q.withRule != nil,
 			_q.withTransaction != nil,
 			_q.withUser != nil,
+			_q.withFamily != nil,
 			_q.withTransfer != nil,
 		}
 	)
@@ -529,6 +566,12 @@ func (_q *RuleExecutionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withFamily; query != nil {
+		if err := _q.loadFamily(ctx, query, nodes, nil,
+			func(n *RuleExecution, e *Family) { n.Edges.Family = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withTransfer; query != nil {
 		if err := _q.loadTransfer(ctx, query, nodes, nil,
 			func(n *RuleExecution, e *SavingsTransfer) { n.Edges.Transfer = e }); err != nil {
@@ -625,6 +668,38 @@ func (_q *RuleExecutionQuery) loadUser(ctx context.Context, query *UserQuery, no
 	}
 	return nil
 }
+func (_q *RuleExecutionQuery) loadFamily(ctx context.Context, query *FamilyQuery, nodes []*RuleExecution, init func(*RuleExecution), assign func(*RuleExecution, *Family)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*RuleExecution)
+	for i := range nodes {
+		if nodes[i].FamilyID == nil {
+			continue
+		}
+		fk := *nodes[i].FamilyID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(family.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "family_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *RuleExecutionQuery) loadTransfer(ctx context.Context, query *SavingsTransferQuery, nodes []*RuleExecution, init func(*RuleExecution), assign func(*RuleExecution, *SavingsTransfer)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*RuleExecution)
@@ -690,6 +765,9 @@ func (_q *RuleExecutionQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withUser != nil {
 			_spec.Node.AddColumnOnce(ruleexecution.FieldUserID)
 		}
+		if _q.withFamily != nil {
+			_spec.Node.AddColumnOnce(ruleexecution.FieldFamilyID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regulation/internal/ent/family"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
@@ -76,6 +77,26 @@ func (_u *RuleExecutionUpdate) SetNillableUserID(v *uuid.UUID) *RuleExecutionUpd
 	return _u
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_u *RuleExecutionUpdate) SetFamilyID(v uuid.UUID) *RuleExecutionUpdate {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *RuleExecutionUpdate) SetNillableFamilyID(v *uuid.UUID) *RuleExecutionUpdate {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *RuleExecutionUpdate) ClearFamilyID() *RuleExecutionUpdate {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
 // SetAmountCents sets the "amount_cents" field.
 func (_u *RuleExecutionUpdate) SetAmountCents(v int64) *RuleExecutionUpdate {
 	_u.mutation.ResetAmountCents()
@@ -194,6 +215,11 @@ func (_u *RuleExecutionUpdate) SetUser(v *User) *RuleExecutionUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// SetFamily sets the "family" edge to the Family entity.
+func (_u *RuleExecutionUpdate) SetFamily(v *Family) *RuleExecutionUpdate {
+	return _u.SetFamilyID(v.ID)
+}
+
 // SetTransferID sets the "transfer" edge to the SavingsTransfer entity by ID.
 func (_u *RuleExecutionUpdate) SetTransferID(id uuid.UUID) *RuleExecutionUpdate {
 	_u.mutation.SetTransferID(id)
@@ -236,6 +262,12 @@ func (_u *RuleExecutionUpdate) ClearUser() *RuleExecutionUpdate {
 	return _u
 }
 
+// ClearFamily clears the "family" edge to the Family entity.
+func (_u *RuleExecutionUpdate) ClearFamily() *RuleExecutionUpdate {
+	_u.mutation.ClearFamily()
+	return _u
+}
+
 // ClearTransfer clears the "transfer" edge to the SavingsTransfer entity.
 func (_u *RuleExecutionUpdate) ClearTransfer() *RuleExecutionUpdate {
 	_u.mutation.ClearTransfer()
@@ -420,6 +452,35 @@ func (_u *RuleExecutionUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FamilyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ruleexecution.FamilyTable,
+			Columns: []string{ruleexecution.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FamilyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ruleexecution.FamilyTable,
+			Columns: []string{ruleexecution.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.TransferCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -513,6 +574,26 @@ func (_u *RuleExecutionUpdateOne) SetNillableUserID(v *uuid.UUID) *RuleExecution
 	return _u
 }
 
+// SetFamilyID sets the "family_id" field.
+func (_u *RuleExecutionUpdateOne) SetFamilyID(v uuid.UUID) *RuleExecutionUpdateOne {
+	_u.mutation.SetFamilyID(v)
+	return _u
+}
+
+// SetNillableFamilyID sets the "family_id" field if the given value is not nil.
+func (_u *RuleExecutionUpdateOne) SetNillableFamilyID(v *uuid.UUID) *RuleExecutionUpdateOne {
+	if v != nil {
+		_u.SetFamilyID(*v)
+	}
+	return _u
+}
+
+// ClearFamilyID clears the value of the "family_id" field.
+func (_u *RuleExecutionUpdateOne) ClearFamilyID() *RuleExecutionUpdateOne {
+	_u.mutation.ClearFamilyID()
+	return _u
+}
+
 // SetAmountCents sets the "amount_cents" field.
 func (_u *RuleExecutionUpdateOne) SetAmountCents(v int64) *RuleExecutionUpdateOne {
 	_u.mutation.ResetAmountCents()
@@ -631,6 +712,11 @@ func (_u *RuleExecutionUpdateOne) SetUser(v *User) *RuleExecutionUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// SetFamily sets the "family" edge to the Family entity.
+func (_u *RuleExecutionUpdateOne) SetFamily(v *Family) *RuleExecutionUpdateOne {
+	return _u.SetFamilyID(v.ID)
+}
+
 // SetTransferID sets the "transfer" edge to the SavingsTransfer entity by ID.
 func (_u *RuleExecutionUpdateOne) SetTransferID(id uuid.UUID) *RuleExecutionUpdateOne {
 	_u.mutation.SetTransferID(id)
@@ -673,6 +759,12 @@ func (_u *RuleExecutionUpdateOne) ClearUser() *RuleExecutionUpdateOne {
 	return _u
 }
 
+// ClearFamily clears the "family" edge to the Family entity.
+func (_u *RuleExecutionUpdateOne) ClearFamily() *RuleExecutionUpdateOne {
+	_u.mutation.ClearFamily()
+	return _u
+}
+
 // ClearTransfer clears the "transfer" edge to the SavingsTransfer entity.
 func (_u *RuleExecutionUpdateOne) ClearTransfer() *RuleExecutionUpdateOne {
 	_u.mutation.ClearTransfer()
@@ -887,6 +979,35 @@ func (_u *RuleExecutionUpdateOne) sqlSave(ctx context.Context) (_node *RuleExecu
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FamilyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ruleexecution.FamilyTable,
+			Columns: []string{ruleexecution.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FamilyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ruleexecution.FamilyTable,
+			Columns: []string{ruleexecution.FamilyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(family.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.TransferCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -2,238 +2,4 @@
 
 package ent
 
-import (
-	"regulation/internal/ent/account"
-	"regulation/internal/ent/item"
-	"regulation/internal/ent/pushsubscription"
-	"regulation/internal/ent/rule"
-	"regulation/internal/ent/ruleexecution"
-	"regulation/internal/ent/savingstransfer"
-	"regulation/internal/ent/schema"
-	"regulation/internal/ent/synccursor"
-	"regulation/internal/ent/transaction"
-	"regulation/internal/ent/user"
-	"time"
-
-	"github.com/google/uuid"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	accountFields := schema.Account{}.Fields()
-	_ = accountFields
-	// accountDescPlaidID is the schema descriptor for plaid_id field.
-	accountDescPlaidID := accountFields[3].Descriptor()
-	// account.PlaidIDValidator is a validator for the "plaid_id" field. It is called by the builders before save.
-	account.PlaidIDValidator = accountDescPlaidID.Validators[0].(func(string) error)
-	// accountDescName is the schema descriptor for name field.
-	accountDescName := accountFields[4].Descriptor()
-	// account.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	account.NameValidator = accountDescName.Validators[0].(func(string) error)
-	// accountDescCurrentBalance is the schema descriptor for current_balance field.
-	accountDescCurrentBalance := accountFields[8].Descriptor()
-	// account.DefaultCurrentBalance holds the default value on creation for the current_balance field.
-	account.DefaultCurrentBalance = accountDescCurrentBalance.Default.(int64)
-	// accountDescIsActive is the schema descriptor for is_active field.
-	accountDescIsActive := accountFields[10].Descriptor()
-	// account.DefaultIsActive holds the default value on creation for the is_active field.
-	account.DefaultIsActive = accountDescIsActive.Default.(bool)
-	// accountDescCreatedAt is the schema descriptor for created_at field.
-	accountDescCreatedAt := accountFields[11].Descriptor()
-	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
-	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
-	// accountDescUpdatedAt is the schema descriptor for updated_at field.
-	accountDescUpdatedAt := accountFields[12].Descriptor()
-	// account.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	account.DefaultUpdatedAt = accountDescUpdatedAt.Default.(func() time.Time)
-	// account.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	account.UpdateDefaultUpdatedAt = accountDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// accountDescID is the schema descriptor for id field.
-	accountDescID := accountFields[0].Descriptor()
-	// account.DefaultID holds the default value on creation for the id field.
-	account.DefaultID = accountDescID.Default.(func() uuid.UUID)
-	itemFields := schema.Item{}.Fields()
-	_ = itemFields
-	// itemDescPlaidID is the schema descriptor for plaid_id field.
-	itemDescPlaidID := itemFields[2].Descriptor()
-	// item.PlaidIDValidator is a validator for the "plaid_id" field. It is called by the builders before save.
-	item.PlaidIDValidator = itemDescPlaidID.Validators[0].(func(string) error)
-	// itemDescAccessToken is the schema descriptor for access_token field.
-	itemDescAccessToken := itemFields[3].Descriptor()
-	// item.AccessTokenValidator is a validator for the "access_token" field. It is called by the builders before save.
-	item.AccessTokenValidator = itemDescAccessToken.Validators[0].(func(string) error)
-	// itemDescInstitutionName is the schema descriptor for institution_name field.
-	itemDescInstitutionName := itemFields[4].Descriptor()
-	// item.InstitutionNameValidator is a validator for the "institution_name" field. It is called by the builders before save.
-	item.InstitutionNameValidator = itemDescInstitutionName.Validators[0].(func(string) error)
-	// itemDescIsActive is the schema descriptor for is_active field.
-	itemDescIsActive := itemFields[5].Descriptor()
-	// item.DefaultIsActive holds the default value on creation for the is_active field.
-	item.DefaultIsActive = itemDescIsActive.Default.(bool)
-	// itemDescCreatedAt is the schema descriptor for created_at field.
-	itemDescCreatedAt := itemFields[6].Descriptor()
-	// item.DefaultCreatedAt holds the default value on creation for the created_at field.
-	item.DefaultCreatedAt = itemDescCreatedAt.Default.(func() time.Time)
-	// itemDescUpdatedAt is the schema descriptor for updated_at field.
-	itemDescUpdatedAt := itemFields[7].Descriptor()
-	// item.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	item.DefaultUpdatedAt = itemDescUpdatedAt.Default.(func() time.Time)
-	// item.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	item.UpdateDefaultUpdatedAt = itemDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// itemDescID is the schema descriptor for id field.
-	itemDescID := itemFields[0].Descriptor()
-	// item.DefaultID holds the default value on creation for the id field.
-	item.DefaultID = itemDescID.Default.(func() uuid.UUID)
-	pushsubscriptionFields := schema.PushSubscription{}.Fields()
-	_ = pushsubscriptionFields
-	// pushsubscriptionDescEndpoint is the schema descriptor for endpoint field.
-	pushsubscriptionDescEndpoint := pushsubscriptionFields[2].Descriptor()
-	// pushsubscription.EndpointValidator is a validator for the "endpoint" field. It is called by the builders before save.
-	pushsubscription.EndpointValidator = pushsubscriptionDescEndpoint.Validators[0].(func(string) error)
-	// pushsubscriptionDescP256dh is the schema descriptor for p256dh field.
-	pushsubscriptionDescP256dh := pushsubscriptionFields[3].Descriptor()
-	// pushsubscription.P256dhValidator is a validator for the "p256dh" field. It is called by the builders before save.
-	pushsubscription.P256dhValidator = pushsubscriptionDescP256dh.Validators[0].(func(string) error)
-	// pushsubscriptionDescAuth is the schema descriptor for auth field.
-	pushsubscriptionDescAuth := pushsubscriptionFields[4].Descriptor()
-	// pushsubscription.AuthValidator is a validator for the "auth" field. It is called by the builders before save.
-	pushsubscription.AuthValidator = pushsubscriptionDescAuth.Validators[0].(func(string) error)
-	// pushsubscriptionDescActive is the schema descriptor for active field.
-	pushsubscriptionDescActive := pushsubscriptionFields[5].Descriptor()
-	// pushsubscription.DefaultActive holds the default value on creation for the active field.
-	pushsubscription.DefaultActive = pushsubscriptionDescActive.Default.(bool)
-	// pushsubscriptionDescCreatedAt is the schema descriptor for created_at field.
-	pushsubscriptionDescCreatedAt := pushsubscriptionFields[6].Descriptor()
-	// pushsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
-	pushsubscription.DefaultCreatedAt = pushsubscriptionDescCreatedAt.Default.(func() time.Time)
-	// pushsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
-	pushsubscriptionDescUpdatedAt := pushsubscriptionFields[7].Descriptor()
-	// pushsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	pushsubscription.DefaultUpdatedAt = pushsubscriptionDescUpdatedAt.Default.(func() time.Time)
-	// pushsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	pushsubscription.UpdateDefaultUpdatedAt = pushsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// pushsubscriptionDescID is the schema descriptor for id field.
-	pushsubscriptionDescID := pushsubscriptionFields[0].Descriptor()
-	// pushsubscription.DefaultID holds the default value on creation for the id field.
-	pushsubscription.DefaultID = pushsubscriptionDescID.Default.(func() uuid.UUID)
-	ruleFields := schema.Rule{}.Fields()
-	_ = ruleFields
-	// ruleDescName is the schema descriptor for name field.
-	ruleDescName := ruleFields[2].Descriptor()
-	// rule.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	rule.NameValidator = ruleDescName.Validators[0].(func(string) error)
-	// ruleDescIsActive is the schema descriptor for is_active field.
-	ruleDescIsActive := ruleFields[9].Descriptor()
-	// rule.DefaultIsActive holds the default value on creation for the is_active field.
-	rule.DefaultIsActive = ruleDescIsActive.Default.(bool)
-	// ruleDescPriority is the schema descriptor for priority field.
-	ruleDescPriority := ruleFields[10].Descriptor()
-	// rule.DefaultPriority holds the default value on creation for the priority field.
-	rule.DefaultPriority = ruleDescPriority.Default.(int)
-	// ruleDescExecutionCount is the schema descriptor for execution_count field.
-	ruleDescExecutionCount := ruleFields[11].Descriptor()
-	// rule.DefaultExecutionCount holds the default value on creation for the execution_count field.
-	rule.DefaultExecutionCount = ruleDescExecutionCount.Default.(int)
-	// ruleDescTotalSavedCents is the schema descriptor for total_saved_cents field.
-	ruleDescTotalSavedCents := ruleFields[12].Descriptor()
-	// rule.DefaultTotalSavedCents holds the default value on creation for the total_saved_cents field.
-	rule.DefaultTotalSavedCents = ruleDescTotalSavedCents.Default.(int64)
-	// ruleDescCreatedAt is the schema descriptor for created_at field.
-	ruleDescCreatedAt := ruleFields[13].Descriptor()
-	// rule.DefaultCreatedAt holds the default value on creation for the created_at field.
-	rule.DefaultCreatedAt = ruleDescCreatedAt.Default.(func() time.Time)
-	// ruleDescUpdatedAt is the schema descriptor for updated_at field.
-	ruleDescUpdatedAt := ruleFields[14].Descriptor()
-	// rule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	rule.DefaultUpdatedAt = ruleDescUpdatedAt.Default.(func() time.Time)
-	// rule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	rule.UpdateDefaultUpdatedAt = ruleDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// ruleDescID is the schema descriptor for id field.
-	ruleDescID := ruleFields[0].Descriptor()
-	// rule.DefaultID holds the default value on creation for the id field.
-	rule.DefaultID = ruleDescID.Default.(func() uuid.UUID)
-	ruleexecutionFields := schema.RuleExecution{}.Fields()
-	_ = ruleexecutionFields
-	// ruleexecutionDescCreatedAt is the schema descriptor for created_at field.
-	ruleexecutionDescCreatedAt := ruleexecutionFields[9].Descriptor()
-	// ruleexecution.DefaultCreatedAt holds the default value on creation for the created_at field.
-	ruleexecution.DefaultCreatedAt = ruleexecutionDescCreatedAt.Default.(func() time.Time)
-	// ruleexecutionDescID is the schema descriptor for id field.
-	ruleexecutionDescID := ruleexecutionFields[0].Descriptor()
-	// ruleexecution.DefaultID holds the default value on creation for the id field.
-	ruleexecution.DefaultID = ruleexecutionDescID.Default.(func() uuid.UUID)
-	savingstransferFields := schema.SavingsTransfer{}.Fields()
-	_ = savingstransferFields
-	// savingstransferDescCreatedAt is the schema descriptor for created_at field.
-	savingstransferDescCreatedAt := savingstransferFields[9].Descriptor()
-	// savingstransfer.DefaultCreatedAt holds the default value on creation for the created_at field.
-	savingstransfer.DefaultCreatedAt = savingstransferDescCreatedAt.Default.(func() time.Time)
-	// savingstransferDescID is the schema descriptor for id field.
-	savingstransferDescID := savingstransferFields[0].Descriptor()
-	// savingstransfer.DefaultID holds the default value on creation for the id field.
-	savingstransfer.DefaultID = savingstransferDescID.Default.(func() uuid.UUID)
-	synccursorFields := schema.SyncCursor{}.Fields()
-	_ = synccursorFields
-	// synccursorDescCursor is the schema descriptor for cursor field.
-	synccursorDescCursor := synccursorFields[2].Descriptor()
-	// synccursor.DefaultCursor holds the default value on creation for the cursor field.
-	synccursor.DefaultCursor = synccursorDescCursor.Default.(string)
-	// synccursorDescLastSyncAt is the schema descriptor for last_sync_at field.
-	synccursorDescLastSyncAt := synccursorFields[3].Descriptor()
-	// synccursor.DefaultLastSyncAt holds the default value on creation for the last_sync_at field.
-	synccursor.DefaultLastSyncAt = synccursorDescLastSyncAt.Default.(func() time.Time)
-	// synccursor.UpdateDefaultLastSyncAt holds the default value on update for the last_sync_at field.
-	synccursor.UpdateDefaultLastSyncAt = synccursorDescLastSyncAt.UpdateDefault.(func() time.Time)
-	// synccursorDescLastError is the schema descriptor for last_error field.
-	synccursorDescLastError := synccursorFields[4].Descriptor()
-	// synccursor.DefaultLastError holds the default value on creation for the last_error field.
-	synccursor.DefaultLastError = synccursorDescLastError.Default.(string)
-	// synccursorDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
-	synccursorDescConsecutiveFailures := synccursorFields[5].Descriptor()
-	// synccursor.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
-	synccursor.DefaultConsecutiveFailures = synccursorDescConsecutiveFailures.Default.(int)
-	// synccursorDescID is the schema descriptor for id field.
-	synccursorDescID := synccursorFields[0].Descriptor()
-	// synccursor.DefaultID holds the default value on creation for the id field.
-	synccursor.DefaultID = synccursorDescID.Default.(func() uuid.UUID)
-	transactionFields := schema.Transaction{}.Fields()
-	_ = transactionFields
-	// transactionDescPlaidID is the schema descriptor for plaid_id field.
-	transactionDescPlaidID := transactionFields[2].Descriptor()
-	// transaction.PlaidIDValidator is a validator for the "plaid_id" field. It is called by the builders before save.
-	transaction.PlaidIDValidator = transactionDescPlaidID.Validators[0].(func(string) error)
-	// transactionDescName is the schema descriptor for name field.
-	transactionDescName := transactionFields[5].Descriptor()
-	// transaction.NameValidator is a validator for the "name" field. It is called by the builders before save.
-	transaction.NameValidator = transactionDescName.Validators[0].(func(string) error)
-	// transactionDescCategory is the schema descriptor for category field.
-	transactionDescCategory := transactionFields[7].Descriptor()
-	// transaction.DefaultCategory holds the default value on creation for the category field.
-	transaction.DefaultCategory = transactionDescCategory.Default.(string)
-	// transactionDescPending is the schema descriptor for pending field.
-	transactionDescPending := transactionFields[9].Descriptor()
-	// transaction.DefaultPending holds the default value on creation for the pending field.
-	transaction.DefaultPending = transactionDescPending.Default.(bool)
-	// transactionDescCreatedAt is the schema descriptor for created_at field.
-	transactionDescCreatedAt := transactionFields[11].Descriptor()
-	// transaction.DefaultCreatedAt holds the default value on creation for the created_at field.
-	transaction.DefaultCreatedAt = transactionDescCreatedAt.Default.(func() time.Time)
-	// transactionDescUpdatedAt is the schema descriptor for updated_at field.
-	transactionDescUpdatedAt := transactionFields[12].Descriptor()
-	// transaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
-	transaction.DefaultUpdatedAt = transactionDescUpdatedAt.Default.(func() time.Time)
-	// transaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
-	transaction.UpdateDefaultUpdatedAt = transactionDescUpdatedAt.UpdateDefault.(func() time.Time)
-	// transactionDescID is the schema descriptor for id field.
-	transactionDescID := transactionFields[0].Descriptor()
-	// transaction.DefaultID holds the default value on creation for the id field.
-	transaction.DefaultID = transactionDescID.Default.(func() uuid.UUID)
-	userFields := schema.User{}.Fields()
-	_ = userFields
-	// userDescID is the schema descriptor for id field.
-	userDescID := userFields[0].Descriptor()
-	// user.DefaultID holds the default value on creation for the id field.
-	user.DefaultID = userDescID.Default.(func() uuid.UUID)
-}
+// The schema-stitching logic is generated in regulation/internal/ent/runtime/runtime.go
@@ -2,7 +2,526 @@
 
 package runtime
 
-// The schema-stitching logic is generated in regulation/internal/ent/runtime.go
+import (
+	"regulation/internal/ent/account"
+	"regulation/internal/ent/backfilljob"
+	"regulation/internal/ent/balancesnapshot"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/budgetalert"
+	"regulation/internal/ent/family"
+	"regulation/internal/ent/familymember"
+	"regulation/internal/ent/goal"
+	"regulation/internal/ent/item"
+	"regulation/internal/ent/monthlyreport"
+	"regulation/internal/ent/pushsubscription"
+	"regulation/internal/ent/recurringcharge"
+	"regulation/internal/ent/rule"
+	"regulation/internal/ent/ruleexecution"
+	"regulation/internal/ent/savingstransfer"
+	"regulation/internal/ent/schema"
+	"regulation/internal/ent/synccursor"
+	"regulation/internal/ent/syncrun"
+	"regulation/internal/ent/transaction"
+	"regulation/internal/ent/user"
+	"time"
+
+	"github.com/google/uuid"
+
+	"entgo.io/ent/schema/field"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	accountFields := schema.Account{}.Fields()
+	_ = accountFields
+	// accountDescExternalID is the schema descriptor for external_id field.
+	accountDescExternalID := accountFields[3].Descriptor()
+	// account.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	account.ExternalIDValidator = accountDescExternalID.Validators[0].(func(string) error)
+	// accountDescName is the schema descriptor for name field.
+	accountDescName := accountFields[4].Descriptor()
+	// account.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	account.NameValidator = accountDescName.Validators[0].(func(string) error)
+	// accountDescCurrentBalance is the schema descriptor for current_balance field.
+	accountDescCurrentBalance := accountFields[8].Descriptor()
+	// account.DefaultCurrentBalance holds the default value on creation for the current_balance field.
+	account.DefaultCurrentBalance = accountDescCurrentBalance.Default.(int64)
+	// accountDescCurrency is the schema descriptor for currency field.
+	accountDescCurrency := accountFields[10].Descriptor()
+	// account.DefaultCurrency holds the default value on creation for the currency field.
+	account.DefaultCurrency = accountDescCurrency.Default.(string)
+	// accountDescIsActive is the schema descriptor for is_active field.
+	accountDescIsActive := accountFields[13].Descriptor()
+	// account.DefaultIsActive holds the default value on creation for the is_active field.
+	account.DefaultIsActive = accountDescIsActive.Default.(bool)
+	// accountDescCreatedAt is the schema descriptor for created_at field.
+	accountDescCreatedAt := accountFields[16].Descriptor()
+	// account.DefaultCreatedAt holds the default value on creation for the created_at field.
+	account.DefaultCreatedAt = accountDescCreatedAt.Default.(func() time.Time)
+	// accountDescUpdatedAt is the schema descriptor for updated_at field.
+	accountDescUpdatedAt := accountFields[17].Descriptor()
+	// account.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	account.DefaultUpdatedAt = accountDescUpdatedAt.Default.(func() time.Time)
+	// account.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	account.UpdateDefaultUpdatedAt = accountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// accountDescID is the schema descriptor for id field.
+	accountDescID := accountFields[0].Descriptor()
+	// account.DefaultID holds the default value on creation for the id field.
+	account.DefaultID = accountDescID.Default.(func() uuid.UUID)
+	backfilljobFields := schema.BackfillJob{}.Fields()
+	_ = backfilljobFields
+	// backfilljobDescDaysRequested is the schema descriptor for days_requested field.
+	backfilljobDescDaysRequested := backfilljobFields[3].Descriptor()
+	// backfilljob.DaysRequestedValidator is a validator for the "days_requested" field. It is called by the builders before save.
+	backfilljob.DaysRequestedValidator = backfilljobDescDaysRequested.Validators[0].(func(int) error)
+	// backfilljobDescSyncCount is the schema descriptor for sync_count field.
+	backfilljobDescSyncCount := backfilljobFields[5].Descriptor()
+	// backfilljob.DefaultSyncCount holds the default value on creation for the sync_count field.
+	backfilljob.DefaultSyncCount = backfilljobDescSyncCount.Default.(int)
+	// backfilljobDescTransactionsSynced is the schema descriptor for transactions_synced field.
+	backfilljobDescTransactionsSynced := backfilljobFields[6].Descriptor()
+	// backfilljob.DefaultTransactionsSynced holds the default value on creation for the transactions_synced field.
+	backfilljob.DefaultTransactionsSynced = backfilljobDescTransactionsSynced.Default.(int)
+	// backfilljobDescStartedAt is the schema descriptor for started_at field.
+	backfilljobDescStartedAt := backfilljobFields[12].Descriptor()
+	// backfilljob.DefaultStartedAt holds the default value on creation for the started_at field.
+	backfilljob.DefaultStartedAt = backfilljobDescStartedAt.Default.(func() time.Time)
+	// backfilljobDescUpdatedAt is the schema descriptor for updated_at field.
+	backfilljobDescUpdatedAt := backfilljobFields[13].Descriptor()
+	// backfilljob.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	backfilljob.DefaultUpdatedAt = backfilljobDescUpdatedAt.Default.(func() time.Time)
+	// backfilljob.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	backfilljob.UpdateDefaultUpdatedAt = backfilljobDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// backfilljobDescID is the schema descriptor for id field.
+	backfilljobDescID := backfilljobFields[0].Descriptor()
+	// backfilljob.DefaultID holds the default value on creation for the id field.
+	backfilljob.DefaultID = backfilljobDescID.Default.(func() uuid.UUID)
+	balancesnapshotFields := schema.BalanceSnapshot{}.Fields()
+	_ = balancesnapshotFields
+	// balancesnapshotDescCreatedAt is the schema descriptor for created_at field.
+	balancesnapshotDescCreatedAt := balancesnapshotFields[6].Descriptor()
+	// balancesnapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	balancesnapshot.DefaultCreatedAt = balancesnapshotDescCreatedAt.Default.(func() time.Time)
+	// balancesnapshotDescUpdatedAt is the schema descriptor for updated_at field.
+	balancesnapshotDescUpdatedAt := balancesnapshotFields[7].Descriptor()
+	// balancesnapshot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	balancesnapshot.DefaultUpdatedAt = balancesnapshotDescUpdatedAt.Default.(func() time.Time)
+	// balancesnapshot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	balancesnapshot.UpdateDefaultUpdatedAt = balancesnapshotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// balancesnapshotDescID is the schema descriptor for id field.
+	balancesnapshotDescID := balancesnapshotFields[0].Descriptor()
+	// balancesnapshot.DefaultID holds the default value on creation for the id field.
+	balancesnapshot.DefaultID = balancesnapshotDescID.Default.(func() uuid.UUID)
+	budgetFields := schema.Budget{}.Fields()
+	_ = budgetFields
+	// budgetDescAmountCents is the schema descriptor for amount_cents field.
+	budgetDescAmountCents := budgetFields[4].Descriptor()
+	// budget.AmountCentsValidator is a validator for the "amount_cents" field. It is called by the builders before save.
+	budget.AmountCentsValidator = budgetDescAmountCents.Validators[0].(func(int64) error)
+	// budgetDescAlertThresholds is the schema descriptor for alert_thresholds field.
+	budgetDescAlertThresholds := budgetFields[5].Descriptor()
+	// budget.DefaultAlertThresholds holds the default value on creation for the alert_thresholds field.
+	budget.DefaultAlertThresholds = budgetDescAlertThresholds.Default.([]int)
+	// budgetDescIsActive is the schema descriptor for is_active field.
+	budgetDescIsActive := budgetFields[6].Descriptor()
+	// budget.DefaultIsActive holds the default value on creation for the is_active field.
+	budget.DefaultIsActive = budgetDescIsActive.Default.(bool)
+	// budgetDescCreatedAt is the schema descriptor for created_at field.
+	budgetDescCreatedAt := budgetFields[7].Descriptor()
+	// budget.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budget.DefaultCreatedAt = budgetDescCreatedAt.Default.(func() time.Time)
+	// budgetDescUpdatedAt is the schema descriptor for updated_at field.
+	budgetDescUpdatedAt := budgetFields[8].Descriptor()
+	// budget.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	budget.DefaultUpdatedAt = budgetDescUpdatedAt.Default.(func() time.Time)
+	// budget.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	budget.UpdateDefaultUpdatedAt = budgetDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// budgetDescID is the schema descriptor for id field.
+	budgetDescID := budgetFields[0].Descriptor()
+	// budget.DefaultID holds the default value on creation for the id field.
+	budget.DefaultID = budgetDescID.Default.(func() uuid.UUID)
+	budgetalertFields := schema.BudgetAlert{}.Fields()
+	_ = budgetalertFields
+	// budgetalertDescMonth is the schema descriptor for month field.
+	budgetalertDescMonth := budgetalertFields[2].Descriptor()
+	// budgetalert.MonthValidator is a validator for the "month" field. It is called by the builders before save.
+	budgetalert.MonthValidator = budgetalertDescMonth.Validators[0].(func(string) error)
+	// budgetalertDescCreatedAt is the schema descriptor for created_at field.
+	budgetalertDescCreatedAt := budgetalertFields[5].Descriptor()
+	// budgetalert.DefaultCreatedAt holds the default value on creation for the created_at field.
+	budgetalert.DefaultCreatedAt = budgetalertDescCreatedAt.Default.(func() time.Time)
+	// budgetalertDescID is the schema descriptor for id field.
+	budgetalertDescID := budgetalertFields[0].Descriptor()
+	// budgetalert.DefaultID holds the default value on creation for the id field.
+	budgetalert.DefaultID = budgetalertDescID.Default.(func() uuid.UUID)
+	familyFields := schema.Family{}.Fields()
+	_ = familyFields
+	// familyDescName is the schema descriptor for name field.
+	familyDescName := familyFields[1].Descriptor()
+	// family.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	family.NameValidator = familyDescName.Validators[0].(func(string) error)
+	// familyDescCreatedAt is the schema descriptor for created_at field.
+	familyDescCreatedAt := familyFields[3].Descriptor()
+	// family.DefaultCreatedAt holds the default value on creation for the created_at field.
+	family.DefaultCreatedAt = familyDescCreatedAt.Default.(func() time.Time)
+	// familyDescUpdatedAt is the schema descriptor for updated_at field.
+	familyDescUpdatedAt := familyFields[4].Descriptor()
+	// family.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	family.DefaultUpdatedAt = familyDescUpdatedAt.Default.(func() time.Time)
+	// family.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	family.UpdateDefaultUpdatedAt = familyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// familyDescID is the schema descriptor for id field.
+	familyDescID := familyFields[0].Descriptor()
+	// family.DefaultID holds the default value on creation for the id field.
+	family.DefaultID = familyDescID.Default.(func() uuid.UUID)
+	familymemberFields := schema.FamilyMember{}.Fields()
+	_ = familymemberFields
+	// familymemberDescCreatedAt is the schema descriptor for created_at field.
+	familymemberDescCreatedAt := familymemberFields[5].Descriptor()
+	// familymember.DefaultCreatedAt holds the default value on creation for the created_at field.
+	familymember.DefaultCreatedAt = familymemberDescCreatedAt.Default.(func() time.Time)
+	// familymemberDescID is the schema descriptor for id field.
+	familymemberDescID := familymemberFields[0].Descriptor()
+	// familymember.DefaultID holds the default value on creation for the id field.
+	familymember.DefaultID = familymemberDescID.Default.(func() uuid.UUID)
+	goalFields := schema.Goal{}.Fields()
+	_ = goalFields
+	// goalDescName is the schema descriptor for name field.
+	goalDescName := goalFields[3].Descriptor()
+	// goal.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	goal.NameValidator = goalDescName.Validators[0].(func(string) error)
+	// goalDescTargetCents is the schema descriptor for target_cents field.
+	goalDescTargetCents := goalFields[6].Descriptor()
+	// goal.TargetCentsValidator is a validator for the "target_cents" field. It is called by the builders before save.
+	goal.TargetCentsValidator = goalDescTargetCents.Validators[0].(func(int64) error)
+	// goalDescCreatedAt is the schema descriptor for created_at field.
+	goalDescCreatedAt := goalFields[7].Descriptor()
+	// goal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	goal.DefaultCreatedAt = goalDescCreatedAt.Default.(func() time.Time)
+	// goalDescUpdatedAt is the schema descriptor for updated_at field.
+	goalDescUpdatedAt := goalFields[8].Descriptor()
+	// goal.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	goal.DefaultUpdatedAt = goalDescUpdatedAt.Default.(func() time.Time)
+	// goal.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	goal.UpdateDefaultUpdatedAt = goalDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// goalDescID is the schema descriptor for id field.
+	goalDescID := goalFields[0].Descriptor()
+	// goal.DefaultID holds the default value on creation for the id field.
+	goal.DefaultID = goalDescID.Default.(func() uuid.UUID)
+	itemHooks := schema.Item{}.Hooks()
+	item.Hooks[0] = itemHooks[0]
+	itemFields := schema.Item{}.Fields()
+	_ = itemFields
+	// itemDescProvider is the schema descriptor for provider field.
+	itemDescProvider := itemFields[2].Descriptor()
+	// item.DefaultProvider holds the default value on creation for the provider field.
+	item.DefaultProvider = itemDescProvider.Default.(string)
+	// item.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	item.ProviderValidator = itemDescProvider.Validators[0].(func(string) error)
+	// itemDescExternalID is the schema descriptor for external_id field.
+	itemDescExternalID := itemFields[3].Descriptor()
+	// item.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	item.ExternalIDValidator = itemDescExternalID.Validators[0].(func(string) error)
+	// itemDescAccessToken is the schema descriptor for access_token field.
+	itemDescAccessToken := itemFields[4].Descriptor()
+	item.ValueScanner.AccessToken = itemDescAccessToken.ValueScanner.(field.TypeValueScanner[string])
+	// item.AccessTokenValidator is a validator for the "access_token" field. It is called by the builders before save.
+	item.AccessTokenValidator = itemDescAccessToken.Validators[0].(func(string) error)
+	// itemDescAccessTokenKeyID is the schema descriptor for access_token_key_id field.
+	itemDescAccessTokenKeyID := itemFields[5].Descriptor()
+	// item.DefaultAccessTokenKeyID holds the default value on creation for the access_token_key_id field.
+	item.DefaultAccessTokenKeyID = itemDescAccessTokenKeyID.Default.(string)
+	// itemDescInstitutionName is the schema descriptor for institution_name field.
+	itemDescInstitutionName := itemFields[6].Descriptor()
+	// item.InstitutionNameValidator is a validator for the "institution_name" field. It is called by the builders before save.
+	item.InstitutionNameValidator = itemDescInstitutionName.Validators[0].(func(string) error)
+	// itemDescIsActive is the schema descriptor for is_active field.
+	itemDescIsActive := itemFields[11].Descriptor()
+	// item.DefaultIsActive holds the default value on creation for the is_active field.
+	item.DefaultIsActive = itemDescIsActive.Default.(bool)
+	// itemDescCreatedAt is the schema descriptor for created_at field.
+	itemDescCreatedAt := itemFields[13].Descriptor()
+	// item.DefaultCreatedAt holds the default value on creation for the created_at field.
+	item.DefaultCreatedAt = itemDescCreatedAt.Default.(func() time.Time)
+	// itemDescUpdatedAt is the schema descriptor for updated_at field.
+	itemDescUpdatedAt := itemFields[14].Descriptor()
+	// item.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	item.DefaultUpdatedAt = itemDescUpdatedAt.Default.(func() time.Time)
+	// item.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	item.UpdateDefaultUpdatedAt = itemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// itemDescID is the schema descriptor for id field.
+	itemDescID := itemFields[0].Descriptor()
+	// item.DefaultID holds the default value on creation for the id field.
+	item.DefaultID = itemDescID.Default.(func() uuid.UUID)
+	monthlyreportFields := schema.MonthlyReport{}.Fields()
+	_ = monthlyreportFields
+	// monthlyreportDescMonth is the schema descriptor for month field.
+	monthlyreportDescMonth := monthlyreportFields[2].Descriptor()
+	// monthlyreport.MonthValidator is a validator for the "month" field. It is called by the builders before save.
+	monthlyreport.MonthValidator = monthlyreportDescMonth.Validators[0].(func(string) error)
+	// monthlyreportDescIncomeCents is the schema descriptor for income_cents field.
+	monthlyreportDescIncomeCents := monthlyreportFields[3].Descriptor()
+	// monthlyreport.DefaultIncomeCents holds the default value on creation for the income_cents field.
+	monthlyreport.DefaultIncomeCents = monthlyreportDescIncomeCents.Default.(int64)
+	// monthlyreportDescSpendCents is the schema descriptor for spend_cents field.
+	monthlyreportDescSpendCents := monthlyreportFields[4].Descriptor()
+	// monthlyreport.DefaultSpendCents holds the default value on creation for the spend_cents field.
+	monthlyreport.DefaultSpendCents = monthlyreportDescSpendCents.Default.(int64)
+	// monthlyreportDescSavingsCents is the schema descriptor for savings_cents field.
+	monthlyreportDescSavingsCents := monthlyreportFields[5].Descriptor()
+	// monthlyreport.DefaultSavingsCents holds the default value on creation for the savings_cents field.
+	monthlyreport.DefaultSavingsCents = monthlyreportDescSavingsCents.Default.(int64)
+	// monthlyreportDescNetCents is the schema descriptor for net_cents field.
+	monthlyreportDescNetCents := monthlyreportFields[6].Descriptor()
+	// monthlyreport.DefaultNetCents holds the default value on creation for the net_cents field.
+	monthlyreport.DefaultNetCents = monthlyreportDescNetCents.Default.(int64)
+	// monthlyreportDescCurrency is the schema descriptor for currency field.
+	monthlyreportDescCurrency := monthlyreportFields[7].Descriptor()
+	// monthlyreport.DefaultCurrency holds the default value on creation for the currency field.
+	monthlyreport.DefaultCurrency = monthlyreportDescCurrency.Default.(string)
+	// monthlyreportDescCreatedAt is the schema descriptor for created_at field.
+	monthlyreportDescCreatedAt := monthlyreportFields[11].Descriptor()
+	// monthlyreport.DefaultCreatedAt holds the default value on creation for the created_at field.
+	monthlyreport.DefaultCreatedAt = monthlyreportDescCreatedAt.Default.(func() time.Time)
+	// monthlyreportDescID is the schema descriptor for id field.
+	monthlyreportDescID := monthlyreportFields[0].Descriptor()
+	// monthlyreport.DefaultID holds the default value on creation for the id field.
+	monthlyreport.DefaultID = monthlyreportDescID.Default.(func() uuid.UUID)
+	pushsubscriptionFields := schema.PushSubscription{}.Fields()
+	_ = pushsubscriptionFields
+	// pushsubscriptionDescEndpoint is the schema descriptor for endpoint field.
+	pushsubscriptionDescEndpoint := pushsubscriptionFields[2].Descriptor()
+	// pushsubscription.EndpointValidator is a validator for the "endpoint" field. It is called by the builders before save.
+	pushsubscription.EndpointValidator = pushsubscriptionDescEndpoint.Validators[0].(func(string) error)
+	// pushsubscriptionDescP256dh is the schema descriptor for p256dh field.
+	pushsubscriptionDescP256dh := pushsubscriptionFields[3].Descriptor()
+	// pushsubscription.P256dhValidator is a validator for the "p256dh" field. It is called by the builders before save.
+	pushsubscription.P256dhValidator = pushsubscriptionDescP256dh.Validators[0].(func(string) error)
+	// pushsubscriptionDescAuth is the schema descriptor for auth field.
+	pushsubscriptionDescAuth := pushsubscriptionFields[4].Descriptor()
+	// pushsubscription.AuthValidator is a validator for the "auth" field. It is called by the builders before save.
+	pushsubscription.AuthValidator = pushsubscriptionDescAuth.Validators[0].(func(string) error)
+	// pushsubscriptionDescActive is the schema descriptor for active field.
+	pushsubscriptionDescActive := pushsubscriptionFields[5].Descriptor()
+	// pushsubscription.DefaultActive holds the default value on creation for the active field.
+	pushsubscription.DefaultActive = pushsubscriptionDescActive.Default.(bool)
+	// pushsubscriptionDescCreatedAt is the schema descriptor for created_at field.
+	pushsubscriptionDescCreatedAt := pushsubscriptionFields[6].Descriptor()
+	// pushsubscription.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pushsubscription.DefaultCreatedAt = pushsubscriptionDescCreatedAt.Default.(func() time.Time)
+	// pushsubscriptionDescUpdatedAt is the schema descriptor for updated_at field.
+	pushsubscriptionDescUpdatedAt := pushsubscriptionFields[7].Descriptor()
+	// pushsubscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	pushsubscription.DefaultUpdatedAt = pushsubscriptionDescUpdatedAt.Default.(func() time.Time)
+	// pushsubscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	pushsubscription.UpdateDefaultUpdatedAt = pushsubscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// pushsubscriptionDescID is the schema descriptor for id field.
+	pushsubscriptionDescID := pushsubscriptionFields[0].Descriptor()
+	// pushsubscription.DefaultID holds the default value on creation for the id field.
+	pushsubscription.DefaultID = pushsubscriptionDescID.Default.(func() uuid.UUID)
+	recurringchargeFields := schema.RecurringCharge{}.Fields()
+	_ = recurringchargeFields
+	// recurringchargeDescMerchantKey is the schema descriptor for merchant_key field.
+	recurringchargeDescMerchantKey := recurringchargeFields[2].Descriptor()
+	// recurringcharge.MerchantKeyValidator is a validator for the "merchant_key" field. It is called by the builders before save.
+	recurringcharge.MerchantKeyValidator = recurringchargeDescMerchantKey.Validators[0].(func(string) error)
+	// recurringchargeDescMerchantName is the schema descriptor for merchant_name field.
+	recurringchargeDescMerchantName := recurringchargeFields[3].Descriptor()
+	// recurringcharge.MerchantNameValidator is a validator for the "merchant_name" field. It is called by the builders before save.
+	recurringcharge.MerchantNameValidator = recurringchargeDescMerchantName.Validators[0].(func(string) error)
+	// recurringchargeDescCategory is the schema descriptor for category field.
+	recurringchargeDescCategory := recurringchargeFields[4].Descriptor()
+	// recurringcharge.DefaultCategory holds the default value on creation for the category field.
+	recurringcharge.DefaultCategory = recurringchargeDescCategory.Default.(string)
+	// recurringchargeDescPriceIncreased is the schema descriptor for price_increased field.
+	recurringchargeDescPriceIncreased := recurringchargeFields[8].Descriptor()
+	// recurringcharge.DefaultPriceIncreased holds the default value on creation for the price_increased field.
+	recurringcharge.DefaultPriceIncreased = recurringchargeDescPriceIncreased.Default.(bool)
+	// recurringchargeDescIsActive is the schema descriptor for is_active field.
+	recurringchargeDescIsActive := recurringchargeFields[12].Descriptor()
+	// recurringcharge.DefaultIsActive holds the default value on creation for the is_active field.
+	recurringcharge.DefaultIsActive = recurringchargeDescIsActive.Default.(bool)
+	// recurringchargeDescCreatedAt is the schema descriptor for created_at field.
+	recurringchargeDescCreatedAt := recurringchargeFields[13].Descriptor()
+	// recurringcharge.DefaultCreatedAt holds the default value on creation for the created_at field.
+	recurringcharge.DefaultCreatedAt = recurringchargeDescCreatedAt.Default.(func() time.Time)
+	// recurringchargeDescUpdatedAt is the schema descriptor for updated_at field.
+	recurringchargeDescUpdatedAt := recurringchargeFields[14].Descriptor()
+	// recurringcharge.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	recurringcharge.DefaultUpdatedAt = recurringchargeDescUpdatedAt.Default.(func() time.Time)
+	// recurringcharge.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	recurringcharge.UpdateDefaultUpdatedAt = recurringchargeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// recurringchargeDescID is the schema descriptor for id field.
+	recurringchargeDescID := recurringchargeFields[0].Descriptor()
+	// recurringcharge.DefaultID holds the default value on creation for the id field.
+	recurringcharge.DefaultID = recurringchargeDescID.Default.(func() uuid.UUID)
+	ruleFields := schema.Rule{}.Fields()
+	_ = ruleFields
+	// ruleDescName is the schema descriptor for name field.
+	ruleDescName := ruleFields[3].Descriptor()
+	// rule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	rule.NameValidator = ruleDescName.Validators[0].(func(string) error)
+	// ruleDescIsActive is the schema descriptor for is_active field.
+	ruleDescIsActive := ruleFields[12].Descriptor()
+	// rule.DefaultIsActive holds the default value on creation for the is_active field.
+	rule.DefaultIsActive = ruleDescIsActive.Default.(bool)
+	// ruleDescPriority is the schema descriptor for priority field.
+	ruleDescPriority := ruleFields[13].Descriptor()
+	// rule.DefaultPriority holds the default value on creation for the priority field.
+	rule.DefaultPriority = ruleDescPriority.Default.(int)
+	// ruleDescExecutionCount is the schema descriptor for execution_count field.
+	ruleDescExecutionCount := ruleFields[14].Descriptor()
+	// rule.DefaultExecutionCount holds the default value on creation for the execution_count field.
+	rule.DefaultExecutionCount = ruleDescExecutionCount.Default.(int)
+	// ruleDescTotalSavedCents is the schema descriptor for total_saved_cents field.
+	ruleDescTotalSavedCents := ruleFields[15].Descriptor()
+	// rule.DefaultTotalSavedCents holds the default value on creation for the total_saved_cents field.
+	rule.DefaultTotalSavedCents = ruleDescTotalSavedCents.Default.(int64)
+	// ruleDescCreatedAt is the schema descriptor for created_at field.
+	ruleDescCreatedAt := ruleFields[16].Descriptor()
+	// rule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rule.DefaultCreatedAt = ruleDescCreatedAt.Default.(func() time.Time)
+	// ruleDescUpdatedAt is the schema descriptor for updated_at field.
+	ruleDescUpdatedAt := ruleFields[17].Descriptor()
+	// rule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rule.DefaultUpdatedAt = ruleDescUpdatedAt.Default.(func() time.Time)
+	// rule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rule.UpdateDefaultUpdatedAt = ruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ruleDescID is the schema descriptor for id field.
+	ruleDescID := ruleFields[0].Descriptor()
+	// rule.DefaultID holds the default value on creation for the id field.
+	rule.DefaultID = ruleDescID.Default.(func() uuid.UUID)
+	ruleexecutionFields := schema.RuleExecution{}.Fields()
+	_ = ruleexecutionFields
+	// ruleexecutionDescCreatedAt is the schema descriptor for created_at field.
+	ruleexecutionDescCreatedAt := ruleexecutionFields[10].Descriptor()
+	// ruleexecution.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ruleexecution.DefaultCreatedAt = ruleexecutionDescCreatedAt.Default.(func() time.Time)
+	// ruleexecutionDescID is the schema descriptor for id field.
+	ruleexecutionDescID := ruleexecutionFields[0].Descriptor()
+	// ruleexecution.DefaultID holds the default value on creation for the id field.
+	ruleexecution.DefaultID = ruleexecutionDescID.Default.(func() uuid.UUID)
+	savingstransferFields := schema.SavingsTransfer{}.Fields()
+	_ = savingstransferFields
+	// savingstransferDescCurrency is the schema descriptor for currency field.
+	savingstransferDescCurrency := savingstransferFields[6].Descriptor()
+	// savingstransfer.DefaultCurrency holds the default value on creation for the currency field.
+	savingstransfer.DefaultCurrency = savingstransferDescCurrency.Default.(string)
+	// savingstransferDescCreatedAt is the schema descriptor for created_at field.
+	savingstransferDescCreatedAt := savingstransferFields[10].Descriptor()
+	// savingstransfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	savingstransfer.DefaultCreatedAt = savingstransferDescCreatedAt.Default.(func() time.Time)
+	// savingstransferDescID is the schema descriptor for id field.
+	savingstransferDescID := savingstransferFields[0].Descriptor()
+	// savingstransfer.DefaultID holds the default value on creation for the id field.
+	savingstransfer.DefaultID = savingstransferDescID.Default.(func() uuid.UUID)
+	synccursorFields := schema.SyncCursor{}.Fields()
+	_ = synccursorFields
+	// synccursorDescCursor is the schema descriptor for cursor field.
+	synccursorDescCursor := synccursorFields[2].Descriptor()
+	// synccursor.DefaultCursor holds the default value on creation for the cursor field.
+	synccursor.DefaultCursor = synccursorDescCursor.Default.(string)
+	// synccursorDescLastSyncAt is the schema descriptor for last_sync_at field.
+	synccursorDescLastSyncAt := synccursorFields[3].Descriptor()
+	// synccursor.DefaultLastSyncAt holds the default value on creation for the last_sync_at field.
+	synccursor.DefaultLastSyncAt = synccursorDescLastSyncAt.Default.(func() time.Time)
+	// synccursor.UpdateDefaultLastSyncAt holds the default value on update for the last_sync_at field.
+	synccursor.UpdateDefaultLastSyncAt = synccursorDescLastSyncAt.UpdateDefault.(func() time.Time)
+	// synccursorDescLastError is the schema descriptor for last_error field.
+	synccursorDescLastError := synccursorFields[4].Descriptor()
+	// synccursor.DefaultLastError holds the default value on creation for the last_error field.
+	synccursor.DefaultLastError = synccursorDescLastError.Default.(string)
+	// synccursorDescConsecutiveFailures is the schema descriptor for consecutive_failures field.
+	synccursorDescConsecutiveFailures := synccursorFields[5].Descriptor()
+	// synccursor.DefaultConsecutiveFailures holds the default value on creation for the consecutive_failures field.
+	synccursor.DefaultConsecutiveFailures = synccursorDescConsecutiveFailures.Default.(int)
+	// synccursorDescNextSyncAt is the schema descriptor for next_sync_at field.
+	synccursorDescNextSyncAt := synccursorFields[6].Descriptor()
+	// synccursor.DefaultNextSyncAt holds the default value on creation for the next_sync_at field.
+	synccursor.DefaultNextSyncAt = synccursorDescNextSyncAt.Default.(func() time.Time)
+	// synccursorDescID is the schema descriptor for id field.
+	synccursorDescID := synccursorFields[0].Descriptor()
+	// synccursor.DefaultID holds the default value on creation for the id field.
+	synccursor.DefaultID = synccursorDescID.Default.(func() uuid.UUID)
+	syncrunFields := schema.SyncRun{}.Fields()
+	_ = syncrunFields
+	// syncrunDescAddedCount is the schema descriptor for added_count field.
+	syncrunDescAddedCount := syncrunFields[4].Descriptor()
+	// syncrun.DefaultAddedCount holds the default value on creation for the added_count field.
+	syncrun.DefaultAddedCount = syncrunDescAddedCount.Default.(int)
+	// syncrunDescModifiedCount is the schema descriptor for modified_count field.
+	syncrunDescModifiedCount := syncrunFields[5].Descriptor()
+	// syncrun.DefaultModifiedCount holds the default value on creation for the modified_count field.
+	syncrun.DefaultModifiedCount = syncrunDescModifiedCount.Default.(int)
+	// syncrunDescRemovedCount is the schema descriptor for removed_count field.
+	syncrunDescRemovedCount := syncrunFields[6].Descriptor()
+	// syncrun.DefaultRemovedCount holds the default value on creation for the removed_count field.
+	syncrun.DefaultRemovedCount = syncrunDescRemovedCount.Default.(int)
+	// syncrunDescRulesTriggered is the schema descriptor for rules_triggered field.
+	syncrunDescRulesTriggered := syncrunFields[7].Descriptor()
+	// syncrun.DefaultRulesTriggered holds the default value on creation for the rules_triggered field.
+	syncrun.DefaultRulesTriggered = syncrunDescRulesTriggered.Default.(int)
+	// syncrunDescStartedAt is the schema descriptor for started_at field.
+	syncrunDescStartedAt := syncrunFields[9].Descriptor()
+	// syncrun.DefaultStartedAt holds the default value on creation for the started_at field.
+	syncrun.DefaultStartedAt = syncrunDescStartedAt.Default.(func() time.Time)
+	// syncrunDescID is the schema descriptor for id field.
+	syncrunDescID := syncrunFields[0].Descriptor()
+	// syncrun.DefaultID holds the default value on creation for the id field.
+	syncrun.DefaultID = syncrunDescID.Default.(func() uuid.UUID)
+	transactionFields := schema.Transaction{}.Fields()
+	_ = transactionFields
+	// transactionDescExternalID is the schema descriptor for external_id field.
+	transactionDescExternalID := transactionFields[2].Descriptor()
+	// transaction.ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	transaction.ExternalIDValidator = transactionDescExternalID.Validators[0].(func(string) error)
+	// transactionDescCurrency is the schema descriptor for currency field.
+	transactionDescCurrency := transactionFields[4].Descriptor()
+	// transaction.DefaultCurrency holds the default value on creation for the currency field.
+	transaction.DefaultCurrency = transactionDescCurrency.Default.(string)
+	// transactionDescName is the schema descriptor for name field.
+	transactionDescName := transactionFields[6].Descriptor()
+	// transaction.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	transaction.NameValidator = transactionDescName.Validators[0].(func(string) error)
+	// transactionDescCategory is the schema descriptor for category field.
+	transactionDescCategory := transactionFields[8].Descriptor()
+	// transaction.DefaultCategory holds the default value on creation for the category field.
+	transaction.DefaultCategory = transactionDescCategory.Default.(string)
+	// transactionDescPending is the schema descriptor for pending field.
+	transactionDescPending := transactionFields[10].Descriptor()
+	// transaction.DefaultPending holds the default value on creation for the pending field.
+	transaction.DefaultPending = transactionDescPending.Default.(bool)
+	// transactionDescInternalTransfer is the schema descriptor for internal_transfer field.
+	transactionDescInternalTransfer := transactionFields[13].Descriptor()
+	// transaction.DefaultInternalTransfer holds the default value on creation for the internal_transfer field.
+	transaction.DefaultInternalTransfer = transactionDescInternalTransfer.Default.(bool)
+	// transactionDescCreatedAt is the schema descriptor for created_at field.
+	transactionDescCreatedAt := transactionFields[17].Descriptor()
+	// transaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	transaction.DefaultCreatedAt = transactionDescCreatedAt.Default.(func() time.Time)
+	// transactionDescUpdatedAt is the schema descriptor for updated_at field.
+	transactionDescUpdatedAt := transactionFields[18].Descriptor()
+	// transaction.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	transaction.DefaultUpdatedAt = transactionDescUpdatedAt.Default.(func() time.Time)
+	// transaction.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	transaction.UpdateDefaultUpdatedAt = transactionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// transactionDescID is the schema descriptor for id field.
+	transactionDescID := transactionFields[0].Descriptor()
+	// transaction.DefaultID holds the default value on creation for the id field.
+	transaction.DefaultID = transactionDescID.Default.(func() uuid.UUID)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescHomeCurrency is the schema descriptor for home_currency field.
+	userDescHomeCurrency := userFields[5].Descriptor()
+	// user.DefaultHomeCurrency holds the default value on creation for the home_currency field.
+	user.DefaultHomeCurrency = userDescHomeCurrency.Default.(string)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.DefaultID holds the default value on creation for the id field.
+	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
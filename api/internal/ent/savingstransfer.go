@@ -31,10 +31,12 @@ type SavingsTransfer struct {
 	TargetAccountID uuid.UUID `json:"target_account_id,omitempty"`
 	// Amount to transfer in cents
 	AmountCents int64 `json:"amount_cents,omitempty"`
+	// ISO 4217 currency of the amount
+	Currency string `json:"currency,omitempty"`
 	// Transfer status
 	Status savingstransfer.Status `json:"status,omitempty"`
-	// Plaid transfer ID if real transfer was executed
-	PlaidTransferID string `json:"plaid_transfer_id,omitempty"`
+	// Aggregator's transfer ID if real transfer was executed
+	ExternalTransferID string `json:"external_transfer_id,omitempty"`
 	// Error details if failed
 	ErrorMessage string `json:"error_message,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -113,7 +115,7 @@ func (*SavingsTransfer) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case savingstransfer.FieldAmountCents:
 			values[i] = new(sql.NullInt64)
-		case savingstransfer.FieldStatus, savingstransfer.FieldPlaidTransferID, savingstransfer.FieldErrorMessage:
+		case savingstransfer.FieldCurrency, savingstransfer.FieldStatus, savingstransfer.FieldExternalTransferID, savingstransfer.FieldErrorMessage:
 			values[i] = new(sql.NullString)
 		case savingstransfer.FieldCreatedAt, savingstransfer.FieldExecutedAt:
 			values[i] = new(sql.NullTime)
@@ -170,17 +172,23 @@ func (_m *SavingsTransfer) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.AmountCents = value.Int64
 			}
+		case savingstransfer.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
 		case savingstransfer.FieldStatus:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
 			} else if value.Valid {
 				_m.Status = savingstransfer.Status(value.String)
 			}
-		case savingstransfer.FieldPlaidTransferID:
+		case savingstransfer.FieldExternalTransferID:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field plaid_transfer_id", values[i])
+				return fmt.Errorf("unexpected type %T for field external_transfer_id", values[i])
 			} else if value.Valid {
-				_m.PlaidTransferID = value.String
+				_m.ExternalTransferID = value.String
 			}
 		case savingstransfer.FieldErrorMessage:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -272,11 +280,14 @@ func (_m *SavingsTransfer) String() string {
 	builder.WriteString("amount_cents=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AmountCents))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
-	builder.WriteString("plaid_transfer_id=")
-	builder.WriteString(_m.PlaidTransferID)
+	builder.WriteString("external_transfer_id=")
+	builder.WriteString(_m.ExternalTransferID)
 	builder.WriteString(", ")
 	builder.WriteString("error_message=")
 	builder.WriteString(_m.ErrorMessage)
@@ -26,10 +26,12 @@ const (
 	FieldTargetAccountID = "target_account_id"
 	// FieldAmountCents holds the string denoting the amount_cents field in the database.
 	FieldAmountCents = "amount_cents"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
-	// FieldPlaidTransferID holds the string denoting the plaid_transfer_id field in the database.
-	FieldPlaidTransferID = "plaid_transfer_id"
+	// FieldExternalTransferID holds the string denoting the external_transfer_id field in the database.
+	FieldExternalTransferID = "external_transfer_id"
 	// FieldErrorMessage holds the string denoting the error_message field in the database.
 	FieldErrorMessage = "error_message"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -84,8 +86,9 @@ var Columns = []string{
 	FieldSourceAccountID,
 	FieldTargetAccountID,
 	FieldAmountCents,
+	FieldCurrency,
 	FieldStatus,
-	FieldPlaidTransferID,
+	FieldExternalTransferID,
 	FieldErrorMessage,
 	FieldCreatedAt,
 	FieldExecutedAt,
@@ -102,6 +105,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
@@ -170,14 +175,19 @@ func ByAmountCents(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmountCents, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
-// ByPlaidTransferID orders the results by the plaid_transfer_id field.
-func ByPlaidTransferID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldPlaidTransferID, opts...).ToFunc()
+// ByExternalTransferID orders the results by the external_transfer_id field.
+func ByExternalTransferID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalTransferID, opts...).ToFunc()
 }
 
 // ByErrorMessage orders the results by the error_message field.
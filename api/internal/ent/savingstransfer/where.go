@@ -81,9 +81,14 @@ func AmountCents(v int64) predicate.SavingsTransfer {
 	return predicate.SavingsTransfer(sql.FieldEQ(FieldAmountCents, v))
 }
 
-// PlaidTransferID applies equality check predicate on the "plaid_transfer_id" field. It's identical to PlaidTransferIDEQ.
-func PlaidTransferID(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldEQ(FieldPlaidTransferID, v))
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ExternalTransferID applies equality check predicate on the "external_transfer_id" field. It's identical to ExternalTransferIDEQ.
+func ExternalTransferID(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldEQ(FieldExternalTransferID, v))
 }
 
 // ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
@@ -221,6 +226,71 @@ func AmountCentsLTE(v int64) predicate.SavingsTransfer {
 	return predicate.SavingsTransfer(sql.FieldLTE(FieldAmountCents, v))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldContainsFold(FieldCurrency, v))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v Status) predicate.SavingsTransfer {
 	return predicate.SavingsTransfer(sql.FieldEQ(FieldStatus, v))
@@ -241,79 +311,79 @@ func StatusNotIn(vs ...Status) predicate.SavingsTransfer {
 	return predicate.SavingsTransfer(sql.FieldNotIn(FieldStatus, vs...))
 }
 
-// PlaidTransferIDEQ applies the EQ predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDEQ(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldEQ(FieldPlaidTransferID, v))
+// ExternalTransferIDEQ applies the EQ predicate on the "external_transfer_id" field.
+func ExternalTransferIDEQ(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldEQ(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDNEQ applies the NEQ predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDNEQ(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldNEQ(FieldPlaidTransferID, v))
+// ExternalTransferIDNEQ applies the NEQ predicate on the "external_transfer_id" field.
+func ExternalTransferIDNEQ(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldNEQ(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDIn applies the In predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDIn(vs ...string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldIn(FieldPlaidTransferID, vs...))
+// ExternalTransferIDIn applies the In predicate on the "external_transfer_id" field.
+func ExternalTransferIDIn(vs ...string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldIn(FieldExternalTransferID, vs...))
 }
 
-// PlaidTransferIDNotIn applies the NotIn predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDNotIn(vs ...string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldNotIn(FieldPlaidTransferID, vs...))
+// ExternalTransferIDNotIn applies the NotIn predicate on the "external_transfer_id" field.
+func ExternalTransferIDNotIn(vs ...string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldNotIn(FieldExternalTransferID, vs...))
 }
 
-// PlaidTransferIDGT applies the GT predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDGT(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldGT(FieldPlaidTransferID, v))
+// ExternalTransferIDGT applies the GT predicate on the "external_transfer_id" field.
+func ExternalTransferIDGT(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldGT(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDGTE applies the GTE predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDGTE(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldGTE(FieldPlaidTransferID, v))
+// ExternalTransferIDGTE applies the GTE predicate on the "external_transfer_id" field.
+func ExternalTransferIDGTE(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldGTE(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDLT applies the LT predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDLT(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldLT(FieldPlaidTransferID, v))
+// ExternalTransferIDLT applies the LT predicate on the "external_transfer_id" field.
+func ExternalTransferIDLT(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldLT(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDLTE applies the LTE predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDLTE(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldLTE(FieldPlaidTransferID, v))
+// ExternalTransferIDLTE applies the LTE predicate on the "external_transfer_id" field.
+func ExternalTransferIDLTE(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldLTE(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDContains applies the Contains predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDContains(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldContains(FieldPlaidTransferID, v))
+// ExternalTransferIDContains applies the Contains predicate on the "external_transfer_id" field.
+func ExternalTransferIDContains(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldContains(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDHasPrefix applies the HasPrefix predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDHasPrefix(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldHasPrefix(FieldPlaidTransferID, v))
+// ExternalTransferIDHasPrefix applies the HasPrefix predicate on the "external_transfer_id" field.
+func ExternalTransferIDHasPrefix(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldHasPrefix(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDHasSuffix applies the HasSuffix predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDHasSuffix(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldHasSuffix(FieldPlaidTransferID, v))
+// ExternalTransferIDHasSuffix applies the HasSuffix predicate on the "external_transfer_id" field.
+func ExternalTransferIDHasSuffix(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldHasSuffix(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDIsNil applies the IsNil predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDIsNil() predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldIsNull(FieldPlaidTransferID))
+// ExternalTransferIDIsNil applies the IsNil predicate on the "external_transfer_id" field.
+func ExternalTransferIDIsNil() predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldIsNull(FieldExternalTransferID))
 }
 
-// PlaidTransferIDNotNil applies the NotNil predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDNotNil() predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldNotNull(FieldPlaidTransferID))
+// ExternalTransferIDNotNil applies the NotNil predicate on the "external_transfer_id" field.
+func ExternalTransferIDNotNil() predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldNotNull(FieldExternalTransferID))
 }
 
-// PlaidTransferIDEqualFold applies the EqualFold predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDEqualFold(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldEqualFold(FieldPlaidTransferID, v))
+// ExternalTransferIDEqualFold applies the EqualFold predicate on the "external_transfer_id" field.
+func ExternalTransferIDEqualFold(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldEqualFold(FieldExternalTransferID, v))
 }
 
-// PlaidTransferIDContainsFold applies the ContainsFold predicate on the "plaid_transfer_id" field.
-func PlaidTransferIDContainsFold(v string) predicate.SavingsTransfer {
-	return predicate.SavingsTransfer(sql.FieldContainsFold(FieldPlaidTransferID, v))
+// ExternalTransferIDContainsFold applies the ContainsFold predicate on the "external_transfer_id" field.
+func ExternalTransferIDContainsFold(v string) predicate.SavingsTransfer {
+	return predicate.SavingsTransfer(sql.FieldContainsFold(FieldExternalTransferID, v))
 }
 
 // ErrorMessageEQ applies the EQ predicate on the "error_message" field.
@@ -57,6 +57,20 @@ func (_c *SavingsTransferCreate) SetAmountCents(v int64) *SavingsTransferCreate
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *SavingsTransferCreate) SetCurrency(v string) *SavingsTransferCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SavingsTransferCreate) SetNillableCurrency(v *string) *SavingsTransferCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *SavingsTransferCreate) SetStatus(v savingstransfer.Status) *SavingsTransferCreate {
 	_c.mutation.SetStatus(v)
@@ -71,16 +85,16 @@ func (_c *SavingsTransferCreate) SetNillableStatus(v *savingstransfer.Status) *S
 	return _c
 }
 
-// SetPlaidTransferID sets the "plaid_transfer_id" field.
-func (_c *SavingsTransferCreate) SetPlaidTransferID(v string) *SavingsTransferCreate {
-	_c.mutation.SetPlaidTransferID(v)
+// SetExternalTransferID sets the "external_transfer_id" field.
+func (_c *SavingsTransferCreate) SetExternalTransferID(v string) *SavingsTransferCreate {
+	_c.mutation.SetExternalTransferID(v)
 	return _c
 }
 
-// SetNillablePlaidTransferID sets the "plaid_transfer_id" field if the given value is not nil.
-func (_c *SavingsTransferCreate) SetNillablePlaidTransferID(v *string) *SavingsTransferCreate {
+// SetNillableExternalTransferID sets the "external_transfer_id" field if the given value is not nil.
+func (_c *SavingsTransferCreate) SetNillableExternalTransferID(v *string) *SavingsTransferCreate {
 	if v != nil {
-		_c.SetPlaidTransferID(*v)
+		_c.SetExternalTransferID(*v)
 	}
 	return _c
 }
@@ -196,6 +210,10 @@ func (_c *SavingsTransferCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *SavingsTransferCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := savingstransfer.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		v := savingstransfer.DefaultStatus
 		_c.mutation.SetStatus(v)
@@ -227,6 +245,9 @@ func (_c *SavingsTransferCreate) check() error {
 	if _, ok := _c.mutation.AmountCents(); !ok {
 		return &ValidationError{Name: "amount_cents", err: errors.New(`ent: missing required field "SavingsTransfer.amount_cents"`)}
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "SavingsTransfer.currency"`)}
+	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "SavingsTransfer.status"`)}
 	}
@@ -290,13 +311,17 @@ func (_c *SavingsTransferCreate) createSpec() (*SavingsTransfer, *sqlgraph.Creat
 		_spec.SetField(savingstransfer.FieldAmountCents, field.TypeInt64, value)
 		_node.AmountCents = value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(savingstransfer.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(savingstransfer.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
-	if value, ok := _c.mutation.PlaidTransferID(); ok {
-		_spec.SetField(savingstransfer.FieldPlaidTransferID, field.TypeString, value)
-		_node.PlaidTransferID = value
+	if value, ok := _c.mutation.ExternalTransferID(); ok {
+		_spec.SetField(savingstransfer.FieldExternalTransferID, field.TypeString, value)
+		_node.ExternalTransferID = value
 	}
 	if value, ok := _c.mutation.ErrorMessage(); ok {
 		_spec.SetField(savingstransfer.FieldErrorMessage, field.TypeString, value)
@@ -496,6 +521,18 @@ func (u *SavingsTransferUpsert) AddAmountCents(v int64) *SavingsTransferUpsert {
 	return u
 }
 
+// SetCurrency sets the "currency" field.
+func (u *SavingsTransferUpsert) SetCurrency(v string) *SavingsTransferUpsert {
+	u.Set(savingstransfer.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SavingsTransferUpsert) UpdateCurrency() *SavingsTransferUpsert {
+	u.SetExcluded(savingstransfer.FieldCurrency)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *SavingsTransferUpsert) SetStatus(v savingstransfer.Status) *SavingsTransferUpsert {
 	u.Set(savingstransfer.FieldStatus, v)
@@ -508,21 +545,21 @@ func (u *SavingsTransferUpsert) UpdateStatus() *SavingsTransferUpsert {
 	return u
 }
 
-// SetPlaidTransferID sets the "plaid_transfer_id" field.
-func (u *SavingsTransferUpsert) SetPlaidTransferID(v string) *SavingsTransferUpsert {
-	u.Set(savingstransfer.FieldPlaidTransferID, v)
+// SetExternalTransferID sets the "external_transfer_id" field.
+func (u *SavingsTransferUpsert) SetExternalTransferID(v string) *SavingsTransferUpsert {
+	u.Set(savingstransfer.FieldExternalTransferID, v)
 	return u
 }
 
-// UpdatePlaidTransferID sets the "plaid_transfer_id" field to the value that was provided on create.
-func (u *SavingsTransferUpsert) UpdatePlaidTransferID() *SavingsTransferUpsert {
-	u.SetExcluded(savingstransfer.FieldPlaidTransferID)
+// UpdateExternalTransferID sets the "external_transfer_id" field to the value that was provided on create.
+func (u *SavingsTransferUpsert) UpdateExternalTransferID() *SavingsTransferUpsert {
+	u.SetExcluded(savingstransfer.FieldExternalTransferID)
 	return u
 }
 
-// ClearPlaidTransferID clears the value of the "plaid_transfer_id" field.
-func (u *SavingsTransferUpsert) ClearPlaidTransferID() *SavingsTransferUpsert {
-	u.SetNull(savingstransfer.FieldPlaidTransferID)
+// ClearExternalTransferID clears the value of the "external_transfer_id" field.
+func (u *SavingsTransferUpsert) ClearExternalTransferID() *SavingsTransferUpsert {
+	u.SetNull(savingstransfer.FieldExternalTransferID)
 	return u
 }
 
@@ -690,6 +727,20 @@ func (u *SavingsTransferUpsertOne) UpdateAmountCents() *SavingsTransferUpsertOne
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *SavingsTransferUpsertOne) SetCurrency(v string) *SavingsTransferUpsertOne {
+	return u.Update(func(s *SavingsTransferUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SavingsTransferUpsertOne) UpdateCurrency() *SavingsTransferUpsertOne {
+	return u.Update(func(s *SavingsTransferUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *SavingsTransferUpsertOne) SetStatus(v savingstransfer.Status) *SavingsTransferUpsertOne {
 	return u.Update(func(s *SavingsTransferUpsert) {
@@ -704,24 +755,24 @@ func (u *SavingsTransferUpsertOne) UpdateStatus() *SavingsTransferUpsertOne {
 	})
 }
 
-// SetPlaidTransferID sets the "plaid_transfer_id" field.
-func (u *SavingsTransferUpsertOne) SetPlaidTransferID(v string) *SavingsTransferUpsertOne {
+// SetExternalTransferID sets the "external_transfer_id" field.
+func (u *SavingsTransferUpsertOne) SetExternalTransferID(v string) *SavingsTransferUpsertOne {
 	return u.Update(func(s *SavingsTransferUpsert) {
-		s.SetPlaidTransferID(v)
+		s.SetExternalTransferID(v)
 	})
 }
 
-// UpdatePlaidTransferID sets the "plaid_transfer_id" field to the value that was provided on create.
-func (u *SavingsTransferUpsertOne) UpdatePlaidTransferID() *SavingsTransferUpsertOne {
+// UpdateExternalTransferID sets the "external_transfer_id" field to the value that was provided on create.
+func (u *SavingsTransferUpsertOne) UpdateExternalTransferID() *SavingsTransferUpsertOne {
 	return u.Update(func(s *SavingsTransferUpsert) {
-		s.UpdatePlaidTransferID()
+		s.UpdateExternalTransferID()
 	})
 }
 
-// ClearPlaidTransferID clears the value of the "plaid_transfer_id" field.
-func (u *SavingsTransferUpsertOne) ClearPlaidTransferID() *SavingsTransferUpsertOne {
+// ClearExternalTransferID clears the value of the "external_transfer_id" field.
+func (u *SavingsTransferUpsertOne) ClearExternalTransferID() *SavingsTransferUpsertOne {
 	return u.Update(func(s *SavingsTransferUpsert) {
-		s.ClearPlaidTransferID()
+		s.ClearExternalTransferID()
 	})
 }
 
@@ -1062,6 +1113,20 @@ func (u *SavingsTransferUpsertBulk) UpdateAmountCents() *SavingsTransferUpsertBu
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *SavingsTransferUpsertBulk) SetCurrency(v string) *SavingsTransferUpsertBulk {
+	return u.Update(func(s *SavingsTransferUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *SavingsTransferUpsertBulk) UpdateCurrency() *SavingsTransferUpsertBulk {
+	return u.Update(func(s *SavingsTransferUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *SavingsTransferUpsertBulk) SetStatus(v savingstransfer.Status) *SavingsTransferUpsertBulk {
 	return u.Update(func(s *SavingsTransferUpsert) {
@@ -1076,24 +1141,24 @@ func (u *SavingsTransferUpsertBulk) UpdateStatus() *SavingsTransferUpsertBulk {
 	})
 }
 
-// SetPlaidTransferID sets the "plaid_transfer_id" field.
-func (u *SavingsTransferUpsertBulk) SetPlaidTransferID(v string) *SavingsTransferUpsertBulk {
+// SetExternalTransferID sets the "external_transfer_id" field.
+func (u *SavingsTransferUpsertBulk) SetExternalTransferID(v string) *SavingsTransferUpsertBulk {
 	return u.Update(func(s *SavingsTransferUpsert) {
-		s.SetPlaidTransferID(v)
+		s.SetExternalTransferID(v)
 	})
 }
 
-// UpdatePlaidTransferID sets the "plaid_transfer_id" field to the value that was provided on create.
-func (u *SavingsTransferUpsertBulk) UpdatePlaidTransferID() *SavingsTransferUpsertBulk {
+// UpdateExternalTransferID sets the "external_transfer_id" field to the value that was provided on create.
+func (u *SavingsTransferUpsertBulk) UpdateExternalTransferID() *SavingsTransferUpsertBulk {
 	return u.Update(func(s *SavingsTransferUpsert) {
-		s.UpdatePlaidTransferID()
+		s.UpdateExternalTransferID()
 	})
 }
 
-// ClearPlaidTransferID clears the value of the "plaid_transfer_id" field.
-func (u *SavingsTransferUpsertBulk) ClearPlaidTransferID() *SavingsTransferUpsertBulk {
+// ClearExternalTransferID clears the value of the "external_transfer_id" field.
+func (u *SavingsTransferUpsertBulk) ClearExternalTransferID() *SavingsTransferUpsertBulk {
 	return u.Update(func(s *SavingsTransferUpsert) {
-		s.ClearPlaidTransferID()
+		s.ClearExternalTransferID()
 	})
 }
 
@@ -110,6 +110,20 @@ func (_u *SavingsTransferUpdate) AddAmountCents(v int64) *SavingsTransferUpdate
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *SavingsTransferUpdate) SetCurrency(v string) *SavingsTransferUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SavingsTransferUpdate) SetNillableCurrency(v *string) *SavingsTransferUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *SavingsTransferUpdate) SetStatus(v savingstransfer.Status) *SavingsTransferUpdate {
 	_u.mutation.SetStatus(v)
@@ -124,23 +138,23 @@ func (_u *SavingsTransferUpdate) SetNillableStatus(v *savingstransfer.Status) *S
 	return _u
 }
 
-// SetPlaidTransferID sets the "plaid_transfer_id" field.
-func (_u *SavingsTransferUpdate) SetPlaidTransferID(v string) *SavingsTransferUpdate {
-	_u.mutation.SetPlaidTransferID(v)
+// SetExternalTransferID sets the "external_transfer_id" field.
+func (_u *SavingsTransferUpdate) SetExternalTransferID(v string) *SavingsTransferUpdate {
+	_u.mutation.SetExternalTransferID(v)
 	return _u
 }
 
-// SetNillablePlaidTransferID sets the "plaid_transfer_id" field if the given value is not nil.
-func (_u *SavingsTransferUpdate) SetNillablePlaidTransferID(v *string) *SavingsTransferUpdate {
+// SetNillableExternalTransferID sets the "external_transfer_id" field if the given value is not nil.
+func (_u *SavingsTransferUpdate) SetNillableExternalTransferID(v *string) *SavingsTransferUpdate {
 	if v != nil {
-		_u.SetPlaidTransferID(*v)
+		_u.SetExternalTransferID(*v)
 	}
 	return _u
 }
 
-// ClearPlaidTransferID clears the value of the "plaid_transfer_id" field.
-func (_u *SavingsTransferUpdate) ClearPlaidTransferID() *SavingsTransferUpdate {
-	_u.mutation.ClearPlaidTransferID()
+// ClearExternalTransferID clears the value of the "external_transfer_id" field.
+func (_u *SavingsTransferUpdate) ClearExternalTransferID() *SavingsTransferUpdate {
+	_u.mutation.ClearExternalTransferID()
 	return _u
 }
 
@@ -306,14 +320,17 @@ func (_u *SavingsTransferUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.AddedAmountCents(); ok {
 		_spec.AddField(savingstransfer.FieldAmountCents, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(savingstransfer.FieldCurrency, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(savingstransfer.FieldStatus, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.PlaidTransferID(); ok {
-		_spec.SetField(savingstransfer.FieldPlaidTransferID, field.TypeString, value)
+	if value, ok := _u.mutation.ExternalTransferID(); ok {
+		_spec.SetField(savingstransfer.FieldExternalTransferID, field.TypeString, value)
 	}
-	if _u.mutation.PlaidTransferIDCleared() {
-		_spec.ClearField(savingstransfer.FieldPlaidTransferID, field.TypeString)
+	if _u.mutation.ExternalTransferIDCleared() {
+		_spec.ClearField(savingstransfer.FieldExternalTransferID, field.TypeString)
 	}
 	if value, ok := _u.mutation.ErrorMessage(); ok {
 		_spec.SetField(savingstransfer.FieldErrorMessage, field.TypeString, value)
@@ -542,6 +559,20 @@ func (_u *SavingsTransferUpdateOne) AddAmountCents(v int64) *SavingsTransferUpda
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *SavingsTransferUpdateOne) SetCurrency(v string) *SavingsTransferUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SavingsTransferUpdateOne) SetNillableCurrency(v *string) *SavingsTransferUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *SavingsTransferUpdateOne) SetStatus(v savingstransfer.Status) *SavingsTransferUpdateOne {
 	_u.mutation.SetStatus(v)
@@ -556,23 +587,23 @@ func (_u *SavingsTransferUpdateOne) SetNillableStatus(v *savingstransfer.Status)
 	return _u
 }
 
-// SetPlaidTransferID sets the "plaid_transfer_id" field.
-func (_u *SavingsTransferUpdateOne) SetPlaidTransferID(v string) *SavingsTransferUpdateOne {
-	_u.mutation.SetPlaidTransferID(v)
+// SetExternalTransferID sets the "external_transfer_id" field.
+func (_u *SavingsTransferUpdateOne) SetExternalTransferID(v string) *SavingsTransferUpdateOne {
+	_u.mutation.SetExternalTransferID(v)
 	return _u
 }
 
-// SetNillablePlaidTransferID sets the "plaid_transfer_id" field if the given value is not nil.
-func (_u *SavingsTransferUpdateOne) SetNillablePlaidTransferID(v *string) *SavingsTransferUpdateOne {
+// SetNillableExternalTransferID sets the "external_transfer_id" field if the given value is not nil.
+func (_u *SavingsTransferUpdateOne) SetNillableExternalTransferID(v *string) *SavingsTransferUpdateOne {
 	if v != nil {
-		_u.SetPlaidTransferID(*v)
+		_u.SetExternalTransferID(*v)
 	}
 	return _u
 }
 
-// ClearPlaidTransferID clears the value of the "plaid_transfer_id" field.
-func (_u *SavingsTransferUpdateOne) ClearPlaidTransferID() *SavingsTransferUpdateOne {
-	_u.mutation.ClearPlaidTransferID()
+// ClearExternalTransferID clears the value of the "external_transfer_id" field.
+func (_u *SavingsTransferUpdateOne) ClearExternalTransferID() *SavingsTransferUpdateOne {
+	_u.mutation.ClearExternalTransferID()
 	return _u
 }
 
@@ -768,14 +799,17 @@ func (_u *SavingsTransferUpdateOne) sqlSave(ctx context.Context) (_node *Savings
 	if value, ok := _u.mutation.AddedAmountCents(); ok {
 		_spec.AddField(savingstransfer.FieldAmountCents, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(savingstransfer.FieldCurrency, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(savingstransfer.FieldStatus, field.TypeEnum, value)
 	}
-	if value, ok := _u.mutation.PlaidTransferID(); ok {
-		_spec.SetField(savingstransfer.FieldPlaidTransferID, field.TypeString, value)
+	if value, ok := _u.mutation.ExternalTransferID(); ok {
+		_spec.SetField(savingstransfer.FieldExternalTransferID, field.TypeString, value)
 	}
-	if _u.mutation.PlaidTransferIDCleared() {
-		_spec.ClearField(savingstransfer.FieldPlaidTransferID, field.TypeString)
+	if _u.mutation.ExternalTransferIDCleared() {
+		_spec.ClearField(savingstransfer.FieldExternalTransferID, field.TypeString)
 	}
 	if value, ok := _u.mutation.ErrorMessage(); ok {
 		_spec.SetField(savingstransfer.FieldErrorMessage, field.TypeString, value)
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -11,7 +12,7 @@ import (
 )
 
 // Account holds the schema definition for the Account entity.
-// Represents an individual bank account linked through Plaid.
+// Represents an individual bank account linked through an aggregator such as Plaid, or filled by statement imports.
 type Account struct {
 	ent.Schema
 }
@@ -23,13 +24,14 @@ func (Account) Fields() []ent.Field {
 			Default(uuid.New).
 			Immutable(),
 		field.UUID("item_id", uuid.UUID{}).
-			Comment("FK to Item"),
+			Optional().
+			Nillable().
+			Comment("FK to Item, nil for manual accounts filled by statement imports"),
 		field.UUID("user_id", uuid.UUID{}).
 			Comment("FK to User for quick queries"),
-		field.String("plaid_id").
+		field.String("external_id").
 			NotEmpty().
-			Unique().
-			Comment("Plaid's unique identifier for this account"),
+			Comment("Aggregator's identifier for this account, unique per item; manual-<uuid> for manual accounts"),
 		field.String("name").
 			NotEmpty().
 			Comment("User-friendly account name from bank"),
@@ -39,7 +41,7 @@ func (Account) Fields() []ent.Field {
 			Comment("Type of account"),
 		field.String("subtype").
 			Optional().
-			Comment("Detailed account subtype from Plaid"),
+			Comment("Detailed account subtype from the aggregator"),
 		field.String("mask").
 			Optional().
 			Comment("Last 4 digits of account number"),
@@ -50,9 +52,28 @@ func (Account) Fields() []ent.Field {
 			Optional().
 			Nillable().
 			Comment("Available balance in cents (may be nil)"),
+		field.String("currency").
+			Default("USD").
+			Comment("ISO 4217 currency of the balances"),
+		field.Int64("credit_limit").
+			Optional().
+			Nillable().
+			Comment("Credit limit in cents for credit accounts, if the institution reports one"),
+		field.UUID("funding_account_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Checking account savings are taken from when rules fire on this credit account's purchases"),
 		field.Bool("is_active").
 			Default(true).
 			Comment("Whether this account is still active"),
+		field.Time("closed_at").
+			Optional().
+			Nillable().
+			Comment("When the account stopped appearing at the institution, reactivated if it reappears"),
+		field.Time("balance_date").
+			Optional().
+			Nillable().
+			Comment("Day of the statement balance last applied to a manual account, so older statements don't overwrite it"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -68,7 +89,6 @@ func (Account) Edges() []ent.Edge {
 		edge.From("item", Item.Type).
 			Ref("accounts").
 			Field("item_id").
-			Required().
 			Unique(),
 		edge.From("user", User.Type).
 			Ref("accounts").
@@ -79,6 +99,13 @@ func (Account) Edges() []ent.Edge {
 		edge.To("target_rules", Rule.Type),
 		edge.To("outgoing_transfers", SavingsTransfer.Type),
 		edge.To("incoming_transfers", SavingsTransfer.Type),
+		edge.To("savings_families", Family.Type),
+		edge.To("balance_snapshots", BalanceSnapshot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("funded_accounts", Account.Type).
+			From("funding_account").
+			Field("funding_account_id").
+			Unique(),
 	}
 }
 
@@ -86,7 +113,6 @@ func (Account) Edges() []ent.Edge {
 func (Account) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "is_active"),
-		index.Fields("plaid_id").Unique(),
-		index.Fields("item_id"),
+		index.Fields("item_id", "external_id").Unique(),
 	}
 }
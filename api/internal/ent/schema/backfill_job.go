@@ -0,0 +1,96 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent/schema/schematype"
+)
+
+// BackfillJob holds the schema definition for the BackfillJob entity.
+// Tracks the historical sync of a newly linked Item and the starter-rule analysis that follows it.
+type BackfillJob struct {
+	ent.Schema
+}
+
+// Fields of the BackfillJob.
+func (BackfillJob) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("item_id", uuid.UUID{}).
+			Immutable().
+			Comment("FK to Item"),
+		field.Enum("status").
+			Values("queued", "syncing", "analyzing", "completed", "failed").
+			Default("queued").
+			Comment("Progress of the job; completed once history is synced and analyzed"),
+		field.Int("days_requested").
+			Positive().
+			Immutable().
+			Comment("Days of history requested from the aggregator"),
+		field.Enum("update_status").
+			Values("not_ready", "initial_update_complete", "historical_update_complete").
+			Default("not_ready").
+			Comment("How much history the aggregator has pulled: nothing yet, the last 30 days, or all of it"),
+		field.Int("sync_count").
+			Default(0).
+			Comment("Number of syncs the job has run"),
+		field.Int("transactions_synced").
+			Default(0).
+			Comment("Number of transactions added by the job's syncs"),
+		field.Time("oldest_transaction_date").
+			Optional().
+			Nillable().
+			Comment("Date of the item's oldest transaction so far, how far back the history reaches"),
+		field.JSON("starter_rules", []schematype.StarterRule{}).
+			Optional().
+			Comment("Savings rules suggested from the synced history"),
+		field.Text("starter_analysis").
+			Optional().
+			Comment("Overall analysis accompanying the starter rules"),
+		field.String("analysis_error").
+			Optional().
+			Nillable().
+			Comment("Why no starter rules were suggested; the job still completes"),
+		field.String("error").
+			Optional().
+			Nillable().
+			Comment("Error details if status is failed"),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Comment("Touched by every step, so jobs abandoned by a stopped process can be resumed"),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the BackfillJob.
+func (BackfillJob) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("backfill_job").
+			Field("item_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Indexes of the BackfillJob.
+func (BackfillJob) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "updated_at"),
+	}
+}
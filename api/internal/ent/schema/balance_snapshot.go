@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BalanceSnapshot holds the schema definition for the BalanceSnapshot entity.
+// Records an account's balance once per day so balance and net worth history can be charted.
+type BalanceSnapshot struct {
+	ent.Schema
+}
+
+// Fields of the BalanceSnapshot.
+func (BalanceSnapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("account_id", uuid.UUID{}).
+			Comment("FK to Account"),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("FK to User (denormalized for quick queries)"),
+		field.Time("date").
+			Comment("Day of the snapshot (UTC midnight); later syncs that day overwrite it"),
+		field.Int64("current_balance").
+			Comment("Current balance in cents"),
+		field.Int64("available_balance").
+			Optional().
+			Nillable().
+			Comment("Available balance in cents (may be nil)"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the BalanceSnapshot.
+func (BalanceSnapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", Account.Type).
+			Ref("balance_snapshots").
+			Field("account_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the BalanceSnapshot.
+func (BalanceSnapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "date").Unique(),
+		index.Fields("user_id", "date"),
+	}
+}
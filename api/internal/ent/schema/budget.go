@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Budget holds the schema definition for the Budget entity.
+// A monthly spending budget for a category, owned by a user or shared by a family.
+type Budget struct {
+	ent.Schema
+}
+
+// Fields of the Budget.
+func (Budget) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("FK to User who created the budget"),
+		field.UUID("family_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK to Family for family budgets tracked over all members (nil for personal budgets)"),
+		field.Enum("category").
+			Values(
+				"Dining",
+				"Groceries",
+				"Transport",
+				"Shopping",
+				"Subscriptions",
+				"Entertainment",
+				"Bills",
+				"Misc",
+			).
+			Comment("Transaction category the budget applies to"),
+		field.Int64("amount_cents").
+			Positive().
+			Comment("Monthly budget amount in cents"),
+		field.JSON("alert_thresholds", []int{}).
+			Default([]int{80, 100}).
+			Comment("Percentages of the budget at which an alert is sent"),
+		field.Bool("is_active").
+			Default(true).
+			Comment("Whether the budget is tracked and alerts are sent"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Budget.
+func (Budget) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("budgets").
+			Field("user_id").
+			Required().
+			Unique(),
+		edge.From("family", Family.Type).
+			Ref("budgets").
+			Field("family_id").
+			Unique(),
+		edge.To("alerts", BudgetAlert.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("rules", Rule.Type),
+	}
+}
+
+// Indexes of the Budget.
+func (Budget) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "category"),
+		index.Fields("family_id", "category"),
+	}
+}
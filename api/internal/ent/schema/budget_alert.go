@@ -0,0 +1,56 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// BudgetAlert holds the schema definition for the BudgetAlert entity.
+// Records that a budget threshold alert was sent so it fires once per month.
+type BudgetAlert struct {
+	ent.Schema
+}
+
+// Fields of the BudgetAlert.
+func (BudgetAlert) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("budget_id", uuid.UUID{}).
+			Comment("FK to Budget"),
+		field.String("month").
+			NotEmpty().
+			Comment("Budget month in YYYY-MM format"),
+		field.Int("threshold").
+			Comment("Threshold percentage that was crossed"),
+		field.Int64("spent_cents").
+			Comment("Month-to-date spend when the alert was sent"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the BudgetAlert.
+func (BudgetAlert) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("budget", Budget.Type).
+			Ref("alerts").
+			Field("budget_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the BudgetAlert.
+func (BudgetAlert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("budget_id", "month", "threshold").Unique(),
+	}
+}
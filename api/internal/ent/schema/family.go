@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// Family holds the schema definition for the Family entity.
+// Groups users that share summaries, goals and family-level rules.
+type Family struct {
+	ent.Schema
+}
+
+// Fields of the Family.
+func (Family) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("name").
+			NotEmpty().
+			Comment("Display name of the family"),
+		field.UUID("savings_account_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK to the shared Family Savings Account"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Family.
+func (Family) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("savings_account", Account.Type).
+			Ref("savings_families").
+			Field("savings_account_id").
+			Unique(),
+		edge.To("members", FamilyMember.Type),
+		edge.To("rules", Rule.Type),
+		edge.To("rule_executions", RuleExecution.Type),
+		edge.To("goals", Goal.Type),
+		edge.To("budgets", Budget.Type),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// FamilyMember holds the schema definition for the FamilyMember entity.
+// Links a user to a family with a role that controls what they can manage.
+type FamilyMember struct {
+	ent.Schema
+}
+
+// Fields of the FamilyMember.
+func (FamilyMember) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("family_id", uuid.UUID{}).
+			Comment("FK to Family"),
+		field.UUID("user_id", uuid.UUID{}).
+			Unique().
+			Comment("FK to User (a user belongs to at most one family)"),
+		field.Enum("role").
+			Values("owner", "parent", "member", "teen").
+			Default("member").
+			Comment("Role within the family: owners and parents manage family rules"),
+		field.Enum("transaction_visibility").
+			Values("totals", "itemized").
+			Default("totals").
+			Comment("Whether other members see this member's individual transactions or only totals"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the FamilyMember.
+func (FamilyMember) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("family", Family.Type).
+			Ref("members").
+			Field("family_id").
+			Required().
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("user", User.Type).
+			Ref("family_membership").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the FamilyMember.
+func (FamilyMember) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+		index.Fields("user_id").Unique(),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Goal holds the schema definition for the Goal entity.
+// Represents a personal or shared family savings target or spending limit.
+type Goal struct {
+	ent.Schema
+}
+
+// Fields of the Goal.
+func (Goal) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("FK to User who created the goal"),
+		field.UUID("family_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK to Family for shared family goals (nil for personal goals)"),
+		field.String("name").
+			NotEmpty().
+			Comment("Goal name (e.g., 'Summer vacation')"),
+		field.Enum("kind").
+			Values("savings", "spending_limit").
+			Default("savings").
+			Comment("savings: auto-saved amount towards target, spending_limit: monthly spend cap"),
+		field.String("category").
+			Optional().
+			Nillable().
+			Comment("Spending category for spending_limit goals (nil for all categories)"),
+		field.Int64("target_cents").
+			Positive().
+			Comment("Target amount in cents"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Goal.
+func (Goal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("goals").
+			Field("user_id").
+			Required().
+			Unique(),
+		edge.From("family", Family.Type).
+			Ref("goals").
+			Field("family_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Goal.
+func (Goal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("family_id"),
+	}
+}
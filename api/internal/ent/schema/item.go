@@ -1,17 +1,22 @@
 package schema
 
 import (
+	"context"
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
+
+	"regulation/internal/ent/schema/schematype"
+	"regulation/internal/envelope"
 )
 
 // Item holds the schema definition for the Item entity.
-// Represents a connection to a financial institution via Plaid.
+// Represents a connection to a financial institution via an aggregator such as Plaid.
 type Item struct {
 	ent.Schema
 }
@@ -24,20 +29,44 @@ func (Item) Fields() []ent.Field {
 			Immutable(),
 		field.UUID("user_id", uuid.UUID{}).
 			Comment("FK to User who owns this connection"),
-		field.String("plaid_id").
+		field.String("provider").
 			NotEmpty().
-			Unique().
-			Comment("Plaid's unique identifier for this item"),
+			Default("plaid").
+			Comment("Aggregator the item is linked through, such as plaid"),
+		field.String("external_id").
+			NotEmpty().
+			Comment("Aggregator's unique identifier for this item"),
 		field.String("access_token").
 			NotEmpty().
 			Sensitive().
-			Comment("Encrypted Plaid access token for API calls"),
+			ValueScanner(schematype.EncryptedString).
+			Comment("Envelope-encrypted aggregator access token for API calls"),
+		field.String("access_token_key_id").
+			Default("").
+			Comment("Key-encryption key the access token is sealed with, empty for legacy plaintext"),
 		field.String("institution_name").
 			NotEmpty().
 			Comment("Name of the financial institution"),
+		field.String("institution_id").
+			Optional().
+			Nillable().
+			Comment("Aggregator's institution ID; nil until looked up, empty if the aggregator does not know it"),
+		field.Text("institution_logo").
+			Optional().
+			Comment("Base64-encoded PNG logo of the institution"),
+		field.String("institution_color").
+			Optional().
+			Comment("Primary brand color of the institution as a hex code"),
+		field.String("institution_url").
+			Optional().
+			Comment("Homepage of the institution"),
 		field.Bool("is_active").
 			Default(true).
 			Comment("Whether this connection is still active"),
+		field.Enum("status").
+			Values("healthy", "login_required", "institution_down", "rate_limited", "error").
+			Default("healthy").
+			Comment("Sync health; login_required and error items are parked until a sync succeeds again"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -55,9 +84,40 @@ func (Item) Edges() []ent.Edge {
 			Field("user_id").
 			Required().
 			Unique(),
-		edge.To("accounts", Account.Type),
+		edge.To("accounts", Account.Type).
+			Annotations(entsql.OnDelete(entsql.NoAction)),
 		edge.To("sync_cursor", SyncCursor.Type).
 			Unique(),
+		edge.To("sync_runs", SyncRun.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("backfill_job", BackfillJob.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Hooks of the Item.
+func (Item) Hooks() []ent.Hook {
+	return []ent.Hook{
+		// Record the key the access token is encrypted with so rotation can find stale rows
+		func(next ent.Mutator) ent.Mutator {
+			return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+				if _, ok := m.Field("access_token"); !ok {
+					return next.Mutate(ctx, m)
+				}
+
+				c, err := envelope.Default()
+				if err != nil {
+					return nil, err
+				}
+
+				if err := m.SetField("access_token_key_id", c.ActiveKeyID()); err != nil {
+					return nil, err
+				}
+
+				return next.Mutate(ctx, m)
+			})
+		},
 	}
 }
 
@@ -65,6 +125,8 @@ func (Item) Edges() []ent.Edge {
 func (Item) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "is_active"),
-		index.Fields("plaid_id").Unique(),
+		index.Fields("status"),
+		index.Fields("access_token_key_id"),
+		index.Fields("provider", "external_id").Unique(),
 	}
 }
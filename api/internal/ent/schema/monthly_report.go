@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent/schema/schematype"
+)
+
+// MonthlyReport holds the schema definition for the MonthlyReport entity.
+// Archived month-end summary generated for each user on the first of the following month.
+type MonthlyReport struct {
+	ent.Schema
+}
+
+// Fields of the MonthlyReport.
+func (MonthlyReport) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("FK to User"),
+		field.String("month").
+			NotEmpty().
+			Immutable().
+			Comment("Reported month in YYYY-MM format"),
+
+		// Totals
+		field.Int64("income_cents").
+			Default(0).
+			Comment("Settled income in cents"),
+		field.Int64("spend_cents").
+			Default(0).
+			Comment("Settled spend in cents"),
+		field.Int64("savings_cents").
+			Default(0).
+			Comment("Auto-saved amount in cents"),
+		field.Int64("net_cents").
+			Default(0).
+			Comment("Income minus spend in cents"),
+		field.String("currency").
+			Default("USD").
+			Comment("ISO 4217 currency the totals are reported in"),
+
+		// Breakdowns
+		field.JSON("top_rules", []schematype.ReportRule{}).
+			Optional().
+			Comment("Most active rules of the month"),
+		field.JSON("goals", []schematype.ReportGoal{}).
+			Optional().
+			Comment("Goal completion at the end of the month"),
+		field.JSON("category_changes", []schematype.ReportCategoryChange{}).
+			Optional().
+			Comment("Spend per category compared with the previous month"),
+
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the MonthlyReport.
+func (MonthlyReport) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("monthly_reports").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the MonthlyReport.
+func (MonthlyReport) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "month").Unique(),
+	}
+}
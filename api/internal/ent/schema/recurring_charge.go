@@ -0,0 +1,83 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// RecurringCharge holds the schema definition for the RecurringCharge entity.
+// A subscription or other repeating charge detected from a user's transactions.
+type RecurringCharge struct {
+	ent.Schema
+}
+
+// Fields of the RecurringCharge.
+func (RecurringCharge) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Comment("FK to User"),
+		field.String("merchant_key").
+			NotEmpty().
+			Comment("Normalized merchant name used to group transactions"),
+		field.String("merchant_name").
+			NotEmpty().
+			Comment("Display name from the most recent charge"),
+		field.String("category").
+			Default("Misc").
+			Comment("App category of the most recent charge"),
+		field.Enum("cadence").
+			Values("weekly", "monthly", "annual").
+			Comment("Inferred charge interval"),
+		field.Int64("amount_cents").
+			Comment("Amount of the most recent charge in cents"),
+		field.Int64("previous_amount_cents").
+			Optional().
+			Nillable().
+			Comment("Amount before the most recent price change in cents"),
+		field.Bool("price_increased").
+			Default(false).
+			Comment("Whether the most recent charge is higher than the one before"),
+		field.Int("occurrences").
+			Comment("Number of matching charges found"),
+		field.Time("last_charge_date").
+			Comment("Date of the most recent charge"),
+		field.Time("next_charge_date").
+			Comment("Predicted date of the next charge"),
+		field.Bool("is_active").
+			Default(true).
+			Comment("False once an expected charge has not appeared"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RecurringCharge.
+func (RecurringCharge) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("recurring_charges").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the RecurringCharge.
+func (RecurringCharge) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "merchant_key", "cadence").Unique(),
+		index.Fields("user_id", "is_active"),
+	}
+}
@@ -24,6 +24,10 @@ func (Rule) Fields() []ent.Field {
 			Immutable(),
 		field.UUID("user_id", uuid.UUID{}).
 			Comment("FK to User who owns this rule"),
+		field.UUID("family_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK to Family for family-level rules evaluated over all members"),
 		field.String("name").
 			NotEmpty().
 			Comment("User-friendly rule name (e.g., 'Dining 2x Save')"),
@@ -52,6 +56,16 @@ func (Rule) Fields() []ent.Field {
 			Nillable().
 			Comment("Maximum transaction amount to trigger (cents)"),
 
+		// Condition: Monthly total threshold (optional)
+		field.Int64("monthly_threshold_cents").
+			Optional().
+			Nillable().
+			Comment("Month-to-date category spend that must be exceeded before the rule applies (cents)"),
+		field.UUID("budget_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK to Budget whose amount is used as the monthly threshold"),
+
 		// Action: What to do when rule matches
 		field.Enum("action_type").
 			Values("multiply", "fixed").
@@ -101,6 +115,14 @@ func (Rule) Edges() []ent.Edge {
 			Field("target_account_id").
 			Required().
 			Unique(),
+		edge.From("family", Family.Type).
+			Ref("rules").
+			Field("family_id").
+			Unique(),
+		edge.From("budget", Budget.Type).
+			Ref("rules").
+			Field("budget_id").
+			Unique(),
 		edge.To("executions", RuleExecution.Type),
 	}
 }
@@ -109,6 +131,7 @@ func (Rule) Edges() []ent.Edge {
 func (Rule) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "is_active"),
+		index.Fields("family_id", "is_active"),
 		index.Fields("category"),
 		index.Fields("priority"),
 	}
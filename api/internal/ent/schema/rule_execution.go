@@ -28,12 +28,16 @@ func (RuleExecution) Fields() []ent.Field {
 			Comment("FK to Transaction that triggered the rule"),
 		field.UUID("user_id", uuid.UUID{}).
 			Comment("FK to User (denormalized for quick queries)"),
+		field.UUID("family_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("FK to Family when triggered by a family rule"),
 
 		// Transfer details
 		field.Int64("amount_cents").
 			Comment("Calculated savings amount in cents"),
 		field.UUID("source_account_id", uuid.UUID{}).
-			Comment("FK to Account where transaction occurred"),
+			Comment("FK to Account savings are taken from: where the transaction occurred, or a credit card's funding account"),
 		field.UUID("target_account_id", uuid.UUID{}).
 			Comment("FK to Account where savings should go"),
 
@@ -74,6 +78,10 @@ func (RuleExecution) Edges() []ent.Edge {
 			Field("user_id").
 			Required().
 			Unique(),
+		edge.From("family", Family.Type).
+			Ref("rule_executions").
+			Field("family_id").
+			Unique(),
 		edge.To("transfer", SavingsTransfer.Type).
 			Unique(),
 	}
@@ -85,6 +93,7 @@ func (RuleExecution) Indexes() []ent.Index {
 		// Idempotency: prevent duplicate rule executions for same transaction
 		index.Fields("rule_id", "transaction_id").Unique(),
 		index.Fields("user_id", "created_at"),
+		index.Fields("family_id", "created_at"),
 		index.Fields("status"),
 	}
 }
@@ -34,15 +34,18 @@ func (SavingsTransfer) Fields() []ent.Field {
 			Comment("FK to Account (savings)"),
 		field.Int64("amount_cents").
 			Comment("Amount to transfer in cents"),
+		field.String("currency").
+			Default("USD").
+			Comment("ISO 4217 currency of the amount"),
 
 		// Status
 		field.Enum("status").
 			Values("suggested", "approved", "executed", "cancelled", "failed").
 			Default("suggested").
 			Comment("Transfer status"),
-		field.String("plaid_transfer_id").
+		field.String("external_transfer_id").
 			Optional().
-			Comment("Plaid transfer ID if real transfer was executed"),
+			Comment("Aggregator's transfer ID if real transfer was executed"),
 		field.String("error_message").
 			Optional().
 			Comment("Error details if failed"),
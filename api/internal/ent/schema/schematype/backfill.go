@@ -0,0 +1,15 @@
+package schematype
+
+// StarterRule is a savings rule suggested from an item's first transactions once its backfill completes
+type StarterRule struct {
+	Name             string  `cbor:"name" json:"name"`
+	Category         string  `cbor:"category" json:"category"`
+	ActionType       string  `cbor:"action_type" json:"action_type"`
+	ActionValue      float64 `cbor:"action_value" json:"action_value"`
+	MinAmountCents   *int64  `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
+	MaxAmountCents   *int64  `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
+	EstimatedSavings float64 `cbor:"estimated_savings" json:"estimated_savings"`
+	Confidence       string  `cbor:"confidence" json:"confidence"`
+	Reasoning        string  `cbor:"reasoning" json:"reasoning"`
+	ImpactLevel      string  `cbor:"impact_level" json:"impact_level"`
+}
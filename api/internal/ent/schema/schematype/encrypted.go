@@ -0,0 +1,32 @@
+package schematype
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"entgo.io/ent/schema/field"
+
+	"regulation/internal/envelope"
+)
+
+// EncryptedString stores a string field encrypted with the default envelope cipher
+// and decrypts it transparently when scanned
+var EncryptedString = field.ValueScannerFunc[string, *sql.NullString]{
+	V: func(s string) (driver.Value, error) {
+		c, err := envelope.Default()
+		if err != nil {
+			return nil, err
+		}
+		return c.Encrypt(s)
+	},
+	S: func(ns *sql.NullString) (string, error) {
+		if !ns.Valid {
+			return "", nil
+		}
+		c, err := envelope.Default()
+		if err != nil {
+			return "", err
+		}
+		return c.Decrypt(ns.String)
+	},
+}
@@ -0,0 +1,29 @@
+package schematype
+
+import "github.com/google/uuid"
+
+// ReportRule summarizes the activity of a single rule within a monthly report
+type ReportRule struct {
+	RuleID         uuid.UUID `cbor:"rule_id" json:"rule_id"`
+	Name           string    `cbor:"name" json:"name"`
+	ExecutionCount int       `cbor:"execution_count" json:"execution_count"`
+	SavedCents     int64     `cbor:"saved_cents" json:"saved_cents"`
+}
+
+// ReportGoal captures the completion of a goal at the end of a reported month
+type ReportGoal struct {
+	GoalID        uuid.UUID `cbor:"goal_id" json:"goal_id"`
+	Name          string    `cbor:"name" json:"name"`
+	Kind          string    `cbor:"kind" json:"kind"`
+	TargetCents   int64     `cbor:"target_cents" json:"target_cents"`
+	ProgressCents int64     `cbor:"progress_cents" json:"progress_cents"`
+	Percent       float64   `cbor:"percent" json:"percent"`
+}
+
+// ReportCategoryChange compares spend in a category with the previous month
+type ReportCategoryChange struct {
+	Category      string `cbor:"category" json:"category"`
+	Spend         int64  `cbor:"spend" json:"spend"`
+	PreviousSpend int64  `cbor:"previous_spend" json:"previous_spend"`
+	Change        int64  `cbor:"change" json:"change"`
+}
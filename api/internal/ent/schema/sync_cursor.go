@@ -27,7 +27,7 @@ func (SyncCursor) Fields() []ent.Field {
 			Comment("FK to Item"),
 		field.String("cursor").
 			Default("").
-			Comment("Plaid sync cursor for incremental updates"),
+			Comment("Aggregator sync cursor for incremental updates"),
 		field.Time("last_sync_at").
 			Default(time.Now).
 			UpdateDefault(time.Now).
@@ -38,6 +38,9 @@ func (SyncCursor) Fields() []ent.Field {
 		field.Int("consecutive_failures").
 			Default(0).
 			Comment("Number of consecutive failed sync attempts"),
+		field.Time("next_sync_at").
+			Default(time.Now).
+			Comment("When the sync worker should sync the item next"),
 	}
 }
 
@@ -56,5 +59,6 @@ func (SyncCursor) Edges() []ent.Edge {
 func (SyncCursor) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("item_id").Unique(),
+		index.Fields("next_sync_at"),
 	}
 }
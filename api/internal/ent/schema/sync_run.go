@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// SyncRun holds the schema definition for the SyncRun entity.
+// Records a single sync attempt of an Item for troubleshooting.
+type SyncRun struct {
+	ent.Schema
+}
+
+// Fields of the SyncRun.
+func (SyncRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("item_id", uuid.UUID{}).
+			Comment("FK to Item"),
+		field.Enum("trigger").
+			Values("scheduled", "webhook", "manual", "link").
+			Comment("What started the sync"),
+		field.Enum("status").
+			Values("running", "succeeded", "failed").
+			Default("running").
+			Comment("Outcome of the sync, running until it finishes"),
+		field.Int("added_count").
+			Default(0).
+			Comment("Number of transactions added"),
+		field.Int("modified_count").
+			Default(0).
+			Comment("Number of transactions modified"),
+		field.Int("removed_count").
+			Default(0).
+			Comment("Number of transactions removed"),
+		field.Int("rules_triggered").
+			Default(0).
+			Comment("Number of rule executions created by the sync"),
+		field.String("error").
+			Optional().
+			Nillable().
+			Comment("Error details if status is failed"),
+		field.Time("started_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the SyncRun.
+func (SyncRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("item", Item.Type).
+			Ref("sync_runs").
+			Field("item_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the SyncRun.
+func (SyncRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("item_id", "started_at"),
+	}
+}
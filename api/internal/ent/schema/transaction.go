@@ -11,7 +11,7 @@ import (
 )
 
 // Transaction holds the schema definition for the Transaction entity.
-// Represents a financial transaction imported from Plaid.
+// Represents a financial transaction synced from an aggregator or imported from a statement.
 type Transaction struct {
 	ent.Schema
 }
@@ -24,12 +24,14 @@ func (Transaction) Fields() []ent.Field {
 			Immutable(),
 		field.UUID("account_id", uuid.UUID{}).
 			Comment("FK to Account"),
-		field.String("plaid_id").
+		field.String("external_id").
 			NotEmpty().
-			Unique().
-			Comment("Plaid's unique identifier for this transaction"),
+			Comment("Aggregator's identifier for this transaction, unique per account; import-<hash> for imported statement rows"),
 		field.Int64("amount").
 			Comment("Transaction amount in cents (positive for debit, negative for credit)"),
+		field.String("currency").
+			Default("USD").
+			Comment("ISO 4217 currency of the amount"),
 		field.Time("date").
 			Comment("Transaction date"),
 		field.String("name").
@@ -41,15 +43,34 @@ func (Transaction) Fields() []ent.Field {
 		field.String("category").
 			Default("Misc").
 			Comment("App category: Dining, Shopping, Transport, etc."),
-		field.JSON("plaid_categories", []string{}).
+		field.JSON("provider_categories", []string{}).
 			Optional().
-			Comment("Original Plaid category array"),
+			Comment("Original category array from the aggregator or statement"),
 		field.Bool("pending").
 			Default(false).
 			Comment("Whether transaction is still pending"),
+		field.String("pending_transaction_id").
+			Optional().
+			Nillable().
+			Comment("External ID of the pending transaction this posted one replaced"),
 		field.String("payment_channel").
 			Optional().
 			Comment("Payment channel: online, in store, etc."),
+		field.Bool("internal_transfer").
+			Default(false).
+			Comment("Whether this moves money between the user's own accounts (excluded from income/spend)"),
+		field.UUID("transfer_pair_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Transaction on the other side of an internal transfer, if matched"),
+		field.Float("anomaly_score").
+			Optional().
+			Nillable().
+			Comment("How unusual the charge is for the user, 0 (typical) to 1, if scored"),
+		field.String("anomaly_reason").
+			Optional().
+			Nillable().
+			Comment("Why the charge was flagged as unusual"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -75,8 +96,9 @@ func (Transaction) Edges() []ent.Edge {
 func (Transaction) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("account_id", "date"),
-		index.Fields("plaid_id").Unique(),
+		index.Fields("account_id", "external_id").Unique(),
 		index.Fields("category"),
 		index.Fields("pending"),
+		index.Fields("internal_transfer"),
 	}
 }
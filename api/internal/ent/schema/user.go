@@ -23,6 +23,8 @@ func (User) Fields() []ent.Field {
 		field.UUID("custody_account_id", uuid.Nil).Optional().Nillable(),
 
 		field.String("nickname"),
+
+		field.String("home_currency").Default("USD").Comment("ISO 4217 currency totals are reported in"),
 	}
 }
 
@@ -39,5 +41,11 @@ func (User) Edges() []ent.Edge {
 		edge.To("rules", Rule.Type),
 		edge.To("rule_executions", RuleExecution.Type),
 		edge.To("savings_transfers", SavingsTransfer.Type),
+		edge.To("family_membership", FamilyMember.Type).
+			Unique(),
+		edge.To("goals", Goal.Type),
+		edge.To("monthly_reports", MonthlyReport.Type),
+		edge.To("budgets", Budget.Type),
+		edge.To("recurring_charges", RecurringCharge.Type),
 	}
 }
@@ -21,7 +21,7 @@ type SyncCursor struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// FK to Item
 	ItemID uuid.UUID `json:"item_id,omitempty"`
-	// Plaid sync cursor for incremental updates
+	// Aggregator sync cursor for incremental updates
 	Cursor string `json:"cursor,omitempty"`
 	// Last sync attempt timestamp
 	LastSyncAt time.Time `json:"last_sync_at,omitempty"`
@@ -29,6 +29,8 @@ type SyncCursor struct {
 	LastError string `json:"last_error,omitempty"`
 	// Number of consecutive failed sync attempts
 	ConsecutiveFailures int `json:"consecutive_failures,omitempty"`
+	// When the sync worker should sync the item next
+	NextSyncAt time.Time `json:"next_sync_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SyncCursorQuery when eager-loading is set.
 	Edges        SyncCursorEdges `json:"edges"`
@@ -64,7 +66,7 @@ func (*SyncCursor) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullInt64)
 		case synccursor.FieldCursor, synccursor.FieldLastError:
 			values[i] = new(sql.NullString)
-		case synccursor.FieldLastSyncAt:
+		case synccursor.FieldLastSyncAt, synccursor.FieldNextSyncAt:
 			values[i] = new(sql.NullTime)
 		case synccursor.FieldID, synccursor.FieldItemID:
 			values[i] = new(uuid.UUID)
@@ -119,6 +121,12 @@ func (_m *SyncCursor) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ConsecutiveFailures = int(value.Int64)
 			}
+		case synccursor.FieldNextSyncAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_sync_at", values[i])
+			} else if value.Valid {
+				_m.NextSyncAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -174,6 +182,9 @@ func (_m *SyncCursor) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("consecutive_failures=")
 	builder.WriteString(fmt.Sprintf("%v", _m.ConsecutiveFailures))
+	builder.WriteString(", ")
+	builder.WriteString("next_sync_at=")
+	builder.WriteString(_m.NextSyncAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -25,6 +25,8 @@ const (
 	FieldLastError = "last_error"
 	// FieldConsecutiveFailures holds the string denoting the consecutive_failures field in the database.
 	FieldConsecutiveFailures = "consecutive_failures"
+	// FieldNextSyncAt holds the string denoting the next_sync_at field in the database.
+	FieldNextSyncAt = "next_sync_at"
 	// EdgeItem holds the string denoting the item edge name in mutations.
 	EdgeItem = "item"
 	// Table holds the table name of the synccursor in the database.
@@ -46,6 +48,7 @@ var Columns = []string{
 	FieldLastSyncAt,
 	FieldLastError,
 	FieldConsecutiveFailures,
+	FieldNextSyncAt,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -69,6 +72,8 @@ var (
 	DefaultLastError string
 	// DefaultConsecutiveFailures holds the default value on creation for the "consecutive_failures" field.
 	DefaultConsecutiveFailures int
+	// DefaultNextSyncAt holds the default value on creation for the "next_sync_at" field.
+	DefaultNextSyncAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -106,6 +111,11 @@ func ByConsecutiveFailures(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConsecutiveFailures, opts...).ToFunc()
 }
 
+// ByNextSyncAt orders the results by the next_sync_at field.
+func ByNextSyncAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextSyncAt, opts...).ToFunc()
+}
+
 // ByItemField orders the results by item field.
 func ByItemField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
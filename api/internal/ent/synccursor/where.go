@@ -81,6 +81,11 @@ func ConsecutiveFailures(v int) predicate.SyncCursor {
 	return predicate.SyncCursor(sql.FieldEQ(FieldConsecutiveFailures, v))
 }
 
+// NextSyncAt applies equality check predicate on the "next_sync_at" field. It's identical to NextSyncAtEQ.
+func NextSyncAt(v time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldEQ(FieldNextSyncAt, v))
+}
+
 // ItemIDEQ applies the EQ predicate on the "item_id" field.
 func ItemIDEQ(v uuid.UUID) predicate.SyncCursor {
 	return predicate.SyncCursor(sql.FieldEQ(FieldItemID, v))
@@ -311,6 +316,46 @@ func ConsecutiveFailuresLTE(v int) predicate.SyncCursor {
 	return predicate.SyncCursor(sql.FieldLTE(FieldConsecutiveFailures, v))
 }
 
+// NextSyncAtEQ applies the EQ predicate on the "next_sync_at" field.
+func NextSyncAtEQ(v time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldEQ(FieldNextSyncAt, v))
+}
+
+// NextSyncAtNEQ applies the NEQ predicate on the "next_sync_at" field.
+func NextSyncAtNEQ(v time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldNEQ(FieldNextSyncAt, v))
+}
+
+// NextSyncAtIn applies the In predicate on the "next_sync_at" field.
+func NextSyncAtIn(vs ...time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldIn(FieldNextSyncAt, vs...))
+}
+
+// NextSyncAtNotIn applies the NotIn predicate on the "next_sync_at" field.
+func NextSyncAtNotIn(vs ...time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldNotIn(FieldNextSyncAt, vs...))
+}
+
+// NextSyncAtGT applies the GT predicate on the "next_sync_at" field.
+func NextSyncAtGT(v time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldGT(FieldNextSyncAt, v))
+}
+
+// NextSyncAtGTE applies the GTE predicate on the "next_sync_at" field.
+func NextSyncAtGTE(v time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldGTE(FieldNextSyncAt, v))
+}
+
+// NextSyncAtLT applies the LT predicate on the "next_sync_at" field.
+func NextSyncAtLT(v time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldLT(FieldNextSyncAt, v))
+}
+
+// NextSyncAtLTE applies the LTE predicate on the "next_sync_at" field.
+func NextSyncAtLTE(v time.Time) predicate.SyncCursor {
+	return predicate.SyncCursor(sql.FieldLTE(FieldNextSyncAt, v))
+}
+
 // HasItem applies the HasEdge predicate on the "item" edge.
 func HasItem() predicate.SyncCursor {
 	return predicate.SyncCursor(func(s *sql.Selector) {
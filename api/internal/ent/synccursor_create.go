@@ -87,6 +87,20 @@ func (_c *SyncCursorCreate) SetNillableConsecutiveFailures(v *int) *SyncCursorCr
 	return _c
 }
 
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_c *SyncCursorCreate) SetNextSyncAt(v time.Time) *SyncCursorCreate {
+	_c.mutation.SetNextSyncAt(v)
+	return _c
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_c *SyncCursorCreate) SetNillableNextSyncAt(v *time.Time) *SyncCursorCreate {
+	if v != nil {
+		_c.SetNextSyncAt(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *SyncCursorCreate) SetID(v uuid.UUID) *SyncCursorCreate {
 	_c.mutation.SetID(v)
@@ -157,6 +171,10 @@ func (_c *SyncCursorCreate) defaults() {
 		v := synccursor.DefaultConsecutiveFailures
 		_c.mutation.SetConsecutiveFailures(v)
 	}
+	if _, ok := _c.mutation.NextSyncAt(); !ok {
+		v := synccursor.DefaultNextSyncAt()
+		_c.mutation.SetNextSyncAt(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := synccursor.DefaultID()
 		_c.mutation.SetID(v)
@@ -180,6 +198,9 @@ func (_c *SyncCursorCreate) check() error {
 	if _, ok := _c.mutation.ConsecutiveFailures(); !ok {
 		return &ValidationError{Name: "consecutive_failures", err: errors.New(`ent: missing required field "SyncCursor.consecutive_failures"`)}
 	}
+	if _, ok := _c.mutation.NextSyncAt(); !ok {
+		return &ValidationError{Name: "next_sync_at", err: errors.New(`ent: missing required field "SyncCursor.next_sync_at"`)}
+	}
 	if len(_c.mutation.ItemIDs()) == 0 {
 		return &ValidationError{Name: "item", err: errors.New(`ent: missing required edge "SyncCursor.item"`)}
 	}
@@ -235,6 +256,10 @@ func (_c *SyncCursorCreate) createSpec() (*SyncCursor, *sqlgraph.CreateSpec) {
 		_spec.SetField(synccursor.FieldConsecutiveFailures, field.TypeInt, value)
 		_node.ConsecutiveFailures = value
 	}
+	if value, ok := _c.mutation.NextSyncAt(); ok {
+		_spec.SetField(synccursor.FieldNextSyncAt, field.TypeTime, value)
+		_node.NextSyncAt = value
+	}
 	if nodes := _c.mutation.ItemIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -370,6 +395,18 @@ func (u *SyncCursorUpsert) AddConsecutiveFailures(v int) *SyncCursorUpsert {
 	return u
 }
 
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *SyncCursorUpsert) SetNextSyncAt(v time.Time) *SyncCursorUpsert {
+	u.Set(synccursor.FieldNextSyncAt, v)
+	return u
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *SyncCursorUpsert) UpdateNextSyncAt() *SyncCursorUpsert {
+	u.SetExcluded(synccursor.FieldNextSyncAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -495,6 +532,20 @@ func (u *SyncCursorUpsertOne) UpdateConsecutiveFailures() *SyncCursorUpsertOne {
 	})
 }
 
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *SyncCursorUpsertOne) SetNextSyncAt(v time.Time) *SyncCursorUpsertOne {
+	return u.Update(func(s *SyncCursorUpsert) {
+		s.SetNextSyncAt(v)
+	})
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *SyncCursorUpsertOne) UpdateNextSyncAt() *SyncCursorUpsertOne {
+	return u.Update(func(s *SyncCursorUpsert) {
+		s.UpdateNextSyncAt()
+	})
+}
+
 // Exec executes the query.
 func (u *SyncCursorUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -787,6 +838,20 @@ func (u *SyncCursorUpsertBulk) UpdateConsecutiveFailures() *SyncCursorUpsertBulk
 	})
 }
 
+// SetNextSyncAt sets the "next_sync_at" field.
+func (u *SyncCursorUpsertBulk) SetNextSyncAt(v time.Time) *SyncCursorUpsertBulk {
+	return u.Update(func(s *SyncCursorUpsert) {
+		s.SetNextSyncAt(v)
+	})
+}
+
+// UpdateNextSyncAt sets the "next_sync_at" field to the value that was provided on create.
+func (u *SyncCursorUpsertBulk) UpdateNextSyncAt() *SyncCursorUpsertBulk {
+	return u.Update(func(s *SyncCursorUpsert) {
+		s.UpdateNextSyncAt()
+	})
+}
+
 // Exec executes the query.
 func (u *SyncCursorUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -100,6 +100,20 @@ func (_u *SyncCursorUpdate) AddConsecutiveFailures(v int) *SyncCursorUpdate {
 	return _u
 }
 
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_u *SyncCursorUpdate) SetNextSyncAt(v time.Time) *SyncCursorUpdate {
+	_u.mutation.SetNextSyncAt(v)
+	return _u
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_u *SyncCursorUpdate) SetNillableNextSyncAt(v *time.Time) *SyncCursorUpdate {
+	if v != nil {
+		_u.SetNextSyncAt(*v)
+	}
+	return _u
+}
+
 // SetItem sets the "item" edge to the Item entity.
 func (_u *SyncCursorUpdate) SetItem(v *Item) *SyncCursorUpdate {
 	return _u.SetItemID(v.ID)
@@ -193,6 +207,9 @@ func (_u *SyncCursorUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
 		_spec.AddField(synccursor.FieldConsecutiveFailures, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.NextSyncAt(); ok {
+		_spec.SetField(synccursor.FieldNextSyncAt, field.TypeTime, value)
+	}
 	if _u.mutation.ItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -313,6 +330,20 @@ func (_u *SyncCursorUpdateOne) AddConsecutiveFailures(v int) *SyncCursorUpdateOn
 	return _u
 }
 
+// SetNextSyncAt sets the "next_sync_at" field.
+func (_u *SyncCursorUpdateOne) SetNextSyncAt(v time.Time) *SyncCursorUpdateOne {
+	_u.mutation.SetNextSyncAt(v)
+	return _u
+}
+
+// SetNillableNextSyncAt sets the "next_sync_at" field if the given value is not nil.
+func (_u *SyncCursorUpdateOne) SetNillableNextSyncAt(v *time.Time) *SyncCursorUpdateOne {
+	if v != nil {
+		_u.SetNextSyncAt(*v)
+	}
+	return _u
+}
+
 // SetItem sets the "item" edge to the Item entity.
 func (_u *SyncCursorUpdateOne) SetItem(v *Item) *SyncCursorUpdateOne {
 	return _u.SetItemID(v.ID)
@@ -436,6 +467,9 @@ func (_u *SyncCursorUpdateOne) sqlSave(ctx context.Context) (_node *SyncCursor,
 	if value, ok := _u.mutation.AddedConsecutiveFailures(); ok {
 		_spec.AddField(synccursor.FieldConsecutiveFailures, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.NextSyncAt(); ok {
+		_spec.SetField(synccursor.FieldNextSyncAt, field.TypeTime, value)
+	}
 	if _u.mutation.ItemCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -22,10 +22,12 @@ type Transaction struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// FK to Account
 	AccountID uuid.UUID `json:"account_id,omitempty"`
-	// Plaid's unique identifier for this transaction
-	PlaidID string `json:"plaid_id,omitempty"`
+	// Aggregator's identifier for this transaction, unique per account; import-<hash> for imported statement rows
+	ExternalID string `json:"external_id,omitempty"`
 	// Transaction amount in cents (positive for debit, negative for credit)
 	Amount int64 `json:"amount,omitempty"`
+	// ISO 4217 currency of the amount
+	Currency string `json:"currency,omitempty"`
 	// Transaction date
 	Date time.Time `json:"date,omitempty"`
 	// Transaction description/name
@@ -34,12 +36,22 @@ type Transaction struct {
 	MerchantName string `json:"merchant_name,omitempty"`
 	// App category: Dining, Shopping, Transport, etc.
 	Category string `json:"category,omitempty"`
-	// Original Plaid category array
-	PlaidCategories []string `json:"plaid_categories,omitempty"`
+	// Original category array from the aggregator or statement
+	ProviderCategories []string `json:"provider_categories,omitempty"`
 	// Whether transaction is still pending
 	Pending bool `json:"pending,omitempty"`
+	// External ID of the pending transaction this posted one replaced
+	PendingTransactionID *string `json:"pending_transaction_id,omitempty"`
 	// Payment channel: online, in store, etc.
 	PaymentChannel string `json:"payment_channel,omitempty"`
+	// Whether this moves money between the user's own accounts (excluded from income/spend)
+	InternalTransfer bool `json:"internal_transfer,omitempty"`
+	// Transaction on the other side of an internal transfer, if matched
+	TransferPairID *uuid.UUID `json:"transfer_pair_id,omitempty"`
+	// How unusual the charge is for the user, 0 (typical) to 1, if scored
+	AnomalyScore *float64 `json:"anomaly_score,omitempty"`
+	// Why the charge was flagged as unusual
+	AnomalyReason *string `json:"anomaly_reason,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -86,13 +98,17 @@ func (*Transaction) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case transaction.FieldPlaidCategories:
+		case transaction.FieldTransferPairID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case transaction.FieldProviderCategories:
 			values[i] = new([]byte)
-		case transaction.FieldPending:
+		case transaction.FieldPending, transaction.FieldInternalTransfer:
 			values[i] = new(sql.NullBool)
+		case transaction.FieldAnomalyScore:
+			values[i] = new(sql.NullFloat64)
 		case transaction.FieldAmount:
 			values[i] = new(sql.NullInt64)
-		case transaction.FieldPlaidID, transaction.FieldName, transaction.FieldMerchantName, transaction.FieldCategory, transaction.FieldPaymentChannel:
+		case transaction.FieldExternalID, transaction.FieldCurrency, transaction.FieldName, transaction.FieldMerchantName, transaction.FieldCategory, transaction.FieldPendingTransactionID, transaction.FieldPaymentChannel, transaction.FieldAnomalyReason:
 			values[i] = new(sql.NullString)
 		case transaction.FieldDate, transaction.FieldCreatedAt, transaction.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -125,11 +141,11 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.AccountID = *value
 			}
-		case transaction.FieldPlaidID:
+		case transaction.FieldExternalID:
 			if value, ok := values[i].(*sql.NullString); !ok {
-				return fmt.Errorf("unexpected type %T for field plaid_id", values[i])
+				return fmt.Errorf("unexpected type %T for field external_id", values[i])
 			} else if value.Valid {
-				_m.PlaidID = value.String
+				_m.ExternalID = value.String
 			}
 		case transaction.FieldAmount:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
@@ -137,6 +153,12 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Amount = value.Int64
 			}
+		case transaction.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
 		case transaction.FieldDate:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field date", values[i])
@@ -161,12 +183,12 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Category = value.String
 			}
-		case transaction.FieldPlaidCategories:
+		case transaction.FieldProviderCategories:
 			if value, ok := values[i].(*[]byte); !ok {
-				return fmt.Errorf("unexpected type %T for field plaid_categories", values[i])
+				return fmt.Errorf("unexpected type %T for field provider_categories", values[i])
 			} else if value != nil && len(*value) > 0 {
-				if err := json.Unmarshal(*value, &_m.PlaidCategories); err != nil {
-					return fmt.Errorf("unmarshal field plaid_categories: %w", err)
+				if err := json.Unmarshal(*value, &_m.ProviderCategories); err != nil {
+					return fmt.Errorf("unmarshal field provider_categories: %w", err)
 				}
 			}
 		case transaction.FieldPending:
@@ -175,12 +197,46 @@ func (_m *Transaction) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Pending = value.Bool
 			}
+		case transaction.FieldPendingTransactionID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_transaction_id", values[i])
+			} else if value.Valid {
+				_m.PendingTransactionID = new(string)
+				*_m.PendingTransactionID = value.String
+			}
 		case transaction.FieldPaymentChannel:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field payment_channel", values[i])
 			} else if value.Valid {
 				_m.PaymentChannel = value.String
 			}
+		case transaction.FieldInternalTransfer:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field internal_transfer", values[i])
+			} else if value.Valid {
+				_m.InternalTransfer = value.Bool
+			}
+		case transaction.FieldTransferPairID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field transfer_pair_id", values[i])
+			} else if value.Valid {
+				_m.TransferPairID = new(uuid.UUID)
+				*_m.TransferPairID = *value.S.(*uuid.UUID)
+			}
+		case transaction.FieldAnomalyScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field anomaly_score", values[i])
+			} else if value.Valid {
+				_m.AnomalyScore = new(float64)
+				*_m.AnomalyScore = value.Float64
+			}
+		case transaction.FieldAnomalyReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field anomaly_reason", values[i])
+			} else if value.Valid {
+				_m.AnomalyReason = new(string)
+				*_m.AnomalyReason = value.String
+			}
 		case transaction.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -242,12 +298,15 @@ func (_m *Transaction) String() string {
 	builder.WriteString("account_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
 	builder.WriteString(", ")
-	builder.WriteString("plaid_id=")
-	builder.WriteString(_m.PlaidID)
+	builder.WriteString("external_id=")
+	builder.WriteString(_m.ExternalID)
 	builder.WriteString(", ")
 	builder.WriteString("amount=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
 	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
 	builder.WriteString("date=")
 	builder.WriteString(_m.Date.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -260,15 +319,38 @@ func (_m *Transaction) String() string {
 	builder.WriteString("category=")
 	builder.WriteString(_m.Category)
 	builder.WriteString(", ")
-	builder.WriteString("plaid_categories=")
-	builder.WriteString(fmt.Sprintf("%v", _m.PlaidCategories))
+	builder.WriteString("provider_categories=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ProviderCategories))
 	builder.WriteString(", ")
 	builder.WriteString("pending=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Pending))
 	builder.WriteString(", ")
+	if v := _m.PendingTransactionID; v != nil {
+		builder.WriteString("pending_transaction_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("payment_channel=")
 	builder.WriteString(_m.PaymentChannel)
 	builder.WriteString(", ")
+	builder.WriteString("internal_transfer=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InternalTransfer))
+	builder.WriteString(", ")
+	if v := _m.TransferPairID; v != nil {
+		builder.WriteString("transfer_pair_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AnomalyScore; v != nil {
+		builder.WriteString("anomaly_score=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AnomalyReason; v != nil {
+		builder.WriteString("anomaly_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
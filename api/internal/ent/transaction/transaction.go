@@ -17,10 +17,12 @@ const (
 	FieldID = "id"
 	// FieldAccountID holds the string denoting the account_id field in the database.
 	FieldAccountID = "account_id"
-	// FieldPlaidID holds the string denoting the plaid_id field in the database.
-	FieldPlaidID = "plaid_id"
+	// FieldExternalID holds the string denoting the external_id field in the database.
+	FieldExternalID = "external_id"
 	// FieldAmount holds the string denoting the amount field in the database.
 	FieldAmount = "amount"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
 	// FieldDate holds the string denoting the date field in the database.
 	FieldDate = "date"
 	// FieldName holds the string denoting the name field in the database.
@@ -29,12 +31,22 @@ const (
 	FieldMerchantName = "merchant_name"
 	// FieldCategory holds the string denoting the category field in the database.
 	FieldCategory = "category"
-	// FieldPlaidCategories holds the string denoting the plaid_categories field in the database.
-	FieldPlaidCategories = "plaid_categories"
+	// FieldProviderCategories holds the string denoting the provider_categories field in the database.
+	FieldProviderCategories = "provider_categories"
 	// FieldPending holds the string denoting the pending field in the database.
 	FieldPending = "pending"
+	// FieldPendingTransactionID holds the string denoting the pending_transaction_id field in the database.
+	FieldPendingTransactionID = "pending_transaction_id"
 	// FieldPaymentChannel holds the string denoting the payment_channel field in the database.
 	FieldPaymentChannel = "payment_channel"
+	// FieldInternalTransfer holds the string denoting the internal_transfer field in the database.
+	FieldInternalTransfer = "internal_transfer"
+	// FieldTransferPairID holds the string denoting the transfer_pair_id field in the database.
+	FieldTransferPairID = "transfer_pair_id"
+	// FieldAnomalyScore holds the string denoting the anomaly_score field in the database.
+	FieldAnomalyScore = "anomaly_score"
+	// FieldAnomalyReason holds the string denoting the anomaly_reason field in the database.
+	FieldAnomalyReason = "anomaly_reason"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -65,15 +77,21 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldAccountID,
-	FieldPlaidID,
+	FieldExternalID,
 	FieldAmount,
+	FieldCurrency,
 	FieldDate,
 	FieldName,
 	FieldMerchantName,
 	FieldCategory,
-	FieldPlaidCategories,
+	FieldProviderCategories,
 	FieldPending,
+	FieldPendingTransactionID,
 	FieldPaymentChannel,
+	FieldInternalTransfer,
+	FieldTransferPairID,
+	FieldAnomalyScore,
+	FieldAnomalyReason,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -89,14 +107,18 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// PlaidIDValidator is a validator for the "plaid_id" field. It is called by the builders before save.
-	PlaidIDValidator func(string) error
+	// ExternalIDValidator is a validator for the "external_id" field. It is called by the builders before save.
+	ExternalIDValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
 	// DefaultCategory holds the default value on creation for the "category" field.
 	DefaultCategory string
 	// DefaultPending holds the default value on creation for the "pending" field.
 	DefaultPending bool
+	// DefaultInternalTransfer holds the default value on creation for the "internal_transfer" field.
+	DefaultInternalTransfer bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -120,9 +142,9 @@ func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
 }
 
-// ByPlaidID orders the results by the plaid_id field.
-func ByPlaidID(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldPlaidID, opts...).ToFunc()
+// ByExternalID orders the results by the external_id field.
+func ByExternalID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExternalID, opts...).ToFunc()
 }
 
 // ByAmount orders the results by the amount field.
@@ -130,6 +152,11 @@ func ByAmount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAmount, opts...).ToFunc()
 }
 
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
 // ByDate orders the results by the date field.
 func ByDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDate, opts...).ToFunc()
@@ -155,11 +182,36 @@ func ByPending(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPending, opts...).ToFunc()
 }
 
+// ByPendingTransactionID orders the results by the pending_transaction_id field.
+func ByPendingTransactionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingTransactionID, opts...).ToFunc()
+}
+
 // ByPaymentChannel orders the results by the payment_channel field.
 func ByPaymentChannel(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPaymentChannel, opts...).ToFunc()
 }
 
+// ByInternalTransfer orders the results by the internal_transfer field.
+func ByInternalTransfer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInternalTransfer, opts...).ToFunc()
+}
+
+// ByTransferPairID orders the results by the transfer_pair_id field.
+func ByTransferPairID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTransferPairID, opts...).ToFunc()
+}
+
+// ByAnomalyScore orders the results by the anomaly_score field.
+func ByAnomalyScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnomalyScore, opts...).ToFunc()
+}
+
+// ByAnomalyReason orders the results by the anomaly_reason field.
+func ByAnomalyReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnomalyReason, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
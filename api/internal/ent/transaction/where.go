@@ -61,9 +61,9 @@ func AccountID(v uuid.UUID) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAccountID, v))
 }
 
-// PlaidID applies equality check predicate on the "plaid_id" field. It's identical to PlaidIDEQ.
-func PlaidID(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEQ(FieldPlaidID, v))
+// ExternalID applies equality check predicate on the "external_id" field. It's identical to ExternalIDEQ.
+func ExternalID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExternalID, v))
 }
 
 // Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
@@ -71,6 +71,11 @@ func Amount(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldAmount, v))
 }
 
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
 // Date applies equality check predicate on the "date" field. It's identical to DateEQ.
 func Date(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldDate, v))
@@ -96,11 +101,36 @@ func Pending(v bool) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldPending, v))
 }
 
+// PendingTransactionID applies equality check predicate on the "pending_transaction_id" field. It's identical to PendingTransactionIDEQ.
+func PendingTransactionID(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPendingTransactionID, v))
+}
+
 // PaymentChannel applies equality check predicate on the "payment_channel" field. It's identical to PaymentChannelEQ.
 func PaymentChannel(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldPaymentChannel, v))
 }
 
+// InternalTransfer applies equality check predicate on the "internal_transfer" field. It's identical to InternalTransferEQ.
+func InternalTransfer(v bool) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldInternalTransfer, v))
+}
+
+// TransferPairID applies equality check predicate on the "transfer_pair_id" field. It's identical to TransferPairIDEQ.
+func TransferPairID(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTransferPairID, v))
+}
+
+// AnomalyScore applies equality check predicate on the "anomaly_score" field. It's identical to AnomalyScoreEQ.
+func AnomalyScore(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAnomalyScore, v))
+}
+
+// AnomalyReason applies equality check predicate on the "anomaly_reason" field. It's identical to AnomalyReasonEQ.
+func AnomalyReason(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAnomalyReason, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
@@ -131,69 +161,69 @@ func AccountIDNotIn(vs ...uuid.UUID) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNotIn(FieldAccountID, vs...))
 }
 
-// PlaidIDEQ applies the EQ predicate on the "plaid_id" field.
-func PlaidIDEQ(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEQ(FieldPlaidID, v))
+// ExternalIDEQ applies the EQ predicate on the "external_id" field.
+func ExternalIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldExternalID, v))
 }
 
-// PlaidIDNEQ applies the NEQ predicate on the "plaid_id" field.
-func PlaidIDNEQ(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldNEQ(FieldPlaidID, v))
+// ExternalIDNEQ applies the NEQ predicate on the "external_id" field.
+func ExternalIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldExternalID, v))
 }
 
-// PlaidIDIn applies the In predicate on the "plaid_id" field.
-func PlaidIDIn(vs ...string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldIn(FieldPlaidID, vs...))
+// ExternalIDIn applies the In predicate on the "external_id" field.
+func ExternalIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldExternalID, vs...))
 }
 
-// PlaidIDNotIn applies the NotIn predicate on the "plaid_id" field.
-func PlaidIDNotIn(vs ...string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldNotIn(FieldPlaidID, vs...))
+// ExternalIDNotIn applies the NotIn predicate on the "external_id" field.
+func ExternalIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldExternalID, vs...))
 }
 
-// PlaidIDGT applies the GT predicate on the "plaid_id" field.
-func PlaidIDGT(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldGT(FieldPlaidID, v))
+// ExternalIDGT applies the GT predicate on the "external_id" field.
+func ExternalIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldExternalID, v))
 }
 
-// PlaidIDGTE applies the GTE predicate on the "plaid_id" field.
-func PlaidIDGTE(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldGTE(FieldPlaidID, v))
+// ExternalIDGTE applies the GTE predicate on the "external_id" field.
+func ExternalIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldExternalID, v))
 }
 
-// PlaidIDLT applies the LT predicate on the "plaid_id" field.
-func PlaidIDLT(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldLT(FieldPlaidID, v))
+// ExternalIDLT applies the LT predicate on the "external_id" field.
+func ExternalIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldExternalID, v))
 }
 
-// PlaidIDLTE applies the LTE predicate on the "plaid_id" field.
-func PlaidIDLTE(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldLTE(FieldPlaidID, v))
+// ExternalIDLTE applies the LTE predicate on the "external_id" field.
+func ExternalIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldExternalID, v))
 }
 
-// PlaidIDContains applies the Contains predicate on the "plaid_id" field.
-func PlaidIDContains(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldContains(FieldPlaidID, v))
+// ExternalIDContains applies the Contains predicate on the "external_id" field.
+func ExternalIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldExternalID, v))
 }
 
-// PlaidIDHasPrefix applies the HasPrefix predicate on the "plaid_id" field.
-func PlaidIDHasPrefix(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldHasPrefix(FieldPlaidID, v))
+// ExternalIDHasPrefix applies the HasPrefix predicate on the "external_id" field.
+func ExternalIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldExternalID, v))
 }
 
-// PlaidIDHasSuffix applies the HasSuffix predicate on the "plaid_id" field.
-func PlaidIDHasSuffix(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldHasSuffix(FieldPlaidID, v))
+// ExternalIDHasSuffix applies the HasSuffix predicate on the "external_id" field.
+func ExternalIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldExternalID, v))
 }
 
-// PlaidIDEqualFold applies the EqualFold predicate on the "plaid_id" field.
-func PlaidIDEqualFold(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldEqualFold(FieldPlaidID, v))
+// ExternalIDEqualFold applies the EqualFold predicate on the "external_id" field.
+func ExternalIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldExternalID, v))
 }
 
-// PlaidIDContainsFold applies the ContainsFold predicate on the "plaid_id" field.
-func PlaidIDContainsFold(v string) predicate.Transaction {
-	return predicate.Transaction(sql.FieldContainsFold(FieldPlaidID, v))
+// ExternalIDContainsFold applies the ContainsFold predicate on the "external_id" field.
+func ExternalIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldExternalID, v))
 }
 
 // AmountEQ applies the EQ predicate on the "amount" field.
@@ -236,6 +266,71 @@ func AmountLTE(v int64) predicate.Transaction {
 	return predicate.Transaction(sql.FieldLTE(FieldAmount, v))
 }
 
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldCurrency, v))
+}
+
 // DateEQ applies the EQ predicate on the "date" field.
 func DateEQ(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldDate, v))
@@ -481,14 +576,14 @@ func CategoryContainsFold(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldContainsFold(FieldCategory, v))
 }
 
-// PlaidCategoriesIsNil applies the IsNil predicate on the "plaid_categories" field.
-func PlaidCategoriesIsNil() predicate.Transaction {
-	return predicate.Transaction(sql.FieldIsNull(FieldPlaidCategories))
+// ProviderCategoriesIsNil applies the IsNil predicate on the "provider_categories" field.
+func ProviderCategoriesIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldProviderCategories))
 }
 
-// PlaidCategoriesNotNil applies the NotNil predicate on the "plaid_categories" field.
-func PlaidCategoriesNotNil() predicate.Transaction {
-	return predicate.Transaction(sql.FieldNotNull(FieldPlaidCategories))
+// ProviderCategoriesNotNil applies the NotNil predicate on the "provider_categories" field.
+func ProviderCategoriesNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldProviderCategories))
 }
 
 // PendingEQ applies the EQ predicate on the "pending" field.
@@ -501,6 +596,81 @@ func PendingNEQ(v bool) predicate.Transaction {
 	return predicate.Transaction(sql.FieldNEQ(FieldPending, v))
 }
 
+// PendingTransactionIDEQ applies the EQ predicate on the "pending_transaction_id" field.
+func PendingTransactionIDEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDNEQ applies the NEQ predicate on the "pending_transaction_id" field.
+func PendingTransactionIDNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDIn applies the In predicate on the "pending_transaction_id" field.
+func PendingTransactionIDIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldPendingTransactionID, vs...))
+}
+
+// PendingTransactionIDNotIn applies the NotIn predicate on the "pending_transaction_id" field.
+func PendingTransactionIDNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldPendingTransactionID, vs...))
+}
+
+// PendingTransactionIDGT applies the GT predicate on the "pending_transaction_id" field.
+func PendingTransactionIDGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDGTE applies the GTE predicate on the "pending_transaction_id" field.
+func PendingTransactionIDGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDLT applies the LT predicate on the "pending_transaction_id" field.
+func PendingTransactionIDLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDLTE applies the LTE predicate on the "pending_transaction_id" field.
+func PendingTransactionIDLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDContains applies the Contains predicate on the "pending_transaction_id" field.
+func PendingTransactionIDContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDHasPrefix applies the HasPrefix predicate on the "pending_transaction_id" field.
+func PendingTransactionIDHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDHasSuffix applies the HasSuffix predicate on the "pending_transaction_id" field.
+func PendingTransactionIDHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDIsNil applies the IsNil predicate on the "pending_transaction_id" field.
+func PendingTransactionIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldPendingTransactionID))
+}
+
+// PendingTransactionIDNotNil applies the NotNil predicate on the "pending_transaction_id" field.
+func PendingTransactionIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldPendingTransactionID))
+}
+
+// PendingTransactionIDEqualFold applies the EqualFold predicate on the "pending_transaction_id" field.
+func PendingTransactionIDEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldPendingTransactionID, v))
+}
+
+// PendingTransactionIDContainsFold applies the ContainsFold predicate on the "pending_transaction_id" field.
+func PendingTransactionIDContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldPendingTransactionID, v))
+}
+
 // PaymentChannelEQ applies the EQ predicate on the "payment_channel" field.
 func PaymentChannelEQ(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldPaymentChannel, v))
@@ -576,6 +746,191 @@ func PaymentChannelContainsFold(v string) predicate.Transaction {
 	return predicate.Transaction(sql.FieldContainsFold(FieldPaymentChannel, v))
 }
 
+// InternalTransferEQ applies the EQ predicate on the "internal_transfer" field.
+func InternalTransferEQ(v bool) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldInternalTransfer, v))
+}
+
+// InternalTransferNEQ applies the NEQ predicate on the "internal_transfer" field.
+func InternalTransferNEQ(v bool) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldInternalTransfer, v))
+}
+
+// TransferPairIDEQ applies the EQ predicate on the "transfer_pair_id" field.
+func TransferPairIDEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldTransferPairID, v))
+}
+
+// TransferPairIDNEQ applies the NEQ predicate on the "transfer_pair_id" field.
+func TransferPairIDNEQ(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldTransferPairID, v))
+}
+
+// TransferPairIDIn applies the In predicate on the "transfer_pair_id" field.
+func TransferPairIDIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldTransferPairID, vs...))
+}
+
+// TransferPairIDNotIn applies the NotIn predicate on the "transfer_pair_id" field.
+func TransferPairIDNotIn(vs ...uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldTransferPairID, vs...))
+}
+
+// TransferPairIDGT applies the GT predicate on the "transfer_pair_id" field.
+func TransferPairIDGT(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldTransferPairID, v))
+}
+
+// TransferPairIDGTE applies the GTE predicate on the "transfer_pair_id" field.
+func TransferPairIDGTE(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldTransferPairID, v))
+}
+
+// TransferPairIDLT applies the LT predicate on the "transfer_pair_id" field.
+func TransferPairIDLT(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldTransferPairID, v))
+}
+
+// TransferPairIDLTE applies the LTE predicate on the "transfer_pair_id" field.
+func TransferPairIDLTE(v uuid.UUID) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldTransferPairID, v))
+}
+
+// TransferPairIDIsNil applies the IsNil predicate on the "transfer_pair_id" field.
+func TransferPairIDIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldTransferPairID))
+}
+
+// TransferPairIDNotNil applies the NotNil predicate on the "transfer_pair_id" field.
+func TransferPairIDNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldTransferPairID))
+}
+
+// AnomalyScoreEQ applies the EQ predicate on the "anomaly_score" field.
+func AnomalyScoreEQ(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAnomalyScore, v))
+}
+
+// AnomalyScoreNEQ applies the NEQ predicate on the "anomaly_score" field.
+func AnomalyScoreNEQ(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldAnomalyScore, v))
+}
+
+// AnomalyScoreIn applies the In predicate on the "anomaly_score" field.
+func AnomalyScoreIn(vs ...float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldAnomalyScore, vs...))
+}
+
+// AnomalyScoreNotIn applies the NotIn predicate on the "anomaly_score" field.
+func AnomalyScoreNotIn(vs ...float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldAnomalyScore, vs...))
+}
+
+// AnomalyScoreGT applies the GT predicate on the "anomaly_score" field.
+func AnomalyScoreGT(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldAnomalyScore, v))
+}
+
+// AnomalyScoreGTE applies the GTE predicate on the "anomaly_score" field.
+func AnomalyScoreGTE(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldAnomalyScore, v))
+}
+
+// AnomalyScoreLT applies the LT predicate on the "anomaly_score" field.
+func AnomalyScoreLT(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldAnomalyScore, v))
+}
+
+// AnomalyScoreLTE applies the LTE predicate on the "anomaly_score" field.
+func AnomalyScoreLTE(v float64) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldAnomalyScore, v))
+}
+
+// AnomalyScoreIsNil applies the IsNil predicate on the "anomaly_score" field.
+func AnomalyScoreIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldAnomalyScore))
+}
+
+// AnomalyScoreNotNil applies the NotNil predicate on the "anomaly_score" field.
+func AnomalyScoreNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldAnomalyScore))
+}
+
+// AnomalyReasonEQ applies the EQ predicate on the "anomaly_reason" field.
+func AnomalyReasonEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEQ(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonNEQ applies the NEQ predicate on the "anomaly_reason" field.
+func AnomalyReasonNEQ(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNEQ(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonIn applies the In predicate on the "anomaly_reason" field.
+func AnomalyReasonIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldIn(FieldAnomalyReason, vs...))
+}
+
+// AnomalyReasonNotIn applies the NotIn predicate on the "anomaly_reason" field.
+func AnomalyReasonNotIn(vs ...string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotIn(FieldAnomalyReason, vs...))
+}
+
+// AnomalyReasonGT applies the GT predicate on the "anomaly_reason" field.
+func AnomalyReasonGT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGT(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonGTE applies the GTE predicate on the "anomaly_reason" field.
+func AnomalyReasonGTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldGTE(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonLT applies the LT predicate on the "anomaly_reason" field.
+func AnomalyReasonLT(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLT(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonLTE applies the LTE predicate on the "anomaly_reason" field.
+func AnomalyReasonLTE(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldLTE(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonContains applies the Contains predicate on the "anomaly_reason" field.
+func AnomalyReasonContains(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContains(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonHasPrefix applies the HasPrefix predicate on the "anomaly_reason" field.
+func AnomalyReasonHasPrefix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasPrefix(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonHasSuffix applies the HasSuffix predicate on the "anomaly_reason" field.
+func AnomalyReasonHasSuffix(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldHasSuffix(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonIsNil applies the IsNil predicate on the "anomaly_reason" field.
+func AnomalyReasonIsNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldIsNull(FieldAnomalyReason))
+}
+
+// AnomalyReasonNotNil applies the NotNil predicate on the "anomaly_reason" field.
+func AnomalyReasonNotNil() predicate.Transaction {
+	return predicate.Transaction(sql.FieldNotNull(FieldAnomalyReason))
+}
+
+// AnomalyReasonEqualFold applies the EqualFold predicate on the "anomaly_reason" field.
+func AnomalyReasonEqualFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldEqualFold(FieldAnomalyReason, v))
+}
+
+// AnomalyReasonContainsFold applies the ContainsFold predicate on the "anomaly_reason" field.
+func AnomalyReasonContainsFold(v string) predicate.Transaction {
+	return predicate.Transaction(sql.FieldContainsFold(FieldAnomalyReason, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Transaction {
 	return predicate.Transaction(sql.FieldEQ(FieldCreatedAt, v))
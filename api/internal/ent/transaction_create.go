@@ -32,9 +32,9 @@ func (_c *TransactionCreate) SetAccountID(v uuid.UUID) *TransactionCreate {
 	return _c
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_c *TransactionCreate) SetPlaidID(v string) *TransactionCreate {
-	_c.mutation.SetPlaidID(v)
+// SetExternalID sets the "external_id" field.
+func (_c *TransactionCreate) SetExternalID(v string) *TransactionCreate {
+	_c.mutation.SetExternalID(v)
 	return _c
 }
 
@@ -44,6 +44,20 @@ func (_c *TransactionCreate) SetAmount(v int64) *TransactionCreate {
 	return _c
 }
 
+// SetCurrency sets the "currency" field.
+func (_c *TransactionCreate) SetCurrency(v string) *TransactionCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableCurrency(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
 // SetDate sets the "date" field.
 func (_c *TransactionCreate) SetDate(v time.Time) *TransactionCreate {
 	_c.mutation.SetDate(v)
@@ -84,9 +98,9 @@ func (_c *TransactionCreate) SetNillableCategory(v *string) *TransactionCreate {
 	return _c
 }
 
-// SetPlaidCategories sets the "plaid_categories" field.
-func (_c *TransactionCreate) SetPlaidCategories(v []string) *TransactionCreate {
-	_c.mutation.SetPlaidCategories(v)
+// SetProviderCategories sets the "provider_categories" field.
+func (_c *TransactionCreate) SetProviderCategories(v []string) *TransactionCreate {
+	_c.mutation.SetProviderCategories(v)
 	return _c
 }
 
@@ -104,6 +118,20 @@ func (_c *TransactionCreate) SetNillablePending(v *bool) *TransactionCreate {
 	return _c
 }
 
+// SetPendingTransactionID sets the "pending_transaction_id" field.
+func (_c *TransactionCreate) SetPendingTransactionID(v string) *TransactionCreate {
+	_c.mutation.SetPendingTransactionID(v)
+	return _c
+}
+
+// SetNillablePendingTransactionID sets the "pending_transaction_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillablePendingTransactionID(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetPendingTransactionID(*v)
+	}
+	return _c
+}
+
 // SetPaymentChannel sets the "payment_channel" field.
 func (_c *TransactionCreate) SetPaymentChannel(v string) *TransactionCreate {
 	_c.mutation.SetPaymentChannel(v)
@@ -118,6 +146,62 @@ func (_c *TransactionCreate) SetNillablePaymentChannel(v *string) *TransactionCr
 	return _c
 }
 
+// SetInternalTransfer sets the "internal_transfer" field.
+func (_c *TransactionCreate) SetInternalTransfer(v bool) *TransactionCreate {
+	_c.mutation.SetInternalTransfer(v)
+	return _c
+}
+
+// SetNillableInternalTransfer sets the "internal_transfer" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableInternalTransfer(v *bool) *TransactionCreate {
+	if v != nil {
+		_c.SetInternalTransfer(*v)
+	}
+	return _c
+}
+
+// SetTransferPairID sets the "transfer_pair_id" field.
+func (_c *TransactionCreate) SetTransferPairID(v uuid.UUID) *TransactionCreate {
+	_c.mutation.SetTransferPairID(v)
+	return _c
+}
+
+// SetNillableTransferPairID sets the "transfer_pair_id" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableTransferPairID(v *uuid.UUID) *TransactionCreate {
+	if v != nil {
+		_c.SetTransferPairID(*v)
+	}
+	return _c
+}
+
+// SetAnomalyScore sets the "anomaly_score" field.
+func (_c *TransactionCreate) SetAnomalyScore(v float64) *TransactionCreate {
+	_c.mutation.SetAnomalyScore(v)
+	return _c
+}
+
+// SetNillableAnomalyScore sets the "anomaly_score" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableAnomalyScore(v *float64) *TransactionCreate {
+	if v != nil {
+		_c.SetAnomalyScore(*v)
+	}
+	return _c
+}
+
+// SetAnomalyReason sets the "anomaly_reason" field.
+func (_c *TransactionCreate) SetAnomalyReason(v string) *TransactionCreate {
+	_c.mutation.SetAnomalyReason(v)
+	return _c
+}
+
+// SetNillableAnomalyReason sets the "anomaly_reason" field if the given value is not nil.
+func (_c *TransactionCreate) SetNillableAnomalyReason(v *string) *TransactionCreate {
+	if v != nil {
+		_c.SetAnomalyReason(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *TransactionCreate) SetCreatedAt(v time.Time) *TransactionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -215,6 +299,10 @@ func (_c *TransactionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *TransactionCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := transaction.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
 	if _, ok := _c.mutation.Category(); !ok {
 		v := transaction.DefaultCategory
 		_c.mutation.SetCategory(v)
@@ -223,6 +311,10 @@ func (_c *TransactionCreate) defaults() {
 		v := transaction.DefaultPending
 		_c.mutation.SetPending(v)
 	}
+	if _, ok := _c.mutation.InternalTransfer(); !ok {
+		v := transaction.DefaultInternalTransfer
+		_c.mutation.SetInternalTransfer(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := transaction.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -242,17 +334,20 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.AccountID(); !ok {
 		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "Transaction.account_id"`)}
 	}
-	if _, ok := _c.mutation.PlaidID(); !ok {
-		return &ValidationError{Name: "plaid_id", err: errors.New(`ent: missing required field "Transaction.plaid_id"`)}
+	if _, ok := _c.mutation.ExternalID(); !ok {
+		return &ValidationError{Name: "external_id", err: errors.New(`ent: missing required field "Transaction.external_id"`)}
 	}
-	if v, ok := _c.mutation.PlaidID(); ok {
-		if err := transaction.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.plaid_id": %w`, err)}
+	if v, ok := _c.mutation.ExternalID(); ok {
+		if err := transaction.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.external_id": %w`, err)}
 		}
 	}
 	if _, ok := _c.mutation.Amount(); !ok {
 		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "Transaction.amount"`)}
 	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Transaction.currency"`)}
+	}
 	if _, ok := _c.mutation.Date(); !ok {
 		return &ValidationError{Name: "date", err: errors.New(`ent: missing required field "Transaction.date"`)}
 	}
@@ -270,6 +365,9 @@ func (_c *TransactionCreate) check() error {
 	if _, ok := _c.mutation.Pending(); !ok {
 		return &ValidationError{Name: "pending", err: errors.New(`ent: missing required field "Transaction.pending"`)}
 	}
+	if _, ok := _c.mutation.InternalTransfer(); !ok {
+		return &ValidationError{Name: "internal_transfer", err: errors.New(`ent: missing required field "Transaction.internal_transfer"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Transaction.created_at"`)}
 	}
@@ -315,14 +413,18 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_node.ID = id
 		_spec.ID.Value = &id
 	}
-	if value, ok := _c.mutation.PlaidID(); ok {
-		_spec.SetField(transaction.FieldPlaidID, field.TypeString, value)
-		_node.PlaidID = value
+	if value, ok := _c.mutation.ExternalID(); ok {
+		_spec.SetField(transaction.FieldExternalID, field.TypeString, value)
+		_node.ExternalID = value
 	}
 	if value, ok := _c.mutation.Amount(); ok {
 		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
 		_node.Amount = value
 	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
 	if value, ok := _c.mutation.Date(); ok {
 		_spec.SetField(transaction.FieldDate, field.TypeTime, value)
 		_node.Date = value
@@ -339,18 +441,38 @@ func (_c *TransactionCreate) createSpec() (*Transaction, *sqlgraph.CreateSpec) {
 		_spec.SetField(transaction.FieldCategory, field.TypeString, value)
 		_node.Category = value
 	}
-	if value, ok := _c.mutation.PlaidCategories(); ok {
-		_spec.SetField(transaction.FieldPlaidCategories, field.TypeJSON, value)
-		_node.PlaidCategories = value
+	if value, ok := _c.mutation.ProviderCategories(); ok {
+		_spec.SetField(transaction.FieldProviderCategories, field.TypeJSON, value)
+		_node.ProviderCategories = value
 	}
 	if value, ok := _c.mutation.Pending(); ok {
 		_spec.SetField(transaction.FieldPending, field.TypeBool, value)
 		_node.Pending = value
 	}
+	if value, ok := _c.mutation.PendingTransactionID(); ok {
+		_spec.SetField(transaction.FieldPendingTransactionID, field.TypeString, value)
+		_node.PendingTransactionID = &value
+	}
 	if value, ok := _c.mutation.PaymentChannel(); ok {
 		_spec.SetField(transaction.FieldPaymentChannel, field.TypeString, value)
 		_node.PaymentChannel = value
 	}
+	if value, ok := _c.mutation.InternalTransfer(); ok {
+		_spec.SetField(transaction.FieldInternalTransfer, field.TypeBool, value)
+		_node.InternalTransfer = value
+	}
+	if value, ok := _c.mutation.TransferPairID(); ok {
+		_spec.SetField(transaction.FieldTransferPairID, field.TypeUUID, value)
+		_node.TransferPairID = &value
+	}
+	if value, ok := _c.mutation.AnomalyScore(); ok {
+		_spec.SetField(transaction.FieldAnomalyScore, field.TypeFloat64, value)
+		_node.AnomalyScore = &value
+	}
+	if value, ok := _c.mutation.AnomalyReason(); ok {
+		_spec.SetField(transaction.FieldAnomalyReason, field.TypeString, value)
+		_node.AnomalyReason = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(transaction.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -456,15 +578,15 @@ func (u *TransactionUpsert) UpdateAccountID() *TransactionUpsert {
 	return u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *TransactionUpsert) SetPlaidID(v string) *TransactionUpsert {
-	u.Set(transaction.FieldPlaidID, v)
+// SetExternalID sets the "external_id" field.
+func (u *TransactionUpsert) SetExternalID(v string) *TransactionUpsert {
+	u.Set(transaction.FieldExternalID, v)
 	return u
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *TransactionUpsert) UpdatePlaidID() *TransactionUpsert {
-	u.SetExcluded(transaction.FieldPlaidID)
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateExternalID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldExternalID)
 	return u
 }
 
@@ -486,6 +608,18 @@ func (u *TransactionUpsert) AddAmount(v int64) *TransactionUpsert {
 	return u
 }
 
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsert) SetCurrency(v string) *TransactionUpsert {
+	u.Set(transaction.FieldCurrency, v)
+	return u
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateCurrency() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldCurrency)
+	return u
+}
+
 // SetDate sets the "date" field.
 func (u *TransactionUpsert) SetDate(v time.Time) *TransactionUpsert {
 	u.Set(transaction.FieldDate, v)
@@ -540,21 +674,21 @@ func (u *TransactionUpsert) UpdateCategory() *TransactionUpsert {
 	return u
 }
 
-// SetPlaidCategories sets the "plaid_categories" field.
-func (u *TransactionUpsert) SetPlaidCategories(v []string) *TransactionUpsert {
-	u.Set(transaction.FieldPlaidCategories, v)
+// SetProviderCategories sets the "provider_categories" field.
+func (u *TransactionUpsert) SetProviderCategories(v []string) *TransactionUpsert {
+	u.Set(transaction.FieldProviderCategories, v)
 	return u
 }
 
-// UpdatePlaidCategories sets the "plaid_categories" field to the value that was provided on create.
-func (u *TransactionUpsert) UpdatePlaidCategories() *TransactionUpsert {
-	u.SetExcluded(transaction.FieldPlaidCategories)
+// UpdateProviderCategories sets the "provider_categories" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateProviderCategories() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldProviderCategories)
 	return u
 }
 
-// ClearPlaidCategories clears the value of the "plaid_categories" field.
-func (u *TransactionUpsert) ClearPlaidCategories() *TransactionUpsert {
-	u.SetNull(transaction.FieldPlaidCategories)
+// ClearProviderCategories clears the value of the "provider_categories" field.
+func (u *TransactionUpsert) ClearProviderCategories() *TransactionUpsert {
+	u.SetNull(transaction.FieldProviderCategories)
 	return u
 }
 
@@ -570,6 +704,24 @@ func (u *TransactionUpsert) UpdatePending() *TransactionUpsert {
 	return u
 }
 
+// SetPendingTransactionID sets the "pending_transaction_id" field.
+func (u *TransactionUpsert) SetPendingTransactionID(v string) *TransactionUpsert {
+	u.Set(transaction.FieldPendingTransactionID, v)
+	return u
+}
+
+// UpdatePendingTransactionID sets the "pending_transaction_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdatePendingTransactionID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldPendingTransactionID)
+	return u
+}
+
+// ClearPendingTransactionID clears the value of the "pending_transaction_id" field.
+func (u *TransactionUpsert) ClearPendingTransactionID() *TransactionUpsert {
+	u.SetNull(transaction.FieldPendingTransactionID)
+	return u
+}
+
 // SetPaymentChannel sets the "payment_channel" field.
 func (u *TransactionUpsert) SetPaymentChannel(v string) *TransactionUpsert {
 	u.Set(transaction.FieldPaymentChannel, v)
@@ -588,6 +740,78 @@ func (u *TransactionUpsert) ClearPaymentChannel() *TransactionUpsert {
 	return u
 }
 
+// SetInternalTransfer sets the "internal_transfer" field.
+func (u *TransactionUpsert) SetInternalTransfer(v bool) *TransactionUpsert {
+	u.Set(transaction.FieldInternalTransfer, v)
+	return u
+}
+
+// UpdateInternalTransfer sets the "internal_transfer" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateInternalTransfer() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldInternalTransfer)
+	return u
+}
+
+// SetTransferPairID sets the "transfer_pair_id" field.
+func (u *TransactionUpsert) SetTransferPairID(v uuid.UUID) *TransactionUpsert {
+	u.Set(transaction.FieldTransferPairID, v)
+	return u
+}
+
+// UpdateTransferPairID sets the "transfer_pair_id" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateTransferPairID() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldTransferPairID)
+	return u
+}
+
+// ClearTransferPairID clears the value of the "transfer_pair_id" field.
+func (u *TransactionUpsert) ClearTransferPairID() *TransactionUpsert {
+	u.SetNull(transaction.FieldTransferPairID)
+	return u
+}
+
+// SetAnomalyScore sets the "anomaly_score" field.
+func (u *TransactionUpsert) SetAnomalyScore(v float64) *TransactionUpsert {
+	u.Set(transaction.FieldAnomalyScore, v)
+	return u
+}
+
+// UpdateAnomalyScore sets the "anomaly_score" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateAnomalyScore() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldAnomalyScore)
+	return u
+}
+
+// AddAnomalyScore adds v to the "anomaly_score" field.
+func (u *TransactionUpsert) AddAnomalyScore(v float64) *TransactionUpsert {
+	u.Add(transaction.FieldAnomalyScore, v)
+	return u
+}
+
+// ClearAnomalyScore clears the value of the "anomaly_score" field.
+func (u *TransactionUpsert) ClearAnomalyScore() *TransactionUpsert {
+	u.SetNull(transaction.FieldAnomalyScore)
+	return u
+}
+
+// SetAnomalyReason sets the "anomaly_reason" field.
+func (u *TransactionUpsert) SetAnomalyReason(v string) *TransactionUpsert {
+	u.Set(transaction.FieldAnomalyReason, v)
+	return u
+}
+
+// UpdateAnomalyReason sets the "anomaly_reason" field to the value that was provided on create.
+func (u *TransactionUpsert) UpdateAnomalyReason() *TransactionUpsert {
+	u.SetExcluded(transaction.FieldAnomalyReason)
+	return u
+}
+
+// ClearAnomalyReason clears the value of the "anomaly_reason" field.
+func (u *TransactionUpsert) ClearAnomalyReason() *TransactionUpsert {
+	u.SetNull(transaction.FieldAnomalyReason)
+	return u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TransactionUpsert) SetUpdatedAt(v time.Time) *TransactionUpsert {
 	u.Set(transaction.FieldUpdatedAt, v)
@@ -665,17 +889,17 @@ func (u *TransactionUpsertOne) UpdateAccountID() *TransactionUpsertOne {
 	})
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *TransactionUpsertOne) SetPlaidID(v string) *TransactionUpsertOne {
+// SetExternalID sets the "external_id" field.
+func (u *TransactionUpsertOne) SetExternalID(v string) *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
-		s.SetPlaidID(v)
+		s.SetExternalID(v)
 	})
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *TransactionUpsertOne) UpdatePlaidID() *TransactionUpsertOne {
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateExternalID() *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
-		s.UpdatePlaidID()
+		s.UpdateExternalID()
 	})
 }
 
@@ -700,6 +924,20 @@ func (u *TransactionUpsertOne) UpdateAmount() *TransactionUpsertOne {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsertOne) SetCurrency(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateCurrency() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
 // SetDate sets the "date" field.
 func (u *TransactionUpsertOne) SetDate(v time.Time) *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
@@ -763,24 +1001,24 @@ func (u *TransactionUpsertOne) UpdateCategory() *TransactionUpsertOne {
 	})
 }
 
-// SetPlaidCategories sets the "plaid_categories" field.
-func (u *TransactionUpsertOne) SetPlaidCategories(v []string) *TransactionUpsertOne {
+// SetProviderCategories sets the "provider_categories" field.
+func (u *TransactionUpsertOne) SetProviderCategories(v []string) *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
-		s.SetPlaidCategories(v)
+		s.SetProviderCategories(v)
 	})
 }
 
-// UpdatePlaidCategories sets the "plaid_categories" field to the value that was provided on create.
-func (u *TransactionUpsertOne) UpdatePlaidCategories() *TransactionUpsertOne {
+// UpdateProviderCategories sets the "provider_categories" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateProviderCategories() *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
-		s.UpdatePlaidCategories()
+		s.UpdateProviderCategories()
 	})
 }
 
-// ClearPlaidCategories clears the value of the "plaid_categories" field.
-func (u *TransactionUpsertOne) ClearPlaidCategories() *TransactionUpsertOne {
+// ClearProviderCategories clears the value of the "provider_categories" field.
+func (u *TransactionUpsertOne) ClearProviderCategories() *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
-		s.ClearPlaidCategories()
+		s.ClearProviderCategories()
 	})
 }
 
@@ -798,6 +1036,27 @@ func (u *TransactionUpsertOne) UpdatePending() *TransactionUpsertOne {
 	})
 }
 
+// SetPendingTransactionID sets the "pending_transaction_id" field.
+func (u *TransactionUpsertOne) SetPendingTransactionID(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetPendingTransactionID(v)
+	})
+}
+
+// UpdatePendingTransactionID sets the "pending_transaction_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdatePendingTransactionID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdatePendingTransactionID()
+	})
+}
+
+// ClearPendingTransactionID clears the value of the "pending_transaction_id" field.
+func (u *TransactionUpsertOne) ClearPendingTransactionID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearPendingTransactionID()
+	})
+}
+
 // SetPaymentChannel sets the "payment_channel" field.
 func (u *TransactionUpsertOne) SetPaymentChannel(v string) *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
@@ -819,6 +1078,90 @@ func (u *TransactionUpsertOne) ClearPaymentChannel() *TransactionUpsertOne {
 	})
 }
 
+// SetInternalTransfer sets the "internal_transfer" field.
+func (u *TransactionUpsertOne) SetInternalTransfer(v bool) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetInternalTransfer(v)
+	})
+}
+
+// UpdateInternalTransfer sets the "internal_transfer" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateInternalTransfer() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateInternalTransfer()
+	})
+}
+
+// SetTransferPairID sets the "transfer_pair_id" field.
+func (u *TransactionUpsertOne) SetTransferPairID(v uuid.UUID) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetTransferPairID(v)
+	})
+}
+
+// UpdateTransferPairID sets the "transfer_pair_id" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateTransferPairID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateTransferPairID()
+	})
+}
+
+// ClearTransferPairID clears the value of the "transfer_pair_id" field.
+func (u *TransactionUpsertOne) ClearTransferPairID() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearTransferPairID()
+	})
+}
+
+// SetAnomalyScore sets the "anomaly_score" field.
+func (u *TransactionUpsertOne) SetAnomalyScore(v float64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAnomalyScore(v)
+	})
+}
+
+// AddAnomalyScore adds v to the "anomaly_score" field.
+func (u *TransactionUpsertOne) AddAnomalyScore(v float64) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAnomalyScore(v)
+	})
+}
+
+// UpdateAnomalyScore sets the "anomaly_score" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateAnomalyScore() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAnomalyScore()
+	})
+}
+
+// ClearAnomalyScore clears the value of the "anomaly_score" field.
+func (u *TransactionUpsertOne) ClearAnomalyScore() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearAnomalyScore()
+	})
+}
+
+// SetAnomalyReason sets the "anomaly_reason" field.
+func (u *TransactionUpsertOne) SetAnomalyReason(v string) *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAnomalyReason(v)
+	})
+}
+
+// UpdateAnomalyReason sets the "anomaly_reason" field to the value that was provided on create.
+func (u *TransactionUpsertOne) UpdateAnomalyReason() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAnomalyReason()
+	})
+}
+
+// ClearAnomalyReason clears the value of the "anomaly_reason" field.
+func (u *TransactionUpsertOne) ClearAnomalyReason() *TransactionUpsertOne {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearAnomalyReason()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TransactionUpsertOne) SetUpdatedAt(v time.Time) *TransactionUpsertOne {
 	return u.Update(func(s *TransactionUpsert) {
@@ -1065,17 +1408,17 @@ func (u *TransactionUpsertBulk) UpdateAccountID() *TransactionUpsertBulk {
 	})
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (u *TransactionUpsertBulk) SetPlaidID(v string) *TransactionUpsertBulk {
+// SetExternalID sets the "external_id" field.
+func (u *TransactionUpsertBulk) SetExternalID(v string) *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
-		s.SetPlaidID(v)
+		s.SetExternalID(v)
 	})
 }
 
-// UpdatePlaidID sets the "plaid_id" field to the value that was provided on create.
-func (u *TransactionUpsertBulk) UpdatePlaidID() *TransactionUpsertBulk {
+// UpdateExternalID sets the "external_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateExternalID() *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
-		s.UpdatePlaidID()
+		s.UpdateExternalID()
 	})
 }
 
@@ -1100,6 +1443,20 @@ func (u *TransactionUpsertBulk) UpdateAmount() *TransactionUpsertBulk {
 	})
 }
 
+// SetCurrency sets the "currency" field.
+func (u *TransactionUpsertBulk) SetCurrency(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetCurrency(v)
+	})
+}
+
+// UpdateCurrency sets the "currency" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateCurrency() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateCurrency()
+	})
+}
+
 // SetDate sets the "date" field.
 func (u *TransactionUpsertBulk) SetDate(v time.Time) *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
@@ -1163,24 +1520,24 @@ func (u *TransactionUpsertBulk) UpdateCategory() *TransactionUpsertBulk {
 	})
 }
 
-// SetPlaidCategories sets the "plaid_categories" field.
-func (u *TransactionUpsertBulk) SetPlaidCategories(v []string) *TransactionUpsertBulk {
+// SetProviderCategories sets the "provider_categories" field.
+func (u *TransactionUpsertBulk) SetProviderCategories(v []string) *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
-		s.SetPlaidCategories(v)
+		s.SetProviderCategories(v)
 	})
 }
 
-// UpdatePlaidCategories sets the "plaid_categories" field to the value that was provided on create.
-func (u *TransactionUpsertBulk) UpdatePlaidCategories() *TransactionUpsertBulk {
+// UpdateProviderCategories sets the "provider_categories" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateProviderCategories() *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
-		s.UpdatePlaidCategories()
+		s.UpdateProviderCategories()
 	})
 }
 
-// ClearPlaidCategories clears the value of the "plaid_categories" field.
-func (u *TransactionUpsertBulk) ClearPlaidCategories() *TransactionUpsertBulk {
+// ClearProviderCategories clears the value of the "provider_categories" field.
+func (u *TransactionUpsertBulk) ClearProviderCategories() *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
-		s.ClearPlaidCategories()
+		s.ClearProviderCategories()
 	})
 }
 
@@ -1198,6 +1555,27 @@ func (u *TransactionUpsertBulk) UpdatePending() *TransactionUpsertBulk {
 	})
 }
 
+// SetPendingTransactionID sets the "pending_transaction_id" field.
+func (u *TransactionUpsertBulk) SetPendingTransactionID(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetPendingTransactionID(v)
+	})
+}
+
+// UpdatePendingTransactionID sets the "pending_transaction_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdatePendingTransactionID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdatePendingTransactionID()
+	})
+}
+
+// ClearPendingTransactionID clears the value of the "pending_transaction_id" field.
+func (u *TransactionUpsertBulk) ClearPendingTransactionID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearPendingTransactionID()
+	})
+}
+
 // SetPaymentChannel sets the "payment_channel" field.
 func (u *TransactionUpsertBulk) SetPaymentChannel(v string) *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
@@ -1219,6 +1597,90 @@ func (u *TransactionUpsertBulk) ClearPaymentChannel() *TransactionUpsertBulk {
 	})
 }
 
+// SetInternalTransfer sets the "internal_transfer" field.
+func (u *TransactionUpsertBulk) SetInternalTransfer(v bool) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetInternalTransfer(v)
+	})
+}
+
+// UpdateInternalTransfer sets the "internal_transfer" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateInternalTransfer() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateInternalTransfer()
+	})
+}
+
+// SetTransferPairID sets the "transfer_pair_id" field.
+func (u *TransactionUpsertBulk) SetTransferPairID(v uuid.UUID) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetTransferPairID(v)
+	})
+}
+
+// UpdateTransferPairID sets the "transfer_pair_id" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateTransferPairID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateTransferPairID()
+	})
+}
+
+// ClearTransferPairID clears the value of the "transfer_pair_id" field.
+func (u *TransactionUpsertBulk) ClearTransferPairID() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearTransferPairID()
+	})
+}
+
+// SetAnomalyScore sets the "anomaly_score" field.
+func (u *TransactionUpsertBulk) SetAnomalyScore(v float64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAnomalyScore(v)
+	})
+}
+
+// AddAnomalyScore adds v to the "anomaly_score" field.
+func (u *TransactionUpsertBulk) AddAnomalyScore(v float64) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.AddAnomalyScore(v)
+	})
+}
+
+// UpdateAnomalyScore sets the "anomaly_score" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateAnomalyScore() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAnomalyScore()
+	})
+}
+
+// ClearAnomalyScore clears the value of the "anomaly_score" field.
+func (u *TransactionUpsertBulk) ClearAnomalyScore() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearAnomalyScore()
+	})
+}
+
+// SetAnomalyReason sets the "anomaly_reason" field.
+func (u *TransactionUpsertBulk) SetAnomalyReason(v string) *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.SetAnomalyReason(v)
+	})
+}
+
+// UpdateAnomalyReason sets the "anomaly_reason" field to the value that was provided on create.
+func (u *TransactionUpsertBulk) UpdateAnomalyReason() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.UpdateAnomalyReason()
+	})
+}
+
+// ClearAnomalyReason clears the value of the "anomaly_reason" field.
+func (u *TransactionUpsertBulk) ClearAnomalyReason() *TransactionUpsertBulk {
+	return u.Update(func(s *TransactionUpsert) {
+		s.ClearAnomalyReason()
+	})
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (u *TransactionUpsertBulk) SetUpdatedAt(v time.Time) *TransactionUpsertBulk {
 	return u.Update(func(s *TransactionUpsert) {
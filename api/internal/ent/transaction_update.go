@@ -47,16 +47,16 @@ func (_u *TransactionUpdate) SetNillableAccountID(v *uuid.UUID) *TransactionUpda
 	return _u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_u *TransactionUpdate) SetPlaidID(v string) *TransactionUpdate {
-	_u.mutation.SetPlaidID(v)
+// SetExternalID sets the "external_id" field.
+func (_u *TransactionUpdate) SetExternalID(v string) *TransactionUpdate {
+	_u.mutation.SetExternalID(v)
 	return _u
 }
 
-// SetNillablePlaidID sets the "plaid_id" field if the given value is not nil.
-func (_u *TransactionUpdate) SetNillablePlaidID(v *string) *TransactionUpdate {
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableExternalID(v *string) *TransactionUpdate {
 	if v != nil {
-		_u.SetPlaidID(*v)
+		_u.SetExternalID(*v)
 	}
 	return _u
 }
@@ -82,6 +82,20 @@ func (_u *TransactionUpdate) AddAmount(v int64) *TransactionUpdate {
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *TransactionUpdate) SetCurrency(v string) *TransactionUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableCurrency(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
 // SetDate sets the "date" field.
 func (_u *TransactionUpdate) SetDate(v time.Time) *TransactionUpdate {
 	_u.mutation.SetDate(v)
@@ -144,21 +158,21 @@ func (_u *TransactionUpdate) SetNillableCategory(v *string) *TransactionUpdate {
 	return _u
 }
 
-// SetPlaidCategories sets the "plaid_categories" field.
-func (_u *TransactionUpdate) SetPlaidCategories(v []string) *TransactionUpdate {
-	_u.mutation.SetPlaidCategories(v)
+// SetProviderCategories sets the "provider_categories" field.
+func (_u *TransactionUpdate) SetProviderCategories(v []string) *TransactionUpdate {
+	_u.mutation.SetProviderCategories(v)
 	return _u
 }
 
-// AppendPlaidCategories appends value to the "plaid_categories" field.
-func (_u *TransactionUpdate) AppendPlaidCategories(v []string) *TransactionUpdate {
-	_u.mutation.AppendPlaidCategories(v)
+// AppendProviderCategories appends value to the "provider_categories" field.
+func (_u *TransactionUpdate) AppendProviderCategories(v []string) *TransactionUpdate {
+	_u.mutation.AppendProviderCategories(v)
 	return _u
 }
 
-// ClearPlaidCategories clears the value of the "plaid_categories" field.
-func (_u *TransactionUpdate) ClearPlaidCategories() *TransactionUpdate {
-	_u.mutation.ClearPlaidCategories()
+// ClearProviderCategories clears the value of the "provider_categories" field.
+func (_u *TransactionUpdate) ClearProviderCategories() *TransactionUpdate {
+	_u.mutation.ClearProviderCategories()
 	return _u
 }
 
@@ -176,6 +190,26 @@ func (_u *TransactionUpdate) SetNillablePending(v *bool) *TransactionUpdate {
 	return _u
 }
 
+// SetPendingTransactionID sets the "pending_transaction_id" field.
+func (_u *TransactionUpdate) SetPendingTransactionID(v string) *TransactionUpdate {
+	_u.mutation.SetPendingTransactionID(v)
+	return _u
+}
+
+// SetNillablePendingTransactionID sets the "pending_transaction_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillablePendingTransactionID(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetPendingTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearPendingTransactionID clears the value of the "pending_transaction_id" field.
+func (_u *TransactionUpdate) ClearPendingTransactionID() *TransactionUpdate {
+	_u.mutation.ClearPendingTransactionID()
+	return _u
+}
+
 // SetPaymentChannel sets the "payment_channel" field.
 func (_u *TransactionUpdate) SetPaymentChannel(v string) *TransactionUpdate {
 	_u.mutation.SetPaymentChannel(v)
@@ -196,6 +230,87 @@ func (_u *TransactionUpdate) ClearPaymentChannel() *TransactionUpdate {
 	return _u
 }
 
+// SetInternalTransfer sets the "internal_transfer" field.
+func (_u *TransactionUpdate) SetInternalTransfer(v bool) *TransactionUpdate {
+	_u.mutation.SetInternalTransfer(v)
+	return _u
+}
+
+// SetNillableInternalTransfer sets the "internal_transfer" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableInternalTransfer(v *bool) *TransactionUpdate {
+	if v != nil {
+		_u.SetInternalTransfer(*v)
+	}
+	return _u
+}
+
+// SetTransferPairID sets the "transfer_pair_id" field.
+func (_u *TransactionUpdate) SetTransferPairID(v uuid.UUID) *TransactionUpdate {
+	_u.mutation.SetTransferPairID(v)
+	return _u
+}
+
+// SetNillableTransferPairID sets the "transfer_pair_id" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableTransferPairID(v *uuid.UUID) *TransactionUpdate {
+	if v != nil {
+		_u.SetTransferPairID(*v)
+	}
+	return _u
+}
+
+// ClearTransferPairID clears the value of the "transfer_pair_id" field.
+func (_u *TransactionUpdate) ClearTransferPairID() *TransactionUpdate {
+	_u.mutation.ClearTransferPairID()
+	return _u
+}
+
+// SetAnomalyScore sets the "anomaly_score" field.
+func (_u *TransactionUpdate) SetAnomalyScore(v float64) *TransactionUpdate {
+	_u.mutation.ResetAnomalyScore()
+	_u.mutation.SetAnomalyScore(v)
+	return _u
+}
+
+// SetNillableAnomalyScore sets the "anomaly_score" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableAnomalyScore(v *float64) *TransactionUpdate {
+	if v != nil {
+		_u.SetAnomalyScore(*v)
+	}
+	return _u
+}
+
+// AddAnomalyScore adds value to the "anomaly_score" field.
+func (_u *TransactionUpdate) AddAnomalyScore(v float64) *TransactionUpdate {
+	_u.mutation.AddAnomalyScore(v)
+	return _u
+}
+
+// ClearAnomalyScore clears the value of the "anomaly_score" field.
+func (_u *TransactionUpdate) ClearAnomalyScore() *TransactionUpdate {
+	_u.mutation.ClearAnomalyScore()
+	return _u
+}
+
+// SetAnomalyReason sets the "anomaly_reason" field.
+func (_u *TransactionUpdate) SetAnomalyReason(v string) *TransactionUpdate {
+	_u.mutation.SetAnomalyReason(v)
+	return _u
+}
+
+// SetNillableAnomalyReason sets the "anomaly_reason" field if the given value is not nil.
+func (_u *TransactionUpdate) SetNillableAnomalyReason(v *string) *TransactionUpdate {
+	if v != nil {
+		_u.SetAnomalyReason(*v)
+	}
+	return _u
+}
+
+// ClearAnomalyReason clears the value of the "anomaly_reason" field.
+func (_u *TransactionUpdate) ClearAnomalyReason() *TransactionUpdate {
+	_u.mutation.ClearAnomalyReason()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TransactionUpdate) SetUpdatedAt(v time.Time) *TransactionUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -292,9 +407,9 @@ func (_u *TransactionUpdate) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *TransactionUpdate) check() error {
-	if v, ok := _u.mutation.PlaidID(); ok {
-		if err := transaction.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.plaid_id": %w`, err)}
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := transaction.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.external_id": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.Name(); ok {
@@ -326,8 +441,8 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 			}
 		}
 	}
-	if value, ok := _u.mutation.PlaidID(); ok {
-		_spec.SetField(transaction.FieldPlaidID, field.TypeString, value)
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(transaction.FieldExternalID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Amount(); ok {
 		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
@@ -335,6 +450,9 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.AddedAmount(); ok {
 		_spec.AddField(transaction.FieldAmount, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Date(); ok {
 		_spec.SetField(transaction.FieldDate, field.TypeTime, value)
 	}
@@ -350,26 +468,56 @@ func (_u *TransactionUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.Category(); ok {
 		_spec.SetField(transaction.FieldCategory, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.PlaidCategories(); ok {
-		_spec.SetField(transaction.FieldPlaidCategories, field.TypeJSON, value)
+	if value, ok := _u.mutation.ProviderCategories(); ok {
+		_spec.SetField(transaction.FieldProviderCategories, field.TypeJSON, value)
 	}
-	if value, ok := _u.mutation.AppendedPlaidCategories(); ok {
+	if value, ok := _u.mutation.AppendedProviderCategories(); ok {
 		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, transaction.FieldPlaidCategories, value)
+			sqljson.Append(u, transaction.FieldProviderCategories, value)
 		})
 	}
-	if _u.mutation.PlaidCategoriesCleared() {
-		_spec.ClearField(transaction.FieldPlaidCategories, field.TypeJSON)
+	if _u.mutation.ProviderCategoriesCleared() {
+		_spec.ClearField(transaction.FieldProviderCategories, field.TypeJSON)
 	}
 	if value, ok := _u.mutation.Pending(); ok {
 		_spec.SetField(transaction.FieldPending, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PendingTransactionID(); ok {
+		_spec.SetField(transaction.FieldPendingTransactionID, field.TypeString, value)
+	}
+	if _u.mutation.PendingTransactionIDCleared() {
+		_spec.ClearField(transaction.FieldPendingTransactionID, field.TypeString)
+	}
 	if value, ok := _u.mutation.PaymentChannel(); ok {
 		_spec.SetField(transaction.FieldPaymentChannel, field.TypeString, value)
 	}
 	if _u.mutation.PaymentChannelCleared() {
 		_spec.ClearField(transaction.FieldPaymentChannel, field.TypeString)
 	}
+	if value, ok := _u.mutation.InternalTransfer(); ok {
+		_spec.SetField(transaction.FieldInternalTransfer, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TransferPairID(); ok {
+		_spec.SetField(transaction.FieldTransferPairID, field.TypeUUID, value)
+	}
+	if _u.mutation.TransferPairIDCleared() {
+		_spec.ClearField(transaction.FieldTransferPairID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AnomalyScore(); ok {
+		_spec.SetField(transaction.FieldAnomalyScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAnomalyScore(); ok {
+		_spec.AddField(transaction.FieldAnomalyScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.AnomalyScoreCleared() {
+		_spec.ClearField(transaction.FieldAnomalyScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AnomalyReason(); ok {
+		_spec.SetField(transaction.FieldAnomalyReason, field.TypeString, value)
+	}
+	if _u.mutation.AnomalyReasonCleared() {
+		_spec.ClearField(transaction.FieldAnomalyReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -483,16 +631,16 @@ func (_u *TransactionUpdateOne) SetNillableAccountID(v *uuid.UUID) *TransactionU
 	return _u
 }
 
-// SetPlaidID sets the "plaid_id" field.
-func (_u *TransactionUpdateOne) SetPlaidID(v string) *TransactionUpdateOne {
-	_u.mutation.SetPlaidID(v)
+// SetExternalID sets the "external_id" field.
+func (_u *TransactionUpdateOne) SetExternalID(v string) *TransactionUpdateOne {
+	_u.mutation.SetExternalID(v)
 	return _u
 }
 
-// SetNillablePlaidID sets the "plaid_id" field if the given value is not nil.
-func (_u *TransactionUpdateOne) SetNillablePlaidID(v *string) *TransactionUpdateOne {
+// SetNillableExternalID sets the "external_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableExternalID(v *string) *TransactionUpdateOne {
 	if v != nil {
-		_u.SetPlaidID(*v)
+		_u.SetExternalID(*v)
 	}
 	return _u
 }
@@ -518,6 +666,20 @@ func (_u *TransactionUpdateOne) AddAmount(v int64) *TransactionUpdateOne {
 	return _u
 }
 
+// SetCurrency sets the "currency" field.
+func (_u *TransactionUpdateOne) SetCurrency(v string) *TransactionUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableCurrency(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
 // SetDate sets the "date" field.
 func (_u *TransactionUpdateOne) SetDate(v time.Time) *TransactionUpdateOne {
 	_u.mutation.SetDate(v)
@@ -580,21 +742,21 @@ func (_u *TransactionUpdateOne) SetNillableCategory(v *string) *TransactionUpdat
 	return _u
 }
 
-// SetPlaidCategories sets the "plaid_categories" field.
-func (_u *TransactionUpdateOne) SetPlaidCategories(v []string) *TransactionUpdateOne {
-	_u.mutation.SetPlaidCategories(v)
+// SetProviderCategories sets the "provider_categories" field.
+func (_u *TransactionUpdateOne) SetProviderCategories(v []string) *TransactionUpdateOne {
+	_u.mutation.SetProviderCategories(v)
 	return _u
 }
 
-// AppendPlaidCategories appends value to the "plaid_categories" field.
-func (_u *TransactionUpdateOne) AppendPlaidCategories(v []string) *TransactionUpdateOne {
-	_u.mutation.AppendPlaidCategories(v)
+// AppendProviderCategories appends value to the "provider_categories" field.
+func (_u *TransactionUpdateOne) AppendProviderCategories(v []string) *TransactionUpdateOne {
+	_u.mutation.AppendProviderCategories(v)
 	return _u
 }
 
-// ClearPlaidCategories clears the value of the "plaid_categories" field.
-func (_u *TransactionUpdateOne) ClearPlaidCategories() *TransactionUpdateOne {
-	_u.mutation.ClearPlaidCategories()
+// ClearProviderCategories clears the value of the "provider_categories" field.
+func (_u *TransactionUpdateOne) ClearProviderCategories() *TransactionUpdateOne {
+	_u.mutation.ClearProviderCategories()
 	return _u
 }
 
@@ -612,6 +774,26 @@ func (_u *TransactionUpdateOne) SetNillablePending(v *bool) *TransactionUpdateOn
 	return _u
 }
 
+// SetPendingTransactionID sets the "pending_transaction_id" field.
+func (_u *TransactionUpdateOne) SetPendingTransactionID(v string) *TransactionUpdateOne {
+	_u.mutation.SetPendingTransactionID(v)
+	return _u
+}
+
+// SetNillablePendingTransactionID sets the "pending_transaction_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillablePendingTransactionID(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetPendingTransactionID(*v)
+	}
+	return _u
+}
+
+// ClearPendingTransactionID clears the value of the "pending_transaction_id" field.
+func (_u *TransactionUpdateOne) ClearPendingTransactionID() *TransactionUpdateOne {
+	_u.mutation.ClearPendingTransactionID()
+	return _u
+}
+
 // SetPaymentChannel sets the "payment_channel" field.
 func (_u *TransactionUpdateOne) SetPaymentChannel(v string) *TransactionUpdateOne {
 	_u.mutation.SetPaymentChannel(v)
@@ -632,6 +814,87 @@ func (_u *TransactionUpdateOne) ClearPaymentChannel() *TransactionUpdateOne {
 	return _u
 }
 
+// SetInternalTransfer sets the "internal_transfer" field.
+func (_u *TransactionUpdateOne) SetInternalTransfer(v bool) *TransactionUpdateOne {
+	_u.mutation.SetInternalTransfer(v)
+	return _u
+}
+
+// SetNillableInternalTransfer sets the "internal_transfer" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableInternalTransfer(v *bool) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetInternalTransfer(*v)
+	}
+	return _u
+}
+
+// SetTransferPairID sets the "transfer_pair_id" field.
+func (_u *TransactionUpdateOne) SetTransferPairID(v uuid.UUID) *TransactionUpdateOne {
+	_u.mutation.SetTransferPairID(v)
+	return _u
+}
+
+// SetNillableTransferPairID sets the "transfer_pair_id" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableTransferPairID(v *uuid.UUID) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetTransferPairID(*v)
+	}
+	return _u
+}
+
+// ClearTransferPairID clears the value of the "transfer_pair_id" field.
+func (_u *TransactionUpdateOne) ClearTransferPairID() *TransactionUpdateOne {
+	_u.mutation.ClearTransferPairID()
+	return _u
+}
+
+// SetAnomalyScore sets the "anomaly_score" field.
+func (_u *TransactionUpdateOne) SetAnomalyScore(v float64) *TransactionUpdateOne {
+	_u.mutation.ResetAnomalyScore()
+	_u.mutation.SetAnomalyScore(v)
+	return _u
+}
+
+// SetNillableAnomalyScore sets the "anomaly_score" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableAnomalyScore(v *float64) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetAnomalyScore(*v)
+	}
+	return _u
+}
+
+// AddAnomalyScore adds value to the "anomaly_score" field.
+func (_u *TransactionUpdateOne) AddAnomalyScore(v float64) *TransactionUpdateOne {
+	_u.mutation.AddAnomalyScore(v)
+	return _u
+}
+
+// ClearAnomalyScore clears the value of the "anomaly_score" field.
+func (_u *TransactionUpdateOne) ClearAnomalyScore() *TransactionUpdateOne {
+	_u.mutation.ClearAnomalyScore()
+	return _u
+}
+
+// SetAnomalyReason sets the "anomaly_reason" field.
+func (_u *TransactionUpdateOne) SetAnomalyReason(v string) *TransactionUpdateOne {
+	_u.mutation.SetAnomalyReason(v)
+	return _u
+}
+
+// SetNillableAnomalyReason sets the "anomaly_reason" field if the given value is not nil.
+func (_u *TransactionUpdateOne) SetNillableAnomalyReason(v *string) *TransactionUpdateOne {
+	if v != nil {
+		_u.SetAnomalyReason(*v)
+	}
+	return _u
+}
+
+// ClearAnomalyReason clears the value of the "anomaly_reason" field.
+func (_u *TransactionUpdateOne) ClearAnomalyReason() *TransactionUpdateOne {
+	_u.mutation.ClearAnomalyReason()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *TransactionUpdateOne) SetUpdatedAt(v time.Time) *TransactionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -741,9 +1004,9 @@ func (_u *TransactionUpdateOne) defaults() {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *TransactionUpdateOne) check() error {
-	if v, ok := _u.mutation.PlaidID(); ok {
-		if err := transaction.PlaidIDValidator(v); err != nil {
-			return &ValidationError{Name: "plaid_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.plaid_id": %w`, err)}
+	if v, ok := _u.mutation.ExternalID(); ok {
+		if err := transaction.ExternalIDValidator(v); err != nil {
+			return &ValidationError{Name: "external_id", err: fmt.Errorf(`ent: validator failed for field "Transaction.external_id": %w`, err)}
 		}
 	}
 	if v, ok := _u.mutation.Name(); ok {
@@ -792,8 +1055,8 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 			}
 		}
 	}
-	if value, ok := _u.mutation.PlaidID(); ok {
-		_spec.SetField(transaction.FieldPlaidID, field.TypeString, value)
+	if value, ok := _u.mutation.ExternalID(); ok {
+		_spec.SetField(transaction.FieldExternalID, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.Amount(); ok {
 		_spec.SetField(transaction.FieldAmount, field.TypeInt64, value)
@@ -801,6 +1064,9 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.AddedAmount(); ok {
 		_spec.AddField(transaction.FieldAmount, field.TypeInt64, value)
 	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(transaction.FieldCurrency, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.Date(); ok {
 		_spec.SetField(transaction.FieldDate, field.TypeTime, value)
 	}
@@ -816,26 +1082,56 @@ func (_u *TransactionUpdateOne) sqlSave(ctx context.Context) (_node *Transaction
 	if value, ok := _u.mutation.Category(); ok {
 		_spec.SetField(transaction.FieldCategory, field.TypeString, value)
 	}
-	if value, ok := _u.mutation.PlaidCategories(); ok {
-		_spec.SetField(transaction.FieldPlaidCategories, field.TypeJSON, value)
+	if value, ok := _u.mutation.ProviderCategories(); ok {
+		_spec.SetField(transaction.FieldProviderCategories, field.TypeJSON, value)
 	}
-	if value, ok := _u.mutation.AppendedPlaidCategories(); ok {
+	if value, ok := _u.mutation.AppendedProviderCategories(); ok {
 		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, transaction.FieldPlaidCategories, value)
+			sqljson.Append(u, transaction.FieldProviderCategories, value)
 		})
 	}
-	if _u.mutation.PlaidCategoriesCleared() {
-		_spec.ClearField(transaction.FieldPlaidCategories, field.TypeJSON)
+	if _u.mutation.ProviderCategoriesCleared() {
+		_spec.ClearField(transaction.FieldProviderCategories, field.TypeJSON)
 	}
 	if value, ok := _u.mutation.Pending(); ok {
 		_spec.SetField(transaction.FieldPending, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.PendingTransactionID(); ok {
+		_spec.SetField(transaction.FieldPendingTransactionID, field.TypeString, value)
+	}
+	if _u.mutation.PendingTransactionIDCleared() {
+		_spec.ClearField(transaction.FieldPendingTransactionID, field.TypeString)
+	}
 	if value, ok := _u.mutation.PaymentChannel(); ok {
 		_spec.SetField(transaction.FieldPaymentChannel, field.TypeString, value)
 	}
 	if _u.mutation.PaymentChannelCleared() {
 		_spec.ClearField(transaction.FieldPaymentChannel, field.TypeString)
 	}
+	if value, ok := _u.mutation.InternalTransfer(); ok {
+		_spec.SetField(transaction.FieldInternalTransfer, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.TransferPairID(); ok {
+		_spec.SetField(transaction.FieldTransferPairID, field.TypeUUID, value)
+	}
+	if _u.mutation.TransferPairIDCleared() {
+		_spec.ClearField(transaction.FieldTransferPairID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.AnomalyScore(); ok {
+		_spec.SetField(transaction.FieldAnomalyScore, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedAnomalyScore(); ok {
+		_spec.AddField(transaction.FieldAnomalyScore, field.TypeFloat64, value)
+	}
+	if _u.mutation.AnomalyScoreCleared() {
+		_spec.ClearField(transaction.FieldAnomalyScore, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.AnomalyReason(); ok {
+		_spec.SetField(transaction.FieldAnomalyReason, field.TypeString, value)
+	}
+	if _u.mutation.AnomalyReasonCleared() {
+		_spec.ClearField(transaction.FieldAnomalyReason, field.TypeString)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(transaction.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -16,10 +16,28 @@ type Tx struct {
 	config
 	// Account is the client for interacting with the Account builders.
 	Account *AccountClient
+	// BackfillJob is the client for interacting with the BackfillJob builders.
+	BackfillJob *BackfillJobClient
+	// BalanceSnapshot is the client for interacting with the BalanceSnapshot builders.
+	BalanceSnapshot *BalanceSnapshotClient
+	// Budget is the client for interacting with the Budget builders.
+	Budget *BudgetClient
+	// BudgetAlert is the client for interacting with the BudgetAlert builders.
+	BudgetAlert *BudgetAlertClient
+	// Family is the client for interacting with the Family builders.
+	Family *FamilyClient
+	// FamilyMember is the client for interacting with the FamilyMember builders.
+	FamilyMember *FamilyMemberClient
+	// Goal is the client for interacting with the Goal builders.
+	Goal *GoalClient
 	// Item is the client for interacting with the Item builders.
 	Item *ItemClient
+	// MonthlyReport is the client for interacting with the MonthlyReport builders.
+	MonthlyReport *MonthlyReportClient
 	// PushSubscription is the client for interacting with the PushSubscription builders.
 	PushSubscription *PushSubscriptionClient
+	// RecurringCharge is the client for interacting with the RecurringCharge builders.
+	RecurringCharge *RecurringChargeClient
 	// Rule is the client for interacting with the Rule builders.
 	Rule *RuleClient
 	// RuleExecution is the client for interacting with the RuleExecution builders.
@@ -28,6 +46,8 @@ type Tx struct {
 	SavingsTransfer *SavingsTransferClient
 	// SyncCursor is the client for interacting with the SyncCursor builders.
 	SyncCursor *SyncCursorClient
+	// SyncRun is the client for interacting with the SyncRun builders.
+	SyncRun *SyncRunClient
 	// Transaction is the client for interacting with the Transaction builders.
 	Transaction *TransactionClient
 	// User is the client for interacting with the User builders.
@@ -164,12 +184,22 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Account = NewAccountClient(tx.config)
+	tx.BackfillJob = NewBackfillJobClient(tx.config)
+	tx.BalanceSnapshot = NewBalanceSnapshotClient(tx.config)
+	tx.Budget = NewBudgetClient(tx.config)
+	tx.BudgetAlert = NewBudgetAlertClient(tx.config)
+	tx.Family = NewFamilyClient(tx.config)
+	tx.FamilyMember = NewFamilyMemberClient(tx.config)
+	tx.Goal = NewGoalClient(tx.config)
 	tx.Item = NewItemClient(tx.config)
+	tx.MonthlyReport = NewMonthlyReportClient(tx.config)
 	tx.PushSubscription = NewPushSubscriptionClient(tx.config)
+	tx.RecurringCharge = NewRecurringChargeClient(tx.config)
 	tx.Rule = NewRuleClient(tx.config)
 	tx.RuleExecution = NewRuleExecutionClient(tx.config)
 	tx.SavingsTransfer = NewSavingsTransferClient(tx.config)
 	tx.SyncCursor = NewSyncCursorClient(tx.config)
+	tx.SyncRun = NewSyncRunClient(tx.config)
 	tx.Transaction = NewTransactionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
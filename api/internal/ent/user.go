@@ -4,6 +4,7 @@ package ent
 
 import (
 	"fmt"
+	"regulation/internal/ent/familymember"
 	"regulation/internal/ent/user"
 	"strings"
 
@@ -25,6 +26,8 @@ type User struct {
 	CustodyAccountID *uuid.UUID `json:"custody_account_id,omitempty"`
 	// Nickname holds the value of the "nickname" field.
 	Nickname string `json:"nickname,omitempty"`
+	// ISO 4217 currency totals are reported in
+	HomeCurrency string `json:"home_currency,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -49,9 +52,19 @@ type UserEdges struct {
 	RuleExecutions []*RuleExecution `json:"rule_executions,omitempty"`
 	// SavingsTransfers holds the value of the savings_transfers edge.
 	SavingsTransfers []*SavingsTransfer `json:"savings_transfers,omitempty"`
+	// FamilyMembership holds the value of the family_membership edge.
+	FamilyMembership *FamilyMember `json:"family_membership,omitempty"`
+	// Goals holds the value of the goals edge.
+	Goals []*Goal `json:"goals,omitempty"`
+	// MonthlyReports holds the value of the monthly_reports edge.
+	MonthlyReports []*MonthlyReport `json:"monthly_reports,omitempty"`
+	// Budgets holds the value of the budgets edge.
+	Budgets []*Budget `json:"budgets,omitempty"`
+	// RecurringCharges holds the value of the recurring_charges edge.
+	RecurringCharges []*RecurringCharge `json:"recurring_charges,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [8]bool
+	loadedTypes [13]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -128,6 +141,53 @@ func (e UserEdges) SavingsTransfersOrErr() ([]*SavingsTransfer, error) {
 	return nil, &NotLoadedError{edge: "savings_transfers"}
 }
 
+// FamilyMembershipOrErr returns the FamilyMembership value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e UserEdges) FamilyMembershipOrErr() (*FamilyMember, error) {
+	if e.FamilyMembership != nil {
+		return e.FamilyMembership, nil
+	} else if e.loadedTypes[8] {
+		return nil, &NotFoundError{label: familymember.Label}
+	}
+	return nil, &NotLoadedError{edge: "family_membership"}
+}
+
+// GoalsOrErr returns the Goals value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) GoalsOrErr() ([]*Goal, error) {
+	if e.loadedTypes[9] {
+		return e.Goals, nil
+	}
+	return nil, &NotLoadedError{edge: "goals"}
+}
+
+// MonthlyReportsOrErr returns the MonthlyReports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) MonthlyReportsOrErr() ([]*MonthlyReport, error) {
+	if e.loadedTypes[10] {
+		return e.MonthlyReports, nil
+	}
+	return nil, &NotLoadedError{edge: "monthly_reports"}
+}
+
+// BudgetsOrErr returns the Budgets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) BudgetsOrErr() ([]*Budget, error) {
+	if e.loadedTypes[11] {
+		return e.Budgets, nil
+	}
+	return nil, &NotLoadedError{edge: "budgets"}
+}
+
+// RecurringChargesOrErr returns the RecurringCharges value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RecurringChargesOrErr() ([]*RecurringCharge, error) {
+	if e.loadedTypes[12] {
+		return e.RecurringCharges, nil
+	}
+	return nil, &NotLoadedError{edge: "recurring_charges"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -137,7 +197,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case user.FieldPassword:
 			values[i] = new([]byte)
-		case user.FieldEmail, user.FieldNickname:
+		case user.FieldEmail, user.FieldNickname, user.FieldHomeCurrency:
 			values[i] = new(sql.NullString)
 		case user.FieldID:
 			values[i] = new(uuid.UUID)
@@ -187,6 +247,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Nickname = value.String
 			}
+		case user.FieldHomeCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field home_currency", values[i])
+			} else if value.Valid {
+				_m.HomeCurrency = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -240,6 +306,31 @@ func (_m *User) QuerySavingsTransfers() *SavingsTransferQuery {
 	return NewUserClient(_m.config).QuerySavingsTransfers(_m)
 }
 
+// QueryFamilyMembership queries the "family_membership" edge of the User entity.
+func (_m *User) QueryFamilyMembership() *FamilyMemberQuery {
+	return NewUserClient(_m.config).QueryFamilyMembership(_m)
+}
+
+// QueryGoals queries the "goals" edge of the User entity.
+func (_m *User) QueryGoals() *GoalQuery {
+	return NewUserClient(_m.config).QueryGoals(_m)
+}
+
+// QueryMonthlyReports queries the "monthly_reports" edge of the User entity.
+func (_m *User) QueryMonthlyReports() *MonthlyReportQuery {
+	return NewUserClient(_m.config).QueryMonthlyReports(_m)
+}
+
+// QueryBudgets queries the "budgets" edge of the User entity.
+func (_m *User) QueryBudgets() *BudgetQuery {
+	return NewUserClient(_m.config).QueryBudgets(_m)
+}
+
+// QueryRecurringCharges queries the "recurring_charges" edge of the User entity.
+func (_m *User) QueryRecurringCharges() *RecurringChargeQuery {
+	return NewUserClient(_m.config).QueryRecurringCharges(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -275,6 +366,9 @@ func (_m *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("nickname=")
 	builder.WriteString(_m.Nickname)
+	builder.WriteString(", ")
+	builder.WriteString("home_currency=")
+	builder.WriteString(_m.HomeCurrency)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -21,6 +21,8 @@ const (
 	FieldCustodyAccountID = "custody_account_id"
 	// FieldNickname holds the string denoting the nickname field in the database.
 	FieldNickname = "nickname"
+	// FieldHomeCurrency holds the string denoting the home_currency field in the database.
+	FieldHomeCurrency = "home_currency"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
 	// EdgeCustodyAccount holds the string denoting the custody_account edge name in mutations.
@@ -37,6 +39,16 @@ const (
 	EdgeRuleExecutions = "rule_executions"
 	// EdgeSavingsTransfers holds the string denoting the savings_transfers edge name in mutations.
 	EdgeSavingsTransfers = "savings_transfers"
+	// EdgeFamilyMembership holds the string denoting the family_membership edge name in mutations.
+	EdgeFamilyMembership = "family_membership"
+	// EdgeGoals holds the string denoting the goals edge name in mutations.
+	EdgeGoals = "goals"
+	// EdgeMonthlyReports holds the string denoting the monthly_reports edge name in mutations.
+	EdgeMonthlyReports = "monthly_reports"
+	// EdgeBudgets holds the string denoting the budgets edge name in mutations.
+	EdgeBudgets = "budgets"
+	// EdgeRecurringCharges holds the string denoting the recurring_charges edge name in mutations.
+	EdgeRecurringCharges = "recurring_charges"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// UserTable is the table that holds the user relation/edge.
@@ -89,6 +101,41 @@ const (
 	SavingsTransfersInverseTable = "savings_transfers"
 	// SavingsTransfersColumn is the table column denoting the savings_transfers relation/edge.
 	SavingsTransfersColumn = "user_id"
+	// FamilyMembershipTable is the table that holds the family_membership relation/edge.
+	FamilyMembershipTable = "family_members"
+	// FamilyMembershipInverseTable is the table name for the FamilyMember entity.
+	// It exists in this package in order to avoid circular dependency with the "familymember" package.
+	FamilyMembershipInverseTable = "family_members"
+	// FamilyMembershipColumn is the table column denoting the family_membership relation/edge.
+	FamilyMembershipColumn = "user_id"
+	// GoalsTable is the table that holds the goals relation/edge.
+	GoalsTable = "goals"
+	// GoalsInverseTable is the table name for the Goal entity.
+	// It exists in this package in order to avoid circular dependency with the "goal" package.
+	GoalsInverseTable = "goals"
+	// GoalsColumn is the table column denoting the goals relation/edge.
+	GoalsColumn = "user_id"
+	// MonthlyReportsTable is the table that holds the monthly_reports relation/edge.
+	MonthlyReportsTable = "monthly_reports"
+	// MonthlyReportsInverseTable is the table name for the MonthlyReport entity.
+	// It exists in this package in order to avoid circular dependency with the "monthlyreport" package.
+	MonthlyReportsInverseTable = "monthly_reports"
+	// MonthlyReportsColumn is the table column denoting the monthly_reports relation/edge.
+	MonthlyReportsColumn = "user_id"
+	// BudgetsTable is the table that holds the budgets relation/edge.
+	BudgetsTable = "budgets"
+	// BudgetsInverseTable is the table name for the Budget entity.
+	// It exists in this package in order to avoid circular dependency with the "budget" package.
+	BudgetsInverseTable = "budgets"
+	// BudgetsColumn is the table column denoting the budgets relation/edge.
+	BudgetsColumn = "user_id"
+	// RecurringChargesTable is the table that holds the recurring_charges relation/edge.
+	RecurringChargesTable = "recurring_charges"
+	// RecurringChargesInverseTable is the table name for the RecurringCharge entity.
+	// It exists in this package in order to avoid circular dependency with the "recurringcharge" package.
+	RecurringChargesInverseTable = "recurring_charges"
+	// RecurringChargesColumn is the table column denoting the recurring_charges relation/edge.
+	RecurringChargesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -98,6 +145,7 @@ var Columns = []string{
 	FieldPassword,
 	FieldCustodyAccountID,
 	FieldNickname,
+	FieldHomeCurrency,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -111,6 +159,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultHomeCurrency holds the default value on creation for the "home_currency" field.
+	DefaultHomeCurrency string
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -138,6 +188,11 @@ func ByNickname(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldNickname, opts...).ToFunc()
 }
 
+// ByHomeCurrency orders the results by the home_currency field.
+func ByHomeCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHomeCurrency, opts...).ToFunc()
+}
+
 // ByUserCount orders the results by user count.
 func ByUserCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -242,6 +297,69 @@ func BySavingsTransfers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newSavingsTransfersStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByFamilyMembershipField orders the results by family_membership field.
+func ByFamilyMembershipField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFamilyMembershipStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByGoalsCount orders the results by goals count.
+func ByGoalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newGoalsStep(), opts...)
+	}
+}
+
+// ByGoals orders the results by goals terms.
+func ByGoals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newGoalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByMonthlyReportsCount orders the results by monthly_reports count.
+func ByMonthlyReportsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMonthlyReportsStep(), opts...)
+	}
+}
+
+// ByMonthlyReports orders the results by monthly_reports terms.
+func ByMonthlyReports(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMonthlyReportsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBudgetsCount orders the results by budgets count.
+func ByBudgetsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBudgetsStep(), opts...)
+	}
+}
+
+// ByBudgets orders the results by budgets terms.
+func ByBudgets(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBudgetsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRecurringChargesCount orders the results by recurring_charges count.
+func ByRecurringChargesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRecurringChargesStep(), opts...)
+	}
+}
+
+// ByRecurringCharges orders the results by recurring_charges terms.
+func ByRecurringCharges(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecurringChargesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -298,3 +416,38 @@ func newSavingsTransfersStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SavingsTransfersTable, SavingsTransfersColumn),
 	)
 }
+func newFamilyMembershipStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FamilyMembershipInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, FamilyMembershipTable, FamilyMembershipColumn),
+	)
+}
+func newGoalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(GoalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, GoalsTable, GoalsColumn),
+	)
+}
+func newMonthlyReportsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MonthlyReportsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MonthlyReportsTable, MonthlyReportsColumn),
+	)
+}
+func newBudgetsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BudgetsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BudgetsTable, BudgetsColumn),
+	)
+}
+func newRecurringChargesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecurringChargesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RecurringChargesTable, RecurringChargesColumn),
+	)
+}
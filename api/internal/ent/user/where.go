@@ -75,6 +75,11 @@ func Nickname(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNickname, v))
 }
 
+// HomeCurrency applies equality check predicate on the "home_currency" field. It's identical to HomeCurrencyEQ.
+func HomeCurrency(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHomeCurrency, v))
+}
+
 // EmailEQ applies the EQ predicate on the "email" field.
 func EmailEQ(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldEmail, v))
@@ -275,6 +280,71 @@ func NicknameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldNickname, v))
 }
 
+// HomeCurrencyEQ applies the EQ predicate on the "home_currency" field.
+func HomeCurrencyEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyNEQ applies the NEQ predicate on the "home_currency" field.
+func HomeCurrencyNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyIn applies the In predicate on the "home_currency" field.
+func HomeCurrencyIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldHomeCurrency, vs...))
+}
+
+// HomeCurrencyNotIn applies the NotIn predicate on the "home_currency" field.
+func HomeCurrencyNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldHomeCurrency, vs...))
+}
+
+// HomeCurrencyGT applies the GT predicate on the "home_currency" field.
+func HomeCurrencyGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyGTE applies the GTE predicate on the "home_currency" field.
+func HomeCurrencyGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyLT applies the LT predicate on the "home_currency" field.
+func HomeCurrencyLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyLTE applies the LTE predicate on the "home_currency" field.
+func HomeCurrencyLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyContains applies the Contains predicate on the "home_currency" field.
+func HomeCurrencyContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyHasPrefix applies the HasPrefix predicate on the "home_currency" field.
+func HomeCurrencyHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyHasSuffix applies the HasSuffix predicate on the "home_currency" field.
+func HomeCurrencyHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyEqualFold applies the EqualFold predicate on the "home_currency" field.
+func HomeCurrencyEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldHomeCurrency, v))
+}
+
+// HomeCurrencyContainsFold applies the ContainsFold predicate on the "home_currency" field.
+func HomeCurrencyContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldHomeCurrency, v))
+}
+
 // HasUser applies the HasEdge predicate on the "user" edge.
 func HasUser() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
@@ -459,6 +529,121 @@ func HasSavingsTransfersWith(preds ...predicate.SavingsTransfer) predicate.User
 	})
 }
 
+// HasFamilyMembership applies the HasEdge predicate on the "family_membership" edge.
+func HasFamilyMembership() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, FamilyMembershipTable, FamilyMembershipColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFamilyMembershipWith applies the HasEdge predicate on the "family_membership" edge with a given conditions (other predicates).
+func HasFamilyMembershipWith(preds ...predicate.FamilyMember) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFamilyMembershipStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasGoals applies the HasEdge predicate on the "goals" edge.
+func HasGoals() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, GoalsTable, GoalsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasGoalsWith applies the HasEdge predicate on the "goals" edge with a given conditions (other predicates).
+func HasGoalsWith(preds ...predicate.Goal) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newGoalsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMonthlyReports applies the HasEdge predicate on the "monthly_reports" edge.
+func HasMonthlyReports() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MonthlyReportsTable, MonthlyReportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMonthlyReportsWith applies the HasEdge predicate on the "monthly_reports" edge with a given conditions (other predicates).
+func HasMonthlyReportsWith(preds ...predicate.MonthlyReport) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newMonthlyReportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBudgets applies the HasEdge predicate on the "budgets" edge.
+func HasBudgets() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BudgetsTable, BudgetsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBudgetsWith applies the HasEdge predicate on the "budgets" edge with a given conditions (other predicates).
+func HasBudgetsWith(preds ...predicate.Budget) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newBudgetsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRecurringCharges applies the HasEdge predicate on the "recurring_charges" edge.
+func HasRecurringCharges() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RecurringChargesTable, RecurringChargesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecurringChargesWith applies the HasEdge predicate on the "recurring_charges" edge with a given conditions (other predicates).
+func HasRecurringChargesWith(preds ...predicate.RecurringCharge) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newRecurringChargesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
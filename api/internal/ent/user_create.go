@@ -7,8 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/familymember"
+	"regulation/internal/ent/goal"
 	"regulation/internal/ent/item"
+	"regulation/internal/ent/monthlyreport"
 	"regulation/internal/ent/pushsubscription"
+	"regulation/internal/ent/recurringcharge"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/savingstransfer"
@@ -61,6 +66,20 @@ func (_c *UserCreate) SetNickname(v string) *UserCreate {
 	return _c
 }
 
+// SetHomeCurrency sets the "home_currency" field.
+func (_c *UserCreate) SetHomeCurrency(v string) *UserCreate {
+	_c.mutation.SetHomeCurrency(v)
+	return _c
+}
+
+// SetNillableHomeCurrency sets the "home_currency" field if the given value is not nil.
+func (_c *UserCreate) SetNillableHomeCurrency(v *string) *UserCreate {
+	if v != nil {
+		_c.SetHomeCurrency(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *UserCreate) SetID(v uuid.UUID) *UserCreate {
 	_c.mutation.SetID(v)
@@ -185,6 +204,85 @@ func (_c *UserCreate) AddSavingsTransfers(v ...*SavingsTransfer) *UserCreate {
 	return _c.AddSavingsTransferIDs(ids...)
 }
 
+// SetFamilyMembershipID sets the "family_membership" edge to the FamilyMember entity by ID.
+func (_c *UserCreate) SetFamilyMembershipID(id uuid.UUID) *UserCreate {
+	_c.mutation.SetFamilyMembershipID(id)
+	return _c
+}
+
+// SetNillableFamilyMembershipID sets the "family_membership" edge to the FamilyMember entity by ID if the given value is not nil.
+func (_c *UserCreate) SetNillableFamilyMembershipID(id *uuid.UUID) *UserCreate {
+	if id != nil {
+		_c = _c.SetFamilyMembershipID(*id)
+	}
+	return _c
+}
+
+// SetFamilyMembership sets the "family_membership" edge to the FamilyMember entity.
+func (_c *UserCreate) SetFamilyMembership(v *FamilyMember) *UserCreate {
+	return _c.SetFamilyMembershipID(v.ID)
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (_c *UserCreate) AddGoalIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddGoalIDs(ids...)
+	return _c
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (_c *UserCreate) AddGoals(v ...*Goal) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddGoalIDs(ids...)
+}
+
+// AddMonthlyReportIDs adds the "monthly_reports" edge to the MonthlyReport entity by IDs.
+func (_c *UserCreate) AddMonthlyReportIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddMonthlyReportIDs(ids...)
+	return _c
+}
+
+// AddMonthlyReports adds the "monthly_reports" edges to the MonthlyReport entity.
+func (_c *UserCreate) AddMonthlyReports(v ...*MonthlyReport) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMonthlyReportIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_c *UserCreate) AddBudgetIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddBudgetIDs(ids...)
+	return _c
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_c *UserCreate) AddBudgets(v ...*Budget) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBudgetIDs(ids...)
+}
+
+// AddRecurringChargeIDs adds the "recurring_charges" edge to the RecurringCharge entity by IDs.
+func (_c *UserCreate) AddRecurringChargeIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddRecurringChargeIDs(ids...)
+	return _c
+}
+
+// AddRecurringCharges adds the "recurring_charges" edges to the RecurringCharge entity.
+func (_c *UserCreate) AddRecurringCharges(v ...*RecurringCharge) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRecurringChargeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -220,6 +318,10 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.HomeCurrency(); !ok {
+		v := user.DefaultHomeCurrency
+		_c.mutation.SetHomeCurrency(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := user.DefaultID()
 		_c.mutation.SetID(v)
@@ -237,6 +339,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.Nickname(); !ok {
 		return &ValidationError{Name: "nickname", err: errors.New(`ent: missing required field "User.nickname"`)}
 	}
+	if _, ok := _c.mutation.HomeCurrency(); !ok {
+		return &ValidationError{Name: "home_currency", err: errors.New(`ent: missing required field "User.home_currency"`)}
+	}
 	return nil
 }
 
@@ -285,6 +390,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldNickname, field.TypeString, value)
 		_node.Nickname = value
 	}
+	if value, ok := _c.mutation.HomeCurrency(); ok {
+		_spec.SetField(user.FieldHomeCurrency, field.TypeString, value)
+		_node.HomeCurrency = value
+	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -414,6 +523,86 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.FamilyMembershipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.FamilyMembershipTable,
+			Columns: []string{user.FamilyMembershipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(familymember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MonthlyReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MonthlyReportsTable,
+			Columns: []string{user.MonthlyReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyreport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetsTable,
+			Columns: []string{user.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RecurringChargesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecurringChargesTable,
+			Columns: []string{user.RecurringChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringcharge.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -520,6 +709,18 @@ func (u *UserUpsert) UpdateNickname() *UserUpsert {
 	return u
 }
 
+// SetHomeCurrency sets the "home_currency" field.
+func (u *UserUpsert) SetHomeCurrency(v string) *UserUpsert {
+	u.Set(user.FieldHomeCurrency, v)
+	return u
+}
+
+// UpdateHomeCurrency sets the "home_currency" field to the value that was provided on create.
+func (u *UserUpsert) UpdateHomeCurrency() *UserUpsert {
+	u.SetExcluded(user.FieldHomeCurrency)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -631,6 +832,20 @@ func (u *UserUpsertOne) UpdateNickname() *UserUpsertOne {
 	})
 }
 
+// SetHomeCurrency sets the "home_currency" field.
+func (u *UserUpsertOne) SetHomeCurrency(v string) *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHomeCurrency(v)
+	})
+}
+
+// UpdateHomeCurrency sets the "home_currency" field to the value that was provided on create.
+func (u *UserUpsertOne) UpdateHomeCurrency() *UserUpsertOne {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHomeCurrency()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -909,6 +1124,20 @@ func (u *UserUpsertBulk) UpdateNickname() *UserUpsertBulk {
 	})
 }
 
+// SetHomeCurrency sets the "home_currency" field.
+func (u *UserUpsertBulk) SetHomeCurrency(v string) *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.SetHomeCurrency(v)
+	})
+}
+
+// UpdateHomeCurrency sets the "home_currency" field to the value that was provided on create.
+func (u *UserUpsertBulk) UpdateHomeCurrency() *UserUpsertBulk {
+	return u.Update(func(s *UserUpsert) {
+		s.UpdateHomeCurrency()
+	})
+}
+
 // Exec executes the query.
 func (u *UserUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -8,9 +8,14 @@ import (
 	"fmt"
 	"math"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/familymember"
+	"regulation/internal/ent/goal"
 	"regulation/internal/ent/item"
+	"regulation/internal/ent/monthlyreport"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/pushsubscription"
+	"regulation/internal/ent/recurringcharge"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/savingstransfer"
@@ -39,6 +44,11 @@ type UserQuery struct {
 	withRules             *RuleQuery
 	withRuleExecutions    *RuleExecutionQuery
 	withSavingsTransfers  *SavingsTransferQuery
+	withFamilyMembership  *FamilyMemberQuery
+	withGoals             *GoalQuery
+	withMonthlyReports    *MonthlyReportQuery
+	withBudgets           *BudgetQuery
+	withRecurringCharges  *RecurringChargeQuery
 	modifiers             []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -252,6 +262,116 @@ func (_q *UserQuery) QuerySavingsTransfers() *SavingsTransferQuery {
 	return query
 }
 
+// QueryFamilyMembership chains the current query on the "family_membership" edge.
+func (_q *UserQuery) QueryFamilyMembership() *FamilyMemberQuery {
+	query := (&FamilyMemberClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(familymember.Table, familymember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, user.FamilyMembershipTable, user.FamilyMembershipColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryGoals chains the current query on the "goals" edge.
+func (_q *UserQuery) QueryGoals() *GoalQuery {
+	query := (&GoalClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(goal.Table, goal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.GoalsTable, user.GoalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMonthlyReports chains the current query on the "monthly_reports" edge.
+func (_q *UserQuery) QueryMonthlyReports() *MonthlyReportQuery {
+	query := (&MonthlyReportClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(monthlyreport.Table, monthlyreport.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.MonthlyReportsTable, user.MonthlyReportsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBudgets chains the current query on the "budgets" edge.
+func (_q *UserQuery) QueryBudgets() *BudgetQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(budget.Table, budget.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BudgetsTable, user.BudgetsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRecurringCharges chains the current query on the "recurring_charges" edge.
+func (_q *UserQuery) QueryRecurringCharges() *RecurringChargeQuery {
+	query := (&RecurringChargeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(recurringcharge.Table, recurringcharge.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RecurringChargesTable, user.RecurringChargesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -452,6 +572,11 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withRules:             _q.withRules.Clone(),
 		withRuleExecutions:    _q.withRuleExecutions.Clone(),
 		withSavingsTransfers:  _q.withSavingsTransfers.Clone(),
+		withFamilyMembership:  _q.withFamilyMembership.Clone(),
+		withGoals:             _q.withGoals.Clone(),
+		withMonthlyReports:    _q.withMonthlyReports.Clone(),
+		withBudgets:           _q.withBudgets.Clone(),
+		withRecurringCharges:  _q.withRecurringCharges.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
 		path:      _q.path,
@@ -547,6 +672,61 @@ func (_q *UserQuery) WithSavingsTransfers(opts ...func(*SavingsTransferQuery)) *
 	return _q
 }
 
+// WithFamilyMembership tells the query-builder to eager-load the nodes that are connected to
+// the "family_membership" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithFamilyMembership(opts ...func(*FamilyMemberQuery)) *UserQuery {
+	query := (&FamilyMemberClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFamilyMembership = query
+	return _q
+}
+
+// WithGoals tells the query-builder to eager-load the nodes that are connected to
+// the "goals" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithGoals(opts ...func(*GoalQuery)) *UserQuery {
+	query := (&GoalClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withGoals = query
+	return _q
+}
+
+// WithMonthlyReports tells the query-builder to eager-load the nodes that are connected to
+// the "monthly_reports" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithMonthlyReports(opts ...func(*MonthlyReportQuery)) *UserQuery {
+	query := (&MonthlyReportClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMonthlyReports = query
+	return _q
+}
+
+// WithBudgets tells the query-builder to eager-load the nodes that are connected to
+// the "budgets" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithBudgets(opts ...func(*BudgetQuery)) *UserQuery {
+	query := (&BudgetClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBudgets = query
+	return _q
+}
+
+// WithRecurringCharges tells the query-builder to eager-load the nodes that are connected to
+// the "recurring_charges" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithRecurringCharges(opts ...func(*RecurringChargeQuery)) *UserQuery {
+	query := (&RecurringChargeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecurringCharges = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -625,7 +805,7 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [8]bool{
+		loadedTypes = [13]bool{
 			_q.withUser != nil,
 			_q.withCustodyAccount != nil,
 			_q.withItems != nil,
@@ -634,6 +814,11 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			_q.withRules != nil,
 			_q.withRuleExecutions != nil,
 			_q.withSavingsTransfers != nil,
+			_q.withFamilyMembership != nil,
+			_q.withGoals != nil,
+			_q.withMonthlyReports != nil,
+			_q.withBudgets != nil,
+			_q.withRecurringCharges != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -712,6 +897,40 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withFamilyMembership; query != nil {
+		if err := _q.loadFamilyMembership(ctx, query, nodes, nil,
+			func(n *User, e *FamilyMember) { n.Edges.FamilyMembership = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withGoals; query != nil {
+		if err := _q.loadGoals(ctx, query, nodes,
+			func(n *User) { n.Edges.Goals = []*Goal{} },
+			func(n *User, e *Goal) { n.Edges.Goals = append(n.Edges.Goals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMonthlyReports; query != nil {
+		if err := _q.loadMonthlyReports(ctx, query, nodes,
+			func(n *User) { n.Edges.MonthlyReports = []*MonthlyReport{} },
+			func(n *User, e *MonthlyReport) { n.Edges.MonthlyReports = append(n.Edges.MonthlyReports, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBudgets; query != nil {
+		if err := _q.loadBudgets(ctx, query, nodes,
+			func(n *User) { n.Edges.Budgets = []*Budget{} },
+			func(n *User, e *Budget) { n.Edges.Budgets = append(n.Edges.Budgets, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRecurringCharges; query != nil {
+		if err := _q.loadRecurringCharges(ctx, query, nodes,
+			func(n *User) { n.Edges.RecurringCharges = []*RecurringCharge{} },
+			func(n *User, e *RecurringCharge) { n.Edges.RecurringCharges = append(n.Edges.RecurringCharges, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -960,6 +1179,153 @@ func (_q *UserQuery) loadSavingsTransfers(ctx context.Context, query *SavingsTra
 	}
 	return nil
 }
+func (_q *UserQuery) loadFamilyMembership(ctx context.Context, query *FamilyMemberQuery, nodes []*User, init func(*User), assign func(*User, *FamilyMember)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(familymember.FieldUserID)
+	}
+	query.Where(predicate.FamilyMember(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.FamilyMembershipColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadGoals(ctx context.Context, query *GoalQuery, nodes []*User, init func(*User), assign func(*User, *Goal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(goal.FieldUserID)
+	}
+	query.Where(predicate.Goal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.GoalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadMonthlyReports(ctx context.Context, query *MonthlyReportQuery, nodes []*User, init func(*User), assign func(*User, *MonthlyReport)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(monthlyreport.FieldUserID)
+	}
+	query.Where(predicate.MonthlyReport(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.MonthlyReportsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadBudgets(ctx context.Context, query *BudgetQuery, nodes []*User, init func(*User), assign func(*User, *Budget)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(budget.FieldUserID)
+	}
+	query.Where(predicate.Budget(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.BudgetsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadRecurringCharges(ctx context.Context, query *RecurringChargeQuery, nodes []*User, init func(*User), assign func(*User, *RecurringCharge)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(recurringcharge.FieldUserID)
+	}
+	query.Where(predicate.RecurringCharge(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RecurringChargesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
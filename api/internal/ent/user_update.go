@@ -7,9 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"regulation/internal/ent/account"
+	"regulation/internal/ent/budget"
+	"regulation/internal/ent/familymember"
+	"regulation/internal/ent/goal"
 	"regulation/internal/ent/item"
+	"regulation/internal/ent/monthlyreport"
 	"regulation/internal/ent/predicate"
 	"regulation/internal/ent/pushsubscription"
+	"regulation/internal/ent/recurringcharge"
 	"regulation/internal/ent/rule"
 	"regulation/internal/ent/ruleexecution"
 	"regulation/internal/ent/savingstransfer"
@@ -89,6 +94,20 @@ func (_u *UserUpdate) SetNillableNickname(v *string) *UserUpdate {
 	return _u
 }
 
+// SetHomeCurrency sets the "home_currency" field.
+func (_u *UserUpdate) SetHomeCurrency(v string) *UserUpdate {
+	_u.mutation.SetHomeCurrency(v)
+	return _u
+}
+
+// SetNillableHomeCurrency sets the "home_currency" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableHomeCurrency(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetHomeCurrency(*v)
+	}
+	return _u
+}
+
 // AddUserIDs adds the "user" edge to the User entity by IDs.
 func (_u *UserUpdate) AddUserIDs(ids ...uuid.UUID) *UserUpdate {
 	_u.mutation.AddUserIDs(ids...)
@@ -199,6 +218,85 @@ func (_u *UserUpdate) AddSavingsTransfers(v ...*SavingsTransfer) *UserUpdate {
 	return _u.AddSavingsTransferIDs(ids...)
 }
 
+// SetFamilyMembershipID sets the "family_membership" edge to the FamilyMember entity by ID.
+func (_u *UserUpdate) SetFamilyMembershipID(id uuid.UUID) *UserUpdate {
+	_u.mutation.SetFamilyMembershipID(id)
+	return _u
+}
+
+// SetNillableFamilyMembershipID sets the "family_membership" edge to the FamilyMember entity by ID if the given value is not nil.
+func (_u *UserUpdate) SetNillableFamilyMembershipID(id *uuid.UUID) *UserUpdate {
+	if id != nil {
+		_u = _u.SetFamilyMembershipID(*id)
+	}
+	return _u
+}
+
+// SetFamilyMembership sets the "family_membership" edge to the FamilyMember entity.
+func (_u *UserUpdate) SetFamilyMembership(v *FamilyMember) *UserUpdate {
+	return _u.SetFamilyMembershipID(v.ID)
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (_u *UserUpdate) AddGoalIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddGoalIDs(ids...)
+	return _u
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (_u *UserUpdate) AddGoals(v ...*Goal) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoalIDs(ids...)
+}
+
+// AddMonthlyReportIDs adds the "monthly_reports" edge to the MonthlyReport entity by IDs.
+func (_u *UserUpdate) AddMonthlyReportIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddMonthlyReportIDs(ids...)
+	return _u
+}
+
+// AddMonthlyReports adds the "monthly_reports" edges to the MonthlyReport entity.
+func (_u *UserUpdate) AddMonthlyReports(v ...*MonthlyReport) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMonthlyReportIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_u *UserUpdate) AddBudgetIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddBudgetIDs(ids...)
+	return _u
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_u *UserUpdate) AddBudgets(v ...*Budget) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBudgetIDs(ids...)
+}
+
+// AddRecurringChargeIDs adds the "recurring_charges" edge to the RecurringCharge entity by IDs.
+func (_u *UserUpdate) AddRecurringChargeIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddRecurringChargeIDs(ids...)
+	return _u
+}
+
+// AddRecurringCharges adds the "recurring_charges" edges to the RecurringCharge entity.
+func (_u *UserUpdate) AddRecurringCharges(v ...*RecurringCharge) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecurringChargeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -357,6 +455,96 @@ func (_u *UserUpdate) RemoveSavingsTransfers(v ...*SavingsTransfer) *UserUpdate
 	return _u.RemoveSavingsTransferIDs(ids...)
 }
 
+// ClearFamilyMembership clears the "family_membership" edge to the FamilyMember entity.
+func (_u *UserUpdate) ClearFamilyMembership() *UserUpdate {
+	_u.mutation.ClearFamilyMembership()
+	return _u
+}
+
+// ClearGoals clears all "goals" edges to the Goal entity.
+func (_u *UserUpdate) ClearGoals() *UserUpdate {
+	_u.mutation.ClearGoals()
+	return _u
+}
+
+// RemoveGoalIDs removes the "goals" edge to Goal entities by IDs.
+func (_u *UserUpdate) RemoveGoalIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveGoalIDs(ids...)
+	return _u
+}
+
+// RemoveGoals removes "goals" edges to Goal entities.
+func (_u *UserUpdate) RemoveGoals(v ...*Goal) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoalIDs(ids...)
+}
+
+// ClearMonthlyReports clears all "monthly_reports" edges to the MonthlyReport entity.
+func (_u *UserUpdate) ClearMonthlyReports() *UserUpdate {
+	_u.mutation.ClearMonthlyReports()
+	return _u
+}
+
+// RemoveMonthlyReportIDs removes the "monthly_reports" edge to MonthlyReport entities by IDs.
+func (_u *UserUpdate) RemoveMonthlyReportIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveMonthlyReportIDs(ids...)
+	return _u
+}
+
+// RemoveMonthlyReports removes "monthly_reports" edges to MonthlyReport entities.
+func (_u *UserUpdate) RemoveMonthlyReports(v ...*MonthlyReport) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMonthlyReportIDs(ids...)
+}
+
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (_u *UserUpdate) ClearBudgets() *UserUpdate {
+	_u.mutation.ClearBudgets()
+	return _u
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (_u *UserUpdate) RemoveBudgetIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveBudgetIDs(ids...)
+	return _u
+}
+
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (_u *UserUpdate) RemoveBudgets(v ...*Budget) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBudgetIDs(ids...)
+}
+
+// ClearRecurringCharges clears all "recurring_charges" edges to the RecurringCharge entity.
+func (_u *UserUpdate) ClearRecurringCharges() *UserUpdate {
+	_u.mutation.ClearRecurringCharges()
+	return _u
+}
+
+// RemoveRecurringChargeIDs removes the "recurring_charges" edge to RecurringCharge entities by IDs.
+func (_u *UserUpdate) RemoveRecurringChargeIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveRecurringChargeIDs(ids...)
+	return _u
+}
+
+// RemoveRecurringCharges removes "recurring_charges" edges to RecurringCharge entities.
+func (_u *UserUpdate) RemoveRecurringCharges(v ...*RecurringCharge) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurringChargeIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -408,6 +596,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Nickname(); ok {
 		_spec.SetField(user.FieldNickname, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.HomeCurrency(); ok {
+		_spec.SetField(user.FieldHomeCurrency, field.TypeString, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -752,6 +943,215 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FamilyMembershipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.FamilyMembershipTable,
+			Columns: []string{user.FamilyMembershipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(familymember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FamilyMembershipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.FamilyMembershipTable,
+			Columns: []string{user.FamilyMembershipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(familymember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !_u.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MonthlyReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MonthlyReportsTable,
+			Columns: []string{user.MonthlyReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyreport.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMonthlyReportsIDs(); len(nodes) > 0 && !_u.mutation.MonthlyReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MonthlyReportsTable,
+			Columns: []string{user.MonthlyReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyreport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonthlyReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MonthlyReportsTable,
+			Columns: []string{user.MonthlyReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyreport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetsTable,
+			Columns: []string{user.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !_u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetsTable,
+			Columns: []string{user.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetsTable,
+			Columns: []string{user.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurringChargesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecurringChargesTable,
+			Columns: []string{user.RecurringChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringcharge.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecurringChargesIDs(); len(nodes) > 0 && !_u.mutation.RecurringChargesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecurringChargesTable,
+			Columns: []string{user.RecurringChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringcharge.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurringChargesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecurringChargesTable,
+			Columns: []string{user.RecurringChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringcharge.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -828,6 +1228,20 @@ func (_u *UserUpdateOne) SetNillableNickname(v *string) *UserUpdateOne {
 	return _u
 }
 
+// SetHomeCurrency sets the "home_currency" field.
+func (_u *UserUpdateOne) SetHomeCurrency(v string) *UserUpdateOne {
+	_u.mutation.SetHomeCurrency(v)
+	return _u
+}
+
+// SetNillableHomeCurrency sets the "home_currency" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableHomeCurrency(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetHomeCurrency(*v)
+	}
+	return _u
+}
+
 // AddUserIDs adds the "user" edge to the User entity by IDs.
 func (_u *UserUpdateOne) AddUserIDs(ids ...uuid.UUID) *UserUpdateOne {
 	_u.mutation.AddUserIDs(ids...)
@@ -938,6 +1352,85 @@ func (_u *UserUpdateOne) AddSavingsTransfers(v ...*SavingsTransfer) *UserUpdateO
 	return _u.AddSavingsTransferIDs(ids...)
 }
 
+// SetFamilyMembershipID sets the "family_membership" edge to the FamilyMember entity by ID.
+func (_u *UserUpdateOne) SetFamilyMembershipID(id uuid.UUID) *UserUpdateOne {
+	_u.mutation.SetFamilyMembershipID(id)
+	return _u
+}
+
+// SetNillableFamilyMembershipID sets the "family_membership" edge to the FamilyMember entity by ID if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableFamilyMembershipID(id *uuid.UUID) *UserUpdateOne {
+	if id != nil {
+		_u = _u.SetFamilyMembershipID(*id)
+	}
+	return _u
+}
+
+// SetFamilyMembership sets the "family_membership" edge to the FamilyMember entity.
+func (_u *UserUpdateOne) SetFamilyMembership(v *FamilyMember) *UserUpdateOne {
+	return _u.SetFamilyMembershipID(v.ID)
+}
+
+// AddGoalIDs adds the "goals" edge to the Goal entity by IDs.
+func (_u *UserUpdateOne) AddGoalIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddGoalIDs(ids...)
+	return _u
+}
+
+// AddGoals adds the "goals" edges to the Goal entity.
+func (_u *UserUpdateOne) AddGoals(v ...*Goal) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddGoalIDs(ids...)
+}
+
+// AddMonthlyReportIDs adds the "monthly_reports" edge to the MonthlyReport entity by IDs.
+func (_u *UserUpdateOne) AddMonthlyReportIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddMonthlyReportIDs(ids...)
+	return _u
+}
+
+// AddMonthlyReports adds the "monthly_reports" edges to the MonthlyReport entity.
+func (_u *UserUpdateOne) AddMonthlyReports(v ...*MonthlyReport) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMonthlyReportIDs(ids...)
+}
+
+// AddBudgetIDs adds the "budgets" edge to the Budget entity by IDs.
+func (_u *UserUpdateOne) AddBudgetIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddBudgetIDs(ids...)
+	return _u
+}
+
+// AddBudgets adds the "budgets" edges to the Budget entity.
+func (_u *UserUpdateOne) AddBudgets(v ...*Budget) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBudgetIDs(ids...)
+}
+
+// AddRecurringChargeIDs adds the "recurring_charges" edge to the RecurringCharge entity by IDs.
+func (_u *UserUpdateOne) AddRecurringChargeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddRecurringChargeIDs(ids...)
+	return _u
+}
+
+// AddRecurringCharges adds the "recurring_charges" edges to the RecurringCharge entity.
+func (_u *UserUpdateOne) AddRecurringCharges(v ...*RecurringCharge) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRecurringChargeIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -1096,6 +1589,96 @@ func (_u *UserUpdateOne) RemoveSavingsTransfers(v ...*SavingsTransfer) *UserUpda
 	return _u.RemoveSavingsTransferIDs(ids...)
 }
 
+// ClearFamilyMembership clears the "family_membership" edge to the FamilyMember entity.
+func (_u *UserUpdateOne) ClearFamilyMembership() *UserUpdateOne {
+	_u.mutation.ClearFamilyMembership()
+	return _u
+}
+
+// ClearGoals clears all "goals" edges to the Goal entity.
+func (_u *UserUpdateOne) ClearGoals() *UserUpdateOne {
+	_u.mutation.ClearGoals()
+	return _u
+}
+
+// RemoveGoalIDs removes the "goals" edge to Goal entities by IDs.
+func (_u *UserUpdateOne) RemoveGoalIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveGoalIDs(ids...)
+	return _u
+}
+
+// RemoveGoals removes "goals" edges to Goal entities.
+func (_u *UserUpdateOne) RemoveGoals(v ...*Goal) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveGoalIDs(ids...)
+}
+
+// ClearMonthlyReports clears all "monthly_reports" edges to the MonthlyReport entity.
+func (_u *UserUpdateOne) ClearMonthlyReports() *UserUpdateOne {
+	_u.mutation.ClearMonthlyReports()
+	return _u
+}
+
+// RemoveMonthlyReportIDs removes the "monthly_reports" edge to MonthlyReport entities by IDs.
+func (_u *UserUpdateOne) RemoveMonthlyReportIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveMonthlyReportIDs(ids...)
+	return _u
+}
+
+// RemoveMonthlyReports removes "monthly_reports" edges to MonthlyReport entities.
+func (_u *UserUpdateOne) RemoveMonthlyReports(v ...*MonthlyReport) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMonthlyReportIDs(ids...)
+}
+
+// ClearBudgets clears all "budgets" edges to the Budget entity.
+func (_u *UserUpdateOne) ClearBudgets() *UserUpdateOne {
+	_u.mutation.ClearBudgets()
+	return _u
+}
+
+// RemoveBudgetIDs removes the "budgets" edge to Budget entities by IDs.
+func (_u *UserUpdateOne) RemoveBudgetIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveBudgetIDs(ids...)
+	return _u
+}
+
+// RemoveBudgets removes "budgets" edges to Budget entities.
+func (_u *UserUpdateOne) RemoveBudgets(v ...*Budget) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBudgetIDs(ids...)
+}
+
+// ClearRecurringCharges clears all "recurring_charges" edges to the RecurringCharge entity.
+func (_u *UserUpdateOne) ClearRecurringCharges() *UserUpdateOne {
+	_u.mutation.ClearRecurringCharges()
+	return _u
+}
+
+// RemoveRecurringChargeIDs removes the "recurring_charges" edge to RecurringCharge entities by IDs.
+func (_u *UserUpdateOne) RemoveRecurringChargeIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveRecurringChargeIDs(ids...)
+	return _u
+}
+
+// RemoveRecurringCharges removes "recurring_charges" edges to RecurringCharge entities.
+func (_u *UserUpdateOne) RemoveRecurringCharges(v ...*RecurringCharge) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRecurringChargeIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1177,6 +1760,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Nickname(); ok {
 		_spec.SetField(user.FieldNickname, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.HomeCurrency(); ok {
+		_spec.SetField(user.FieldHomeCurrency, field.TypeString, value)
+	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1521,6 +2107,215 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.FamilyMembershipCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.FamilyMembershipTable,
+			Columns: []string{user.FamilyMembershipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(familymember.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FamilyMembershipIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   user.FamilyMembershipTable,
+			Columns: []string{user.FamilyMembershipColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(familymember.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedGoalsIDs(); len(nodes) > 0 && !_u.mutation.GoalsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.GoalsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.GoalsTable,
+			Columns: []string{user.GoalsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(goal.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MonthlyReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MonthlyReportsTable,
+			Columns: []string{user.MonthlyReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyreport.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMonthlyReportsIDs(); len(nodes) > 0 && !_u.mutation.MonthlyReportsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MonthlyReportsTable,
+			Columns: []string{user.MonthlyReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyreport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MonthlyReportsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.MonthlyReportsTable,
+			Columns: []string{user.MonthlyReportsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(monthlyreport.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetsTable,
+			Columns: []string{user.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBudgetsIDs(); len(nodes) > 0 && !_u.mutation.BudgetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetsTable,
+			Columns: []string{user.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BudgetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.BudgetsTable,
+			Columns: []string{user.BudgetsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(budget.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RecurringChargesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecurringChargesTable,
+			Columns: []string{user.RecurringChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringcharge.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRecurringChargesIDs(); len(nodes) > 0 && !_u.mutation.RecurringChargesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecurringChargesTable,
+			Columns: []string{user.RecurringChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringcharge.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecurringChargesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RecurringChargesTable,
+			Columns: []string{user.RecurringChargesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(recurringcharge.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(_u.modifiers...)
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
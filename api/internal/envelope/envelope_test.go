@@ -0,0 +1,159 @@
+package envelope_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+
+	"regulation/internal/envelope"
+)
+
+// newCipher creates a cipher over a local KMS holding a key for every ID, each filled with its first byte
+func newCipher(t *testing.T, activeKeyID string, keyIDs ...string) *envelope.Cipher {
+	t.Helper()
+
+	keys := make(map[string][]byte, len(keyIDs))
+	for _, id := range keyIDs {
+		keys[id] = bytes.Repeat([]byte(id[:1]), 32)
+	}
+
+	kms, err := envelope.NewLocalKMS(keys, activeKeyID)
+	if err != nil {
+		t.Fatalf("failed to create kms: %v", err)
+	}
+
+	return envelope.New(kms)
+}
+
+// replacePart replaces one colon-separated part of an encrypted value
+func replacePart(value string, index int, part string) string {
+	parts := strings.Split(value, ":")
+	parts[index] = part
+	return strings.Join(parts, ":")
+}
+
+// flipLastByte flips the last byte of a base64-encoded part of an encrypted value
+func flipLastByte(t *testing.T, value string, index int) string {
+	t.Helper()
+
+	decoded, err := base64.RawStdEncoding.DecodeString(strings.Split(value, ":")[index])
+	if err != nil {
+		t.Fatalf("failed to decode part %d: %v", index, err)
+	}
+	decoded[len(decoded)-1] ^= 0xff
+
+	return replacePart(value, index, base64.RawStdEncoding.EncodeToString(decoded))
+}
+
+func TestCipher(t *testing.T) {
+	const secret = "access-production-1b2c3d"
+
+	sealer := newCipher(t, "a-2024", "a-2024")
+	sealed, err := sealer.Encrypt(secret)
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		cipher  *envelope.Cipher
+		value   string
+		want    string
+		wantErr error // nil to only require some error when fail is set
+		fail    bool
+	}{
+		{
+			name:   "round trip",
+			cipher: sealer,
+			value:  sealed,
+			want:   secret,
+		},
+		{
+			name:   "retired key after rotation",
+			cipher: newCipher(t, "b-2025", "a-2024", "b-2025"),
+			value:  sealed,
+			want:   secret,
+		},
+		{
+			name:   "legacy plaintext",
+			cipher: sealer,
+			value:  "access-sandbox-legacy",
+			want:   "access-sandbox-legacy",
+		},
+		{
+			name:    "retired key no longer configured",
+			cipher:  newCipher(t, "b-2025", "b-2025"),
+			value:   sealed,
+			wantErr: envelope.ErrUnknownKey,
+			fail:    true,
+		},
+		{
+			name:   "wrong key id",
+			cipher: newCipher(t, "a-2024", "a-2024", "b-2025"),
+			value:  replacePart(sealed, 1, "b-2025"),
+			fail:   true,
+		},
+		{
+			name:   "tampered ciphertext",
+			cipher: sealer,
+			value:  flipLastByte(t, sealed, 3),
+			fail:   true,
+		},
+		{
+			name:   "tampered data key",
+			cipher: sealer,
+			value:  flipLastByte(t, sealed, 2),
+			fail:   true,
+		},
+		{
+			name:   "malformed value",
+			cipher: sealer,
+			value:  "v1:a-2024:missing-ciphertext",
+			fail:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.cipher.Decrypt(tt.value)
+			if tt.fail {
+				if err == nil {
+					t.Fatalf("Decrypt() = %q, want an error", got)
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Decrypt() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Decrypt() error = %v", err)
+			}
+			if got != tt.want {
+				t.Fatalf("Decrypt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncryptUsesActiveKey(t *testing.T) {
+	cipher := newCipher(t, "b-2025", "a-2024", "b-2025")
+
+	first, err := cipher.Encrypt("secret")
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+	second, err := cipher.Encrypt("secret")
+	if err != nil {
+		t.Fatalf("failed to encrypt: %v", err)
+	}
+
+	if !envelope.IsEncrypted(first) || !strings.HasPrefix(first, "v1:b-2025:") {
+		t.Errorf("Encrypt() = %q, want a v1 value sealed with b-2025", first)
+	}
+	if first == second {
+		t.Error("Encrypt() sealed the same plaintext to the same value twice")
+	}
+}
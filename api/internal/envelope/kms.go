@@ -0,0 +1,105 @@
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// KMS wraps and unwraps data keys with named key-encryption keys
+type KMS interface {
+	// ActiveKeyID returns the key-encryption key new data keys are wrapped with
+	ActiveKeyID() string
+	// WrapKey encrypts a data key with the given key-encryption key
+	WrapKey(keyID string, dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts a data key wrapped with the given key-encryption key
+	UnwrapKey(keyID string, wrapped []byte) ([]byte, error)
+}
+
+// LocalKMS is a KMS stand-in holding AES-256 key-encryption keys in memory
+type LocalKMS struct {
+	keys   map[string]cipher.AEAD
+	active string
+}
+
+// NewLocalKMS creates a KMS from 32-byte key-encryption keys by ID
+// Retired keys must stay configured until every value wrapped with them is rotated
+func NewLocalKMS(keys map[string][]byte, activeKeyID string) (*LocalKMS, error) {
+	kms := &LocalKMS{
+		keys:   make(map[string]cipher.AEAD, len(keys)),
+		active: activeKeyID,
+	}
+
+	for id, key := range keys {
+		if len(key) != 32 {
+			return nil, fmt.Errorf("key %q must be 32 bytes, got %d", id, len(key))
+		}
+
+		aead, err := newGCM(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", id, err)
+		}
+		kms.keys[id] = aead
+	}
+
+	if _, ok := kms.keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key %q is not configured", activeKeyID)
+	}
+
+	return kms, nil
+}
+
+// ActiveKeyID returns the key-encryption key new data keys are wrapped with
+func (k *LocalKMS) ActiveKeyID() string {
+	return k.active
+}
+
+// WrapKey encrypts a data key with the given key-encryption key
+func (k *LocalKMS) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, keyID)
+	}
+	return seal(aead, dataKey, []byte(keyID))
+}
+
+// UnwrapKey decrypts a data key wrapped with the given key-encryption key
+func (k *LocalKMS) UnwrapKey(keyID string, wrapped []byte) ([]byte, error) {
+	aead, ok := k.keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownKey, keyID)
+	}
+	return open(aead, wrapped, []byte(keyID))
+}
+
+// ErrUnknownKey is returned for key IDs the KMS does not hold
+var ErrUnknownKey = errors.New("unknown key-encryption key")
+
+// newGCM creates an AES-GCM AEAD for a 32-byte key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce prepended to the ciphertext
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts a value produced by seal
+func open(aead cipher.AEAD, sealed, additionalData []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
@@ -6,6 +6,7 @@ const (
 	InvalidRequest         = "invalid_request"
 	InvalidParametersError = "invalid_parameters"
 	NotFoundError          = "not_found"
+	ForbiddenError         = "forbidden"
 )
 
 // Authentication errors
@@ -0,0 +1,39 @@
+package account
+
+import (
+	"fmt"
+	"strings"
+
+	"regulation/server/services/request_context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/gofiber/fiber/v3"
+)
+
+// SetHomeCurrency changes the currency the user's cashflow, net worth and reports are totalled in
+// @Route PUT /account/home-currency
+func (h *Handler) SetHomeCurrency(ctx fiber.Ctx, req *SetHomeCurrencyRequest) error {
+	user := request_context.User(ctx)
+
+	err := h.db.User.
+		UpdateOne(user).
+		SetHomeCurrency(strings.ToUpper(req.HomeCurrency)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update home currency: %w", err)
+	}
+
+	return nil
+}
+
+type SetHomeCurrencyRequest struct {
+	// HomeCurrency is an ISO 4217 currency code such as USD or EUR
+	HomeCurrency string `cbor:"home_currency"`
+}
+
+func (r *SetHomeCurrencyRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.HomeCurrency, validation.Required, is.CurrencyCode),
+	)
+}
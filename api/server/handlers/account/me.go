@@ -13,9 +13,10 @@ func (h *Handler) Me(ctx fiber.Ctx) (*UserResponse, error) {
 	user := request_context.User(ctx)
 
 	return &UserResponse{
-		ID:       user.ID,
-		Email:    user.Email,
-		Nickname: user.Nickname,
+		ID:           user.ID,
+		Email:        user.Email,
+		Nickname:     user.Nickname,
+		HomeCurrency: user.HomeCurrency,
 	}, nil
 }
 
@@ -23,4 +24,6 @@ type UserResponse struct {
 	ID       uuid.UUID `cbor:"id"`
 	Email    string    `cbor:"email"`
 	Nickname string    `cbor:"nickname"`
+	// HomeCurrency is the ISO 4217 currency totals are reported in
+	HomeCurrency string `cbor:"home_currency"`
 }
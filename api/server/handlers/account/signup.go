@@ -2,6 +2,7 @@ package account
 
 import (
 	"fmt"
+	"strings"
 
 	"regulation/internal/ent"
 	"regulation/internal/protocol"
@@ -31,6 +32,7 @@ func (h *Handler) Signup(ctx fiber.Ctx, req *SignupRequest) (ret error) {
 		SetEmail(req.Email).
 		SetPassword(hash).
 		SetNickname(req.Nickname).
+		SetNillableHomeCurrency(req.homeCurrency()).
 		Exec(ctx); err != nil {
 		if ent.IsConstraintError(err) {
 			return protocol.ErrorResponse{
@@ -48,6 +50,17 @@ type SignupRequest struct {
 	Email    string `cbor:"email"`
 	Password string `cbor:"password"`
 	Nickname string `cbor:"nickname"`
+	// HomeCurrency is the ISO 4217 currency totals are reported in, USD when omitted
+	HomeCurrency string `cbor:"home_currency,omitempty"`
+}
+
+// homeCurrency returns the requested home currency, or nil to use the default
+func (r *SignupRequest) homeCurrency() *string {
+	if r.HomeCurrency == "" {
+		return nil
+	}
+	code := strings.ToUpper(r.HomeCurrency)
+	return &code
 }
 
 func (r *SignupRequest) Validate() error {
@@ -55,5 +68,6 @@ func (r *SignupRequest) Validate() error {
 		validation.Field(&r.Email, validation.Required, is.Email),
 		validation.Field(&r.Password, validation.Required, validation.RuneLength(8, 128)),
 		validation.Field(&r.Nickname, validation.Required, validation.RuneLength(3, 30)),
+		validation.Field(&r.HomeCurrency, is.CurrencyCode),
 	)
 }
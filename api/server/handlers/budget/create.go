@@ -0,0 +1,91 @@
+package budget
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entbudget "regulation/internal/ent/budget"
+	entfamilymember "regulation/internal/ent/familymember"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// CreateBudget creates a monthly category budget for the user or their family
+// @Route POST /budgets
+func (h *Handler) CreateBudget(ctx fiber.Ctx, req *CreateBudgetRequest) (*BudgetResponse, error) {
+	session := request_context.Session(ctx)
+
+	if req.FamilyID != nil {
+		member, err := h.db.FamilyMember.
+			Query().
+			Where(
+				entfamilymember.UserID(session.UserID),
+				entfamilymember.FamilyID(*req.FamilyID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, protocol.ErrorResponse{
+					Code:    protocol.NotFoundError,
+					Message: "family not found",
+				}
+			}
+			return nil, fmt.Errorf("failed to load family membership: %w", err)
+		}
+
+		if member.Role != entfamilymember.RoleOwner && member.Role != entfamilymember.RoleParent {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.ForbiddenError,
+				Message: "only family owners and parents can create family budgets",
+			}
+		}
+	}
+
+	create := h.db.Budget.
+		Create().
+		SetUserID(session.UserID).
+		SetNillableFamilyID(req.FamilyID).
+		SetCategory(req.Category).
+		SetAmountCents(req.AmountCents)
+	if req.AlertThresholds != nil {
+		create.SetAlertThresholds(req.AlertThresholds)
+	}
+
+	budget, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create budget: %w", err)
+	}
+
+	response := toBudgetResponse(budget)
+	return &response, nil
+}
+
+type CreateBudgetRequest struct {
+	Category    entbudget.Category `cbor:"category" json:"category"`
+	AmountCents int64              `cbor:"amount_cents" json:"amount_cents"`
+	// AlertThresholds are percentages of the budget that trigger an alert (default 80 and 100)
+	AlertThresholds []int `cbor:"alert_thresholds,omitempty" json:"alert_thresholds,omitempty"`
+	// FamilyID creates a family budget tracked over all members' transactions
+	FamilyID *uuid.UUID `cbor:"family_id,omitempty" json:"family_id,omitempty"`
+}
+
+func (r *CreateBudgetRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Category, validation.Required, validation.In(
+			entbudget.CategoryDining,
+			entbudget.CategoryGroceries,
+			entbudget.CategoryTransport,
+			entbudget.CategoryShopping,
+			entbudget.CategorySubscriptions,
+			entbudget.CategoryEntertainment,
+			entbudget.CategoryBills,
+			entbudget.CategoryMisc,
+		)),
+		validation.Field(&r.AmountCents, validation.Required, validation.Min(int64(1))),
+		validation.Field(&r.AlertThresholds, validation.Length(0, 10), validation.Each(validation.Min(1), validation.Max(1000))),
+	)
+}
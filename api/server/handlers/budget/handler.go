@@ -0,0 +1,82 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entbudget "regulation/internal/ent/budget"
+	entfamilymember "regulation/internal/ent/familymember"
+	"regulation/internal/ent/predicate"
+	"regulation/internal/session"
+	"regulation/server/services/budget"
+)
+
+// Handler handles budget requests
+type Handler struct {
+	db             *ent.Client
+	sessionManager *session.Manager
+	budgetTracker  *budget.Tracker
+}
+
+// New creates a new budget handler
+func New(db *ent.Client, sessionManager *session.Manager, budgetTracker *budget.Tracker) *Handler {
+	return &Handler{
+		db:             db,
+		sessionManager: sessionManager,
+		budgetTracker:  budgetTracker,
+	}
+}
+
+// visibleBudgets returns a predicate matching the user's personal budgets and,
+// when manageOnly is false or the user is a family owner/parent, their family's budgets
+func (h *Handler) visibleBudgets(ctx context.Context, userID uuid.UUID, manageOnly bool) (predicate.Budget, error) {
+	personal := entbudget.And(
+		entbudget.UserID(userID),
+		entbudget.FamilyIDIsNil(),
+	)
+
+	member, err := h.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return personal, nil
+		}
+		return nil, fmt.Errorf("failed to load family membership: %w", err)
+	}
+
+	if manageOnly && member.Role != entfamilymember.RoleOwner && member.Role != entfamilymember.RoleParent {
+		return personal, nil
+	}
+
+	return entbudget.Or(personal, entbudget.FamilyID(member.FamilyID)), nil
+}
+
+type BudgetResponse struct {
+	ID              uuid.UUID  `cbor:"id" json:"id"`
+	FamilyID        *uuid.UUID `cbor:"family_id,omitempty" json:"family_id,omitempty"`
+	Category        string     `cbor:"category" json:"category"`
+	AmountCents     int64      `cbor:"amount_cents" json:"amount_cents"`
+	AlertThresholds []int      `cbor:"alert_thresholds" json:"alert_thresholds"`
+	IsActive        bool       `cbor:"is_active" json:"is_active"`
+	CreatedAt       any        `cbor:"created_at" json:"created_at"`
+	UpdatedAt       any        `cbor:"updated_at" json:"updated_at"`
+}
+
+// toBudgetResponse converts a budget entity to its API representation
+func toBudgetResponse(budget *ent.Budget) BudgetResponse {
+	return BudgetResponse{
+		ID:              budget.ID,
+		FamilyID:        budget.FamilyID,
+		Category:        string(budget.Category),
+		AmountCents:     budget.AmountCents,
+		AlertThresholds: budget.AlertThresholds,
+		IsActive:        budget.IsActive,
+		CreatedAt:       budget.CreatedAt,
+		UpdatedAt:       budget.UpdatedAt,
+	}
+}
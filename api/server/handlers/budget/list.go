@@ -0,0 +1,44 @@
+package budget
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+
+	"regulation/internal/ent"
+	entbudget "regulation/internal/ent/budget"
+	"regulation/server/services/request_context"
+)
+
+// ListBudgets retrieves the user's personal budgets and their family's budgets
+// @Route GET /budgets
+func (h *Handler) ListBudgets(ctx fiber.Ctx) (*ListBudgetsResponse, error) {
+	session := request_context.Session(ctx)
+
+	visible, err := h.visibleBudgets(ctx, session.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := h.db.Budget.
+		Query().
+		Where(visible).
+		Order(ent.Asc(entbudget.FieldCategory), ent.Asc(entbudget.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query budgets: %w", err)
+	}
+
+	response := make([]BudgetResponse, len(budgets))
+	for i, budget := range budgets {
+		response[i] = toBudgetResponse(budget)
+	}
+
+	return &ListBudgetsResponse{
+		Budgets: response,
+	}, nil
+}
+
+type ListBudgetsResponse struct {
+	Budgets []BudgetResponse `cbor:"budgets" json:"budgets"`
+}
@@ -0,0 +1,97 @@
+package budget
+
+import (
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+
+	"regulation/internal/ent"
+	entbudget "regulation/internal/ent/budget"
+	entbudgetalert "regulation/internal/ent/budgetalert"
+	"regulation/server/services/budget"
+	"regulation/server/services/request_context"
+)
+
+// GetBudgetStatus retrieves spend against every visible budget for the current or a past month
+// @Route POST /budgets/status
+func (h *Handler) GetBudgetStatus(ctx fiber.Ctx, req *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error) {
+	session := request_context.Session(ctx)
+
+	// Default to the current month if not provided
+	month := time.Now()
+	if req.Month != nil {
+		month, _ = time.ParseInLocation(budget.MonthFormat, *req.Month, time.Local) // validated in Validate
+	}
+	monthKey := month.Format(budget.MonthFormat)
+
+	visible, err := h.visibleBudgets(ctx, session.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	budgets, err := h.db.Budget.
+		Query().
+		Where(visible).
+		WithAlerts(func(q *ent.BudgetAlertQuery) {
+			q.Where(entbudgetalert.Month(monthKey)).
+				Order(ent.Asc(entbudgetalert.FieldThreshold))
+		}).
+		Order(ent.Asc(entbudget.FieldCategory), ent.Asc(entbudget.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query budgets: %w", err)
+	}
+
+	statuses := make([]BudgetStatus, len(budgets))
+	for i, b := range budgets {
+		spent, err := h.budgetTracker.Spent(ctx, b, month)
+		if err != nil {
+			return nil, err
+		}
+
+		alerted := make([]int, len(b.Edges.Alerts))
+		for j, alert := range b.Edges.Alerts {
+			alerted[j] = alert.Threshold
+		}
+
+		statuses[i] = BudgetStatus{
+			Budget:            toBudgetResponse(b),
+			SpentCents:        spent,
+			RemainingCents:    b.AmountCents - spent,
+			Percent:           float64(spent) / float64(b.AmountCents) * 100,
+			AlertedThresholds: alerted,
+		}
+	}
+
+	return &GetBudgetStatusResponse{
+		Month:    monthKey,
+		Statuses: statuses,
+	}, nil
+}
+
+type GetBudgetStatusRequest struct {
+	// Month in YYYY-MM format, defaults to the current month
+	Month *string `cbor:"month,omitempty" json:"month,omitempty"`
+}
+
+func (r *GetBudgetStatusRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Month, validation.Date(budget.MonthFormat)),
+	)
+}
+
+type GetBudgetStatusResponse struct {
+	Month    string         `cbor:"month" json:"month"`
+	Statuses []BudgetStatus `cbor:"statuses" json:"statuses"`
+}
+
+type BudgetStatus struct {
+	Budget         BudgetResponse `cbor:"budget" json:"budget"`
+	SpentCents     int64          `cbor:"spent_cents" json:"spent_cents"`
+	RemainingCents int64          `cbor:"remaining_cents" json:"remaining_cents"`
+	Percent        float64        `cbor:"percent" json:"percent"`
+	// AlertedThresholds lists the thresholds already alerted in the month
+	AlertedThresholds []int `cbor:"alerted_thresholds" json:"alerted_thresholds"`
+}
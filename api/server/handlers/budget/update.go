@@ -0,0 +1,121 @@
+package budget
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entbudget "regulation/internal/ent/budget"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// UpdateBudget updates the amount, alert thresholds or active state of a budget
+// @Route PATCH /budgets/:id
+func (h *Handler) UpdateBudget(ctx fiber.Ctx, req *UpdateBudgetRequest) (*BudgetResponse, error) {
+	session := request_context.Session(ctx)
+
+	budgetID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid budget ID format",
+		}
+	}
+
+	manageable, err := h.visibleBudgets(ctx, session.UserID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	budget, err := h.db.Budget.
+		Query().
+		Where(
+			entbudget.ID(budgetID),
+			manageable,
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "budget not found",
+			}
+		}
+		return nil, fmt.Errorf("failed to query budget: %w", err)
+	}
+
+	update := h.db.Budget.UpdateOne(budget)
+	if req.AmountCents != nil {
+		update.SetAmountCents(*req.AmountCents)
+	}
+	if req.AlertThresholds != nil {
+		update.SetAlertThresholds(req.AlertThresholds)
+	}
+	if req.IsActive != nil {
+		update.SetIsActive(*req.IsActive)
+	}
+
+	budget, err = update.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update budget: %w", err)
+	}
+
+	response := toBudgetResponse(budget)
+	return &response, nil
+}
+
+// DeleteBudget deletes a budget; rules linked to it keep running without a threshold
+// @Route DELETE /budgets/:id
+func (h *Handler) DeleteBudget(ctx fiber.Ctx) error {
+	session := request_context.Session(ctx)
+
+	budgetID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid budget ID format",
+		}
+	}
+
+	manageable, err := h.visibleBudgets(ctx, session.UserID, true)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := h.db.Budget.
+		Delete().
+		Where(
+			entbudget.ID(budgetID),
+			manageable,
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete budget: %w", err)
+	}
+
+	if deleted == 0 {
+		return protocol.ErrorResponse{
+			Code:    protocol.NotFoundError,
+			Message: "budget not found",
+		}
+	}
+
+	return nil
+}
+
+type UpdateBudgetRequest struct {
+	AmountCents     *int64 `cbor:"amount_cents,omitempty" json:"amount_cents,omitempty"`
+	AlertThresholds []int  `cbor:"alert_thresholds,omitempty" json:"alert_thresholds,omitempty"`
+	IsActive        *bool  `cbor:"is_active,omitempty" json:"is_active,omitempty"`
+}
+
+func (r *UpdateBudgetRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.AmountCents, validation.Min(int64(1))),
+		validation.Field(&r.AlertThresholds, validation.Length(0, 10), validation.Each(validation.Min(1), validation.Max(1000))),
+	)
+}
@@ -0,0 +1,228 @@
+package family
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entfamilymember "regulation/internal/ent/familymember"
+	entgoal "regulation/internal/ent/goal"
+	enttransaction "regulation/internal/ent/transaction"
+	"regulation/server/handlers/models"
+	"regulation/server/services/cashflow"
+	"regulation/server/services/request_context"
+)
+
+const (
+	// topCategoryLimit is the number of spending categories returned in the summary
+	topCategoryLimit = 5
+	// memberTransactionLimit is the maximum number of itemized transactions per member
+	memberTransactionLimit = 100
+)
+
+// GetFamilyCashflow retrieves combined income, spend and auto-save for the family
+// with a per-member breakdown, top categories and family goal progress
+// @Route POST /family/cashflow
+func (h *Handler) GetFamilyCashflow(ctx fiber.Ctx, req *GetFamilyCashflowRequest) (*GetFamilyCashflowResponse, error) {
+	session := request_context.Session(ctx)
+	// Totals are reported in the requester's home currency
+	homeCurrency := request_context.User(ctx).HomeCurrency
+
+	self, err := h.membership(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default date range to current month if not provided
+	now := time.Now()
+	start, end := cashflow.MonthRange(now)
+	if req.Start != nil {
+		start = *req.Start
+	}
+	if req.End != nil {
+		end = *req.End
+	}
+
+	members, err := h.db.FamilyMember.
+		Query().
+		Where(entfamilymember.FamilyID(self.FamilyID)).
+		WithUser().
+		Order(ent.Asc(entfamilymember.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load family members: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		userIDs[i] = m.UserID
+	}
+
+	totals, err := h.cashflowService.TotalsByUser(ctx, userIDs, homeCurrency, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	autoSaved, err := h.cashflowService.AutoSavedByUser(ctx, userIDs, homeCurrency, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := h.cashflowService.SpendByCategory(ctx, userIDs, homeCurrency, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) > topCategoryLimit {
+		categories = categories[:topCategoryLimit]
+	}
+
+	response := &GetFamilyCashflowResponse{
+		Currency:      homeCurrency,
+		Members:       make([]MemberCashflowResponse, 0, len(members)),
+		TopCategories: make([]CategoryTotalResponse, len(categories)),
+		Start:         start,
+		End:           end,
+	}
+
+	for _, m := range members {
+		memberTotals := totals[m.UserID]
+
+		memberResponse := MemberCashflowResponse{
+			UserID:                m.UserID,
+			Role:                  string(m.Role),
+			TransactionVisibility: string(m.TransactionVisibility),
+			TotalIncome:           memberTotals.Income,
+			TotalSpend:            memberTotals.Spend,
+			Net:                   memberTotals.Income - memberTotals.Spend,
+			AutoSaved:             autoSaved[m.UserID],
+		}
+		if m.Edges.User != nil {
+			memberResponse.Nickname = m.Edges.User.Nickname
+		}
+
+		// Members choose whether their transactions are listed; the requester always sees their own
+		if m.UserID == session.UserID || m.TransactionVisibility == entfamilymember.TransactionVisibilityItemized {
+			transactions, err := h.db.Transaction.
+				Query().
+				Where(
+					enttransaction.HasAccountWith(entaccount.UserID(m.UserID)),
+					enttransaction.Pending(false),
+					enttransaction.DateGTE(start),
+					enttransaction.DateLTE(end),
+				).
+				Order(ent.Desc(enttransaction.FieldDate)).
+				Limit(memberTransactionLimit).
+				All(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query member transactions: %w", err)
+			}
+
+			memberResponse.Transactions = make([]models.Transaction, 0, len(transactions))
+			for _, txn := range transactions {
+				merchantPtr := &txn.MerchantName
+				if txn.MerchantName == "" {
+					merchantPtr = nil
+				}
+
+				channelPtr := &txn.PaymentChannel
+				if txn.PaymentChannel == "" {
+					channelPtr = nil
+				}
+
+				memberResponse.Transactions = append(memberResponse.Transactions, models.Transaction{
+					ID:               txn.ID,
+					AccountID:        txn.AccountID,
+					Amount:           txn.Amount,
+					Currency:         txn.Currency,
+					Date:             txn.Date,
+					Name:             txn.Name,
+					MerchantName:     merchantPtr,
+					Category:         txn.Category,
+					Pending:          txn.Pending,
+					PaymentChannel:   channelPtr,
+					InternalTransfer: txn.InternalTransfer,
+					AnomalyScore:     txn.AnomalyScore,
+					AnomalyReason:    txn.AnomalyReason,
+				})
+			}
+		}
+
+		response.TotalIncome += memberResponse.TotalIncome
+		response.TotalSpend += memberResponse.TotalSpend
+		response.AutoSaved += memberResponse.AutoSaved
+		response.Members = append(response.Members, memberResponse)
+	}
+	response.Net = response.TotalIncome - response.TotalSpend
+
+	for i, category := range categories {
+		response.TopCategories[i] = CategoryTotalResponse{
+			Category: category.Category,
+			Spend:    category.Spend,
+		}
+	}
+
+	goals, err := h.db.Goal.
+		Query().
+		Where(entgoal.FamilyID(self.FamilyID)).
+		Order(ent.Asc(entgoal.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query family goals: %w", err)
+	}
+
+	response.Goals = make([]models.Goal, len(goals))
+	for i, goal := range goals {
+		progress, err := h.cashflowService.GoalProgress(ctx, goal, now)
+		if err != nil {
+			return nil, err
+		}
+		response.Goals[i] = models.NewGoal(goal, progress)
+	}
+
+	return response, nil
+}
+
+type GetFamilyCashflowRequest struct {
+	Start *time.Time `cbor:"start,omitempty" json:"start,omitempty"`
+	End   *time.Time `cbor:"end,omitempty" json:"end,omitempty"`
+}
+
+func (r *GetFamilyCashflowRequest) Validate() error {
+	return nil // No validation needed for optional time fields
+}
+
+type GetFamilyCashflowResponse struct {
+	// Currency is the ISO 4217 code every total is converted to
+	Currency      string                   `cbor:"currency" json:"currency"`
+	TotalIncome   int64                    `cbor:"total_income" json:"total_income"`
+	TotalSpend    int64                    `cbor:"total_spend" json:"total_spend"`
+	Net           int64                    `cbor:"net" json:"net"`
+	AutoSaved     int64                    `cbor:"auto_saved" json:"auto_saved"`
+	Members       []MemberCashflowResponse `cbor:"members" json:"members"`
+	TopCategories []CategoryTotalResponse  `cbor:"top_categories" json:"top_categories"`
+	Goals         []models.Goal            `cbor:"goals" json:"goals"`
+	Start         time.Time                `cbor:"start" json:"start"`
+	End           time.Time                `cbor:"end" json:"end"`
+}
+
+type MemberCashflowResponse struct {
+	UserID                uuid.UUID `cbor:"user_id" json:"user_id"`
+	Nickname              string    `cbor:"nickname" json:"nickname"`
+	Role                  string    `cbor:"role" json:"role"`
+	TransactionVisibility string    `cbor:"transaction_visibility" json:"transaction_visibility"`
+	TotalIncome           int64     `cbor:"total_income" json:"total_income"`
+	TotalSpend            int64     `cbor:"total_spend" json:"total_spend"`
+	Net                   int64     `cbor:"net" json:"net"`
+	AutoSaved             int64     `cbor:"auto_saved" json:"auto_saved"`
+	// Transactions is only set for the requester and members sharing itemized transactions
+	Transactions []models.Transaction `cbor:"transactions,omitempty" json:"transactions,omitempty"`
+}
+
+type CategoryTotalResponse struct {
+	Category string `cbor:"category" json:"category"`
+	Spend    int64  `cbor:"spend" json:"spend"`
+}
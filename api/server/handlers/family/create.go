@@ -0,0 +1,91 @@
+package family
+
+import (
+	"fmt"
+
+	"github.com/DeltaLaboratory/contrib/hooks"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+
+	"regulation/internal/ent"
+	entfamilymember "regulation/internal/ent/familymember"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// CreateFamily creates a new family with the current user as its owner
+// @Route POST /family
+func (h *Handler) CreateFamily(ctx fiber.Ctx, req *CreateFamilyRequest) (_ *FamilyResponse, ret error) {
+	session := request_context.Session(ctx)
+
+	// A user can only belong to one family
+	exists, err := h.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(session.UserID)).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check family membership: %w", err)
+	}
+
+	if exists {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidRequest,
+			Message: "user already belongs to a family",
+		}
+	}
+
+	tx, err := h.db.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer hooks.Rollback(tx, &ret)
+
+	family, err := tx.Family.
+		Create().
+		SetName(req.Name).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create family: %w", err)
+	}
+
+	owner, err := tx.FamilyMember.
+		Create().
+		SetFamilyID(family.ID).
+		SetUserID(session.UserID).
+		SetRole(entfamilymember.RoleOwner).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.InvalidRequest,
+				Message: "user already belongs to a family",
+			}
+		}
+		return nil, fmt.Errorf("failed to add family owner: %w", err)
+	}
+
+	return &FamilyResponse{
+		ID:        family.ID,
+		Name:      family.Name,
+		CreatedAt: family.CreatedAt,
+		Members: []MemberResponse{
+			{
+				ID:                    owner.ID,
+				UserID:                owner.UserID,
+				Nickname:              request_context.User(ctx).Nickname,
+				Role:                  string(owner.Role),
+				TransactionVisibility: string(owner.TransactionVisibility),
+			},
+		},
+	}, nil
+}
+
+type CreateFamilyRequest struct {
+	Name string `cbor:"name" json:"name"`
+}
+
+func (r *CreateFamilyRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Name, validation.Required, validation.RuneLength(1, 50)),
+	)
+}
@@ -0,0 +1,74 @@
+package family
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entfamily "regulation/internal/ent/family"
+	entfamilymember "regulation/internal/ent/familymember"
+	"regulation/server/services/request_context"
+)
+
+// GetFamily retrieves the current user's family with its members
+// @Route GET /family
+func (h *Handler) GetFamily(ctx fiber.Ctx) (*FamilyResponse, error) {
+	session := request_context.Session(ctx)
+
+	member, err := h.membership(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	family, err := h.db.Family.
+		Query().
+		Where(entfamily.ID(member.FamilyID)).
+		WithMembers(func(q *ent.FamilyMemberQuery) {
+			q.WithUser().Order(ent.Asc(entfamilymember.FieldCreatedAt))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load family: %w", err)
+	}
+
+	members := make([]MemberResponse, 0, len(family.Edges.Members))
+	for _, m := range family.Edges.Members {
+		resp := MemberResponse{
+			ID:                    m.ID,
+			UserID:                m.UserID,
+			Role:                  string(m.Role),
+			TransactionVisibility: string(m.TransactionVisibility),
+		}
+		if m.Edges.User != nil {
+			resp.Nickname = m.Edges.User.Nickname
+		}
+		members = append(members, resp)
+	}
+
+	return &FamilyResponse{
+		ID:               family.ID,
+		Name:             family.Name,
+		SavingsAccountID: family.SavingsAccountID,
+		Members:          members,
+		CreatedAt:        family.CreatedAt,
+	}, nil
+}
+
+type FamilyResponse struct {
+	ID               uuid.UUID        `cbor:"id" json:"id"`
+	Name             string           `cbor:"name" json:"name"`
+	SavingsAccountID *uuid.UUID       `cbor:"savings_account_id,omitempty" json:"savings_account_id,omitempty"`
+	Members          []MemberResponse `cbor:"members" json:"members"`
+	CreatedAt        time.Time        `cbor:"created_at" json:"created_at"`
+}
+
+type MemberResponse struct {
+	ID                    uuid.UUID `cbor:"id" json:"id"`
+	UserID                uuid.UUID `cbor:"user_id" json:"user_id"`
+	Nickname              string    `cbor:"nickname" json:"nickname"`
+	Role                  string    `cbor:"role" json:"role"`
+	TransactionVisibility string    `cbor:"transaction_visibility" json:"transaction_visibility"`
+}
@@ -0,0 +1,75 @@
+package family
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entfamilymember "regulation/internal/ent/familymember"
+	"regulation/internal/protocol"
+	"regulation/internal/session"
+	"regulation/server/services/cashflow"
+)
+
+// Handler handles family management requests
+type Handler struct {
+	db              *ent.Client
+	sessionManager  *session.Manager
+	cashflowService *cashflow.Service
+}
+
+// New creates a new family handler
+func New(db *ent.Client, sessionManager *session.Manager, cashflowService *cashflow.Service) *Handler {
+	return &Handler{
+		db:              db,
+		sessionManager:  sessionManager,
+		cashflowService: cashflowService,
+	}
+}
+
+// membership loads the family membership of a user
+// Codes: NotFoundError
+func (h *Handler) membership(ctx context.Context, userID uuid.UUID) (*ent.FamilyMember, error) {
+	member, err := h.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(userID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "user is not a member of a family",
+			}
+		}
+		return nil, fmt.Errorf("failed to load family membership: %w", err)
+	}
+
+	return member, nil
+}
+
+// managingMembership loads the family membership of a user and ensures the
+// user is allowed to manage the family (owner or parent)
+// Codes: NotFoundError, ForbiddenError
+func (h *Handler) managingMembership(ctx context.Context, userID uuid.UUID) (*ent.FamilyMember, error) {
+	member, err := h.membership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !CanManage(member) {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.ForbiddenError,
+			Message: "only family owners and parents can manage the family",
+		}
+	}
+
+	return member, nil
+}
+
+// CanManage reports whether a member may manage family settings and family rules
+func CanManage(member *ent.FamilyMember) bool {
+	return member.Role == entfamilymember.RoleOwner || member.Role == entfamilymember.RoleParent
+}
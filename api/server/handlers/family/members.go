@@ -0,0 +1,145 @@
+package family
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entfamilymember "regulation/internal/ent/familymember"
+	entuser "regulation/internal/ent/user"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// AddMember adds an existing user to the current user's family
+// @Route POST /family/members
+func (h *Handler) AddMember(ctx fiber.Ctx, req *AddMemberRequest) (*MemberResponse, error) {
+	session := request_context.Session(ctx)
+
+	manager, err := h.managingMembership(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only owners can grant the parent role
+	if req.Role == entfamilymember.RoleParent && manager.Role != entfamilymember.RoleOwner {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.ForbiddenError,
+			Message: "only the family owner can add parents",
+		}
+	}
+
+	invitee, err := h.db.User.
+		Query().
+		Where(entuser.EmailEQ(req.Email)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "user not found",
+			}
+		}
+		return nil, fmt.Errorf("failed to query user: %w", err)
+	}
+
+	member, err := h.db.FamilyMember.
+		Create().
+		SetFamilyID(manager.FamilyID).
+		SetUserID(invitee.ID).
+		SetRole(req.Role).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.InvalidRequest,
+				Message: "user already belongs to a family",
+			}
+		}
+		return nil, fmt.Errorf("failed to add family member: %w", err)
+	}
+
+	return &MemberResponse{
+		ID:                    member.ID,
+		UserID:                member.UserID,
+		Nickname:              invitee.Nickname,
+		Role:                  string(member.Role),
+		TransactionVisibility: string(member.TransactionVisibility),
+	}, nil
+}
+
+// RemoveMember removes a member from the family; members may also remove themselves
+// @Route DELETE /family/members/:id
+func (h *Handler) RemoveMember(ctx fiber.Ctx) error {
+	session := request_context.Session(ctx)
+
+	memberID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid member ID format",
+		}
+	}
+
+	self, err := h.membership(ctx, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	target, err := h.db.FamilyMember.
+		Query().
+		Where(
+			entfamilymember.ID(memberID),
+			entfamilymember.FamilyID(self.FamilyID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "family member not found",
+			}
+		}
+		return fmt.Errorf("failed to load family member: %w", err)
+	}
+
+	if target.ID != self.ID && !CanManage(self) {
+		return protocol.ErrorResponse{
+			Code:    protocol.ForbiddenError,
+			Message: "only family owners and parents can remove other members",
+		}
+	}
+
+	if target.Role == entfamilymember.RoleOwner {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidRequest,
+			Message: "the family owner cannot be removed",
+		}
+	}
+
+	if err := h.db.FamilyMember.DeleteOne(target).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to remove family member: %w", err)
+	}
+
+	return nil
+}
+
+type AddMemberRequest struct {
+	Email string               `cbor:"email" json:"email"`
+	Role  entfamilymember.Role `cbor:"role" json:"role"`
+}
+
+func (r *AddMemberRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Email, validation.Required, is.Email),
+		validation.Field(&r.Role, validation.Required, validation.In(
+			entfamilymember.RoleParent,
+			entfamilymember.RoleMember,
+			entfamilymember.RoleTeen,
+		)),
+	)
+}
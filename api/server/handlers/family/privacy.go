@@ -0,0 +1,46 @@
+package family
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+
+	entfamilymember "regulation/internal/ent/familymember"
+	"regulation/server/services/request_context"
+)
+
+// SetTransactionVisibility controls whether other family members see the
+// current user's individual transactions or only their totals
+// @Route PUT /family/privacy
+func (h *Handler) SetTransactionVisibility(ctx fiber.Ctx, req *SetTransactionVisibilityRequest) error {
+	session := request_context.Session(ctx)
+
+	member, err := h.membership(ctx, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = h.db.FamilyMember.
+		UpdateOne(member).
+		SetTransactionVisibility(req.TransactionVisibility).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update transaction visibility: %w", err)
+	}
+
+	return nil
+}
+
+type SetTransactionVisibilityRequest struct {
+	TransactionVisibility entfamilymember.TransactionVisibility `cbor:"transaction_visibility" json:"transaction_visibility"`
+}
+
+func (r *SetTransactionVisibilityRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.TransactionVisibility, validation.Required, validation.In(
+			entfamilymember.TransactionVisibilityTotals,
+			entfamilymember.TransactionVisibilityItemized,
+		)),
+	)
+}
@@ -0,0 +1,87 @@
+package family
+
+import (
+	"fmt"
+
+	"github.com/DeltaLaboratory/contrib/hooks"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entrule "regulation/internal/ent/rule"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// SetSavingsAccount designates one of the current user's savings accounts as the Family Savings account
+// @Route PUT /family/savings-account
+func (h *Handler) SetSavingsAccount(ctx fiber.Ctx, req *SetSavingsAccountRequest) (ret error) {
+	session := request_context.Session(ctx)
+
+	member, err := h.managingMembership(ctx, session.UserID)
+	if err != nil {
+		return err
+	}
+
+	// The shared account must be an active savings account owned by the requester
+	savingsAccount, err := h.db.Account.
+		Query().
+		Where(
+			entaccount.ID(req.AccountID),
+			entaccount.UserID(session.UserID),
+			entaccount.IsActive(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "account not found or not owned by user",
+			}
+		}
+		return fmt.Errorf("failed to validate account: %w", err)
+	}
+
+	if savingsAccount.Type != entaccount.TypeSavings {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "family savings account must be a savings account",
+		}
+	}
+
+	tx, err := h.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer hooks.Rollback(tx, &ret)
+
+	if err := tx.Family.
+		UpdateOneID(member.FamilyID).
+		SetSavingsAccountID(savingsAccount.ID).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set family savings account: %w", err)
+	}
+
+	// Family rules always save into the current Family Savings account
+	if err := tx.Rule.
+		Update().
+		Where(entrule.FamilyID(member.FamilyID)).
+		SetTargetAccountID(savingsAccount.ID).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to retarget family rules: %w", err)
+	}
+
+	return nil
+}
+
+type SetSavingsAccountRequest struct {
+	AccountID uuid.UUID `cbor:"account_id" json:"account_id"`
+}
+
+func (r *SetSavingsAccountRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.AccountID, validation.Required),
+	)
+}
@@ -0,0 +1,51 @@
+package financial
+
+import (
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/gofiber/fiber/v3"
+
+	entaccount "regulation/internal/ent/account"
+	"regulation/server/handlers/models"
+	"regulation/server/services/request_context"
+)
+
+// CreateManualAccount creates an account for a bank Plaid does not cover, filled by importing its statements
+// @Route POST /financial/manual-accounts
+func (h *Handler) CreateManualAccount(ctx fiber.Ctx, req *CreateManualAccountRequest) (*models.Account, error) {
+	user := request_context.User(ctx)
+
+	currencyCode := req.Currency
+	if currencyCode == "" {
+		currencyCode = user.HomeCurrency
+	}
+
+	acc, err := h.importService.CreateManualAccount(ctx, user.ID, req.Name, entaccount.Type(req.Type), req.Mask, currencyCode)
+	if err != nil {
+		return nil, err
+	}
+
+	account := models.NewAccount(acc)
+	return &account, nil
+}
+
+type CreateManualAccountRequest struct {
+	Name string `cbor:"name"`
+	// Type is checking, savings, credit or other
+	Type string `cbor:"type"`
+	// Mask is the last 4 digits of the account number
+	Mask string `cbor:"mask,omitempty"`
+	// Currency is an ISO 4217 currency code, the user's home currency when empty
+	Currency string `cbor:"currency,omitempty"`
+}
+
+func (r *CreateManualAccountRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Name, validation.Required, validation.Length(1, 100)),
+		validation.Field(&r.Type, validation.Required, validation.By(func(value any) error {
+			return entaccount.TypeValidator(entaccount.Type(value.(string)))
+		})),
+		validation.Field(&r.Mask, validation.Length(0, 4), is.Digit),
+		validation.Field(&r.Currency, is.CurrencyCode),
+	)
+}
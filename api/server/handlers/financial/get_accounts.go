@@ -13,12 +13,12 @@ import (
 // GetAccounts retrieves all accounts for the authenticated user
 // @Route GET /financial/accounts
 func (h *Handler) GetAccounts(ctx fiber.Ctx) (*GetAccountsResponse, error) {
-	session := request_context.Session(ctx)
+	user := request_context.User(ctx)
 
 	// Query all active accounts for user
 	accounts, err := h.db.Account.
 		Query().
-		Where(account.UserID(session.UserID)).
+		Where(account.UserID(user.ID)).
 		Where(account.IsActive(true)).
 		All(ctx)
 
@@ -28,28 +28,26 @@ func (h *Handler) GetAccounts(ctx fiber.Ctx) (*GetAccountsResponse, error) {
 
 	// Convert to response
 	responses := make([]models.Account, 0, len(accounts))
+	var owed, limit int64
 	for _, acc := range accounts {
-		maskPtr := &acc.Mask
-		if acc.Mask == "" {
-			maskPtr = nil
-		}
+		responses = append(responses, models.NewAccount(acc))
 
-		responses = append(responses, models.Account{
-			ID:               acc.ID,
-			Name:             acc.Name,
-			Type:             string(acc.Type),
-			Mask:             maskPtr,
-			CurrentBalance:   acc.CurrentBalance,
-			AvailableBalance: acc.AvailableBalance,
-			IsActive:         acc.IsActive,
-		})
+		// Overall utilization only covers cards with a known limit in the home currency
+		if acc.Type == account.TypeCredit && acc.CreditLimit != nil && acc.Currency == user.HomeCurrency {
+			owed += acc.CurrentBalance
+			limit += *acc.CreditLimit
+		}
 	}
 
 	return &GetAccountsResponse{
-		Accounts: responses,
+		Accounts:          responses,
+		CreditUtilization: models.CreditUtilization(owed, limit),
 	}, nil
 }
 
 type GetAccountsResponse struct {
 	Accounts []models.Account `cbor:"accounts" json:"accounts"`
+	// CreditUtilization is the balance owed across credit accounts in the home currency
+	// as a percentage of their combined limits
+	CreditUtilization *float64 `cbor:"credit_utilization,omitempty" json:"credit_utilization,omitempty"`
 }
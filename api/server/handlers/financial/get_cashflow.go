@@ -1,74 +1,101 @@
 package financial
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
 
-	"regulation/internal/ent/account"
-	"regulation/internal/ent/transaction"
+	"regulation/server/services/cashflow"
 	"regulation/server/services/request_context"
 )
 
-// GetCashflow retrieves income, spend, and NET for a date range
+// GetCashflow retrieves income, spend, auto-save and NET for a date range,
+// broken down by category and compared with the previous period
 // @Route POST /financial/cashflow
 func (h *Handler) GetCashflow(ctx fiber.Ctx, req *GetCashflowRequest) (*GetCashflowResponse, error) {
-	session := request_context.Session(ctx)
+	user := request_context.User(ctx)
+	userIDs := []uuid.UUID{user.ID}
 
 	// Set default date range to current month if not provided
-	now := time.Now()
-	start := req.Start
-	end := req.End
+	start, end := cashflow.MonthRange(time.Now())
+	if req.Start != nil {
+		start = *req.Start
+	}
+	if req.End != nil {
+		end = *req.End
+	}
+	previousStart, previousEnd := cashflow.PreviousPeriod(start, end)
 
-	if start == nil {
-		monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
-		start = &monthStart
+	current, err := h.cashflowService.Summarize(ctx, userIDs, user.HomeCurrency, start, end)
+	if err != nil {
+		return nil, err
 	}
 
-	if end == nil {
-		monthEnd := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, now.Location()).Add(-time.Second)
-		end = &monthEnd
+	previous, err := h.cashflowService.Summarize(ctx, userIDs, user.HomeCurrency, previousStart, previousEnd)
+	if err != nil {
+		return nil, err
 	}
 
-	// Get all transactions for user's accounts within date range
-	transactions, err := h.db.Transaction.
-		Query().
-		Where(transaction.HasAccountWith(
-			account.UserID(session.UserID),
-		)).
-		Where(transaction.DateGTE(*start)).
-		Where(transaction.DateLTE(*end)).
-		Where(transaction.Pending(false)). // Only count non-pending transactions
-		All(ctx)
+	categories, err := h.cashflowService.SpendByCategory(ctx, userIDs, user.HomeCurrency, start, end)
+	if err != nil {
+		return nil, err
+	}
 
+	previousCategories, err := h.cashflowService.SpendByCategory(ctx, userIDs, user.HomeCurrency, previousStart, previousEnd)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query transactions: %w", err)
+		return nil, err
 	}
 
-	// Calculate income and spend
-	var totalIncome int64
-	var totalSpend int64
-
-	for _, txn := range transactions {
-		if txn.Amount < 0 {
-			// Negative amount = credit (income)
-			totalIncome += -txn.Amount // Convert to positive
-		} else {
-			// Positive amount = debit (spend)
-			totalSpend += txn.Amount
-		}
+	previousSpend := make(map[string]int64, len(previousCategories))
+	for _, category := range previousCategories {
+		previousSpend[category.Category] = category.Spend
 	}
 
-	// Calculate NET
-	net := totalIncome - totalSpend
+	categoryResponses := make([]CategoryCashflow, len(categories))
+	for i, category := range categories {
+		var share float64
+		if current.Spend > 0 {
+			share = float64(category.Spend) / float64(current.Spend) * 100
+		}
+
+		categoryResponses[i] = CategoryCashflow{
+			Category:      category.Category,
+			Spend:         category.Spend,
+			SharePercent:  share,
+			PreviousSpend: previousSpend[category.Category],
+			Change:        category.Spend - previousSpend[category.Category],
+			ChangePercent: cashflow.PercentChange(previousSpend[category.Category], category.Spend),
+		}
+	}
 
 	return &GetCashflowResponse{
-		TotalIncome: totalIncome,
-		TotalSpend:  totalSpend,
-		Net:         net,
-		Start:       *start,
-		End:         *end,
+		Currency:    current.Currency,
+		TotalIncome: current.Income,
+		TotalSpend:  current.Spend,
+		Net:         current.Net(),
+		AutoSaved:   current.AutoSaved,
+		Categories:  categoryResponses,
+		Previous: CashflowPeriod{
+			TotalIncome: previous.Income,
+			TotalSpend:  previous.Spend,
+			Net:         previous.Net(),
+			AutoSaved:   previous.AutoSaved,
+			Start:       previousStart,
+			End:         previousEnd,
+		},
+		Change: CashflowChange{
+			TotalIncome:        current.Income - previous.Income,
+			TotalIncomePercent: cashflow.PercentChange(previous.Income, current.Income),
+			TotalSpend:         current.Spend - previous.Spend,
+			TotalSpendPercent:  cashflow.PercentChange(previous.Spend, current.Spend),
+			Net:                current.Net() - previous.Net(),
+			NetPercent:         cashflow.PercentChange(previous.Net(), current.Net()),
+			AutoSaved:          current.AutoSaved - previous.AutoSaved,
+			AutoSavedPercent:   cashflow.PercentChange(previous.AutoSaved, current.AutoSaved),
+		},
+		Start: start,
+		End:   end,
 	}, nil
 }
 
@@ -82,9 +109,48 @@ func (r *GetCashflowRequest) Validate() error {
 }
 
 type GetCashflowResponse struct {
+	// Currency is the ISO 4217 code every amount is converted to
+	Currency    string             `cbor:"currency" json:"currency"`
+	TotalIncome int64              `cbor:"total_income" json:"total_income"`
+	TotalSpend  int64              `cbor:"total_spend" json:"total_spend"`
+	Net         int64              `cbor:"net" json:"net"`
+	AutoSaved   int64              `cbor:"auto_saved" json:"auto_saved"`
+	Categories  []CategoryCashflow `cbor:"categories" json:"categories"`
+	Previous    CashflowPeriod     `cbor:"previous" json:"previous"`
+	Change      CashflowChange     `cbor:"change" json:"change"`
+	Start       time.Time          `cbor:"start" json:"start"`
+	End         time.Time          `cbor:"end" json:"end"`
+}
+
+// CategoryCashflow holds spend for a single category, ordered by spend descending
+type CategoryCashflow struct {
+	Category      string   `cbor:"category" json:"category"`
+	Spend         int64    `cbor:"spend" json:"spend"`
+	SharePercent  float64  `cbor:"share_percent" json:"share_percent"`
+	PreviousSpend int64    `cbor:"previous_spend" json:"previous_spend"`
+	Change        int64    `cbor:"change" json:"change"`
+	ChangePercent *float64 `cbor:"change_percent,omitempty" json:"change_percent,omitempty"`
+}
+
+// CashflowPeriod holds the totals of the period compared against
+type CashflowPeriod struct {
 	TotalIncome int64     `cbor:"total_income" json:"total_income"`
 	TotalSpend  int64     `cbor:"total_spend" json:"total_spend"`
 	Net         int64     `cbor:"net" json:"net"`
+	AutoSaved   int64     `cbor:"auto_saved" json:"auto_saved"`
 	Start       time.Time `cbor:"start" json:"start"`
 	End         time.Time `cbor:"end" json:"end"`
 }
+
+// CashflowChange holds period-over-period deltas; percentages are omitted
+// when the previous value is zero
+type CashflowChange struct {
+	TotalIncome        int64    `cbor:"total_income" json:"total_income"`
+	TotalIncomePercent *float64 `cbor:"total_income_percent,omitempty" json:"total_income_percent,omitempty"`
+	TotalSpend         int64    `cbor:"total_spend" json:"total_spend"`
+	TotalSpendPercent  *float64 `cbor:"total_spend_percent,omitempty" json:"total_spend_percent,omitempty"`
+	Net                int64    `cbor:"net" json:"net"`
+	NetPercent         *float64 `cbor:"net_percent,omitempty" json:"net_percent,omitempty"`
+	AutoSaved          int64    `cbor:"auto_saved" json:"auto_saved"`
+	AutoSavedPercent   *float64 `cbor:"auto_saved_percent,omitempty" json:"auto_saved_percent,omitempty"`
+}
@@ -0,0 +1,110 @@
+package financial
+
+import (
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/protocol"
+	"regulation/server/services/networth"
+	"regulation/server/services/request_context"
+)
+
+// GetNetWorth retrieves the authenticated user's balance history as a net worth timeline,
+// with checking, savings and credit balances broken out for charting and converted to the home currency
+// Defaults to weekly points over the last year
+// @Route POST /financial/net-worth
+func (h *Handler) GetNetWorth(ctx fiber.Ctx, req *GetNetWorthRequest) (*GetNetWorthResponse, error) {
+	user := request_context.User(ctx)
+
+	end := time.Now()
+	if req.End != nil {
+		end = *req.End
+	}
+	start := end.AddDate(-1, 0, 0)
+	if req.Start != nil {
+		start = *req.Start
+	}
+
+	granularity := networth.GranularityWeek
+	if req.Granularity != "" {
+		granularity = networth.Granularity(req.Granularity)
+	}
+
+	if start.After(end) {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "start must not be after end",
+		}
+	}
+	if networth.PeriodCount(start, end, granularity) > networth.MaxPoints {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: fmt.Sprintf("range has more than %d points, choose a shorter range or coarser granularity", networth.MaxPoints),
+		}
+	}
+
+	points, err := h.netWorthService.Timeline(ctx, []uuid.UUID{user.ID}, user.HomeCurrency, start, end, granularity)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetNetWorthResponse{
+		Points:      make([]NetWorthPoint, len(points)),
+		Granularity: string(granularity),
+		Currency:    user.HomeCurrency,
+	}
+
+	for i, point := range points {
+		response.Points[i] = NetWorthPoint{
+			Date:     point.Date,
+			Checking: point.Checking,
+			Savings:  point.Savings,
+			Other:    point.Other,
+			Credit:   point.Credit,
+			Assets:   point.Assets(),
+			NetWorth: point.NetWorth(),
+		}
+	}
+
+	return response, nil
+}
+
+type GetNetWorthRequest struct {
+	Start *time.Time `cbor:"start,omitempty" json:"start,omitempty"`
+	End   *time.Time `cbor:"end,omitempty" json:"end,omitempty"`
+	// Granularity is day, week or month
+	Granularity string `cbor:"granularity,omitempty" json:"granularity,omitempty"`
+}
+
+func (r *GetNetWorthRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Granularity, validation.In(
+			string(networth.GranularityDay),
+			string(networth.GranularityWeek),
+			string(networth.GranularityMonth),
+		)),
+	)
+}
+
+type GetNetWorthResponse struct {
+	Points      []NetWorthPoint `cbor:"points" json:"points"`
+	Granularity string          `cbor:"granularity" json:"granularity"`
+	// Currency is the ISO 4217 code every balance is converted to
+	Currency string `cbor:"currency" json:"currency"`
+}
+
+// NetWorthPoint holds balances in cents as of the end of a period
+// Credit is the amount owed on credit accounts and is subtracted from NetWorth
+type NetWorthPoint struct {
+	Date     time.Time `cbor:"date" json:"date"`
+	Checking int64     `cbor:"checking" json:"checking"`
+	Savings  int64     `cbor:"savings" json:"savings"`
+	Other    int64     `cbor:"other" json:"other"`
+	Credit   int64     `cbor:"credit" json:"credit"`
+	Assets   int64     `cbor:"assets" json:"assets"`
+	NetWorth int64     `cbor:"net_worth" json:"net_worth"`
+}
@@ -0,0 +1,90 @@
+package financial
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entrecurringcharge "regulation/internal/ent/recurringcharge"
+	"regulation/server/services/request_context"
+)
+
+// GetRecurringCharges retrieves the detected recurring charges of the authenticated user
+// ordered by the next predicted charge
+// @Route GET /financial/recurring
+func (h *Handler) GetRecurringCharges(ctx fiber.Ctx) (*GetRecurringChargesResponse, error) {
+	session := request_context.Session(ctx)
+
+	charges, err := h.db.RecurringCharge.
+		Query().
+		Where(entrecurringcharge.UserID(session.UserID)).
+		Order(
+			ent.Desc(entrecurringcharge.FieldIsActive),
+			ent.Asc(entrecurringcharge.FieldNextChargeDate),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query recurring charges: %w", err)
+	}
+
+	response := &GetRecurringChargesResponse{
+		Charges: make([]RecurringChargeResponse, len(charges)),
+	}
+
+	for i, charge := range charges {
+		response.Charges[i] = RecurringChargeResponse{
+			ID:                  charge.ID,
+			MerchantName:        charge.MerchantName,
+			Category:            charge.Category,
+			Cadence:             string(charge.Cadence),
+			AmountCents:         charge.AmountCents,
+			PreviousAmountCents: charge.PreviousAmountCents,
+			PriceIncreased:      charge.PriceIncreased,
+			Occurrences:         charge.Occurrences,
+			LastChargeDate:      charge.LastChargeDate,
+			NextChargeDate:      charge.NextChargeDate,
+			IsActive:            charge.IsActive,
+		}
+
+		if charge.IsActive {
+			response.MonthlyTotalCents += monthlyAmount(charge)
+		}
+	}
+
+	return response, nil
+}
+
+// monthlyAmount normalizes a recurring charge to its monthly cost
+func monthlyAmount(charge *ent.RecurringCharge) int64 {
+	switch charge.Cadence {
+	case entrecurringcharge.CadenceWeekly:
+		return charge.AmountCents * 52 / 12
+	case entrecurringcharge.CadenceAnnual:
+		return charge.AmountCents / 12
+	default:
+		return charge.AmountCents
+	}
+}
+
+type GetRecurringChargesResponse struct {
+	Charges []RecurringChargeResponse `cbor:"charges" json:"charges"`
+	// MonthlyTotalCents is the estimated monthly cost of all active recurring charges
+	MonthlyTotalCents int64 `cbor:"monthly_total_cents" json:"monthly_total_cents"`
+}
+
+type RecurringChargeResponse struct {
+	ID                  uuid.UUID `cbor:"id" json:"id"`
+	MerchantName        string    `cbor:"merchant_name" json:"merchant_name"`
+	Category            string    `cbor:"category" json:"category"`
+	Cadence             string    `cbor:"cadence" json:"cadence"`
+	AmountCents         int64     `cbor:"amount_cents" json:"amount_cents"`
+	PreviousAmountCents *int64    `cbor:"previous_amount_cents,omitempty" json:"previous_amount_cents,omitempty"`
+	PriceIncreased      bool      `cbor:"price_increased" json:"price_increased"`
+	Occurrences         int       `cbor:"occurrences" json:"occurrences"`
+	LastChargeDate      time.Time `cbor:"last_charge_date" json:"last_charge_date"`
+	NextChargeDate      time.Time `cbor:"next_charge_date" json:"next_charge_date"`
+	IsActive            bool      `cbor:"is_active" json:"is_active"`
+}
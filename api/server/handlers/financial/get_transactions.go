@@ -72,15 +72,19 @@ func (h *Handler) GetTransactions(ctx fiber.Ctx, req *GetTransactionsRequest) (*
 		}
 
 		responses = append(responses, models.Transaction{
-			ID:             txn.ID,
-			AccountID:      txn.AccountID,
-			Amount:         txn.Amount,
-			Date:           txn.Date,
-			Name:           txn.Name,
-			MerchantName:   merchantPtr,
-			Category:       txn.Category,
-			Pending:        txn.Pending,
-			PaymentChannel: channelPtr,
+			ID:               txn.ID,
+			AccountID:        txn.AccountID,
+			Amount:           txn.Amount,
+			Currency:         txn.Currency,
+			Date:             txn.Date,
+			Name:             txn.Name,
+			MerchantName:     merchantPtr,
+			Category:         txn.Category,
+			Pending:          txn.Pending,
+			PaymentChannel:   channelPtr,
+			InternalTransfer: txn.InternalTransfer,
+			AnomalyScore:     txn.AnomalyScore,
+			AnomalyReason:    txn.AnomalyReason,
 		})
 	}
 
@@ -3,18 +3,27 @@ package financial
 import (
 	"regulation/internal/ent"
 	"regulation/internal/session"
+	"regulation/server/services/cashflow"
+	"regulation/server/services/importer"
+	"regulation/server/services/networth"
 )
 
 // Handler manages financial data queries for dashboard
 type Handler struct {
-	db             *ent.Client
-	sessionManager *session.Manager
+	db              *ent.Client
+	sessionManager  *session.Manager
+	cashflowService *cashflow.Service
+	netWorthService *networth.Service
+	importService   *importer.Service
 }
 
 // New creates a new financial handler
-func New(db *ent.Client, sessionManager *session.Manager) *Handler {
+func New(db *ent.Client, sessionManager *session.Manager, cashflowService *cashflow.Service, netWorthService *networth.Service, importService *importer.Service) *Handler {
 	return &Handler{
-		db:             db,
-		sessionManager: sessionManager,
+		db:              db,
+		sessionManager:  sessionManager,
+		cashflowService: cashflowService,
+		netWorthService: netWorthService,
+		importService:   importService,
 	}
 }
@@ -0,0 +1,113 @@
+package financial
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/protocol"
+	"regulation/server/services/importer"
+	"regulation/server/services/request_context"
+)
+
+// ImportStatement imports the transactions of a CSV, OFX or QFX bank statement into a manual account
+// Transactions already imported are skipped, so overlapping statements can be imported safely
+// @Route POST /financial/accounts/:id/import
+func (h *Handler) ImportStatement(ctx fiber.Ctx, req *ImportStatementRequest) (*ImportStatementResponse, error) {
+	session := request_context.Session(ctx)
+
+	accountID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid account id",
+		}
+	}
+
+	acc, err := h.userAccount(ctx, session.UserID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	format, err := importer.ParseFormat(req.Format)
+	if err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: err.Error(),
+		}
+	}
+
+	summary, err := h.importService.Import(ctx, acc, format, req.Data, req.Profile)
+	if errors.Is(err, importer.ErrInvalidStatement) {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: err.Error(),
+		}
+	}
+	if errors.Is(err, importer.ErrNotManual) || errors.Is(err, importer.ErrCurrencyMismatch) {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidRequest,
+			Message: err.Error(),
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	skipped := make([]SkippedRow, len(summary.Skipped))
+	for i, row := range summary.Skipped {
+		skipped[i] = SkippedRow{
+			Line:   row.Line,
+			Reason: row.Reason,
+		}
+	}
+
+	return &ImportStatementResponse{
+		Parsed:         summary.Parsed,
+		Imported:       summary.Imported,
+		Duplicates:     summary.Duplicates,
+		Skipped:        skipped,
+		RulesTriggered: summary.RulesTriggered,
+		From:           summary.From,
+		To:             summary.To,
+		BalanceUpdated: summary.BalanceUpdated,
+	}, nil
+}
+
+type ImportStatementRequest struct {
+	// Format is csv, ofx or qfx
+	Format string `cbor:"format"`
+	// Data is the content of the statement file
+	Data []byte `cbor:"data"`
+	// Profile maps the columns of CSV statements, the Date, Description and Amount columns when omitted
+	Profile *importer.CSVProfile `cbor:"profile,omitempty"`
+}
+
+func (r *ImportStatementRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Format, validation.Required),
+		validation.Field(&r.Data, validation.Required),
+		validation.Field(&r.Profile),
+	)
+}
+
+type ImportStatementResponse struct {
+	Parsed     int `cbor:"parsed"`
+	Imported   int `cbor:"imported"`
+	Duplicates int `cbor:"duplicates"`
+	// Skipped lists rows of the statement that could not be read
+	Skipped        []SkippedRow `cbor:"skipped"`
+	RulesTriggered int          `cbor:"rules_triggered"`
+	// From and To are the dates of the earliest and latest imported transactions
+	From           *time.Time `cbor:"from,omitempty"`
+	To             *time.Time `cbor:"to,omitempty"`
+	BalanceUpdated bool       `cbor:"balance_updated"`
+}
+
+type SkippedRow struct {
+	Line   int    `cbor:"line"`
+	Reason string `cbor:"reason"`
+}
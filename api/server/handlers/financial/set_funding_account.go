@@ -0,0 +1,105 @@
+package financial
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// SetFundingAccount chooses the checking account that savings are taken from
+// when rules fire on purchases made with one of the user's credit cards
+// Omitting funding_account_id clears it, which pauses rules for the card
+// @Route PUT /financial/accounts/:id/funding-account
+func (h *Handler) SetFundingAccount(ctx fiber.Ctx, req *SetFundingAccountRequest) error {
+	session := request_context.Session(ctx)
+
+	accountID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid account id",
+		}
+	}
+
+	card, err := h.userAccount(ctx, session.UserID, accountID)
+	if err != nil {
+		return err
+	}
+
+	if card.Type != entaccount.TypeCredit {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "only credit accounts have a funding account",
+		}
+	}
+
+	update := h.db.Account.UpdateOne(card)
+
+	if req.FundingAccountID == nil {
+		update.ClearFundingAccountID()
+	} else {
+		funding, err := h.userAccount(ctx, session.UserID, *req.FundingAccountID)
+		if err != nil {
+			return err
+		}
+
+		if funding.Type != entaccount.TypeChecking {
+			return protocol.ErrorResponse{
+				Code:    protocol.InvalidParametersError,
+				Message: "funding account must be a checking account",
+			}
+		}
+
+		if funding.Currency != card.Currency {
+			return protocol.ErrorResponse{
+				Code:    protocol.InvalidParametersError,
+				Message: "funding account must be in the same currency as the credit account",
+			}
+		}
+
+		update.SetFundingAccountID(funding.ID)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to set funding account: %w", err)
+	}
+
+	return nil
+}
+
+// userAccount loads an active account owned by the user
+func (h *Handler) userAccount(ctx fiber.Ctx, userID, accountID uuid.UUID) (*ent.Account, error) {
+	acc, err := h.db.Account.
+		Query().
+		Where(
+			entaccount.ID(accountID),
+			entaccount.UserID(userID),
+			entaccount.IsActive(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "account not found or not owned by user",
+			}
+		}
+		return nil, fmt.Errorf("failed to query account: %w", err)
+	}
+
+	return acc, nil
+}
+
+type SetFundingAccountRequest struct {
+	FundingAccountID *uuid.UUID `cbor:"funding_account_id,omitempty" json:"funding_account_id,omitempty"`
+}
+
+func (r *SetFundingAccountRequest) Validate() error {
+	return nil // A missing funding account clears it
+}
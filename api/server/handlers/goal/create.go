@@ -0,0 +1,91 @@
+package goal
+
+import (
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entfamilymember "regulation/internal/ent/familymember"
+	entgoal "regulation/internal/ent/goal"
+	"regulation/internal/protocol"
+	"regulation/server/handlers/models"
+	"regulation/server/services/request_context"
+)
+
+// CreateGoal creates a personal goal, or a shared goal for the user's family
+// @Route POST /goals
+func (h *Handler) CreateGoal(ctx fiber.Ctx, req *CreateGoalRequest) (*models.Goal, error) {
+	session := request_context.Session(ctx)
+
+	if req.FamilyID != nil {
+		member, err := h.db.FamilyMember.
+			Query().
+			Where(
+				entfamilymember.UserID(session.UserID),
+				entfamilymember.FamilyID(*req.FamilyID),
+			).
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, protocol.ErrorResponse{
+					Code:    protocol.NotFoundError,
+					Message: "family not found",
+				}
+			}
+			return nil, fmt.Errorf("failed to load family membership: %w", err)
+		}
+
+		if member.Role != entfamilymember.RoleOwner && member.Role != entfamilymember.RoleParent {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.ForbiddenError,
+				Message: "only family owners and parents can create family goals",
+			}
+		}
+	}
+
+	goal, err := h.db.Goal.
+		Create().
+		SetUserID(session.UserID).
+		SetNillableFamilyID(req.FamilyID).
+		SetName(req.Name).
+		SetKind(req.Kind).
+		SetNillableCategory(req.Category).
+		SetTargetCents(req.TargetCents).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create goal: %w", err)
+	}
+
+	progress, err := h.cashflowService.GoalProgress(ctx, goal, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	response := models.NewGoal(goal, progress)
+	return &response, nil
+}
+
+type CreateGoalRequest struct {
+	Name        string       `cbor:"name" json:"name"`
+	Kind        entgoal.Kind `cbor:"kind" json:"kind"`
+	Category    *string      `cbor:"category,omitempty" json:"category,omitempty"`
+	TargetCents int64        `cbor:"target_cents" json:"target_cents"`
+	// FamilyID creates a shared family goal
+	FamilyID *uuid.UUID `cbor:"family_id,omitempty" json:"family_id,omitempty"`
+}
+
+func (r *CreateGoalRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Name, validation.Required, validation.RuneLength(1, 100)),
+		validation.Field(&r.Kind, validation.Required, validation.In(
+			entgoal.KindSavings,
+			entgoal.KindSpendingLimit,
+		)),
+		validation.Field(&r.Category, validation.When(r.Kind == entgoal.KindSavings, validation.Nil)),
+		validation.Field(&r.TargetCents, validation.Required, validation.Min(int64(1))),
+	)
+}
@@ -0,0 +1,64 @@
+package goal
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entfamilymember "regulation/internal/ent/familymember"
+	entgoal "regulation/internal/ent/goal"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// DeleteGoal deletes a personal goal, or a family goal when the user manages the family
+// @Route DELETE /goals/:id
+func (h *Handler) DeleteGoal(ctx fiber.Ctx) error {
+	session := request_context.Session(ctx)
+
+	goalID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid goal ID format",
+		}
+	}
+
+	manageable := entgoal.And(
+		entgoal.UserID(session.UserID),
+		entgoal.FamilyIDIsNil(),
+	)
+
+	member, err := h.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(session.UserID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to load family membership: %w", err)
+	}
+	if member != nil && (member.Role == entfamilymember.RoleOwner || member.Role == entfamilymember.RoleParent) {
+		manageable = entgoal.Or(manageable, entgoal.FamilyID(member.FamilyID))
+	}
+
+	deleted, err := h.db.Goal.
+		Delete().
+		Where(
+			entgoal.ID(goalID),
+			manageable,
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+
+	if deleted == 0 {
+		return protocol.ErrorResponse{
+			Code:    protocol.NotFoundError,
+			Message: "goal not found",
+		}
+	}
+
+	return nil
+}
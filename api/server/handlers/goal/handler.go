@@ -0,0 +1,23 @@
+package goal
+
+import (
+	"regulation/internal/ent"
+	"regulation/internal/session"
+	"regulation/server/services/cashflow"
+)
+
+// Handler handles personal and family goal requests
+type Handler struct {
+	db              *ent.Client
+	sessionManager  *session.Manager
+	cashflowService *cashflow.Service
+}
+
+// New creates a new goal handler
+func New(db *ent.Client, sessionManager *session.Manager, cashflowService *cashflow.Service) *Handler {
+	return &Handler{
+		db:              db,
+		sessionManager:  sessionManager,
+		cashflowService: cashflowService,
+	}
+}
@@ -0,0 +1,63 @@
+package goal
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+
+	"regulation/internal/ent"
+	entfamilymember "regulation/internal/ent/familymember"
+	entgoal "regulation/internal/ent/goal"
+	"regulation/server/handlers/models"
+	"regulation/server/services/request_context"
+)
+
+// ListGoals retrieves the user's personal goals and their family's goals with progress
+// @Route GET /goals
+func (h *Handler) ListGoals(ctx fiber.Ctx) (*ListGoalsResponse, error) {
+	session := request_context.Session(ctx)
+
+	visible := entgoal.And(
+		entgoal.UserID(session.UserID),
+		entgoal.FamilyIDIsNil(),
+	)
+
+	member, err := h.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(session.UserID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to load family membership: %w", err)
+	}
+	if member != nil {
+		visible = entgoal.Or(visible, entgoal.FamilyID(member.FamilyID))
+	}
+
+	goals, err := h.db.Goal.
+		Query().
+		Where(visible).
+		Order(ent.Asc(entgoal.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query goals: %w", err)
+	}
+
+	now := time.Now()
+	response := make([]models.Goal, len(goals))
+	for i, goal := range goals {
+		progress, err := h.cashflowService.GoalProgress(ctx, goal, now)
+		if err != nil {
+			return nil, err
+		}
+		response[i] = models.NewGoal(goal, progress)
+	}
+
+	return &ListGoalsResponse{
+		Goals: response,
+	}, nil
+}
+
+type ListGoalsResponse struct {
+	Goals []models.Goal `cbor:"goals" json:"goals"`
+}
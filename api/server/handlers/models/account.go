@@ -1,6 +1,11 @@
 package models
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+)
 
 // Account represents a bank account in API responses
 type Account struct {
@@ -10,5 +15,51 @@ type Account struct {
 	Mask             *string   `cbor:"mask,omitempty" json:"mask,omitempty"`
 	CurrentBalance   int64     `cbor:"current_balance" json:"current_balance"`
 	AvailableBalance *int64    `cbor:"available_balance,omitempty" json:"available_balance,omitempty"`
+	Currency         string    `cbor:"currency" json:"currency"`
 	IsActive         bool      `cbor:"is_active" json:"is_active"`
+	// CreditLimit, CreditUtilization and FundingAccountID are only set on credit accounts
+	CreditLimit *int64 `cbor:"credit_limit,omitempty" json:"credit_limit,omitempty"`
+	// CreditUtilization is the balance owed as a percentage of the credit limit
+	CreditUtilization *float64   `cbor:"credit_utilization,omitempty" json:"credit_utilization,omitempty"`
+	FundingAccountID  *uuid.UUID `cbor:"funding_account_id,omitempty" json:"funding_account_id,omitempty"`
+}
+
+// NewAccount converts an account entity to the API representation
+func NewAccount(acc *ent.Account) Account {
+	maskPtr := &acc.Mask
+	if acc.Mask == "" {
+		maskPtr = nil
+	}
+
+	account := Account{
+		ID:               acc.ID,
+		Name:             acc.Name,
+		Type:             string(acc.Type),
+		Mask:             maskPtr,
+		CurrentBalance:   acc.CurrentBalance,
+		AvailableBalance: acc.AvailableBalance,
+		Currency:         acc.Currency,
+		IsActive:         acc.IsActive,
+	}
+
+	if acc.Type == entaccount.TypeCredit {
+		account.CreditLimit = acc.CreditLimit
+		account.FundingAccountID = acc.FundingAccountID
+		if acc.CreditLimit != nil {
+			account.CreditUtilization = CreditUtilization(acc.CurrentBalance, *acc.CreditLimit)
+		}
+	}
+
+	return account
+}
+
+// CreditUtilization returns the balance owed as a percentage of the credit limit,
+// or nil when there is no limit to compare against
+func CreditUtilization(balance, limit int64) *float64 {
+	if limit <= 0 {
+		return nil
+	}
+
+	utilization := float64(balance) / float64(limit) * 100
+	return &utilization
 }
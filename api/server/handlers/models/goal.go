@@ -0,0 +1,33 @@
+package models
+
+import (
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+)
+
+// Goal represents a personal or family goal with its progress in API responses
+type Goal struct {
+	ID            uuid.UUID  `cbor:"id" json:"id"`
+	FamilyID      *uuid.UUID `cbor:"family_id,omitempty" json:"family_id,omitempty"`
+	Name          string     `cbor:"name" json:"name"`
+	Kind          string     `cbor:"kind" json:"kind"`
+	Category      *string    `cbor:"category,omitempty" json:"category,omitempty"`
+	TargetCents   int64      `cbor:"target_cents" json:"target_cents"`
+	ProgressCents int64      `cbor:"progress_cents" json:"progress_cents"`
+	Percent       float64    `cbor:"percent" json:"percent"`
+}
+
+// NewGoal converts a goal entity and its progress to the API representation
+func NewGoal(goal *ent.Goal, progressCents int64) Goal {
+	return Goal{
+		ID:            goal.ID,
+		FamilyID:      goal.FamilyID,
+		Name:          goal.Name,
+		Kind:          string(goal.Kind),
+		Category:      goal.Category,
+		TargetCents:   goal.TargetCents,
+		ProgressCents: progressCents,
+		Percent:       float64(progressCents) / float64(goal.TargetCents) * 100,
+	}
+}
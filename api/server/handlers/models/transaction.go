@@ -11,10 +11,16 @@ type Transaction struct {
 	ID             uuid.UUID `cbor:"id" json:"id"`
 	AccountID      uuid.UUID `cbor:"account_id" json:"account_id"`
 	Amount         int64     `cbor:"amount" json:"amount"`
+	Currency       string    `cbor:"currency" json:"currency"`
 	Date           time.Time `cbor:"date" json:"date"`
 	Name           string    `cbor:"name" json:"name"`
 	MerchantName   *string   `cbor:"merchant_name,omitempty" json:"merchant_name,omitempty"`
 	Category       string    `cbor:"category" json:"category"`
 	Pending        bool      `cbor:"pending" json:"pending"`
 	PaymentChannel *string   `cbor:"payment_channel,omitempty" json:"payment_channel,omitempty"`
+	// InternalTransfer marks money moved between the user's own accounts
+	InternalTransfer bool `cbor:"internal_transfer" json:"internal_transfer"`
+	// AnomalyScore rates how unusual the charge is for the user, 0 (typical) to 1
+	AnomalyScore  *float64 `cbor:"anomaly_score,omitempty" json:"anomaly_score,omitempty"`
+	AnomalyReason *string  `cbor:"anomaly_reason,omitempty" json:"anomaly_reason,omitempty"`
 }
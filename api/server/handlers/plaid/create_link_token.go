@@ -3,18 +3,35 @@ package plaid
 import (
 	"fmt"
 
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/gofiber/fiber/v3"
 
+	"regulation/internal/ro"
+	"regulation/server/services/backfill"
 	"regulation/server/services/request_context"
 )
 
 // CreateLinkToken creates a Plaid Link token for account linking
+// The backfill depth is chosen here since Plaid only pulls the history requested when the item is created
 // @Route POST /plaid/create-link-token
 func (h *Handler) CreateLinkToken(ctx fiber.Ctx) (*CreateLinkTokenResponse, error) {
 	session := request_context.Session(ctx)
 
+	// The body is optional; clients that don't offer a choice of depth send none
+	req := new(CreateLinkTokenRequest)
+	if len(ctx.Body()) > 0 {
+		if err := ro.ParseBody(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	daysRequested := backfill.DefaultDays
+	if req.BackfillDays != nil {
+		daysRequested = *req.BackfillDays
+	}
+
 	// Create link token with user ID
-	linkToken, err := h.plaidClient.CreateLinkToken(ctx, session.UserID.String())
+	linkToken, err := h.plaidClient.CreateLinkToken(ctx, session.UserID.String(), daysRequested)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create link token: %w", err)
 	}
@@ -24,6 +41,18 @@ func (h *Handler) CreateLinkToken(ctx fiber.Ctx) (*CreateLinkTokenResponse, erro
 	}, nil
 }
 
+type CreateLinkTokenRequest struct {
+	// BackfillDays is how many days of history to pull, 90 by default
+	// Rules don't run on history dated before the item is linked
+	BackfillDays *int `cbor:"backfill_days,omitempty" json:"backfill_days,omitempty"`
+}
+
+func (r *CreateLinkTokenRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.BackfillDays, validation.Min(backfill.MinDays), validation.Max(backfill.MaxDays)),
+	)
+}
+
 type CreateLinkTokenResponse struct {
 	LinkToken string `cbor:"link_token" json:"link_token"`
 }
@@ -12,6 +12,7 @@ import (
 )
 
 // DisconnectAccount soft-deletes an account (used during onboarding to remove incorrectly linked accounts)
+// The account's item keeps syncing; use RemoveItem to disconnect the whole bank connection
 // @Route DELETE /plaid/accounts/:id
 func (h *Handler) DisconnectAccount(ctx fiber.Ctx) (*DisconnectAccountResponse, error) {
 	session := request_context.Session(ctx)
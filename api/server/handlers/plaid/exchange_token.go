@@ -5,130 +5,162 @@ import (
 	"fmt"
 
 	"github.com/DeltaLaboratory/contrib/hooks"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 
-	"regulation/internal/ent/account"
+	"regulation/internal/currency"
+	"regulation/internal/ent"
 	"regulation/server/handlers/models"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/backfill"
+	"regulation/server/services/plaid"
 	"regulation/server/services/request_context"
 )
 
 // ExchangeToken exchanges a public token for an access token and creates Item and Account records
+// The item's history is pulled by a backfill job, which the client polls until starter rules are ready
 // @Route POST /plaid/exchange-token
-func (h *Handler) ExchangeToken(ctx fiber.Ctx, req *ExchangeTokenRequest) (ret error) {
+func (h *Handler) ExchangeToken(ctx fiber.Ctx, req *ExchangeTokenRequest) (*ExchangeTokenResponse, error) {
 	session := request_context.Session(ctx)
 
 	// Exchange public token for access token
 	result, err := h.plaidClient.ExchangePublicToken(ctx, req.PublicToken)
 	if err != nil {
-		return fmt.Errorf("failed to exchange public token: %w", err)
+		return nil, fmt.Errorf("failed to exchange public token: %w", err)
 	}
 
 	// Get accounts from Plaid
 	accounts, err := h.plaidClient.GetAccounts(ctx, result.AccessToken)
 	if err != nil {
-		return fmt.Errorf("failed to get accounts: %w", err)
+		return nil, fmt.Errorf("failed to get accounts: %w", err)
 	}
 
-	// Get institution name (simplified - in production, use Plaid's institutions endpoint)
-	institutionName := "Bank"
-	if len(accounts) > 0 {
-		institutionName = accounts[0].Name // Use first account name as fallback
+	// Look up the institution; if it fails the item is linked anyway and backfilled on sync
+	institution, lookupErr := aggregator.LookupInstitution(ctx, h.provider, result.AccessToken)
+	if lookupErr != nil {
+		log.Warn().
+			Err(lookupErr).
+			Str("plaid_item_id", result.ItemID).
+			Msg("Failed to look up institution")
 	}
 
-	// Create Item and Account records in a transaction
+	daysRequested := backfill.DefaultDays
+	if req.BackfillDays != nil {
+		daysRequested = *req.BackfillDays
+	}
+
+	itemEntity, job, accountResponses, err := h.createItem(ctx, session.UserID, result, accounts, institution, lookupErr != nil, daysRequested)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pull the item's history in the background; the job outlives the request
+	h.backfillWorker.Enqueue(job.ID)
+
+	return &ExchangeTokenResponse{
+		ItemID:   itemEntity.ID,
+		Accounts: accountResponses,
+		Backfill: newBackfillJobResponse(job),
+	}, nil
+}
+
+type ExchangeTokenRequest struct {
+	PublicToken string `cbor:"public_token" json:"public_token"`
+	// BackfillDays is the history requested when the link token was created, 90 by default
+	BackfillDays *int `cbor:"backfill_days,omitempty" json:"backfill_days,omitempty"`
+}
+
+func (r *ExchangeTokenRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.PublicToken, validation.Required),
+		validation.Field(&r.BackfillDays, validation.Min(backfill.MinDays), validation.Max(backfill.MaxDays)),
+	)
+}
+
+type ExchangeTokenResponse struct {
+	ItemID   uuid.UUID           `cbor:"item_id" json:"item_id"`
+	Accounts []models.Account    `cbor:"accounts" json:"accounts"`
+	Backfill BackfillJobResponse `cbor:"backfill" json:"backfill"`
+}
+
+// createItem creates the Item and Account records of a newly linked item with its backfill job in a transaction
+func (h *Handler) createItem(
+	ctx context.Context,
+	userID uuid.UUID,
+	result *plaid.TokenExchangeResult,
+	accounts []aggregator.Account,
+	institution *aggregator.Institution,
+	lookupFailed bool,
+	daysRequested int,
+) (_ *ent.Item, _ *ent.BackfillJob, _ []models.Account, ret error) {
 	tx, err := h.db.Tx(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to start transaction: %w", err)
 	}
 	defer hooks.Rollback(tx, &ret)
 
 	// Create Item
 	itemCreate := tx.Item.Create().
 		SetID(uuid.New()).
-		SetUserID(session.UserID).
-		SetPlaidID(result.ItemID).
+		SetUserID(userID).
+		SetProvider(plaid.ProviderName).
+		SetExternalID(result.ItemID).
 		SetAccessToken(result.AccessToken).
-		SetInstitutionName(institutionName).
+		SetInstitutionName("Bank").
 		SetIsActive(true)
 
+	if institution != nil {
+		itemCreate.
+			SetInstitutionID(institution.ID).
+			SetInstitutionName(institution.Name).
+			SetInstitutionLogo(institution.Logo).
+			SetInstitutionColor(institution.PrimaryColor).
+			SetInstitutionURL(institution.URL)
+	} else if !lookupFailed {
+		// Plaid does not know the institution; record that the lookup was done
+		itemCreate.SetInstitutionID("")
+	}
+
 	itemEntity, err := itemCreate.Save(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create item: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create item: %w", err)
 	}
 
 	// Create Account records
 	accountResponses := make([]models.Account, 0, len(accounts))
 	for _, acc := range accounts {
-		// Map account type
-		var accountType account.Type
-		switch acc.Subtype {
-		case "checking":
-			accountType = account.TypeChecking
-		case "savings":
-			accountType = account.TypeSavings
-		default:
-			accountType = account.TypeOther
-		}
-
 		accountEntity, err := tx.Account.Create().
 			SetID(uuid.New()).
 			SetItemID(itemEntity.ID).
-			SetUserID(session.UserID).
-			SetPlaidID(acc.AccountID).
+			SetUserID(userID).
+			SetExternalID(acc.AccountID).
 			SetName(acc.Name).
-			SetType(accountType).
+			SetType(acc.Type).
 			SetSubtype(acc.Subtype).
 			SetNillableMask(&acc.Mask).
 			SetCurrentBalance(acc.BalanceCurrent).
 			SetNillableAvailableBalance(acc.BalanceAvailable).
+			SetNillableCreditLimit(acc.BalanceLimit).
+			SetCurrency(currency.Normalize(acc.Currency)).
 			SetIsActive(true).
 			Save(ctx)
 
 		if err != nil {
-			return fmt.Errorf("failed to create account: %w", err)
-		}
-
-		maskPtr := &accountEntity.Mask
-		if accountEntity.Mask == "" {
-			maskPtr = nil
+			return nil, nil, nil, fmt.Errorf("failed to create account: %w", err)
 		}
 
-		accountResponses = append(accountResponses, models.Account{
-			ID:               accountEntity.ID,
-			Name:             accountEntity.Name,
-			Type:             string(accountEntity.Type),
-			Mask:             maskPtr,
-			CurrentBalance:   accountEntity.CurrentBalance,
-			AvailableBalance: accountEntity.AvailableBalance,
-			IsActive:         accountEntity.IsActive,
-		})
+		accountResponses = append(accountResponses, models.NewAccount(accountEntity))
 	}
 
-	// hooks.Rollback will auto-commit here since ret == nil
-
-	// Trigger initial transaction sync in the background
-	// IMPORTANT: Use context.Background() instead of the Fiber context
-	// to avoid nil pointer dereference when the HTTP request context is recycled
-	go func() {
-		_, err := h.syncService.SyncItemTransactions(context.Background(), itemEntity.ID)
-		if err != nil {
-			// Note: Using a new context-independent logger since this runs after response
-			_ = err // Background task error - already logged
-		}
-	}()
-
-	return ctx.JSON(ExchangeTokenResponse{
-		ItemID:   itemEntity.ID,
-		Accounts: accountResponses,
-	})
-}
-
-type ExchangeTokenRequest struct {
-	PublicToken string `cbor:"public_token" json:"public_token"`
-}
+	job, err := tx.BackfillJob.Create().
+		SetItemID(itemEntity.ID).
+		SetDaysRequested(daysRequested).
+		Save(ctx)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create backfill job: %w", err)
+	}
 
-type ExchangeTokenResponse struct {
-	ItemID   uuid.UUID        `cbor:"item_id" json:"item_id"`
-	Accounts []models.Account `cbor:"accounts" json:"accounts"`
+	return itemEntity, job, accountResponses, nil
 }
@@ -0,0 +1,120 @@
+package plaid
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entbackfilljob "regulation/internal/ent/backfilljob"
+	entitem "regulation/internal/ent/item"
+	"regulation/internal/ent/schema/schematype"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// GetBackfill retrieves the progress of an item's initial history sync and, once it completes,
+// the starter rules suggested from it. Clients poll it during onboarding; a push notification is
+// also sent when the job completes.
+// @Route GET /plaid/items/:id/backfill
+func (h *Handler) GetBackfill(ctx fiber.Ctx) (*BackfillJobResponse, error) {
+	session := request_context.Session(ctx)
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid item id",
+		}
+	}
+
+	job, err := h.db.BackfillJob.
+		Query().
+		Where(
+			entbackfilljob.ItemID(itemID),
+			entbackfilljob.HasItemWith(entitem.UserID(session.UserID)),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "backfill not found",
+			}
+		}
+		return nil, fmt.Errorf("failed to load backfill job: %w", err)
+	}
+
+	response := newBackfillJobResponse(job)
+	return &response, nil
+}
+
+// newBackfillJobResponse converts a backfill job to its API representation
+func newBackfillJobResponse(job *ent.BackfillJob) BackfillJobResponse {
+	response := BackfillJobResponse{
+		ID:                 job.ID,
+		ItemID:             job.ItemID,
+		Status:             string(job.Status),
+		DaysRequested:      job.DaysRequested,
+		UpdateStatus:       string(job.UpdateStatus),
+		SyncCount:          job.SyncCount,
+		TransactionsSynced: job.TransactionsSynced,
+		StarterRules:       job.StarterRules,
+		StarterAnalysis:    job.StarterAnalysis,
+		AnalysisError:      job.AnalysisError,
+		Error:              job.Error,
+		StartedAt:          job.StartedAt,
+		FinishedAt:         job.FinishedAt,
+	}
+
+	if job.OldestTransactionDate != nil {
+		date := job.OldestTransactionDate.Format(time.DateOnly)
+		response.OldestTransactionDate = &date
+		response.DaysCovered = int(time.Since(*job.OldestTransactionDate).Hours() / 24)
+	}
+
+	// Syncing is most of the work; the estimate follows how far back the synced history reaches
+	switch {
+	case job.Status == entbackfilljob.StatusCompleted:
+		response.Progress = 100
+	case job.Status == entbackfilljob.StatusAnalyzing,
+		job.UpdateStatus == entbackfilljob.UpdateStatusHistoricalUpdateComplete:
+		response.Progress = 90
+	default:
+		covered := math.Min(float64(response.DaysCovered)/float64(job.DaysRequested), 1)
+		response.Progress = int(covered * 90)
+	}
+
+	return response
+}
+
+type BackfillJobResponse struct {
+	ID     uuid.UUID `cbor:"id" json:"id"`
+	ItemID uuid.UUID `cbor:"item_id" json:"item_id"`
+	// Status is one of queued, syncing, analyzing, completed or failed
+	Status        string `cbor:"status" json:"status"`
+	DaysRequested int    `cbor:"days_requested" json:"days_requested"`
+	// UpdateStatus is how much history the aggregator has pulled: not_ready,
+	// initial_update_complete (the last 30 days) or historical_update_complete
+	UpdateStatus       string `cbor:"update_status" json:"update_status"`
+	SyncCount          int    `cbor:"sync_count" json:"sync_count"`
+	TransactionsSynced int    `cbor:"transactions_synced" json:"transactions_synced"`
+	// OldestTransactionDate is YYYY-MM-DD, unset until a transaction is synced
+	OldestTransactionDate *string `cbor:"oldest_transaction_date,omitempty" json:"oldest_transaction_date,omitempty"`
+	DaysCovered           int     `cbor:"days_covered" json:"days_covered"`
+	// Progress is an estimated percentage
+	Progress int `cbor:"progress" json:"progress"`
+
+	// Starter rules, set once the job completes
+	StarterRules    []schematype.StarterRule `cbor:"starter_rules,omitempty" json:"starter_rules,omitempty"`
+	StarterAnalysis string                   `cbor:"starter_analysis,omitempty" json:"starter_analysis,omitempty"`
+	// AnalysisError is why no starter rules were suggested, e.g. too few transactions
+	AnalysisError *string `cbor:"analysis_error,omitempty" json:"analysis_error,omitempty"`
+
+	Error      *string    `cbor:"error,omitempty" json:"error,omitempty"`
+	StartedAt  time.Time  `cbor:"started_at" json:"started_at"`
+	FinishedAt *time.Time `cbor:"finished_at,omitempty" json:"finished_at,omitempty"`
+}
@@ -0,0 +1,126 @@
+package plaid
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entitem "regulation/internal/ent/item"
+	"regulation/server/services/request_context"
+)
+
+// GetItems lists the authenticated user's bank connections with their sync state
+// @Route GET /plaid/items
+func (h *Handler) GetItems(ctx fiber.Ctx) (*GetItemsResponse, error) {
+	session := request_context.Session(ctx)
+
+	items, err := h.db.Item.
+		Query().
+		Where(entitem.UserID(session.UserID)).
+		WithSyncCursor().
+		WithAccounts().
+		Order(ent.Asc(entitem.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query items: %w", err)
+	}
+
+	response := &GetItemsResponse{
+		Items: make([]ItemResponse, len(items)),
+	}
+
+	for i, item := range items {
+		response.Items[i] = ItemResponse{
+			ID:               item.ID,
+			Provider:         item.Provider,
+			InstitutionName:  item.InstitutionName,
+			InstitutionLogo:  item.InstitutionLogo,
+			InstitutionColor: item.InstitutionColor,
+			InstitutionURL:   item.InstitutionURL,
+			Status:           string(item.Status),
+			IsActive:         item.IsActive,
+			AccountCount:     len(item.Edges.Accounts),
+			CreatedAt:        item.CreatedAt,
+		}
+
+		if cursor := item.Edges.SyncCursor; cursor != nil {
+			response.Items[i].LastSyncAt = &cursor.LastSyncAt
+			response.Items[i].NextSyncAt = &cursor.NextSyncAt
+			response.Items[i].LastError = cursor.LastError
+			response.Items[i].ConsecutiveFailures = cursor.ConsecutiveFailures
+		}
+
+		lastRun, err := h.lastSyncRun(ctx, item.ID)
+		if err != nil {
+			return nil, err
+		}
+		if lastRun != nil {
+			run := newSyncRunResponse(lastRun)
+			response.Items[i].LastRun = &run
+		}
+	}
+
+	return response, nil
+}
+
+// newSyncRunResponse converts a sync run to its API representation
+func newSyncRunResponse(run *ent.SyncRun) SyncRunResponse {
+	return SyncRunResponse{
+		ID:             run.ID,
+		Trigger:        string(run.Trigger),
+		Status:         string(run.Status),
+		AddedCount:     run.AddedCount,
+		ModifiedCount:  run.ModifiedCount,
+		RemovedCount:   run.RemovedCount,
+		RulesTriggered: run.RulesTriggered,
+		Error:          run.Error,
+		StartedAt:      run.StartedAt,
+		FinishedAt:     run.FinishedAt,
+	}
+}
+
+type GetItemsResponse struct {
+	Items []ItemResponse `cbor:"items" json:"items"`
+}
+
+type ItemResponse struct {
+	ID uuid.UUID `cbor:"id" json:"id"`
+	// Provider is the aggregator the item is linked through, such as plaid
+	Provider        string `cbor:"provider" json:"provider"`
+	InstitutionName string `cbor:"institution_name" json:"institution_name"`
+	// InstitutionLogo is a base64-encoded PNG, empty if unknown
+	InstitutionLogo  string `cbor:"institution_logo,omitempty" json:"institution_logo,omitempty"`
+	InstitutionColor string `cbor:"institution_color,omitempty" json:"institution_color,omitempty"`
+	InstitutionURL   string `cbor:"institution_url,omitempty" json:"institution_url,omitempty"`
+	// Status is one of healthy, login_required, institution_down, rate_limited or error
+	Status       string `cbor:"status" json:"status"`
+	IsActive     bool   `cbor:"is_active" json:"is_active"`
+	AccountCount int    `cbor:"account_count" json:"account_count"`
+
+	// Sync state, unset until the item's first sync
+	LastSyncAt          *time.Time       `cbor:"last_sync_at,omitempty" json:"last_sync_at,omitempty"`
+	NextSyncAt          *time.Time       `cbor:"next_sync_at,omitempty" json:"next_sync_at,omitempty"`
+	LastError           string           `cbor:"last_error,omitempty" json:"last_error,omitempty"`
+	ConsecutiveFailures int              `cbor:"consecutive_failures" json:"consecutive_failures"`
+	LastRun             *SyncRunResponse `cbor:"last_run,omitempty" json:"last_run,omitempty"`
+
+	CreatedAt time.Time `cbor:"created_at" json:"created_at"`
+}
+
+type SyncRunResponse struct {
+	ID uuid.UUID `cbor:"id" json:"id"`
+	// Trigger is one of scheduled, webhook, manual or link
+	Trigger string `cbor:"trigger" json:"trigger"`
+	// Status is one of running, succeeded or failed
+	Status         string     `cbor:"status" json:"status"`
+	AddedCount     int        `cbor:"added_count" json:"added_count"`
+	ModifiedCount  int        `cbor:"modified_count" json:"modified_count"`
+	RemovedCount   int        `cbor:"removed_count" json:"removed_count"`
+	RulesTriggered int        `cbor:"rules_triggered" json:"rules_triggered"`
+	Error          *string    `cbor:"error,omitempty" json:"error,omitempty"`
+	StartedAt      time.Time  `cbor:"started_at" json:"started_at"`
+	FinishedAt     *time.Time `cbor:"finished_at,omitempty" json:"finished_at,omitempty"`
+}
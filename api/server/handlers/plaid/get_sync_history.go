@@ -0,0 +1,72 @@
+package plaid
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entitem "regulation/internal/ent/item"
+	entsyncrun "regulation/internal/ent/syncrun"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// syncHistoryLimit is the maximum number of sync runs returned, most recent first
+const syncHistoryLimit = 50
+
+// GetSyncHistory retrieves the most recent sync runs of an item
+// @Route GET /plaid/items/:id/sync-history
+func (h *Handler) GetSyncHistory(ctx fiber.Ctx) (*GetSyncHistoryResponse, error) {
+	session := request_context.Session(ctx)
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid item id",
+		}
+	}
+
+	exists, err := h.db.Item.
+		Query().
+		Where(
+			entitem.ID(itemID),
+			entitem.UserID(session.UserID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load item: %w", err)
+	}
+	if !exists {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.NotFoundError,
+			Message: "item not found",
+		}
+	}
+
+	runs, err := h.db.SyncRun.
+		Query().
+		Where(entsyncrun.ItemID(itemID)).
+		Order(ent.Desc(entsyncrun.FieldStartedAt)).
+		Limit(syncHistoryLimit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sync runs: %w", err)
+	}
+
+	response := &GetSyncHistoryResponse{
+		Runs: make([]SyncRunResponse, len(runs)),
+	}
+
+	for i, run := range runs {
+		response.Runs[i] = newSyncRunResponse(run)
+	}
+
+	return response, nil
+}
+
+type GetSyncHistoryResponse struct {
+	Runs []SyncRunResponse `cbor:"runs" json:"runs"`
+}
@@ -3,6 +3,8 @@ package plaid
 import (
 	"regulation/internal/ent"
 	"regulation/internal/session"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/backfill"
 	"regulation/server/services/plaid"
 )
 
@@ -11,7 +13,10 @@ type Handler struct {
 	db             *ent.Client
 	sessionManager *session.Manager
 	plaidClient    plaid.Client
-	syncService    *plaid.SyncService
+	provider       aggregator.AggregatorProvider
+	syncService    *aggregator.SyncService
+	webhookService *plaid.WebhookService
+	backfillWorker *backfill.Worker
 }
 
 // New creates a new Plaid handler
@@ -19,12 +24,17 @@ func New(
 	db *ent.Client,
 	sessionManager *session.Manager,
 	plaidClient plaid.Client,
-	syncService *plaid.SyncService,
+	syncService *aggregator.SyncService,
+	webhookService *plaid.WebhookService,
+	backfillWorker *backfill.Worker,
 ) *Handler {
 	return &Handler{
 		db:             db,
 		sessionManager: sessionManager,
 		plaidClient:    plaidClient,
+		provider:       plaid.NewProvider(plaidClient),
 		syncService:    syncService,
+		webhookService: webhookService,
+		backfillWorker: backfillWorker,
 	}
 }
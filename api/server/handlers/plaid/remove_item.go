@@ -0,0 +1,71 @@
+package plaid
+
+import (
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// Data retention choices when removing an item
+const (
+	RetentionKeep  = "keep"
+	RetentionPurge = "purge"
+)
+
+// RemoveItem disconnects a bank connection: the access token is revoked at Plaid, the item and its
+// accounts stop syncing and rules saving into its accounts are deactivated.
+// Historical transactions and savings records are kept or purged as the user chooses.
+// @Route DELETE /plaid/items/:id
+func (h *Handler) RemoveItem(ctx fiber.Ctx, req *RemoveItemRequest) (*RemoveItemResponse, error) {
+	session := request_context.Session(ctx)
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid item id",
+		}
+	}
+
+	result, err := h.syncService.RemoveItem(ctx, session.UserID, itemID, req.Retention == RetentionPurge)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "item not found",
+			}
+		}
+		return nil, fmt.Errorf("failed to remove item: %w", err)
+	}
+
+	return &RemoveItemResponse{
+		AccountsDeactivated: result.AccountsDeactivated,
+		RulesDeactivated:    result.RulesDeactivated,
+		TransactionsPurged:  result.TransactionsPurged,
+		TransfersPurged:     result.TransfersPurged,
+	}, nil
+}
+
+type RemoveItemRequest struct {
+	// Retention is keep to retain historical transactions and savings records, or purge to delete them
+	Retention string `cbor:"retention" json:"retention"`
+}
+
+func (r *RemoveItemRequest) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Retention, validation.Required, validation.In(RetentionKeep, RetentionPurge)),
+	)
+}
+
+type RemoveItemResponse struct {
+	AccountsDeactivated int `cbor:"accounts_deactivated" json:"accounts_deactivated"`
+	RulesDeactivated    int `cbor:"rules_deactivated" json:"rules_deactivated"`
+	TransactionsPurged  int `cbor:"transactions_purged" json:"transactions_purged"`
+	TransfersPurged     int `cbor:"transfers_purged" json:"transfers_purged"`
+}
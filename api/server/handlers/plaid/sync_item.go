@@ -0,0 +1,113 @@
+package plaid
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entitem "regulation/internal/ent/item"
+	entsyncrun "regulation/internal/ent/syncrun"
+	"regulation/internal/protocol"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/request_context"
+)
+
+// manualSyncDebounce is how soon after any sync of an item a manual sync is skipped
+const manualSyncDebounce = 1 * time.Minute
+
+// SyncItem syncs a single item right away and returns the recorded sync run.
+// Requests arriving within manualSyncDebounce of the item's last sync return that run instead.
+// @Route POST /plaid/items/:id/sync
+func (h *Handler) SyncItem(ctx fiber.Ctx) (*SyncItemResponse, error) {
+	session := request_context.Session(ctx)
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid item id",
+		}
+	}
+
+	exists, err := h.db.Item.
+		Query().
+		Where(
+			entitem.ID(itemID),
+			entitem.UserID(session.UserID),
+			entitem.IsActive(true),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load item: %w", err)
+	}
+	if !exists {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.NotFoundError,
+			Message: "item not found",
+		}
+	}
+
+	lastRun, err := h.lastSyncRun(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if lastRun != nil && time.Since(lastRun.StartedAt) < manualSyncDebounce {
+		return &SyncItemResponse{
+			Run:       newSyncRunResponse(lastRun),
+			Debounced: true,
+		}, nil
+	}
+
+	_, syncErr := h.syncService.SyncItemTransactions(ctx, itemID, entsyncrun.TriggerManual)
+	if errors.Is(syncErr, aggregator.ErrSyncInProgress) {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidRequest,
+			Message: "sync already in progress",
+		}
+	}
+
+	run, err := h.lastSyncRun(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sync errors are recorded on the new run, unless the sync failed before starting one
+	started := run != nil && (lastRun == nil || run.ID != lastRun.ID)
+	if !started {
+		if syncErr != nil {
+			return nil, fmt.Errorf("failed to sync item: %w", syncErr)
+		}
+		return nil, fmt.Errorf("sync run of item %s was not recorded", itemID)
+	}
+
+	return &SyncItemResponse{
+		Run: newSyncRunResponse(run),
+	}, nil
+}
+
+// lastSyncRun returns the most recent sync run of an item, or nil if it was never synced
+func (h *Handler) lastSyncRun(ctx fiber.Ctx, itemID uuid.UUID) (*ent.SyncRun, error) {
+	run, err := h.db.SyncRun.
+		Query().
+		Where(entsyncrun.ItemID(itemID)).
+		Order(ent.Desc(entsyncrun.FieldStartedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to query last sync run: %w", err)
+	}
+
+	return run, nil
+}
+
+type SyncItemResponse struct {
+	Run SyncRunResponse `cbor:"run" json:"run"`
+	// Debounced is true when the item was synced too recently and Run is that earlier sync
+	Debounced bool `cbor:"debounced" json:"debounced"`
+}
@@ -1,11 +1,15 @@
 package plaid
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/gofiber/fiber/v3"
 	"github.com/google/uuid"
 
+	entsyncrun "regulation/internal/ent/syncrun"
+	"regulation/internal/protocol"
+	"regulation/server/services/aggregator"
 	"regulation/server/services/request_context"
 )
 
@@ -20,12 +24,18 @@ func (h *Handler) SyncTransactions(ctx fiber.Ctx, req *SyncTransactionsRequest)
 	// Sync specific item or all items
 	if req.ItemID != nil {
 		// Sync specific item
-		syncedCount, err = h.syncService.SyncItemTransactions(ctx, *req.ItemID)
+		syncedCount, err = h.syncService.SyncItemTransactions(ctx, *req.ItemID, entsyncrun.TriggerManual)
 	} else {
 		// Sync all user items
 		syncedCount, err = h.syncService.SyncAllUserItems(ctx, session.UserID)
 	}
 
+	if errors.Is(err, aggregator.ErrSyncInProgress) {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidRequest,
+			Message: "sync already in progress",
+		}
+	}
 	if err != nil {
 		return fmt.Errorf("failed to sync transactions: %w", err)
 	}
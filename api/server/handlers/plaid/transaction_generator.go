@@ -47,7 +47,7 @@ func (h *TransactionGeneratorHandler) StartGenerator(ctx fiber.Ctx, req *StartGe
 		return fmt.Errorf("account not found: %w", err)
 	}
 
-	if account.ItemID != req.ItemID {
+	if account.ItemID == nil || *account.ItemID != req.ItemID {
 		return fiber.NewError(fiber.StatusBadRequest, "account does not belong to item")
 	}
 
@@ -63,7 +63,7 @@ func (h *TransactionGeneratorHandler) StartGenerator(ctx fiber.Ctx, req *StartGe
 	generator := plaidservice.NewTransactionGenerator(
 		h.plaidSvc.plaidClient,
 		item.AccessToken,
-		account.PlaidID,
+		account.ExternalID,
 	)
 
 	if err := generator.Start(ctx); err != nil {
@@ -0,0 +1,56 @@
+package plaid
+
+import (
+	"fmt"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entitem "regulation/internal/ent/item"
+	"regulation/internal/protocol"
+	"regulation/server/services/request_context"
+)
+
+// CreateUpdateLinkToken creates a Plaid Link token in update mode to repair an item,
+// e.g. after the bank asked the user to sign in again.
+// After Link succeeds the client triggers a sync of the item, which marks it healthy again.
+// @Route POST /plaid/items/:id/update-link-token
+func (h *Handler) CreateUpdateLinkToken(ctx fiber.Ctx) (*CreateLinkTokenResponse, error) {
+	session := request_context.Session(ctx)
+
+	itemID, err := uuid.Parse(ctx.Params("id"))
+	if err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid item id",
+		}
+	}
+
+	item, err := h.db.Item.
+		Query().
+		Where(
+			entitem.ID(itemID),
+			entitem.UserID(session.UserID),
+			entitem.IsActive(true),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "item not found",
+			}
+		}
+		return nil, fmt.Errorf("failed to load item: %w", err)
+	}
+
+	linkToken, err := h.plaidClient.CreateUpdateLinkToken(ctx, session.UserID.String(), item.AccessToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create update link token: %w", err)
+	}
+
+	return &CreateLinkTokenResponse{
+		LinkToken: linkToken,
+	}, nil
+}
@@ -0,0 +1,28 @@
+package plaid
+
+import (
+	"github.com/gofiber/fiber/v3"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/protocol"
+)
+
+// Webhook receives Plaid item webhooks
+// The request is authenticated by the signed JWT in the Plaid-Verification header rather than a session
+// @Route POST /plaid/webhook
+func (h *Handler) Webhook(ctx fiber.Ctx) error {
+	body := ctx.Body()
+
+	if err := h.webhookService.Verify(ctx, ctx.Get("Plaid-Verification"), body); err != nil {
+		log.Warn().
+			Err(err).
+			Msg("[WEBHOOK] Rejected Plaid webhook")
+
+		return protocol.ErrorResponse{
+			Code:    protocol.UnauthorizedError,
+			Message: "invalid webhook signature",
+		}
+	}
+
+	return h.webhookService.Handle(ctx, body)
+}
@@ -1,17 +1,12 @@
 package recommendation
 
 import (
-	"fmt"
-	"time"
+	"errors"
 
 	"github.com/gofiber/fiber/v3"
 
-	"regulation/internal/ent"
-	"regulation/internal/ent/account"
-	entrule "regulation/internal/ent/rule"
-	"regulation/internal/ent/transaction"
 	"regulation/internal/protocol"
-	"regulation/internal/rulesuggestion"
+	"regulation/server/services/recommendation"
 	"regulation/server/services/request_context"
 )
 
@@ -44,92 +39,19 @@ type GetRecommendationsResponse struct {
 func (h *Handler) GetRecommendations(ctx fiber.Ctx) (*GetRecommendationsResponse, error) {
 	session := request_context.Session(ctx)
 
-	// 1. Define analysis period (last 30 days)
-	analysisPeriodDays := 30
-	startDate := time.Now().AddDate(0, 0, -analysisPeriodDays)
-
-	// 2. Fetch user's transactions from the analysis period
-	transactions, err := h.db.Transaction.
-		Query().
-		Where(
-			transaction.HasAccountWith(
-				account.UserID(session.UserID),
-			),
-			transaction.DateGTE(startDate),
-			transaction.PendingEQ(false), // Only settled transactions
-		).
-		Order(ent.Desc(transaction.FieldDate)).
-		All(ctx)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch transactions: %w", err)
-	}
-
-	// Check if user has enough transaction data
-	if len(transactions) < 5 {
+	result, err := h.recommendationService.Recommend(ctx, session.UserID)
+	if errors.Is(err, recommendation.ErrInsufficientHistory) {
 		return nil, protocol.ErrorResponse{
 			Code:    protocol.InvalidRequest,
-			Message: fmt.Sprintf("insufficient transaction history. need at least 5 transactions, found %d", len(transactions)),
-		}
-	}
-
-	// 3. Convert transactions to summary format
-	txSummaries := make([]rulesuggestion.TransactionSummary, len(transactions))
-	totalSpent := int64(0)
-	for i, tx := range transactions {
-		txSummaries[i] = rulesuggestion.TransactionSummary{
-			Category: tx.Category,
-			Amount:   tx.Amount,
-			Date:     tx.Date,
-		}
-		totalSpent += tx.Amount
-	}
-
-	// 4. Fetch user's existing rules to avoid duplication
-	existingRules, err := h.db.Rule.
-		Query().
-		Where(entrule.UserID(session.UserID)).
-		All(ctx)
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch existing rules: %w", err)
-	}
-
-	// Convert existing rules to info format
-	existingRuleInfos := make([]rulesuggestion.ExistingRuleInfo, len(existingRules))
-	for i, rule := range existingRules {
-		existingRuleInfos[i] = rulesuggestion.ExistingRuleInfo{
-			Category:       rulesuggestion.Category(rule.Category),
-			ActionType:     rulesuggestion.ActionType(rule.ActionType),
-			ActionValue:    rule.ActionValue,
-			ExecutionCount: rule.ExecutionCount,
-			TotalSaved:     float64(rule.TotalSavedCents) / 100,
+			Message: err.Error(),
 		}
 	}
-
-	// 5. Optionally get user's financial context
-	// For now, we'll pass 0 for income and savings goal
-	// These could be fetched from a user profile table if available
-	monthlyIncome := 0.0
-	savingsGoal := 0.0
-
-	// 6. Call the suggestion service
-	suggestions, err := h.suggestionService.AnalyzeTransactionsAndSuggestRules(
-		ctx,
-		session.UserID.String(),
-		txSummaries,
-		existingRuleInfos,
-		monthlyIncome,
-		savingsGoal,
-	)
-
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate suggestions: %w", err)
+		return nil, err
 	}
 
-	// 7. Convert service response to handler response
-	suggestionResponses := make([]RuleSuggestionResponse, len(suggestions.Suggestions))
-	for i, suggestion := range suggestions.Suggestions {
+	suggestionResponses := make([]RuleSuggestionResponse, len(result.Suggestions))
+	for i, suggestion := range result.Suggestions {
 		suggestionResponses[i] = RuleSuggestionResponse{
 			Name:             suggestion.Name,
 			Category:         string(suggestion.Category),
@@ -146,10 +68,10 @@ func (h *Handler) GetRecommendations(ctx fiber.Ctx) (*GetRecommendationsResponse
 
 	return &GetRecommendationsResponse{
 		Suggestions:        suggestionResponses,
-		OverallAnalysis:    suggestions.OverallAnalysis,
-		PrioritySuggestion: suggestions.PrioritySuggestion,
-		AnalysisPeriodDays: analysisPeriodDays,
-		TransactionCount:   len(transactions),
-		TotalSpent:         float64(totalSpent) / 100,
+		OverallAnalysis:    result.OverallAnalysis,
+		PrioritySuggestion: result.PrioritySuggestion,
+		AnalysisPeriodDays: recommendation.AnalysisPeriodDays,
+		TransactionCount:   result.TransactionCount,
+		TotalSpent:         float64(result.TotalSpent) / 100,
 	}, nil
 }
@@ -2,22 +2,22 @@ package recommendation
 
 import (
 	"regulation/internal/ent"
-	"regulation/internal/rulesuggestion"
 	"regulation/internal/session"
+	"regulation/server/services/recommendation"
 )
 
 // Handler handles rule recommendation requests
 type Handler struct {
-	db                *ent.Client
-	sessionManager    *session.Manager
-	suggestionService *rulesuggestion.Service
+	db                    *ent.Client
+	sessionManager        *session.Manager
+	recommendationService *recommendation.Service
 }
 
 // New creates a new recommendation handler
-func New(db *ent.Client, sessionManager *session.Manager, suggestionService *rulesuggestion.Service) *Handler {
+func New(db *ent.Client, sessionManager *session.Manager, recommendationService *recommendation.Service) *Handler {
 	return &Handler{
-		db:                db,
-		sessionManager:    sessionManager,
-		suggestionService: suggestionService,
+		db:                    db,
+		sessionManager:        sessionManager,
+		recommendationService: recommendationService,
 	}
 }
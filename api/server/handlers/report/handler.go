@@ -0,0 +1,20 @@
+package report
+
+import (
+	"regulation/internal/ent"
+	"regulation/internal/session"
+)
+
+// Handler handles monthly report requests
+type Handler struct {
+	db             *ent.Client
+	sessionManager *session.Manager
+}
+
+// New creates a new report handler
+func New(db *ent.Client, sessionManager *session.Manager) *Handler {
+	return &Handler{
+		db:             db,
+		sessionManager: sessionManager,
+	}
+}
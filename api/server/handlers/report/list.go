@@ -0,0 +1,123 @@
+package report
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v3"
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	entmonthlyreport "regulation/internal/ent/monthlyreport"
+	"regulation/internal/ent/schema/schematype"
+	"regulation/internal/protocol"
+	reportservice "regulation/server/services/report"
+	"regulation/server/services/request_context"
+)
+
+// ListReports retrieves all archived monthly reports for the current user, newest first
+// @Route GET /reports
+func (h *Handler) ListReports(ctx fiber.Ctx) (*ListReportsResponse, error) {
+	session := request_context.Session(ctx)
+
+	reports, err := h.db.MonthlyReport.
+		Query().
+		Where(entmonthlyreport.UserID(session.UserID)).
+		Order(ent.Desc(entmonthlyreport.FieldMonth)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query monthly reports: %w", err)
+	}
+
+	response := make([]ReportSummary, len(reports))
+	for i, report := range reports {
+		response[i] = ReportSummary{
+			Month:        report.Month,
+			Headline:     reportservice.Headline(report),
+			IncomeCents:  report.IncomeCents,
+			SpendCents:   report.SpendCents,
+			SavingsCents: report.SavingsCents,
+			NetCents:     report.NetCents,
+			Currency:     report.Currency,
+		}
+	}
+
+	return &ListReportsResponse{
+		Reports: response,
+	}, nil
+}
+
+// GetReport retrieves the monthly report of a single month
+// @Route GET /reports/:month
+func (h *Handler) GetReport(ctx fiber.Ctx) (*ReportResponse, error) {
+	session := request_context.Session(ctx)
+
+	month := ctx.Params("month")
+	if _, err := time.Parse(reportservice.MonthFormat, month); err != nil {
+		return nil, protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "invalid month format, expected YYYY-MM",
+		}
+	}
+
+	report, err := h.db.MonthlyReport.
+		Query().
+		Where(
+			entmonthlyreport.UserID(session.UserID),
+			entmonthlyreport.Month(month),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "report not found",
+			}
+		}
+		return nil, fmt.Errorf("failed to query monthly report: %w", err)
+	}
+
+	return &ReportResponse{
+		ID:              report.ID,
+		Month:           report.Month,
+		Headline:        reportservice.Headline(report),
+		IncomeCents:     report.IncomeCents,
+		SpendCents:      report.SpendCents,
+		SavingsCents:    report.SavingsCents,
+		NetCents:        report.NetCents,
+		Currency:        report.Currency,
+		TopRules:        report.TopRules,
+		Goals:           report.Goals,
+		CategoryChanges: report.CategoryChanges,
+		CreatedAt:       report.CreatedAt,
+	}, nil
+}
+
+type ListReportsResponse struct {
+	Reports []ReportSummary `cbor:"reports" json:"reports"`
+}
+
+type ReportSummary struct {
+	Month        string `cbor:"month" json:"month"`
+	Headline     string `cbor:"headline" json:"headline"`
+	IncomeCents  int64  `cbor:"income_cents" json:"income_cents"`
+	SpendCents   int64  `cbor:"spend_cents" json:"spend_cents"`
+	SavingsCents int64  `cbor:"savings_cents" json:"savings_cents"`
+	NetCents     int64  `cbor:"net_cents" json:"net_cents"`
+	Currency     string `cbor:"currency" json:"currency"`
+}
+
+type ReportResponse struct {
+	ID              uuid.UUID                         `cbor:"id" json:"id"`
+	Month           string                            `cbor:"month" json:"month"`
+	Headline        string                            `cbor:"headline" json:"headline"`
+	IncomeCents     int64                             `cbor:"income_cents" json:"income_cents"`
+	SpendCents      int64                             `cbor:"spend_cents" json:"spend_cents"`
+	SavingsCents    int64                             `cbor:"savings_cents" json:"savings_cents"`
+	NetCents        int64                             `cbor:"net_cents" json:"net_cents"`
+	Currency        string                            `cbor:"currency" json:"currency"`
+	TopRules        []schematype.ReportRule           `cbor:"top_rules" json:"top_rules"`
+	Goals           []schematype.ReportGoal           `cbor:"goals" json:"goals"`
+	CategoryChanges []schematype.ReportCategoryChange `cbor:"category_changes" json:"category_changes"`
+	CreatedAt       time.Time                         `cbor:"created_at" json:"created_at"`
+}
@@ -9,6 +9,7 @@ import (
 
 	"regulation/internal/ent"
 	entaccount "regulation/internal/ent/account"
+	entfamilymember "regulation/internal/ent/familymember"
 	entrule "regulation/internal/ent/rule"
 	"regulation/internal/protocol"
 	"regulation/server/services/request_context"
@@ -19,31 +20,74 @@ import (
 func (h *Handler) CreateRule(ctx fiber.Ctx, req *CreateRuleRequest) (*CreateRuleResponse, error) {
 	session := request_context.Session(ctx)
 
-	// Validate that target account exists and belongs to user
-	targetAccount, err := h.db.Account.
-		Query().
-		Where(
-			entaccount.ID(req.TargetAccountID),
-			entaccount.UserID(session.UserID),
-			entaccount.IsActive(true),
-		).
-		Only(ctx)
+	targetAccountID := req.TargetAccountID
+	if req.FamilyID != nil {
+		// Family rules always save into the family savings account
+		member, err := h.db.FamilyMember.
+			Query().
+			Where(
+				entfamilymember.UserID(session.UserID),
+				entfamilymember.FamilyID(*req.FamilyID),
+			).
+			WithFamily().
+			Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, protocol.ErrorResponse{
+					Code:    protocol.NotFoundError,
+					Message: "family not found",
+				}
+			}
+			return nil, fmt.Errorf("failed to load family membership: %w", err)
+		}
 
-	if err != nil {
-		if ent.IsNotFound(err) {
+		if member.Role != entfamilymember.RoleOwner && member.Role != entfamilymember.RoleParent {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.ForbiddenError,
+				Message: "only family owners and parents can create family rules",
+			}
+		}
+
+		if member.Edges.Family.SavingsAccountID == nil {
+			return nil, protocol.ErrorResponse{
+				Code:    protocol.InvalidParametersError,
+				Message: "family savings account is not set",
+			}
+		}
+		targetAccountID = *member.Edges.Family.SavingsAccountID
+	} else {
+		// Validate that target account exists and belongs to user
+		targetAccount, err := h.db.Account.
+			Query().
+			Where(
+				entaccount.ID(req.TargetAccountID),
+				entaccount.UserID(session.UserID),
+				entaccount.IsActive(true),
+			).
+			Only(ctx)
+
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, protocol.ErrorResponse{
+					Code:    protocol.NotFoundError,
+					Message: "target account not found or not owned by user",
+				}
+			}
+			return nil, fmt.Errorf("failed to validate target account: %w", err)
+		}
+
+		// Validate that target account is a savings account
+		if targetAccount.Type != entaccount.TypeSavings {
 			return nil, protocol.ErrorResponse{
-				Code:    protocol.NotFoundError,
-				Message: "target account not found or not owned by user",
+				Code:    protocol.InvalidParametersError,
+				Message: "target account must be a savings account",
 			}
 		}
-		return nil, fmt.Errorf("failed to validate target account: %w", err)
 	}
 
-	// Validate that target account is a savings account
-	if targetAccount.Type != entaccount.TypeSavings {
-		return nil, protocol.ErrorResponse{
-			Code:    protocol.InvalidParametersError,
-			Message: "target account must be a savings account",
+	if req.BudgetID != nil {
+		if err := h.validateBudget(ctx, session.UserID, *req.BudgetID, req.FamilyID, req.Category); err != nil {
+			return nil, err
 		}
 	}
 
@@ -55,9 +99,12 @@ func (h *Handler) CreateRule(ctx fiber.Ctx, req *CreateRuleRequest) (*CreateRule
 		SetCategory(req.Category).
 		SetNillableMinAmountCents(req.MinAmountCents).
 		SetNillableMaxAmountCents(req.MaxAmountCents).
+		SetNillableFamilyID(req.FamilyID).
+		SetNillableMonthlyThresholdCents(req.MonthlyThresholdCents).
+		SetNillableBudgetID(req.BudgetID).
 		SetActionType(req.ActionType).
 		SetActionValue(req.ActionValue).
-		SetTargetAccountID(req.TargetAccountID).
+		SetTargetAccountID(targetAccountID).
 		SetPriority(req.Priority).
 		Save(ctx)
 
@@ -66,28 +113,37 @@ func (h *Handler) CreateRule(ctx fiber.Ctx, req *CreateRuleRequest) (*CreateRule
 	}
 
 	return &CreateRuleResponse{
-		ID:              rule.ID,
-		Name:            rule.Name,
-		Category:        string(rule.Category),
-		MinAmountCents:  rule.MinAmountCents,
-		MaxAmountCents:  rule.MaxAmountCents,
-		ActionType:      string(rule.ActionType),
-		ActionValue:     rule.ActionValue,
-		TargetAccountID: rule.TargetAccountID,
-		Priority:        rule.Priority,
-		IsActive:        rule.IsActive,
-		ExecutionCount:  rule.ExecutionCount,
-		TotalSavedCents: rule.TotalSavedCents,
-		CreatedAt:       rule.CreatedAt,
-		UpdatedAt:       rule.UpdatedAt,
+		ID:                    rule.ID,
+		Name:                  rule.Name,
+		FamilyID:              rule.FamilyID,
+		Category:              string(rule.Category),
+		MinAmountCents:        rule.MinAmountCents,
+		MaxAmountCents:        rule.MaxAmountCents,
+		MonthlyThresholdCents: rule.MonthlyThresholdCents,
+		BudgetID:              rule.BudgetID,
+		ActionType:            string(rule.ActionType),
+		ActionValue:           rule.ActionValue,
+		TargetAccountID:       rule.TargetAccountID,
+		Priority:              rule.Priority,
+		IsActive:              rule.IsActive,
+		ExecutionCount:        rule.ExecutionCount,
+		TotalSavedCents:       rule.TotalSavedCents,
+		CreatedAt:             rule.CreatedAt,
+		UpdatedAt:             rule.UpdatedAt,
 	}, nil
 }
 
 type CreateRuleRequest struct {
-	Name            string             `cbor:"name" json:"name"`
-	Category        entrule.Category   `cbor:"category" json:"category"`
-	MinAmountCents  *int64             `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
-	MaxAmountCents  *int64             `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
+	Name           string           `cbor:"name" json:"name"`
+	Category       entrule.Category `cbor:"category" json:"category"`
+	MinAmountCents *int64           `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
+	MaxAmountCents *int64           `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
+	// MonthlyThresholdCents only triggers the rule on month-to-date spend above this amount
+	MonthlyThresholdCents *int64 `cbor:"monthly_threshold_cents,omitempty" json:"monthly_threshold_cents,omitempty"`
+	// BudgetID uses the budget amount as monthly threshold: the rule applies once over budget
+	BudgetID *uuid.UUID `cbor:"budget_id,omitempty" json:"budget_id,omitempty"`
+	// FamilyID creates a family rule evaluated over all members' transactions
+	FamilyID        *uuid.UUID         `cbor:"family_id,omitempty" json:"family_id,omitempty"`
 	ActionType      entrule.ActionType `cbor:"action_type" json:"action_type"`
 	ActionValue     float64            `cbor:"action_value" json:"action_value"`
 	TargetAccountID uuid.UUID          `cbor:"target_account_id" json:"target_account_id"`
@@ -109,29 +165,33 @@ func (r *CreateRuleRequest) Validate() error {
 		)),
 		validation.Field(&r.MinAmountCents, validation.Min(0)),
 		validation.Field(&r.MaxAmountCents, validation.Min(0)),
+		validation.Field(&r.MonthlyThresholdCents, validation.Min(0)),
 		validation.Field(&r.ActionType, validation.Required, validation.In(
 			entrule.ActionTypeMultiply,
 			entrule.ActionTypeFixed,
 		)),
 		validation.Field(&r.ActionValue, validation.Required, validation.Min(0.0)),
-		validation.Field(&r.TargetAccountID, validation.Required),
+		validation.Field(&r.TargetAccountID, validation.When(r.FamilyID == nil, validation.Required)),
 		validation.Field(&r.Priority, validation.Min(0)),
 	)
 }
 
 type CreateRuleResponse struct {
-	ID              uuid.UUID `cbor:"id" json:"id"`
-	Name            string    `cbor:"name" json:"name"`
-	Category        string    `cbor:"category" json:"category"`
-	MinAmountCents  *int64    `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
-	MaxAmountCents  *int64    `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
-	ActionType      string    `cbor:"action_type" json:"action_type"`
-	ActionValue     float64   `cbor:"action_value" json:"action_value"`
-	TargetAccountID uuid.UUID `cbor:"target_account_id" json:"target_account_id"`
-	Priority        int       `cbor:"priority" json:"priority"`
-	IsActive        bool      `cbor:"is_active" json:"is_active"`
-	ExecutionCount  int       `cbor:"execution_count" json:"execution_count"`
-	TotalSavedCents int64     `cbor:"total_saved_cents" json:"total_saved_cents"`
-	CreatedAt       any       `cbor:"created_at" json:"created_at"`
-	UpdatedAt       any       `cbor:"updated_at" json:"updated_at"`
+	ID                    uuid.UUID  `cbor:"id" json:"id"`
+	Name                  string     `cbor:"name" json:"name"`
+	FamilyID              *uuid.UUID `cbor:"family_id,omitempty" json:"family_id,omitempty"`
+	Category              string     `cbor:"category" json:"category"`
+	MinAmountCents        *int64     `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
+	MaxAmountCents        *int64     `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
+	MonthlyThresholdCents *int64     `cbor:"monthly_threshold_cents,omitempty" json:"monthly_threshold_cents,omitempty"`
+	BudgetID              *uuid.UUID `cbor:"budget_id,omitempty" json:"budget_id,omitempty"`
+	ActionType            string     `cbor:"action_type" json:"action_type"`
+	ActionValue           float64    `cbor:"action_value" json:"action_value"`
+	TargetAccountID       uuid.UUID  `cbor:"target_account_id" json:"target_account_id"`
+	Priority              int        `cbor:"priority" json:"priority"`
+	IsActive              bool       `cbor:"is_active" json:"is_active"`
+	ExecutionCount        int        `cbor:"execution_count" json:"execution_count"`
+	TotalSavedCents       int64      `cbor:"total_saved_cents" json:"total_saved_cents"`
+	CreatedAt             any        `cbor:"created_at" json:"created_at"`
+	UpdatedAt             any        `cbor:"updated_at" json:"updated_at"`
 }
@@ -23,12 +23,17 @@ func (h *Handler) DeleteRule(ctx fiber.Ctx) error {
 		}
 	}
 
+	manageable, err := h.visibleRules(ctx, session.UserID, true)
+	if err != nil {
+		return err
+	}
+
 	// Delete the rule (verifying ownership via WHERE clause)
 	deleted, err := h.db.Rule.
 		Delete().
 		Where(
 			entrule.ID(ruleID),
-			entrule.UserID(session.UserID),
+			manageable,
 		).
 		Exec(ctx)
 
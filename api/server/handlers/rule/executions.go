@@ -27,12 +27,17 @@ func (h *Handler) GetRuleExecutions(ctx fiber.Ctx) (*RuleExecutionsResponse, err
 		}
 	}
 
-	// Verify rule exists and belongs to user
+	visible, err := h.visibleRules(ctx, session.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify rule exists and is visible to the user
 	_, err = h.db.Rule.
 		Query().
 		Where(
 			entrule.ID(ruleID),
-			entrule.UserID(session.UserID),
+			visible,
 		).
 		Only(ctx)
 
@@ -66,6 +71,8 @@ func (h *Handler) GetRuleExecutions(ctx fiber.Ctx) (*RuleExecutionsResponse, err
 			ID:              exec.ID,
 			RuleID:          exec.RuleID,
 			TransactionID:   exec.TransactionID,
+			UserID:          exec.UserID,
+			FamilyID:        exec.FamilyID,
 			AmountCents:     exec.AmountCents,
 			SourceAccountID: exec.SourceAccountID,
 			TargetAccountID: exec.TargetAccountID,
@@ -105,6 +112,8 @@ type RuleExecutionResponse struct {
 	ID              uuid.UUID           `cbor:"id" json:"id"`
 	RuleID          uuid.UUID           `cbor:"rule_id" json:"rule_id"`
 	TransactionID   uuid.UUID           `cbor:"transaction_id" json:"transaction_id"`
+	UserID          uuid.UUID           `cbor:"user_id" json:"user_id"`
+	FamilyID        *uuid.UUID          `cbor:"family_id,omitempty" json:"family_id,omitempty"`
 	AmountCents     int64               `cbor:"amount_cents" json:"amount_cents"`
 	SourceAccountID uuid.UUID           `cbor:"source_account_id" json:"source_account_id"`
 	TargetAccountID uuid.UUID           `cbor:"target_account_id" json:"target_account_id"`
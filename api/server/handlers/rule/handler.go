@@ -1,7 +1,17 @@
 package rule
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
 	"regulation/internal/ent"
+	entbudget "regulation/internal/ent/budget"
+	entfamilymember "regulation/internal/ent/familymember"
+	"regulation/internal/ent/predicate"
+	entrule "regulation/internal/ent/rule"
+	"regulation/internal/protocol"
 	"regulation/internal/session"
 )
 
@@ -18,3 +28,69 @@ func New(db *ent.Client, sessionManager *session.Manager) *Handler {
 		sessionManager: sessionManager,
 	}
 }
+
+// visibleRules returns a predicate matching the user's personal rules and,
+// when manageOnly is false or the user is a family owner/parent, their family's rules
+func (h *Handler) visibleRules(ctx context.Context, userID uuid.UUID, manageOnly bool) (predicate.Rule, error) {
+	personal := entrule.And(
+		entrule.UserID(userID),
+		entrule.FamilyIDIsNil(),
+	)
+
+	member, err := h.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return personal, nil
+		}
+		return nil, fmt.Errorf("failed to load family membership: %w", err)
+	}
+
+	if manageOnly && member.Role != entfamilymember.RoleOwner && member.Role != entfamilymember.RoleParent {
+		return personal, nil
+	}
+
+	return entrule.Or(personal, entrule.FamilyID(member.FamilyID)), nil
+}
+
+// validateBudget ensures a budget linked to a rule tracks the rule's category and
+// belongs to the same owner: the user's personal budget for personal rules, the
+// family's budget for family rules
+// Codes: NotFoundError, InvalidParametersError
+func (h *Handler) validateBudget(ctx context.Context, userID, budgetID uuid.UUID, familyID *uuid.UUID, category entrule.Category) error {
+	owner := entbudget.And(
+		entbudget.UserID(userID),
+		entbudget.FamilyIDIsNil(),
+	)
+	if familyID != nil {
+		owner = entbudget.FamilyID(*familyID)
+	}
+
+	budget, err := h.db.Budget.
+		Query().
+		Where(
+			entbudget.ID(budgetID),
+			owner,
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return protocol.ErrorResponse{
+				Code:    protocol.NotFoundError,
+				Message: "budget not found",
+			}
+		}
+		return fmt.Errorf("failed to query budget: %w", err)
+	}
+
+	if string(budget.Category) != string(category) {
+		return protocol.ErrorResponse{
+			Code:    protocol.InvalidParametersError,
+			Message: "budget category must match the rule category",
+		}
+	}
+
+	return nil
+}
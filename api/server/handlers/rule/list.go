@@ -12,15 +12,20 @@ import (
 	"regulation/server/services/request_context"
 )
 
-// ListRules retrieves all rules for the current user
+// ListRules retrieves all personal and family rules for the current user
 // @Route GET /rules
 func (h *Handler) ListRules(ctx fiber.Ctx) (*ListRulesResponse, error) {
 	session := request_context.Session(ctx)
 
-	// Query all rules for user, ordered by priority
+	visible, err := h.visibleRules(ctx, session.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Query all visible rules, ordered by priority
 	rules, err := h.db.Rule.
 		Query().
-		Where(entrule.UserID(session.UserID)).
+		Where(visible).
 		Order(ent.Asc(entrule.FieldPriority)).
 		All(ctx)
 
@@ -31,22 +36,7 @@ func (h *Handler) ListRules(ctx fiber.Ctx) (*ListRulesResponse, error) {
 	// Convert to response format
 	response := make([]RuleResponse, len(rules))
 	for i, rule := range rules {
-		response[i] = RuleResponse{
-			ID:              rule.ID,
-			Name:            rule.Name,
-			Category:        string(rule.Category),
-			MinAmountCents:  rule.MinAmountCents,
-			MaxAmountCents:  rule.MaxAmountCents,
-			ActionType:      string(rule.ActionType),
-			ActionValue:     rule.ActionValue,
-			TargetAccountID: rule.TargetAccountID,
-			Priority:        rule.Priority,
-			IsActive:        rule.IsActive,
-			ExecutionCount:  rule.ExecutionCount,
-			TotalSavedCents: rule.TotalSavedCents,
-			CreatedAt:       rule.CreatedAt,
-			UpdatedAt:       rule.UpdatedAt,
-		}
+		response[i] = toRuleResponse(rule)
 	}
 
 	return &ListRulesResponse{
@@ -69,12 +59,17 @@ func (h *Handler) GetRule(ctx fiber.Ctx) (*RuleResponse, error) {
 		}
 	}
 
-	// Query rule, ensuring it belongs to the user
+	visible, err := h.visibleRules(ctx, session.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Query rule, ensuring it is visible to the user
 	rule, err := h.db.Rule.
 		Query().
 		Where(
 			entrule.ID(ruleID),
-			entrule.UserID(session.UserID),
+			visible,
 		).
 		Only(ctx)
 
@@ -88,22 +83,8 @@ func (h *Handler) GetRule(ctx fiber.Ctx) (*RuleResponse, error) {
 		return nil, fmt.Errorf("failed to get rule: %w", err)
 	}
 
-	return &RuleResponse{
-		ID:              rule.ID,
-		Name:            rule.Name,
-		Category:        string(rule.Category),
-		MinAmountCents:  rule.MinAmountCents,
-		MaxAmountCents:  rule.MaxAmountCents,
-		ActionType:      string(rule.ActionType),
-		ActionValue:     rule.ActionValue,
-		TargetAccountID: rule.TargetAccountID,
-		Priority:        rule.Priority,
-		IsActive:        rule.IsActive,
-		ExecutionCount:  rule.ExecutionCount,
-		TotalSavedCents: rule.TotalSavedCents,
-		CreatedAt:       rule.CreatedAt,
-		UpdatedAt:       rule.UpdatedAt,
-	}, nil
+	response := toRuleResponse(rule)
+	return &response, nil
 }
 
 type ListRulesResponse struct {
@@ -112,18 +93,44 @@ type ListRulesResponse struct {
 }
 
 type RuleResponse struct {
-	ID              uuid.UUID `cbor:"id" json:"id"`
-	Name            string    `cbor:"name" json:"name"`
-	Category        string    `cbor:"category" json:"category"`
-	MinAmountCents  *int64    `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
-	MaxAmountCents  *int64    `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
-	ActionType      string    `cbor:"action_type" json:"action_type"`
-	ActionValue     float64   `cbor:"action_value" json:"action_value"`
-	TargetAccountID uuid.UUID `cbor:"target_account_id" json:"target_account_id"`
-	Priority        int       `cbor:"priority" json:"priority"`
-	IsActive        bool      `cbor:"is_active" json:"is_active"`
-	ExecutionCount  int       `cbor:"execution_count" json:"execution_count"`
-	TotalSavedCents int64     `cbor:"total_saved_cents" json:"total_saved_cents"`
-	CreatedAt       any       `cbor:"created_at" json:"created_at"`
-	UpdatedAt       any       `cbor:"updated_at" json:"updated_at"`
+	ID                    uuid.UUID  `cbor:"id" json:"id"`
+	Name                  string     `cbor:"name" json:"name"`
+	FamilyID              *uuid.UUID `cbor:"family_id,omitempty" json:"family_id,omitempty"`
+	Category              string     `cbor:"category" json:"category"`
+	MinAmountCents        *int64     `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
+	MaxAmountCents        *int64     `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
+	MonthlyThresholdCents *int64     `cbor:"monthly_threshold_cents,omitempty" json:"monthly_threshold_cents,omitempty"`
+	BudgetID              *uuid.UUID `cbor:"budget_id,omitempty" json:"budget_id,omitempty"`
+	ActionType            string     `cbor:"action_type" json:"action_type"`
+	ActionValue           float64    `cbor:"action_value" json:"action_value"`
+	TargetAccountID       uuid.UUID  `cbor:"target_account_id" json:"target_account_id"`
+	Priority              int        `cbor:"priority" json:"priority"`
+	IsActive              bool       `cbor:"is_active" json:"is_active"`
+	ExecutionCount        int        `cbor:"execution_count" json:"execution_count"`
+	TotalSavedCents       int64      `cbor:"total_saved_cents" json:"total_saved_cents"`
+	CreatedAt             any        `cbor:"created_at" json:"created_at"`
+	UpdatedAt             any        `cbor:"updated_at" json:"updated_at"`
+}
+
+// toRuleResponse converts a rule entity to its API representation
+func toRuleResponse(rule *ent.Rule) RuleResponse {
+	return RuleResponse{
+		ID:                    rule.ID,
+		Name:                  rule.Name,
+		FamilyID:              rule.FamilyID,
+		Category:              string(rule.Category),
+		MinAmountCents:        rule.MinAmountCents,
+		MaxAmountCents:        rule.MaxAmountCents,
+		MonthlyThresholdCents: rule.MonthlyThresholdCents,
+		BudgetID:              rule.BudgetID,
+		ActionType:            string(rule.ActionType),
+		ActionValue:           rule.ActionValue,
+		TargetAccountID:       rule.TargetAccountID,
+		Priority:              rule.Priority,
+		IsActive:              rule.IsActive,
+		ExecutionCount:        rule.ExecutionCount,
+		TotalSavedCents:       rule.TotalSavedCents,
+		CreatedAt:             rule.CreatedAt,
+		UpdatedAt:             rule.UpdatedAt,
+	}
 }
@@ -27,12 +27,17 @@ func (h *Handler) UpdateRule(ctx fiber.Ctx, req *UpdateRuleRequest) (*RuleRespon
 		}
 	}
 
-	// Verify rule exists and belongs to user
-	_, err = h.db.Rule.
+	manageable, err := h.visibleRules(ctx, session.UserID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify rule exists and can be managed by the user
+	existing, err := h.db.Rule.
 		Query().
 		Where(
 			entrule.ID(ruleID),
-			entrule.UserID(session.UserID),
+			manageable,
 		).
 		Only(ctx)
 
@@ -46,6 +51,21 @@ func (h *Handler) UpdateRule(ctx fiber.Ctx, req *UpdateRuleRequest) (*RuleRespon
 		return nil, fmt.Errorf("failed to verify rule: %w", err)
 	}
 
+	// Re-validate the linked budget when it or the rule category changes
+	category := existing.Category
+	if req.Category != nil {
+		category = *req.Category
+	}
+	budgetID := existing.BudgetID
+	if req.BudgetID != nil {
+		budgetID = req.BudgetID
+	}
+	if budgetID != nil && (req.BudgetID != nil || req.Category != nil) {
+		if err := h.validateBudget(ctx, existing.UserID, *budgetID, existing.FamilyID, category); err != nil {
+			return nil, err
+		}
+	}
+
 	// Build update query
 	update := h.db.Rule.UpdateOneID(ruleID)
 
@@ -61,6 +81,12 @@ func (h *Handler) UpdateRule(ctx fiber.Ctx, req *UpdateRuleRequest) (*RuleRespon
 	if req.MaxAmountCents != nil {
 		update.SetNillableMaxAmountCents(req.MaxAmountCents)
 	}
+	if req.MonthlyThresholdCents != nil {
+		update.SetNillableMonthlyThresholdCents(req.MonthlyThresholdCents)
+	}
+	if req.BudgetID != nil {
+		update.SetNillableBudgetID(req.BudgetID)
+	}
 	if req.ActionType != nil {
 		update.SetActionType(*req.ActionType)
 	}
@@ -77,22 +103,8 @@ func (h *Handler) UpdateRule(ctx fiber.Ctx, req *UpdateRuleRequest) (*RuleRespon
 		return nil, fmt.Errorf("failed to update rule: %w", err)
 	}
 
-	return &RuleResponse{
-		ID:              rule.ID,
-		Name:            rule.Name,
-		Category:        string(rule.Category),
-		MinAmountCents:  rule.MinAmountCents,
-		MaxAmountCents:  rule.MaxAmountCents,
-		ActionType:      string(rule.ActionType),
-		ActionValue:     rule.ActionValue,
-		TargetAccountID: rule.TargetAccountID,
-		Priority:        rule.Priority,
-		IsActive:        rule.IsActive,
-		ExecutionCount:  rule.ExecutionCount,
-		TotalSavedCents: rule.TotalSavedCents,
-		CreatedAt:       rule.CreatedAt,
-		UpdatedAt:       rule.UpdatedAt,
-	}, nil
+	response := toRuleResponse(rule)
+	return &response, nil
 }
 
 // ToggleRule toggles a rule's active status
@@ -109,12 +121,17 @@ func (h *Handler) ToggleRule(ctx fiber.Ctx) (*RuleResponse, error) {
 		}
 	}
 
+	manageable, err := h.visibleRules(ctx, session.UserID, true)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get current rule
 	rule, err := h.db.Rule.
 		Query().
 		Where(
 			entrule.ID(ruleID),
-			entrule.UserID(session.UserID),
+			manageable,
 		).
 		Only(ctx)
 
@@ -138,32 +155,20 @@ func (h *Handler) ToggleRule(ctx fiber.Ctx) (*RuleResponse, error) {
 		return nil, fmt.Errorf("failed to toggle rule: %w", err)
 	}
 
-	return &RuleResponse{
-		ID:              rule.ID,
-		Name:            rule.Name,
-		Category:        string(rule.Category),
-		MinAmountCents:  rule.MinAmountCents,
-		MaxAmountCents:  rule.MaxAmountCents,
-		ActionType:      string(rule.ActionType),
-		ActionValue:     rule.ActionValue,
-		TargetAccountID: rule.TargetAccountID,
-		Priority:        rule.Priority,
-		IsActive:        rule.IsActive,
-		ExecutionCount:  rule.ExecutionCount,
-		TotalSavedCents: rule.TotalSavedCents,
-		CreatedAt:       rule.CreatedAt,
-		UpdatedAt:       rule.UpdatedAt,
-	}, nil
+	response := toRuleResponse(rule)
+	return &response, nil
 }
 
 type UpdateRuleRequest struct {
-	Name           *string             `cbor:"name,omitempty" json:"name,omitempty"`
-	Category       *entrule.Category   `cbor:"category,omitempty" json:"category,omitempty"`
-	MinAmountCents *int64              `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
-	MaxAmountCents *int64              `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
-	ActionType     *entrule.ActionType `cbor:"action_type,omitempty" json:"action_type,omitempty"`
-	ActionValue    *float64            `cbor:"action_value,omitempty" json:"action_value,omitempty"`
-	Priority       *int                `cbor:"priority,omitempty" json:"priority,omitempty"`
+	Name                  *string             `cbor:"name,omitempty" json:"name,omitempty"`
+	Category              *entrule.Category   `cbor:"category,omitempty" json:"category,omitempty"`
+	MinAmountCents        *int64              `cbor:"min_amount_cents,omitempty" json:"min_amount_cents,omitempty"`
+	MaxAmountCents        *int64              `cbor:"max_amount_cents,omitempty" json:"max_amount_cents,omitempty"`
+	MonthlyThresholdCents *int64              `cbor:"monthly_threshold_cents,omitempty" json:"monthly_threshold_cents,omitempty"`
+	BudgetID              *uuid.UUID          `cbor:"budget_id,omitempty" json:"budget_id,omitempty"`
+	ActionType            *entrule.ActionType `cbor:"action_type,omitempty" json:"action_type,omitempty"`
+	ActionValue           *float64            `cbor:"action_value,omitempty" json:"action_value,omitempty"`
+	Priority              *int                `cbor:"priority,omitempty" json:"priority,omitempty"`
 }
 
 func (r *UpdateRuleRequest) Validate() error {
@@ -181,6 +186,7 @@ func (r *UpdateRuleRequest) Validate() error {
 		)),
 		validation.Field(&r.MinAmountCents, validation.Min(0)),
 		validation.Field(&r.MaxAmountCents, validation.Min(0)),
+		validation.Field(&r.MonthlyThresholdCents, validation.Min(0)),
 		validation.Field(&r.ActionType, validation.In(
 			entrule.ActionTypeMultiply,
 			entrule.ActionTypeFixed,
@@ -11,7 +11,15 @@ import (
 	"regulation/internal/ent"
 	"regulation/internal/rulesuggestion"
 	"regulation/internal/session"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/backfill"
+	"regulation/server/services/budget"
+	"regulation/server/services/cashflow"
+	"regulation/server/services/importer"
+	"regulation/server/services/networth"
 	"regulation/server/services/plaid"
+	"regulation/server/services/recommendation"
+	"regulation/server/services/report"
 
 	"github.com/fxamacker/cbor/v2"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -27,6 +35,14 @@ var (
 	decoder cbor.DecMode
 )
 
+const (
+	// plaidRequestsPerSecond and plaidRequestBurst bound Plaid API usage of all replicas together
+	plaidRequestsPerSecond = 10
+	plaidRequestBurst      = 20
+	// syncConcurrency is the number of items the sync worker syncs at once
+	syncConcurrency = 4
+)
+
 func init() {
 	validation.ErrorTag = "cbor"
 
@@ -38,16 +54,26 @@ type Server struct {
 	app    *fiber.App
 	config *config.Config
 
-	db                 *ent.Client
-	categorizerService *categorizer.Service
-	suggestionService  *rulesuggestion.Service
-	cache              rueidis.Client
-	cacheLock          rueidislock.Locker
-	sessionManager     *session.Manager
+	db                    *ent.Client
+	categorizerService    *categorizer.Service
+	suggestionService     *rulesuggestion.Service
+	recommendationService *recommendation.Service
+	cache                 rueidis.Client
+	cacheLock             rueidislock.Locker
+	sessionManager        *session.Manager
+	cashflowService       *cashflow.Service
+	netWorthService       *networth.Service
+	budgetTracker         *budget.Tracker
+
+	plaidClient    plaid.Client
+	syncService    *aggregator.SyncService
+	syncWorker     *aggregator.SyncWorker
+	webhookService *plaid.WebhookService
+	backfillWorker *backfill.Worker
+
+	importService *importer.Service
 
-	plaidClient plaid.Client
-	syncService *plaid.SyncService
-	syncWorker  *plaid.SyncWorker
+	reportGenerator *report.Generator
 
 	logger zerolog.Logger
 }
@@ -126,6 +152,17 @@ func (s *Server) init(ctx context.Context) error {
 		return fmt.Errorf("failed to setup database: %w", err)
 	}
 
+	// Totals across currencies are reported in each user's home currency
+	rates, err := s.config.Currency.RateProvider()
+	if err != nil {
+		return fmt.Errorf("failed to load exchange rates: %w", err)
+	}
+
+	// Initialize cashflow aggregation service
+	s.cashflowService = cashflow.NewService(s.db, rates)
+	s.netWorthService = networth.NewService(s.db, rates)
+	s.budgetTracker = budget.NewTracker(s.db, s.config, s.cashflowService)
+
 	// Initialize Plaid client
 	if s.config.Plaid == nil || s.config.Plaid.UseMockClient() {
 		s.logger.Warn().Str("component", "plaid").Msg("using mock plaid client; no network calls will be made")
@@ -137,11 +174,35 @@ func (s *Server) init(ctx context.Context) error {
 		}
 	}
 
-	// Initialize sync service
-	s.syncService = plaid.NewSyncService(s.plaidClient, s.db, s.config, s.categorizerService)
+	// Share one rate limit across every Plaid request of every replica
+	s.plaidClient = plaid.NewRateLimitedClient(s.plaidClient, plaid.NewRateLimiter(s.cache, plaidRequestsPerSecond, plaidRequestBurst))
+	// Cached institutions don't count against the rate limit
+	s.plaidClient = plaid.NewInstitutionCachingClient(s.plaidClient, s.cache)
 
-	// Initialize sync worker with 30-second interval
-	s.syncWorker = plaid.NewSyncWorker(s.syncService, s.db, 30*time.Second)
+	// Initialize sync service; further aggregators plug in as additional providers
+	providers := []aggregator.AggregatorProvider{plaid.NewProvider(s.plaidClient)}
+	s.syncService = aggregator.NewSyncService(providers, s.db, s.config, s.categorizerService, s.cashflowService, s.budgetTracker, s.netWorthService, s.cacheLock)
+
+	// Initialize sync worker; with webhooks delivering updates, scheduled syncs are only a slow safety net
+	syncInterval := 30 * time.Second
+	if s.config.Plaid.GetWebhookURL() != "" {
+		syncInterval = 15 * time.Minute
+	}
+	s.syncWorker = aggregator.NewSyncWorker(s.syncService, s.db, s.cacheLock, syncInterval, syncConcurrency)
+
+	// Initialize webhook service, caching Plaid's verification keys for a day
+	webhookVerifier := plaid.NewWebhookVerifier(plaid.NewCachedKeyProvider(s.plaidClient, 24*time.Hour))
+	s.webhookService = plaid.NewWebhookService(webhookVerifier, s.db, s.config, s.syncService, s.syncWorker)
+
+	// Initialize starter rule recommendations and the backfill worker that runs them for newly linked items
+	s.recommendationService = recommendation.NewService(s.db, s.suggestionService)
+	s.backfillWorker = backfill.NewWorker(s.db, s.config, s.syncService, s.recommendationService, s.cacheLock)
+
+	// Initialize statement imports for manual accounts, processed like synced transactions
+	s.importService = importer.NewService(s.db, s.syncService, s.netWorthService)
+
+	// Initialize monthly report generator, checking hourly for a new month
+	s.reportGenerator = report.NewGenerator(s.db, s.config, s.cashflowService, time.Hour)
 
 	s.route()
 
@@ -194,6 +255,12 @@ func (s *Server) Run(ctx context.Context) error {
 	// Start sync worker in background
 	go s.syncWorker.Start(ctx)
 
+	// Start backfill worker in background
+	go s.backfillWorker.Start(ctx)
+
+	// Start monthly report generator in background
+	go s.reportGenerator.Start(ctx)
+
 	s.logger.Info().Msg("starting server")
 	if err := s.listen(ctx); err != nil {
 		return fmt.Errorf("server error: %w", err)
@@ -7,6 +7,8 @@ import (
 
 	"regulation/internal/config"
 	"regulation/internal/ent"
+	_ "regulation/internal/ent/runtime"
+	"regulation/internal/envelope"
 
 	entsql "entgo.io/ent/dialect/sql"
 	"github.com/DeltaLaboratory/entcache"
@@ -51,7 +53,12 @@ func connectDB(ctx context.Context, config *config.Config, redis rueidis.Client)
 	return entClient, nil
 }
 func (s *Server) setupDatabase(ctx context.Context) error {
-	var err error
+	// Encrypted fields such as Item.access_token use the default cipher
+	kms, err := s.config.Encryption.KMS()
+	if err != nil {
+		return fmt.Errorf("failed to load encryption keys: %w", err)
+	}
+	envelope.SetDefault(envelope.New(kms))
 
 	// Connect to database
 	s.db, err = connectDB(ctx, s.config, s.cache)
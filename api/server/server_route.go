@@ -3,10 +3,14 @@ package server
 import (
 	"regulation/internal/ro"
 	"regulation/server/handlers/account"
+	"regulation/server/handlers/budget"
+	"regulation/server/handlers/family"
 	"regulation/server/handlers/financial"
+	"regulation/server/handlers/goal"
 	"regulation/server/handlers/notification"
 	"regulation/server/handlers/plaid"
 	"regulation/server/handlers/recommendation"
+	"regulation/server/handlers/report"
 	"regulation/server/handlers/rule"
 	"regulation/server/middleware"
 
@@ -42,19 +46,29 @@ func (s *Server) route() {
 		// Protected routes (authentication required)
 		accountGroup.Post("/logout", auth.Handle, ro.WrapHandler4(handler.Logout))
 		accountGroup.Get("/me", auth.Handle, ro.WrapHandler3(handler.Me))
+		accountGroup.Put("/home-currency", auth.Handle, ro.WrapHandler2(handler.SetHomeCurrency))
 	}
 
 	// Plaid onboarding routes - for linking bank accounts
 	plaidGroup := s.app.Group("/plaid")
 	{
-		handler := plaid.New(s.db, s.sessionManager, s.plaidClient, s.syncService)
+		handler := plaid.New(s.db, s.sessionManager, s.plaidClient, s.syncService, s.webhookService, s.backfillWorker)
 		generatorHandler := plaid.NewTransactionGeneratorHandler(handler)
 
 		// All Plaid routes require authentication
 		plaidGroup.Post("/create-link-token", auth.Handle, ro.WrapHandler3(handler.CreateLinkToken))
-		plaidGroup.Post("/exchange-token", auth.Handle, ro.WrapHandler2(handler.ExchangeToken))
+		plaidGroup.Post("/exchange-token", auth.Handle, ro.WrapHandler(handler.ExchangeToken))
 		plaidGroup.Post("/sync-transactions", auth.Handle, ro.WrapHandler2(handler.SyncTransactions))
 		plaidGroup.Delete("/accounts/:id", auth.Handle, ro.WrapHandler3(handler.DisconnectAccount))
+		plaidGroup.Get("/items", auth.Handle, ro.WrapHandler3(handler.GetItems))
+		plaidGroup.Delete("/items/:id", auth.Handle, ro.WrapHandler(handler.RemoveItem))
+		plaidGroup.Get("/items/:id/sync-history", auth.Handle, ro.WrapHandler3(handler.GetSyncHistory))
+		plaidGroup.Get("/items/:id/backfill", auth.Handle, ro.WrapHandler3(handler.GetBackfill))
+		plaidGroup.Post("/items/:id/sync", auth.Handle, ro.WrapHandler3(handler.SyncItem))
+		plaidGroup.Post("/items/:id/update-link-token", auth.Handle, ro.WrapHandler3(handler.CreateUpdateLinkToken))
+
+		// Plaid calls the webhook directly; it is verified by signature instead of a session
+		plaidGroup.Post("/webhook", ro.WrapHandler4(handler.Webhook))
 
 		// Transaction generator endpoints (sandbox only)
 		plaidGroup.Post("/generator/start", auth.Handle, ro.WrapHandler2(generatorHandler.StartGenerator))
@@ -65,13 +79,18 @@ func (s *Server) route() {
 	// Financial dashboard routes - for viewing account data
 	financialGroup := s.app.Group("/financial")
 	{
-		handler := financial.New(s.db, s.sessionManager)
+		handler := financial.New(s.db, s.sessionManager, s.cashflowService, s.netWorthService, s.importService)
 
 		// All financial routes require authentication
 		financialGroup.Get("/accounts", auth.Handle, ro.WrapHandler3(handler.GetAccounts))
 		financialGroup.Post("/transactions", auth.Handle, ro.WrapHandler(handler.GetTransactions))
 		financialGroup.Post("/accounts/:id/transactions", auth.Handle, ro.WrapHandler(handler.GetAccountTransactions))
+		financialGroup.Put("/accounts/:id/funding-account", auth.Handle, ro.WrapHandler2(handler.SetFundingAccount))
+		financialGroup.Post("/accounts/:id/import", auth.Handle, ro.WrapHandler(handler.ImportStatement))
+		financialGroup.Post("/manual-accounts", auth.Handle, ro.WrapHandler(handler.CreateManualAccount))
 		financialGroup.Post("/cashflow", auth.Handle, ro.WrapHandler(handler.GetCashflow))
+		financialGroup.Get("/recurring", auth.Handle, ro.WrapHandler3(handler.GetRecurringCharges))
+		financialGroup.Post("/net-worth", auth.Handle, ro.WrapHandler(handler.GetNetWorth))
 	}
 
 	notificationGroup := s.app.Group("/notification")
@@ -101,11 +120,60 @@ func (s *Server) route() {
 		ruleGroup.Get("/:id/executions", auth.Handle, ro.WrapHandler3(ruleHandler.GetRuleExecutions))
 	}
 
+	// Family routes - for shared family groups and savings
+	familyGroup := s.app.Group("/family")
+	{
+		familyHandler := family.New(s.db, s.sessionManager, s.cashflowService)
+
+		// All family routes require authentication
+		familyGroup.Post("/", auth.Handle, ro.WrapHandler(familyHandler.CreateFamily))
+		familyGroup.Get("/", auth.Handle, ro.WrapHandler3(familyHandler.GetFamily))
+		familyGroup.Post("/members", auth.Handle, ro.WrapHandler(familyHandler.AddMember))
+		familyGroup.Delete("/members/:id", auth.Handle, ro.WrapHandler4(familyHandler.RemoveMember))
+		familyGroup.Put("/savings-account", auth.Handle, ro.WrapHandler2(familyHandler.SetSavingsAccount))
+		familyGroup.Put("/privacy", auth.Handle, ro.WrapHandler2(familyHandler.SetTransactionVisibility))
+		familyGroup.Post("/cashflow", auth.Handle, ro.WrapHandler(familyHandler.GetFamilyCashflow))
+	}
+
+	// Goal routes - for personal and shared family goals
+	goalGroup := s.app.Group("/goals")
+	{
+		goalHandler := goal.New(s.db, s.sessionManager, s.cashflowService)
+
+		// All goal routes require authentication
+		goalGroup.Post("/", auth.Handle, ro.WrapHandler(goalHandler.CreateGoal))
+		goalGroup.Get("/", auth.Handle, ro.WrapHandler3(goalHandler.ListGoals))
+		goalGroup.Delete("/:id", auth.Handle, ro.WrapHandler4(goalHandler.DeleteGoal))
+	}
+
+	// Budget routes - monthly category budgets with threshold alerts
+	budgetGroup := s.app.Group("/budgets")
+	{
+		budgetHandler := budget.New(s.db, s.sessionManager, s.budgetTracker)
+
+		// All budget routes require authentication
+		budgetGroup.Post("/", auth.Handle, ro.WrapHandler(budgetHandler.CreateBudget))
+		budgetGroup.Get("/", auth.Handle, ro.WrapHandler3(budgetHandler.ListBudgets))
+		budgetGroup.Post("/status", auth.Handle, ro.WrapHandler(budgetHandler.GetBudgetStatus))
+		budgetGroup.Patch("/:id", auth.Handle, ro.WrapHandler(budgetHandler.UpdateBudget))
+		budgetGroup.Delete("/:id", auth.Handle, ro.WrapHandler4(budgetHandler.DeleteBudget))
+	}
+
+	// Monthly report routes - archived month-end summaries
+	reportGroup := s.app.Group("/reports")
+	{
+		reportHandler := report.New(s.db, s.sessionManager)
+
+		// All report routes require authentication
+		reportGroup.Get("/", auth.Handle, ro.WrapHandler3(reportHandler.ListReports))
+		reportGroup.Get("/:month", auth.Handle, ro.WrapHandler3(reportHandler.GetReport))
+	}
+
 	// Rule recommendation routes - AI-powered rule suggestions
 	// Note: To create a rule from a recommendation, clients should use POST /rules
 	recommendationGroup := s.app.Group("/recommendations")
 	{
-		recommendationHandler := recommendation.New(s.db, s.sessionManager, s.suggestionService)
+		recommendationHandler := recommendation.New(s.db, s.sessionManager, s.recommendationService)
 
 		// All recommendation routes require authentication
 		recommendationGroup.Get("/", auth.Handle, ro.WrapHandler3(recommendationHandler.GetRecommendations))
@@ -0,0 +1,239 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entrule "regulation/internal/ent/rule"
+	"regulation/server/services/notification"
+)
+
+// reconcileAccounts brings the item's accounts in line with the ones its provider reports:
+// new accounts are created, vanished ones are closed, and names, masks, types and balances are updated.
+// Accounts the user disconnected stay inactive; closed accounts are reactivated if they reappear.
+func (s *SyncService) reconcileAccounts(ctx context.Context, provider AggregatorProvider, item *ent.Item) error {
+	providerAccounts, err := provider.GetAccounts(ctx, item.AccessToken)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from %s: %w", provider.Name(), err)
+	}
+
+	existing, err := s.entClient.Account.
+		Query().
+		Where(entaccount.ItemID(item.ID)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query item accounts: %w", err)
+	}
+
+	known := make(map[string]*ent.Account, len(existing))
+	for _, acc := range existing {
+		known[acc.ExternalID] = acc
+	}
+
+	seen := make(map[string]bool, len(providerAccounts))
+	for _, acc := range providerAccounts {
+		seen[acc.AccountID] = true
+
+		current, ok := known[acc.AccountID]
+		if !ok {
+			if err := s.createAccount(ctx, item, acc); err != nil {
+				log.Error().
+					Err(err).
+					Str("account_id", acc.AccountID).
+					Msg("Failed to create discovered account")
+			}
+			continue
+		}
+
+		if err := s.updateAccount(ctx, current, acc); err != nil {
+			log.Error().
+				Err(err).
+				Str("account_id", acc.AccountID).
+				Msg("Failed to update account")
+			// Continue with other accounts even if one fails
+			continue
+		}
+	}
+
+	// An empty list is more likely a provider glitch than every account closing at once
+	if len(providerAccounts) == 0 {
+		log.Warn().
+			Str("item_id", item.ID.String()).
+			Str("provider", provider.Name()).
+			Msg("[SYNC] Provider returned no accounts, skipping account closure")
+		return nil
+	}
+
+	var closed []*ent.Account
+	for _, acc := range existing {
+		if seen[acc.ExternalID] || !acc.IsActive {
+			continue
+		}
+
+		err := s.entClient.Account.
+			UpdateOne(acc).
+			SetIsActive(false).
+			SetClosedAt(time.Now()).
+			Exec(ctx)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("account_id", acc.ExternalID).
+				Msg("Failed to close vanished account")
+			continue
+		}
+
+		log.Info().
+			Str("item_id", item.ID.String()).
+			Str("account_id", acc.ID.String()).
+			Msg("[SYNC] Closed account that no longer appears at the institution")
+
+		closed = append(closed, acc)
+	}
+
+	if len(closed) > 0 {
+		if err := s.deactivateClosedRuleTargets(ctx, item, closed); err != nil {
+			log.Error().
+				Err(err).
+				Str("item_id", item.ID.String()).
+				Msg("Failed to deactivate rules targeting closed accounts")
+		}
+	}
+
+	return nil
+}
+
+// createAccount stores an account discovered at an existing item and records its first balance snapshot
+func (s *SyncService) createAccount(ctx context.Context, item *ent.Item, acc Account) error {
+	created, err := s.entClient.Account.
+		Create().
+		SetItemID(item.ID).
+		SetUserID(item.UserID).
+		SetExternalID(acc.AccountID).
+		SetName(acc.Name).
+		SetType(acc.Type).
+		SetSubtype(acc.Subtype).
+		SetMask(acc.Mask).
+		SetCurrentBalance(acc.BalanceCurrent).
+		SetNillableAvailableBalance(acc.BalanceAvailable).
+		SetNillableCreditLimit(acc.BalanceLimit).
+		SetCurrency(currency.Normalize(acc.Currency)).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Info().
+		Str("item_id", item.ID.String()).
+		Str("account_id", created.ID.String()).
+		Str("subtype", acc.Subtype).
+		Msg("[SYNC] Discovered new account")
+
+	return s.netWorthService.RecordSnapshot(ctx, created)
+}
+
+// updateAccount applies the details and balances the provider reports to a known account
+// and records its balance snapshot for the day
+func (s *SyncService) updateAccount(ctx context.Context, current *ent.Account, acc Account) error {
+	update := s.entClient.Account.
+		UpdateOne(current).
+		SetName(acc.Name).
+		SetType(acc.Type).
+		SetSubtype(acc.Subtype).
+		SetMask(acc.Mask).
+		SetCurrentBalance(acc.BalanceCurrent).
+		SetNillableAvailableBalance(acc.BalanceAvailable).
+		SetNillableCreditLimit(acc.BalanceLimit).
+		SetCurrency(currency.Normalize(acc.Currency))
+
+	if current.ClosedAt != nil {
+		update.
+			SetIsActive(true).
+			ClearClosedAt()
+
+		log.Info().
+			Str("account_id", current.ID.String()).
+			Msg("[SYNC] Reactivated account that reappeared at the institution")
+	}
+
+	if current.Name != acc.Name || current.Mask != acc.Mask || current.Subtype != acc.Subtype {
+		log.Info().
+			Str("account_id", current.ID.String()).
+			Str("previous_name", current.Name).
+			Str("name", acc.Name).
+			Str("previous_subtype", current.Subtype).
+			Str("subtype", acc.Subtype).
+			Msg("[SYNC] Account details changed")
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	log.Debug().
+		Str("account_id", acc.AccountID).
+		Int64("current_balance", acc.BalanceCurrent).
+		Msg("Account balance updated")
+
+	// Disconnected accounts keep their balance but no longer contribute history
+	if !updated.IsActive {
+		return nil
+	}
+
+	return s.netWorthService.RecordSnapshot(ctx, updated)
+}
+
+// deactivateClosedRuleTargets deactivates the rules saving into closed accounts, like removing an item does,
+// and tells their owners to choose another account
+func (s *SyncService) deactivateClosedRuleTargets(ctx context.Context, item *ent.Item, closed []*ent.Account) error {
+	names := make(map[uuid.UUID]string, len(closed))
+	ids := make([]uuid.UUID, len(closed))
+	for i, acc := range closed {
+		names[acc.ID] = acc.Name
+		ids[i] = acc.ID
+	}
+
+	rules, err := s.entClient.Rule.
+		Query().
+		Where(
+			entrule.TargetAccountIDIn(ids...),
+			entrule.IsActive(true),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query rules: %w", err)
+	}
+
+	for _, r := range rules {
+		if err := s.entClient.Rule.UpdateOne(r).SetIsActive(false).Exec(ctx); err != nil {
+			log.Error().
+				Err(err).
+				Str("rule_id", r.ID.String()).
+				Msg("Failed to deactivate rule targeting closed account")
+			continue
+		}
+
+		log.Info().
+			Str("rule_id", r.ID.String()).
+			Str("account_id", r.TargetAccountID.String()).
+			Msg("[SYNC] Deactivated rule targeting closed account")
+
+		payload := &notification.Payload{
+			Title: "Savings rule needs a new account",
+			Body: fmt.Sprintf("%s no longer appears at %s, so %q was paused. Choose another account and turn the rule back on.",
+				names[r.TargetAccountID], item.InstitutionName, r.Name),
+		}
+
+		s.notificationService.Notify(ctx, r.UserID, payload)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	entaccount "regulation/internal/ent/account"
+)
+
+// ImportTransactions stores transactions that did not come from a provider, such as rows of an uploaded
+// bank statement, through the same categorization, transfer detection and rule pipeline as synced ones.
+// AccountID must be the ExternalID of an account owned by userID and TransactionID the row's unique key.
+func (s *SyncService) ImportTransactions(ctx context.Context, userID uuid.UUID, transactions []Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	if err := s.processAddedTransactions(ctx, entaccount.UserID(userID), transactions); err != nil {
+		return fmt.Errorf("failed to process imported transactions: %w", err)
+	}
+
+	// Imports can carry months of history, so recurring charges are worth re-detecting
+	if err := s.recurringDetector.DetectForUser(ctx, userID); err != nil {
+		// Log but don't fail the import if detection fails
+		log.Error().
+			Err(err).
+			Str("user_id", userID.String()).
+			Msg("[RECURRING] Failed to detect recurring charges")
+	}
+
+	return nil
+}
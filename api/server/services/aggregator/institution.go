@@ -0,0 +1,55 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/ent"
+)
+
+// LookupInstitution retrieves the institution of the item an access token belongs to
+// Returns nil if the provider does not know the item's institution
+func LookupInstitution(ctx context.Context, provider AggregatorProvider, accessToken string) (*Institution, error) {
+	item, err := provider.GetItem(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if item.InstitutionID == "" {
+		return nil, nil
+	}
+
+	return provider.GetInstitution(ctx, item.InstitutionID)
+}
+
+// backfillInstitution stores institution metadata on items linked before it was recorded
+func (s *SyncService) backfillInstitution(ctx context.Context, provider AggregatorProvider, item *ent.Item) error {
+	institution, err := LookupInstitution(ctx, provider, item.AccessToken)
+	if err != nil {
+		return fmt.Errorf("failed to look up institution: %w", err)
+	}
+
+	// An empty institution ID records that the lookup was done
+	update := s.entClient.Item.UpdateOne(item).SetInstitutionID("")
+	if institution != nil {
+		update.
+			SetInstitutionID(institution.ID).
+			SetInstitutionName(institution.Name).
+			SetInstitutionLogo(institution.Logo).
+			SetInstitutionColor(institution.PrimaryColor).
+			SetInstitutionURL(institution.URL)
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to store institution: %w", err)
+	}
+
+	log.Info().
+		Str("item_id", item.ID.String()).
+		Bool("found", institution != nil).
+		Msg("[SYNC] Backfilled item institution")
+
+	return nil
+}
@@ -0,0 +1,250 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/DeltaLaboratory/contrib/hooks"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entitem "regulation/internal/ent/item"
+	entrule "regulation/internal/ent/rule"
+	entruleexecution "regulation/internal/ent/ruleexecution"
+	entsavingstransfer "regulation/internal/ent/savingstransfer"
+	entsynccursor "regulation/internal/ent/synccursor"
+	enttransaction "regulation/internal/ent/transaction"
+)
+
+// RemovalResult summarizes what removing an item changed
+type RemovalResult struct {
+	AccountsDeactivated int
+	RulesDeactivated    int
+	// TransactionsPurged and TransfersPurged are zero unless history was purged
+	TransactionsPurged int
+	TransfersPurged    int
+}
+
+// RemoveItem disconnects an item: its access token is revoked at its provider, the item and its accounts
+// are deactivated, its sync cursor is dropped and rules saving into its accounts are deactivated.
+// With purge, the item's transactions and the rule executions and savings transfers involving its
+// accounts are deleted as well; otherwise they are kept for history.
+// Waits for an in-flight sync of the item to finish first.
+func (s *SyncService) RemoveItem(ctx context.Context, userID, itemID uuid.UUID, purge bool) (*RemovalResult, error) {
+	lockCtx, release, err := s.locker.WithContext(ctx, itemLockName(itemID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire item lock: %w", err)
+	}
+	defer release()
+
+	item, err := s.entClient.Item.
+		Query().
+		Where(
+			entitem.ID(itemID),
+			entitem.UserID(userID),
+		).
+		Only(lockCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := s.provider(item)
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke first so a failure leaves the item syncing rather than silently keeping a live token
+	if err := provider.RemoveItem(lockCtx, item.AccessToken); err != nil {
+		return nil, fmt.Errorf("failed to revoke item: %w", err)
+	}
+
+	result, err := s.deactivateItem(lockCtx, item, purge)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("item_id", item.ID.String()).
+		Bool("purge", purge).
+		Int("accounts_deactivated", result.AccountsDeactivated).
+		Int("rules_deactivated", result.RulesDeactivated).
+		Int("transactions_purged", result.TransactionsPurged).
+		Int("transfers_purged", result.TransfersPurged).
+		Msg("[SYNC] Removed item")
+
+	// Recurring charges are derived from transactions, so re-detect them without the purged history
+	if purge && result.TransactionsPurged > 0 {
+		if err := s.recurringDetector.DetectForUser(lockCtx, item.UserID); err != nil {
+			log.Error().
+				Err(err).
+				Str("item_id", item.ID.String()).
+				Msg("[RECURRING] Failed to detect recurring charges")
+		}
+	}
+
+	return result, nil
+}
+
+// DeactivateRevokedItem applies the local side of RemoveItem to an item whose access was already revoked
+// outside the app, e.g. by the user at their bank. History is kept since the user didn't ask to delete it.
+// Waits for an in-flight sync of the item to finish first.
+func (s *SyncService) DeactivateRevokedItem(ctx context.Context, item *ent.Item) (*RemovalResult, error) {
+	lockCtx, release, err := s.locker.WithContext(ctx, itemLockName(item.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire item lock: %w", err)
+	}
+	defer release()
+
+	result, err := s.deactivateItem(lockCtx, item, false)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("item_id", item.ID.String()).
+		Int("accounts_deactivated", result.AccountsDeactivated).
+		Int("rules_deactivated", result.RulesDeactivated).
+		Msg("[SYNC] Deactivated revoked item")
+
+	return result, nil
+}
+
+// deactivateItem applies the local side of RemoveItem in a single transaction
+func (s *SyncService) deactivateItem(ctx context.Context, item *ent.Item, purge bool) (_ *RemovalResult, ret error) {
+	tx, err := s.entClient.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer hooks.Rollback(tx, &ret)
+
+	result := &RemovalResult{}
+
+	accountIDs, err := tx.Account.
+		Query().
+		Where(entaccount.ItemID(item.ID)).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query item accounts: %w", err)
+	}
+
+	if err := tx.Item.UpdateOne(item).SetIsActive(false).Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to deactivate item: %w", err)
+	}
+
+	result.AccountsDeactivated, err = tx.Account.
+		Update().
+		Where(
+			entaccount.IDIn(accountIDs...),
+			entaccount.IsActive(true),
+		).
+		SetIsActive(false).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deactivate item accounts: %w", err)
+	}
+
+	// The cursor is tied to the revoked access token; relinking creates a new item
+	_, err = tx.SyncCursor.
+		Delete().
+		Where(entsynccursor.ItemID(item.ID)).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete sync cursor: %w", err)
+	}
+
+	result.RulesDeactivated, err = tx.Rule.
+		Update().
+		Where(
+			entrule.TargetAccountIDIn(accountIDs...),
+			entrule.IsActive(true),
+		).
+		SetIsActive(false).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deactivate rules: %w", err)
+	}
+
+	if purge {
+		if err := purgeItemHistory(ctx, tx, accountIDs, result); err != nil {
+			return nil, err
+		}
+	}
+
+	// hooks.Rollback will auto-commit here since ret == nil
+	return result, nil
+}
+
+// purgeItemHistory deletes the transactions of the given accounts and the rule executions
+// and savings transfers involving them
+func purgeItemHistory(ctx context.Context, tx *ent.Tx, accountIDs []uuid.UUID, result *RemovalResult) error {
+	executions := entruleexecution.Or(
+		entruleexecution.HasTransactionWith(enttransaction.AccountIDIn(accountIDs...)),
+		entruleexecution.SourceAccountIDIn(accountIDs...),
+		entruleexecution.TargetAccountIDIn(accountIDs...),
+	)
+
+	var err error
+	result.TransfersPurged, err = tx.SavingsTransfer.
+		Delete().
+		Where(entsavingstransfer.Or(
+			entsavingstransfer.HasRuleExecutionWith(executions),
+			entsavingstransfer.SourceAccountIDIn(accountIDs...),
+			entsavingstransfer.TargetAccountIDIn(accountIDs...),
+		)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge savings transfers: %w", err)
+	}
+
+	_, err = tx.RuleExecution.
+		Delete().
+		Where(executions).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge rule executions: %w", err)
+	}
+
+	// Unlink transfer counterparts at other items so they count as regular transactions again
+	paired, err := tx.Transaction.
+		Query().
+		Where(
+			enttransaction.AccountIDIn(accountIDs...),
+			enttransaction.TransferPairIDNotNil(),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query transfer pairs: %w", err)
+	}
+
+	if len(paired) > 0 {
+		pairIDs := make([]uuid.UUID, len(paired))
+		for i, txn := range paired {
+			pairIDs[i] = *txn.TransferPairID
+		}
+
+		err = tx.Transaction.
+			Update().
+			Where(
+				enttransaction.IDIn(pairIDs...),
+				enttransaction.AccountIDNotIn(accountIDs...),
+			).
+			SetInternalTransfer(false).
+			ClearTransferPairID().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to unlink transfer counterparts: %w", err)
+		}
+	}
+
+	result.TransactionsPurged, err = tx.Transaction.
+		Delete().
+		Where(enttransaction.AccountIDIn(accountIDs...)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to purge transactions: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/ent"
+	entitem "regulation/internal/ent/item"
+	"regulation/server/services/notification"
+)
+
+// SetItemStatus moves an item to a new status, notifying the user when they need to act
+func (s *SyncService) SetItemStatus(ctx context.Context, item *ent.Item, status entitem.Status, reason string) error {
+	if item.Status == status {
+		return nil
+	}
+
+	if err := s.entClient.Item.UpdateOne(item).SetStatus(status).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update item status: %w", err)
+	}
+
+	log.Warn().
+		Str("item_id", item.ID.String()).
+		Str("previous_status", string(item.Status)).
+		Str("status", string(status)).
+		Str("reason", reason).
+		Msg("[SYNC] Item status changed")
+
+	if status != entitem.StatusLoginRequired {
+		return nil
+	}
+
+	payload := &notification.Payload{
+		Title: "Bank connection needs attention",
+		Body:  fmt.Sprintf("Sign in to %s again to keep syncing transactions.", item.InstitutionName),
+	}
+
+	s.notificationService.Notify(ctx, item.UserID, payload)
+
+	return nil
+}
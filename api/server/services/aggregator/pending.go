@@ -0,0 +1,77 @@
+package aggregator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/ent"
+	enttransaction "regulation/internal/ent/transaction"
+)
+
+// mergePendingTransaction folds the pending row a posted transaction replaces on the same account into the posted one.
+// The pending row is re-keyed to the posted external ID so the upsert that follows updates it in place
+// and keeps its ID, instead of leaving two rows to be double counted. Pending rows never run through
+// transfer detection, anomaly scoring or rules, so the posted transaction is processed as new.
+func (s *SyncService) mergePendingTransaction(ctx context.Context, account *ent.Account, tx Transaction) error {
+	if tx.Pending || tx.PendingTransactionID == "" {
+		return nil
+	}
+
+	pending, err := s.entClient.Transaction.
+		Query().
+		Where(
+			enttransaction.AccountID(account.ID),
+			enttransaction.ExternalID(tx.PendingTransactionID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		// The pending row was never synced or was already removed
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to query pending transaction: %w", err)
+	}
+
+	posted, err := s.entClient.Transaction.
+		Query().
+		Where(
+			enttransaction.AccountID(account.ID),
+			enttransaction.ExternalID(tx.TransactionID),
+		).
+		Exist(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to check posted transaction: %w", err)
+	}
+
+	// The posted row is already stored, so the pending one is a leftover duplicate
+	if posted {
+		if err := s.entClient.Transaction.DeleteOne(pending).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete duplicate pending transaction: %w", err)
+		}
+
+		log.Info().
+			Str("transaction_id", tx.TransactionID).
+			Str("pending_transaction_id", tx.PendingTransactionID).
+			Msg("[SYNC] Removed pending transaction superseded by posted transaction")
+		return nil
+	}
+
+	err = s.entClient.Transaction.
+		UpdateOne(pending).
+		SetExternalID(tx.TransactionID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to link pending transaction: %w", err)
+	}
+
+	log.Info().
+		Str("transaction_id", tx.TransactionID).
+		Str("pending_transaction_id", tx.PendingTransactionID).
+		Int64("pending_amount", pending.Amount).
+		Int64("posted_amount", tx.Amount).
+		Msg("[SYNC] Merged pending transaction into posted transaction")
+
+	return nil
+}
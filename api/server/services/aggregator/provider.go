@@ -0,0 +1,109 @@
+// Package aggregator syncs accounts and transactions from account aggregators such as Plaid.
+// Each aggregator is an AggregatorProvider; items record the provider they were linked through,
+// and the sync, rule and notification pipeline works the same for every provider.
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	entaccount "regulation/internal/ent/account"
+	entitem "regulation/internal/ent/item"
+)
+
+// ErrUnknownProvider is returned when an item was linked through a provider that is not configured
+var ErrUnknownProvider = errors.New("unknown aggregator provider")
+
+// AggregatorProvider is an account aggregator items are linked through and synced from
+type AggregatorProvider interface {
+	// Name identifies the provider, stored as the provider of the items linked through it
+	Name() string
+
+	// GetAccounts retrieves all accounts for an access token
+	GetAccounts(ctx context.Context, accessToken string) ([]Account, error)
+
+	// SyncTransactions retrieves the transactions added, modified and removed since cursor,
+	// an empty cursor starting from the beginning of the item's history
+	SyncTransactions(ctx context.Context, accessToken, cursor string) (*TransactionSyncResult, error)
+
+	// RemoveItem revokes an item's access token; an item already gone at the provider is not an error
+	RemoveItem(ctx context.Context, accessToken string) error
+
+	// GetItem retrieves the item an access token belongs to
+	GetItem(ctx context.Context, accessToken string) (*ItemInfo, error)
+
+	// GetInstitution retrieves an institution's name and branding
+	GetInstitution(ctx context.Context, institutionID string) (*Institution, error)
+
+	// ClassifyError maps an error returned by the provider to the item status it indicates
+	// Returns false for errors that say nothing about the item's health
+	ClassifyError(err error) (entitem.Status, bool)
+}
+
+// ItemInfo describes an item at its provider
+type ItemInfo struct {
+	ItemID        string
+	InstitutionID string // empty if the provider does not know the institution
+}
+
+// Institution describes a financial institution
+type Institution struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Logo         string `json:"logo,omitempty"`          // base64-encoded PNG, may be empty
+	PrimaryColor string `json:"primary_color,omitempty"` // hex color, may be empty
+	URL          string `json:"url,omitempty"`
+}
+
+// Account represents a bank account reported by a provider
+type Account struct {
+	AccountID        string // the provider's ID of the account
+	Name             string
+	Type             entaccount.Type
+	Subtype          string // the provider's detailed subtype
+	Mask             string
+	BalanceCurrent   int64  // in cents
+	BalanceAvailable *int64 // in cents, may be nil
+	BalanceLimit     *int64 // credit limit in cents, may be nil
+	Currency         string // ISO 4217 code of the balances
+}
+
+// Transaction represents a transaction reported by a provider
+type Transaction struct {
+	TransactionID  string // the provider's ID of the transaction
+	AccountID      string // the provider's ID of the account
+	Amount         int64  // in cents (positive = debit, negative = credit)
+	Currency       string // ISO 4217 code of the amount
+	Date           time.Time
+	Name           string
+	MerchantName   string
+	Categories     []string
+	Pending        bool
+	PaymentChannel string
+	// PendingTransactionID is set on posted transactions that replace a pending one
+	PendingTransactionID string
+}
+
+// TransactionSyncResult contains the result of syncing transactions
+type TransactionSyncResult struct {
+	Added    []Transaction
+	Modified []Transaction
+	Removed  []string // transaction IDs that were removed
+	Cursor   string
+	HasMore  bool
+	// UpdateStatus is empty for providers that return all available history on every sync
+	UpdateStatus UpdateStatus
+}
+
+// UpdateStatus is how much of an item's transaction history the provider has pulled from the institution
+type UpdateStatus string
+
+const (
+	// UpdateStatusNotReady means no history has been pulled yet
+	UpdateStatusNotReady UpdateStatus = "not_ready"
+	// UpdateStatusInitialComplete means the last 30 days are available
+	UpdateStatusInitialComplete UpdateStatus = "initial_update_complete"
+	// UpdateStatusHistoricalComplete means all requested history is available
+	UpdateStatusHistoricalComplete UpdateStatus = "historical_update_complete"
+)
@@ -0,0 +1,22 @@
+package aggregator
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// leaderLockName is held by the replica that runs the periodic sync cycle
+	leaderLockName = "plaid:sync:leader"
+	// itemLockPrefix prefixes the lock held while an item is being synced
+	itemLockPrefix = "plaid:sync:item:"
+)
+
+// ErrSyncInProgress is returned when another process is already syncing the item
+var ErrSyncInProgress = errors.New("item sync already in progress")
+
+// itemLockName returns the name of the lock guarding an item's sync
+func itemLockName(itemID uuid.UUID) string {
+	return itemLockPrefix + itemID.String()
+}
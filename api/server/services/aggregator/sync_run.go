@@ -0,0 +1,106 @@
+package aggregator
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entruleexecution "regulation/internal/ent/ruleexecution"
+	entsyncrun "regulation/internal/ent/syncrun"
+	enttransaction "regulation/internal/ent/transaction"
+)
+
+// syncRunRetention is how long sync runs are kept for troubleshooting
+const syncRunRetention = 30 * 24 * time.Hour
+
+// SyncResult tallies the transactions processed by an item sync
+type SyncResult struct {
+	Added    int
+	Modified int
+	Removed  int
+	// UpdateStatus is how much history the provider had pulled as of the sync's last page
+	UpdateStatus UpdateStatus
+}
+
+// startRun records the start of an item sync
+// Failing to record is logged and the sync proceeds without a run
+func (s *SyncService) startRun(ctx context.Context, itemID uuid.UUID, trigger entsyncrun.Trigger) *ent.SyncRun {
+	run, err := s.entClient.SyncRun.
+		Create().
+		SetItemID(itemID).
+		SetTrigger(trigger).
+		Save(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("item_id", itemID.String()).
+			Msg("[SYNC] Failed to record sync run")
+		return nil
+	}
+
+	return run
+}
+
+// finishRun records the outcome of an item sync and prunes runs past syncRunRetention
+func (s *SyncService) finishRun(ctx context.Context, run *ent.SyncRun, result *SyncResult, syncErr error) {
+	if run == nil {
+		return
+	}
+
+	// Rules run synchronously during the sync, so every execution since the start belongs to it
+	rulesTriggered, err := s.entClient.RuleExecution.
+		Query().
+		Where(
+			entruleexecution.CreatedAtGTE(run.StartedAt),
+			entruleexecution.HasTransactionWith(
+				enttransaction.HasAccountWith(entaccount.ItemID(run.ItemID)),
+			),
+		).
+		Count(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("sync_run_id", run.ID.String()).
+			Msg("[SYNC] Failed to count rules triggered by sync run")
+	}
+
+	update := s.entClient.SyncRun.
+		UpdateOne(run).
+		SetStatus(entsyncrun.StatusSucceeded).
+		SetAddedCount(result.Added).
+		SetModifiedCount(result.Modified).
+		SetRemovedCount(result.Removed).
+		SetRulesTriggered(rulesTriggered).
+		SetFinishedAt(time.Now())
+
+	if syncErr != nil {
+		update.
+			SetStatus(entsyncrun.StatusFailed).
+			SetError(syncErr.Error())
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		log.Error().
+			Err(err).
+			Str("sync_run_id", run.ID.String()).
+			Msg("[SYNC] Failed to record sync run outcome")
+	}
+
+	_, err = s.entClient.SyncRun.
+		Delete().
+		Where(
+			entsyncrun.ItemID(run.ItemID),
+			entsyncrun.StartedAtLT(time.Now().Add(-syncRunRetention)),
+		).
+		Exec(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("item_id", run.ItemID.String()).
+			Msg("[SYNC] Failed to prune old sync runs")
+	}
+}
@@ -0,0 +1,828 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/rueidis/rueidislock"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/categorizer"
+	"regulation/internal/config"
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entitem "regulation/internal/ent/item"
+	"regulation/internal/ent/predicate"
+	entsynccursor "regulation/internal/ent/synccursor"
+	entsyncrun "regulation/internal/ent/syncrun"
+	enttransaction "regulation/internal/ent/transaction"
+	"regulation/server/services/anomaly"
+	"regulation/server/services/budget"
+	"regulation/server/services/cashflow"
+	"regulation/server/services/networth"
+	"regulation/server/services/notification"
+	"regulation/server/services/recurring"
+	"regulation/server/services/rule"
+	"regulation/server/services/transfer"
+)
+
+// SyncService handles transaction synchronization from aggregators
+type SyncService struct {
+	providers           map[string]AggregatorProvider
+	entClient           *ent.Client
+	ruleEngine          *rule.Engine
+	transferDetector    *transfer.Detector
+	anomalyScorer       *anomaly.Scorer
+	budgetTracker       *budget.Tracker
+	recurringDetector   *recurring.Detector
+	netWorthService     *networth.Service
+	categorizerService  *categorizer.Service
+	notificationService *notification.Service
+
+	// locker coordinates item syncs across replicas
+	locker rueidislock.Locker
+}
+
+// NewSyncService creates a new transaction sync service syncing items through the given providers
+func NewSyncService(providers []AggregatorProvider, entClient *ent.Client, cfg *config.Config, categorizerSvc *categorizer.Service, cashflowService *cashflow.Service, budgetTracker *budget.Tracker, netWorthService *networth.Service, locker rueidislock.Locker) *SyncService {
+	byName := make(map[string]AggregatorProvider, len(providers))
+	for _, provider := range providers {
+		byName[provider.Name()] = provider
+	}
+
+	return &SyncService{
+		providers:           byName,
+		entClient:           entClient,
+		ruleEngine:          rule.NewEngine(entClient, cfg, cashflowService),
+		transferDetector:    transfer.NewDetector(entClient),
+		anomalyScorer:       anomaly.NewScorer(entClient, cfg),
+		budgetTracker:       budgetTracker,
+		recurringDetector:   recurring.NewDetector(entClient, cfg),
+		netWorthService:     netWorthService,
+		categorizerService:  categorizerSvc,
+		notificationService: notification.New(cfg, entClient),
+		locker:              locker,
+	}
+}
+
+// provider returns the provider an item was linked through
+func (s *SyncService) provider(item *ent.Item) (AggregatorProvider, error) {
+	provider, ok := s.providers[item.Provider]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownProvider, item.Provider)
+	}
+	return provider, nil
+}
+
+// SyncItemTransactions synchronizes transactions for a single Item, returning the number added
+// The item is locked across replicas while syncing so its transactions and rules are processed once;
+// ErrSyncInProgress is returned when another process holds the lock.
+// Each sync that acquires the lock is recorded as a SyncRun with the given trigger
+func (s *SyncService) SyncItemTransactions(ctx context.Context, itemID uuid.UUID, trigger entsyncrun.Trigger) (int, error) {
+	result, err := s.SyncItemWithResult(ctx, itemID, trigger)
+	return result.Added, err
+}
+
+// SyncItemWithResult synchronizes transactions for a single Item like SyncItemTransactions,
+// also reporting what was processed and how much history the provider has pulled
+// The result is never nil, tallying what was processed before any error
+func (s *SyncService) SyncItemWithResult(ctx context.Context, itemID uuid.UUID, trigger entsyncrun.Trigger) (*SyncResult, error) {
+	result := &SyncResult{}
+
+	lockCtx, release, err := s.locker.TryWithContext(ctx, itemLockName(itemID))
+	if err != nil {
+		if errors.Is(err, rueidislock.ErrNotLocked) {
+			log.Info().
+				Str("item_id", itemID.String()).
+				Str("trigger", string(trigger)).
+				Msg("[SYNC] Item is being synced by another process, skipping")
+
+			return result, ErrSyncInProgress
+		}
+		return result, fmt.Errorf("failed to acquire item lock: %w", err)
+	}
+	defer release()
+
+	acquiredAt := time.Now()
+
+	defer func() {
+		log.Info().
+			Str("item_id", itemID.String()).
+			Dur("held", time.Since(acquiredAt)).
+			Msg("[SYNC] Released item lock")
+	}()
+
+	run := s.startRun(ctx, itemID, trigger)
+
+	// lockCtx is cancelled if the lock is lost, stopping the sync before another process takes over
+	err = s.syncItem(lockCtx, itemID, result)
+
+	// Record the outcome even if the sync was cancelled
+	s.finishRun(context.WithoutCancel(ctx), run, result, err)
+
+	return result, err
+}
+
+// syncItem synchronizes transactions for a single Item while the item lock is held,
+// tallying the processed transactions in result
+func (s *SyncService) syncItem(ctx context.Context, itemID uuid.UUID, result *SyncResult) error {
+	// Load Item with access token
+	item, err := s.entClient.Item.
+		Query().
+		Where(entitem.ID(itemID)).
+		Where(entitem.IsActive(true)).
+		Only(ctx)
+	if err != nil {
+		// Record failure in cursor
+		_ = s.RecordSyncError(ctx, itemID, fmt.Errorf("failed to load item: %w", err))
+		return fmt.Errorf("failed to load item: %w", err)
+	}
+
+	provider, err := s.provider(item)
+	if err != nil {
+		_ = s.RecordSyncError(ctx, itemID, err)
+		return err
+	}
+
+	// Load or create sync cursor
+	cursor, err := s.getOrCreateCursor(ctx, itemID)
+	if err != nil {
+		_ = s.RecordSyncError(ctx, itemID, fmt.Errorf("failed to get sync cursor: %w", err))
+		return fmt.Errorf("failed to get sync cursor: %w", err)
+	}
+
+	// Items linked before institutions were recorded pick them up on their next sync
+	if item.InstitutionID == nil {
+		if err := s.backfillInstitution(ctx, provider, item); err != nil {
+			// Log but don't fail the whole sync if the lookup fails
+			log.Error().
+				Err(err).
+				Str("item_id", itemID.String()).
+				Msg("Failed to backfill item institution")
+		}
+	}
+
+	// Reconcile accounts and their balances first so transactions of newly discovered accounts can be stored
+	if err := s.reconcileAccounts(ctx, provider, item); err != nil {
+		// Log but don't fail the whole sync if reconciliation fails
+		log.Error().
+			Err(err).
+			Str("item_id", itemID.String()).
+			Msg("Failed to reconcile accounts")
+	}
+
+	currentCursor := cursor.Cursor
+	hasMore := true
+
+	// Keep syncing while there are more transactions
+	for hasMore {
+		// Ask the provider for the transactions changed since the cursor
+		page, err := provider.SyncTransactions(ctx, item.AccessToken, currentCursor)
+		if err != nil {
+			_ = s.RecordSyncError(ctx, itemID, fmt.Errorf("failed to sync transactions: %w", err))
+
+			// Surface item problems such as ITEM_LOGIN_REQUIRED on the item itself
+			if status, ok := provider.ClassifyError(err); ok {
+				if statusErr := s.SetItemStatus(ctx, item, status, err.Error()); statusErr != nil {
+					log.Error().
+						Err(statusErr).
+						Str("item_id", itemID.String()).
+						Msg("Failed to update item status")
+				}
+			}
+
+			return fmt.Errorf("failed to sync transactions: %w", err)
+		}
+
+		// Process added transactions
+		if err := s.processAddedTransactions(ctx, entaccount.ItemID(item.ID), page.Added); err != nil {
+			_ = s.RecordSyncError(ctx, itemID, fmt.Errorf("failed to process added transactions: %w", err))
+			return fmt.Errorf("failed to process added transactions: %w", err)
+		}
+		result.Added += len(page.Added)
+
+		// Process modified transactions
+		if err := s.processModifiedTransactions(ctx, entaccount.ItemID(item.ID), page.Modified); err != nil {
+			_ = s.RecordSyncError(ctx, itemID, fmt.Errorf("failed to process modified transactions: %w", err))
+			return fmt.Errorf("failed to process modified transactions: %w", err)
+		}
+		result.Modified += len(page.Modified)
+
+		// Process removed transactions
+		if err := s.processRemovedTransactions(ctx, entaccount.ItemID(item.ID), page.Removed); err != nil {
+			_ = s.RecordSyncError(ctx, itemID, fmt.Errorf("failed to process removed transactions: %w", err))
+			return fmt.Errorf("failed to process removed transactions: %w", err)
+		}
+		result.Removed += len(page.Removed)
+
+		// Update cursor
+		currentCursor = page.Cursor
+		hasMore = page.HasMore
+		result.UpdateStatus = page.UpdateStatus
+
+		log.Info().
+			Str("item_id", itemID.String()).
+			Int("added", len(page.Added)).
+			Int("modified", len(page.Modified)).
+			Int("removed", len(page.Removed)).
+			Bool("has_more", hasMore).
+			Msg("Transaction sync batch complete")
+	}
+
+	// Re-detect recurring charges once new transactions have arrived
+	if result.Added > 0 {
+		if err := s.recurringDetector.DetectForUser(ctx, item.UserID); err != nil {
+			// Log but don't fail the whole sync if detection fails
+			log.Error().
+				Err(err).
+				Str("item_id", itemID.String()).
+				Msg("[RECURRING] Failed to detect recurring charges")
+		}
+	}
+
+	// Save the final cursor and mark success
+	if err := s.recordSyncSuccess(ctx, itemID, currentCursor); err != nil {
+		return fmt.Errorf("failed to update cursor: %w", err)
+	}
+
+	return nil
+}
+
+// SyncAllUserItems synchronizes all active items for a user on their request
+func (s *SyncService) SyncAllUserItems(ctx context.Context, userID uuid.UUID) (int, error) {
+	// Load all active Items for user
+	items, err := s.entClient.Item.
+		Query().
+		Where(entitem.UserID(userID)).
+		Where(entitem.IsActive(true)).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load items: %w", err)
+	}
+
+	totalSynced := 0
+	for _, item := range items {
+		synced, err := s.SyncItemTransactions(ctx, item.ID, entsyncrun.TriggerManual)
+		if errors.Is(err, ErrSyncInProgress) {
+			continue // Another process is syncing this item
+		}
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("item_id", item.ID.String()).
+				Msg("Failed to sync item transactions")
+			continue // Continue with other items
+		}
+		totalSynced += synced
+	}
+
+	return totalSynced, nil
+}
+
+// categorizedTransaction is a provider transaction with the stored account it belongs to and its category
+type categorizedTransaction struct {
+	Transaction
+	account  *ent.Account
+	category categorizer.CategoryType
+}
+
+// apply sets the provider's fields of the transaction on a create or update of its row
+func (tx categorizedTransaction) apply(m *ent.TransactionMutation) {
+	m.SetAmount(tx.Amount)
+	m.SetCurrency(currency.Normalize(tx.Currency))
+	m.SetDate(tx.Date)
+	m.SetName(tx.Name)
+	m.SetMerchantName(tx.MerchantName)
+	m.SetCategory(string(tx.category))
+	m.SetProviderCategories(tx.Categories)
+	m.SetPending(tx.Pending)
+	m.SetPaymentChannel(tx.PaymentChannel)
+	if tx.PendingTransactionID != "" {
+		m.SetPendingTransactionID(tx.PendingTransactionID)
+	}
+}
+
+// categorize resolves the account of every transaction and categorizes them in one batch, falling back to
+// the provider categories when categorization fails. Transactions whose account isn't found are logged and left out.
+// accounts scopes the accounts the transactions may belong to, since account IDs are only unique per item
+func (s *SyncService) categorize(ctx context.Context, accounts predicate.Account, transactions []Transaction) []categorizedTransaction {
+	// Step 1: Build categorization requests for batch processing
+	requests := make([]*categorizer.CategorizationRequest, len(transactions))
+	for i, tx := range transactions {
+		requests[i] = &categorizer.CategorizationRequest{
+			MerchantName:       tx.MerchantName,
+			TransactionName:    tx.Name,
+			Amount:             float64(tx.Amount),
+			ProviderCategories: tx.Categories,
+		}
+	}
+
+	// Step 2: Batch categorize all transactions concurrently
+	responses, errors := s.categorizerService.CategorizeBatch(ctx, requests)
+
+	// Step 3: Pair each transaction with its account and categorization result
+	categorized := make([]categorizedTransaction, 0, len(transactions))
+	for i, tx := range transactions {
+		// Get Account
+		account, err := s.entClient.Account.
+			Query().
+			Where(
+				accounts,
+				entaccount.ExternalID(tx.AccountID),
+			).
+			Only(ctx)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("account_id", tx.AccountID).
+				Msg("Failed to find account for transaction")
+			continue
+		}
+
+		// Get categorization result or use fallback
+		var categoryResp *categorizer.CategorizationResponse
+		if errors[i] != nil {
+			log.Error().
+				Err(errors[i]).
+				Str("transaction_id", tx.TransactionID).
+				Msg("Failed to categorize transaction with GPT, using fallback")
+			// Fallback to rule-based categorization
+			categoryResp = &categorizer.CategorizationResponse{
+				Category:   fallbackCategorize(tx.Categories),
+				Confidence: "low",
+				Reasoning:  "Fallback categorization due to GPT error",
+			}
+		} else {
+			categoryResp = responses[i]
+		}
+
+		log.Info().
+			Str("transaction_id", tx.TransactionID).
+			Str("transaction_name", tx.Name).
+			Str("merchant_name", tx.MerchantName).
+			Str("assigned_category", string(categoryResp.Category)).
+			Str("confidence", categoryResp.Confidence).
+			Strs("provider_categories", tx.Categories).
+			Int64("amount_cents", tx.Amount).
+			Bool("pending", tx.Pending).
+			Msg("[SYNC] Categorized transaction")
+
+		categorized = append(categorized, categorizedTransaction{
+			Transaction: tx,
+			account:     account,
+			category:    categoryResp.Category,
+		})
+	}
+
+	return categorized
+}
+
+// processAddedTransactions processes newly added transactions
+// accounts scopes the accounts the transactions may belong to, since account IDs are only unique per item
+func (s *SyncService) processAddedTransactions(ctx context.Context, accounts predicate.Account, transactions []Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	log.Info().
+		Int("transaction_count", len(transactions)).
+		Msg("[SYNC] Processing added transactions")
+
+	for _, tx := range s.categorize(ctx, accounts, transactions) {
+		// Link the pending row this transaction posted from so it isn't counted twice
+		if err := s.mergePendingTransaction(ctx, tx.account, tx.Transaction); err != nil {
+			log.Error().
+				Err(err).
+				Str("transaction_id", tx.TransactionID).
+				Str("pending_transaction_id", tx.PendingTransactionID).
+				Msg("[SYNC] Failed to merge pending transaction")
+		}
+
+		// Upsert transaction
+		create := s.entClient.Transaction.
+			Create().
+			SetID(uuid.New()).
+			SetAccountID(tx.account.ID).
+			SetExternalID(tx.TransactionID)
+		tx.apply(create.Mutation())
+
+		err := create.
+			OnConflictColumns(enttransaction.FieldAccountID, enttransaction.FieldExternalID).
+			UpdateNewValues().
+			Exec(ctx)
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("transaction_id", tx.TransactionID).
+				Msg("Failed to save transaction")
+			continue
+		}
+
+		// Process rules for non-pending transactions
+		if tx.Pending {
+			log.Debug().
+				Str("transaction_id", tx.TransactionID).
+				Msg("[SYNC] Transaction is pending, skipping rule processing")
+			continue
+		}
+
+		s.processSettledTransaction(ctx, tx.account.ID, tx.TransactionID)
+	}
+
+	return nil
+}
+
+// processModifiedTransactions processes modified transactions
+func (s *SyncService) processModifiedTransactions(ctx context.Context, accounts predicate.Account, transactions []Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+
+	log.Info().
+		Int("transaction_count", len(transactions)).
+		Msg("[SYNC] Processing modified transactions")
+
+	for _, tx := range s.categorize(ctx, accounts, transactions) {
+		// Update existing transaction
+		update := s.entClient.Transaction.
+			Update().
+			Where(
+				enttransaction.HasAccountWith(accounts),
+				enttransaction.ExternalID(tx.TransactionID),
+			).
+			SetAccountID(tx.account.ID)
+		tx.apply(update.Mutation())
+
+		err := update.Exec(ctx)
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("transaction_id", tx.TransactionID).
+				Msg("Failed to update transaction")
+			continue
+		}
+
+		// Process rules for non-pending transactions
+		// This is critical for when transactions change from pending to non-pending
+		if tx.Pending {
+			log.Debug().
+				Str("transaction_id", tx.TransactionID).
+				Msg("[SYNC] Modified transaction is pending, skipping rule processing")
+			continue
+		}
+
+		s.processSettledTransaction(ctx, tx.account.ID, tx.TransactionID)
+	}
+
+	return nil
+}
+
+// processSettledTransaction runs a settled transaction through transfer detection, anomaly scoring,
+// rules and budget tracking. Failures are logged so a single transaction doesn't fail the sync.
+// Each step is idempotent, so transactions modified after they settled can run through it again.
+// Rules skip transactions dated before their item was linked, see predatesLink.
+func (s *SyncService) processSettledTransaction(ctx context.Context, accountID uuid.UUID, externalID string) {
+	entTx, err := s.entClient.Transaction.
+		Query().
+		Where(
+			enttransaction.AccountID(accountID),
+			enttransaction.ExternalID(externalID),
+		).
+		WithAccount(func(q *ent.AccountQuery) {
+			q.WithItem()
+		}).
+		Only(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("transaction_id", externalID).
+			Msg("[SYNC] Failed to fetch transaction for rule processing")
+		return
+	}
+
+	log.Info().
+		Str("transaction_id", externalID).
+		Str("ent_transaction_id", entTx.ID.String()).
+		Str("transaction_name", entTx.Name).
+		Int64("amount", entTx.Amount).
+		Str("category", entTx.Category).
+		Msg("[SYNC] Transaction is non-pending, triggering rule processing")
+
+	// Internal transfers are neither income nor spend, so rules don't apply
+	internalTransfer, err := s.transferDetector.Detect(ctx, entTx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("transaction_id", externalID).
+			Msg("[SYNC] Failed to detect internal transfer")
+	} else if internalTransfer {
+		log.Info().
+			Str("transaction_id", externalID).
+			Msg("[SYNC] Transaction is an internal transfer, skipping rule processing")
+		return
+	}
+
+	// Score charges against the user's history before rules move money; already scored ones are skipped
+	if _, err := s.anomalyScorer.ScoreTransaction(ctx, entTx); err != nil {
+		log.Error().
+			Err(err).
+			Str("transaction_id", externalID).
+			Msg("[SYNC] Failed to score transaction anomaly")
+	}
+
+	// Evaluate and execute rules; history dated before the item was linked is past spending rules don't save on
+	if predatesLink(entTx) {
+		log.Debug().
+			Str("transaction_id", externalID).
+			Time("date", entTx.Date).
+			Msg("[SYNC] Transaction predates the item's link, skipping rule processing")
+	} else if err := s.ruleEngine.ProcessTransaction(ctx, entTx); err != nil {
+		log.Error().
+			Err(err).
+			Str("transaction_id", externalID).
+			Msg("[SYNC] Failed to process rules for transaction")
+	} else {
+		log.Info().
+			Str("transaction_id", externalID).
+			Msg("[SYNC] Successfully processed rules for transaction")
+	}
+
+	// Track budgets after rules so alerts reflect the settled transaction
+	if err := s.budgetTracker.CheckTransaction(ctx, entTx); err != nil {
+		log.Error().
+			Err(err).
+			Str("transaction_id", externalID).
+			Msg("[SYNC] Failed to check budgets for transaction")
+	}
+}
+
+// predatesLink reports whether a transaction, loaded with its account and item, is dated before the day
+// its item was linked. Such transactions arrive with the backfill of a new item, which can reach back
+// up to two years; transactions of manual accounts never predate a link.
+func predatesLink(txn *ent.Transaction) bool {
+	account := txn.Edges.Account
+	if account == nil || account.Edges.Item == nil {
+		return false
+	}
+	return txn.Date.Before(networth.Day(account.Edges.Item.CreatedAt))
+}
+
+// processRemovedTransactions processes removed transactions
+// The counterpart of a removed transfer leg is unlinked so it counts as a regular transaction again
+func (s *SyncService) processRemovedTransactions(ctx context.Context, accounts predicate.Account, transactionIDs []string) error {
+	for _, txID := range transactionIDs {
+		txn, err := s.entClient.Transaction.
+			Query().
+			Where(
+				enttransaction.HasAccountWith(accounts),
+				enttransaction.ExternalID(txID),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("transaction_id", txID).
+				Msg("Failed to find removed transaction")
+			continue
+		}
+
+		// Delete transaction
+		err = s.entClient.Transaction.
+			DeleteOne(txn).
+			Exec(ctx)
+
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("transaction_id", txID).
+				Msg("Failed to delete transaction")
+			continue
+		}
+
+		if txn.TransferPairID == nil {
+			continue
+		}
+
+		if _, err := s.transferDetector.Unpair(ctx, *txn.TransferPairID); err != nil && !ent.IsNotFound(err) {
+			log.Error().
+				Err(err).
+				Str("transaction_id", txID).
+				Str("counterpart_id", txn.TransferPairID.String()).
+				Msg("[SYNC] Failed to unlink transfer counterpart of removed transaction")
+		}
+	}
+
+	return nil
+}
+
+// getOrCreateCursor gets or creates a sync cursor for an item
+func (s *SyncService) getOrCreateCursor(ctx context.Context, itemID uuid.UUID) (*ent.SyncCursor, error) {
+	cursor, err := s.entClient.SyncCursor.
+		Query().
+		Where(entsynccursor.ItemID(itemID)).
+		Only(ctx)
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			// Create new cursor
+			return s.entClient.SyncCursor.
+				Create().
+				SetItemID(itemID).
+				SetCursor("").
+				Save(ctx)
+		}
+		return nil, err
+	}
+
+	return cursor, nil
+}
+
+// updateCursor updates the sync cursor for an item
+func (s *SyncService) updateCursor(ctx context.Context, itemID uuid.UUID, newCursor string) error {
+	return s.entClient.SyncCursor.
+		Update().
+		Where(entsynccursor.ItemID(itemID)).
+		SetCursor(newCursor).
+		Exec(ctx)
+}
+
+// recordSyncSuccess records a successful sync, resets error counters
+// and marks a parked or repaired item healthy again
+func (s *SyncService) recordSyncSuccess(ctx context.Context, itemID uuid.UUID, newCursor string) error {
+	err := s.entClient.SyncCursor.
+		Update().
+		Where(entsynccursor.ItemID(itemID)).
+		SetCursor(newCursor).
+		SetLastError("").
+		SetConsecutiveFailures(0).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	recovered, err := s.entClient.Item.
+		Update().
+		Where(
+			entitem.ID(itemID),
+			entitem.StatusNEQ(entitem.StatusHealthy),
+		).
+		SetStatus(entitem.StatusHealthy).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if recovered > 0 {
+		log.Info().
+			Str("item_id", itemID.String()).
+			Msg("[SYNC] Item recovered, resuming scheduled syncs")
+	}
+
+	return nil
+}
+
+// RecordSyncError records a sync error and increments failure counter
+func (s *SyncService) RecordSyncError(ctx context.Context, itemID uuid.UUID, err error) error {
+	// Get current cursor to increment consecutive failures
+	cursor, queryErr := s.entClient.SyncCursor.
+		Query().
+		Where(entsynccursor.ItemID(itemID)).
+		Only(ctx)
+
+	if queryErr != nil {
+		// If we can't query the cursor, just log and return
+		log.Error().
+			Err(queryErr).
+			Str("item_id", itemID.String()).
+			Msg("Failed to query cursor for error recording")
+		return queryErr
+	}
+
+	// Increment consecutive failures
+	newFailures := cursor.ConsecutiveFailures + 1
+
+	return s.entClient.SyncCursor.
+		Update().
+		Where(entsynccursor.ItemID(itemID)).
+		SetLastError(err.Error()).
+		SetConsecutiveFailures(newFailures).
+		Exec(ctx)
+}
+
+// fallbackCategorize provides rule-based categorization as a fallback when GPT fails
+func fallbackCategorize(providerCategories []string) categorizer.CategoryType {
+	if len(providerCategories) == 0 {
+		return categorizer.CategoryMisc
+	}
+
+	// Get the primary category (first element)
+	primary := strings.ToLower(providerCategories[0])
+
+	// Check subcategories for more specific matching
+	var secondary string
+	if len(providerCategories) > 1 {
+		secondary = strings.ToLower(providerCategories[1])
+	}
+
+	// Map Plaid-style categories to app categories
+	switch {
+	// Transfer - ignore for rules (internal transfers)
+	case strings.Contains(primary, "transfer"):
+		return categorizer.CategoryTransfer
+	case strings.Contains(primary, "payment"):
+		return categorizer.CategoryTransfer
+
+	// Dining
+	case strings.Contains(primary, "food and drink"):
+		// Check if it's groceries
+		if strings.Contains(secondary, "groceries") || strings.Contains(secondary, "supermarket") {
+			return categorizer.CategoryGroceries
+		}
+		return categorizer.CategoryDining
+	case strings.Contains(primary, "restaurants"):
+		return categorizer.CategoryDining
+
+	// Groceries
+	case strings.Contains(primary, "groceries"):
+		return categorizer.CategoryGroceries
+	case strings.Contains(secondary, "supermarket"):
+		return categorizer.CategoryGroceries
+
+	// Transport
+	case strings.Contains(primary, "transportation"):
+		return categorizer.CategoryTransport
+	case strings.Contains(primary, "travel"):
+		return categorizer.CategoryTransport
+	case strings.Contains(secondary, "gas"):
+		return categorizer.CategoryTransport
+	case strings.Contains(secondary, "parking"):
+		return categorizer.CategoryTransport
+	case strings.Contains(secondary, "public transit"):
+		return categorizer.CategoryTransport
+	case strings.Contains(secondary, "ride share"):
+		return categorizer.CategoryTransport
+
+	// Shopping
+	case strings.Contains(primary, "shops"):
+		return categorizer.CategoryShopping
+	case strings.Contains(primary, "retail"):
+		return categorizer.CategoryShopping
+	case strings.Contains(secondary, "clothing"):
+		return categorizer.CategoryShopping
+	case strings.Contains(secondary, "electronics"):
+		return categorizer.CategoryShopping
+
+	// Subscriptions
+	case strings.Contains(secondary, "subscription"):
+		return categorizer.CategorySubscriptions
+	case strings.Contains(primary, "service"):
+		// Check if it's a recurring service
+		if strings.Contains(secondary, "streaming") ||
+			strings.Contains(secondary, "music") ||
+			strings.Contains(secondary, "software") {
+			return categorizer.CategorySubscriptions
+		}
+		return categorizer.CategoryMisc
+
+	// Entertainment
+	case strings.Contains(primary, "recreation"):
+		return categorizer.CategoryEntertainment
+	case strings.Contains(primary, "entertainment"):
+		return categorizer.CategoryEntertainment
+	case strings.Contains(secondary, "movie"):
+		return categorizer.CategoryEntertainment
+	case strings.Contains(secondary, "concert"):
+		return categorizer.CategoryEntertainment
+	case strings.Contains(secondary, "sporting"):
+		return categorizer.CategoryEntertainment
+
+	// Bills
+	case strings.Contains(primary, "bank fees"):
+		return categorizer.CategoryBills
+	case strings.Contains(primary, "interest"):
+		return categorizer.CategoryBills
+	case strings.Contains(secondary, "utilities"):
+		return categorizer.CategoryBills
+	case strings.Contains(secondary, "internet"):
+		return categorizer.CategoryBills
+	case strings.Contains(secondary, "phone"):
+		return categorizer.CategoryBills
+	case strings.Contains(secondary, "insurance"):
+		return categorizer.CategoryBills
+	case strings.Contains(secondary, "rent"):
+		return categorizer.CategoryBills
+
+	default:
+		return categorizer.CategoryMisc
+	}
+}
@@ -0,0 +1,378 @@
+package aggregator
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/rueidis/rueidislock"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/ent"
+	entitem "regulation/internal/ent/item"
+	entsynccursor "regulation/internal/ent/synccursor"
+	entsyncrun "regulation/internal/ent/syncrun"
+)
+
+const (
+	// syncQueueSize is the number of targeted syncs that can wait for the worker
+	syncQueueSize = 256
+	// leaderRetryInterval is how long to wait before campaigning again after a lock error
+	leaderRetryInterval = 10 * time.Second
+	// scheduleCheckInterval is how often the leader looks for items that are due
+	scheduleCheckInterval = 30 * time.Second
+	// circuitBreakerThreshold is the number of consecutive failures after which an item is parked
+	circuitBreakerThreshold = 5
+	// jitterFraction spreads scheduled syncs by up to this fraction of their delay
+	jitterFraction = 0.2
+)
+
+// SyncWorker handles scheduled background syncing of all active items
+// and targeted syncs enqueued by webhooks.
+// Each item is synced once per syncInterval, backing off after failures, by a bounded pool of
+// goroutines. Only the replica holding the leader lock schedules items; queued syncs run on
+// every replica and rely on the per-item lock in SyncService.
+type SyncWorker struct {
+	syncService  *SyncService
+	entClient    *ent.Client
+	locker       rueidislock.Locker
+	syncInterval time.Duration
+	minBackoff   time.Duration
+	maxBackoff   time.Duration
+
+	// slots bounds the number of concurrent item syncs
+	slots chan struct{}
+
+	queue   chan uuid.UUID
+	mu      sync.Mutex
+	pending map[uuid.UUID]struct{}
+}
+
+// NewSyncWorker creates a new sync worker syncing up to concurrency items at once
+func NewSyncWorker(syncService *SyncService, entClient *ent.Client, locker rueidislock.Locker, syncInterval time.Duration, concurrency int) *SyncWorker {
+	return &SyncWorker{
+		syncService:  syncService,
+		entClient:    entClient,
+		locker:       locker,
+		syncInterval: syncInterval,
+		minBackoff:   1 * time.Minute,
+		maxBackoff:   6 * time.Hour,
+		slots:        make(chan struct{}, concurrency),
+		queue:        make(chan uuid.UUID, syncQueueSize),
+		pending:      make(map[uuid.UUID]struct{}),
+	}
+}
+
+// Enqueue schedules a targeted sync of an item
+// Items already waiting are not queued twice; returns false when the queue is full,
+// in which case the periodic cycle picks the item up
+func (w *SyncWorker) Enqueue(itemID uuid.UUID) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.pending[itemID]; ok {
+		return true
+	}
+
+	select {
+	case w.queue <- itemID:
+		w.pending[itemID] = struct{}{}
+		return true
+	default:
+		log.Warn().
+			Str("item_id", itemID.String()).
+			Msg("Sync queue full, leaving item to the periodic sync")
+		return false
+	}
+}
+
+// Start begins the sync worker loop
+// Queued syncs are processed right away while the process campaigns for leadership;
+// the leader runs the scheduling cycle until its lock is lost or ctx is cancelled
+func (w *SyncWorker) Start(ctx context.Context) {
+	log.Info().
+		Dur("interval", w.syncInterval).
+		Msg("Sync worker started")
+
+	go w.processQueue(ctx)
+
+	for {
+		// Blocks until this process becomes leader
+		leaderCtx, release, err := w.locker.WithContext(ctx, leaderLockName)
+		if err != nil {
+			if ctx.Err() != nil {
+				log.Info().Msg("Sync worker stopping")
+				return
+			}
+
+			log.Error().
+				Err(err).
+				Dur("retry_in", leaderRetryInterval).
+				Msg("[SYNC] Failed to campaign for sync leadership")
+
+			select {
+			case <-ctx.Done():
+				log.Info().Msg("Sync worker stopping")
+				return
+			case <-time.After(leaderRetryInterval):
+				continue
+			}
+		}
+
+		leaderSince := time.Now()
+		log.Info().Msg("[SYNC] Acquired sync leadership")
+
+		w.lead(leaderCtx)
+
+		release()
+
+		if ctx.Err() != nil {
+			log.Info().Msg("Sync worker stopping")
+			return
+		}
+
+		log.Warn().
+			Dur("held", time.Since(leaderSince)).
+			Msg("[SYNC] Lost sync leadership")
+	}
+}
+
+// lead runs the scheduling cycle until ctx is cancelled
+func (w *SyncWorker) lead(ctx context.Context) {
+	ticker := time.NewTicker(scheduleCheckInterval)
+	defer ticker.Stop()
+
+	// Run initial sync immediately
+	w.runSyncCycle(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.runSyncCycle(ctx)
+		}
+	}
+}
+
+// processQueue runs targeted syncs until ctx is cancelled
+func (w *SyncWorker) processQueue(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case itemID := <-w.queue:
+			// Clear before syncing so updates arriving mid-sync queue another run
+			w.mu.Lock()
+			delete(w.pending, itemID)
+			w.mu.Unlock()
+
+			if !w.acquireSlot(ctx) {
+				return
+			}
+
+			go func() {
+				defer w.releaseSlot()
+				w.syncItem(ctx, itemID, entsyncrun.TriggerWebhook)
+			}()
+		}
+	}
+}
+
+// runSyncCycle syncs every active item whose next sync is due
+// Items without a cursor have never been synced and are always due
+func (w *SyncWorker) runSyncCycle(ctx context.Context) {
+	log.Debug().Msg("Starting sync cycle for due items")
+
+	now := time.Now()
+
+	items, err := w.entClient.Item.
+		Query().
+		Where(
+			entitem.IsActive(true),
+			// Transient problems keep retrying with backoff; items needing the user or parked by the breaker wait
+			entitem.StatusIn(entitem.StatusHealthy, entitem.StatusInstitutionDown, entitem.StatusRateLimited),
+			entitem.Or(
+				entitem.Not(entitem.HasSyncCursor()),
+				entitem.HasSyncCursorWith(entsynccursor.NextSyncAtLTE(now)),
+			),
+		).
+		All(ctx)
+
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("Failed to query due items for sync")
+		return
+	}
+
+	if len(items) == 0 {
+		log.Debug().Msg("No items due for sync")
+		return
+	}
+
+	log.Info().
+		Int("item_count", len(items)).
+		Msg("Syncing due items")
+
+	var (
+		wg           sync.WaitGroup
+		mu           sync.Mutex
+		successCount int
+		errorCount   int
+	)
+
+	for _, item := range items {
+		if !w.acquireSlot(ctx) {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer w.releaseSlot()
+
+			ok := w.syncItem(ctx, item.ID, entsyncrun.TriggerScheduled)
+
+			mu.Lock()
+			if ok {
+				successCount++
+			} else {
+				errorCount++
+			}
+			mu.Unlock()
+		}()
+	}
+
+	wg.Wait()
+
+	log.Info().
+		Int("success", successCount).
+		Int("errors", errorCount).
+		Int("total", len(items)).
+		Msg("Sync cycle completed")
+}
+
+// acquireSlot waits for a free pool slot; returns false if ctx is cancelled first
+func (w *SyncWorker) acquireSlot(ctx context.Context) bool {
+	select {
+	case w.slots <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// releaseSlot frees a pool slot
+func (w *SyncWorker) releaseSlot() {
+	<-w.slots
+}
+
+// syncItem makes a single sync attempt and schedules the item's next sync
+// Returns false if the attempt failed
+func (w *SyncWorker) syncItem(ctx context.Context, itemID uuid.UUID, trigger entsyncrun.Trigger) bool {
+	_, err := w.syncService.SyncItemTransactions(ctx, itemID, trigger)
+	if errors.Is(err, ErrSyncInProgress) {
+		// Another replica is syncing the item and will schedule it
+		return true
+	}
+
+	if scheduleErr := w.schedule(ctx, itemID, err); scheduleErr != nil {
+		log.Error().
+			Err(scheduleErr).
+			Str("item_id", itemID.String()).
+			Msg("Failed to schedule next item sync")
+	}
+
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("item_id", itemID.String()).
+			Msg("Item sync failed")
+		return false
+	}
+
+	log.Debug().
+		Str("item_id", itemID.String()).
+		Msg("Successfully synced item")
+
+	return true
+}
+
+// schedule sets the item's next sync after an attempt
+// Successful items are synced again after syncInterval; failed items back off exponentially
+// with their consecutive failures and are parked in the error state once the circuit breaker opens
+func (w *SyncWorker) schedule(ctx context.Context, itemID uuid.UUID, syncErr error) error {
+	cursor, err := w.entClient.SyncCursor.
+		Query().
+		Where(entsynccursor.ItemID(itemID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil // The item could not be loaded, nothing to schedule
+		}
+		return err
+	}
+
+	delay := jitter(w.syncInterval)
+	if syncErr != nil {
+		delay = jitter(w.calculateBackoff(cursor.ConsecutiveFailures - 1))
+	}
+
+	err = w.entClient.SyncCursor.
+		UpdateOne(cursor).
+		SetNextSyncAt(time.Now().Add(delay)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if syncErr == nil || cursor.ConsecutiveFailures < circuitBreakerThreshold {
+		return nil
+	}
+
+	updated, err := w.entClient.Item.
+		Update().
+		Where(
+			entitem.ID(itemID),
+			entitem.StatusEQ(entitem.StatusHealthy),
+		).
+		SetStatus(entitem.StatusError).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	if updated > 0 {
+		log.Warn().
+			Str("item_id", itemID.String()).
+			Int("consecutive_failures", cursor.ConsecutiveFailures).
+			Str("last_error", cursor.LastError).
+			Msg("[SYNC] Circuit breaker opened, parking item in error state")
+	}
+
+	return nil
+}
+
+// calculateBackoff calculates exponential backoff delay
+func (w *SyncWorker) calculateBackoff(attempt int) time.Duration {
+	// Exponential backoff: min * 2^attempt, capped at max
+	backoff := time.Duration(float64(w.minBackoff) * math.Pow(2, float64(max(attempt, 0))))
+
+	if backoff > w.maxBackoff {
+		backoff = w.maxBackoff
+	}
+
+	return backoff
+}
+
+// jitter randomizes a delay by up to jitterFraction in either direction
+// so items failing or linked together don't stay in lockstep
+func jitter(delay time.Duration) time.Duration {
+	spread := float64(delay) * jitterFraction
+	return delay + time.Duration((rand.Float64()*2-1)*spread)
+}
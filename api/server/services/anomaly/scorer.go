@@ -0,0 +1,315 @@
+package anomaly
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/config"
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	"regulation/internal/ent/predicate"
+	enttransaction "regulation/internal/ent/transaction"
+	"regulation/server/services/notification"
+)
+
+const (
+	// AlertThreshold is the score from which a transaction is flagged and the user alerted
+	AlertThreshold = 0.6
+
+	// historyWindow is how far back the user's spending distribution is taken from
+	historyWindow = 180 * 24 * time.Hour
+
+	// minMerchantHistory is the number of past charges needed to judge a merchant's distribution
+	minMerchantHistory = 4
+	// minCategoryHistory is the number of past charges needed to judge a category's distribution
+	minCategoryHistory = 10
+
+	// zScoreScale maps a z-score to a score; z >= 3 reaches AlertThreshold, z >= 5 scores 1
+	zScoreScale = 5.0
+
+	// largeFirstChargeCents is the amount from which a charge at a new merchant is flagged
+	largeFirstChargeCents = 250_00
+	// firstChargeScore is the score of a large charge at a new merchant
+	firstChargeScore = 0.7
+
+	// duplicateWindow is how close two identical charges must be to count as a duplicate
+	// Most institutions only report dates, so identical charges on the same day also count
+	duplicateWindow = 10 * time.Minute
+	// duplicateScore is the score of a likely duplicate charge
+	duplicateScore = 0.9
+
+	// alertWindow is how recent a charge must be to alert on; older charges arrive with backfills and
+	// imports, often before the history they are judged against, so they are only scored
+	alertWindow = 7 * 24 * time.Hour
+)
+
+// Result is the outcome of scoring a transaction
+type Result struct {
+	Score  float64
+	Reason string
+}
+
+// Anomalous reports whether the score reaches AlertThreshold
+func (r Result) Anomalous() bool {
+	return r.Score >= AlertThreshold
+}
+
+// Scorer rates how unusual a charge is compared to the user's own history
+type Scorer struct {
+	db                  *ent.Client
+	notificationService *notification.Service
+}
+
+// NewScorer creates a new anomaly scorer
+func NewScorer(db *ent.Client, cfg *config.Config) *Scorer {
+	return &Scorer{
+		db:                  db,
+		notificationService: notification.New(cfg, db),
+	}
+}
+
+// ScoreTransaction scores a settled charge once, stores the score on the transaction
+// and alerts the user when it is anomalous and dated within alertWindow. The highest scoring signal wins:
+//   - a duplicate of another charge at the same merchant and amount within duplicateWindow
+//   - an amount far above the user's distribution for the merchant, or the category if the merchant is new
+//   - a large first-time charge at a merchant
+func (s *Scorer) ScoreTransaction(ctx context.Context, txn *ent.Transaction) (*Result, error) {
+	// Only charges are scored; refunds and income are never alerted
+	if txn.Amount <= 0 || txn.Pending || txn.InternalTransfer || txn.AnomalyScore != nil {
+		return nil, nil
+	}
+
+	account, err := s.db.Account.Get(ctx, txn.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load account: %w", err)
+	}
+
+	result, err := s.score(ctx, account.UserID, txn)
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.db.Transaction.
+		UpdateOne(txn).
+		SetAnomalyScore(result.Score)
+	if result.Anomalous() {
+		update.SetAnomalyReason(result.Reason)
+	}
+	if err := update.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("failed to store anomaly score: %w", err)
+	}
+
+	if !result.Anomalous() || txn.Date.Before(time.Now().Add(-alertWindow)) {
+		return result, nil
+	}
+
+	log.Info().
+		Str("transaction_id", txn.ID.String()).
+		Str("user_id", account.UserID.String()).
+		Float64("score", result.Score).
+		Str("reason", result.Reason).
+		Msg("[ANOMALY] Flagged unusual transaction")
+
+	payload := &notification.Payload{
+		Title: fmt.Sprintf("Unusual charge: %s at %s", currency.Format(txn.Amount, txn.Currency), merchantName(txn)),
+		Body:  result.Reason,
+	}
+
+	s.notificationService.Notify(ctx, account.UserID, payload)
+
+	return result, nil
+}
+
+// score evaluates every signal and returns the strongest
+func (s *Scorer) score(ctx context.Context, userID uuid.UUID, txn *ent.Transaction) (*Result, error) {
+	result := &Result{}
+
+	duplicate, err := s.duplicate(ctx, txn)
+	if err != nil {
+		return nil, err
+	}
+	if duplicate != nil {
+		return &Result{
+			Score:  duplicateScore,
+			Reason: fmt.Sprintf("Possible duplicate of a %s charge on %s", currency.Format(duplicate.Amount, duplicate.Currency), duplicate.Date.Format("Jan 2")),
+		}, nil
+	}
+
+	merchant := enttransaction.NameEqualFold(txn.Name)
+	if txn.MerchantName != "" {
+		merchant = enttransaction.MerchantNameEqualFold(txn.MerchantName)
+	}
+
+	merchantHistory, err := s.history(ctx, userID, txn, merchant)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(merchantHistory) >= minMerchantHistory {
+		if z := zScore(txn.Amount, merchantHistory); z > 0 {
+			result.Score = math.Min(1, z/zScoreScale)
+			result.Reason = fmt.Sprintf("%.1f× your usual amount at %s", ratio(txn.Amount, merchantHistory), merchantName(txn))
+		}
+		return result, nil
+	}
+
+	categoryHistory, err := s.history(ctx, userID, txn, enttransaction.Category(txn.Category))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(categoryHistory) >= minCategoryHistory {
+		if z := zScore(txn.Amount, categoryHistory); z > 0 {
+			result.Score = math.Min(1, z/zScoreScale)
+			result.Reason = fmt.Sprintf("%.1f× your usual %s charge", ratio(txn.Amount, categoryHistory), txn.Category)
+		}
+	}
+
+	if len(merchantHistory) == 0 && txn.Amount >= largeFirstChargeCents && result.Score < firstChargeScore {
+		seen, err := s.db.Transaction.
+			Query().
+			Where(
+				enttransaction.IDNEQ(txn.ID),
+				enttransaction.HasAccountWith(entaccount.UserID(userID)),
+				merchant,
+			).
+			Exist(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query merchant history: %w", err)
+		}
+
+		if !seen {
+			result.Score = firstChargeScore
+			result.Reason = fmt.Sprintf("First charge at %s", merchantName(txn))
+		}
+	}
+
+	return result, nil
+}
+
+// duplicate returns an identical charge at the same merchant in the same account within duplicateWindow
+func (s *Scorer) duplicate(ctx context.Context, txn *ent.Transaction) (*ent.Transaction, error) {
+	candidates, err := s.db.Transaction.
+		Query().
+		Where(
+			enttransaction.IDNEQ(txn.ID),
+			enttransaction.AccountID(txn.AccountID),
+			enttransaction.Amount(txn.Amount),
+			enttransaction.Name(txn.Name),
+			enttransaction.Pending(false),
+			enttransaction.DateGTE(txn.Date.Add(-24*time.Hour)),
+			enttransaction.DateLTE(txn.Date.Add(24*time.Hour)),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query duplicate candidates: %w", err)
+	}
+
+	for _, candidate := range candidates {
+		if withinDuplicateWindow(txn.Date, candidate.Date) {
+			return candidate, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// history returns the amounts of the user's settled charges in the transaction's currency
+// matching the predicate within historyWindow
+func (s *Scorer) history(ctx context.Context, userID uuid.UUID, txn *ent.Transaction, match predicate.Transaction) ([]int64, error) {
+	var amounts []int64
+
+	err := s.db.Transaction.
+		Query().
+		Where(
+			enttransaction.IDNEQ(txn.ID),
+			enttransaction.HasAccountWith(entaccount.UserID(userID)),
+			enttransaction.Currency(txn.Currency),
+			enttransaction.Pending(false),
+			enttransaction.InternalTransfer(false),
+			enttransaction.AmountGT(0),
+			enttransaction.DateGTE(txn.Date.Add(-historyWindow)),
+			enttransaction.DateLT(txn.Date),
+			match,
+		).
+		Select(enttransaction.FieldAmount).
+		Scan(ctx, &amounts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transaction history: %w", err)
+	}
+
+	return amounts, nil
+}
+
+// withinDuplicateWindow compares two charge times; date-only times match on the same day
+func withinDuplicateWindow(a, b time.Time) bool {
+	if isDateOnly(a) || isDateOnly(b) {
+		ay, am, ad := a.Date()
+		by, bm, bd := b.Date()
+		return ay == by && am == bm && ad == bd
+	}
+
+	diff := a.Sub(b)
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff <= duplicateWindow
+}
+
+// isDateOnly reports whether a time carries no time-of-day information
+func isDateOnly(t time.Time) bool {
+	return t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0
+}
+
+// zScore returns how many standard deviations the amount is above the mean of the history
+// A history without variance uses 10% of the mean so a repeated fixed charge still has a scale
+func zScore(amount int64, history []int64) float64 {
+	mean := mean(history)
+
+	variance := 0.0
+	for _, h := range history {
+		variance += (float64(h) - mean) * (float64(h) - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(history)))
+
+	if floor := mean * 0.1; stddev < floor {
+		stddev = floor
+	}
+	if stddev == 0 {
+		return 0
+	}
+
+	return (float64(amount) - mean) / stddev
+}
+
+// ratio returns the amount relative to the mean of the history
+func ratio(amount int64, history []int64) float64 {
+	mean := mean(history)
+	if mean == 0 {
+		return 0
+	}
+	return float64(amount) / mean
+}
+
+// mean returns the average of the amounts
+func mean(amounts []int64) float64 {
+	total := 0.0
+	for _, a := range amounts {
+		total += float64(a)
+	}
+	return total / float64(len(amounts))
+}
+
+// merchantName returns the merchant name of a transaction, falling back to its description
+func merchantName(txn *ent.Transaction) string {
+	if txn.MerchantName != "" {
+		return txn.MerchantName
+	}
+	return txn.Name
+}
@@ -0,0 +1,387 @@
+// Package backfill tracks the historical sync of newly linked items as jobs, so onboarding can show
+// progress and knows when data is ready, and suggests starter rules once the history is in.
+package backfill
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/rueidis/rueidislock"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/config"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entbackfilljob "regulation/internal/ent/backfilljob"
+	entitem "regulation/internal/ent/item"
+	"regulation/internal/ent/schema/schematype"
+	entsyncrun "regulation/internal/ent/syncrun"
+	enttransaction "regulation/internal/ent/transaction"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/notification"
+	"regulation/server/services/recommendation"
+)
+
+const (
+	// DefaultDays is the history requested when the user doesn't pick a depth, Plaid's default
+	DefaultDays = 90
+	// MinDays covers the period starter rules are suggested from
+	MinDays = recommendation.AnalysisPeriodDays
+	// MaxDays is the most history Plaid can pull
+	MaxDays = 730
+
+	// queueSize is the number of jobs that can wait for the worker
+	queueSize = 256
+	// minPollInterval and maxPollInterval bound the wait between syncs while history is being pulled
+	minPollInterval = 5 * time.Second
+	maxPollInterval = time.Minute
+	// jobTimeout is how long a job waits for the aggregator to pull all history
+	jobTimeout = 30 * time.Minute
+	// maxSyncFailures is the number of failed syncs after which a job fails
+	maxSyncFailures = 5
+	// resumeInterval is how often jobs abandoned by a stopped process are looked for
+	resumeInterval = time.Minute
+	// staleAfter is how long an unfinished job goes untouched before it counts as abandoned
+	staleAfter = 2 * time.Minute
+	// jobLockPrefix prefixes the lock held while a job runs
+	jobLockPrefix = "backfill:job:"
+)
+
+// Worker runs backfill jobs in the background
+// A job syncs its item until the aggregator reports all requested history pulled, then suggests starter rules.
+// Jobs run on the replica that queued them; jobs left behind by a stopped replica are resumed by any replica.
+// Synced history is stored, categorized and scored like any sync, but rules only run on transactions dated
+// from the day the item was linked, so a deep backfill doesn't create savings for past spending
+type Worker struct {
+	db                    *ent.Client
+	syncService           *aggregator.SyncService
+	recommendationService *recommendation.Service
+	notificationService   *notification.Service
+	locker                rueidislock.Locker
+
+	queue chan uuid.UUID
+}
+
+// NewWorker creates a new backfill worker
+func NewWorker(db *ent.Client, cfg *config.Config, syncService *aggregator.SyncService, recommendationService *recommendation.Service, locker rueidislock.Locker) *Worker {
+	return &Worker{
+		db:                    db,
+		syncService:           syncService,
+		recommendationService: recommendationService,
+		notificationService:   notification.New(cfg, db),
+		locker:                locker,
+		queue:                 make(chan uuid.UUID, queueSize),
+	}
+}
+
+// Enqueue queues a job created with its item; when the queue is full the job is left for the resume cycle
+func (w *Worker) Enqueue(jobID uuid.UUID) {
+	select {
+	case w.queue <- jobID:
+	default:
+		log.Warn().
+			Str("job_id", jobID.String()).
+			Msg("[BACKFILL] Queue full, leaving job to the resume cycle")
+	}
+}
+
+// Start runs queued jobs and resumes abandoned ones until ctx is cancelled
+func (w *Worker) Start(ctx context.Context) {
+	log.Info().Msg("Backfill worker started")
+
+	ticker := time.NewTicker(resumeInterval)
+	defer ticker.Stop()
+
+	w.resumeAbandoned(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Backfill worker stopping")
+			return
+		case jobID := <-w.queue:
+			go w.run(ctx, jobID)
+		case <-ticker.C:
+			w.resumeAbandoned(ctx)
+		}
+	}
+}
+
+// resumeAbandoned queues unfinished jobs that haven't been touched for staleAfter
+// Jobs still running elsewhere are skipped once run finds their lock held
+func (w *Worker) resumeAbandoned(ctx context.Context) {
+	jobIDs, err := w.db.BackfillJob.
+		Query().
+		Where(
+			entbackfilljob.StatusIn(entbackfilljob.StatusQueued, entbackfilljob.StatusSyncing, entbackfilljob.StatusAnalyzing),
+			entbackfilljob.UpdatedAtLT(time.Now().Add(-staleAfter)),
+		).
+		IDs(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("[BACKFILL] Failed to query abandoned jobs")
+		return
+	}
+
+	for _, jobID := range jobIDs {
+		w.Enqueue(jobID)
+	}
+}
+
+// run runs a job to completion while holding its lock
+func (w *Worker) run(ctx context.Context, jobID uuid.UUID) {
+	lockCtx, release, err := w.locker.TryWithContext(ctx, jobLockPrefix+jobID.String())
+	if err != nil {
+		if !errors.Is(err, rueidislock.ErrNotLocked) {
+			log.Error().
+				Err(err).
+				Str("job_id", jobID.String()).
+				Msg("[BACKFILL] Failed to acquire job lock")
+		}
+		return
+	}
+	defer release()
+
+	job, err := w.db.BackfillJob.
+		Query().
+		Where(entbackfilljob.ID(jobID)).
+		WithItem().
+		Only(lockCtx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("job_id", jobID.String()).
+			Msg("[BACKFILL] Failed to load job")
+		return
+	}
+
+	// Another replica may have finished the job since it was queued
+	if job.Status == entbackfilljob.StatusCompleted || job.Status == entbackfilljob.StatusFailed {
+		return
+	}
+
+	if job.Status != entbackfilljob.StatusAnalyzing {
+		if job, err = w.syncHistory(lockCtx, job); err != nil {
+			w.fail(context.WithoutCancel(ctx), job, err)
+			return
+		}
+	}
+
+	if err := w.analyze(lockCtx, job); err != nil {
+		w.fail(context.WithoutCancel(ctx), job, err)
+	}
+}
+
+// syncHistory syncs the job's item until all requested history is in, or until jobTimeout if at least the
+// last 30 days are; scheduled syncs pull the rest later
+func (w *Worker) syncHistory(ctx context.Context, job *ent.BackfillJob) (*ent.BackfillJob, error) {
+	deadline := job.StartedAt.Add(jobTimeout)
+	pollInterval := minPollInterval
+	failures := 0
+
+	log.Info().
+		Str("job_id", job.ID.String()).
+		Str("item_id", job.ItemID.String()).
+		Int("days_requested", job.DaysRequested).
+		Msg("[BACKFILL] Syncing item history")
+
+	for {
+		result, syncErr := w.syncService.SyncItemWithResult(ctx, job.ItemID, entsyncrun.TriggerLink)
+
+		update := job.Update().
+			SetStatus(entbackfilljob.StatusSyncing).
+			AddTransactionsSynced(result.Added)
+
+		switch {
+		case errors.Is(syncErr, aggregator.ErrSyncInProgress):
+			// A scheduled or webhook sync is pulling the same history; check again after it
+		case syncErr != nil:
+			failures++
+
+			log.Warn().
+				Err(syncErr).
+				Str("job_id", job.ID.String()).
+				Int("failures", failures).
+				Msg("[BACKFILL] Item sync failed")
+
+			// Problems only the user can fix, such as a changed password, won't go away by retrying
+			item, err := w.db.Item.Get(ctx, job.ItemID)
+			if err != nil {
+				return job, fmt.Errorf("failed to load item: %w", err)
+			}
+			if !item.IsActive || item.Status == entitem.StatusLoginRequired || item.Status == entitem.StatusError || failures >= maxSyncFailures {
+				return job, syncErr
+			}
+		default:
+			failures = 0
+			update.AddSyncCount(1)
+
+			// Providers that don't report an update status return everything available on every sync
+			status := result.UpdateStatus
+			if status == "" {
+				status = aggregator.UpdateStatusHistoricalComplete
+			}
+			update.SetUpdateStatus(entbackfilljob.UpdateStatus(status))
+		}
+
+		if oldest, err := w.oldestTransactionDate(ctx, job.ItemID); err != nil {
+			log.Error().
+				Err(err).
+				Str("job_id", job.ID.String()).
+				Msg("[BACKFILL] Failed to find oldest transaction")
+		} else if oldest != nil {
+			update.SetOldestTransactionDate(*oldest)
+		}
+
+		saved, err := update.Save(ctx)
+		if err != nil {
+			return job, fmt.Errorf("failed to update backfill job: %w", err)
+		}
+		job = saved
+
+		if job.UpdateStatus == entbackfilljob.UpdateStatusHistoricalUpdateComplete {
+			return job, nil
+		}
+
+		if time.Now().After(deadline) {
+			if job.UpdateStatus == entbackfilljob.UpdateStatusInitialUpdateComplete {
+				log.Warn().
+					Str("job_id", job.ID.String()).
+					Msg("[BACKFILL] Timed out waiting for full history, continuing with the last 30 days")
+				return job, nil
+			}
+			return job, errors.New("timed out waiting for the aggregator to pull transaction history")
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+
+		pollInterval = min(pollInterval*2, maxPollInterval)
+	}
+}
+
+// analyze suggests starter rules from the synced history and completes the job
+// A failed analysis is recorded on the job without failing it, since the data is ready either way
+func (w *Worker) analyze(ctx context.Context, job *ent.BackfillJob) error {
+	job, err := job.Update().
+		SetStatus(entbackfilljob.StatusAnalyzing).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update backfill job: %w", err)
+	}
+
+	item, err := w.db.Item.Get(ctx, job.ItemID)
+	if err != nil {
+		return fmt.Errorf("failed to load item: %w", err)
+	}
+
+	update := job.Update().
+		SetStatus(entbackfilljob.StatusCompleted).
+		SetFinishedAt(time.Now())
+
+	result, err := w.recommendationService.Recommend(ctx, item.UserID)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("job_id", job.ID.String()).
+			Msg("[BACKFILL] Failed to suggest starter rules")
+
+		update.SetAnalysisError(err.Error())
+	} else {
+		rules := make([]schematype.StarterRule, len(result.Suggestions))
+		for i, suggestion := range result.Suggestions {
+			rules[i] = schematype.StarterRule{
+				Name:             suggestion.Name,
+				Category:         string(suggestion.Category),
+				ActionType:       string(suggestion.ActionType),
+				ActionValue:      suggestion.ActionValue,
+				MinAmountCents:   suggestion.MinAmountCents,
+				MaxAmountCents:   suggestion.MaxAmountCents,
+				EstimatedSavings: suggestion.EstimatedSavings,
+				Confidence:       suggestion.Confidence,
+				Reasoning:        suggestion.Reasoning,
+				ImpactLevel:      suggestion.ImpactLevel,
+			}
+		}
+
+		update.
+			SetStarterRules(rules).
+			SetStarterAnalysis(result.OverallAnalysis)
+	}
+
+	if job, err = update.Save(ctx); err != nil {
+		return fmt.Errorf("failed to update backfill job: %w", err)
+	}
+
+	log.Info().
+		Str("job_id", job.ID.String()).
+		Str("item_id", job.ItemID.String()).
+		Int("transactions_synced", job.TransactionsSynced).
+		Int("starter_rules", len(job.StarterRules)).
+		Msg("[BACKFILL] Job completed")
+
+	w.notify(ctx, item, job)
+
+	return nil
+}
+
+// fail records a job's failure
+func (w *Worker) fail(ctx context.Context, job *ent.BackfillJob, jobErr error) {
+	log.Error().
+		Err(jobErr).
+		Str("job_id", job.ID.String()).
+		Str("item_id", job.ItemID.String()).
+		Msg("[BACKFILL] Job failed")
+
+	err := job.Update().
+		SetStatus(entbackfilljob.StatusFailed).
+		SetError(jobErr.Error()).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("job_id", job.ID.String()).
+			Msg("[BACKFILL] Failed to record job failure")
+	}
+}
+
+// notify tells the user their transactions are in, for clients that aren't polling
+func (w *Worker) notify(ctx context.Context, item *ent.Item, job *ent.BackfillJob) {
+	body := fmt.Sprintf("%d transactions imported from %s", job.TransactionsSynced, item.InstitutionName)
+	if len(job.StarterRules) > 0 {
+		body += fmt.Sprintf(", with %d savings rules suggested", len(job.StarterRules))
+	}
+
+	payload := &notification.Payload{
+		Title: "Your accounts are ready",
+		Body:  body,
+		URL:   "/recommendations",
+	}
+
+	w.notificationService.Notify(ctx, item.UserID, payload)
+}
+
+// oldestTransactionDate returns the date of an item's oldest transaction, nil when it has none
+func (w *Worker) oldestTransactionDate(ctx context.Context, itemID uuid.UUID) (*time.Time, error) {
+	oldest, err := w.db.Transaction.
+		Query().
+		Where(enttransaction.HasAccountWith(entaccount.ItemID(itemID))).
+		Order(ent.Asc(enttransaction.FieldDate)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &oldest.Date, nil
+}
@@ -0,0 +1,211 @@
+package budget
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/config"
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entbudget "regulation/internal/ent/budget"
+	entfamilymember "regulation/internal/ent/familymember"
+	"regulation/server/services/cashflow"
+	"regulation/server/services/notification"
+)
+
+// MonthFormat is the layout of BudgetAlert.month
+const MonthFormat = "2006-01"
+
+// Tracker tracks month-to-date spend against budgets and sends threshold alerts
+type Tracker struct {
+	db                  *ent.Client
+	cashflowService     *cashflow.Service
+	notificationService *notification.Service
+}
+
+// NewTracker creates a new budget tracker
+func NewTracker(db *ent.Client, cfg *config.Config, cashflowService *cashflow.Service) *Tracker {
+	return &Tracker{
+		db:                  db,
+		cashflowService:     cashflowService,
+		notificationService: notification.New(cfg, db),
+	}
+}
+
+// Spent returns the settled spend counted against a budget in the month containing the given time,
+// in the home currency of the budget's owner
+// Family budgets count the spend of all family members
+func (t *Tracker) Spent(ctx context.Context, budget *ent.Budget, month time.Time) (int64, error) {
+	userIDs, err := t.userIDs(ctx, budget)
+	if err != nil {
+		return 0, err
+	}
+
+	currencyCode, err := t.cashflowService.HomeCurrency(ctx, budget.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	start, end := cashflow.MonthRange(month)
+	return t.cashflowService.Spend(ctx, userIDs, currencyCode, string(budget.Category), start, end)
+}
+
+// CheckTransaction evaluates the budgets matching a settled transaction's category
+// and sends an alert for every newly crossed threshold
+// Only transactions of the current month are checked so backfills don't trigger stale alerts
+func (t *Tracker) CheckTransaction(ctx context.Context, transaction *ent.Transaction) error {
+	now := time.Now()
+	if transaction.Date.Year() != now.Year() || transaction.Date.Month() != now.Month() {
+		return nil
+	}
+
+	account, err := transaction.QueryAccount().Only(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get account: %w", err)
+	}
+
+	membership, err := t.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(account.UserID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to load family membership: %w", err)
+	}
+
+	scope := entbudget.And(
+		entbudget.UserID(account.UserID),
+		entbudget.FamilyIDIsNil(),
+	)
+	if membership != nil {
+		scope = entbudget.Or(scope, entbudget.FamilyID(membership.FamilyID))
+	}
+
+	budgets, err := t.db.Budget.
+		Query().
+		Where(
+			scope,
+			entbudget.CategoryEQ(entbudget.Category(transaction.Category)),
+			entbudget.IsActive(true),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load budgets: %w", err)
+	}
+
+	for _, budget := range budgets {
+		if err := t.checkBudget(ctx, budget, now); err != nil {
+			log.Error().
+				Err(err).
+				Str("budget_id", budget.ID.String()).
+				Msg("[BUDGET] Failed to check budget thresholds")
+			continue
+		}
+	}
+
+	return nil
+}
+
+// checkBudget sends an alert for every threshold the budget crossed this month
+// that has not been alerted yet
+func (t *Tracker) checkBudget(ctx context.Context, budget *ent.Budget, now time.Time) error {
+	spent, err := t.Spent(ctx, budget, now)
+	if err != nil {
+		return err
+	}
+
+	month := now.Format(MonthFormat)
+
+	thresholds := append([]int(nil), budget.AlertThresholds...)
+	sort.Ints(thresholds)
+
+	var crossed []int
+	for _, threshold := range thresholds {
+		if spent*100 >= budget.AmountCents*int64(threshold) {
+			crossed = append(crossed, threshold)
+		}
+	}
+
+	// Record every crossed threshold, but only announce the highest new one
+	notify := 0
+	for _, threshold := range crossed {
+		err := t.db.BudgetAlert.
+			Create().
+			SetBudgetID(budget.ID).
+			SetMonth(month).
+			SetThreshold(threshold).
+			SetSpentCents(spent).
+			Exec(ctx)
+		if err != nil {
+			if ent.IsConstraintError(err) {
+				continue // Already alerted this month
+			}
+			return fmt.Errorf("failed to record budget alert: %w", err)
+		}
+		notify = threshold
+	}
+
+	if notify == 0 {
+		return nil
+	}
+
+	log.Info().
+		Str("budget_id", budget.ID.String()).
+		Str("category", string(budget.Category)).
+		Int64("spent_cents", spent).
+		Int64("budget_cents", budget.AmountCents).
+		Int("threshold", notify).
+		Msg("[BUDGET] Budget threshold crossed")
+
+	userIDs, err := t.userIDs(ctx, budget)
+	if err != nil {
+		return err
+	}
+
+	title := fmt.Sprintf("%s budget %d%% used", budget.Category, notify)
+	if notify >= 100 {
+		title = fmt.Sprintf("%s budget exceeded", budget.Category)
+	}
+
+	currencyCode, err := t.cashflowService.HomeCurrency(ctx, budget.UserID)
+	if err != nil {
+		return err
+	}
+
+	payload := &notification.Payload{
+		Title: title,
+		Body:  fmt.Sprintf("%s of %s spent", currency.Format(spent, currencyCode), currency.Format(budget.AmountCents, currencyCode)),
+	}
+
+	for _, userID := range userIDs {
+		t.notificationService.Notify(ctx, userID, payload)
+	}
+
+	return nil
+}
+
+// userIDs returns the users whose spend counts against a budget
+func (t *Tracker) userIDs(ctx context.Context, budget *ent.Budget) ([]uuid.UUID, error) {
+	if budget.FamilyID == nil {
+		return []uuid.UUID{budget.UserID}, nil
+	}
+
+	members, err := t.db.FamilyMember.
+		Query().
+		Where(entfamilymember.FamilyID(*budget.FamilyID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load family members: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+
+	return userIDs, nil
+}
@@ -0,0 +1,411 @@
+package cashflow
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entfamilymember "regulation/internal/ent/familymember"
+	entgoal "regulation/internal/ent/goal"
+	entruleexecution "regulation/internal/ent/ruleexecution"
+	entsavingstransfer "regulation/internal/ent/savingstransfer"
+	enttransaction "regulation/internal/ent/transaction"
+	entuser "regulation/internal/ent/user"
+)
+
+// Service aggregates income, spend and auto-save totals in the database
+// Amounts are summed per currency and converted to the requested reporting currency,
+// so totals never mix currencies silently
+type Service struct {
+	db    *ent.Client
+	rates currency.RateProvider
+}
+
+// NewService creates a new cashflow service
+func NewService(db *ent.Client, rates currency.RateProvider) *Service {
+	return &Service{db: db, rates: rates}
+}
+
+// Totals holds settled income and spend in cents for a single user
+type Totals struct {
+	UserID uuid.UUID `json:"user_id"`
+	Income int64     `json:"income"`
+	Spend  int64     `json:"spend"`
+}
+
+// CategoryTotal holds settled spend in cents for a single category
+type CategoryTotal struct {
+	Category string `json:"category"`
+	Spend    int64  `json:"spend"`
+}
+
+// MonthRange returns the first and last instant of the month containing t
+func MonthRange(t time.Time) (time.Time, time.Time) {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	end := start.AddDate(0, 1, 0).Add(-time.Second)
+	return start, end
+}
+
+// TotalsByUser sums settled income and spend per user within [start, end] in the given currency
+// Users without transactions are omitted from the result
+func (s *Service) TotalsByUser(ctx context.Context, userIDs []uuid.UUID, currencyCode string, start, end time.Time) (map[uuid.UUID]Totals, error) {
+	var rows []struct {
+		UserID   uuid.UUID `json:"user_id"`
+		Currency string    `json:"currency"`
+		Income   int64     `json:"income"`
+		Spend    int64     `json:"spend"`
+	}
+
+	err := s.db.Transaction.
+		Query().
+		Where(
+			enttransaction.HasAccountWith(entaccount.UserIDIn(userIDs...)),
+			enttransaction.Pending(false),
+			enttransaction.InternalTransfer(false),
+			enttransaction.DateGTE(start),
+			enttransaction.DateLTE(end),
+		).
+		Modify(func(sel *sql.Selector) {
+			accounts := sql.Table(entaccount.Table)
+			amount := sel.C(enttransaction.FieldAmount)
+
+			sel.Join(accounts).
+				On(sel.C(enttransaction.FieldAccountID), accounts.C(entaccount.FieldID))
+			sel.Select(
+				sql.As(accounts.C(entaccount.FieldUserID), "user_id"),
+				sql.As(sel.C(enttransaction.FieldCurrency), "currency"),
+				// Negative amount = credit (income), positive amount = debit (spend)
+				sql.As(fmt.Sprintf("COALESCE(SUM(CASE WHEN %[1]s < 0 THEN -%[1]s ELSE 0 END), 0)", amount), "income"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(CASE WHEN %[1]s > 0 THEN %[1]s ELSE 0 END), 0)", amount), "spend"),
+			).GroupBy(accounts.C(entaccount.FieldUserID), sel.C(enttransaction.FieldCurrency))
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate cashflow: %w", err)
+	}
+
+	totals := make(map[uuid.UUID]Totals, len(rows))
+	for _, row := range rows {
+		income, err := s.Convert(ctx, row.Income, row.Currency, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+		spend, err := s.Convert(ctx, row.Spend, row.Currency, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+
+		total := totals[row.UserID]
+		total.UserID = row.UserID
+		total.Income += income
+		total.Spend += spend
+		totals[row.UserID] = total
+	}
+
+	return totals, nil
+}
+
+// SpendByCategory sums settled spend per category within [start, end] in the given currency,
+// ordered from the highest to the lowest spend
+func (s *Service) SpendByCategory(ctx context.Context, userIDs []uuid.UUID, currencyCode string, start, end time.Time) ([]CategoryTotal, error) {
+	var rows []struct {
+		Category string `json:"category"`
+		Currency string `json:"currency"`
+		Spend    int64  `json:"spend"`
+	}
+
+	err := s.db.Transaction.
+		Query().
+		Where(
+			enttransaction.HasAccountWith(entaccount.UserIDIn(userIDs...)),
+			enttransaction.Pending(false),
+			enttransaction.InternalTransfer(false),
+			enttransaction.AmountGT(0),
+			enttransaction.DateGTE(start),
+			enttransaction.DateLTE(end),
+		).
+		GroupBy(enttransaction.FieldCategory, enttransaction.FieldCurrency).
+		Aggregate(func(sel *sql.Selector) string {
+			return sql.As(fmt.Sprintf("SUM(%s)", sel.C(enttransaction.FieldAmount)), "spend")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate category spend: %w", err)
+	}
+
+	var totals []CategoryTotal
+	index := make(map[string]int, len(rows))
+	for _, row := range rows {
+		spend, err := s.Convert(ctx, row.Spend, row.Currency, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+
+		i, ok := index[row.Category]
+		if !ok {
+			i = len(totals)
+			index[row.Category] = i
+			totals = append(totals, CategoryTotal{Category: row.Category})
+		}
+		totals[i].Spend += spend
+	}
+
+	sort.Slice(totals, func(i, j int) bool {
+		return totals[i].Spend > totals[j].Spend
+	})
+
+	return totals, nil
+}
+
+// Spend sums settled spend of the given users within [start, end] in the given currency,
+// limited to a category unless category is empty
+func (s *Service) Spend(ctx context.Context, userIDs []uuid.UUID, currencyCode, category string, start, end time.Time) (int64, error) {
+	var totals []currencyTotal
+
+	query := s.db.Transaction.
+		Query().
+		Where(
+			enttransaction.HasAccountWith(entaccount.UserIDIn(userIDs...)),
+			enttransaction.Pending(false),
+			enttransaction.InternalTransfer(false),
+			enttransaction.AmountGT(0),
+			enttransaction.DateGTE(start),
+			enttransaction.DateLTE(end),
+		)
+	if category != "" {
+		query = query.Where(enttransaction.Category(category))
+	}
+
+	err := query.
+		GroupBy(enttransaction.FieldCurrency).
+		Aggregate(func(sel *sql.Selector) string {
+			return sql.As(fmt.Sprintf("SUM(%s)", sel.C(enttransaction.FieldAmount)), "total")
+		}).
+		Scan(ctx, &totals)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum spend: %w", err)
+	}
+
+	return s.sum(ctx, totals, currencyCode)
+}
+
+// AutoSavedByUser sums savings transfers created by rules per user within [start, end] in the given currency
+// Cancelled and failed transfers are not counted
+func (s *Service) AutoSavedByUser(ctx context.Context, userIDs []uuid.UUID, currencyCode string, start, end time.Time) (map[uuid.UUID]int64, error) {
+	var rows []struct {
+		UserID   uuid.UUID `json:"user_id"`
+		Currency string    `json:"currency"`
+		Total    int64     `json:"total"`
+	}
+
+	err := s.db.SavingsTransfer.
+		Query().
+		Where(
+			entsavingstransfer.UserIDIn(userIDs...),
+			entsavingstransfer.StatusNotIn(entsavingstransfer.StatusCancelled, entsavingstransfer.StatusFailed),
+			entsavingstransfer.CreatedAtGTE(start),
+			entsavingstransfer.CreatedAtLTE(end),
+		).
+		GroupBy(entsavingstransfer.FieldUserID, entsavingstransfer.FieldCurrency).
+		Aggregate(func(sel *sql.Selector) string {
+			return sql.As(fmt.Sprintf("SUM(%s)", sel.C(entsavingstransfer.FieldAmountCents)), "total")
+		}).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate auto-save: %w", err)
+	}
+
+	totals := make(map[uuid.UUID]int64, len(rows))
+	for _, row := range rows {
+		total, err := s.Convert(ctx, row.Total, row.Currency, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+		totals[row.UserID] += total
+	}
+
+	return totals, nil
+}
+
+// GoalProgress returns the amount in cents counted towards a goal at the given time,
+// in the home currency of the goal's owner
+//   - savings goals count auto-saved transfers since the goal was created
+//     (family goals only count transfers made by family rules)
+//   - spending_limit goals count month-to-date spend, optionally limited to a category
+func (s *Service) GoalProgress(ctx context.Context, goal *ent.Goal, now time.Time) (int64, error) {
+	currencyCode, err := s.HomeCurrency(ctx, goal.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	userIDs := []uuid.UUID{goal.UserID}
+	if goal.FamilyID != nil {
+		members, err := s.db.FamilyMember.
+			Query().
+			Where(entfamilymember.FamilyID(*goal.FamilyID)).
+			All(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to load family members: %w", err)
+		}
+
+		userIDs = make([]uuid.UUID, len(members))
+		for i, member := range members {
+			userIDs[i] = member.UserID
+		}
+	}
+
+	var totals []currencyTotal
+
+	switch goal.Kind {
+	case entgoal.KindSavings:
+		query := s.db.SavingsTransfer.
+			Query().
+			Where(
+				entsavingstransfer.UserIDIn(userIDs...),
+				entsavingstransfer.StatusNotIn(entsavingstransfer.StatusCancelled, entsavingstransfer.StatusFailed),
+				entsavingstransfer.CreatedAtGTE(goal.CreatedAt),
+				entsavingstransfer.CreatedAtLTE(now),
+			)
+		if goal.FamilyID != nil {
+			query = query.Where(entsavingstransfer.HasRuleExecutionWith(entruleexecution.FamilyID(*goal.FamilyID)))
+		}
+
+		err := query.
+			GroupBy(entsavingstransfer.FieldCurrency).
+			Aggregate(func(sel *sql.Selector) string {
+				return sql.As(fmt.Sprintf("SUM(%s)", sel.C(entsavingstransfer.FieldAmountCents)), "total")
+			}).
+			Scan(ctx, &totals)
+		if err != nil {
+			return 0, fmt.Errorf("failed to sum goal savings: %w", err)
+		}
+	case entgoal.KindSpendingLimit:
+		start, _ := MonthRange(now)
+
+		var category string
+		if goal.Category != nil {
+			category = *goal.Category
+		}
+
+		return s.Spend(ctx, userIDs, currencyCode, category, start, now)
+	default:
+		return 0, fmt.Errorf("unsupported goal kind: %s", goal.Kind)
+	}
+
+	return s.sum(ctx, totals, currencyCode)
+}
+
+// Summary holds combined settled income, spend and auto-save in cents of Currency for a period
+type Summary struct {
+	Currency  string
+	Income    int64
+	Spend     int64
+	AutoSaved int64
+}
+
+// Net returns income minus spend
+func (s Summary) Net() int64 {
+	return s.Income - s.Spend
+}
+
+// Summarize combines income, spend and auto-save of the given users within [start, end] in the given currency
+func (s *Service) Summarize(ctx context.Context, userIDs []uuid.UUID, currencyCode string, start, end time.Time) (Summary, error) {
+	summary := Summary{Currency: currencyCode}
+
+	totals, err := s.TotalsByUser(ctx, userIDs, currencyCode, start, end)
+	if err != nil {
+		return summary, err
+	}
+
+	autoSaved, err := s.AutoSavedByUser(ctx, userIDs, currencyCode, start, end)
+	if err != nil {
+		return summary, err
+	}
+
+	for _, total := range totals {
+		summary.Income += total.Income
+		summary.Spend += total.Spend
+	}
+	for _, total := range autoSaved {
+		summary.AutoSaved += total
+	}
+
+	return summary, nil
+}
+
+// PreviousPeriod returns the period immediately preceding [start, end]
+// Whole calendar months map to the previous calendar month, other ranges are
+// shifted back by their own length
+func PreviousPeriod(start, end time.Time) (time.Time, time.Time) {
+	if monthStart, monthEnd := MonthRange(start); monthStart.Equal(start) && monthEnd.Equal(end) {
+		return MonthRange(start.AddDate(0, -1, 0))
+	}
+
+	length := end.Sub(start)
+	previousEnd := start.Add(-time.Second)
+	return previousEnd.Add(-length), previousEnd
+}
+
+// PercentChange returns the relative change from previous to current in percent,
+// or nil when there is no previous value to compare against
+func PercentChange(previous, current int64) *float64 {
+	if previous == 0 {
+		return nil
+	}
+
+	change := float64(current-previous) / math.Abs(float64(previous)) * 100
+	return &change
+}
+
+// HomeCurrency returns the currency a user's totals are reported in
+func (s *Service) HomeCurrency(ctx context.Context, userID uuid.UUID) (string, error) {
+	homeCurrency, err := s.db.User.
+		Query().
+		Where(entuser.ID(userID)).
+		Select(entuser.FieldHomeCurrency).
+		String(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to load home currency: %w", err)
+	}
+
+	return homeCurrency, nil
+}
+
+// currencyTotal is an amount in cents summed within a single currency
+type currencyTotal struct {
+	Currency string `json:"currency"`
+	Total    int64  `json:"total"`
+}
+
+// sum converts per-currency totals to the reporting currency and adds them up
+func (s *Service) sum(ctx context.Context, totals []currencyTotal, currencyCode string) (int64, error) {
+	var sum int64
+	for _, total := range totals {
+		converted, err := s.Convert(ctx, total.Total, total.Currency, currencyCode)
+		if err != nil {
+			return 0, err
+		}
+		sum += converted
+	}
+
+	return sum, nil
+}
+
+// Convert converts an amount in cents to the reporting currency
+// A missing exchange rate is an error rather than a silently mixed total
+func (s *Service) Convert(ctx context.Context, cents int64, from, to string) (int64, error) {
+	converted, err := currency.Convert(ctx, s.rates, cents, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert %s to %s: %w", from, to, err)
+	}
+
+	return converted, nil
+}
@@ -0,0 +1,232 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// CSVProfile maps the columns of a bank's CSV export, which every bank lays out differently
+// Columns are named by their header, matched case-insensitively
+type CSVProfile struct {
+	// Date is the column of the transaction date, parsed with DateFormat
+	Date string `cbor:"date" json:"date"`
+	// DateFormat is a Go time layout, 2006-01-02 when empty
+	DateFormat string `cbor:"date_format,omitempty" json:"date_format,omitempty"`
+	// Description is the column of the transaction name
+	Description string `cbor:"description" json:"description"`
+	// Amount is the column of the signed amount; leave empty when the bank uses Debit and Credit columns instead
+	Amount string `cbor:"amount,omitempty" json:"amount,omitempty"`
+	// Debit and Credit are the columns of money leaving and entering the account
+	Debit  string `cbor:"debit,omitempty" json:"debit,omitempty"`
+	Credit string `cbor:"credit,omitempty" json:"credit,omitempty"`
+	// DebitsPositive is set when the Amount column is positive for money leaving the account;
+	// most banks export spend as negative
+	DebitsPositive bool `cbor:"debits_positive,omitempty" json:"debits_positive,omitempty"`
+	// Merchant, Category and ID are optional columns of the merchant name, the bank's category
+	// and the bank's unique transaction identifier
+	Merchant string `cbor:"merchant,omitempty" json:"merchant,omitempty"`
+	Category string `cbor:"category,omitempty" json:"category,omitempty"`
+	ID       string `cbor:"id,omitempty" json:"id,omitempty"`
+	// Delimiter separates fields, a comma when empty
+	Delimiter string `cbor:"delimiter,omitempty" json:"delimiter,omitempty"`
+	// DecimalComma is set when amounts are written as 1.234,56
+	DecimalComma bool `cbor:"decimal_comma,omitempty" json:"decimal_comma,omitempty"`
+}
+
+// DefaultCSVProfile returns the profile used when none is given: Date, Description and a signed Amount column
+func DefaultCSVProfile() *CSVProfile {
+	return &CSVProfile{
+		Date:        "Date",
+		Description: "Description",
+		Amount:      "Amount",
+	}
+}
+
+// Validate ensures CSVProfile names the columns needed to read a transaction
+func (p *CSVProfile) Validate() error {
+	return validation.ValidateStruct(p,
+		validation.Field(&p.Date, validation.Required),
+		validation.Field(&p.Description, validation.Required),
+		validation.Field(&p.Amount, validation.When(p.Debit == "" && p.Credit == "", validation.Required.Error("amount or debit and credit columns are required"))),
+		validation.Field(&p.Debit, validation.When(p.Amount == "", validation.Required), validation.When(p.Amount != "", validation.Empty)),
+		validation.Field(&p.Credit, validation.When(p.Amount == "", validation.Required), validation.When(p.Amount != "", validation.Empty)),
+		validation.Field(&p.Delimiter, validation.RuneLength(0, 1)),
+	)
+}
+
+// ParseCSV reads a CSV statement whose first row is a header
+// Rows that cannot be read are reported in Statement.Skipped rather than failing the whole file
+func ParseCSV(data []byte, profile *CSVProfile) (*Statement, error) {
+	if err := profile.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid csv profile: %w", err)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.LazyQuotes = true
+	if profile.Delimiter != "" {
+		reader.Comma, _ = utf8.DecodeRuneInString(profile.Delimiter)
+	}
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("csv statement is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	// column returns the index of a profile column, -1 for columns the profile leaves empty
+	column := func(name string) (int, error) {
+		if name == "" {
+			return -1, nil
+		}
+		i, ok := columns[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			return 0, fmt.Errorf("csv statement has no %q column", name)
+		}
+		return i, nil
+	}
+
+	var cols struct{ date, description, amount, debit, credit, merchant, category, id int }
+	for _, c := range []struct {
+		index *int
+		name  string
+	}{
+		{&cols.date, profile.Date},
+		{&cols.description, profile.Description},
+		{&cols.amount, profile.Amount},
+		{&cols.debit, profile.Debit},
+		{&cols.credit, profile.Credit},
+		{&cols.merchant, profile.Merchant},
+		{&cols.category, profile.Category},
+		{&cols.id, profile.ID},
+	} {
+		if *c.index, err = column(c.name); err != nil {
+			return nil, err
+		}
+	}
+
+	dateFormat := profile.DateFormat
+	if dateFormat == "" {
+		dateFormat = time.DateOnly
+	}
+
+	statement := &Statement{}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("failed to read csv statement: %w", err)
+			}
+			statement.Skipped = append(statement.Skipped, SkippedRow{Line: parseErr.Line, Reason: parseErr.Err.Error()})
+			continue
+		}
+		line, _ := reader.FieldPos(0)
+
+		// field returns a trimmed field, empty for columns the row is too short to have
+		field := func(i int) string {
+			if i < 0 || i >= len(row) {
+				return ""
+			}
+			return strings.TrimSpace(row[i])
+		}
+
+		// Exports often end with blank lines
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+
+		date, err := time.Parse(dateFormat, field(cols.date))
+		if err != nil {
+			statement.Skipped = append(statement.Skipped, SkippedRow{Line: line, Reason: fmt.Sprintf("invalid date %q", field(cols.date))})
+			continue
+		}
+
+		amount, err := csvAmount(field, cols.amount, cols.debit, cols.credit, profile)
+		if err != nil {
+			statement.Skipped = append(statement.Skipped, SkippedRow{Line: line, Reason: err.Error()})
+			continue
+		}
+
+		name := field(cols.description)
+		if name == "" {
+			statement.Skipped = append(statement.Skipped, SkippedRow{Line: line, Reason: "description is empty"})
+			continue
+		}
+
+		record := Record{
+			FITID:        field(cols.id),
+			Date:         date,
+			Amount:       amount,
+			Name:         name,
+			MerchantName: field(cols.merchant),
+		}
+		if category := field(cols.category); category != "" {
+			record.Categories = []string{category}
+		}
+
+		statement.Records = append(statement.Records, record)
+	}
+
+	return statement, nil
+}
+
+// csvAmount reads the amount of a row as positive for debits, from either the signed amount
+// column or the debit and credit columns
+func csvAmount(field func(int) string, amountCol, debitCol, creditCol int, profile *CSVProfile) (int64, error) {
+	if amountCol >= 0 {
+		amount, err := parseAmount(field(amountCol), profile.DecimalComma)
+		if err != nil {
+			return 0, err
+		}
+		if !profile.DebitsPositive {
+			amount = -amount
+		}
+		return amount, nil
+	}
+
+	var debit, credit int64
+	var err error
+	if value := field(debitCol); value != "" {
+		if debit, err = parseAmount(value, profile.DecimalComma); err != nil {
+			return 0, err
+		}
+	}
+	if value := field(creditCol); value != "" {
+		if credit, err = parseAmount(value, profile.DecimalComma); err != nil {
+			return 0, err
+		}
+	}
+	if debit == 0 && credit == 0 {
+		return 0, errors.New("debit and credit are both empty")
+	}
+
+	// Some banks sign the debit column, so only its magnitude counts
+	return abs(debit) - abs(credit), nil
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,116 @@
+package importer
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name        string
+		fixture     string
+		profile     *CSVProfile
+		want        []Record
+		wantSkipped []SkippedRow
+	}{
+		{
+			name:    "signed amount",
+			fixture: "checking.csv",
+			profile: DefaultCSVProfile(),
+			want: []Record{
+				{Date: day(2025, 1, 3), Amount: 450, Name: "COFFEE SHOP"},
+				{Date: day(2025, 1, 5), Amount: -250000, Name: "PAYROLL ACME"},
+				{Date: day(2025, 1, 7), Amount: 8210, Name: "GROCERY MART"},
+			},
+			wantSkipped: []SkippedRow{
+				{Line: 5, Reason: `invalid date "not-a-date"`},
+				{Line: 6, Reason: "description is empty"},
+			},
+		},
+		{
+			name:    "debits positive",
+			fixture: "checking.csv",
+			profile: &CSVProfile{Date: "date", Description: "description", Amount: "amount", DebitsPositive: true},
+			want: []Record{
+				{Date: day(2025, 1, 3), Amount: -450, Name: "COFFEE SHOP"},
+				{Date: day(2025, 1, 5), Amount: 250000, Name: "PAYROLL ACME"},
+				{Date: day(2025, 1, 7), Amount: -8210, Name: "GROCERY MART"},
+			},
+			wantSkipped: []SkippedRow{
+				{Line: 5, Reason: `invalid date "not-a-date"`},
+				{Line: 6, Reason: "description is empty"},
+			},
+		},
+		{
+			name:    "debit and credit columns",
+			fixture: "giro.csv",
+			profile: &CSVProfile{
+				Date:         "BUCHUNGSTAG",
+				DateFormat:   "02.01.2006",
+				Description:  "Verwendungszweck",
+				Debit:        "Belastung",
+				Credit:       "Gutschrift",
+				Merchant:     "Händler",
+				Category:     "Kategorie",
+				ID:           "Referenz",
+				Delimiter:    ";",
+				DecimalComma: true,
+			},
+			want: []Record{
+				{FITID: "REF-1", Date: day(2025, 1, 3), Amount: 123456, Name: "Kartenzahlung", MerchantName: "Bäckerei", Categories: []string{"Lebensmittel"}},
+				{FITID: "REF-2", Date: day(2025, 1, 4), Amount: -1200, Name: "Erstattung"},
+			},
+			wantSkipped: []SkippedRow{
+				{Line: 4, Reason: "debit and credit are both empty"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement, err := ParseCSV(readFixture(t, tt.fixture), tt.profile)
+			if err != nil {
+				t.Fatalf("ParseCSV() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(statement.Records, tt.want) {
+				t.Errorf("ParseCSV() records = %+v, want %+v", statement.Records, tt.want)
+			}
+			if !reflect.DeepEqual(statement.Skipped, tt.wantSkipped) {
+				t.Errorf("ParseCSV() skipped = %+v, want %+v", statement.Skipped, tt.wantSkipped)
+			}
+		})
+	}
+}
+
+func TestParseCSVInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		profile *CSVProfile
+	}{
+		{
+			name:    "missing column",
+			data:    readFixture(t, "checking.csv"),
+			profile: &CSVProfile{Date: "Date", Description: "Memo", Amount: "Amount"},
+		},
+		{
+			name:    "amount with debit and credit",
+			data:    readFixture(t, "checking.csv"),
+			profile: &CSVProfile{Date: "Date", Description: "Description", Amount: "Amount", Debit: "Amount", Credit: "Amount"},
+		},
+		{
+			name:    "empty file",
+			data:    nil,
+			profile: DefaultCSVProfile(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseCSV(tt.data, tt.profile); err == nil {
+				t.Fatal("ParseCSV() succeeded, want an error")
+			}
+		})
+	}
+}
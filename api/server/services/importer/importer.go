@@ -0,0 +1,297 @@
+// Package importer imports bank statements into manual accounts for banks no aggregator covers.
+// Imported rows go through the same categorization, transfer detection and rule pipeline as synced ones.
+package importer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entruleexecution "regulation/internal/ent/ruleexecution"
+	enttransaction "regulation/internal/ent/transaction"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/networth"
+)
+
+var (
+	// ErrNotManual is returned when importing into an account that is synced from an aggregator
+	ErrNotManual = errors.New("statements can only be imported into manual accounts")
+	// ErrInvalidStatement is returned when a statement cannot be parsed
+	ErrInvalidStatement = errors.New("invalid statement")
+	// ErrCurrencyMismatch is returned when a statement is in another currency than its account
+	ErrCurrencyMismatch = errors.New("statement currency does not match the account")
+)
+
+// Service imports bank statements
+type Service struct {
+	db              *ent.Client
+	syncService     *aggregator.SyncService
+	netWorthService *networth.Service
+}
+
+// NewService creates a new statement import service
+func NewService(db *ent.Client, syncService *aggregator.SyncService, netWorthService *networth.Service) *Service {
+	return &Service{
+		db:              db,
+		syncService:     syncService,
+		netWorthService: netWorthService,
+	}
+}
+
+// Summary reports the outcome of an import
+type Summary struct {
+	// Parsed is the number of transactions read from the statement
+	Parsed int
+	// Imported is the number of new transactions stored
+	Imported int
+	// Duplicates is the number of transactions already imported, by an earlier import or earlier in the statement
+	Duplicates int
+	// Skipped holds rows that could not be read
+	Skipped []SkippedRow
+	// RulesTriggered is the number of rule executions the imported transactions triggered
+	RulesTriggered int
+	// From and To are the dates of the earliest and latest imported transactions, nil when none were imported
+	From *time.Time
+	To   *time.Time
+	// BalanceUpdated is set when the statement's ledger balance became the account balance
+	BalanceUpdated bool
+}
+
+// CreateManualAccount creates an account that is filled by statement imports instead of an aggregator
+func (s *Service) CreateManualAccount(ctx context.Context, userID uuid.UUID, name string, accountType entaccount.Type, mask, currencyCode string) (*ent.Account, error) {
+	id := uuid.New()
+
+	acc, err := s.db.Account.
+		Create().
+		SetID(id).
+		SetUserID(userID).
+		SetExternalID("manual-" + id.String()).
+		SetName(name).
+		SetType(accountType).
+		SetMask(mask).
+		SetCurrency(currency.Normalize(currencyCode)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create manual account: %w", err)
+	}
+
+	return acc, nil
+}
+
+// Import parses a statement and stores its new transactions in a manual account
+// Transactions are deduplicated by the bank's FITID when the statement has one and by their content otherwise,
+// so the same or an overlapping statement can be imported again without creating duplicates
+func (s *Service) Import(ctx context.Context, account *ent.Account, format Format, data []byte, profile *CSVProfile) (*Summary, error) {
+	if account.ItemID != nil {
+		return nil, ErrNotManual
+	}
+
+	statement, err := Parse(format, data, profile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidStatement, err)
+	}
+
+	if statement.Currency != "" && statement.Currency != account.Currency {
+		return nil, fmt.Errorf("%w: statement is in %s, account is in %s", ErrCurrencyMismatch, statement.Currency, account.Currency)
+	}
+
+	summary := &Summary{
+		Parsed:  len(statement.Records),
+		Skipped: statement.Skipped,
+	}
+
+	keys := importKeys(account.ID, statement.Records)
+
+	existing, err := s.db.Transaction.
+		Query().
+		Where(
+			enttransaction.AccountID(account.ID),
+			enttransaction.ExternalIDIn(keys...),
+		).
+		Select(enttransaction.FieldExternalID).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query imported transactions: %w", err)
+	}
+
+	fresh, duplicates := dedupe(keys, existing)
+	summary.Duplicates = duplicates
+
+	var transactions []aggregator.Transaction
+	var newKeys []string
+	for _, i := range fresh {
+		record := statement.Records[i]
+
+		transactions = append(transactions, aggregator.Transaction{
+			TransactionID: keys[i],
+			AccountID:     account.ExternalID,
+			Amount:        record.Amount,
+			Currency:      account.Currency,
+			Date:          record.Date,
+			Name:          record.Name,
+			MerchantName:  record.MerchantName,
+			Categories:    record.Categories,
+		})
+		newKeys = append(newKeys, keys[i])
+
+		if summary.From == nil || record.Date.Before(*summary.From) {
+			summary.From = &record.Date
+		}
+		if summary.To == nil || record.Date.After(*summary.To) {
+			summary.To = &record.Date
+		}
+	}
+
+	if err := s.syncService.ImportTransactions(ctx, account.UserID, transactions); err != nil {
+		return nil, err
+	}
+
+	// Rows that failed to save were logged by the sync pipeline, so count what was stored
+	if summary.Imported, err = s.db.Transaction.
+		Query().
+		Where(
+			enttransaction.AccountID(account.ID),
+			enttransaction.ExternalIDIn(newKeys...),
+		).
+		Count(ctx); err != nil {
+		return nil, fmt.Errorf("failed to count imported transactions: %w", err)
+	}
+
+	if summary.RulesTriggered, err = s.db.RuleExecution.
+		Query().
+		Where(entruleexecution.HasTransactionWith(
+			enttransaction.AccountID(account.ID),
+			enttransaction.ExternalIDIn(newKeys...),
+		)).
+		Count(ctx); err != nil {
+		return nil, fmt.Errorf("failed to count rule executions: %w", err)
+	}
+
+	if statement.Balance != nil {
+		if summary.BalanceUpdated, err = s.updateBalance(ctx, account, statement, latestDate(statement.Records)); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info().
+		Str("account_id", account.ID.String()).
+		Str("format", string(format)).
+		Int("parsed", summary.Parsed).
+		Int("imported", summary.Imported).
+		Int("duplicates", summary.Duplicates).
+		Int("skipped", len(summary.Skipped)).
+		Int("rules_triggered", summary.RulesTriggered).
+		Msg("[IMPORT] Imported statement")
+
+	return summary, nil
+}
+
+// updateBalance records a statement's ledger balance for net worth on the day it was reported as of,
+// and makes it the account balance unless a newer statement's balance was already applied.
+// Statements without a balance date are taken as of their latest transaction, or today when they have none.
+// It reports whether the account balance changed.
+func (s *Service) updateBalance(ctx context.Context, account *ent.Account, statement *Statement, latest *time.Time) (bool, error) {
+	// Credit balances are stored as the amount owed, like Plaid reports them
+	balance := *statement.Balance
+	if account.Type == entaccount.TypeCredit {
+		balance = -balance
+	}
+
+	asOf := networth.Day(time.Now())
+	switch {
+	case statement.BalanceDate != nil:
+		asOf = networth.Day(*statement.BalanceDate)
+	case latest != nil:
+		asOf = networth.Day(*latest)
+	}
+
+	if err := s.netWorthService.RecordSnapshotAt(ctx, account, asOf, balance); err != nil {
+		return false, err
+	}
+
+	if account.BalanceDate != nil && asOf.Before(*account.BalanceDate) {
+		log.Info().
+			Str("account_id", account.ID.String()).
+			Time("balance_date", asOf).
+			Time("current_balance_date", *account.BalanceDate).
+			Msg("[IMPORT] Statement is older than the applied balance, keeping the account balance")
+		return false, nil
+	}
+
+	err := s.db.Account.
+		UpdateOne(account).
+		SetCurrentBalance(balance).
+		SetBalanceDate(asOf).
+		Exec(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to update account balance: %w", err)
+	}
+
+	return true, nil
+}
+
+// importKeys returns the key each record is stored under as its transaction's external_id
+// Records with a FITID are keyed by it, which banks keep unique per account. Others are keyed by
+// their date, amount and name along with how many identical records precede them in the statement,
+// so two identical purchases on one day are both kept while a re-imported statement matches up again
+func importKeys(accountID uuid.UUID, records []Record) []string {
+	keys := make([]string, len(records))
+	occurrences := make(map[string]int)
+
+	for i, record := range records {
+		var content string
+		if record.FITID != "" {
+			content = "fitid\x00" + record.FITID
+		} else {
+			row := record.Date.Format(time.DateOnly) + "\x00" + strconv.FormatInt(record.Amount, 10) + "\x00" + record.Name
+			content = row + "\x00" + strconv.Itoa(occurrences[row])
+			occurrences[row]++
+		}
+
+		sum := sha256.Sum256([]byte(accountID.String() + "\x00" + content))
+		keys[i] = "import-" + hex.EncodeToString(sum[:16])
+	}
+
+	return keys
+}
+
+// dedupe returns the indexes of the keys to import, skipping keys that were already imported and
+// keys repeated earlier in the statement, along with how many were skipped
+func dedupe(keys, existing []string) (fresh []int, duplicates int) {
+	seen := make(map[string]bool, len(keys)+len(existing))
+	for _, key := range existing {
+		seen[key] = true
+	}
+
+	for i, key := range keys {
+		if seen[key] {
+			duplicates++
+			continue
+		}
+		seen[key] = true
+		fresh = append(fresh, i)
+	}
+
+	return fresh, duplicates
+}
+
+// latestDate returns the date of the latest record, nil when there are none
+func latestDate(records []Record) *time.Time {
+	var latest *time.Time
+	for i := range records {
+		if latest == nil || records[i].Date.After(*latest) {
+			latest = &records[i].Date
+		}
+	}
+	return latest
+}
@@ -0,0 +1,119 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// readFixture reads a statement from testdata
+func readFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	return data
+}
+
+// parseFixture parses a statement from testdata in the format of its extension
+func parseFixture(t *testing.T, name string) *Statement {
+	t.Helper()
+
+	format, err := FormatFromPath(name)
+	if err != nil {
+		t.Fatalf("failed to infer format: %v", err)
+	}
+
+	statement, err := Parse(format, readFixture(t, name), nil)
+	if err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+	return statement
+}
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestImportKeys(t *testing.T) {
+	account := uuid.New()
+	coffee := Record{Date: day(2025, 1, 20), Amount: 450, Name: "COFFEE SHOP"}
+
+	keys := importKeys(account, []Record{
+		{FITID: "20250110-1", Date: day(2025, 1, 10), Amount: 20000, Name: "TRANSFER TO SAVINGS"},
+		{FITID: "20250110-1", Date: day(2025, 1, 11), Amount: 20000, Name: "ONLINE TRANSFER TO SAVINGS"},
+		coffee,
+		coffee,
+	})
+
+	if keys[0] != keys[1] {
+		t.Error("records with the same FITID got different keys")
+	}
+	if keys[2] == keys[3] {
+		t.Error("identical records without a FITID got the same key")
+	}
+	if other := importKeys(uuid.New(), []Record{coffee}); other[0] == keys[2] {
+		t.Error("the same record in another account got the same key")
+	}
+}
+
+func TestReimport(t *testing.T) {
+	tests := []struct {
+		name           string
+		first, second  string
+		wantDuplicates int
+		// wantImported names the records of the second statement that are new
+		wantImported []string
+	}{
+		{
+			name:           "same statement",
+			first:          "january.csv",
+			second:         "january.csv",
+			wantDuplicates: 3,
+		},
+		{
+			name:           "overlapping statement without ids",
+			first:          "january.csv",
+			second:         "january-february.csv",
+			wantDuplicates: 3,
+			wantImported:   []string{"COFFEE SHOP", "RENT"},
+		},
+		{
+			name:           "overlapping statement with fitids",
+			first:          "checking.ofx",
+			second:         "checking-overlap.ofx",
+			wantDuplicates: 1,
+			wantImported:   []string{"COFFEE SHOP"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := uuid.New()
+
+			// The first import stored every record of its statement
+			existing := importKeys(account, parseFixture(t, tt.first).Records)
+
+			second := parseFixture(t, tt.second)
+			fresh, duplicates := dedupe(importKeys(account, second.Records), existing)
+
+			if duplicates != tt.wantDuplicates {
+				t.Errorf("Duplicates = %d, want %d", duplicates, tt.wantDuplicates)
+			}
+
+			var imported []string
+			for _, i := range fresh {
+				imported = append(imported, second.Records[i].Name)
+			}
+			if !reflect.DeepEqual(imported, tt.wantImported) {
+				t.Errorf("imported %v, want %v", imported, tt.wantImported)
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"regulation/internal/currency"
+)
+
+// ParseOFX reads an OFX or QFX statement of a bank or credit card account
+// Both the SGML dialect of OFX 1.x, where leaf elements are not closed, and the XML dialect of
+// OFX 2.x are accepted, so elements are read as a flat stream of tags rather than a tree
+func ParseOFX(data []byte) (*Statement, error) {
+	body := string(data)
+
+	// The OFX 1.x header is a block of KEY:VALUE lines before the first element
+	start := strings.Index(strings.ToUpper(body), "<OFX>")
+	if start < 0 {
+		return nil, errors.New("ofx statement has no <OFX> element")
+	}
+	body = body[start:]
+
+	statement := &Statement{}
+	var (
+		txn      *ofxTransaction
+		inLedger bool
+		line     = 1 + strings.Count(string(data[:start]), "\n")
+	)
+
+	for len(body) > 0 {
+		open := strings.IndexByte(body, '<')
+		if open < 0 {
+			break
+		}
+		line += strings.Count(body[:open], "\n")
+		body = body[open+1:]
+
+		end := strings.IndexByte(body, '>')
+		if end < 0 {
+			break
+		}
+		tag := strings.ToUpper(strings.TrimSpace(body[:end]))
+		body = body[end+1:]
+
+		// A leaf element's value runs until the next tag
+		value := body
+		if next := strings.IndexByte(body, '<'); next >= 0 {
+			value = body[:next]
+		}
+		value = html.UnescapeString(strings.TrimSpace(value))
+
+		switch tag {
+		case "STMTTRN":
+			txn = &ofxTransaction{line: line}
+		case "/STMTTRN":
+			if txn == nil {
+				continue
+			}
+			if record, err := txn.record(); err != nil {
+				statement.Skipped = append(statement.Skipped, SkippedRow{Line: txn.line, Reason: err.Error()})
+			} else {
+				statement.Records = append(statement.Records, record)
+			}
+			txn = nil
+		case "LEDGERBAL":
+			inLedger = true
+		case "/LEDGERBAL":
+			inLedger = false
+		case "CURDEF":
+			if statement.Currency == "" && value != "" {
+				statement.Currency = currency.Normalize(value)
+			}
+		case "BALAMT":
+			if inLedger {
+				if balance, err := parseAmount(value, false); err == nil {
+					statement.Balance = &balance
+				}
+			}
+		case "DTASOF":
+			if inLedger && len(value) >= 8 {
+				if date, err := time.Parse("20060102", value[:8]); err == nil {
+					statement.BalanceDate = &date
+				}
+			}
+		default:
+			if txn != nil {
+				txn.set(tag, value)
+			}
+		}
+	}
+
+	return statement, nil
+}
+
+// ofxTransaction collects the elements of a STMTTRN aggregate
+type ofxTransaction struct {
+	line    int
+	fitID   string
+	posted  string
+	amount  string
+	name    string
+	memo    string
+	trnType string
+}
+
+// set records the value of an element inside the transaction
+func (t *ofxTransaction) set(tag, value string) {
+	switch tag {
+	case "FITID":
+		t.fitID = value
+	case "DTPOSTED":
+		t.posted = value
+	case "TRNAMT":
+		t.amount = value
+	case "NAME":
+		// NAME also appears inside the PAYEE aggregate, which names the same party
+		if t.name == "" {
+			t.name = value
+		}
+	case "MEMO":
+		t.memo = value
+	case "TRNTYPE":
+		t.trnType = strings.ToUpper(value)
+	}
+}
+
+// record converts the transaction into a Record
+func (t *ofxTransaction) record() (Record, error) {
+	// Dates are YYYYMMDD optionally followed by a time and timezone, only the day matters
+	if len(t.posted) < 8 {
+		return Record{}, fmt.Errorf("invalid posted date %q", t.posted)
+	}
+	date, err := time.Parse("20060102", t.posted[:8])
+	if err != nil {
+		return Record{}, fmt.Errorf("invalid posted date %q", t.posted)
+	}
+
+	amount, err := parseAmount(t.amount, false)
+	if err != nil {
+		return Record{}, err
+	}
+
+	name := t.name
+	if name == "" {
+		name = t.memo
+	}
+	if name == "" {
+		return Record{}, errors.New("transaction has no name or memo")
+	}
+
+	record := Record{
+		FITID: t.fitID,
+		Date:  date,
+		// OFX amounts are negative for money leaving the account
+		Amount: -amount,
+		Name:   name,
+	}
+	// Transfers are the only transaction type that says anything about the category
+	if t.trnType == "XFER" {
+		record.Categories = []string{"Transfer"}
+	}
+
+	return record, nil
+}
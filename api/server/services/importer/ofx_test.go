@@ -0,0 +1,74 @@
+package importer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseOFX(t *testing.T) {
+	tests := []struct {
+		name            string
+		fixture         string
+		want            []Record
+		wantSkipped     []SkippedRow
+		wantBalance     int64
+		wantBalanceDate time.Time
+	}{
+		{
+			name:    "sgml",
+			fixture: "checking.ofx",
+			want: []Record{
+				{FITID: "20250103-1", Date: day(2025, 1, 3), Amount: 450, Name: "COFFEE SHOP"},
+				{FITID: "20250105-1", Date: day(2025, 1, 5), Amount: -250000, Name: "PAYROLL ACME & CO"},
+				{FITID: "20250110-1", Date: day(2025, 1, 10), Amount: 20000, Name: "TRANSFER TO SAVINGS", Categories: []string{"Transfer"}},
+			},
+			wantSkipped: []SkippedRow{
+				{Line: 61, Reason: `invalid posted date "2025011"`},
+			},
+			wantBalance:     183456,
+			wantBalanceDate: day(2025, 1, 31),
+		},
+		{
+			name:    "xml",
+			fixture: "card.qfx",
+			want: []Record{
+				{FITID: "CC-1", Date: day(2025, 2, 12), Amount: 8210, Name: "GROCERY MART"},
+				{FITID: "CC-2", Date: day(2025, 2, 15), Amount: -50000, Name: "PAYMENT THANK YOU"},
+			},
+			wantBalance:     -123456,
+			wantBalanceDate: day(2025, 2, 28),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statement, err := ParseOFX(readFixture(t, tt.fixture))
+			if err != nil {
+				t.Fatalf("ParseOFX() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(statement.Records, tt.want) {
+				t.Errorf("ParseOFX() records = %+v, want %+v", statement.Records, tt.want)
+			}
+			if !reflect.DeepEqual(statement.Skipped, tt.wantSkipped) {
+				t.Errorf("ParseOFX() skipped = %+v, want %+v", statement.Skipped, tt.wantSkipped)
+			}
+			if statement.Currency != "USD" {
+				t.Errorf("ParseOFX() currency = %q, want USD", statement.Currency)
+			}
+			if statement.Balance == nil || *statement.Balance != tt.wantBalance {
+				t.Errorf("ParseOFX() balance = %v, want %d", statement.Balance, tt.wantBalance)
+			}
+			if statement.BalanceDate == nil || !statement.BalanceDate.Equal(tt.wantBalanceDate) {
+				t.Errorf("ParseOFX() balance date = %v, want %v", statement.BalanceDate, tt.wantBalanceDate)
+			}
+		})
+	}
+}
+
+func TestParseOFXInvalid(t *testing.T) {
+	if _, err := ParseOFX(readFixture(t, "checking.csv")); err == nil {
+		t.Fatal("ParseOFX() of a csv statement succeeded, want an error")
+	}
+}
@@ -0,0 +1,138 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Format is the file format of a bank statement
+type Format string
+
+const (
+	FormatCSV Format = "csv"
+	FormatOFX Format = "ofx"
+	// FormatQFX is Quicken's flavour of OFX and is parsed the same way
+	FormatQFX Format = "qfx"
+)
+
+// ErrUnknownFormat is returned for statement formats that cannot be parsed
+var ErrUnknownFormat = errors.New("unknown statement format")
+
+// ParseFormat parses a format name such as csv, ofx or qfx
+func ParseFormat(name string) (Format, error) {
+	switch format := Format(strings.ToLower(strings.TrimSpace(name))); format {
+	case FormatCSV, FormatOFX, FormatQFX:
+		return format, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrUnknownFormat, name)
+	}
+}
+
+// FormatFromPath infers the format of a statement file from its extension
+func FormatFromPath(path string) (Format, error) {
+	return ParseFormat(strings.TrimPrefix(filepath.Ext(path), "."))
+}
+
+// Record is a single transaction read from a statement
+type Record struct {
+	// FITID is the bank's unique identifier for the transaction, empty when the statement has none
+	FITID        string
+	Date         time.Time
+	Amount       int64 // in cents (positive = debit, negative = credit), like Plaid
+	Name         string
+	MerchantName string
+	// Categories holds the bank's own categories, if the statement has any
+	Categories []string
+}
+
+// SkippedRow is a statement row that could not be read
+type SkippedRow struct {
+	Line   int
+	Reason string
+}
+
+// Statement is the parsed content of a statement file
+type Statement struct {
+	Records []Record
+	Skipped []SkippedRow
+	// Currency is the ISO 4217 currency the statement declares, empty when it declares none
+	Currency string
+	// Balance is the ledger balance at the end of the statement in cents, nil when it has none.
+	// It is positive when money is held, so credit card statements report what is owed as negative
+	Balance *int64
+	// BalanceDate is the day Balance was reported as of, nil when the statement gives no date
+	BalanceDate *time.Time
+}
+
+// Parse reads a statement of the given format
+// profile describes the columns of CSV statements and is ignored for other formats; nil uses DefaultCSVProfile
+func Parse(format Format, data []byte, profile *CSVProfile) (*Statement, error) {
+	switch format {
+	case FormatCSV:
+		if profile == nil {
+			profile = DefaultCSVProfile()
+		}
+		return ParseCSV(data, profile)
+	case FormatOFX, FormatQFX:
+		return ParseOFX(data)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// parseAmount parses a decimal amount into cents, accepting currency symbols, thousands
+// separators, a leading or trailing minus sign and accounting-style parentheses for negatives
+// With decimalComma, the comma is the decimal separator and periods separate thousands
+func parseAmount(value string, decimalComma bool) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, errors.New("amount is empty")
+	}
+
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+
+	decimal, thousands := '.', ','
+	if decimalComma {
+		decimal, thousands = ',', '.'
+	}
+
+	var number strings.Builder
+	for _, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			number.WriteRune(r)
+		case r == decimal:
+			number.WriteRune('.')
+		case r == '-':
+			negative = !negative
+		case r == thousands, r == '+', r == ' ':
+			// Formatting only
+		default:
+			// Currency symbols and codes such as $, € or USD
+			if !unicode.IsLetter(r) && !unicode.Is(unicode.Sc, r) {
+				return 0, fmt.Errorf("invalid amount %q", value)
+			}
+		}
+	}
+
+	amount, err := strconv.ParseFloat(number.String(), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	cents := int64(math.Round(amount * 100))
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
@@ -0,0 +1,230 @@
+package networth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entbalancesnapshot "regulation/internal/ent/balancesnapshot"
+)
+
+// Granularity is the spacing of points in a net worth timeline
+type Granularity string
+
+const (
+	GranularityDay   Granularity = "day"
+	GranularityWeek  Granularity = "week"
+	GranularityMonth Granularity = "month"
+)
+
+// MaxPoints bounds the length of a timeline
+const MaxPoints = 400
+
+// Service records daily account balances and builds net worth history from them
+type Service struct {
+	db    *ent.Client
+	rates currency.RateProvider
+}
+
+// NewService creates a new net worth service
+func NewService(db *ent.Client, rates currency.RateProvider) *Service {
+	return &Service{db: db, rates: rates}
+}
+
+// Point holds balances in cents as of the end of a timeline period
+// Credit balances are amounts owed and count against net worth
+type Point struct {
+	Date     time.Time `json:"date"`
+	Checking int64     `json:"checking"`
+	Savings  int64     `json:"savings"`
+	Other    int64     `json:"other"`
+	Credit   int64     `json:"credit"`
+}
+
+// Assets returns the total of depository balances
+func (p Point) Assets() int64 {
+	return p.Checking + p.Savings + p.Other
+}
+
+// NetWorth returns assets minus credit balances owed
+func (p Point) NetWorth() int64 {
+	return p.Assets() - p.Credit
+}
+
+// Day truncates t to the UTC day snapshots are keyed by
+func Day(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// RecordSnapshot stores the account's current balances as today's snapshot,
+// replacing an earlier snapshot of the same day
+func (s *Service) RecordSnapshot(ctx context.Context, account *ent.Account) error {
+	return s.recordSnapshot(ctx, account, time.Now(), account.CurrentBalance, account.AvailableBalance)
+}
+
+// RecordSnapshotAt stores the balance an account had on a given day, such as a statement's closing balance,
+// replacing an earlier snapshot of the same day
+func (s *Service) RecordSnapshotAt(ctx context.Context, account *ent.Account, date time.Time, balance int64) error {
+	return s.recordSnapshot(ctx, account, date, balance, nil)
+}
+
+// recordSnapshot upserts the snapshot of an account for the day of date
+func (s *Service) recordSnapshot(ctx context.Context, account *ent.Account, date time.Time, balance int64, available *int64) error {
+	err := s.db.BalanceSnapshot.
+		Create().
+		SetAccountID(account.ID).
+		SetUserID(account.UserID).
+		SetDate(Day(date)).
+		SetCurrentBalance(balance).
+		SetNillableAvailableBalance(available).
+		OnConflictColumns(entbalancesnapshot.FieldAccountID, entbalancesnapshot.FieldDate).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record balance snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// PeriodCount returns the number of dates PeriodEnds would return, without building them
+// so oversized ranges can be rejected cheaply
+func PeriodCount(start, end time.Time, granularity Granularity) int {
+	start, end = Day(start), Day(end)
+	if start.After(end) {
+		return 0
+	}
+
+	days := int(end.Sub(start).Hours() / 24)
+	switch granularity {
+	case GranularityWeek:
+		// The first week ends on the first Sunday, every later one 7 days after the previous
+		first := (7 - int(start.Weekday())) % 7
+		if first >= days {
+			return 1
+		}
+		return 1 + (days-first+6)/7
+	case GranularityMonth:
+		return (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month()) + 1
+	default:
+		return days + 1
+	}
+}
+
+// PeriodEnds returns the last day of every period between start and end, the final one clamped to end
+// Weeks end on Sunday
+func PeriodEnds(start, end time.Time, granularity Granularity) []time.Time {
+	start, end = Day(start), Day(end)
+
+	var ends []time.Time
+	for day := start; !day.After(end); {
+		var periodEnd time.Time
+		switch granularity {
+		case GranularityWeek:
+			periodEnd = day.AddDate(0, 0, (7-int(day.Weekday()))%7)
+		case GranularityMonth:
+			periodEnd = time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+		default:
+			periodEnd = day
+		}
+
+		if periodEnd.After(end) {
+			periodEnd = end
+		}
+
+		ends = append(ends, periodEnd)
+		day = periodEnd.AddDate(0, 0, 1)
+	}
+
+	return ends
+}
+
+// Timeline returns the combined balances of the users' accounts at the end of every period
+// between start and end, converted to the given currency. Each account counts with its latest
+// snapshot on or before the period end; inactive accounts stop counting after their last snapshot.
+func (s *Service) Timeline(ctx context.Context, userIDs []uuid.UUID, currencyCode string, start, end time.Time, granularity Granularity) ([]Point, error) {
+	ends := PeriodEnds(start, end, granularity)
+	if len(ends) == 0 {
+		return nil, nil
+	}
+
+	accounts, err := s.db.Account.
+		Query().
+		Where(entaccount.UserIDIn(userIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query accounts: %w", err)
+	}
+
+	accountIDs := make([]uuid.UUID, len(accounts))
+	for i, account := range accounts {
+		accountIDs[i] = account.ID
+	}
+
+	snapshots, err := s.db.BalanceSnapshot.
+		Query().
+		Where(
+			entbalancesnapshot.AccountIDIn(accountIDs...),
+			entbalancesnapshot.DateLTE(ends[len(ends)-1]),
+		).
+		Order(ent.Asc(entbalancesnapshot.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query balance snapshots: %w", err)
+	}
+
+	history := make(map[uuid.UUID][]*ent.BalanceSnapshot, len(accounts))
+	for _, snapshot := range snapshots {
+		history[snapshot.AccountID] = append(history[snapshot.AccountID], snapshot)
+	}
+
+	points := make([]Point, len(ends))
+	for i, date := range ends {
+		points[i].Date = date
+	}
+
+	for _, account := range accounts {
+		series := history[account.ID]
+		if len(series) == 0 {
+			continue
+		}
+		last := series[len(series)-1].Date
+
+		next := 0
+		var latest *ent.BalanceSnapshot
+		for i, date := range ends {
+			for next < len(series) && !series[next].Date.After(date) {
+				latest = series[next]
+				next++
+			}
+
+			if latest == nil || (!account.IsActive && date.After(last)) {
+				continue
+			}
+
+			balance, err := currency.Convert(ctx, s.rates, latest.CurrentBalance, account.Currency, currencyCode)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert %s balance to %s: %w", account.Currency, currencyCode, err)
+			}
+
+			switch account.Type {
+			case entaccount.TypeChecking:
+				points[i].Checking += balance
+			case entaccount.TypeSavings:
+				points[i].Savings += balance
+			case entaccount.TypeCredit:
+				points[i].Credit += balance
+			default:
+				points[i].Other += balance
+			}
+		}
+	}
+
+	return points, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/SherClockHolmes/webpush-go"
 	"github.com/fxamacker/cbor/v2"
 	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
 
 	"regulation/internal/config"
 	"regulation/internal/ent"
@@ -148,6 +149,26 @@ func (s *Service) SendToUser(ctx context.Context, userID uuid.UUID, payload *Pay
 	return successCount, errs
 }
 
+// Notify sends a notification to all of a user's subscriptions like SendToUser, logging failed
+// deliveries instead of returning them, for alerts that shouldn't fail the work that raised them
+func (s *Service) Notify(ctx context.Context, userID uuid.UUID, payload *Payload) {
+	successCount, errs := s.SendToUser(ctx, userID, payload)
+
+	if len(errs) > 0 {
+		log.Warn().
+			Str("user_id", userID.String()).
+			Int("success_count", successCount).
+			Int("error_count", len(errs)).
+			Errs("errors", errs).
+			Msg("Some notifications failed to send")
+	} else if successCount > 0 {
+		log.Debug().
+			Str("user_id", userID.String()).
+			Int("count", successCount).
+			Msg("Notifications sent successfully")
+	}
+}
+
 // sendToSubscription sends a notification to a single subscription
 func (s *Service) sendToSubscription(ctx context.Context, sub *ent.PushSubscription, message []byte) error {
 	// Create the subscription
@@ -0,0 +1,34 @@
+package plaid
+
+import (
+	entaccount "regulation/internal/ent/account"
+)
+
+// AccountType maps a Plaid account type and subtype to the app account type
+// Every Plaid credit account (credit cards, PayPal credit) is a credit account; depository
+// accounts are checking or savings by subtype, and everything else is other
+func AccountType(accountType, subtype string) entaccount.Type {
+	switch accountType {
+	case "credit":
+		return entaccount.TypeCredit
+	case "depository":
+		switch subtype {
+		case "checking", "cash management", "prepaid":
+			return entaccount.TypeChecking
+		case "savings", "money market", "cd", "hsa":
+			return entaccount.TypeSavings
+		}
+	}
+
+	// Older clients report only a subtype
+	switch subtype {
+	case "checking":
+		return entaccount.TypeChecking
+	case "savings":
+		return entaccount.TypeSavings
+	case "credit card":
+		return entaccount.TypeCredit
+	default:
+		return entaccount.TypeOther
+	}
+}
@@ -2,26 +2,46 @@ package plaid
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"fmt"
 	"time"
+
+	"regulation/server/services/aggregator"
 )
 
 // Client defines the interface for interacting with Plaid API.
 // This interface allows for easy mocking in tests.
 type Client interface {
-	// CreateLinkToken creates a link token for initializing Plaid Link
-	CreateLinkToken(ctx context.Context, userID string) (string, error)
+	// CreateLinkToken creates a link token for initializing Plaid Link, requesting daysRequested days of history
+	CreateLinkToken(ctx context.Context, userID string, daysRequested int) (string, error)
+
+	// CreateUpdateLinkToken creates a link token that opens Plaid Link in update mode
+	// so the user can repair an existing item, e.g. after ITEM_LOGIN_REQUIRED
+	CreateUpdateLinkToken(ctx context.Context, userID, accessToken string) (string, error)
 
 	// ExchangePublicToken exchanges a public token for an access token
 	ExchangePublicToken(ctx context.Context, publicToken string) (*TokenExchangeResult, error)
 
 	// GetAccounts retrieves all accounts for an access token
-	GetAccounts(ctx context.Context, accessToken string) ([]Account, error)
+	GetAccounts(ctx context.Context, accessToken string) ([]aggregator.Account, error)
 
 	// SyncTransactions retrieves transactions using the sync endpoint
-	SyncTransactions(ctx context.Context, accessToken, cursor string) (*TransactionSyncResult, error)
+	SyncTransactions(ctx context.Context, accessToken, cursor string) (*aggregator.TransactionSyncResult, error)
 
 	// RefreshTransactions triggers a refresh of transactions (for sandbox testing)
 	RefreshTransactions(ctx context.Context, accessToken string) error
+
+	// RemoveItem removes an item at Plaid, invalidating its access token
+	RemoveItem(ctx context.Context, accessToken string) error
+
+	// GetItem retrieves the Plaid item an access token belongs to
+	GetItem(ctx context.Context, accessToken string) (*aggregator.ItemInfo, error)
+
+	// GetInstitution retrieves an institution's name and branding
+	GetInstitution(ctx context.Context, institutionID string) (*aggregator.Institution, error)
+
+	// GetWebhookVerificationKey retrieves the public key Plaid signed a webhook with
+	GetWebhookVerificationKey(ctx context.Context, keyID string) (*WebhookVerificationKey, error)
 }
 
 // TokenExchangeResult contains the result of exchanging a public token
@@ -30,35 +50,20 @@ type TokenExchangeResult struct {
 	ItemID      string
 }
 
-// Account represents a bank account from Plaid
-type Account struct {
-	AccountID        string
-	Name             string
-	Type             string
-	Subtype          string
-	Mask             string
-	BalanceCurrent   int64  // in cents
-	BalanceAvailable *int64 // in cents, may be nil
+// WebhookVerificationKey is a public key used to verify Plaid webhook signatures
+type WebhookVerificationKey struct {
+	KeyID     string
+	PublicKey *ecdsa.PublicKey
+	ExpiredAt *time.Time // nil while the key is current
 }
 
-// Transaction represents a transaction from Plaid
-type Transaction struct {
-	TransactionID  string
-	AccountID      string
-	Amount         int64 // in cents (positive = debit, negative = credit)
-	Date           time.Time
-	Name           string
-	MerchantName   string
-	Categories     []string
-	Pending        bool
-	PaymentChannel string
+// Error is an error returned by the Plaid API
+type Error struct {
+	Type    string // e.g. ITEM_ERROR, INSTITUTION_ERROR, RATE_LIMIT_EXCEEDED
+	Code    string // e.g. ITEM_LOGIN_REQUIRED, INSTITUTION_DOWN
+	Message string
 }
 
-// TransactionSyncResult contains the result of syncing transactions
-type TransactionSyncResult struct {
-	Added    []Transaction
-	Modified []Transaction
-	Removed  []string // transaction IDs that were removed
-	Cursor   string
-	HasMore  bool
+func (e *Error) Error() string {
+	return fmt.Sprintf("plaid %s (%s): %s", e.Code, e.Type, e.Message)
 }
@@ -2,13 +2,20 @@ package plaid
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"math/big"
+	"strings"
 	"time"
 
 	"github.com/plaid/plaid-go/v35/plaid"
 
 	"regulation/internal/config"
+	"regulation/internal/currency"
+	"regulation/server/services/aggregator"
 )
 
 // plaidClientImpl is the real implementation using Plaid SDK
@@ -40,6 +47,11 @@ func NewPlaidClient(cfg *config.PlaidConfig) (Client, error) {
 		return nil, fmt.Errorf("invalid plaid environment: %s", env)
 	}
 
+	// Point the client at another host speaking Plaid's API, such as the local sandbox server
+	if baseURL := cfg.GetBaseURL(); baseURL != "" {
+		configuration.Servers = plaid.ServerConfigurations{{URL: strings.TrimSuffix(baseURL, "/")}}
+	}
+
 	return &plaidClientImpl{
 		client: plaid.NewAPIClient(configuration),
 		config: cfg,
@@ -47,7 +59,7 @@ func NewPlaidClient(cfg *config.PlaidConfig) (Client, error) {
 }
 
 // CreateLinkToken creates a link token for initializing Plaid Link
-func (p *plaidClientImpl) CreateLinkToken(ctx context.Context, userID string) (string, error) {
+func (p *plaidClientImpl) CreateLinkToken(ctx context.Context, userID string, daysRequested int) (string, error) {
 	user := plaid.LinkTokenCreateRequestUser{
 		ClientUserId: userID,
 	}
@@ -62,6 +74,16 @@ func (p *plaidClientImpl) CreateLinkToken(ctx context.Context, userID string) (s
 	// Request transactions product for transaction data
 	request.SetProducts([]plaid.Products{plaid.PRODUCTS_TRANSACTIONS})
 
+	// History is only pulled as far back as requested when the item is linked
+	transactions := plaid.NewLinkTokenTransactions()
+	transactions.SetDaysRequested(int32(daysRequested))
+	request.SetTransactions(*transactions)
+
+	// Deliver item webhooks so syncs run as soon as updates are available
+	if webhookURL := p.config.GetWebhookURL(); webhookURL != "" {
+		request.SetWebhook(webhookURL)
+	}
+
 	// Filter to only checking and savings accounts
 	request.SetAccountFilters(plaid.LinkTokenAccountFilters{
 		Depository: &plaid.DepositoryFilter{
@@ -76,7 +98,37 @@ func (p *plaidClientImpl) CreateLinkToken(ctx context.Context, userID string) (s
 		LinkTokenCreateRequest(*request).
 		Execute()
 	if err != nil {
-		return "", fmt.Errorf("failed to create link token: %w", err)
+		return "", fmt.Errorf("failed to create link token: %w", toError(err))
+	}
+
+	return resp.GetLinkToken(), nil
+}
+
+// CreateUpdateLinkToken creates a link token that opens Plaid Link in update mode for an existing item
+func (p *plaidClientImpl) CreateUpdateLinkToken(ctx context.Context, userID, accessToken string) (string, error) {
+	user := plaid.LinkTokenCreateRequestUser{
+		ClientUserId: userID,
+	}
+
+	request := plaid.NewLinkTokenCreateRequest(
+		"Who Are You To Spend",
+		"en",
+		[]plaid.CountryCode{plaid.COUNTRYCODE_US},
+		user,
+	)
+
+	// Update mode is selected by passing the item's access token instead of products
+	request.SetAccessToken(accessToken)
+
+	if webhookURL := p.config.GetWebhookURL(); webhookURL != "" {
+		request.SetWebhook(webhookURL)
+	}
+
+	resp, _, err := p.client.PlaidApi.LinkTokenCreate(ctx).
+		LinkTokenCreateRequest(*request).
+		Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to create update link token: %w", toError(err))
 	}
 
 	return resp.GetLinkToken(), nil
@@ -90,7 +142,7 @@ func (p *plaidClientImpl) ExchangePublicToken(ctx context.Context, publicToken s
 		ItemPublicTokenExchangeRequest(*request).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to exchange public token: %w", err)
+		return nil, fmt.Errorf("failed to exchange public token: %w", toError(err))
 	}
 
 	return &TokenExchangeResult{
@@ -100,17 +152,17 @@ func (p *plaidClientImpl) ExchangePublicToken(ctx context.Context, publicToken s
 }
 
 // GetAccounts retrieves all accounts for an access token
-func (p *plaidClientImpl) GetAccounts(ctx context.Context, accessToken string) ([]Account, error) {
+func (p *plaidClientImpl) GetAccounts(ctx context.Context, accessToken string) ([]aggregator.Account, error) {
 	request := plaid.NewAccountsGetRequest(accessToken)
 
 	resp, _, err := p.client.PlaidApi.AccountsGet(ctx).
 		AccountsGetRequest(*request).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get accounts: %w", err)
+		return nil, fmt.Errorf("failed to get accounts: %w", toError(err))
 	}
 
-	accounts := make([]Account, 0, len(resp.GetAccounts()))
+	accounts := make([]aggregator.Account, 0, len(resp.GetAccounts()))
 	for _, acc := range resp.GetAccounts() {
 		balances := acc.GetBalances()
 
@@ -120,14 +172,22 @@ func (p *plaidClientImpl) GetAccounts(ctx context.Context, accessToken string) (
 			balanceAvailable = &cents
 		}
 
-		accounts = append(accounts, Account{
+		var balanceLimit *int64
+		if limit, ok := balances.GetLimitOk(); ok && limit != nil {
+			cents := int64(*limit * 100)
+			balanceLimit = &cents
+		}
+
+		accounts = append(accounts, aggregator.Account{
 			AccountID:        acc.GetAccountId(),
 			Name:             acc.GetName(),
-			Type:             string(acc.GetType()),
+			Type:             AccountType(string(acc.GetType()), string(acc.GetSubtype())),
 			Subtype:          string(acc.GetSubtype()),
 			Mask:             acc.GetMask(),
 			BalanceCurrent:   int64(balances.GetCurrent() * 100), // convert to cents
 			BalanceAvailable: balanceAvailable,
+			BalanceLimit:     balanceLimit,
+			Currency:         currencyCode(balances.GetIsoCurrencyCode(), balances.GetUnofficialCurrencyCode()),
 		})
 	}
 
@@ -135,7 +195,7 @@ func (p *plaidClientImpl) GetAccounts(ctx context.Context, accessToken string) (
 }
 
 // SyncTransactions retrieves transactions using the sync endpoint
-func (p *plaidClientImpl) SyncTransactions(ctx context.Context, accessToken, cursor string) (*TransactionSyncResult, error) {
+func (p *plaidClientImpl) SyncTransactions(ctx context.Context, accessToken, cursor string) (*aggregator.TransactionSyncResult, error) {
 	request := plaid.NewTransactionsSyncRequest(accessToken)
 	if cursor != "" {
 		request.SetCursor(cursor)
@@ -145,15 +205,17 @@ func (p *plaidClientImpl) SyncTransactions(ctx context.Context, accessToken, cur
 		TransactionsSyncRequest(*request).
 		Execute()
 	if err != nil {
-		return nil, fmt.Errorf("failed to sync transactions: %w", err)
+		return nil, fmt.Errorf("failed to sync transactions: %w", toError(err))
 	}
 
-	result := &TransactionSyncResult{
-		Added:    make([]Transaction, 0, len(resp.GetAdded())),
-		Modified: make([]Transaction, 0, len(resp.GetModified())),
+	result := &aggregator.TransactionSyncResult{
+		Added:    make([]aggregator.Transaction, 0, len(resp.GetAdded())),
+		Modified: make([]aggregator.Transaction, 0, len(resp.GetModified())),
 		Removed:  make([]string, 0, len(resp.GetRemoved())),
 		Cursor:   resp.GetNextCursor(),
 		HasMore:  resp.GetHasMore(),
+
+		UpdateStatus: updateStatus(resp.GetTransactionsUpdateStatus()),
 	}
 
 	// Process added transactions
@@ -175,7 +237,7 @@ func (p *plaidClientImpl) SyncTransactions(ctx context.Context, accessToken, cur
 }
 
 // convertTransaction converts a Plaid transaction to our internal Transaction type
-func (p *plaidClientImpl) convertTransaction(tx plaid.Transaction) Transaction {
+func (p *plaidClientImpl) convertTransaction(tx plaid.Transaction) aggregator.Transaction {
 	// Parse date
 	date, _ := time.Parse("2006-01-02", tx.GetDate())
 
@@ -191,19 +253,50 @@ func (p *plaidClientImpl) convertTransaction(tx plaid.Transaction) Transaction {
 		paymentChannel = *channel
 	}
 
-	return Transaction{
-		TransactionID:  tx.GetTransactionId(),
-		AccountID:      tx.GetAccountId(),
-		Amount:         int64(tx.GetAmount() * 100), // convert to cents
-		Date:           date,
-		Name:           tx.GetName(),
-		MerchantName:   merchantName,
-		Categories:     tx.GetCategory(),
-		Pending:        tx.GetPending(),
-		PaymentChannel: paymentChannel,
+	// Get the pending transaction this one posted from, if any
+	pendingTransactionID := ""
+	if pendingID, ok := tx.GetPendingTransactionIdOk(); ok && pendingID != nil {
+		pendingTransactionID = *pendingID
+	}
+
+	return aggregator.Transaction{
+		TransactionID:        tx.GetTransactionId(),
+		AccountID:            tx.GetAccountId(),
+		Amount:               int64(tx.GetAmount() * 100), // convert to cents
+		Currency:             currencyCode(tx.GetIsoCurrencyCode(), tx.GetUnofficialCurrencyCode()),
+		Date:                 date,
+		Name:                 tx.GetName(),
+		MerchantName:         merchantName,
+		Categories:           tx.GetCategory(),
+		Pending:              tx.GetPending(),
+		PaymentChannel:       paymentChannel,
+		PendingTransactionID: pendingTransactionID,
 	}
 }
 
+// updateStatus converts how much history Plaid has pulled, empty when Plaid does not know
+func updateStatus(status plaid.TransactionsUpdateStatus) aggregator.UpdateStatus {
+	switch status {
+	case plaid.TRANSACTIONSUPDATESTATUS_NOT_READY:
+		return aggregator.UpdateStatusNotReady
+	case plaid.TRANSACTIONSUPDATESTATUS_INITIAL_UPDATE_COMPLETE:
+		return aggregator.UpdateStatusInitialComplete
+	case plaid.TRANSACTIONSUPDATESTATUS_HISTORICAL_UPDATE_COMPLETE:
+		return aggregator.UpdateStatusHistoricalComplete
+	default:
+		return ""
+	}
+}
+
+// currencyCode picks the ISO currency code Plaid reports, falling back to the unofficial one
+// (used for currencies such as cryptocurrencies) and then to USD
+func currencyCode(iso, unofficial string) string {
+	if iso != "" {
+		return currency.Normalize(iso)
+	}
+	return currency.Normalize(unofficial)
+}
+
 // RefreshTransactions triggers a refresh of transactions (for sandbox testing)
 func (p *plaidClientImpl) RefreshTransactions(ctx context.Context, accessToken string) error {
 	request := plaid.NewTransactionsRefreshRequest(accessToken)
@@ -212,8 +305,127 @@ func (p *plaidClientImpl) RefreshTransactions(ctx context.Context, accessToken s
 		TransactionsRefreshRequest(*request).
 		Execute()
 	if err != nil {
-		return fmt.Errorf("failed to refresh transactions: %w", err)
+		return fmt.Errorf("failed to refresh transactions: %w", toError(err))
+	}
+
+	return nil
+}
+
+// RemoveItem removes an item at Plaid, invalidating its access token
+func (p *plaidClientImpl) RemoveItem(ctx context.Context, accessToken string) error {
+	request := plaid.NewItemRemoveRequest(accessToken)
+
+	_, _, err := p.client.PlaidApi.ItemRemove(ctx).
+		ItemRemoveRequest(*request).
+		Execute()
+	if err != nil {
+		return fmt.Errorf("failed to remove item: %w", toError(err))
 	}
 
 	return nil
 }
+
+// GetItem retrieves the Plaid item an access token belongs to
+func (p *plaidClientImpl) GetItem(ctx context.Context, accessToken string) (*aggregator.ItemInfo, error) {
+	request := plaid.NewItemGetRequest(accessToken)
+
+	resp, _, err := p.client.PlaidApi.ItemGet(ctx).
+		ItemGetRequest(*request).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get item: %w", toError(err))
+	}
+
+	item := resp.GetItem()
+
+	return &aggregator.ItemInfo{
+		ItemID:        item.GetItemId(),
+		InstitutionID: item.GetInstitutionId(),
+	}, nil
+}
+
+// GetInstitution retrieves an institution's name and branding
+func (p *plaidClientImpl) GetInstitution(ctx context.Context, institutionID string) (*aggregator.Institution, error) {
+	options := plaid.NewInstitutionsGetByIdRequestOptions()
+	options.SetIncludeOptionalMetadata(true)
+
+	request := plaid.NewInstitutionsGetByIdRequest(
+		institutionID,
+		[]plaid.CountryCode{plaid.COUNTRYCODE_US},
+	)
+	request.SetOptions(*options)
+
+	resp, _, err := p.client.PlaidApi.InstitutionsGetById(ctx).
+		InstitutionsGetByIdRequest(*request).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get institution: %w", toError(err))
+	}
+
+	institution := resp.GetInstitution()
+
+	return &aggregator.Institution{
+		ID:           institution.GetInstitutionId(),
+		Name:         institution.GetName(),
+		Logo:         institution.GetLogo(),
+		PrimaryColor: institution.GetPrimaryColor(),
+		URL:          institution.GetUrl(),
+	}, nil
+}
+
+// GetWebhookVerificationKey retrieves the public key Plaid signed a webhook with
+func (p *plaidClientImpl) GetWebhookVerificationKey(ctx context.Context, keyID string) (*WebhookVerificationKey, error) {
+	request := plaid.NewWebhookVerificationKeyGetRequest(keyID)
+
+	resp, _, err := p.client.PlaidApi.WebhookVerificationKeyGet(ctx).
+		WebhookVerificationKeyGetRequest(*request).
+		Execute()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook verification key: %w", toError(err))
+	}
+
+	jwk := resp.GetKey()
+	if jwk.GetKty() != "EC" || jwk.GetCrv() != "P-256" {
+		return nil, fmt.Errorf("unsupported webhook verification key type: %s %s", jwk.GetKty(), jwk.GetCrv())
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.GetX())
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook verification key x coordinate: %w", err)
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(jwk.GetY())
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook verification key y coordinate: %w", err)
+	}
+
+	key := &WebhookVerificationKey{
+		KeyID: jwk.GetKid(),
+		PublicKey: &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		},
+	}
+
+	if expiredAt, ok := jwk.GetExpiredAtOk(); ok && expiredAt != nil {
+		t := time.Unix(int64(*expiredAt), 0)
+		key.ExpiredAt = &t
+	}
+
+	return key, nil
+}
+
+// toError converts a Plaid API error response into *Error, returning other errors unchanged
+func toError(err error) error {
+	plaidErr, convErr := plaid.ToPlaidError(err)
+	if convErr != nil || plaidErr.GetErrorCode() == "" {
+		return err
+	}
+
+	return &Error{
+		Type:    string(plaidErr.GetErrorType()),
+		Code:    plaidErr.GetErrorCode(),
+		Message: plaidErr.GetErrorMessage(),
+	}
+}
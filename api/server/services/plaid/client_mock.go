@@ -2,36 +2,54 @@ package plaid
 
 import (
 	"context"
+	"errors"
+	"sync"
+
+	entaccount "regulation/internal/ent/account"
+	"regulation/server/services/aggregator"
 )
 
 // MockPlaidClient is a mock implementation of PlaidClient for testing
 type MockPlaidClient struct {
-	CreateLinkTokenFn     func(ctx context.Context, userID string) (string, error)
-	ExchangePublicTokenFn func(ctx context.Context, publicToken string) (*TokenExchangeResult, error)
-	GetAccountsFn         func(ctx context.Context, accessToken string) ([]Account, error)
-	SyncTransactionsFn    func(ctx context.Context, accessToken, cursor string) (*TransactionSyncResult, error)
-	RefreshTransactionsFn func(ctx context.Context, accessToken string) error
+	CreateLinkTokenFn       func(ctx context.Context, userID string, daysRequested int) (string, error)
+	CreateUpdateLinkTokenFn func(ctx context.Context, userID, accessToken string) (string, error)
+	ExchangePublicTokenFn   func(ctx context.Context, publicToken string) (*TokenExchangeResult, error)
+	GetAccountsFn           func(ctx context.Context, accessToken string) ([]aggregator.Account, error)
+	SyncTransactionsFn      func(ctx context.Context, accessToken, cursor string) (*aggregator.TransactionSyncResult, error)
+	RefreshTransactionsFn   func(ctx context.Context, accessToken string) error
+	RemoveItemFn            func(ctx context.Context, accessToken string) error
+	GetItemFn               func(ctx context.Context, accessToken string) (*aggregator.ItemInfo, error)
+	GetInstitutionFn        func(ctx context.Context, institutionID string) (*aggregator.Institution, error)
+
+	GetWebhookVerificationKeyFn func(ctx context.Context, keyID string) (*WebhookVerificationKey, error)
+
+	// loginRequired holds access tokens whose item needs re-authentication
+	mu            sync.Mutex
+	loginRequired map[string]bool
 }
 
 // NewMockPlaidClient creates a new mock Plaid client with default behaviors
 func NewMockPlaidClient() *MockPlaidClient {
 	return &MockPlaidClient{
-		CreateLinkTokenFn: func(_ context.Context, userID string) (string, error) {
+		CreateLinkTokenFn: func(_ context.Context, userID string, _ int) (string, error) {
 			return "link-sandbox-test-token-" + userID, nil
 		},
+		CreateUpdateLinkTokenFn: func(_ context.Context, userID, _ string) (string, error) {
+			return "link-sandbox-update-token-" + userID, nil
+		},
 		ExchangePublicTokenFn: func(_ context.Context, _ string) (*TokenExchangeResult, error) {
 			return &TokenExchangeResult{
 				AccessToken: "access-sandbox-test-token",
 				ItemID:      "item-sandbox-test-id",
 			}, nil
 		},
-		GetAccountsFn: func(_ context.Context, _ string) ([]Account, error) {
+		GetAccountsFn: func(_ context.Context, _ string) ([]aggregator.Account, error) {
 			available := int64(150000) // $1500.00
-			return []Account{
+			return []aggregator.Account{
 				{
 					AccountID:        "account-checking-123",
 					Name:             "Plaid Checking",
-					Type:             "depository",
+					Type:             entaccount.TypeChecking,
 					Subtype:          "checking",
 					Mask:             "0000",
 					BalanceCurrent:   200000, // $2000.00 in cents
@@ -40,7 +58,7 @@ func NewMockPlaidClient() *MockPlaidClient {
 				{
 					AccountID:        "account-savings-456",
 					Name:             "Plaid Savings",
-					Type:             "depository",
+					Type:             entaccount.TypeSavings,
 					Subtype:          "savings",
 					Mask:             "1111",
 					BalanceCurrent:   500000, // $5000.00 in cents
@@ -48,21 +66,23 @@ func NewMockPlaidClient() *MockPlaidClient {
 				},
 			}, nil
 		},
-		SyncTransactionsFn: func(_ context.Context, _ string, cursor string) (*TransactionSyncResult, error) {
+		SyncTransactionsFn: func(_ context.Context, _ string, cursor string) (*aggregator.TransactionSyncResult, error) {
 			// Return empty result if cursor is provided (simulating no new transactions)
 			if cursor != "" {
-				return &TransactionSyncResult{
-					Added:    []Transaction{},
-					Modified: []Transaction{},
+				return &aggregator.TransactionSyncResult{
+					Added:    []aggregator.Transaction{},
+					Modified: []aggregator.Transaction{},
 					Removed:  []string{},
 					Cursor:   cursor,
 					HasMore:  false,
+
+					UpdateStatus: aggregator.UpdateStatusHistoricalComplete,
 				}, nil
 			}
 
 			// Return sample transactions on first sync
-			return &TransactionSyncResult{
-				Added: []Transaction{
+			return &aggregator.TransactionSyncResult{
+				Added: []aggregator.Transaction{
 					{
 						TransactionID:  "tx-1",
 						AccountID:      "account-checking-123",
@@ -84,23 +104,65 @@ func NewMockPlaidClient() *MockPlaidClient {
 						PaymentChannel: "online",
 					},
 				},
-				Modified: []Transaction{},
+				Modified: []aggregator.Transaction{},
 				Removed:  []string{},
 				Cursor:   "mock-cursor-1",
 				HasMore:  false,
+
+				UpdateStatus: aggregator.UpdateStatusHistoricalComplete,
 			}, nil
 		},
 	}
 }
 
 // CreateLinkToken creates a mock link token
-func (m *MockPlaidClient) CreateLinkToken(ctx context.Context, userID string) (string, error) {
+func (m *MockPlaidClient) CreateLinkToken(ctx context.Context, userID string, daysRequested int) (string, error) {
 	if m.CreateLinkTokenFn != nil {
-		return m.CreateLinkTokenFn(ctx, userID)
+		return m.CreateLinkTokenFn(ctx, userID, daysRequested)
 	}
 	return "link-sandbox-test-token", nil
 }
 
+// RequireLogin simulates an item entering ITEM_LOGIN_REQUIRED:
+// requests with the access token fail until an update link token is created for it
+func (m *MockPlaidClient) RequireLogin(accessToken string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.loginRequired == nil {
+		m.loginRequired = make(map[string]bool)
+	}
+	m.loginRequired[accessToken] = true
+}
+
+// checkLogin returns ITEM_LOGIN_REQUIRED for items marked with RequireLogin
+func (m *MockPlaidClient) checkLogin(accessToken string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.loginRequired[accessToken] {
+		return &Error{
+			Type:    "ITEM_ERROR",
+			Code:    "ITEM_LOGIN_REQUIRED",
+			Message: "the login details of this item have changed",
+		}
+	}
+	return nil
+}
+
+// CreateUpdateLinkToken creates a mock update-mode link token
+// The mock treats the item as repaired right away, as if the user completed Link
+func (m *MockPlaidClient) CreateUpdateLinkToken(ctx context.Context, userID, accessToken string) (string, error) {
+	m.mu.Lock()
+	delete(m.loginRequired, accessToken)
+	m.mu.Unlock()
+
+	if m.CreateUpdateLinkTokenFn != nil {
+		return m.CreateUpdateLinkTokenFn(ctx, userID, accessToken)
+	}
+	return "link-sandbox-update-token", nil
+}
+
 // ExchangePublicToken exchanges a mock public token
 func (m *MockPlaidClient) ExchangePublicToken(ctx context.Context, publicToken string) (*TokenExchangeResult, error) {
 	if m.ExchangePublicTokenFn != nil {
@@ -113,24 +175,32 @@ func (m *MockPlaidClient) ExchangePublicToken(ctx context.Context, publicToken s
 }
 
 // GetAccounts retrieves mock accounts
-func (m *MockPlaidClient) GetAccounts(ctx context.Context, accessToken string) ([]Account, error) {
+func (m *MockPlaidClient) GetAccounts(ctx context.Context, accessToken string) ([]aggregator.Account, error) {
+	if err := m.checkLogin(accessToken); err != nil {
+		return nil, err
+	}
 	if m.GetAccountsFn != nil {
 		return m.GetAccountsFn(ctx, accessToken)
 	}
-	return []Account{}, nil
+	return []aggregator.Account{}, nil
 }
 
 // SyncTransactions retrieves mock transactions
-func (m *MockPlaidClient) SyncTransactions(ctx context.Context, accessToken, cursor string) (*TransactionSyncResult, error) {
+func (m *MockPlaidClient) SyncTransactions(ctx context.Context, accessToken, cursor string) (*aggregator.TransactionSyncResult, error) {
+	if err := m.checkLogin(accessToken); err != nil {
+		return nil, err
+	}
 	if m.SyncTransactionsFn != nil {
 		return m.SyncTransactionsFn(ctx, accessToken, cursor)
 	}
-	return &TransactionSyncResult{
-		Added:    []Transaction{},
-		Modified: []Transaction{},
+	return &aggregator.TransactionSyncResult{
+		Added:    []aggregator.Transaction{},
+		Modified: []aggregator.Transaction{},
 		Removed:  []string{},
 		Cursor:   "mock-cursor",
 		HasMore:  false,
+
+		UpdateStatus: aggregator.UpdateStatusHistoricalComplete,
 	}, nil
 }
 
@@ -142,3 +212,49 @@ func (m *MockPlaidClient) RefreshTransactions(ctx context.Context, accessToken s
 	// Mock implementation does nothing
 	return nil
 }
+
+// RemoveItem removes a mock item
+func (m *MockPlaidClient) RemoveItem(ctx context.Context, accessToken string) error {
+	m.mu.Lock()
+	delete(m.loginRequired, accessToken)
+	m.mu.Unlock()
+
+	if m.RemoveItemFn != nil {
+		return m.RemoveItemFn(ctx, accessToken)
+	}
+	// Mock implementation does nothing
+	return nil
+}
+
+// GetItem retrieves a mock item at the sandbox test institution
+func (m *MockPlaidClient) GetItem(ctx context.Context, accessToken string) (*aggregator.ItemInfo, error) {
+	if m.GetItemFn != nil {
+		return m.GetItemFn(ctx, accessToken)
+	}
+	return &aggregator.ItemInfo{
+		ItemID:        "item-sandbox-test-id",
+		InstitutionID: "ins_109508",
+	}, nil
+}
+
+// GetInstitution retrieves a mock institution
+func (m *MockPlaidClient) GetInstitution(ctx context.Context, institutionID string) (*aggregator.Institution, error) {
+	if m.GetInstitutionFn != nil {
+		return m.GetInstitutionFn(ctx, institutionID)
+	}
+	return &aggregator.Institution{
+		ID:           institutionID,
+		Name:         "First Platypus Bank",
+		PrimaryColor: "#1f1f1f",
+		URL:          "https://plaid.com",
+	}, nil
+}
+
+// GetWebhookVerificationKey retrieves a mock webhook verification key
+// The mock client has no key by default, so webhooks are rejected unless GetWebhookVerificationKeyFn is set
+func (m *MockPlaidClient) GetWebhookVerificationKey(ctx context.Context, keyID string) (*WebhookVerificationKey, error) {
+	if m.GetWebhookVerificationKeyFn != nil {
+		return m.GetWebhookVerificationKeyFn(ctx, keyID)
+	}
+	return nil, errors.New("mock client has no webhook verification key")
+}
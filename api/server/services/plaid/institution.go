@@ -0,0 +1,73 @@
+package plaid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/rs/zerolog/log"
+
+	"regulation/server/services/aggregator"
+)
+
+const (
+	// institutionKeyPrefix prefixes the Redis key caching an institution
+	institutionKeyPrefix = "plaid:institution:"
+	// institutionCacheTTL is how long institution metadata is cached; names and logos rarely change
+	institutionCacheTTL = 24 * time.Hour
+)
+
+// institutionCachingClient serves institutions from Redis before asking Plaid
+type institutionCachingClient struct {
+	Client
+	cache rueidis.Client
+}
+
+// NewInstitutionCachingClient wraps a Client so institution lookups are cached in Redis
+func NewInstitutionCachingClient(client Client, cache rueidis.Client) Client {
+	return &institutionCachingClient{
+		Client: client,
+		cache:  cache,
+	}
+}
+
+// GetInstitution retrieves an institution from the cache, falling back to Plaid
+// Cache failures are logged and never fail the lookup
+func (c *institutionCachingClient) GetInstitution(ctx context.Context, institutionID string) (*aggregator.Institution, error) {
+	key := institutionKeyPrefix + institutionID
+
+	cached, err := c.cache.Do(ctx, c.cache.B().Get().Key(key).Build()).AsBytes()
+	if err == nil {
+		var institution aggregator.Institution
+		if err := json.Unmarshal(cached, &institution); err == nil {
+			return &institution, nil
+		}
+	} else if !rueidis.IsRedisNil(err) {
+		log.Warn().
+			Err(err).
+			Str("institution_id", institutionID).
+			Msg("Failed to read cached institution")
+	}
+
+	institution, err := c.Client.GetInstitution(ctx, institutionID)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(institution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode institution: %w", err)
+	}
+
+	err = c.cache.Do(ctx, c.cache.B().Set().Key(key).Value(string(data)).Ex(institutionCacheTTL).Build()).Error()
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("institution_id", institutionID).
+			Msg("Failed to cache institution")
+	}
+
+	return institution, nil
+}
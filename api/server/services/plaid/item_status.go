@@ -0,0 +1,49 @@
+package plaid
+
+import (
+	"errors"
+
+	entitem "regulation/internal/ent/item"
+)
+
+// Plaid error types and codes that indicate the health of an item
+const (
+	errorTypeInstitution = "INSTITUTION_ERROR"
+	errorTypeRateLimit   = "RATE_LIMIT_EXCEEDED"
+	// errorCodeItemNotFound is returned for items that were already removed
+	errorCodeItemNotFound = "ITEM_NOT_FOUND"
+)
+
+// loginRequiredCodes are item errors only the user can resolve through Link update mode
+var loginRequiredCodes = map[string]bool{
+	"ITEM_LOGIN_REQUIRED": true,
+	"INVALID_CREDENTIALS": true,
+	"INVALID_MFA":         true,
+	"ITEM_LOCKED":         true,
+	"USER_SETUP_REQUIRED": true,
+}
+
+// classifyCode maps a Plaid error type and code to the item status it indicates
+// Returns false for errors that say nothing about the item's health
+func classifyCode(errorType, errorCode string) (entitem.Status, bool) {
+	switch {
+	case loginRequiredCodes[errorCode]:
+		return entitem.StatusLoginRequired, true
+	case errorType == errorTypeInstitution:
+		return entitem.StatusInstitutionDown, true
+	case errorType == errorTypeRateLimit:
+		return entitem.StatusRateLimited, true
+	default:
+		return "", false
+	}
+}
+
+// ClassifyError maps a sync error to the item status it indicates
+// Returns false for errors that are not Plaid item errors
+func ClassifyError(err error) (entitem.Status, bool) {
+	var plaidErr *Error
+	if !errors.As(err, &plaidErr) {
+		return "", false
+	}
+	return classifyCode(plaidErr.Type, plaidErr.Code)
+}
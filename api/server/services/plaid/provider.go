@@ -0,0 +1,43 @@
+package plaid
+
+import (
+	"context"
+	"errors"
+
+	entitem "regulation/internal/ent/item"
+	"regulation/server/services/aggregator"
+)
+
+// ProviderName is the provider of items linked through Plaid
+const ProviderName = "plaid"
+
+// provider syncs items through the Plaid API
+type provider struct {
+	Client
+}
+
+// NewProvider wraps a Client as the Plaid aggregator provider
+func NewProvider(client Client) aggregator.AggregatorProvider {
+	return &provider{Client: client}
+}
+
+// Name identifies Plaid as the provider of an item
+func (p *provider) Name() string {
+	return ProviderName
+}
+
+// RemoveItem revokes an item's access token, treating items Plaid already removed as revoked
+func (p *provider) RemoveItem(ctx context.Context, accessToken string) error {
+	err := p.Client.RemoveItem(ctx, accessToken)
+
+	var plaidErr *Error
+	if errors.As(err, &plaidErr) && plaidErr.Code == errorCodeItemNotFound {
+		return nil
+	}
+	return err
+}
+
+// ClassifyError maps a Plaid error to the item status it indicates
+func (p *provider) ClassifyError(err error) (entitem.Status, bool) {
+	return ClassifyError(err)
+}
@@ -0,0 +1,183 @@
+package plaid
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/rs/zerolog/log"
+
+	"regulation/server/services/aggregator"
+)
+
+// rateLimitKey is the Redis key holding the state of the Plaid rate limit shared by every replica
+const rateLimitKey = "plaid:rate_limit"
+
+// rateLimitScript reserves a request slot with the generic cell rate algorithm: the key holds the theoretical
+// arrival time of the next request in microseconds of Redis' clock, which every replica shares.
+// Returns 0 when the request may be made, otherwise how many microseconds to wait before trying again.
+var rateLimitScript = rueidis.NewLuaScript(`
+local now = redis.call('TIME')
+now = tonumber(now[1]) * 1000000 + tonumber(now[2])
+local interval = tonumber(ARGV[1])
+local tolerance = interval * (tonumber(ARGV[2]) - 1)
+
+local tat = tonumber(redis.call('GET', KEYS[1]) or now)
+if tat < now then
+	tat = now
+end
+
+if tat - tolerance > now then
+	return tat - tolerance - now
+end
+
+tat = tat + interval
+-- Formatted explicitly since Lua would write the microsecond timestamp in exponent notation
+redis.call('SET', KEYS[1], string.format('%.0f', tat), 'PX', math.ceil((tat - now) / 1000) + 1)
+return 0
+`)
+
+// RateLimiter bounds the Plaid requests of every replica together, keeping its state in Redis
+type RateLimiter struct {
+	cache rueidis.Client
+	// interval is the time between requests at the average rate, in microseconds
+	interval int64
+	burst    int
+}
+
+// NewRateLimiter creates a limiter allowing requestsPerSecond on average across all replicas,
+// with bursts up to burst
+func NewRateLimiter(cache rueidis.Client, requestsPerSecond float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		cache:    cache,
+		interval: int64(float64(time.Second/time.Microsecond) / requestsPerSecond),
+		burst:    max(burst, 1),
+	}
+}
+
+// Wait blocks until a request may be made or ctx is cancelled
+// Redis failures are logged and let the request through, so Plaid's own limit is the fallback
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		wait, err := rateLimitScript.Exec(ctx, l.cache, []string{rateLimitKey}, []string{
+			strconv.FormatInt(l.interval, 10),
+			strconv.Itoa(l.burst),
+		}).AsInt64()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Warn().
+				Err(err).
+				Msg("Failed to reserve Plaid rate limit, sending request anyway")
+			return nil
+		}
+
+		if wait <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(time.Duration(wait) * time.Microsecond)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// rateLimitedClient waits on a shared RateLimiter before every Plaid request
+type rateLimitedClient struct {
+	client  Client
+	limiter *RateLimiter
+}
+
+// NewRateLimitedClient wraps a Client so all its requests share the limiter
+func NewRateLimitedClient(client Client, limiter *RateLimiter) Client {
+	return &rateLimitedClient{
+		client:  client,
+		limiter: limiter,
+	}
+}
+
+// CreateLinkToken creates a link token for initializing Plaid Link
+func (c *rateLimitedClient) CreateLinkToken(ctx context.Context, userID string, daysRequested int) (string, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	return c.client.CreateLinkToken(ctx, userID, daysRequested)
+}
+
+// CreateUpdateLinkToken creates a link token that opens Plaid Link in update mode
+func (c *rateLimitedClient) CreateUpdateLinkToken(ctx context.Context, userID, accessToken string) (string, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return "", err
+	}
+	return c.client.CreateUpdateLinkToken(ctx, userID, accessToken)
+}
+
+// ExchangePublicToken exchanges a public token for an access token
+func (c *rateLimitedClient) ExchangePublicToken(ctx context.Context, publicToken string) (*TokenExchangeResult, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.ExchangePublicToken(ctx, publicToken)
+}
+
+// GetAccounts retrieves all accounts for an access token
+func (c *rateLimitedClient) GetAccounts(ctx context.Context, accessToken string) ([]aggregator.Account, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetAccounts(ctx, accessToken)
+}
+
+// SyncTransactions retrieves transactions using the sync endpoint
+func (c *rateLimitedClient) SyncTransactions(ctx context.Context, accessToken, cursor string) (*aggregator.TransactionSyncResult, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.SyncTransactions(ctx, accessToken, cursor)
+}
+
+// RefreshTransactions triggers a refresh of transactions
+func (c *rateLimitedClient) RefreshTransactions(ctx context.Context, accessToken string) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	return c.client.RefreshTransactions(ctx, accessToken)
+}
+
+// RemoveItem removes an item at Plaid, invalidating its access token
+func (c *rateLimitedClient) RemoveItem(ctx context.Context, accessToken string) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	return c.client.RemoveItem(ctx, accessToken)
+}
+
+// GetItem retrieves the Plaid item an access token belongs to
+func (c *rateLimitedClient) GetItem(ctx context.Context, accessToken string) (*aggregator.ItemInfo, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetItem(ctx, accessToken)
+}
+
+// GetInstitution retrieves an institution's name and branding
+func (c *rateLimitedClient) GetInstitution(ctx context.Context, institutionID string) (*aggregator.Institution, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetInstitution(ctx, institutionID)
+}
+
+// GetWebhookVerificationKey retrieves the public key Plaid signed a webhook with
+func (c *rateLimitedClient) GetWebhookVerificationKey(ctx context.Context, keyID string) (*WebhookVerificationKey, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.client.GetWebhookVerificationKey(ctx, keyID)
+}
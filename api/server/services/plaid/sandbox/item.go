@@ -0,0 +1,262 @@
+package sandbox
+
+import (
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"regulation/internal/currency"
+)
+
+// item is a linked item replaying the scenario from the moment it was linked
+type item struct {
+	id          string
+	accessToken string
+	scenario    *Scenario
+	// suffix keeps account and transaction IDs unique across items, as Plaid's are
+	suffix string
+
+	accounts []Account
+	err      *Error
+
+	// released is the number of steps released, nextAt is when the next one is due
+	released int
+	nextAt   time.Time
+	// changes is the log transactions/sync pages through; a cursor is an offset into it
+	changes []change
+}
+
+// change is one added, modified or removed transaction
+type change struct {
+	kind changeKind
+	// transaction is the Plaid JSON of added and modified transactions
+	transaction map[string]any
+	id          string
+}
+
+type changeKind int
+
+const (
+	changeAdded changeKind = iota
+	changeModified
+	changeRemoved
+)
+
+func newItem(scenario *Scenario, suffix string, linkedAt time.Time) *item {
+	it := &item{
+		id:          "item-sandbox-" + suffix,
+		accessToken: "access-sandbox-" + uuid.NewString(),
+		scenario:    scenario,
+		suffix:      suffix,
+		accounts:    append([]Account(nil), scenario.Accounts...),
+	}
+
+	if len(scenario.Steps) > 0 {
+		it.nextAt = linkedAt.Add(time.Duration(scenario.Steps[0].After))
+	}
+
+	return it
+}
+
+// advance releases every step that is due, in scripted time so a late request sees steps spaced as scripted
+// Nothing is released while the item has an error, as Plaid stops updating items that need attention
+func (it *item) advance(now time.Time) {
+	for it.err == nil && it.released < len(it.scenario.Steps) && !now.Before(it.nextAt) {
+		it.release(it.nextAt)
+	}
+}
+
+// releaseNext releases the next step immediately
+func (it *item) releaseNext(now time.Time) {
+	it.advance(now)
+	if it.err == nil && it.released < len(it.scenario.Steps) {
+		it.release(now)
+	}
+}
+
+// release applies the next step as of at and schedules the one after it
+func (it *item) release(at time.Time) {
+	step := it.scenario.Steps[it.released]
+	it.released++
+
+	for _, txn := range step.Added {
+		it.changes = append(it.changes, change{kind: changeAdded, id: txn.ID, transaction: it.transactionJSON(txn, at)})
+	}
+	for _, txn := range step.Modified {
+		it.changes = append(it.changes, change{kind: changeModified, id: txn.ID, transaction: it.transactionJSON(txn, at)})
+	}
+	for _, id := range step.Removed {
+		it.changes = append(it.changes, change{kind: changeRemoved, id: id})
+	}
+
+	for i := range it.accounts {
+		if balance, ok := step.Balances[it.accounts[i].ID]; ok {
+			it.accounts[i].Current = balance
+		}
+	}
+
+	if step.Error != nil {
+		it.err = step.Error
+	}
+
+	if it.released < len(it.scenario.Steps) {
+		it.nextAt = at.Add(time.Duration(it.scenario.Steps[it.released].After))
+	}
+}
+
+// syncPage is one page of transactions/sync
+type syncPage struct {
+	added      []map[string]any
+	modified   []map[string]any
+	removed    []map[string]any
+	nextCursor string
+	hasMore    bool
+}
+
+// page returns up to count changes after cursor
+// A page ends early before a transaction it already contains, since a page's added, modified and removed
+// lists lose the order in which one transaction changed
+func (it *item) page(cursor string, count int) (*syncPage, error) {
+	offset := 0
+	if cursor != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+		if err == nil {
+			offset, err = strconv.Atoi(string(decoded))
+		}
+		if err != nil || offset < 0 || offset > len(it.changes) {
+			return nil, newError("INVALID_REQUEST", "INVALID_FIELD", "cursor is not valid for this item")
+		}
+	}
+
+	page := &syncPage{
+		added:    []map[string]any{},
+		modified: []map[string]any{},
+		removed:  []map[string]any{},
+	}
+
+	seen := make(map[string]bool)
+	end := offset
+	for end < len(it.changes) && end-offset < count {
+		c := it.changes[end]
+		if seen[c.id] {
+			break
+		}
+		seen[c.id] = true
+
+		switch c.kind {
+		case changeAdded:
+			page.added = append(page.added, c.transaction)
+		case changeModified:
+			page.modified = append(page.modified, c.transaction)
+		case changeRemoved:
+			page.removed = append(page.removed, map[string]any{"transaction_id": it.externalID(c.id)})
+		}
+		end++
+	}
+
+	page.nextCursor = base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+	page.hasMore = end < len(it.changes)
+
+	return page, nil
+}
+
+// externalID returns the Plaid ID of a scenario account or transaction
+func (it *item) externalID(id string) string {
+	return id + "-" + it.suffix
+}
+
+// account returns the scenario account with an ID
+func (it *item) account(id string) Account {
+	for _, account := range it.accounts {
+		if account.ID == id {
+			return account
+		}
+	}
+	return Account{}
+}
+
+// transactionJSON renders a scripted transaction as Plaid does, dated relative to release when it has no date
+func (it *item) transactionJSON(txn Transaction, releasedAt time.Time) map[string]any {
+	date := txn.Date
+	if date == "" {
+		date = releasedAt.AddDate(0, 0, -txn.DaysAgo).Format(time.DateOnly)
+	}
+
+	channel := txn.Channel
+	if channel == "" {
+		channel = "other"
+	}
+
+	var pendingID any
+	if txn.PendingID != "" {
+		pendingID = it.externalID(txn.PendingID)
+	}
+
+	category := txn.Category
+	if category == nil {
+		category = []string{}
+	}
+
+	return map[string]any{
+		"transaction_id":           it.externalID(txn.ID),
+		"account_id":               it.externalID(txn.Account),
+		"amount":                   txn.Amount,
+		"iso_currency_code":        currency.Normalize(it.account(txn.Account).Currency),
+		"unofficial_currency_code": nil,
+		"date":                     date,
+		"authorized_date":          nil,
+		"name":                     txn.Name,
+		"merchant_name":            nilIfEmpty(txn.Merchant),
+		"category":                 category,
+		"pending":                  txn.Pending,
+		"pending_transaction_id":   pendingID,
+		"payment_channel":          channel,
+	}
+}
+
+func (it *item) accountsJSON() []map[string]any {
+	accounts := make([]map[string]any, 0, len(it.accounts))
+	for _, account := range it.accounts {
+		accounts = append(accounts, map[string]any{
+			"account_id": it.externalID(account.ID),
+			"balances": map[string]any{
+				"current":                  account.Current,
+				"available":                account.Available,
+				"limit":                    account.Limit,
+				"iso_currency_code":        currency.Normalize(account.Currency),
+				"unofficial_currency_code": nil,
+			},
+			"mask":          nilIfEmpty(account.Mask),
+			"name":          account.Name,
+			"official_name": nil,
+			"type":          account.Type,
+			"subtype":       nilIfEmpty(account.Subtype),
+		})
+	}
+	return accounts
+}
+
+func (it *item) itemJSON() map[string]any {
+	var itemErr any
+	if it.err != nil {
+		itemErr = map[string]any{
+			"error_type":    it.err.Type,
+			"error_code":    it.err.Code,
+			"error_message": it.err.Message,
+		}
+	}
+
+	return map[string]any{
+		"item_id":                 it.id,
+		"institution_id":          it.scenario.Institution.ID,
+		"webhook":                 nil,
+		"error":                   itemErr,
+		"available_products":      []string{},
+		"billed_products":         []string{"transactions"},
+		"products":                []string{"transactions"},
+		"consent_expiration_time": nil,
+		"update_type":             "background",
+	}
+}
@@ -0,0 +1,251 @@
+// Package sandbox is a local stand-in for Plaid's API, so the real Plaid client can run end-to-end
+// without network access. Every linked item replays a scenario: a script of accounts and of
+// transactions being added, modified and removed over time.
+package sandbox
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+//go:embed scenarios/default.json
+var defaultScenario []byte
+
+// Scenario scripts what every item linked against the sandbox sees
+type Scenario struct {
+	Institution Institution `json:"institution"`
+	Accounts    []Account   `json:"accounts"`
+	// Steps are released in order, each once its delay has passed or when the item is refreshed
+	Steps []Step `json:"steps"`
+}
+
+// Institution is the bank items are linked to
+type Institution struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	URL          string `json:"url,omitempty"`
+	PrimaryColor string `json:"primary_color,omitempty"`
+}
+
+// Account is an account of the scripted item, with balances in dollars like Plaid reports them
+type Account struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`    // depository, credit, loan, investment
+	Subtype string `json:"subtype"` // e.g. checking, savings, credit card
+	Mask    string `json:"mask"`
+	// Currency is an ISO 4217 code, USD when empty
+	Currency  string   `json:"currency,omitempty"`
+	Current   float64  `json:"current"`
+	Available *float64 `json:"available,omitempty"`
+	Limit     *float64 `json:"limit,omitempty"`
+}
+
+// Step is one update of the item's transactions
+type Step struct {
+	// After is how long after the previous step, or after linking for the first step, this step is released
+	After Duration `json:"after,omitempty"`
+	// Added, Modified and Removed are the changes the next transactions/sync returns
+	Added    []Transaction `json:"added,omitempty"`
+	Modified []Transaction `json:"modified,omitempty"`
+	Removed  []string      `json:"removed,omitempty"`
+	// Balances replaces the current balance of accounts by ID
+	Balances map[string]float64 `json:"balances,omitempty"`
+	// Error puts the item into an error state until it is repaired through an update mode link token
+	Error *Error `json:"error,omitempty"`
+}
+
+// Transaction is a scripted transaction; amounts are in dollars and positive for money leaving the account
+type Transaction struct {
+	ID      string  `json:"id"`
+	Account string  `json:"account"`
+	Amount  float64 `json:"amount"`
+	// Date is YYYY-MM-DD; when empty the transaction is dated DaysAgo days before its step is released
+	Date     string   `json:"date,omitempty"`
+	DaysAgo  int      `json:"days_ago,omitempty"`
+	Name     string   `json:"name"`
+	Merchant string   `json:"merchant,omitempty"`
+	Category []string `json:"category,omitempty"`
+	Pending  bool     `json:"pending,omitempty"`
+	// PendingID is the pending transaction this one posted from
+	PendingID string `json:"pending_id,omitempty"`
+	// Channel is the payment channel: online, in store or other
+	Channel string `json:"channel,omitempty"`
+}
+
+// Error is a Plaid error an item fails with
+type Error struct {
+	Type    string `json:"type"` // e.g. ITEM_ERROR, INSTITUTION_ERROR
+	Code    string `json:"code"` // e.g. ITEM_LOGIN_REQUIRED, INSTITUTION_DOWN
+	Message string `json:"message,omitempty"`
+}
+
+// Duration is a time.Duration written as a string such as "90s" or "24h" in scenario files
+type Duration time.Duration
+
+// UnmarshalJSON parses a duration string
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("duration must be a string such as \"1h\": %w", err)
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	*d = Duration(parsed)
+	return nil
+}
+
+// MarshalJSON writes the duration as a string
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// DefaultScenario returns the scenario used when none is given: a checking and a savings account
+// with a month of history, followed by a pending purchase that posts, a refund that is reversed and
+// finally a login error that is repaired by creating an update mode link token
+func DefaultScenario() *Scenario {
+	scenario, err := ParseScenario(defaultScenario)
+	if err != nil {
+		panic(fmt.Sprintf("invalid default sandbox scenario: %v", err))
+	}
+	return scenario
+}
+
+// LoadScenario reads a scenario file
+func LoadScenario(path string) (*Scenario, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scenario: %w", err)
+	}
+
+	scenario, err := ParseScenario(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return scenario, nil
+}
+
+// ParseScenario decodes and validates a scenario
+func ParseScenario(data []byte) (*Scenario, error) {
+	var scenario Scenario
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		return nil, fmt.Errorf("failed to parse scenario: %w", err)
+	}
+
+	if err := scenario.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid scenario: %w", err)
+	}
+
+	return &scenario, nil
+}
+
+// Validate ensures Scenario only refers to accounts and transactions it defines
+func (s *Scenario) Validate() error {
+	if err := validation.ValidateStruct(s,
+		validation.Field(&s.Institution),
+		validation.Field(&s.Accounts, validation.Required),
+	); err != nil {
+		return err
+	}
+
+	accounts := make(map[string]bool, len(s.Accounts))
+	for _, account := range s.Accounts {
+		if accounts[account.ID] {
+			return fmt.Errorf("account %q is defined twice", account.ID)
+		}
+		accounts[account.ID] = true
+	}
+
+	// Transactions must be added before they are modified or removed, and never added twice
+	transactions := make(map[string]bool)
+	for i, step := range s.Steps {
+		if step.After < 0 {
+			return fmt.Errorf("step %d: after must not be negative", i+1)
+		}
+
+		for _, txn := range step.Added {
+			if err := txn.validate(accounts); err != nil {
+				return fmt.Errorf("step %d: %w", i+1, err)
+			}
+			if transactions[txn.ID] {
+				return fmt.Errorf("step %d: transaction %q is added twice", i+1, txn.ID)
+			}
+			transactions[txn.ID] = true
+		}
+
+		for _, txn := range step.Modified {
+			if err := txn.validate(accounts); err != nil {
+				return fmt.Errorf("step %d: %w", i+1, err)
+			}
+			if !transactions[txn.ID] {
+				return fmt.Errorf("step %d: modified transaction %q was never added", i+1, txn.ID)
+			}
+		}
+
+		for _, id := range step.Removed {
+			if !transactions[id] {
+				return fmt.Errorf("step %d: removed transaction %q was never added", i+1, id)
+			}
+			delete(transactions, id)
+		}
+
+		for id := range step.Balances {
+			if !accounts[id] {
+				return fmt.Errorf("step %d: balance of unknown account %q", i+1, id)
+			}
+		}
+
+		if step.Error != nil && (step.Error.Type == "" || step.Error.Code == "") {
+			return fmt.Errorf("step %d: error type and code are required", i+1)
+		}
+	}
+
+	return nil
+}
+
+// Validate ensures Institution can be looked up
+func (i Institution) Validate() error {
+	return validation.ValidateStruct(&i,
+		validation.Field(&i.ID, validation.Required),
+		validation.Field(&i.Name, validation.Required),
+	)
+}
+
+// Validate ensures Account has the fields Plaid always reports
+func (a Account) Validate() error {
+	return validation.ValidateStruct(&a,
+		validation.Field(&a.ID, validation.Required),
+		validation.Field(&a.Name, validation.Required),
+		validation.Field(&a.Type, validation.Required, validation.In("depository", "credit", "loan", "investment", "other")),
+	)
+}
+
+// validate ensures a transaction belongs to a scripted account and can be dated
+func (t Transaction) validate(accounts map[string]bool) error {
+	if t.ID == "" {
+		return errors.New("transaction id is required")
+	}
+	if !accounts[t.Account] {
+		return fmt.Errorf("transaction %q belongs to unknown account %q", t.ID, t.Account)
+	}
+	if t.Name == "" {
+		return fmt.Errorf("transaction %q has no name", t.ID)
+	}
+	if t.Date != "" {
+		if _, err := time.Parse(time.DateOnly, t.Date); err != nil {
+			return fmt.Errorf("transaction %q has an invalid date %q", t.ID, t.Date)
+		}
+	}
+	return nil
+}
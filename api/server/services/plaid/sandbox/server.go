@@ -0,0 +1,392 @@
+package sandbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+)
+
+// Server serves the subset of Plaid's API the Plaid client calls, replaying a scenario for every item
+// Point the client at it through the Plaid config's base_url or PLAID_BASE_URL
+type Server struct {
+	scenario *Scenario
+	mux      *http.ServeMux
+	server   *http.Server
+
+	mu    sync.Mutex
+	items map[string]*item // by access token
+	// usedPublicTokens holds public tokens already exchanged, which Plaid only accepts once
+	usedPublicTokens map[string]bool
+	// removed holds access tokens of removed items
+	removed map[string]bool
+}
+
+// New creates a sandbox server replaying scenario, the default scenario when nil
+func New(scenario *Scenario) *Server {
+	if scenario == nil {
+		scenario = DefaultScenario()
+	}
+
+	s := &Server{
+		scenario:         scenario,
+		mux:              http.NewServeMux(),
+		items:            make(map[string]*item),
+		usedPublicTokens: make(map[string]bool),
+		removed:          make(map[string]bool),
+	}
+
+	s.handle("/link/token/create", s.createLinkToken)
+	s.handle("/item/public_token/exchange", s.exchangePublicToken)
+	s.handle("/sandbox/public_token/create", s.createPublicToken)
+	s.handle("/accounts/get", s.getAccounts)
+	s.handle("/transactions/sync", s.syncTransactions)
+	s.handle("/transactions/refresh", s.refreshTransactions)
+	s.handle("/item/get", s.getItem)
+	s.handle("/item/remove", s.removeItem)
+	s.handle("/institutions/get_by_id", s.getInstitution)
+
+	return s
+}
+
+// Start listens on addr, e.g. "127.0.0.1:0" for any free port, and returns the base URL to configure the client with
+func (s *Server) Start(addr string) (string, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", fmt.Errorf("failed to listen on %s: %w", addr, err)
+	}
+
+	s.server = &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		if err := s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msg("[SANDBOX] Server stopped")
+		}
+	}()
+
+	return "http://" + listener.Addr().String(), nil
+}
+
+// Close stops a server started with Start
+func (s *Server) Close() error {
+	if s.server == nil {
+		return nil
+	}
+	return s.server.Shutdown(context.Background())
+}
+
+// ServeHTTP lets the server be mounted in another server or an httptest.Server
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// request holds the fields of every endpoint's request body the sandbox reads
+type request struct {
+	ClientID      string `json:"client_id"`
+	Secret        string `json:"secret"`
+	AccessToken   string `json:"access_token"`
+	PublicToken   string `json:"public_token"`
+	Cursor        string `json:"cursor"`
+	Count         int    `json:"count"`
+	InstitutionID string `json:"institution_id"`
+}
+
+// apiError is an error response in Plaid's format
+type apiError struct {
+	status  int
+	Type    string
+	Code    string
+	Message string
+}
+
+func (e *apiError) Error() string {
+	return e.Code + ": " + e.Message
+}
+
+// newError creates a Plaid error returned with status 400, as Plaid returns most errors
+func newError(errorType, code, message string) *apiError {
+	return &apiError{status: http.StatusBadRequest, Type: errorType, Code: code, Message: message}
+}
+
+// handle registers a POST endpoint that authenticates the caller and writes its response as JSON
+func (s *Server) handle(path string, fn func(req *request) (map[string]any, error)) {
+	s.mux.HandleFunc("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		requestID := uuid.NewString()
+
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeError(w, requestID, newError("INVALID_REQUEST", "INVALID_BODY", "request body is not valid JSON"))
+			return
+		}
+
+		// The client sends its keys as headers, Plaid also accepts them in the body
+		if r.Header.Get("PLAID-CLIENT-ID") == "" && req.ClientID == "" || r.Header.Get("PLAID-SECRET") == "" && req.Secret == "" {
+			writeError(w, requestID, newError("INVALID_INPUT", "INVALID_API_KEYS", "client_id and secret are required"))
+			return
+		}
+
+		resp, err := fn(&req)
+		if err != nil {
+			var plaidErr *apiError
+			if !errors.As(err, &plaidErr) {
+				plaidErr = &apiError{status: http.StatusInternalServerError, Type: "API_ERROR", Code: "INTERNAL_SERVER_ERROR", Message: err.Error()}
+			}
+			log.Debug().Str("path", path).Str("code", plaidErr.Code).Msg("[SANDBOX] Request failed")
+			writeError(w, requestID, plaidErr)
+			return
+		}
+
+		resp["request_id"] = requestID
+		writeJSON(w, http.StatusOK, resp)
+	})
+}
+
+func writeError(w http.ResponseWriter, requestID string, err *apiError) {
+	writeJSON(w, err.status, map[string]any{
+		"error_type":      err.Type,
+		"error_code":      err.Code,
+		"error_message":   err.Message,
+		"display_message": nil,
+		"request_id":      requestID,
+		"causes":          []any{},
+		"status":          err.status,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Error().Err(err).Msg("[SANDBOX] Failed to write response")
+	}
+}
+
+// createLinkToken issues a link token; in update mode, with an access token, the item counts as repaired
+// since the sandbox has no Link UI for the user to complete
+func (s *Server) createLinkToken(req *request) (map[string]any, error) {
+	if req.AccessToken != "" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		it, err := s.item(req.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		it.err = nil
+	}
+
+	return map[string]any{
+		"link_token": "link-sandbox-" + uuid.NewString(),
+		"expiration": time.Now().Add(4 * time.Hour).UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// createPublicToken issues a public token as if the user had completed Link
+func (s *Server) createPublicToken(req *request) (map[string]any, error) {
+	if req.InstitutionID != "" && req.InstitutionID != s.scenario.Institution.ID {
+		return nil, newError("INVALID_INPUT", "INVALID_INSTITUTION", "the sandbox scenario has no institution "+req.InstitutionID)
+	}
+
+	return map[string]any{
+		"public_token": "public-sandbox-" + uuid.NewString(),
+	}, nil
+}
+
+// exchangePublicToken links a new item for any public token not exchanged before
+func (s *Server) exchangePublicToken(req *request) (map[string]any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if req.PublicToken == "" || s.usedPublicTokens[req.PublicToken] {
+		return nil, newError("INVALID_INPUT", "INVALID_PUBLIC_TOKEN", "public token is missing or was already exchanged")
+	}
+	s.usedPublicTokens[req.PublicToken] = true
+
+	// A random suffix keeps item IDs unique across restarts, as they are stored once per provider
+	it := newItem(s.scenario, uuid.NewString()[:8], time.Now())
+	s.items[it.accessToken] = it
+
+	log.Info().Str("item_id", it.id).Msg("[SANDBOX] Linked item")
+
+	return map[string]any{
+		"access_token": it.accessToken,
+		"item_id":      it.id,
+	}, nil
+}
+
+func (s *Server) getAccounts(req *request) (map[string]any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it, err := s.healthyItem(req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"accounts": it.accountsJSON(),
+		"item":     it.itemJSON(),
+	}, nil
+}
+
+// syncTransactions pages through the changes released since the cursor
+func (s *Server) syncTransactions(req *request) (map[string]any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it, err := s.healthyItem(req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	count := req.Count
+	switch {
+	case count == 0:
+		count = 100
+	case count < 1 || count > 500:
+		return nil, newError("INVALID_REQUEST", "INVALID_FIELD", "count must be between 1 and 500")
+	}
+
+	page, err := it.page(req.Cursor, count)
+	if err != nil {
+		return nil, err
+	}
+
+	status := "HISTORICAL_UPDATE_COMPLETE"
+	if it.released == 0 {
+		status = "NOT_READY"
+	}
+
+	return map[string]any{
+		"transactions_update_status": status,
+		"accounts":                   it.accountsJSON(),
+		"added":                      page.added,
+		"modified":                   page.modified,
+		"removed":                    page.removed,
+		"next_cursor":                page.nextCursor,
+		"has_more":                   page.hasMore,
+	}, nil
+}
+
+// refreshTransactions releases the next step without waiting for its delay
+func (s *Server) refreshTransactions(req *request) (map[string]any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it, err := s.healthyItem(req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	it.releaseNext(time.Now())
+
+	return map[string]any{}, nil
+}
+
+func (s *Server) getItem(req *request) (map[string]any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it, err := s.item(req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	it.advance(time.Now())
+
+	return map[string]any{
+		"item":   it.itemJSON(),
+		"status": map[string]any{},
+	}, nil
+}
+
+func (s *Server) removeItem(req *request) (map[string]any, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	it, err := s.item(req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(s.items, it.accessToken)
+	s.removed[it.accessToken] = true
+
+	log.Info().Str("item_id", it.id).Msg("[SANDBOX] Removed item")
+
+	return map[string]any{}, nil
+}
+
+func (s *Server) getInstitution(req *request) (map[string]any, error) {
+	institution := s.scenario.Institution
+	if req.InstitutionID != institution.ID {
+		return nil, newError("INVALID_INPUT", "INVALID_INSTITUTION", "the sandbox scenario has no institution "+req.InstitutionID)
+	}
+
+	return map[string]any{
+		"institution": map[string]any{
+			"institution_id":  institution.ID,
+			"name":            institution.Name,
+			"products":        []string{"transactions"},
+			"country_codes":   []string{"US"},
+			"url":             nilIfEmpty(institution.URL),
+			"primary_color":   nilIfEmpty(institution.PrimaryColor),
+			"logo":            nil,
+			"routing_numbers": []string{},
+			"oauth":           false,
+		},
+	}, nil
+}
+
+// item returns the item of an access token
+// Callers must hold s.mu
+func (s *Server) item(accessToken string) (*item, error) {
+	if s.removed[accessToken] {
+		return nil, newError("ITEM_ERROR", "ITEM_NOT_FOUND", "the Item you requested cannot be found, it has been removed")
+	}
+
+	it, ok := s.items[accessToken]
+	if !ok {
+		return nil, newError("INVALID_INPUT", "INVALID_ACCESS_TOKEN", "provided access token is in an invalid format or does not exist")
+	}
+
+	return it, nil
+}
+
+// healthyItem returns the item of an access token once its due steps are released, failing with the
+// item's error while it has one
+// Callers must hold s.mu
+func (s *Server) healthyItem(accessToken string) (*item, error) {
+	it, err := s.item(accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	it.advance(time.Now())
+
+	if it.err != nil {
+		message := it.err.Message
+		if message == "" {
+			message = "the sandbox scenario put the item into an error state"
+		}
+		return nil, newError(it.err.Type, it.err.Code, message)
+	}
+
+	return it, nil
+}
+
+func nilIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
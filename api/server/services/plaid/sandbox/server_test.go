@@ -0,0 +1,252 @@
+package sandbox_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"regulation/internal/config"
+	entitem "regulation/internal/ent/item"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/plaid"
+	"regulation/server/services/plaid/sandbox"
+)
+
+// newClient starts a sandbox replaying the default scenario and returns the real Plaid client pointed at it
+func newClient(t *testing.T) plaid.Client {
+	t.Helper()
+
+	server := httptest.NewServer(sandbox.New(nil))
+	t.Cleanup(server.Close)
+
+	client, err := plaid.NewPlaidClient(&config.PlaidConfig{
+		ClientID:    "sandbox-client",
+		Secret:      "sandbox-secret",
+		Environment: "sandbox",
+		BaseURL:     server.URL,
+	})
+	if err != nil {
+		t.Fatalf("failed to create plaid client: %v", err)
+	}
+
+	return client
+}
+
+// link links an item the way the app does once the user completes Link with publicToken
+func link(t *testing.T, client plaid.Client, publicToken string) *plaid.TokenExchangeResult {
+	t.Helper()
+	ctx := context.Background()
+
+	if _, err := client.CreateLinkToken(ctx, "user-1", 90); err != nil {
+		t.Fatalf("failed to create link token: %v", err)
+	}
+
+	result, err := client.ExchangePublicToken(ctx, publicToken)
+	if err != nil {
+		t.Fatalf("failed to exchange public token: %v", err)
+	}
+
+	return result
+}
+
+// syncAll pages through every change after cursor, returning the pages and the cursor to continue from
+func syncAll(t *testing.T, client plaid.Client, accessToken, cursor string) ([]*aggregator.TransactionSyncResult, string) {
+	t.Helper()
+
+	var pages []*aggregator.TransactionSyncResult
+	for {
+		page, err := client.SyncTransactions(context.Background(), accessToken, cursor)
+		if err != nil {
+			t.Fatalf("failed to sync transactions: %v", err)
+		}
+		pages = append(pages, page)
+		cursor = page.Cursor
+
+		if !page.HasMore {
+			return pages, cursor
+		}
+	}
+}
+
+func TestLink(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+
+	first := link(t, client, "public-sandbox-1")
+	second := link(t, client, "public-sandbox-2")
+	if first.ItemID == second.ItemID || first.AccessToken == second.AccessToken {
+		t.Fatalf("linked items share IDs: %+v and %+v", first, second)
+	}
+
+	if _, err := client.ExchangePublicToken(ctx, "public-sandbox-1"); err == nil {
+		t.Fatal("exchanging a public token twice succeeded")
+	}
+
+	accounts, err := client.GetAccounts(ctx, first.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to get accounts: %v", err)
+	}
+	if len(accounts) != 2 {
+		t.Fatalf("got %d accounts, want 2", len(accounts))
+	}
+	if accounts[0].BalanceCurrent != 200000 {
+		t.Errorf("checking balance is %d cents, want 200000", accounts[0].BalanceCurrent)
+	}
+
+	item, err := client.GetItem(ctx, first.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to get item: %v", err)
+	}
+	if item.ItemID != first.ItemID {
+		t.Errorf("item ID is %q, want %q", item.ItemID, first.ItemID)
+	}
+
+	institution, err := client.GetInstitution(ctx, item.InstitutionID)
+	if err != nil {
+		t.Fatalf("failed to get institution: %v", err)
+	}
+	if institution.Name != "Sandbox Bank" {
+		t.Errorf("institution name is %q, want Sandbox Bank", institution.Name)
+	}
+}
+
+func TestSync(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	item := link(t, client, "public-sandbox-1")
+
+	// The month of history is released on link
+	pages, cursor := syncAll(t, client, item.AccessToken, "")
+	if len(pages) != 1 || len(pages[0].Added) != 14 {
+		t.Fatalf("initial sync returned %d pages, want 1 page of 14 transactions", len(pages))
+	}
+	if pages[0].UpdateStatus != aggregator.UpdateStatusHistoricalComplete {
+		t.Errorf("update status is %q, want %q", pages[0].UpdateStatus, aggregator.UpdateStatusHistoricalComplete)
+	}
+
+	// Nothing new until the next step is released
+	pages, cursor = syncAll(t, client, item.AccessToken, cursor)
+	if len(pages[0].Added)+len(pages[0].Modified)+len(pages[0].Removed) != 0 {
+		t.Fatalf("sync before the next step returned changes: %+v", pages[0])
+	}
+
+	// The pending purchase is added, then posts while the coffee is modified
+	for range 2 {
+		if err := client.RefreshTransactions(ctx, item.AccessToken); err != nil {
+			t.Fatalf("failed to refresh transactions: %v", err)
+		}
+	}
+
+	pages, cursor = syncAll(t, client, item.AccessToken, cursor)
+	// The coffee is added and modified in the same sync, so its changes span two pages
+	if len(pages) < 2 {
+		t.Fatalf("sync returned %d pages, want the changes split across pages", len(pages))
+	}
+
+	var pending, posted *aggregator.Transaction
+	removed := make(map[string]bool)
+	for _, page := range pages {
+		for i, txn := range page.Added {
+			switch {
+			case txn.Pending:
+				pending = &page.Added[i]
+			case txn.PendingTransactionID != "":
+				posted = &page.Added[i]
+			}
+		}
+		for _, id := range page.Removed {
+			removed[id] = true
+		}
+	}
+
+	if pending == nil || posted == nil {
+		t.Fatalf("sync did not return both the pending and the posted purchase")
+	}
+	if posted.PendingTransactionID != pending.TransactionID {
+		t.Errorf("posted purchase points at %q, want the pending purchase %q", posted.PendingTransactionID, pending.TransactionID)
+	}
+	if !removed[pending.TransactionID] {
+		t.Errorf("pending purchase %q was not removed once posted", pending.TransactionID)
+	}
+	if posted.Amount != 12999 || posted.Currency != "USD" {
+		t.Errorf("posted purchase is %d %s, want 12999 USD", posted.Amount, posted.Currency)
+	}
+
+	// The refund is reversed
+	if err := client.RefreshTransactions(ctx, item.AccessToken); err != nil {
+		t.Fatalf("failed to refresh transactions: %v", err)
+	}
+	pages, _ = syncAll(t, client, item.AccessToken, cursor)
+	if len(pages[0].Removed) != 1 {
+		t.Errorf("sync returned %d removed transactions, want the reversed refund", len(pages[0].Removed))
+	}
+
+	if _, err := client.SyncTransactions(ctx, item.AccessToken, "not-a-cursor"); err == nil {
+		t.Error("syncing with an invalid cursor succeeded")
+	}
+}
+
+func TestLoginRequired(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	item := link(t, client, "public-sandbox-1")
+
+	// Release every step up to the login error
+	for range 4 {
+		if err := client.RefreshTransactions(ctx, item.AccessToken); err != nil {
+			t.Fatalf("failed to refresh transactions: %v", err)
+		}
+	}
+
+	_, err := client.SyncTransactions(ctx, item.AccessToken, "")
+	if err == nil {
+		t.Fatal("sync of an item that needs its login updated succeeded")
+	}
+
+	var plaidErr *plaid.Error
+	if !errors.As(err, &plaidErr) || plaidErr.Code != "ITEM_LOGIN_REQUIRED" {
+		t.Fatalf("sync failed with %v, want ITEM_LOGIN_REQUIRED", err)
+	}
+	if status, ok := plaid.ClassifyError(err); !ok || status != entitem.StatusLoginRequired {
+		t.Errorf("error classified as %q, want %q", status, entitem.StatusLoginRequired)
+	}
+
+	// Creating an update mode link token stands in for the user completing Link
+	if _, err := client.CreateUpdateLinkToken(ctx, "user-1", item.AccessToken); err != nil {
+		t.Fatalf("failed to create update link token: %v", err)
+	}
+
+	if _, err := client.GetAccounts(ctx, item.AccessToken); err != nil {
+		t.Errorf("item is still failing after repair: %v", err)
+	}
+	pages, _ := syncAll(t, client, item.AccessToken, "")
+	if len(pages[0].Added) == 0 {
+		t.Error("sync after repair returned no transactions")
+	}
+}
+
+func TestRemove(t *testing.T) {
+	client := newClient(t)
+	ctx := context.Background()
+	item := link(t, client, "public-sandbox-1")
+	other := link(t, client, "public-sandbox-2")
+
+	if err := client.RemoveItem(ctx, item.AccessToken); err != nil {
+		t.Fatalf("failed to remove item: %v", err)
+	}
+
+	_, err := client.GetItem(ctx, item.AccessToken)
+	var plaidErr *plaid.Error
+	if !errors.As(err, &plaidErr) || plaidErr.Code != "ITEM_NOT_FOUND" {
+		t.Fatalf("getting a removed item failed with %v, want ITEM_NOT_FOUND", err)
+	}
+	if _, err := client.SyncTransactions(ctx, item.AccessToken, ""); err == nil {
+		t.Error("sync of a removed item succeeded")
+	}
+
+	// Other items are untouched
+	if _, err := client.GetItem(ctx, other.AccessToken); err != nil {
+		t.Errorf("failed to get the item that was not removed: %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package plaid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/config"
+	"regulation/internal/ent"
+	entitem "regulation/internal/ent/item"
+	"regulation/server/services/aggregator"
+	"regulation/server/services/notification"
+)
+
+// Webhook types and codes handled by WebhookService
+const (
+	WebhookTypeTransactions = "TRANSACTIONS"
+	WebhookTypeItem         = "ITEM"
+
+	WebhookCodeSyncUpdatesAvailable  = "SYNC_UPDATES_AVAILABLE"
+	WebhookCodeError                 = "ERROR"
+	WebhookCodePendingExpiration     = "PENDING_EXPIRATION"
+	WebhookCodeUserPermissionRevoked = "USER_PERMISSION_REVOKED"
+	WebhookCodeLoginRepaired         = "LOGIN_REPAIRED"
+)
+
+// Webhook is the payload of a Plaid item webhook
+type Webhook struct {
+	WebhookType           string        `json:"webhook_type"`
+	WebhookCode           string        `json:"webhook_code"`
+	ItemID                string        `json:"item_id"`
+	Error                 *WebhookError `json:"error,omitempty"`
+	ConsentExpirationTime *time.Time    `json:"consent_expiration_time,omitempty"`
+}
+
+// WebhookError is the Plaid error attached to ITEM webhooks
+type WebhookError struct {
+	ErrorType    string `json:"error_type"`
+	ErrorCode    string `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+// WebhookService verifies Plaid webhooks and reacts to item updates
+type WebhookService struct {
+	verifier            *WebhookVerifier
+	entClient           *ent.Client
+	syncService         *aggregator.SyncService
+	syncWorker          *aggregator.SyncWorker
+	notificationService *notification.Service
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(verifier *WebhookVerifier, entClient *ent.Client, cfg *config.Config, syncService *aggregator.SyncService, syncWorker *aggregator.SyncWorker) *WebhookService {
+	return &WebhookService{
+		verifier:            verifier,
+		entClient:           entClient,
+		syncService:         syncService,
+		syncWorker:          syncWorker,
+		notificationService: notification.New(cfg, entClient),
+	}
+}
+
+// Verify checks the Plaid-Verification token against the raw request body
+func (s *WebhookService) Verify(ctx context.Context, token string, body []byte) error {
+	return s.verifier.Verify(ctx, token, body)
+}
+
+// Handle processes a verified webhook body
+// Unknown webhook types and items are acknowledged and ignored
+func (s *WebhookService) Handle(ctx context.Context, body []byte) error {
+	var webhook Webhook
+	if err := json.Unmarshal(body, &webhook); err != nil {
+		return fmt.Errorf("failed to decode webhook: %w", err)
+	}
+
+	logger := log.With().
+		Str("webhook_type", webhook.WebhookType).
+		Str("webhook_code", webhook.WebhookCode).
+		Str("plaid_item_id", webhook.ItemID).
+		Logger()
+
+	item, err := s.entClient.Item.
+		Query().
+		Where(
+			entitem.Provider(ProviderName),
+			entitem.ExternalID(webhook.ItemID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			logger.Warn().Msg("[WEBHOOK] Webhook for unknown item, ignoring")
+			return nil
+		}
+		return fmt.Errorf("failed to load item: %w", err)
+	}
+
+	logger.Info().
+		Str("item_id", item.ID.String()).
+		Msg("[WEBHOOK] Received Plaid webhook")
+
+	switch {
+	case webhook.WebhookType == WebhookTypeTransactions && webhook.WebhookCode == WebhookCodeSyncUpdatesAvailable:
+		if item.IsActive {
+			s.syncWorker.Enqueue(item.ID)
+		}
+
+	case webhook.WebhookType == WebhookTypeItem && webhook.WebhookCode == WebhookCodeError:
+		return s.handleItemError(ctx, item, webhook.Error)
+
+	case webhook.WebhookType == WebhookTypeItem && webhook.WebhookCode == WebhookCodePendingExpiration:
+		body := fmt.Sprintf("Your %s connection will expire soon. Reconnect it to keep syncing.", item.InstitutionName)
+		if webhook.ConsentExpirationTime != nil {
+			body = fmt.Sprintf("Your %s connection expires on %s. Reconnect it to keep syncing.",
+				item.InstitutionName, webhook.ConsentExpirationTime.Format("Jan 2"))
+		}
+
+		s.notificationService.Notify(ctx, item.UserID, &notification.Payload{
+			Title: "Bank connection expiring",
+			Body:  body,
+		})
+
+	case webhook.WebhookType == WebhookTypeItem && webhook.WebhookCode == WebhookCodeUserPermissionRevoked:
+		return s.handlePermissionRevoked(ctx, item)
+
+	case webhook.WebhookType == WebhookTypeItem && webhook.WebhookCode == WebhookCodeLoginRepaired:
+		// The next successful sync marks the item healthy again
+		if item.IsActive {
+			s.syncWorker.Enqueue(item.ID)
+		}
+
+	default:
+		logger.Debug().Msg("[WEBHOOK] Unhandled webhook, ignoring")
+	}
+
+	return nil
+}
+
+// handleItemError records the item error on its sync cursor and updates the item status,
+// which asks the user to reconnect if needed
+func (s *WebhookService) handleItemError(ctx context.Context, item *ent.Item, webhookErr *WebhookError) error {
+	if webhookErr == nil {
+		return nil
+	}
+
+	err := s.syncService.RecordSyncError(ctx, item.ID, fmt.Errorf("%s: %s", webhookErr.ErrorCode, webhookErr.ErrorMessage))
+	if err != nil {
+		return fmt.Errorf("failed to record item error: %w", err)
+	}
+
+	log.Warn().
+		Str("item_id", item.ID.String()).
+		Str("error_code", webhookErr.ErrorCode).
+		Msg("[WEBHOOK] Item entered error state")
+
+	status, ok := classifyCode(webhookErr.ErrorType, webhookErr.ErrorCode)
+	if !ok {
+		return nil
+	}
+
+	return s.syncService.SetItemStatus(ctx, item, status, webhookErr.ErrorCode)
+}
+
+// handlePermissionRevoked deactivates an item after the user revoked access at the bank, the same way
+// removing it in the app does but without revoking it at Plaid again and keeping its history
+func (s *WebhookService) handlePermissionRevoked(ctx context.Context, item *ent.Item) error {
+	if !item.IsActive {
+		return nil
+	}
+
+	result, err := s.syncService.DeactivateRevokedItem(ctx, item)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate revoked item: %w", err)
+	}
+
+	log.Info().
+		Str("item_id", item.ID.String()).
+		Msg("[WEBHOOK] Deactivated item after permission was revoked")
+
+	body := fmt.Sprintf("Access to %s was revoked, so its accounts are no longer synced.", item.InstitutionName)
+	if result.RulesDeactivated > 0 {
+		body += fmt.Sprintf(" %d rules saving into them were paused.", result.RulesDeactivated)
+	}
+
+	s.notificationService.Notify(ctx, item.UserID, &notification.Payload{
+		Title: "Bank connection removed",
+		Body:  body,
+	})
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package plaid
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// webhookMaxAge is how old a webhook signature may be before it is rejected as a replay
+const webhookMaxAge = 5 * time.Minute
+
+// KeyProvider resolves the public keys Plaid signs webhooks with
+type KeyProvider interface {
+	// Key returns the current public key with the given key ID
+	Key(ctx context.Context, keyID string) (*ecdsa.PublicKey, error)
+}
+
+// cachedKey is a verification key with the time it was fetched
+type cachedKey struct {
+	key       *WebhookVerificationKey
+	fetchedAt time.Time
+}
+
+// CachedKeyProvider fetches verification keys from Plaid and caches them by key ID
+type CachedKeyProvider struct {
+	plaidClient Client
+	ttl         time.Duration
+
+	mu   sync.Mutex
+	keys map[string]cachedKey
+}
+
+// NewCachedKeyProvider creates a key provider that refetches keys after ttl
+func NewCachedKeyProvider(plaidClient Client, ttl time.Duration) *CachedKeyProvider {
+	return &CachedKeyProvider{
+		plaidClient: plaidClient,
+		ttl:         ttl,
+		keys:        make(map[string]cachedKey),
+	}
+}
+
+// Key returns the cached key or fetches it from Plaid, rejecting expired keys
+func (p *CachedKeyProvider) Key(ctx context.Context, keyID string) (*ecdsa.PublicKey, error) {
+	p.mu.Lock()
+	cached, ok := p.keys[keyID]
+	p.mu.Unlock()
+
+	if !ok || time.Since(cached.fetchedAt) > p.ttl {
+		key, err := p.plaidClient.GetWebhookVerificationKey(ctx, keyID)
+		if err != nil {
+			return nil, err
+		}
+
+		cached = cachedKey{key: key, fetchedAt: time.Now()}
+
+		p.mu.Lock()
+		p.keys[keyID] = cached
+		p.mu.Unlock()
+	}
+
+	if cached.key.ExpiredAt != nil && cached.key.ExpiredAt.Before(time.Now()) {
+		return nil, fmt.Errorf("webhook verification key %s expired", keyID)
+	}
+
+	return cached.key.PublicKey, nil
+}
+
+// StaticKeyProvider serves a fixed set of keys, e.g. a locally generated key in tests
+type StaticKeyProvider map[string]*ecdsa.PublicKey
+
+// Key returns the key with the given key ID
+func (p StaticKeyProvider) Key(_ context.Context, keyID string) (*ecdsa.PublicKey, error) {
+	key, ok := p[keyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown webhook verification key %s", keyID)
+	}
+	return key, nil
+}
+
+// WebhookVerifier checks the signed JWT Plaid sends in the Plaid-Verification header
+type WebhookVerifier struct {
+	keys KeyProvider
+}
+
+// NewWebhookVerifier creates a new webhook verifier
+func NewWebhookVerifier(keys KeyProvider) *WebhookVerifier {
+	return &WebhookVerifier{keys: keys}
+}
+
+// Verify validates the ES256 signature, the issue time and the body hash of a webhook
+func (v *WebhookVerifier) Verify(ctx context.Context, token string, body []byte) error {
+	if token == "" {
+		return errors.New("missing verification token")
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		keyID, ok := t.Header["kid"].(string)
+		if !ok || keyID == "" {
+			return nil, errors.New("missing key id")
+		}
+		return v.keys.Key(ctx, keyID)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodES256.Alg()}), jwt.WithIssuedAt())
+	if err != nil {
+		return fmt.Errorf("invalid verification token: %w", err)
+	}
+
+	issuedAt, err := claims.GetIssuedAt()
+	if err != nil || issuedAt == nil {
+		return errors.New("missing token issue time")
+	}
+	if time.Since(issuedAt.Time) > webhookMaxAge {
+		return errors.New("verification token is too old")
+	}
+
+	expected, ok := claims["request_body_sha256"].(string)
+	if !ok {
+		return errors.New("missing request body hash")
+	}
+
+	actual := sha256.Sum256(body)
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(hex.EncodeToString(actual[:]))) != 1 {
+		return errors.New("request body hash mismatch")
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package plaid_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"regulation/server/services/plaid"
+)
+
+// newKey generates a P-256 key like the ones Plaid signs webhooks with
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+// sign creates a verification token for body the way Plaid does
+func sign(t *testing.T, key *ecdsa.PrivateKey, keyID string, issuedAt time.Time, body []byte) string {
+	t.Helper()
+
+	hash := sha256.Sum256(body)
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iat":                 issuedAt.Unix(),
+		"request_body_sha256": hex.EncodeToString(hash[:]),
+	})
+	token.Header["kid"] = keyID
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func TestWebhookVerifier(t *testing.T) {
+	key := newKey(t)
+	verifier := plaid.NewWebhookVerifier(plaid.StaticKeyProvider{"key-1": &key.PublicKey})
+
+	body := []byte(`{"webhook_type":"TRANSACTIONS","webhook_code":"SYNC_UPDATES_AVAILABLE","item_id":"item-1"}`)
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		token   string
+		body    []byte
+		wantErr string // empty for a valid webhook
+	}{
+		{
+			name:  "valid",
+			token: sign(t, key, "key-1", now, body),
+			body:  body,
+		},
+		{
+			name:    "missing token",
+			token:   "",
+			body:    body,
+			wantErr: "missing verification token",
+		},
+		{
+			name:    "expired iat",
+			token:   sign(t, key, "key-1", now.Add(-10*time.Minute), body),
+			body:    body,
+			wantErr: "too old",
+		},
+		{
+			name:    "body hash mismatch",
+			token:   sign(t, key, "key-1", now, body),
+			body:    []byte(`{"webhook_type":"ITEM","webhook_code":"USER_PERMISSION_REVOKED","item_id":"item-1"}`),
+			wantErr: "body hash mismatch",
+		},
+		{
+			name:    "unknown kid",
+			token:   sign(t, key, "key-2", now, body),
+			body:    body,
+			wantErr: "unknown webhook verification key",
+		},
+		{
+			name:    "signed with another key",
+			token:   sign(t, newKey(t), "key-1", now, body),
+			body:    body,
+			wantErr: "signature is invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifier.Verify(context.Background(), tt.token, tt.body)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Verify() error = %v", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
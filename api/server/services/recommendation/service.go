@@ -0,0 +1,132 @@
+// Package recommendation suggests savings rules from a user's recent settled spending.
+package recommendation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"regulation/internal/ent"
+	"regulation/internal/ent/account"
+	entrule "regulation/internal/ent/rule"
+	"regulation/internal/ent/transaction"
+	"regulation/internal/rulesuggestion"
+)
+
+const (
+	// AnalysisPeriodDays is how many days of transactions are analyzed
+	AnalysisPeriodDays = 30
+	// minTransactions is the number of settled transactions needed for meaningful suggestions
+	minTransactions = 5
+)
+
+// ErrInsufficientHistory is returned when a user has too few recent transactions to analyze
+var ErrInsufficientHistory = errors.New("insufficient transaction history")
+
+// Service suggests savings rules
+type Service struct {
+	db                *ent.Client
+	suggestionService *rulesuggestion.Service
+}
+
+// NewService creates a new recommendation service
+func NewService(db *ent.Client, suggestionService *rulesuggestion.Service) *Service {
+	return &Service{
+		db:                db,
+		suggestionService: suggestionService,
+	}
+}
+
+// Recommendation holds the rules suggested for a user's recent spending
+type Recommendation struct {
+	Suggestions        []rulesuggestion.RuleSuggestion
+	OverallAnalysis    string
+	PrioritySuggestion string
+	// TransactionCount is the number of settled transactions analyzed
+	TransactionCount int
+	// TotalSpent is the sum of the analyzed transactions in cents
+	TotalSpent int64
+}
+
+// Recommend analyzes a user's settled transactions of the last AnalysisPeriodDays days and suggests
+// savings rules that don't duplicate the user's existing ones
+func (s *Service) Recommend(ctx context.Context, userID uuid.UUID) (*Recommendation, error) {
+	startDate := time.Now().AddDate(0, 0, -AnalysisPeriodDays)
+
+	transactions, err := s.db.Transaction.
+		Query().
+		Where(
+			transaction.HasAccountWith(
+				account.UserID(userID),
+			),
+			transaction.DateGTE(startDate),
+			transaction.PendingEQ(false), // Only settled transactions
+		).
+		Order(ent.Desc(transaction.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transactions: %w", err)
+	}
+
+	if len(transactions) < minTransactions {
+		return nil, fmt.Errorf("%w: need at least %d transactions, found %d", ErrInsufficientHistory, minTransactions, len(transactions))
+	}
+
+	txSummaries := make([]rulesuggestion.TransactionSummary, len(transactions))
+	totalSpent := int64(0)
+	for i, tx := range transactions {
+		txSummaries[i] = rulesuggestion.TransactionSummary{
+			Category: tx.Category,
+			Amount:   tx.Amount,
+			Date:     tx.Date,
+		}
+		totalSpent += tx.Amount
+	}
+
+	// Existing rules are passed along so they aren't suggested again
+	existingRules, err := s.db.Rule.
+		Query().
+		Where(entrule.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch existing rules: %w", err)
+	}
+
+	existingRuleInfos := make([]rulesuggestion.ExistingRuleInfo, len(existingRules))
+	for i, rule := range existingRules {
+		existingRuleInfos[i] = rulesuggestion.ExistingRuleInfo{
+			Category:       rulesuggestion.Category(rule.Category),
+			ActionType:     rulesuggestion.ActionType(rule.ActionType),
+			ActionValue:    rule.ActionValue,
+			ExecutionCount: rule.ExecutionCount,
+			TotalSaved:     float64(rule.TotalSavedCents) / 100,
+		}
+	}
+
+	// Income and savings goal are not known yet, so they are left out of the analysis
+	monthlyIncome := 0.0
+	savingsGoal := 0.0
+
+	suggestions, err := s.suggestionService.AnalyzeTransactionsAndSuggestRules(
+		ctx,
+		userID.String(),
+		txSummaries,
+		existingRuleInfos,
+		monthlyIncome,
+		savingsGoal,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate suggestions: %w", err)
+	}
+
+	return &Recommendation{
+		Suggestions:        suggestions.Suggestions,
+		OverallAnalysis:    suggestions.OverallAnalysis,
+		PrioritySuggestion: suggestions.PrioritySuggestion,
+		TransactionCount:   len(transactions),
+		TotalSpent:         totalSpent,
+	}, nil
+}
@@ -0,0 +1,413 @@
+package recurring
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/config"
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entrecurringcharge "regulation/internal/ent/recurringcharge"
+	enttransaction "regulation/internal/ent/transaction"
+	"regulation/server/services/notification"
+)
+
+const (
+	// lookback is how far back transactions are scanned (long enough for annual charges)
+	lookback = 400 * 24 * time.Hour
+
+	// amountTolerance is the relative amount difference still treated as the same charge
+	amountTolerance = 0.2
+
+	// matchRatio is the share of intervals that must fit the cadence
+	matchRatio = 0.75
+
+	// staleGrace is how long after a predicted charge date a missing charge deactivates it
+	staleGrace = 7 * 24 * time.Hour
+
+	// alertWindow is how recent the charge that made a series recurring must be to announce it;
+	// series found in backfilled or imported history are recorded silently
+	alertWindow = 7 * 24 * time.Hour
+)
+
+// cadenceSpec describes how a cadence is recognized and predicted
+type cadenceSpec struct {
+	cadence        entrecurringcharge.Cadence
+	intervalDays   float64
+	toleranceDays  float64
+	minOccurrences int
+	next           func(time.Time) time.Time
+}
+
+var cadences = []cadenceSpec{
+	{
+		cadence:        entrecurringcharge.CadenceWeekly,
+		intervalDays:   7,
+		toleranceDays:  1,
+		minOccurrences: 3,
+		next:           func(t time.Time) time.Time { return t.AddDate(0, 0, 7) },
+	},
+	{
+		cadence:        entrecurringcharge.CadenceMonthly,
+		intervalDays:   30,
+		toleranceDays:  4,
+		minOccurrences: 3,
+		next:           func(t time.Time) time.Time { return t.AddDate(0, 1, 0) },
+	},
+	{
+		cadence:        entrecurringcharge.CadenceAnnual,
+		intervalDays:   365,
+		toleranceDays:  7,
+		minOccurrences: 2,
+		next:           func(t time.Time) time.Time { return t.AddDate(1, 0, 0) },
+	},
+}
+
+// Detector recognizes subscriptions and other repeating charges in a user's transactions
+type Detector struct {
+	db                  *ent.Client
+	notificationService *notification.Service
+}
+
+// NewDetector creates a new recurring charge detector
+func NewDetector(db *ent.Client, cfg *config.Config) *Detector {
+	return &Detector{
+		db:                  db,
+		notificationService: notification.New(cfg, db),
+	}
+}
+
+// DetectForUser scans the user's recent charges, groups them by normalized merchant
+// and amount, and records every group with a weekly, monthly or annual cadence.
+// The user is notified about price increases and newly found recurring charges that became
+// recurring within alertWindow, so backfills and imports don't announce long-running subscriptions.
+func (d *Detector) DetectForUser(ctx context.Context, userID uuid.UUID) error {
+	now := time.Now()
+
+	transactions, err := d.db.Transaction.
+		Query().
+		Where(
+			enttransaction.HasAccountWith(entaccount.UserID(userID)),
+			enttransaction.Pending(false),
+			enttransaction.InternalTransfer(false),
+			enttransaction.AmountGT(0),
+			enttransaction.DateGTE(now.Add(-lookback)),
+		).
+		Order(ent.Asc(enttransaction.FieldDate)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query transactions: %w", err)
+	}
+
+	groups := make(map[string][]*ent.Transaction)
+	for _, txn := range transactions {
+		key := NormalizeMerchant(merchantName(txn))
+		if key == "" {
+			continue
+		}
+		groups[key] = append(groups[key], txn)
+	}
+
+	for key, group := range groups {
+		for _, sameCurrency := range splitByCurrency(group) {
+			for _, s := range detectSeries(sameCurrency) {
+				if err := d.record(ctx, userID, key, s.spec, s.charges, now); err != nil {
+					log.Error().
+						Err(err).
+						Str("user_id", userID.String()).
+						Str("merchant_key", key).
+						Msg("[RECURRING] Failed to record recurring charge")
+				}
+			}
+		}
+	}
+
+	// Charges that dropped out of the lookback window entirely
+	err = d.db.RecurringCharge.
+		Update().
+		Where(
+			entrecurringcharge.UserID(userID),
+			entrecurringcharge.IsActive(true),
+			entrecurringcharge.NextChargeDateLT(now.Add(-staleGrace)),
+		).
+		SetIsActive(false).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to deactivate stale recurring charges: %w", err)
+	}
+
+	return nil
+}
+
+// record creates or updates the recurring charge for a series of charges
+func (d *Detector) record(ctx context.Context, userID uuid.UUID, key string, spec cadenceSpec, cluster []*ent.Transaction, now time.Time) error {
+	last := cluster[len(cluster)-1]
+	nextDate := spec.next(last.Date)
+	active := !nextDate.Add(staleGrace).Before(now)
+
+	var previousAmount *int64
+	priceIncreased := false
+	if before := cluster[len(cluster)-2]; last.Amount > before.Amount {
+		previousAmount = &before.Amount
+		priceIncreased = true
+	}
+
+	existing, err := d.db.RecurringCharge.
+		Query().
+		Where(
+			entrecurringcharge.UserID(userID),
+			entrecurringcharge.MerchantKey(key),
+			entrecurringcharge.CadenceEQ(spec.cadence),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to query recurring charge: %w", err)
+	}
+
+	if existing == nil {
+		charge, err := d.db.RecurringCharge.
+			Create().
+			SetUserID(userID).
+			SetMerchantKey(key).
+			SetMerchantName(merchantName(last)).
+			SetCategory(last.Category).
+			SetCadence(spec.cadence).
+			SetAmountCents(last.Amount).
+			SetNillablePreviousAmountCents(previousAmount).
+			SetPriceIncreased(priceIncreased).
+			SetOccurrences(len(cluster)).
+			SetLastChargeDate(last.Date).
+			SetNextChargeDate(nextDate).
+			SetIsActive(active).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create recurring charge: %w", err)
+		}
+
+		log.Info().
+			Str("user_id", userID.String()).
+			Str("merchant", charge.MerchantName).
+			Str("cadence", string(charge.Cadence)).
+			Int64("amount_cents", charge.AmountCents).
+			Msg("[RECURRING] Detected new recurring charge")
+
+		// The charge that completed the cadence tells when the series became recurring
+		recognized := cluster[min(spec.minOccurrences, len(cluster))-1]
+		if active && !recognized.Date.Before(now.Add(-alertWindow)) {
+			d.notificationService.Notify(ctx, userID, &notification.Payload{
+				Title: fmt.Sprintf("New %s charge: %s", charge.Cadence, charge.MerchantName),
+				Body:  fmt.Sprintf("%s, next on %s", currency.Format(charge.AmountCents, last.Currency), charge.NextChargeDate.Format("Jan 2")),
+			})
+		}
+
+		return nil
+	}
+
+	// A price increase is only announced once, when the higher charge first appears
+	newIncrease := priceIncreased && last.Date.After(existing.LastChargeDate)
+
+	err = d.db.RecurringCharge.
+		UpdateOne(existing).
+		SetMerchantName(merchantName(last)).
+		SetCategory(last.Category).
+		SetAmountCents(last.Amount).
+		SetNillablePreviousAmountCents(previousAmount).
+		SetPriceIncreased(priceIncreased).
+		SetOccurrences(len(cluster)).
+		SetLastChargeDate(last.Date).
+		SetNextChargeDate(nextDate).
+		SetIsActive(active).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update recurring charge: %w", err)
+	}
+
+	if newIncrease {
+		log.Info().
+			Str("user_id", userID.String()).
+			Str("merchant", existing.MerchantName).
+			Int64("previous_amount_cents", *previousAmount).
+			Int64("amount_cents", last.Amount).
+			Msg("[RECURRING] Detected price increase")
+
+		d.notificationService.Notify(ctx, userID, &notification.Payload{
+			Title: fmt.Sprintf("Price increase: %s", merchantName(last)),
+			Body:  fmt.Sprintf("%s → %s %s", currency.Format(*previousAmount, last.Currency), currency.Format(last.Amount, last.Currency), spec.cadence),
+		})
+	}
+
+	return nil
+}
+
+// splitByCurrency splits date-ordered charges of one merchant by currency, keeping their order
+// so amounts in different currencies are never compared with each other
+func splitByCurrency(transactions []*ent.Transaction) [][]*ent.Transaction {
+	var groups [][]*ent.Transaction
+	index := make(map[string]int)
+	for _, txn := range transactions {
+		i, ok := index[txn.Currency]
+		if !ok {
+			i = len(groups)
+			index[txn.Currency] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], txn)
+	}
+
+	return groups
+}
+
+// series is a run of charges repeating on one cadence
+type series struct {
+	spec    cadenceSpec
+	charges []*ent.Transaction
+}
+
+// detectSeries finds the recurring series in date-ordered charges of one merchant and currency,
+// keeping only the most recent series per cadence since each cadence maps to one recurring charge.
+// A cluster of a new amount that picks up on cadence where an earlier series stopped continues that
+// series, so a price change beyond amountTolerance is compared with the old price instead of starting over
+func detectSeries(transactions []*ent.Transaction) []*series {
+	var detected []*series
+	for _, cluster := range clusterByAmount(transactions) {
+		spec, ok := inferCadence(cluster)
+
+		var continued *series
+		for _, s := range detected {
+			if (!ok || s.spec.cadence == spec.cadence) && continues(s, cluster) {
+				continued = s
+				break
+			}
+		}
+
+		switch {
+		case continued != nil:
+			continued.charges = append(continued.charges, cluster...)
+		case ok:
+			detected = append(detected, &series{spec: spec, charges: cluster})
+		}
+	}
+
+	latest := make(map[entrecurringcharge.Cadence]*series)
+	var result []*series
+	for _, s := range detected {
+		current, ok := latest[s.spec.cadence]
+		if !ok {
+			latest[s.spec.cadence] = s
+			result = append(result, s)
+			continue
+		}
+		if s.charges[len(s.charges)-1].Date.After(current.charges[len(current.charges)-1].Date) {
+			*current = *s
+		}
+	}
+
+	return result
+}
+
+// continues reports whether a cluster starts after a series' last charge and keeps to its cadence
+func continues(s *series, cluster []*ent.Transaction) bool {
+	previous := s.charges[len(s.charges)-1]
+	for _, txn := range cluster {
+		interval := txn.Date.Sub(previous.Date).Hours() / 24
+		if interval < s.spec.intervalDays-s.spec.toleranceDays || interval > s.spec.intervalDays+s.spec.toleranceDays {
+			return false
+		}
+		previous = txn
+	}
+
+	return true
+}
+
+// clusterByAmount splits date-ordered charges of one merchant into groups of similar amounts
+// Each charge is compared with the latest charge of a group so gradual price changes stay together
+func clusterByAmount(transactions []*ent.Transaction) [][]*ent.Transaction {
+	var clusters [][]*ent.Transaction
+
+	for _, txn := range transactions {
+		placed := false
+		for i, cluster := range clusters {
+			latest := cluster[len(cluster)-1].Amount
+			if absDiff(txn.Amount, latest) <= int64(float64(latest)*amountTolerance) {
+				clusters[i] = append(cluster, txn)
+				placed = true
+				break
+			}
+		}
+
+		if !placed {
+			clusters = append(clusters, []*ent.Transaction{txn})
+		}
+	}
+
+	return clusters
+}
+
+// inferCadence returns the cadence most intervals between consecutive charges fit
+func inferCadence(cluster []*ent.Transaction) (cadenceSpec, bool) {
+	if len(cluster) < 2 {
+		return cadenceSpec{}, false
+	}
+
+	intervals := make([]float64, len(cluster)-1)
+	for i := 1; i < len(cluster); i++ {
+		intervals[i-1] = cluster[i].Date.Sub(cluster[i-1].Date).Hours() / 24
+	}
+
+	for _, spec := range cadences {
+		if len(cluster) < spec.minOccurrences {
+			continue
+		}
+
+		matched := 0
+		for _, interval := range intervals {
+			if interval >= spec.intervalDays-spec.toleranceDays && interval <= spec.intervalDays+spec.toleranceDays {
+				matched++
+			}
+		}
+
+		if float64(matched) >= float64(len(intervals))*matchRatio {
+			return spec, true
+		}
+	}
+
+	return cadenceSpec{}, false
+}
+
+// merchantName returns the merchant name of a transaction, falling back to its description
+func merchantName(txn *ent.Transaction) string {
+	if txn.MerchantName != "" {
+		return txn.MerchantName
+	}
+	return txn.Name
+}
+
+// NormalizeMerchant reduces a merchant name to a stable grouping key by lowercasing it
+// and dropping digits and punctuation (e.g. "NETFLIX.COM *8231" -> "netflix com")
+func NormalizeMerchant(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// absDiff returns the absolute difference of two amounts
+func absDiff(a, b int64) int64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
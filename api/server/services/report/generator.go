@@ -0,0 +1,438 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/config"
+	"regulation/internal/currency"
+	"regulation/internal/ent"
+	entfamilymember "regulation/internal/ent/familymember"
+	entgoal "regulation/internal/ent/goal"
+	entmonthlyreport "regulation/internal/ent/monthlyreport"
+	entrule "regulation/internal/ent/rule"
+	entruleexecution "regulation/internal/ent/ruleexecution"
+	"regulation/internal/ent/schema/schematype"
+	enttransaction "regulation/internal/ent/transaction"
+	entuser "regulation/internal/ent/user"
+	"regulation/server/services/cashflow"
+	"regulation/server/services/notification"
+)
+
+const (
+	// MonthFormat is the layout of MonthlyReport.month
+	MonthFormat = "2006-01"
+
+	// topRuleLimit is the number of most active rules kept in a report
+	topRuleLimit = 3
+)
+
+// Generator builds month-end reports for every user on the first of the month
+type Generator struct {
+	db                  *ent.Client
+	cashflowService     *cashflow.Service
+	notificationService *notification.Service
+	checkInterval       time.Duration
+
+	// lastMonth is the most recent month reports were generated for by this process
+	lastMonth string
+}
+
+// NewGenerator creates a new monthly report generator
+func NewGenerator(db *ent.Client, cfg *config.Config, cashflowService *cashflow.Service, checkInterval time.Duration) *Generator {
+	return &Generator{
+		db:                  db,
+		cashflowService:     cashflowService,
+		notificationService: notification.New(cfg, db),
+		checkInterval:       checkInterval,
+	}
+}
+
+// Start begins the report generator loop
+// Reports for the previous month are generated on the first check of a new month;
+// missing reports are also caught up after downtime
+func (g *Generator) Start(ctx context.Context) {
+	ticker := time.NewTicker(g.checkInterval)
+	defer ticker.Stop()
+
+	log.Info().
+		Dur("interval", g.checkInterval).
+		Msg("Monthly report generator started")
+
+	g.runCycle(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("Monthly report generator stopping")
+			return
+		case <-ticker.C:
+			g.runCycle(ctx)
+		}
+	}
+}
+
+// runCycle generates reports for the previous month if not done yet
+func (g *Generator) runCycle(ctx context.Context) {
+	currentStart, _ := cashflow.MonthRange(time.Now())
+	previous := currentStart.AddDate(0, -1, 0)
+
+	month := previous.Format(MonthFormat)
+	if g.lastMonth == month {
+		return
+	}
+
+	if err := g.GenerateAll(ctx, previous); err != nil {
+		log.Error().
+			Err(err).
+			Str("month", month).
+			Msg("Failed to generate monthly reports")
+		return
+	}
+
+	g.lastMonth = month
+}
+
+// GenerateAll builds the report of the given month for every user with linked accounts
+// Users that already have a report for the month are skipped
+func (g *Generator) GenerateAll(ctx context.Context, month time.Time) error {
+	monthKey := month.Format(MonthFormat)
+
+	userIDs, err := g.db.User.
+		Query().
+		Where(
+			entuser.HasAccounts(),
+			entuser.Not(entuser.HasMonthlyReportsWith(entmonthlyreport.Month(monthKey))),
+		).
+		IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query users without report: %w", err)
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	log.Info().
+		Str("month", monthKey).
+		Int("user_count", len(userIDs)).
+		Msg("Generating monthly reports")
+
+	for _, userID := range userIDs {
+		report, err := g.Generate(ctx, userID, month)
+		if err != nil {
+			log.Error().
+				Err(err).
+				Str("user_id", userID.String()).
+				Str("month", monthKey).
+				Msg("Failed to generate monthly report")
+			continue // Continue with other users
+		}
+
+		// Another replica may have generated the report first
+		if report == nil {
+			continue
+		}
+
+		g.notify(ctx, report)
+	}
+
+	return nil
+}
+
+// Generate builds and stores the report of the given month for a user
+// Returns nil without error when the report already exists
+func (g *Generator) Generate(ctx context.Context, userID uuid.UUID, month time.Time) (*ent.MonthlyReport, error) {
+	userIDs := []uuid.UUID{userID}
+	start, end := cashflow.MonthRange(month)
+	previousStart, previousEnd := cashflow.PreviousPeriod(start, end)
+
+	currencyCode, err := g.cashflowService.HomeCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := g.cashflowService.Summarize(ctx, userIDs, currencyCode, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	topRules, err := g.topRules(ctx, userID, currencyCode, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := g.goals(ctx, userID, end)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := g.categoryChanges(ctx, userIDs, currencyCode, start, end, previousStart, previousEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := g.db.MonthlyReport.
+		Create().
+		SetUserID(userID).
+		SetMonth(month.Format(MonthFormat)).
+		SetIncomeCents(summary.Income).
+		SetSpendCents(summary.Spend).
+		SetSavingsCents(summary.AutoSaved).
+		SetNetCents(summary.Net()).
+		SetCurrency(summary.Currency).
+		SetTopRules(topRules).
+		SetGoals(goals).
+		SetCategoryChanges(categories).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to save monthly report: %w", err)
+	}
+
+	return report, nil
+}
+
+// topRules returns the user's most active rules within [start, end] by execution count
+// Savings are summed per transaction currency and converted to the report currency
+func (g *Generator) topRules(ctx context.Context, userID uuid.UUID, currencyCode string, start, end time.Time) ([]schematype.ReportRule, error) {
+	var totals []struct {
+		RuleID   uuid.UUID `json:"rule_id"`
+		Currency string    `json:"currency"`
+		Count    int       `json:"count"`
+		Sum      int64     `json:"sum"`
+	}
+
+	err := g.db.RuleExecution.
+		Query().
+		Where(
+			entruleexecution.UserID(userID),
+			entruleexecution.StatusNEQ(entruleexecution.StatusFailed),
+			entruleexecution.CreatedAtGTE(start),
+			entruleexecution.CreatedAtLTE(end),
+		).
+		Modify(func(sel *sql.Selector) {
+			transactions := sql.Table(enttransaction.Table)
+
+			sel.Join(transactions).
+				On(sel.C(entruleexecution.FieldTransactionID), transactions.C(enttransaction.FieldID))
+			sel.Select(
+				sql.As(sel.C(entruleexecution.FieldRuleID), "rule_id"),
+				sql.As(transactions.C(enttransaction.FieldCurrency), "currency"),
+				sql.As("COUNT(*)", "count"),
+				sql.As(fmt.Sprintf("COALESCE(SUM(%s), 0)", sel.C(entruleexecution.FieldAmountCents)), "sum"),
+			).GroupBy(sel.C(entruleexecution.FieldRuleID), transactions.C(enttransaction.FieldCurrency))
+		}).
+		Scan(ctx, &totals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate rule executions: %w", err)
+	}
+
+	type ruleTotal struct {
+		RuleID uuid.UUID
+		Count  int
+		Sum    int64
+	}
+
+	var rows []*ruleTotal
+	byRule := make(map[uuid.UUID]*ruleTotal)
+	for _, total := range totals {
+		saved, err := g.cashflowService.Convert(ctx, total.Sum, total.Currency, currencyCode)
+		if err != nil {
+			return nil, err
+		}
+
+		row, ok := byRule[total.RuleID]
+		if !ok {
+			row = &ruleTotal{RuleID: total.RuleID}
+			byRule[total.RuleID] = row
+			rows = append(rows, row)
+		}
+		row.Count += total.Count
+		row.Sum += saved
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].Count != rows[j].Count {
+			return rows[i].Count > rows[j].Count
+		}
+		return rows[i].Sum > rows[j].Sum
+	})
+	if len(rows) > topRuleLimit {
+		rows = rows[:topRuleLimit]
+	}
+
+	ruleIDs := make([]uuid.UUID, len(rows))
+	for i, row := range rows {
+		ruleIDs[i] = row.RuleID
+	}
+
+	rules, err := g.db.Rule.
+		Query().
+		Where(entrule.IDIn(ruleIDs...)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rules: %w", err)
+	}
+
+	names := make(map[uuid.UUID]string, len(rules))
+	for _, r := range rules {
+		names[r.ID] = r.Name
+	}
+
+	topRules := make([]schematype.ReportRule, len(rows))
+	for i, row := range rows {
+		topRules[i] = schematype.ReportRule{
+			RuleID:         row.RuleID,
+			Name:           names[row.RuleID],
+			ExecutionCount: row.Count,
+			SavedCents:     row.Sum,
+		}
+	}
+
+	return topRules, nil
+}
+
+// goals returns the completion of the user's personal and family goals at the given time
+func (g *Generator) goals(ctx context.Context, userID uuid.UUID, at time.Time) ([]schematype.ReportGoal, error) {
+	visible := entgoal.And(
+		entgoal.UserID(userID),
+		entgoal.FamilyIDIsNil(),
+	)
+
+	member, err := g.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(userID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to load family membership: %w", err)
+	}
+	if member != nil {
+		visible = entgoal.Or(visible, entgoal.FamilyID(member.FamilyID))
+	}
+
+	goals, err := g.db.Goal.
+		Query().
+		Where(
+			visible,
+			entgoal.CreatedAtLTE(at),
+		).
+		Order(ent.Asc(entgoal.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query goals: %w", err)
+	}
+
+	reportGoals := make([]schematype.ReportGoal, len(goals))
+	for i, goal := range goals {
+		progress, err := g.cashflowService.GoalProgress(ctx, goal, at)
+		if err != nil {
+			return nil, err
+		}
+
+		reportGoals[i] = schematype.ReportGoal{
+			GoalID:        goal.ID,
+			Name:          goal.Name,
+			Kind:          string(goal.Kind),
+			TargetCents:   goal.TargetCents,
+			ProgressCents: progress,
+			Percent:       float64(progress) / float64(goal.TargetCents) * 100,
+		}
+	}
+
+	return reportGoals, nil
+}
+
+// categoryChanges compares category spend of the reported month with the previous month
+func (g *Generator) categoryChanges(ctx context.Context, userIDs []uuid.UUID, currencyCode string, start, end, previousStart, previousEnd time.Time) ([]schematype.ReportCategoryChange, error) {
+	current, err := g.cashflowService.SpendByCategory(ctx, userIDs, currencyCode, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := g.cashflowService.SpendByCategory(ctx, userIDs, currencyCode, previousStart, previousEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	previousSpend := make(map[string]int64, len(previous))
+	for _, category := range previous {
+		previousSpend[category.Category] = category.Spend
+	}
+
+	changes := make([]schematype.ReportCategoryChange, 0, len(current)+len(previous))
+	for _, category := range current {
+		changes = append(changes, schematype.ReportCategoryChange{
+			Category:      category.Category,
+			Spend:         category.Spend,
+			PreviousSpend: previousSpend[category.Category],
+			Change:        category.Spend - previousSpend[category.Category],
+		})
+		delete(previousSpend, category.Category)
+	}
+
+	// Categories with no spend this month
+	for _, category := range previous {
+		if _, ok := previousSpend[category.Category]; !ok {
+			continue
+		}
+
+		changes = append(changes, schematype.ReportCategoryChange{
+			Category:      category.Category,
+			PreviousSpend: category.Spend,
+			Change:        -category.Spend,
+		})
+	}
+
+	return changes, nil
+}
+
+// notify sends the report headline to the user
+func (g *Generator) notify(ctx context.Context, report *ent.MonthlyReport) {
+	month, err := time.Parse(MonthFormat, report.Month)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Str("month", report.Month).
+			Msg("Invalid monthly report month")
+		return
+	}
+
+	payload := &notification.Payload{
+		Title: fmt.Sprintf("%s report", month.Format("January 2006")),
+		Body:  Headline(report),
+	}
+
+	g.notificationService.Notify(ctx, report.UserID, payload)
+}
+
+// Headline returns the one-line report summary,
+// e.g. "NET +$320.00 | Top Rule: Dining 2× Save | Goal: 80% complete"
+func Headline(report *ent.MonthlyReport) string {
+	sign := "+"
+	net := report.NetCents
+	if net < 0 {
+		sign = "-"
+		net = -net
+	}
+
+	headline := fmt.Sprintf("NET %s%s", sign, currency.Format(net, report.Currency))
+
+	if len(report.TopRules) > 0 {
+		headline += fmt.Sprintf(" | Top Rule: %s", report.TopRules[0].Name)
+	}
+
+	if len(report.Goals) > 0 {
+		headline += fmt.Sprintf(" | Goal: %.0f%% complete", report.Goals[0].Percent)
+	}
+
+	return headline
+}
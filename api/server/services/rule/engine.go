@@ -5,28 +5,36 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/DeltaLaboratory/contrib/hooks"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 
 	"regulation/internal/config"
+	"regulation/internal/currency"
 	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entfamilymember "regulation/internal/ent/familymember"
 	entrule "regulation/internal/ent/rule"
 	entruleexecution "regulation/internal/ent/ruleexecution"
 	savingstransfer "regulation/internal/ent/savingstransfer"
+	enttransaction "regulation/internal/ent/transaction"
+	"regulation/server/services/cashflow"
 	"regulation/server/services/notification"
 )
 
 // Engine handles rule evaluation and execution
 type Engine struct {
 	db                  *ent.Client
+	cashflowService     *cashflow.Service
 	notificationService *notification.Service
 }
 
 // NewEngine creates a new rule engine
-func NewEngine(db *ent.Client, cfg *config.Config) *Engine {
+func NewEngine(db *ent.Client, cfg *config.Config, cashflowService *cashflow.Service) *Engine {
 	return &Engine{
 		db:                  db,
+		cashflowService:     cashflowService,
 		notificationService: notification.New(cfg, db),
 	}
 }
@@ -58,6 +66,14 @@ func (e *Engine) ProcessTransaction(ctx context.Context, transaction *ent.Transa
 		return nil
 	}
 
+	// Skip money moved between the user's own accounts
+	if transaction.InternalTransfer {
+		log.Debug().
+			Str("transaction_id", transaction.ID.String()).
+			Msg("[RULE ENGINE] Skipping internal transfer transaction")
+		return nil
+	}
+
 	// Get the account to find user_id
 	account, err := transaction.QueryAccount().Only(ctx)
 	if err != nil {
@@ -69,13 +85,45 @@ func (e *Engine) ProcessTransaction(ctx context.Context, transaction *ent.Transa
 		Str("account_id", account.ID.String()).
 		Msg("[RULE ENGINE] Loaded account for transaction")
 
-	// Load all active rules for this user, ordered by priority
+	// Money can't be saved out of a credit card, so card purchases save from the card's funding account
+	fundingAccountID := account.ID
+	if account.Type == entaccount.TypeCredit {
+		if account.FundingAccountID == nil {
+			log.Info().
+				Str("transaction_id", transaction.ID.String()).
+				Str("account_id", account.ID.String()).
+				Msg("[RULE ENGINE] Credit account has no funding account, skipping")
+			return nil
+		}
+		fundingAccountID = *account.FundingAccountID
+	}
+
+	// Load the user's family membership so family rules are evaluated as well
+	membership, err := e.db.FamilyMember.
+		Query().
+		Where(entfamilymember.UserID(account.UserID)).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to load family membership: %w", err)
+	}
+
+	scope := entrule.And(
+		entrule.UserID(account.UserID),
+		entrule.FamilyIDIsNil(),
+	)
+	if membership != nil {
+		scope = entrule.Or(scope, entrule.FamilyID(membership.FamilyID))
+	}
+
+	// Load all active personal and family rules for this user, ordered by priority
 	rules, err := e.db.Rule.
 		Query().
 		Where(
-			entrule.UserID(account.UserID),
+			scope,
 			entrule.IsActive(true),
 		).
+		WithBudget().
+		WithTargetAccount().
 		Order(ent.Asc(entrule.FieldPriority)).
 		All(ctx)
 
@@ -109,7 +157,46 @@ func (e *Engine) ProcessTransaction(ctx context.Context, transaction *ent.Transa
 	// Evaluate each rule
 	matchedCount := 0
 	for _, rule := range rules {
+		// Closed or disconnected accounts can't receive savings
+		if target := rule.Edges.TargetAccount; target != nil && !target.IsActive {
+			log.Warn().
+				Str("rule_id", rule.ID.String()).
+				Str("target_account_id", target.ID.String()).
+				Msg("[RULE ENGINE] Rule target account is inactive, skipping")
+			continue
+		}
+
+		// Savings are moved in the transaction's currency, so the target account must hold it
+		if target := rule.Edges.TargetAccount; target != nil && target.Currency != transaction.Currency {
+			log.Warn().
+				Str("rule_id", rule.ID.String()).
+				Str("transaction_id", transaction.ID.String()).
+				Str("transaction_currency", transaction.Currency).
+				Str("target_currency", target.Currency).
+				Msg("[RULE ENGINE] Rule target account is in a different currency, skipping")
+			continue
+		}
+
 		if e.matchesRule(transaction, rule) {
+			eligibleAmount, err := e.eligibleAmount(ctx, transaction, rule)
+			if err != nil {
+				log.Error().
+					Err(err).
+					Str("rule_id", rule.ID.String()).
+					Str("transaction_id", transaction.ID.String()).
+					Msg("[RULE ENGINE] Failed to evaluate monthly threshold")
+				continue
+			}
+
+			if eligibleAmount == 0 {
+				log.Debug().
+					Str("rule_id", rule.ID.String()).
+					Str("rule_name", rule.Name).
+					Interface("monthly_threshold", rule.MonthlyThresholdCents).
+					Msg("[RULE ENGINE] Rule does NOT apply - monthly threshold not exceeded")
+				continue
+			}
+
 			matchedCount++
 			log.Info().
 				Str("rule_id", rule.ID.String()).
@@ -117,7 +204,7 @@ func (e *Engine) ProcessTransaction(ctx context.Context, transaction *ent.Transa
 				Str("transaction_id", transaction.ID.String()).
 				Msg("[RULE ENGINE] Rule matched! Executing...")
 
-			if err := e.executeRule(ctx, transaction, rule, account); err != nil {
+			if err := e.executeRule(ctx, transaction, rule, account, fundingAccountID, eligibleAmount); err != nil {
 				log.Error().
 					Err(err).
 					Str("rule_id", rule.ID.String()).
@@ -196,8 +283,144 @@ func (e *Engine) matchesRule(transaction *ent.Transaction, rule *ent.Rule) bool
 	return true
 }
 
-// executeRule creates a rule execution and savings transfer
-func (e *Engine) executeRule(ctx context.Context, transaction *ent.Transaction, rule *ent.Rule, account *ent.Account) (ret error) {
+// eligibleAmount returns the part of the transaction amount (cents) the rule applies to.
+// Rules with a monthly threshold (or a linked budget) only apply to spend above that threshold; for family
+// rules the month-to-date total is aggregated across every member of the family. Thresholds are in the home
+// currency of the rule's owner, like budgets, so spend in every currency is converted before comparing.
+func (e *Engine) eligibleAmount(ctx context.Context, transaction *ent.Transaction, rule *ent.Rule) (int64, error) {
+	txAmount := absInt64(transaction.Amount)
+
+	threshold := rule.MonthlyThresholdCents
+	// A linked budget supplies the threshold: the rule applies once the budget is exceeded
+	if budget := rule.Edges.Budget; budget != nil && budget.IsActive {
+		threshold = &budget.AmountCents
+	}
+
+	if threshold == nil {
+		return txAmount, nil
+	}
+
+	userIDs := []uuid.UUID{rule.UserID}
+	if rule.FamilyID != nil {
+		memberIDs, err := e.familyMemberIDs(ctx, *rule.FamilyID)
+		if err != nil {
+			return 0, err
+		}
+		userIDs = memberIDs
+	}
+
+	currencyCode, err := e.cashflowService.HomeCurrency(ctx, rule.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := e.monthToDateSpend(ctx, userIDs, string(rule.Category), currencyCode, transaction)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Debug().
+		Str("rule_id", rule.ID.String()).
+		Int("user_count", len(userIDs)).
+		Int64("month_to_date", total).
+		Int64("threshold", *threshold).
+		Str("currency", currencyCode).
+		Msg("[RULE ENGINE] Evaluated monthly threshold")
+
+	if total <= *threshold {
+		return 0, nil
+	}
+
+	converted, err := e.cashflowService.Convert(ctx, txAmount, transaction.Currency, currencyCode)
+	if err != nil {
+		return 0, err
+	}
+
+	// Only the portion of this transaction above the threshold counts
+	excess := total - *threshold
+	if excess >= converted {
+		return txAmount, nil
+	}
+
+	// Savings are moved in the transaction's currency
+	eligible, err := e.cashflowService.Convert(ctx, excess, currencyCode, transaction.Currency)
+	if err != nil {
+		return 0, err
+	}
+
+	return min(txAmount, eligible), nil
+}
+
+// familyMemberIDs returns the user IDs of every member of a family
+func (e *Engine) familyMemberIDs(ctx context.Context, familyID uuid.UUID) ([]uuid.UUID, error) {
+	members, err := e.db.FamilyMember.
+		Query().
+		Where(entfamilymember.FamilyID(familyID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load family members: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, len(members))
+	for i, member := range members {
+		userIDs[i] = member.UserID
+	}
+
+	return userIDs, nil
+}
+
+// monthToDateSpend sums settled spend in a category for the given users from the start of the month
+// up to (and including) the given transaction, converted to the given currency. Transactions on the same
+// day only count if they were stored before it, so each one sees the spend that preceded it and rules
+// don't save on the excess of a day twice.
+func (e *Engine) monthToDateSpend(ctx context.Context, userIDs []uuid.UUID, category, currencyCode string, transaction *ent.Transaction) (int64, error) {
+	until := transaction.Date
+	monthStart := time.Date(until.Year(), until.Month(), 1, 0, 0, 0, 0, until.Location())
+
+	var totals []struct {
+		Currency string `json:"currency"`
+		Total    int64  `json:"total"`
+	}
+
+	err := e.db.Transaction.
+		Query().
+		Where(
+			enttransaction.HasAccountWith(entaccount.UserIDIn(userIDs...)),
+			enttransaction.Category(category),
+			enttransaction.Pending(false),
+			enttransaction.InternalTransfer(false),
+			enttransaction.AmountGT(0),
+			enttransaction.DateGTE(monthStart),
+			enttransaction.DateLTE(until),
+			enttransaction.Or(
+				enttransaction.DateLT(until),
+				enttransaction.CreatedAtLT(transaction.CreatedAt),
+				enttransaction.ID(transaction.ID),
+			),
+		).
+		GroupBy(enttransaction.FieldCurrency).
+		Aggregate(func(s *sql.Selector) string {
+			return sql.As(fmt.Sprintf("SUM(%s)", s.C(enttransaction.FieldAmount)), "total")
+		}).
+		Scan(ctx, &totals)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum month-to-date spend: %w", err)
+	}
+
+	var sum int64
+	for _, total := range totals {
+		converted, err := e.cashflowService.Convert(ctx, total.Total, total.Currency, currencyCode)
+		if err != nil {
+			return 0, err
+		}
+		sum += converted
+	}
+
+	return sum, nil
+}
+
+// executeRule creates a rule execution and a savings transfer taking money from the funding account
+func (e *Engine) executeRule(ctx context.Context, transaction *ent.Transaction, rule *ent.Rule, account *ent.Account, fundingAccountID uuid.UUID, eligibleAmount int64) (ret error) {
 	log.Info().
 		Str("rule_id", rule.ID.String()).
 		Str("rule_name", rule.Name).
@@ -207,7 +430,7 @@ func (e *Engine) executeRule(ctx context.Context, transaction *ent.Transaction,
 		Msg("[RULE ENGINE] Starting rule execution")
 
 	// Calculate savings amount
-	savingsAmount, err := e.calculateSavingsAmount(transaction, rule)
+	savingsAmount, err := e.calculateSavingsAmount(eligibleAmount, rule)
 	if err != nil {
 		return fmt.Errorf("failed to calculate savings amount: %w", err)
 	}
@@ -231,8 +454,9 @@ func (e *Engine) executeRule(ctx context.Context, transaction *ent.Transaction,
 		SetRuleID(rule.ID).
 		SetTransactionID(transaction.ID).
 		SetUserID(account.UserID).
+		SetNillableFamilyID(rule.FamilyID).
 		SetAmountCents(savingsAmount).
-		SetSourceAccountID(account.ID).
+		SetSourceAccountID(fundingAccountID).
 		SetTargetAccountID(rule.TargetAccountID).
 		SetStatus(entruleexecution.StatusPending).
 		Save(ctx)
@@ -258,9 +482,10 @@ func (e *Engine) executeRule(ctx context.Context, transaction *ent.Transaction,
 		Create().
 		SetRuleExecutionID(execution.ID).
 		SetUserID(account.UserID).
-		SetSourceAccountID(account.ID).
+		SetSourceAccountID(fundingAccountID).
 		SetTargetAccountID(rule.TargetAccountID).
 		SetAmountCents(savingsAmount).
+		SetCurrency(transaction.Currency).
 		SetStatus(savingstransfer.StatusSuggested).
 		Save(ctx)
 
@@ -306,46 +531,48 @@ func (e *Engine) executeRule(ctx context.Context, transaction *ent.Transaction,
 		Int64("amount_cents", savingsAmount).
 		Msg("[RULE ENGINE] Rule executed successfully - committed to database")
 
-	// Send notification to user (don't fail if notification fails)
-	e.sendNotification(ctx, account.UserID, rule.Name, savingsAmount)
+	// Send notification (don't fail if notification fails)
+	if rule.FamilyID != nil {
+		e.notifyFamily(ctx, *rule.FamilyID, rule.Name, savingsAmount, transaction.Currency)
+	} else {
+		e.sendNotification(ctx, account.UserID, "Savings", rule.Name, savingsAmount, transaction.Currency)
+	}
 
 	return nil
 }
 
-// sendNotification sends a push notification to the user about the rule execution
-func (e *Engine) sendNotification(ctx context.Context, userID uuid.UUID, ruleName string, amountCents int64) {
-	// Format amount as dollars
-	amountDollars := float64(amountCents) / 100.0
+// notifyFamily notifies every member of a family about a family rule execution
+func (e *Engine) notifyFamily(ctx context.Context, familyID uuid.UUID, ruleName string, amountCents int64, currencyCode string) {
+	memberIDs, err := e.familyMemberIDs(ctx, familyID)
+	if err != nil {
+		log.Warn().
+			Err(err).
+			Str("family_id", familyID.String()).
+			Msg("Failed to load family members for notification")
+		return
+	}
+
+	for _, memberID := range memberIDs {
+		e.sendNotification(ctx, memberID, "Family Savings", ruleName, amountCents, currencyCode)
+	}
+}
 
+// sendNotification sends a push notification to the user about the rule execution
+func (e *Engine) sendNotification(ctx context.Context, userID uuid.UUID, destination, ruleName string, amountCents int64, currencyCode string) {
 	payload := &notification.Payload{
-		Title: fmt.Sprintf("$%.2f moved to Savings", amountDollars),
+		Title: fmt.Sprintf("%s moved to %s", currency.Format(amountCents, currencyCode), destination),
 		Body:  fmt.Sprintf("Rule: %s", ruleName),
 	}
 
-	successCount, errs := e.notificationService.SendToUser(ctx, userID, payload)
-
-	if len(errs) > 0 {
-		log.Warn().
-			Str("user_id", userID.String()).
-			Int("success_count", successCount).
-			Int("error_count", len(errs)).
-			Msg("Some notifications failed to send")
-	} else if successCount > 0 {
-		log.Debug().
-			Str("user_id", userID.String()).
-			Int("count", successCount).
-			Msg("Notifications sent successfully")
-	}
+	e.notificationService.Notify(ctx, userID, payload)
 }
 
 // calculateSavingsAmount calculates how much to save based on rule action
-func (e *Engine) calculateSavingsAmount(transaction *ent.Transaction, rule *ent.Rule) (int64, error) {
-	txAmount := absInt64(transaction.Amount)
-
+func (e *Engine) calculateSavingsAmount(eligibleAmount int64, rule *ent.Rule) (int64, error) {
 	switch rule.ActionType {
 	case entrule.ActionTypeMultiply:
-		// Multiply transaction amount by action_value
-		return int64(float64(txAmount) * float64(rule.ActionValue)), nil
+		// Multiply the eligible transaction amount by action_value
+		return int64(float64(eligibleAmount) * float64(rule.ActionValue)), nil
 
 	case entrule.ActionTypeFixed:
 		// Fixed amount in dollars -> convert to cents
@@ -0,0 +1,202 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DeltaLaboratory/contrib/hooks"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog/log"
+
+	"regulation/internal/categorizer"
+	"regulation/internal/ent"
+	entaccount "regulation/internal/ent/account"
+	entsavingstransfer "regulation/internal/ent/savingstransfer"
+	enttransaction "regulation/internal/ent/transaction"
+)
+
+// matchWindow is how far apart the two legs of a transfer may be dated
+const matchWindow = 3 * 24 * time.Hour
+
+// Detector flags transactions that move money between a user's own accounts
+// so they are not counted as income or spend
+type Detector struct {
+	db *ent.Client
+}
+
+// NewDetector creates a new internal transfer detector
+func NewDetector(db *ent.Client) *Detector {
+	return &Detector{db: db}
+}
+
+// Detect checks whether a transaction is an internal transfer and flags it,
+// linking it with the opposite leg when one is found in another of the user's accounts.
+// Transactions are considered internal transfers when:
+//   - an opposite-sign transaction of the same amount exists in another account of the user within matchWindow
+//   - an executed savings transfer of the same amount touched the account within matchWindow
+//   - it pays off a credit card, on either the paying account or the card
+//   - the categorizer assigned the Transfer category
+func (d *Detector) Detect(ctx context.Context, txn *ent.Transaction) (bool, error) {
+	if txn.InternalTransfer {
+		return true, nil
+	}
+
+	if txn.Amount == 0 {
+		return false, nil
+	}
+
+	account, err := d.db.Account.Get(ctx, txn.AccountID)
+	if err != nil {
+		return false, fmt.Errorf("failed to load account: %w", err)
+	}
+
+	// Look for the opposite leg in another account of the same user
+	counterpart, err := d.db.Transaction.
+		Query().
+		Where(
+			enttransaction.IDNEQ(txn.ID),
+			enttransaction.AccountIDNEQ(txn.AccountID),
+			enttransaction.HasAccountWith(entaccount.UserID(account.UserID)),
+			enttransaction.Amount(-txn.Amount),
+			enttransaction.Currency(txn.Currency),
+			enttransaction.Pending(false),
+			enttransaction.TransferPairIDIsNil(),
+			enttransaction.DateGTE(txn.Date.Add(-matchWindow)),
+			enttransaction.DateLTE(txn.Date.Add(matchWindow)),
+		).
+		Order(ent.Asc(enttransaction.FieldDate)).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return false, fmt.Errorf("failed to query transfer counterpart: %w", err)
+	}
+
+	if counterpart != nil {
+		if err := d.linkPair(ctx, txn, counterpart); err != nil {
+			return false, err
+		}
+
+		log.Info().
+			Str("transaction_id", txn.ID.String()).
+			Str("counterpart_id", counterpart.ID.String()).
+			Int64("amount_cents", txn.Amount).
+			Msg("[TRANSFER] Matched internal transfer between own accounts")
+
+		return true, nil
+	}
+
+	amount := txn.Amount
+	if amount < 0 {
+		amount = -amount
+	}
+
+	// Savings transfers executed by rules show up as transactions on both accounts
+	executed, err := d.db.SavingsTransfer.
+		Query().
+		Where(
+			entsavingstransfer.StatusEQ(entsavingstransfer.StatusExecuted),
+			entsavingstransfer.Or(
+				entsavingstransfer.SourceAccountID(txn.AccountID),
+				entsavingstransfer.TargetAccountID(txn.AccountID),
+			),
+			entsavingstransfer.AmountCents(amount),
+			entsavingstransfer.ExecutedAtGTE(txn.Date.Add(-matchWindow)),
+			entsavingstransfer.ExecutedAtLTE(txn.Date.Add(matchWindow)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to query savings transfers: %w", err)
+	}
+
+	// Card payments settle purchases already counted as spend on the card
+	cardPayment := isCardPayment(txn, account)
+
+	if !executed && !cardPayment && txn.Category != string(categorizer.CategoryTransfer) {
+		return false, nil
+	}
+
+	if err := d.db.Transaction.UpdateOne(txn).SetInternalTransfer(true).Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to flag internal transfer: %w", err)
+	}
+
+	log.Info().
+		Str("transaction_id", txn.ID.String()).
+		Bool("savings_transfer", executed).
+		Bool("card_payment", cardPayment).
+		Str("category", txn.Category).
+		Msg("[TRANSFER] Flagged internal transfer")
+
+	return true, nil
+}
+
+// linkPair flags both legs of a transfer and links them to each other
+func (d *Detector) linkPair(ctx context.Context, txn, counterpart *ent.Transaction) (ret error) {
+	tx, err := d.db.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer hooks.Rollback(tx, &ret)
+
+	err = tx.Transaction.
+		UpdateOne(txn).
+		SetInternalTransfer(true).
+		SetTransferPairID(counterpart.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to flag transaction: %w", err)
+	}
+
+	err = tx.Transaction.
+		UpdateOne(counterpart).
+		SetInternalTransfer(true).
+		SetTransferPairID(txn.ID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to flag counterpart transaction: %w", err)
+	}
+
+	// hooks.Rollback will auto-commit here since ret == nil
+	return nil
+}
+
+// Unpair unlinks a transaction from its removed counterpart and checks it again, since it may
+// pair with another transaction or still be a transfer on its own
+func (d *Detector) Unpair(ctx context.Context, txnID uuid.UUID) (bool, error) {
+	txn, err := d.db.Transaction.
+		UpdateOneID(txnID).
+		SetInternalTransfer(false).
+		ClearTransferPairID().
+		Save(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to unlink transfer counterpart: %w", err)
+	}
+
+	log.Info().
+		Str("transaction_id", txn.ID.String()).
+		Msg("[TRANSFER] Unlinked transfer whose counterpart was removed")
+
+	return d.Detect(ctx, txn)
+}
+
+// isCardPayment reports whether a transaction pays off a credit card, either as the payment
+// leaving a depository account or as the payment received on the card
+// Purchases and refunds on the card are not payments
+func isCardPayment(txn *ent.Transaction, account *ent.Account) bool {
+	payment, creditCard := false, false
+	for _, category := range txn.ProviderCategories {
+		switch category {
+		case "Payment":
+			payment = true
+		case "Credit Card":
+			creditCard = true
+		}
+	}
+
+	// Payments lower the amount owed, so they are credits on the card
+	if account.Type == entaccount.TypeCredit {
+		return txn.Amount < 0 && (payment || strings.Contains(strings.ToLower(txn.Name), "payment"))
+	}
+
+	return txn.Amount > 0 && payment && creditCard
+}